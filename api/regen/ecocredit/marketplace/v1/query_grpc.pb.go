@@ -35,6 +35,105 @@ type QueryClient interface {
 	// AllowedDenoms queries a paginated list of all bank denoms allowed to be
 	// used in the marketplace.
 	AllowedDenoms(ctx context.Context, in *QueryAllowedDenomsRequest, opts ...grpc.CallOption) (*QueryAllowedDenomsResponse, error)
+	// BuyOrder queries a buy order by its unique identifier.
+	//
+	// Since Revision 1
+	BuyOrder(ctx context.Context, in *QueryBuyOrderRequest, opts ...grpc.CallOption) (*QueryBuyOrderResponse, error)
+	// BuyOrders queries a paginated list of all buy orders.
+	//
+	// Since Revision 1
+	BuyOrders(ctx context.Context, in *QueryBuyOrdersRequest, opts ...grpc.CallOption) (*QueryBuyOrdersResponse, error)
+	// BuyOrdersByBuyer queries a paginated list of all buy orders based on the
+	// account address of the buyer.
+	//
+	// Since Revision 1
+	BuyOrdersByBuyer(ctx context.Context, in *QueryBuyOrdersByBuyerRequest, opts ...grpc.CallOption) (*QueryBuyOrdersByBuyerResponse, error)
+	// TradingFee queries the marketplace trading fee.
+	//
+	// Since Revision 1
+	TradingFee(ctx context.Context, in *QueryTradingFeeRequest, opts ...grpc.CallOption) (*QueryTradingFeeResponse, error)
+	// ClassRoyalty queries the royalty of a credit class.
+	//
+	// Since Revision 1
+	ClassRoyalty(ctx context.Context, in *QueryClassRoyaltyRequest, opts ...grpc.CallOption) (*QueryClassRoyaltyResponse, error)
+	// TradesByBatch queries all trades of credits from a credit batch.
+	//
+	// Since Revision 1
+	TradesByBatch(ctx context.Context, in *QueryTradesByBatchRequest, opts ...grpc.CallOption) (*QueryTradesByBatchResponse, error)
+	// TradesByMarket queries all trades in a market, i.e. all trades of credits
+	// of a credit type in exchange for a bank denom.
+	//
+	// Since Revision 1
+	TradesByMarket(ctx context.Context, in *QueryTradesByMarketRequest, opts ...grpc.CallOption) (*QueryTradesByMarketResponse, error)
+	// LastPrice queries the price of the most recent trade of credits from a
+	// credit batch in a bank denom.
+	//
+	// Since Revision 1
+	LastPrice(ctx context.Context, in *QueryLastPriceRequest, opts ...grpc.CallOption) (*QueryLastPriceResponse, error)
+	// VWAP queries the volume-weighted average price of trades of credits from a
+	// credit batch in a bank denom within an optional time range.
+	//
+	// Since Revision 1
+	VWAP(ctx context.Context, in *QueryVWAPRequest, opts ...grpc.CallOption) (*QueryVWAPResponse, error)
+	// Auction queries an auction by its unique identifier.
+	//
+	// Since Revision 1
+	Auction(ctx context.Context, in *QueryAuctionRequest, opts ...grpc.CallOption) (*QueryAuctionResponse, error)
+	// Auctions queries a paginated list of all auctions.
+	//
+	// Since Revision 1
+	Auctions(ctx context.Context, in *QueryAuctionsRequest, opts ...grpc.CallOption) (*QueryAuctionsResponse, error)
+	// Offer queries an offer by its unique identifier.
+	//
+	// Since Revision 1
+	Offer(ctx context.Context, in *QueryOfferRequest, opts ...grpc.CallOption) (*QueryOfferResponse, error)
+	// OffersBySellOrder queries a paginated list of offers made on a sell
+	// order.
+	//
+	// Since Revision 1
+	OffersBySellOrder(ctx context.Context, in *QueryOffersBySellOrderRequest, opts ...grpc.CallOption) (*QueryOffersBySellOrderResponse, error)
+	// OffersByBuyer queries a paginated list of offers made by a buyer.
+	//
+	// Since Revision 1
+	OffersByBuyer(ctx context.Context, in *QueryOffersByBuyerRequest, opts ...grpc.CallOption) (*QueryOffersByBuyerResponse, error)
+	// Swap queries a swap by its unique identifier.
+	//
+	// Since Revision 1
+	Swap(ctx context.Context, in *QuerySwapRequest, opts ...grpc.CallOption) (*QuerySwapResponse, error)
+	// SwapsByAddress queries a paginated list of swaps proposed by or to an
+	// address.
+	//
+	// Since Revision 1
+	SwapsByAddress(ctx context.Context, in *QuerySwapsByAddressRequest, opts ...grpc.CallOption) (*QuerySwapsByAddressResponse, error)
+	// ForwardContract queries a forward contract by its unique identifier.
+	//
+	// Since Revision 1
+	ForwardContract(ctx context.Context, in *QueryForwardContractRequest, opts ...grpc.CallOption) (*QueryForwardContractResponse, error)
+	// ForwardContractsByProject queries a paginated list of forward contracts
+	// for credits from a project.
+	//
+	// Since Revision 1
+	ForwardContractsByProject(ctx context.Context, in *QueryForwardContractsByProjectRequest, opts ...grpc.CallOption) (*QueryForwardContractsByProjectResponse, error)
+	// SellOrdersByClass queries a paginated list of sell orders for credits from
+	// a credit class with optional filtering and sorting.
+	//
+	// Since Revision 1
+	SellOrdersByClass(ctx context.Context, in *QuerySellOrdersByClassRequest, opts ...grpc.CallOption) (*QuerySellOrdersByClassResponse, error)
+	// SellOrdersByProject queries a paginated list of sell orders for credits
+	// from a project with optional filtering and sorting.
+	//
+	// Since Revision 1
+	SellOrdersByProject(ctx context.Context, in *QuerySellOrdersByProjectRequest, opts ...grpc.CallOption) (*QuerySellOrdersByProjectResponse, error)
+	// SellOrdersByMarket queries a paginated list of sell orders in a market
+	// with optional filtering and sorting.
+	//
+	// Since Revision 1
+	SellOrdersByMarket(ctx context.Context, in *QuerySellOrdersByMarketRequest, opts ...grpc.CallOption) (*QuerySellOrdersByMarketResponse, error)
+	// AllowedDenomTrace queries the IBC denom trace of an allowed denom, i.e. the
+	// base denom and the chain the denom originates from.
+	//
+	// Since Revision 1
+	AllowedDenomTrace(ctx context.Context, in *QueryAllowedDenomTraceRequest, opts ...grpc.CallOption) (*QueryAllowedDenomTraceResponse, error)
 }
 
 type queryClient struct {
@@ -90,6 +189,204 @@ func (c *queryClient) AllowedDenoms(ctx context.Context, in *QueryAllowedDenomsR
 	return out, nil
 }
 
+func (c *queryClient) BuyOrder(ctx context.Context, in *QueryBuyOrderRequest, opts ...grpc.CallOption) (*QueryBuyOrderResponse, error) {
+	out := new(QueryBuyOrderResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/BuyOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BuyOrders(ctx context.Context, in *QueryBuyOrdersRequest, opts ...grpc.CallOption) (*QueryBuyOrdersResponse, error) {
+	out := new(QueryBuyOrdersResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/BuyOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BuyOrdersByBuyer(ctx context.Context, in *QueryBuyOrdersByBuyerRequest, opts ...grpc.CallOption) (*QueryBuyOrdersByBuyerResponse, error) {
+	out := new(QueryBuyOrdersByBuyerResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/BuyOrdersByBuyer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TradingFee(ctx context.Context, in *QueryTradingFeeRequest, opts ...grpc.CallOption) (*QueryTradingFeeResponse, error) {
+	out := new(QueryTradingFeeResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/TradingFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClassRoyalty(ctx context.Context, in *QueryClassRoyaltyRequest, opts ...grpc.CallOption) (*QueryClassRoyaltyResponse, error) {
+	out := new(QueryClassRoyaltyResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/ClassRoyalty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TradesByBatch(ctx context.Context, in *QueryTradesByBatchRequest, opts ...grpc.CallOption) (*QueryTradesByBatchResponse, error) {
+	out := new(QueryTradesByBatchResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/TradesByBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TradesByMarket(ctx context.Context, in *QueryTradesByMarketRequest, opts ...grpc.CallOption) (*QueryTradesByMarketResponse, error) {
+	out := new(QueryTradesByMarketResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/TradesByMarket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LastPrice(ctx context.Context, in *QueryLastPriceRequest, opts ...grpc.CallOption) (*QueryLastPriceResponse, error) {
+	out := new(QueryLastPriceResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/LastPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VWAP(ctx context.Context, in *QueryVWAPRequest, opts ...grpc.CallOption) (*QueryVWAPResponse, error) {
+	out := new(QueryVWAPResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/VWAP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Auction(ctx context.Context, in *QueryAuctionRequest, opts ...grpc.CallOption) (*QueryAuctionResponse, error) {
+	out := new(QueryAuctionResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/Auction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Auctions(ctx context.Context, in *QueryAuctionsRequest, opts ...grpc.CallOption) (*QueryAuctionsResponse, error) {
+	out := new(QueryAuctionsResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/Auctions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Offer(ctx context.Context, in *QueryOfferRequest, opts ...grpc.CallOption) (*QueryOfferResponse, error) {
+	out := new(QueryOfferResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/Offer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OffersBySellOrder(ctx context.Context, in *QueryOffersBySellOrderRequest, opts ...grpc.CallOption) (*QueryOffersBySellOrderResponse, error) {
+	out := new(QueryOffersBySellOrderResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/OffersBySellOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OffersByBuyer(ctx context.Context, in *QueryOffersByBuyerRequest, opts ...grpc.CallOption) (*QueryOffersByBuyerResponse, error) {
+	out := new(QueryOffersByBuyerResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/OffersByBuyer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Swap(ctx context.Context, in *QuerySwapRequest, opts ...grpc.CallOption) (*QuerySwapResponse, error) {
+	out := new(QuerySwapResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/Swap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SwapsByAddress(ctx context.Context, in *QuerySwapsByAddressRequest, opts ...grpc.CallOption) (*QuerySwapsByAddressResponse, error) {
+	out := new(QuerySwapsByAddressResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/SwapsByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ForwardContract(ctx context.Context, in *QueryForwardContractRequest, opts ...grpc.CallOption) (*QueryForwardContractResponse, error) {
+	out := new(QueryForwardContractResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/ForwardContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ForwardContractsByProject(ctx context.Context, in *QueryForwardContractsByProjectRequest, opts ...grpc.CallOption) (*QueryForwardContractsByProjectResponse, error) {
+	out := new(QueryForwardContractsByProjectResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/ForwardContractsByProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SellOrdersByClass(ctx context.Context, in *QuerySellOrdersByClassRequest, opts ...grpc.CallOption) (*QuerySellOrdersByClassResponse, error) {
+	out := new(QuerySellOrdersByClassResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/SellOrdersByClass", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SellOrdersByProject(ctx context.Context, in *QuerySellOrdersByProjectRequest, opts ...grpc.CallOption) (*QuerySellOrdersByProjectResponse, error) {
+	out := new(QuerySellOrdersByProjectResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/SellOrdersByProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SellOrdersByMarket(ctx context.Context, in *QuerySellOrdersByMarketRequest, opts ...grpc.CallOption) (*QuerySellOrdersByMarketResponse, error) {
+	out := new(QuerySellOrdersByMarketResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/SellOrdersByMarket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllowedDenomTrace(ctx context.Context, in *QueryAllowedDenomTraceRequest, opts ...grpc.CallOption) (*QueryAllowedDenomTraceResponse, error) {
+	out := new(QueryAllowedDenomTraceResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/AllowedDenomTrace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -107,6 +404,105 @@ type QueryServer interface {
 	// AllowedDenoms queries a paginated list of all bank denoms allowed to be
 	// used in the marketplace.
 	AllowedDenoms(context.Context, *QueryAllowedDenomsRequest) (*QueryAllowedDenomsResponse, error)
+	// BuyOrder queries a buy order by its unique identifier.
+	//
+	// Since Revision 1
+	BuyOrder(context.Context, *QueryBuyOrderRequest) (*QueryBuyOrderResponse, error)
+	// BuyOrders queries a paginated list of all buy orders.
+	//
+	// Since Revision 1
+	BuyOrders(context.Context, *QueryBuyOrdersRequest) (*QueryBuyOrdersResponse, error)
+	// BuyOrdersByBuyer queries a paginated list of all buy orders based on the
+	// account address of the buyer.
+	//
+	// Since Revision 1
+	BuyOrdersByBuyer(context.Context, *QueryBuyOrdersByBuyerRequest) (*QueryBuyOrdersByBuyerResponse, error)
+	// TradingFee queries the marketplace trading fee.
+	//
+	// Since Revision 1
+	TradingFee(context.Context, *QueryTradingFeeRequest) (*QueryTradingFeeResponse, error)
+	// ClassRoyalty queries the royalty of a credit class.
+	//
+	// Since Revision 1
+	ClassRoyalty(context.Context, *QueryClassRoyaltyRequest) (*QueryClassRoyaltyResponse, error)
+	// TradesByBatch queries all trades of credits from a credit batch.
+	//
+	// Since Revision 1
+	TradesByBatch(context.Context, *QueryTradesByBatchRequest) (*QueryTradesByBatchResponse, error)
+	// TradesByMarket queries all trades in a market, i.e. all trades of credits
+	// of a credit type in exchange for a bank denom.
+	//
+	// Since Revision 1
+	TradesByMarket(context.Context, *QueryTradesByMarketRequest) (*QueryTradesByMarketResponse, error)
+	// LastPrice queries the price of the most recent trade of credits from a
+	// credit batch in a bank denom.
+	//
+	// Since Revision 1
+	LastPrice(context.Context, *QueryLastPriceRequest) (*QueryLastPriceResponse, error)
+	// VWAP queries the volume-weighted average price of trades of credits from a
+	// credit batch in a bank denom within an optional time range.
+	//
+	// Since Revision 1
+	VWAP(context.Context, *QueryVWAPRequest) (*QueryVWAPResponse, error)
+	// Auction queries an auction by its unique identifier.
+	//
+	// Since Revision 1
+	Auction(context.Context, *QueryAuctionRequest) (*QueryAuctionResponse, error)
+	// Auctions queries a paginated list of all auctions.
+	//
+	// Since Revision 1
+	Auctions(context.Context, *QueryAuctionsRequest) (*QueryAuctionsResponse, error)
+	// Offer queries an offer by its unique identifier.
+	//
+	// Since Revision 1
+	Offer(context.Context, *QueryOfferRequest) (*QueryOfferResponse, error)
+	// OffersBySellOrder queries a paginated list of offers made on a sell
+	// order.
+	//
+	// Since Revision 1
+	OffersBySellOrder(context.Context, *QueryOffersBySellOrderRequest) (*QueryOffersBySellOrderResponse, error)
+	// OffersByBuyer queries a paginated list of offers made by a buyer.
+	//
+	// Since Revision 1
+	OffersByBuyer(context.Context, *QueryOffersByBuyerRequest) (*QueryOffersByBuyerResponse, error)
+	// Swap queries a swap by its unique identifier.
+	//
+	// Since Revision 1
+	Swap(context.Context, *QuerySwapRequest) (*QuerySwapResponse, error)
+	// SwapsByAddress queries a paginated list of swaps proposed by or to an
+	// address.
+	//
+	// Since Revision 1
+	SwapsByAddress(context.Context, *QuerySwapsByAddressRequest) (*QuerySwapsByAddressResponse, error)
+	// ForwardContract queries a forward contract by its unique identifier.
+	//
+	// Since Revision 1
+	ForwardContract(context.Context, *QueryForwardContractRequest) (*QueryForwardContractResponse, error)
+	// ForwardContractsByProject queries a paginated list of forward contracts
+	// for credits from a project.
+	//
+	// Since Revision 1
+	ForwardContractsByProject(context.Context, *QueryForwardContractsByProjectRequest) (*QueryForwardContractsByProjectResponse, error)
+	// SellOrdersByClass queries a paginated list of sell orders for credits from
+	// a credit class with optional filtering and sorting.
+	//
+	// Since Revision 1
+	SellOrdersByClass(context.Context, *QuerySellOrdersByClassRequest) (*QuerySellOrdersByClassResponse, error)
+	// SellOrdersByProject queries a paginated list of sell orders for credits
+	// from a project with optional filtering and sorting.
+	//
+	// Since Revision 1
+	SellOrdersByProject(context.Context, *QuerySellOrdersByProjectRequest) (*QuerySellOrdersByProjectResponse, error)
+	// SellOrdersByMarket queries a paginated list of sell orders in a market
+	// with optional filtering and sorting.
+	//
+	// Since Revision 1
+	SellOrdersByMarket(context.Context, *QuerySellOrdersByMarketRequest) (*QuerySellOrdersByMarketResponse, error)
+	// AllowedDenomTrace queries the IBC denom trace of an allowed denom, i.e. the
+	// base denom and the chain the denom originates from.
+	//
+	// Since Revision 1
+	AllowedDenomTrace(context.Context, *QueryAllowedDenomTraceRequest) (*QueryAllowedDenomTraceResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -129,6 +525,72 @@ func (UnimplementedQueryServer) SellOrdersBySeller(context.Context, *QuerySellOr
 func (UnimplementedQueryServer) AllowedDenoms(context.Context, *QueryAllowedDenomsRequest) (*QueryAllowedDenomsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllowedDenoms not implemented")
 }
+func (UnimplementedQueryServer) BuyOrder(context.Context, *QueryBuyOrderRequest) (*QueryBuyOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyOrder not implemented")
+}
+func (UnimplementedQueryServer) BuyOrders(context.Context, *QueryBuyOrdersRequest) (*QueryBuyOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyOrders not implemented")
+}
+func (UnimplementedQueryServer) BuyOrdersByBuyer(context.Context, *QueryBuyOrdersByBuyerRequest) (*QueryBuyOrdersByBuyerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyOrdersByBuyer not implemented")
+}
+func (UnimplementedQueryServer) TradingFee(context.Context, *QueryTradingFeeRequest) (*QueryTradingFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TradingFee not implemented")
+}
+func (UnimplementedQueryServer) ClassRoyalty(context.Context, *QueryClassRoyaltyRequest) (*QueryClassRoyaltyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassRoyalty not implemented")
+}
+func (UnimplementedQueryServer) TradesByBatch(context.Context, *QueryTradesByBatchRequest) (*QueryTradesByBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TradesByBatch not implemented")
+}
+func (UnimplementedQueryServer) TradesByMarket(context.Context, *QueryTradesByMarketRequest) (*QueryTradesByMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TradesByMarket not implemented")
+}
+func (UnimplementedQueryServer) LastPrice(context.Context, *QueryLastPriceRequest) (*QueryLastPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastPrice not implemented")
+}
+func (UnimplementedQueryServer) VWAP(context.Context, *QueryVWAPRequest) (*QueryVWAPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VWAP not implemented")
+}
+func (UnimplementedQueryServer) Auction(context.Context, *QueryAuctionRequest) (*QueryAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Auction not implemented")
+}
+func (UnimplementedQueryServer) Auctions(context.Context, *QueryAuctionsRequest) (*QueryAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Auctions not implemented")
+}
+func (UnimplementedQueryServer) Offer(context.Context, *QueryOfferRequest) (*QueryOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Offer not implemented")
+}
+func (UnimplementedQueryServer) OffersBySellOrder(context.Context, *QueryOffersBySellOrderRequest) (*QueryOffersBySellOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OffersBySellOrder not implemented")
+}
+func (UnimplementedQueryServer) OffersByBuyer(context.Context, *QueryOffersByBuyerRequest) (*QueryOffersByBuyerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OffersByBuyer not implemented")
+}
+func (UnimplementedQueryServer) Swap(context.Context, *QuerySwapRequest) (*QuerySwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Swap not implemented")
+}
+func (UnimplementedQueryServer) SwapsByAddress(context.Context, *QuerySwapsByAddressRequest) (*QuerySwapsByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapsByAddress not implemented")
+}
+func (UnimplementedQueryServer) ForwardContract(context.Context, *QueryForwardContractRequest) (*QueryForwardContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForwardContract not implemented")
+}
+func (UnimplementedQueryServer) ForwardContractsByProject(context.Context, *QueryForwardContractsByProjectRequest) (*QueryForwardContractsByProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForwardContractsByProject not implemented")
+}
+func (UnimplementedQueryServer) SellOrdersByClass(context.Context, *QuerySellOrdersByClassRequest) (*QuerySellOrdersByClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SellOrdersByClass not implemented")
+}
+func (UnimplementedQueryServer) SellOrdersByProject(context.Context, *QuerySellOrdersByProjectRequest) (*QuerySellOrdersByProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SellOrdersByProject not implemented")
+}
+func (UnimplementedQueryServer) SellOrdersByMarket(context.Context, *QuerySellOrdersByMarketRequest) (*QuerySellOrdersByMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SellOrdersByMarket not implemented")
+}
+func (UnimplementedQueryServer) AllowedDenomTrace(context.Context, *QueryAllowedDenomTraceRequest) (*QueryAllowedDenomTraceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedDenomTrace not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -232,6 +694,402 @@ func _Query_AllowedDenoms_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BuyOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBuyOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BuyOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/BuyOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BuyOrder(ctx, req.(*QueryBuyOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BuyOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBuyOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BuyOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/BuyOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BuyOrders(ctx, req.(*QueryBuyOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BuyOrdersByBuyer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBuyOrdersByBuyerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BuyOrdersByBuyer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/BuyOrdersByBuyer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BuyOrdersByBuyer(ctx, req.(*QueryBuyOrdersByBuyerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TradingFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTradingFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TradingFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/TradingFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TradingFee(ctx, req.(*QueryTradingFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClassRoyalty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassRoyaltyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClassRoyalty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/ClassRoyalty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassRoyalty(ctx, req.(*QueryClassRoyaltyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TradesByBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTradesByBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TradesByBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/TradesByBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TradesByBatch(ctx, req.(*QueryTradesByBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TradesByMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTradesByMarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TradesByMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/TradesByMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TradesByMarket(ctx, req.(*QueryTradesByMarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LastPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LastPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/LastPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastPrice(ctx, req.(*QueryLastPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VWAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVWAPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VWAP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/VWAP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VWAP(ctx, req.(*QueryVWAPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Auction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Auction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/Auction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Auction(ctx, req.(*QueryAuctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Auctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Auctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/Auctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Auctions(ctx, req.(*QueryAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Offer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Offer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/Offer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Offer(ctx, req.(*QueryOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OffersBySellOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOffersBySellOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OffersBySellOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/OffersBySellOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OffersBySellOrder(ctx, req.(*QueryOffersBySellOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OffersByBuyer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOffersByBuyerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OffersByBuyer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/OffersByBuyer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OffersByBuyer(ctx, req.(*QueryOffersByBuyerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Swap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Swap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/Swap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Swap(ctx, req.(*QuerySwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SwapsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapsByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SwapsByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/SwapsByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SwapsByAddress(ctx, req.(*QuerySwapsByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ForwardContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryForwardContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ForwardContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/ForwardContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ForwardContract(ctx, req.(*QueryForwardContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ForwardContractsByProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryForwardContractsByProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ForwardContractsByProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/ForwardContractsByProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ForwardContractsByProject(ctx, req.(*QueryForwardContractsByProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SellOrdersByClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySellOrdersByClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SellOrdersByClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/SellOrdersByClass",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SellOrdersByClass(ctx, req.(*QuerySellOrdersByClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SellOrdersByProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySellOrdersByProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SellOrdersByProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/SellOrdersByProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SellOrdersByProject(ctx, req.(*QuerySellOrdersByProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SellOrdersByMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySellOrdersByMarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SellOrdersByMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/SellOrdersByMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SellOrdersByMarket(ctx, req.(*QuerySellOrdersByMarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowedDenomTrace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowedDenomTraceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedDenomTrace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/AllowedDenomTrace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedDenomTrace(ctx, req.(*QueryAllowedDenomTraceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -259,6 +1117,94 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllowedDenoms",
 			Handler:    _Query_AllowedDenoms_Handler,
 		},
+		{
+			MethodName: "BuyOrder",
+			Handler:    _Query_BuyOrder_Handler,
+		},
+		{
+			MethodName: "BuyOrders",
+			Handler:    _Query_BuyOrders_Handler,
+		},
+		{
+			MethodName: "BuyOrdersByBuyer",
+			Handler:    _Query_BuyOrdersByBuyer_Handler,
+		},
+		{
+			MethodName: "TradingFee",
+			Handler:    _Query_TradingFee_Handler,
+		},
+		{
+			MethodName: "ClassRoyalty",
+			Handler:    _Query_ClassRoyalty_Handler,
+		},
+		{
+			MethodName: "TradesByBatch",
+			Handler:    _Query_TradesByBatch_Handler,
+		},
+		{
+			MethodName: "TradesByMarket",
+			Handler:    _Query_TradesByMarket_Handler,
+		},
+		{
+			MethodName: "LastPrice",
+			Handler:    _Query_LastPrice_Handler,
+		},
+		{
+			MethodName: "VWAP",
+			Handler:    _Query_VWAP_Handler,
+		},
+		{
+			MethodName: "Auction",
+			Handler:    _Query_Auction_Handler,
+		},
+		{
+			MethodName: "Auctions",
+			Handler:    _Query_Auctions_Handler,
+		},
+		{
+			MethodName: "Offer",
+			Handler:    _Query_Offer_Handler,
+		},
+		{
+			MethodName: "OffersBySellOrder",
+			Handler:    _Query_OffersBySellOrder_Handler,
+		},
+		{
+			MethodName: "OffersByBuyer",
+			Handler:    _Query_OffersByBuyer_Handler,
+		},
+		{
+			MethodName: "Swap",
+			Handler:    _Query_Swap_Handler,
+		},
+		{
+			MethodName: "SwapsByAddress",
+			Handler:    _Query_SwapsByAddress_Handler,
+		},
+		{
+			MethodName: "ForwardContract",
+			Handler:    _Query_ForwardContract_Handler,
+		},
+		{
+			MethodName: "ForwardContractsByProject",
+			Handler:    _Query_ForwardContractsByProject_Handler,
+		},
+		{
+			MethodName: "SellOrdersByClass",
+			Handler:    _Query_SellOrdersByClass_Handler,
+		},
+		{
+			MethodName: "SellOrdersByProject",
+			Handler:    _Query_SellOrdersByProject_Handler,
+		},
+		{
+			MethodName: "SellOrdersByMarket",
+			Handler:    _Query_SellOrdersByMarket_Handler,
+		},
+		{
+			MethodName: "AllowedDenomTrace",
+			Handler:    _Query_AllowedDenomTrace_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "regen/ecocredit/marketplace/v1/query.proto",
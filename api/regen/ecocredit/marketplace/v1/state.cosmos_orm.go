@@ -98,6 +98,37 @@ func (this SellOrderExpirationIndexKey) WithExpiration(expiration *timestamppb.T
 	return this
 }
 
+type SellOrderMarketIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x SellOrderMarketIdIndexKey) id() uint32            { return 4 }
+func (x SellOrderMarketIdIndexKey) values() []interface{} { return x.vs }
+func (x SellOrderMarketIdIndexKey) sellOrderIndexKey()    {}
+
+func (this SellOrderMarketIdIndexKey) WithMarketId(market_id uint64) SellOrderMarketIdIndexKey {
+	this.vs = []interface{}{market_id}
+	return this
+}
+
+type SellOrderMarketIdSortableAskAmountIndexKey struct {
+	vs []interface{}
+}
+
+func (x SellOrderMarketIdSortableAskAmountIndexKey) id() uint32            { return 5 }
+func (x SellOrderMarketIdSortableAskAmountIndexKey) values() []interface{} { return x.vs }
+func (x SellOrderMarketIdSortableAskAmountIndexKey) sellOrderIndexKey()    {}
+
+func (this SellOrderMarketIdSortableAskAmountIndexKey) WithMarketId(market_id uint64) SellOrderMarketIdSortableAskAmountIndexKey {
+	this.vs = []interface{}{market_id}
+	return this
+}
+
+func (this SellOrderMarketIdSortableAskAmountIndexKey) WithMarketIdSortableAskAmount(market_id uint64, sortable_ask_amount string) SellOrderMarketIdSortableAskAmountIndexKey {
+	this.vs = []interface{}{market_id, sortable_ask_amount}
+	return this
+}
+
 type sellOrderTable struct {
 	table ormtable.AutoIncrementTable
 }
@@ -168,6 +199,151 @@ func NewSellOrderTable(db ormtable.Schema) (SellOrderTable, error) {
 	return sellOrderTable{table.(ormtable.AutoIncrementTable)}, nil
 }
 
+type BuyOrderTable interface {
+	Insert(ctx context.Context, buyOrder *BuyOrder) error
+	InsertReturningID(ctx context.Context, buyOrder *BuyOrder) (uint64, error)
+	Update(ctx context.Context, buyOrder *BuyOrder) error
+	Save(ctx context.Context, buyOrder *BuyOrder) error
+	Delete(ctx context.Context, buyOrder *BuyOrder) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*BuyOrder, error)
+	List(ctx context.Context, prefixKey BuyOrderIndexKey, opts ...ormlist.Option) (BuyOrderIterator, error)
+	ListRange(ctx context.Context, from, to BuyOrderIndexKey, opts ...ormlist.Option) (BuyOrderIterator, error)
+	DeleteBy(ctx context.Context, prefixKey BuyOrderIndexKey) error
+	DeleteRange(ctx context.Context, from, to BuyOrderIndexKey) error
+
+	doNotImplement()
+}
+
+type BuyOrderIterator struct {
+	ormtable.Iterator
+}
+
+func (i BuyOrderIterator) Value() (*BuyOrder, error) {
+	var buyOrder BuyOrder
+	err := i.UnmarshalMessage(&buyOrder)
+	return &buyOrder, err
+}
+
+type BuyOrderIndexKey interface {
+	id() uint32
+	values() []interface{}
+	buyOrderIndexKey()
+}
+
+// primary key starting index..
+type BuyOrderPrimaryKey = BuyOrderIdIndexKey
+
+type BuyOrderIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x BuyOrderIdIndexKey) id() uint32            { return 0 }
+func (x BuyOrderIdIndexKey) values() []interface{} { return x.vs }
+func (x BuyOrderIdIndexKey) buyOrderIndexKey()     {}
+
+func (this BuyOrderIdIndexKey) WithId(id uint64) BuyOrderIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type BuyOrderBuyerIndexKey struct {
+	vs []interface{}
+}
+
+func (x BuyOrderBuyerIndexKey) id() uint32            { return 1 }
+func (x BuyOrderBuyerIndexKey) values() []interface{} { return x.vs }
+func (x BuyOrderBuyerIndexKey) buyOrderIndexKey()     {}
+
+func (this BuyOrderBuyerIndexKey) WithBuyer(buyer []byte) BuyOrderBuyerIndexKey {
+	this.vs = []interface{}{buyer}
+	return this
+}
+
+type BuyOrderExpirationIndexKey struct {
+	vs []interface{}
+}
+
+func (x BuyOrderExpirationIndexKey) id() uint32            { return 2 }
+func (x BuyOrderExpirationIndexKey) values() []interface{} { return x.vs }
+func (x BuyOrderExpirationIndexKey) buyOrderIndexKey()     {}
+
+func (this BuyOrderExpirationIndexKey) WithExpiration(expiration *timestamppb.Timestamp) BuyOrderExpirationIndexKey {
+	this.vs = []interface{}{expiration}
+	return this
+}
+
+type buyOrderTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this buyOrderTable) Insert(ctx context.Context, buyOrder *BuyOrder) error {
+	return this.table.Insert(ctx, buyOrder)
+}
+
+func (this buyOrderTable) Update(ctx context.Context, buyOrder *BuyOrder) error {
+	return this.table.Update(ctx, buyOrder)
+}
+
+func (this buyOrderTable) Save(ctx context.Context, buyOrder *BuyOrder) error {
+	return this.table.Save(ctx, buyOrder)
+}
+
+func (this buyOrderTable) Delete(ctx context.Context, buyOrder *BuyOrder) error {
+	return this.table.Delete(ctx, buyOrder)
+}
+
+func (this buyOrderTable) InsertReturningID(ctx context.Context, buyOrder *BuyOrder) (uint64, error) {
+	return this.table.InsertReturningID(ctx, buyOrder)
+}
+
+func (this buyOrderTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this buyOrderTable) Get(ctx context.Context, id uint64) (*BuyOrder, error) {
+	var buyOrder BuyOrder
+	found, err := this.table.PrimaryKey().Get(ctx, &buyOrder, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &buyOrder, nil
+}
+
+func (this buyOrderTable) List(ctx context.Context, prefixKey BuyOrderIndexKey, opts ...ormlist.Option) (BuyOrderIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return BuyOrderIterator{it}, err
+}
+
+func (this buyOrderTable) ListRange(ctx context.Context, from, to BuyOrderIndexKey, opts ...ormlist.Option) (BuyOrderIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return BuyOrderIterator{it}, err
+}
+
+func (this buyOrderTable) DeleteBy(ctx context.Context, prefixKey BuyOrderIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this buyOrderTable) DeleteRange(ctx context.Context, from, to BuyOrderIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this buyOrderTable) doNotImplement() {}
+
+var _ BuyOrderTable = buyOrderTable{}
+
+func NewBuyOrderTable(db ormtable.Schema) (BuyOrderTable, error) {
+	table := db.GetTable(&BuyOrder{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&BuyOrder{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return buyOrderTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
 type AllowedDenomTable interface {
 	Insert(ctx context.Context, allowedDenom *AllowedDenom) error
 	Update(ctx context.Context, allowedDenom *AllowedDenom) error
@@ -480,55 +656,1483 @@ func NewMarketTable(db ormtable.Schema) (MarketTable, error) {
 	return marketTable{table.(ormtable.AutoIncrementTable)}, nil
 }
 
-type StateStore interface {
-	SellOrderTable() SellOrderTable
-	AllowedDenomTable() AllowedDenomTable
-	MarketTable() MarketTable
+// singleton store
+type TradingFeeTable interface {
+	Get(ctx context.Context) (*TradingFee, error)
+	Save(ctx context.Context, tradingFee *TradingFee) error
+}
+
+type tradingFeeTable struct {
+	table ormtable.Table
+}
+
+var _ TradingFeeTable = tradingFeeTable{}
+
+func (x tradingFeeTable) Get(ctx context.Context) (*TradingFee, error) {
+	tradingFee := &TradingFee{}
+	_, err := x.table.Get(ctx, tradingFee)
+	return tradingFee, err
+}
+
+func (x tradingFeeTable) Save(ctx context.Context, tradingFee *TradingFee) error {
+	return x.table.Save(ctx, tradingFee)
+}
+
+func NewTradingFeeTable(db ormtable.Schema) (TradingFeeTable, error) {
+	table := db.GetTable(&TradingFee{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&TradingFee{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return &tradingFeeTable{table}, nil
+}
+
+type ClassRoyaltyTable interface {
+	Insert(ctx context.Context, classRoyalty *ClassRoyalty) error
+	Update(ctx context.Context, classRoyalty *ClassRoyalty) error
+	Save(ctx context.Context, classRoyalty *ClassRoyalty) error
+	Delete(ctx context.Context, classRoyalty *ClassRoyalty) error
+	Has(ctx context.Context, class_key uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, class_key uint64) (*ClassRoyalty, error)
+	List(ctx context.Context, prefixKey ClassRoyaltyIndexKey, opts ...ormlist.Option) (ClassRoyaltyIterator, error)
+	ListRange(ctx context.Context, from, to ClassRoyaltyIndexKey, opts ...ormlist.Option) (ClassRoyaltyIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ClassRoyaltyIndexKey) error
+	DeleteRange(ctx context.Context, from, to ClassRoyaltyIndexKey) error
 
 	doNotImplement()
 }
 
-type stateStore struct {
-	sellOrder    SellOrderTable
-	allowedDenom AllowedDenomTable
-	market       MarketTable
+type ClassRoyaltyIterator struct {
+	ormtable.Iterator
 }
 
-func (x stateStore) SellOrderTable() SellOrderTable {
-	return x.sellOrder
+func (i ClassRoyaltyIterator) Value() (*ClassRoyalty, error) {
+	var classRoyalty ClassRoyalty
+	err := i.UnmarshalMessage(&classRoyalty)
+	return &classRoyalty, err
 }
 
-func (x stateStore) AllowedDenomTable() AllowedDenomTable {
-	return x.allowedDenom
+type ClassRoyaltyIndexKey interface {
+	id() uint32
+	values() []interface{}
+	classRoyaltyIndexKey()
 }
 
-func (x stateStore) MarketTable() MarketTable {
-	return x.market
+// primary key starting index..
+type ClassRoyaltyPrimaryKey = ClassRoyaltyClassKeyIndexKey
+
+type ClassRoyaltyClassKeyIndexKey struct {
+	vs []interface{}
 }
 
-func (stateStore) doNotImplement() {}
+func (x ClassRoyaltyClassKeyIndexKey) id() uint32            { return 0 }
+func (x ClassRoyaltyClassKeyIndexKey) values() []interface{} { return x.vs }
+func (x ClassRoyaltyClassKeyIndexKey) classRoyaltyIndexKey() {}
 
-var _ StateStore = stateStore{}
+func (this ClassRoyaltyClassKeyIndexKey) WithClassKey(class_key uint64) ClassRoyaltyClassKeyIndexKey {
+	this.vs = []interface{}{class_key}
+	return this
+}
 
-func NewStateStore(db ormtable.Schema) (StateStore, error) {
-	sellOrderTable, err := NewSellOrderTable(db)
+type classRoyaltyTable struct {
+	table ormtable.Table
+}
+
+func (this classRoyaltyTable) Insert(ctx context.Context, classRoyalty *ClassRoyalty) error {
+	return this.table.Insert(ctx, classRoyalty)
+}
+
+func (this classRoyaltyTable) Update(ctx context.Context, classRoyalty *ClassRoyalty) error {
+	return this.table.Update(ctx, classRoyalty)
+}
+
+func (this classRoyaltyTable) Save(ctx context.Context, classRoyalty *ClassRoyalty) error {
+	return this.table.Save(ctx, classRoyalty)
+}
+
+func (this classRoyaltyTable) Delete(ctx context.Context, classRoyalty *ClassRoyalty) error {
+	return this.table.Delete(ctx, classRoyalty)
+}
+
+func (this classRoyaltyTable) Has(ctx context.Context, class_key uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, class_key)
+}
+
+func (this classRoyaltyTable) Get(ctx context.Context, class_key uint64) (*ClassRoyalty, error) {
+	var classRoyalty ClassRoyalty
+	found, err := this.table.PrimaryKey().Get(ctx, &classRoyalty, class_key)
 	if err != nil {
 		return nil, err
 	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &classRoyalty, nil
+}
 
-	allowedDenomTable, err := NewAllowedDenomTable(db)
+func (this classRoyaltyTable) List(ctx context.Context, prefixKey ClassRoyaltyIndexKey, opts ...ormlist.Option) (ClassRoyaltyIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ClassRoyaltyIterator{it}, err
+}
+
+func (this classRoyaltyTable) ListRange(ctx context.Context, from, to ClassRoyaltyIndexKey, opts ...ormlist.Option) (ClassRoyaltyIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ClassRoyaltyIterator{it}, err
+}
+
+func (this classRoyaltyTable) DeleteBy(ctx context.Context, prefixKey ClassRoyaltyIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this classRoyaltyTable) DeleteRange(ctx context.Context, from, to ClassRoyaltyIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this classRoyaltyTable) doNotImplement() {}
+
+var _ ClassRoyaltyTable = classRoyaltyTable{}
+
+func NewClassRoyaltyTable(db ormtable.Schema) (ClassRoyaltyTable, error) {
+	table := db.GetTable(&ClassRoyalty{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&ClassRoyalty{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return classRoyaltyTable{table}, nil
+}
+
+type TradeTable interface {
+	Insert(ctx context.Context, trade *Trade) error
+	InsertReturningID(ctx context.Context, trade *Trade) (uint64, error)
+	Update(ctx context.Context, trade *Trade) error
+	Save(ctx context.Context, trade *Trade) error
+	Delete(ctx context.Context, trade *Trade) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*Trade, error)
+	List(ctx context.Context, prefixKey TradeIndexKey, opts ...ormlist.Option) (TradeIterator, error)
+	ListRange(ctx context.Context, from, to TradeIndexKey, opts ...ormlist.Option) (TradeIterator, error)
+	DeleteBy(ctx context.Context, prefixKey TradeIndexKey) error
+	DeleteRange(ctx context.Context, from, to TradeIndexKey) error
+
+	doNotImplement()
+}
+
+type TradeIterator struct {
+	ormtable.Iterator
+}
+
+func (i TradeIterator) Value() (*Trade, error) {
+	var trade Trade
+	err := i.UnmarshalMessage(&trade)
+	return &trade, err
+}
+
+type TradeIndexKey interface {
+	id() uint32
+	values() []interface{}
+	tradeIndexKey()
+}
+
+// primary key starting index..
+type TradePrimaryKey = TradeIdIndexKey
+
+type TradeIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x TradeIdIndexKey) id() uint32            { return 0 }
+func (x TradeIdIndexKey) values() []interface{} { return x.vs }
+func (x TradeIdIndexKey) tradeIndexKey()        {}
+
+func (this TradeIdIndexKey) WithId(id uint64) TradeIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type TradeBatchKeyMarketIdTimestampIndexKey struct {
+	vs []interface{}
+}
+
+func (x TradeBatchKeyMarketIdTimestampIndexKey) id() uint32            { return 1 }
+func (x TradeBatchKeyMarketIdTimestampIndexKey) values() []interface{} { return x.vs }
+func (x TradeBatchKeyMarketIdTimestampIndexKey) tradeIndexKey()        {}
+
+func (this TradeBatchKeyMarketIdTimestampIndexKey) WithBatchKey(batch_key uint64) TradeBatchKeyMarketIdTimestampIndexKey {
+	this.vs = []interface{}{batch_key}
+	return this
+}
+
+func (this TradeBatchKeyMarketIdTimestampIndexKey) WithBatchKeyMarketId(batch_key uint64, market_id uint64) TradeBatchKeyMarketIdTimestampIndexKey {
+	this.vs = []interface{}{batch_key, market_id}
+	return this
+}
+
+func (this TradeBatchKeyMarketIdTimestampIndexKey) WithBatchKeyMarketIdTimestamp(batch_key uint64, market_id uint64, timestamp *timestamppb.Timestamp) TradeBatchKeyMarketIdTimestampIndexKey {
+	this.vs = []interface{}{batch_key, market_id, timestamp}
+	return this
+}
+
+type TradeMarketIdTimestampIndexKey struct {
+	vs []interface{}
+}
+
+func (x TradeMarketIdTimestampIndexKey) id() uint32            { return 2 }
+func (x TradeMarketIdTimestampIndexKey) values() []interface{} { return x.vs }
+func (x TradeMarketIdTimestampIndexKey) tradeIndexKey()        {}
+
+func (this TradeMarketIdTimestampIndexKey) WithMarketId(market_id uint64) TradeMarketIdTimestampIndexKey {
+	this.vs = []interface{}{market_id}
+	return this
+}
+
+func (this TradeMarketIdTimestampIndexKey) WithMarketIdTimestamp(market_id uint64, timestamp *timestamppb.Timestamp) TradeMarketIdTimestampIndexKey {
+	this.vs = []interface{}{market_id, timestamp}
+	return this
+}
+
+type TradeTimestampIndexKey struct {
+	vs []interface{}
+}
+
+func (x TradeTimestampIndexKey) id() uint32            { return 3 }
+func (x TradeTimestampIndexKey) values() []interface{} { return x.vs }
+func (x TradeTimestampIndexKey) tradeIndexKey()        {}
+
+func (this TradeTimestampIndexKey) WithTimestamp(timestamp *timestamppb.Timestamp) TradeTimestampIndexKey {
+	this.vs = []interface{}{timestamp}
+	return this
+}
+
+type tradeTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this tradeTable) Insert(ctx context.Context, trade *Trade) error {
+	return this.table.Insert(ctx, trade)
+}
+
+func (this tradeTable) Update(ctx context.Context, trade *Trade) error {
+	return this.table.Update(ctx, trade)
+}
+
+func (this tradeTable) Save(ctx context.Context, trade *Trade) error {
+	return this.table.Save(ctx, trade)
+}
+
+func (this tradeTable) Delete(ctx context.Context, trade *Trade) error {
+	return this.table.Delete(ctx, trade)
+}
+
+func (this tradeTable) InsertReturningID(ctx context.Context, trade *Trade) (uint64, error) {
+	return this.table.InsertReturningID(ctx, trade)
+}
+
+func (this tradeTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this tradeTable) Get(ctx context.Context, id uint64) (*Trade, error) {
+	var trade Trade
+	found, err := this.table.PrimaryKey().Get(ctx, &trade, id)
 	if err != nil {
 		return nil, err
 	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &trade, nil
+}
 
-	marketTable, err := NewMarketTable(db)
+func (this tradeTable) List(ctx context.Context, prefixKey TradeIndexKey, opts ...ormlist.Option) (TradeIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return TradeIterator{it}, err
+}
+
+func (this tradeTable) ListRange(ctx context.Context, from, to TradeIndexKey, opts ...ormlist.Option) (TradeIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return TradeIterator{it}, err
+}
+
+func (this tradeTable) DeleteBy(ctx context.Context, prefixKey TradeIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this tradeTable) DeleteRange(ctx context.Context, from, to TradeIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this tradeTable) doNotImplement() {}
+
+var _ TradeTable = tradeTable{}
+
+func NewTradeTable(db ormtable.Schema) (TradeTable, error) {
+	table := db.GetTable(&Trade{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&Trade{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return tradeTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type AuctionTable interface {
+	Insert(ctx context.Context, auction *Auction) error
+	InsertReturningID(ctx context.Context, auction *Auction) (uint64, error)
+	Update(ctx context.Context, auction *Auction) error
+	Save(ctx context.Context, auction *Auction) error
+	Delete(ctx context.Context, auction *Auction) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*Auction, error)
+	List(ctx context.Context, prefixKey AuctionIndexKey, opts ...ormlist.Option) (AuctionIterator, error)
+	ListRange(ctx context.Context, from, to AuctionIndexKey, opts ...ormlist.Option) (AuctionIterator, error)
+	DeleteBy(ctx context.Context, prefixKey AuctionIndexKey) error
+	DeleteRange(ctx context.Context, from, to AuctionIndexKey) error
+
+	doNotImplement()
+}
+
+type AuctionIterator struct {
+	ormtable.Iterator
+}
+
+func (i AuctionIterator) Value() (*Auction, error) {
+	var auction Auction
+	err := i.UnmarshalMessage(&auction)
+	return &auction, err
+}
+
+type AuctionIndexKey interface {
+	id() uint32
+	values() []interface{}
+	auctionIndexKey()
+}
+
+// primary key starting index..
+type AuctionPrimaryKey = AuctionIdIndexKey
+
+type AuctionIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x AuctionIdIndexKey) id() uint32            { return 0 }
+func (x AuctionIdIndexKey) values() []interface{} { return x.vs }
+func (x AuctionIdIndexKey) auctionIndexKey()      {}
+
+func (this AuctionIdIndexKey) WithId(id uint64) AuctionIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type AuctionBatchKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x AuctionBatchKeyIndexKey) id() uint32            { return 1 }
+func (x AuctionBatchKeyIndexKey) values() []interface{} { return x.vs }
+func (x AuctionBatchKeyIndexKey) auctionIndexKey()      {}
+
+func (this AuctionBatchKeyIndexKey) WithBatchKey(batch_key uint64) AuctionBatchKeyIndexKey {
+	this.vs = []interface{}{batch_key}
+	return this
+}
+
+type AuctionSellerIndexKey struct {
+	vs []interface{}
+}
+
+func (x AuctionSellerIndexKey) id() uint32            { return 2 }
+func (x AuctionSellerIndexKey) values() []interface{} { return x.vs }
+func (x AuctionSellerIndexKey) auctionIndexKey()      {}
+
+func (this AuctionSellerIndexKey) WithSeller(seller []byte) AuctionSellerIndexKey {
+	this.vs = []interface{}{seller}
+	return this
+}
+
+type AuctionEndTimeIndexKey struct {
+	vs []interface{}
+}
+
+func (x AuctionEndTimeIndexKey) id() uint32            { return 3 }
+func (x AuctionEndTimeIndexKey) values() []interface{} { return x.vs }
+func (x AuctionEndTimeIndexKey) auctionIndexKey()      {}
+
+func (this AuctionEndTimeIndexKey) WithEndTime(end_time *timestamppb.Timestamp) AuctionEndTimeIndexKey {
+	this.vs = []interface{}{end_time}
+	return this
+}
+
+type auctionTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this auctionTable) Insert(ctx context.Context, auction *Auction) error {
+	return this.table.Insert(ctx, auction)
+}
+
+func (this auctionTable) Update(ctx context.Context, auction *Auction) error {
+	return this.table.Update(ctx, auction)
+}
+
+func (this auctionTable) Save(ctx context.Context, auction *Auction) error {
+	return this.table.Save(ctx, auction)
+}
+
+func (this auctionTable) Delete(ctx context.Context, auction *Auction) error {
+	return this.table.Delete(ctx, auction)
+}
+
+func (this auctionTable) InsertReturningID(ctx context.Context, auction *Auction) (uint64, error) {
+	return this.table.InsertReturningID(ctx, auction)
+}
+
+func (this auctionTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this auctionTable) Get(ctx context.Context, id uint64) (*Auction, error) {
+	var auction Auction
+	found, err := this.table.PrimaryKey().Get(ctx, &auction, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &auction, nil
+}
+
+func (this auctionTable) List(ctx context.Context, prefixKey AuctionIndexKey, opts ...ormlist.Option) (AuctionIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return AuctionIterator{it}, err
+}
+
+func (this auctionTable) ListRange(ctx context.Context, from, to AuctionIndexKey, opts ...ormlist.Option) (AuctionIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return AuctionIterator{it}, err
+}
+
+func (this auctionTable) DeleteBy(ctx context.Context, prefixKey AuctionIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this auctionTable) DeleteRange(ctx context.Context, from, to AuctionIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this auctionTable) doNotImplement() {}
+
+var _ AuctionTable = auctionTable{}
+
+func NewAuctionTable(db ormtable.Schema) (AuctionTable, error) {
+	table := db.GetTable(&Auction{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&Auction{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return auctionTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type OfferTable interface {
+	Insert(ctx context.Context, offer *Offer) error
+	InsertReturningID(ctx context.Context, offer *Offer) (uint64, error)
+	Update(ctx context.Context, offer *Offer) error
+	Save(ctx context.Context, offer *Offer) error
+	Delete(ctx context.Context, offer *Offer) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*Offer, error)
+	List(ctx context.Context, prefixKey OfferIndexKey, opts ...ormlist.Option) (OfferIterator, error)
+	ListRange(ctx context.Context, from, to OfferIndexKey, opts ...ormlist.Option) (OfferIterator, error)
+	DeleteBy(ctx context.Context, prefixKey OfferIndexKey) error
+	DeleteRange(ctx context.Context, from, to OfferIndexKey) error
+
+	doNotImplement()
+}
+
+type OfferIterator struct {
+	ormtable.Iterator
+}
+
+func (i OfferIterator) Value() (*Offer, error) {
+	var offer Offer
+	err := i.UnmarshalMessage(&offer)
+	return &offer, err
+}
+
+type OfferIndexKey interface {
+	id() uint32
+	values() []interface{}
+	offerIndexKey()
+}
+
+// primary key starting index..
+type OfferPrimaryKey = OfferIdIndexKey
+
+type OfferIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x OfferIdIndexKey) id() uint32            { return 0 }
+func (x OfferIdIndexKey) values() []interface{} { return x.vs }
+func (x OfferIdIndexKey) offerIndexKey()        {}
+
+func (this OfferIdIndexKey) WithId(id uint64) OfferIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type OfferSellOrderIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x OfferSellOrderIdIndexKey) id() uint32            { return 1 }
+func (x OfferSellOrderIdIndexKey) values() []interface{} { return x.vs }
+func (x OfferSellOrderIdIndexKey) offerIndexKey()        {}
+
+func (this OfferSellOrderIdIndexKey) WithSellOrderId(sell_order_id uint64) OfferSellOrderIdIndexKey {
+	this.vs = []interface{}{sell_order_id}
+	return this
+}
+
+type OfferBuyerIndexKey struct {
+	vs []interface{}
+}
+
+func (x OfferBuyerIndexKey) id() uint32            { return 2 }
+func (x OfferBuyerIndexKey) values() []interface{} { return x.vs }
+func (x OfferBuyerIndexKey) offerIndexKey()        {}
+
+func (this OfferBuyerIndexKey) WithBuyer(buyer []byte) OfferBuyerIndexKey {
+	this.vs = []interface{}{buyer}
+	return this
+}
+
+type OfferExpirationIndexKey struct {
+	vs []interface{}
+}
+
+func (x OfferExpirationIndexKey) id() uint32            { return 3 }
+func (x OfferExpirationIndexKey) values() []interface{} { return x.vs }
+func (x OfferExpirationIndexKey) offerIndexKey()        {}
+
+func (this OfferExpirationIndexKey) WithExpiration(expiration *timestamppb.Timestamp) OfferExpirationIndexKey {
+	this.vs = []interface{}{expiration}
+	return this
+}
+
+type offerTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this offerTable) Insert(ctx context.Context, offer *Offer) error {
+	return this.table.Insert(ctx, offer)
+}
+
+func (this offerTable) Update(ctx context.Context, offer *Offer) error {
+	return this.table.Update(ctx, offer)
+}
+
+func (this offerTable) Save(ctx context.Context, offer *Offer) error {
+	return this.table.Save(ctx, offer)
+}
+
+func (this offerTable) Delete(ctx context.Context, offer *Offer) error {
+	return this.table.Delete(ctx, offer)
+}
+
+func (this offerTable) InsertReturningID(ctx context.Context, offer *Offer) (uint64, error) {
+	return this.table.InsertReturningID(ctx, offer)
+}
+
+func (this offerTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this offerTable) Get(ctx context.Context, id uint64) (*Offer, error) {
+	var offer Offer
+	found, err := this.table.PrimaryKey().Get(ctx, &offer, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &offer, nil
+}
+
+func (this offerTable) List(ctx context.Context, prefixKey OfferIndexKey, opts ...ormlist.Option) (OfferIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return OfferIterator{it}, err
+}
+
+func (this offerTable) ListRange(ctx context.Context, from, to OfferIndexKey, opts ...ormlist.Option) (OfferIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return OfferIterator{it}, err
+}
+
+func (this offerTable) DeleteBy(ctx context.Context, prefixKey OfferIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this offerTable) DeleteRange(ctx context.Context, from, to OfferIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this offerTable) doNotImplement() {}
+
+var _ OfferTable = offerTable{}
+
+func NewOfferTable(db ormtable.Schema) (OfferTable, error) {
+	table := db.GetTable(&Offer{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&Offer{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return offerTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type SwapTable interface {
+	Insert(ctx context.Context, swap *Swap) error
+	InsertReturningID(ctx context.Context, swap *Swap) (uint64, error)
+	Update(ctx context.Context, swap *Swap) error
+	Save(ctx context.Context, swap *Swap) error
+	Delete(ctx context.Context, swap *Swap) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*Swap, error)
+	List(ctx context.Context, prefixKey SwapIndexKey, opts ...ormlist.Option) (SwapIterator, error)
+	ListRange(ctx context.Context, from, to SwapIndexKey, opts ...ormlist.Option) (SwapIterator, error)
+	DeleteBy(ctx context.Context, prefixKey SwapIndexKey) error
+	DeleteRange(ctx context.Context, from, to SwapIndexKey) error
+
+	doNotImplement()
+}
+
+type SwapIterator struct {
+	ormtable.Iterator
+}
+
+func (i SwapIterator) Value() (*Swap, error) {
+	var swap Swap
+	err := i.UnmarshalMessage(&swap)
+	return &swap, err
+}
+
+type SwapIndexKey interface {
+	id() uint32
+	values() []interface{}
+	swapIndexKey()
+}
+
+// primary key starting index..
+type SwapPrimaryKey = SwapIdIndexKey
+
+type SwapIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x SwapIdIndexKey) id() uint32            { return 0 }
+func (x SwapIdIndexKey) values() []interface{} { return x.vs }
+func (x SwapIdIndexKey) swapIndexKey()         {}
+
+func (this SwapIdIndexKey) WithId(id uint64) SwapIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type SwapProposerIndexKey struct {
+	vs []interface{}
+}
+
+func (x SwapProposerIndexKey) id() uint32            { return 1 }
+func (x SwapProposerIndexKey) values() []interface{} { return x.vs }
+func (x SwapProposerIndexKey) swapIndexKey()         {}
+
+func (this SwapProposerIndexKey) WithProposer(proposer []byte) SwapProposerIndexKey {
+	this.vs = []interface{}{proposer}
+	return this
+}
+
+type SwapCounterpartyIndexKey struct {
+	vs []interface{}
+}
+
+func (x SwapCounterpartyIndexKey) id() uint32            { return 2 }
+func (x SwapCounterpartyIndexKey) values() []interface{} { return x.vs }
+func (x SwapCounterpartyIndexKey) swapIndexKey()         {}
+
+func (this SwapCounterpartyIndexKey) WithCounterparty(counterparty []byte) SwapCounterpartyIndexKey {
+	this.vs = []interface{}{counterparty}
+	return this
+}
+
+type SwapExpirationIndexKey struct {
+	vs []interface{}
+}
+
+func (x SwapExpirationIndexKey) id() uint32            { return 3 }
+func (x SwapExpirationIndexKey) values() []interface{} { return x.vs }
+func (x SwapExpirationIndexKey) swapIndexKey()         {}
+
+func (this SwapExpirationIndexKey) WithExpiration(expiration *timestamppb.Timestamp) SwapExpirationIndexKey {
+	this.vs = []interface{}{expiration}
+	return this
+}
+
+type swapTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this swapTable) Insert(ctx context.Context, swap *Swap) error {
+	return this.table.Insert(ctx, swap)
+}
+
+func (this swapTable) Update(ctx context.Context, swap *Swap) error {
+	return this.table.Update(ctx, swap)
+}
+
+func (this swapTable) Save(ctx context.Context, swap *Swap) error {
+	return this.table.Save(ctx, swap)
+}
+
+func (this swapTable) Delete(ctx context.Context, swap *Swap) error {
+	return this.table.Delete(ctx, swap)
+}
+
+func (this swapTable) InsertReturningID(ctx context.Context, swap *Swap) (uint64, error) {
+	return this.table.InsertReturningID(ctx, swap)
+}
+
+func (this swapTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this swapTable) Get(ctx context.Context, id uint64) (*Swap, error) {
+	var swap Swap
+	found, err := this.table.PrimaryKey().Get(ctx, &swap, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &swap, nil
+}
+
+func (this swapTable) List(ctx context.Context, prefixKey SwapIndexKey, opts ...ormlist.Option) (SwapIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return SwapIterator{it}, err
+}
+
+func (this swapTable) ListRange(ctx context.Context, from, to SwapIndexKey, opts ...ormlist.Option) (SwapIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return SwapIterator{it}, err
+}
+
+func (this swapTable) DeleteBy(ctx context.Context, prefixKey SwapIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this swapTable) DeleteRange(ctx context.Context, from, to SwapIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this swapTable) doNotImplement() {}
+
+var _ SwapTable = swapTable{}
+
+func NewSwapTable(db ormtable.Schema) (SwapTable, error) {
+	table := db.GetTable(&Swap{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&Swap{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return swapTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type ForwardContractTable interface {
+	Insert(ctx context.Context, forwardContract *ForwardContract) error
+	InsertReturningID(ctx context.Context, forwardContract *ForwardContract) (uint64, error)
+	Update(ctx context.Context, forwardContract *ForwardContract) error
+	Save(ctx context.Context, forwardContract *ForwardContract) error
+	Delete(ctx context.Context, forwardContract *ForwardContract) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*ForwardContract, error)
+	List(ctx context.Context, prefixKey ForwardContractIndexKey, opts ...ormlist.Option) (ForwardContractIterator, error)
+	ListRange(ctx context.Context, from, to ForwardContractIndexKey, opts ...ormlist.Option) (ForwardContractIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ForwardContractIndexKey) error
+	DeleteRange(ctx context.Context, from, to ForwardContractIndexKey) error
+
+	doNotImplement()
+}
+
+type ForwardContractIterator struct {
+	ormtable.Iterator
+}
+
+func (i ForwardContractIterator) Value() (*ForwardContract, error) {
+	var forwardContract ForwardContract
+	err := i.UnmarshalMessage(&forwardContract)
+	return &forwardContract, err
+}
+
+type ForwardContractIndexKey interface {
+	id() uint32
+	values() []interface{}
+	forwardContractIndexKey()
+}
+
+// primary key starting index..
+type ForwardContractPrimaryKey = ForwardContractIdIndexKey
+
+type ForwardContractIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x ForwardContractIdIndexKey) id() uint32               { return 0 }
+func (x ForwardContractIdIndexKey) values() []interface{}    { return x.vs }
+func (x ForwardContractIdIndexKey) forwardContractIndexKey() {}
+
+func (this ForwardContractIdIndexKey) WithId(id uint64) ForwardContractIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type ForwardContractBuyerIndexKey struct {
+	vs []interface{}
+}
+
+func (x ForwardContractBuyerIndexKey) id() uint32               { return 1 }
+func (x ForwardContractBuyerIndexKey) values() []interface{}    { return x.vs }
+func (x ForwardContractBuyerIndexKey) forwardContractIndexKey() {}
+
+func (this ForwardContractBuyerIndexKey) WithBuyer(buyer []byte) ForwardContractBuyerIndexKey {
+	this.vs = []interface{}{buyer}
+	return this
+}
+
+type ForwardContractProjectKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x ForwardContractProjectKeyIndexKey) id() uint32               { return 2 }
+func (x ForwardContractProjectKeyIndexKey) values() []interface{}    { return x.vs }
+func (x ForwardContractProjectKeyIndexKey) forwardContractIndexKey() {}
+
+func (this ForwardContractProjectKeyIndexKey) WithProjectKey(project_key uint64) ForwardContractProjectKeyIndexKey {
+	this.vs = []interface{}{project_key}
+	return this
+}
+
+type ForwardContractDeadlineIndexKey struct {
+	vs []interface{}
+}
+
+func (x ForwardContractDeadlineIndexKey) id() uint32               { return 3 }
+func (x ForwardContractDeadlineIndexKey) values() []interface{}    { return x.vs }
+func (x ForwardContractDeadlineIndexKey) forwardContractIndexKey() {}
+
+func (this ForwardContractDeadlineIndexKey) WithDeadline(deadline *timestamppb.Timestamp) ForwardContractDeadlineIndexKey {
+	this.vs = []interface{}{deadline}
+	return this
+}
+
+type forwardContractTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this forwardContractTable) Insert(ctx context.Context, forwardContract *ForwardContract) error {
+	return this.table.Insert(ctx, forwardContract)
+}
+
+func (this forwardContractTable) Update(ctx context.Context, forwardContract *ForwardContract) error {
+	return this.table.Update(ctx, forwardContract)
+}
+
+func (this forwardContractTable) Save(ctx context.Context, forwardContract *ForwardContract) error {
+	return this.table.Save(ctx, forwardContract)
+}
+
+func (this forwardContractTable) Delete(ctx context.Context, forwardContract *ForwardContract) error {
+	return this.table.Delete(ctx, forwardContract)
+}
+
+func (this forwardContractTable) InsertReturningID(ctx context.Context, forwardContract *ForwardContract) (uint64, error) {
+	return this.table.InsertReturningID(ctx, forwardContract)
+}
+
+func (this forwardContractTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this forwardContractTable) Get(ctx context.Context, id uint64) (*ForwardContract, error) {
+	var forwardContract ForwardContract
+	found, err := this.table.PrimaryKey().Get(ctx, &forwardContract, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &forwardContract, nil
+}
+
+func (this forwardContractTable) List(ctx context.Context, prefixKey ForwardContractIndexKey, opts ...ormlist.Option) (ForwardContractIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ForwardContractIterator{it}, err
+}
+
+func (this forwardContractTable) ListRange(ctx context.Context, from, to ForwardContractIndexKey, opts ...ormlist.Option) (ForwardContractIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ForwardContractIterator{it}, err
+}
+
+func (this forwardContractTable) DeleteBy(ctx context.Context, prefixKey ForwardContractIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this forwardContractTable) DeleteRange(ctx context.Context, from, to ForwardContractIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this forwardContractTable) doNotImplement() {}
+
+var _ ForwardContractTable = forwardContractTable{}
+
+func NewForwardContractTable(db ormtable.Schema) (ForwardContractTable, error) {
+	table := db.GetTable(&ForwardContract{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&ForwardContract{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return forwardContractTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type SellOrderAskTable interface {
+	Insert(ctx context.Context, sellOrderAsk *SellOrderAsk) error
+	Update(ctx context.Context, sellOrderAsk *SellOrderAsk) error
+	Save(ctx context.Context, sellOrderAsk *SellOrderAsk) error
+	Delete(ctx context.Context, sellOrderAsk *SellOrderAsk) error
+	Has(ctx context.Context, sell_order_id uint64, market_id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, sell_order_id uint64, market_id uint64) (*SellOrderAsk, error)
+	List(ctx context.Context, prefixKey SellOrderAskIndexKey, opts ...ormlist.Option) (SellOrderAskIterator, error)
+	ListRange(ctx context.Context, from, to SellOrderAskIndexKey, opts ...ormlist.Option) (SellOrderAskIterator, error)
+	DeleteBy(ctx context.Context, prefixKey SellOrderAskIndexKey) error
+	DeleteRange(ctx context.Context, from, to SellOrderAskIndexKey) error
+
+	doNotImplement()
+}
+
+type SellOrderAskIterator struct {
+	ormtable.Iterator
+}
+
+func (i SellOrderAskIterator) Value() (*SellOrderAsk, error) {
+	var sellOrderAsk SellOrderAsk
+	err := i.UnmarshalMessage(&sellOrderAsk)
+	return &sellOrderAsk, err
+}
+
+type SellOrderAskIndexKey interface {
+	id() uint32
+	values() []interface{}
+	sellOrderAskIndexKey()
+}
+
+// primary key starting index..
+type SellOrderAskPrimaryKey = SellOrderAskSellOrderIdMarketIdIndexKey
+
+type SellOrderAskSellOrderIdMarketIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x SellOrderAskSellOrderIdMarketIdIndexKey) id() uint32            { return 0 }
+func (x SellOrderAskSellOrderIdMarketIdIndexKey) values() []interface{} { return x.vs }
+func (x SellOrderAskSellOrderIdMarketIdIndexKey) sellOrderAskIndexKey() {}
+
+func (this SellOrderAskSellOrderIdMarketIdIndexKey) WithSellOrderId(sell_order_id uint64) SellOrderAskSellOrderIdMarketIdIndexKey {
+	this.vs = []interface{}{sell_order_id}
+	return this
+}
+
+func (this SellOrderAskSellOrderIdMarketIdIndexKey) WithSellOrderIdMarketId(sell_order_id uint64, market_id uint64) SellOrderAskSellOrderIdMarketIdIndexKey {
+	this.vs = []interface{}{sell_order_id, market_id}
+	return this
+}
+
+type sellOrderAskTable struct {
+	table ormtable.Table
+}
+
+func (this sellOrderAskTable) Insert(ctx context.Context, sellOrderAsk *SellOrderAsk) error {
+	return this.table.Insert(ctx, sellOrderAsk)
+}
+
+func (this sellOrderAskTable) Update(ctx context.Context, sellOrderAsk *SellOrderAsk) error {
+	return this.table.Update(ctx, sellOrderAsk)
+}
+
+func (this sellOrderAskTable) Save(ctx context.Context, sellOrderAsk *SellOrderAsk) error {
+	return this.table.Save(ctx, sellOrderAsk)
+}
+
+func (this sellOrderAskTable) Delete(ctx context.Context, sellOrderAsk *SellOrderAsk) error {
+	return this.table.Delete(ctx, sellOrderAsk)
+}
+
+func (this sellOrderAskTable) Has(ctx context.Context, sell_order_id uint64, market_id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, sell_order_id, market_id)
+}
+
+func (this sellOrderAskTable) Get(ctx context.Context, sell_order_id uint64, market_id uint64) (*SellOrderAsk, error) {
+	var sellOrderAsk SellOrderAsk
+	found, err := this.table.PrimaryKey().Get(ctx, &sellOrderAsk, sell_order_id, market_id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &sellOrderAsk, nil
+}
+
+func (this sellOrderAskTable) List(ctx context.Context, prefixKey SellOrderAskIndexKey, opts ...ormlist.Option) (SellOrderAskIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return SellOrderAskIterator{it}, err
+}
+
+func (this sellOrderAskTable) ListRange(ctx context.Context, from, to SellOrderAskIndexKey, opts ...ormlist.Option) (SellOrderAskIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return SellOrderAskIterator{it}, err
+}
+
+func (this sellOrderAskTable) DeleteBy(ctx context.Context, prefixKey SellOrderAskIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this sellOrderAskTable) DeleteRange(ctx context.Context, from, to SellOrderAskIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this sellOrderAskTable) doNotImplement() {}
+
+var _ SellOrderAskTable = sellOrderAskTable{}
+
+func NewSellOrderAskTable(db ormtable.Schema) (SellOrderAskTable, error) {
+	table := db.GetTable(&SellOrderAsk{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&SellOrderAsk{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return sellOrderAskTable{table}, nil
+}
+
+// singleton store
+type SellOrderPruneCursorTable interface {
+	Get(ctx context.Context) (*SellOrderPruneCursor, error)
+	Save(ctx context.Context, sellOrderPruneCursor *SellOrderPruneCursor) error
+}
+
+type sellOrderPruneCursorTable struct {
+	table ormtable.Table
+}
+
+var _ SellOrderPruneCursorTable = sellOrderPruneCursorTable{}
+
+func (x sellOrderPruneCursorTable) Get(ctx context.Context) (*SellOrderPruneCursor, error) {
+	sellOrderPruneCursor := &SellOrderPruneCursor{}
+	_, err := x.table.Get(ctx, sellOrderPruneCursor)
+	return sellOrderPruneCursor, err
+}
+
+func (x sellOrderPruneCursorTable) Save(ctx context.Context, sellOrderPruneCursor *SellOrderPruneCursor) error {
+	return x.table.Save(ctx, sellOrderPruneCursor)
+}
+
+func NewSellOrderPruneCursorTable(db ormtable.Schema) (SellOrderPruneCursorTable, error) {
+	table := db.GetTable(&SellOrderPruneCursor{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&SellOrderPruneCursor{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return &sellOrderPruneCursorTable{table}, nil
+}
+
+// singleton store
+type AuctionSettleCursorTable interface {
+	Get(ctx context.Context) (*AuctionSettleCursor, error)
+	Save(ctx context.Context, auctionSettleCursor *AuctionSettleCursor) error
+}
+
+type auctionSettleCursorTable struct {
+	table ormtable.Table
+}
+
+var _ AuctionSettleCursorTable = auctionSettleCursorTable{}
+
+func (x auctionSettleCursorTable) Get(ctx context.Context) (*AuctionSettleCursor, error) {
+	auctionSettleCursor := &AuctionSettleCursor{}
+	_, err := x.table.Get(ctx, auctionSettleCursor)
+	return auctionSettleCursor, err
+}
+
+func (x auctionSettleCursorTable) Save(ctx context.Context, auctionSettleCursor *AuctionSettleCursor) error {
+	return x.table.Save(ctx, auctionSettleCursor)
+}
+
+func NewAuctionSettleCursorTable(db ormtable.Schema) (AuctionSettleCursorTable, error) {
+	table := db.GetTable(&AuctionSettleCursor{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&AuctionSettleCursor{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return &auctionSettleCursorTable{table}, nil
+}
+
+// singleton store
+type OfferPruneCursorTable interface {
+	Get(ctx context.Context) (*OfferPruneCursor, error)
+	Save(ctx context.Context, offerPruneCursor *OfferPruneCursor) error
+}
+
+type offerPruneCursorTable struct {
+	table ormtable.Table
+}
+
+var _ OfferPruneCursorTable = offerPruneCursorTable{}
+
+func (x offerPruneCursorTable) Get(ctx context.Context) (*OfferPruneCursor, error) {
+	offerPruneCursor := &OfferPruneCursor{}
+	_, err := x.table.Get(ctx, offerPruneCursor)
+	return offerPruneCursor, err
+}
+
+func (x offerPruneCursorTable) Save(ctx context.Context, offerPruneCursor *OfferPruneCursor) error {
+	return x.table.Save(ctx, offerPruneCursor)
+}
+
+func NewOfferPruneCursorTable(db ormtable.Schema) (OfferPruneCursorTable, error) {
+	table := db.GetTable(&OfferPruneCursor{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&OfferPruneCursor{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return &offerPruneCursorTable{table}, nil
+}
+
+// singleton store
+type SwapPruneCursorTable interface {
+	Get(ctx context.Context) (*SwapPruneCursor, error)
+	Save(ctx context.Context, swapPruneCursor *SwapPruneCursor) error
+}
+
+type swapPruneCursorTable struct {
+	table ormtable.Table
+}
+
+var _ SwapPruneCursorTable = swapPruneCursorTable{}
+
+func (x swapPruneCursorTable) Get(ctx context.Context) (*SwapPruneCursor, error) {
+	swapPruneCursor := &SwapPruneCursor{}
+	_, err := x.table.Get(ctx, swapPruneCursor)
+	return swapPruneCursor, err
+}
+
+func (x swapPruneCursorTable) Save(ctx context.Context, swapPruneCursor *SwapPruneCursor) error {
+	return x.table.Save(ctx, swapPruneCursor)
+}
+
+func NewSwapPruneCursorTable(db ormtable.Schema) (SwapPruneCursorTable, error) {
+	table := db.GetTable(&SwapPruneCursor{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&SwapPruneCursor{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return &swapPruneCursorTable{table}, nil
+}
+
+// singleton store
+type ForwardContractPruneCursorTable interface {
+	Get(ctx context.Context) (*ForwardContractPruneCursor, error)
+	Save(ctx context.Context, forwardContractPruneCursor *ForwardContractPruneCursor) error
+}
+
+type forwardContractPruneCursorTable struct {
+	table ormtable.Table
+}
+
+var _ ForwardContractPruneCursorTable = forwardContractPruneCursorTable{}
+
+func (x forwardContractPruneCursorTable) Get(ctx context.Context) (*ForwardContractPruneCursor, error) {
+	forwardContractPruneCursor := &ForwardContractPruneCursor{}
+	_, err := x.table.Get(ctx, forwardContractPruneCursor)
+	return forwardContractPruneCursor, err
+}
+
+func (x forwardContractPruneCursorTable) Save(ctx context.Context, forwardContractPruneCursor *ForwardContractPruneCursor) error {
+	return x.table.Save(ctx, forwardContractPruneCursor)
+}
+
+func NewForwardContractPruneCursorTable(db ormtable.Schema) (ForwardContractPruneCursorTable, error) {
+	table := db.GetTable(&ForwardContractPruneCursor{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&ForwardContractPruneCursor{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return &forwardContractPruneCursorTable{table}, nil
+}
+
+// singleton store
+type BuyOrderPruneCursorTable interface {
+	Get(ctx context.Context) (*BuyOrderPruneCursor, error)
+	Save(ctx context.Context, buyOrderPruneCursor *BuyOrderPruneCursor) error
+}
+
+type buyOrderPruneCursorTable struct {
+	table ormtable.Table
+}
+
+var _ BuyOrderPruneCursorTable = buyOrderPruneCursorTable{}
+
+func (x buyOrderPruneCursorTable) Get(ctx context.Context) (*BuyOrderPruneCursor, error) {
+	buyOrderPruneCursor := &BuyOrderPruneCursor{}
+	_, err := x.table.Get(ctx, buyOrderPruneCursor)
+	return buyOrderPruneCursor, err
+}
+
+func (x buyOrderPruneCursorTable) Save(ctx context.Context, buyOrderPruneCursor *BuyOrderPruneCursor) error {
+	return x.table.Save(ctx, buyOrderPruneCursor)
+}
+
+func NewBuyOrderPruneCursorTable(db ormtable.Schema) (BuyOrderPruneCursorTable, error) {
+	table := db.GetTable(&BuyOrderPruneCursor{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&BuyOrderPruneCursor{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return &buyOrderPruneCursorTable{table}, nil
+}
+
+type StateStore interface {
+	SellOrderTable() SellOrderTable
+	BuyOrderTable() BuyOrderTable
+	AllowedDenomTable() AllowedDenomTable
+	MarketTable() MarketTable
+	TradingFeeTable() TradingFeeTable
+	ClassRoyaltyTable() ClassRoyaltyTable
+	TradeTable() TradeTable
+	AuctionTable() AuctionTable
+	OfferTable() OfferTable
+	SwapTable() SwapTable
+	ForwardContractTable() ForwardContractTable
+	SellOrderAskTable() SellOrderAskTable
+	SellOrderPruneCursorTable() SellOrderPruneCursorTable
+	AuctionSettleCursorTable() AuctionSettleCursorTable
+	OfferPruneCursorTable() OfferPruneCursorTable
+	SwapPruneCursorTable() SwapPruneCursorTable
+	ForwardContractPruneCursorTable() ForwardContractPruneCursorTable
+	BuyOrderPruneCursorTable() BuyOrderPruneCursorTable
+
+	doNotImplement()
+}
+
+type stateStore struct {
+	sellOrder                  SellOrderTable
+	buyOrder                   BuyOrderTable
+	allowedDenom               AllowedDenomTable
+	market                     MarketTable
+	tradingFee                 TradingFeeTable
+	classRoyalty               ClassRoyaltyTable
+	trade                      TradeTable
+	auction                    AuctionTable
+	offer                      OfferTable
+	swap                       SwapTable
+	forwardContract            ForwardContractTable
+	sellOrderAsk               SellOrderAskTable
+	sellOrderPruneCursor       SellOrderPruneCursorTable
+	auctionSettleCursor        AuctionSettleCursorTable
+	offerPruneCursor           OfferPruneCursorTable
+	swapPruneCursor            SwapPruneCursorTable
+	forwardContractPruneCursor ForwardContractPruneCursorTable
+	buyOrderPruneCursor        BuyOrderPruneCursorTable
+}
+
+func (x stateStore) SellOrderTable() SellOrderTable {
+	return x.sellOrder
+}
+
+func (x stateStore) BuyOrderTable() BuyOrderTable {
+	return x.buyOrder
+}
+
+func (x stateStore) AllowedDenomTable() AllowedDenomTable {
+	return x.allowedDenom
+}
+
+func (x stateStore) MarketTable() MarketTable {
+	return x.market
+}
+
+func (x stateStore) TradingFeeTable() TradingFeeTable {
+	return x.tradingFee
+}
+
+func (x stateStore) ClassRoyaltyTable() ClassRoyaltyTable {
+	return x.classRoyalty
+}
+
+func (x stateStore) TradeTable() TradeTable {
+	return x.trade
+}
+
+func (x stateStore) AuctionTable() AuctionTable {
+	return x.auction
+}
+
+func (x stateStore) OfferTable() OfferTable {
+	return x.offer
+}
+
+func (x stateStore) SwapTable() SwapTable {
+	return x.swap
+}
+
+func (x stateStore) ForwardContractTable() ForwardContractTable {
+	return x.forwardContract
+}
+
+func (x stateStore) SellOrderAskTable() SellOrderAskTable {
+	return x.sellOrderAsk
+}
+
+func (x stateStore) SellOrderPruneCursorTable() SellOrderPruneCursorTable {
+	return x.sellOrderPruneCursor
+}
+
+func (x stateStore) AuctionSettleCursorTable() AuctionSettleCursorTable {
+	return x.auctionSettleCursor
+}
+
+func (x stateStore) OfferPruneCursorTable() OfferPruneCursorTable {
+	return x.offerPruneCursor
+}
+
+func (x stateStore) SwapPruneCursorTable() SwapPruneCursorTable {
+	return x.swapPruneCursor
+}
+
+func (x stateStore) ForwardContractPruneCursorTable() ForwardContractPruneCursorTable {
+	return x.forwardContractPruneCursor
+}
+
+func (x stateStore) BuyOrderPruneCursorTable() BuyOrderPruneCursorTable {
+	return x.buyOrderPruneCursor
+}
+
+func (stateStore) doNotImplement() {}
+
+var _ StateStore = stateStore{}
+
+func NewStateStore(db ormtable.Schema) (StateStore, error) {
+	sellOrderTable, err := NewSellOrderTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	buyOrderTable, err := NewBuyOrderTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	allowedDenomTable, err := NewAllowedDenomTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	marketTable, err := NewMarketTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	tradingFeeTable, err := NewTradingFeeTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	classRoyaltyTable, err := NewClassRoyaltyTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	tradeTable, err := NewTradeTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	auctionTable, err := NewAuctionTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	offerTable, err := NewOfferTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	swapTable, err := NewSwapTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	forwardContractTable, err := NewForwardContractTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	sellOrderAskTable, err := NewSellOrderAskTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	sellOrderPruneCursorTable, err := NewSellOrderPruneCursorTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	auctionSettleCursorTable, err := NewAuctionSettleCursorTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	offerPruneCursorTable, err := NewOfferPruneCursorTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	swapPruneCursorTable, err := NewSwapPruneCursorTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	forwardContractPruneCursorTable, err := NewForwardContractPruneCursorTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	buyOrderPruneCursorTable, err := NewBuyOrderPruneCursorTable(db)
 	if err != nil {
 		return nil, err
 	}
 
 	return stateStore{
 		sellOrderTable,
+		buyOrderTable,
 		allowedDenomTable,
 		marketTable,
+		tradingFeeTable,
+		classRoyaltyTable,
+		tradeTable,
+		auctionTable,
+		offerTable,
+		swapTable,
+		forwardContractTable,
+		sellOrderAskTable,
+		sellOrderPruneCursorTable,
+		auctionSettleCursorTable,
+		offerPruneCursorTable,
+		swapPruneCursorTable,
+		forwardContractPruneCursorTable,
+		buyOrderPruneCursorTable,
 	}, nil
 }
@@ -4,6 +4,7 @@ package marketplacev1
 import (
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	v1beta1 "github.com/cosmos/cosmos-sdk/api/cosmos/base/v1beta1"
 	_ "github.com/cosmos/cosmos-sdk/api/cosmos/orm/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
@@ -14,6 +15,52 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_SellOrder_11_list)(nil)
+
+type _SellOrder_11_list struct {
+	list *[][]byte
+}
+
+func (x *_SellOrder_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SellOrder_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
+}
+
+func (x *_SellOrder_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SellOrder_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SellOrder_11_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message SellOrder at list field AllowedBuyers as it is not of Message kind"))
+}
+
+func (x *_SellOrder_11_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SellOrder_11_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_SellOrder_11_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_SellOrder                     protoreflect.MessageDescriptor
 	fd_SellOrder_id                  protoreflect.FieldDescriptor
@@ -25,6 +72,8 @@ var (
 	fd_SellOrder_disable_auto_retire protoreflect.FieldDescriptor
 	fd_SellOrder_expiration          protoreflect.FieldDescriptor
 	fd_SellOrder_maker               protoreflect.FieldDescriptor
+	fd_SellOrder_allowed_buyers      protoreflect.FieldDescriptor
+	fd_SellOrder_sortable_ask_amount protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -39,6 +88,8 @@ func init() {
 	fd_SellOrder_disable_auto_retire = md_SellOrder.Fields().ByName("disable_auto_retire")
 	fd_SellOrder_expiration = md_SellOrder.Fields().ByName("expiration")
 	fd_SellOrder_maker = md_SellOrder.Fields().ByName("maker")
+	fd_SellOrder_allowed_buyers = md_SellOrder.Fields().ByName("allowed_buyers")
+	fd_SellOrder_sortable_ask_amount = md_SellOrder.Fields().ByName("sortable_ask_amount")
 }
 
 var _ protoreflect.Message = (*fastReflection_SellOrder)(nil)
@@ -160,6 +211,18 @@ func (x *fastReflection_SellOrder) Range(f func(protoreflect.FieldDescriptor, pr
 			return
 		}
 	}
+	if len(x.AllowedBuyers) != 0 {
+		value := protoreflect.ValueOfList(&_SellOrder_11_list{list: &x.AllowedBuyers})
+		if !f(fd_SellOrder_allowed_buyers, value) {
+			return
+		}
+	}
+	if x.SortableAskAmount != "" {
+		value := protoreflect.ValueOfString(x.SortableAskAmount)
+		if !f(fd_SellOrder_sortable_ask_amount, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -193,6 +256,10 @@ func (x *fastReflection_SellOrder) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Expiration != nil
 	case "regen.ecocredit.marketplace.v1.SellOrder.maker":
 		return x.Maker != false
+	case "regen.ecocredit.marketplace.v1.SellOrder.allowed_buyers":
+		return len(x.AllowedBuyers) != 0
+	case "regen.ecocredit.marketplace.v1.SellOrder.sortable_ask_amount":
+		return x.SortableAskAmount != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrder"))
@@ -227,6 +294,10 @@ func (x *fastReflection_SellOrder) Clear(fd protoreflect.FieldDescriptor) {
 		x.Expiration = nil
 	case "regen.ecocredit.marketplace.v1.SellOrder.maker":
 		x.Maker = false
+	case "regen.ecocredit.marketplace.v1.SellOrder.allowed_buyers":
+		x.AllowedBuyers = nil
+	case "regen.ecocredit.marketplace.v1.SellOrder.sortable_ask_amount":
+		x.SortableAskAmount = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrder"))
@@ -270,6 +341,15 @@ func (x *fastReflection_SellOrder) Get(descriptor protoreflect.FieldDescriptor)
 	case "regen.ecocredit.marketplace.v1.SellOrder.maker":
 		value := x.Maker
 		return protoreflect.ValueOfBool(value)
+	case "regen.ecocredit.marketplace.v1.SellOrder.allowed_buyers":
+		if len(x.AllowedBuyers) == 0 {
+			return protoreflect.ValueOfList(&_SellOrder_11_list{})
+		}
+		listValue := &_SellOrder_11_list{list: &x.AllowedBuyers}
+		return protoreflect.ValueOfList(listValue)
+	case "regen.ecocredit.marketplace.v1.SellOrder.sortable_ask_amount":
+		value := x.SortableAskAmount
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrder"))
@@ -308,6 +388,12 @@ func (x *fastReflection_SellOrder) Set(fd protoreflect.FieldDescriptor, value pr
 		x.Expiration = value.Message().Interface().(*timestamppb.Timestamp)
 	case "regen.ecocredit.marketplace.v1.SellOrder.maker":
 		x.Maker = value.Bool()
+	case "regen.ecocredit.marketplace.v1.SellOrder.allowed_buyers":
+		lv := value.List()
+		clv := lv.(*_SellOrder_11_list)
+		x.AllowedBuyers = *clv.list
+	case "regen.ecocredit.marketplace.v1.SellOrder.sortable_ask_amount":
+		x.SortableAskAmount = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrder"))
@@ -333,6 +419,12 @@ func (x *fastReflection_SellOrder) Mutable(fd protoreflect.FieldDescriptor) prot
 			x.Expiration = new(timestamppb.Timestamp)
 		}
 		return protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.SellOrder.allowed_buyers":
+		if x.AllowedBuyers == nil {
+			x.AllowedBuyers = [][]byte{}
+		}
+		value := &_SellOrder_11_list{list: &x.AllowedBuyers}
+		return protoreflect.ValueOfList(value)
 	case "regen.ecocredit.marketplace.v1.SellOrder.id":
 		panic(fmt.Errorf("field id of message regen.ecocredit.marketplace.v1.SellOrder is not mutable"))
 	case "regen.ecocredit.marketplace.v1.SellOrder.seller":
@@ -349,6 +441,8 @@ func (x *fastReflection_SellOrder) Mutable(fd protoreflect.FieldDescriptor) prot
 		panic(fmt.Errorf("field disable_auto_retire of message regen.ecocredit.marketplace.v1.SellOrder is not mutable"))
 	case "regen.ecocredit.marketplace.v1.SellOrder.maker":
 		panic(fmt.Errorf("field maker of message regen.ecocredit.marketplace.v1.SellOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.SellOrder.sortable_ask_amount":
+		panic(fmt.Errorf("field sortable_ask_amount of message regen.ecocredit.marketplace.v1.SellOrder is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrder"))
@@ -381,6 +475,11 @@ func (x *fastReflection_SellOrder) NewField(fd protoreflect.FieldDescriptor) pro
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "regen.ecocredit.marketplace.v1.SellOrder.maker":
 		return protoreflect.ValueOfBool(false)
+	case "regen.ecocredit.marketplace.v1.SellOrder.allowed_buyers":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_SellOrder_11_list{list: &list})
+	case "regen.ecocredit.marketplace.v1.SellOrder.sortable_ask_amount":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrder"))
@@ -481,6 +580,16 @@ func (x *fastReflection_SellOrder) ProtoMethods() *protoiface.Methods {
 		if x.Maker {
 			n += 2
 		}
+		if len(x.AllowedBuyers) > 0 {
+			for _, b := range x.AllowedBuyers {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.SortableAskAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -510,6 +619,22 @@ func (x *fastReflection_SellOrder) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.SortableAskAmount) > 0 {
+			i -= len(x.SortableAskAmount)
+			copy(dAtA[i:], x.SortableAskAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SortableAskAmount)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.AllowedBuyers) > 0 {
+			for iNdEx := len(x.AllowedBuyers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedBuyers[iNdEx])
+				copy(dAtA[i:], x.AllowedBuyers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedBuyers[iNdEx])))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
 		if x.Maker {
 			i--
 			if x.Maker {
@@ -860,6 +985,70 @@ func (x *fastReflection_SellOrder) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.Maker = bool(v != 0)
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedBuyers", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedBuyers = append(x.AllowedBuyers, make([]byte, postIndex-iNdEx))
+				copy(x.AllowedBuyers[len(x.AllowedBuyers)-1], dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SortableAskAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SortableAskAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -896,29 +1085,59 @@ func (x *fastReflection_SellOrder) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_AllowedDenom               protoreflect.MessageDescriptor
-	fd_AllowedDenom_bank_denom    protoreflect.FieldDescriptor
-	fd_AllowedDenom_display_denom protoreflect.FieldDescriptor
-	fd_AllowedDenom_exponent      protoreflect.FieldDescriptor
+	md_BuyOrder                         protoreflect.MessageDescriptor
+	fd_BuyOrder_id                      protoreflect.FieldDescriptor
+	fd_BuyOrder_buyer                   protoreflect.FieldDescriptor
+	fd_BuyOrder_batch_key               protoreflect.FieldDescriptor
+	fd_BuyOrder_quantity                protoreflect.FieldDescriptor
+	fd_BuyOrder_market_id               protoreflect.FieldDescriptor
+	fd_BuyOrder_bid_amount              protoreflect.FieldDescriptor
+	fd_BuyOrder_disable_auto_retire     protoreflect.FieldDescriptor
+	fd_BuyOrder_retirement_jurisdiction protoreflect.FieldDescriptor
+	fd_BuyOrder_expiration              protoreflect.FieldDescriptor
+	fd_BuyOrder_maker                   protoreflect.FieldDescriptor
+	fd_BuyOrder_class_key               protoreflect.FieldDescriptor
+	fd_BuyOrder_project_key             protoreflect.FieldDescriptor
+	fd_BuyOrder_project_location        protoreflect.FieldDescriptor
+	fd_BuyOrder_min_start_date          protoreflect.FieldDescriptor
+	fd_BuyOrder_max_end_date            protoreflect.FieldDescriptor
+	fd_BuyOrder_retirement_reason       protoreflect.FieldDescriptor
+	fd_BuyOrder_retirement_beneficiary  protoreflect.FieldDescriptor
+	fd_BuyOrder_escrowed_fee_bps        protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_regen_ecocredit_marketplace_v1_state_proto_init()
-	md_AllowedDenom = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("AllowedDenom")
-	fd_AllowedDenom_bank_denom = md_AllowedDenom.Fields().ByName("bank_denom")
-	fd_AllowedDenom_display_denom = md_AllowedDenom.Fields().ByName("display_denom")
-	fd_AllowedDenom_exponent = md_AllowedDenom.Fields().ByName("exponent")
+	md_BuyOrder = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("BuyOrder")
+	fd_BuyOrder_id = md_BuyOrder.Fields().ByName("id")
+	fd_BuyOrder_buyer = md_BuyOrder.Fields().ByName("buyer")
+	fd_BuyOrder_batch_key = md_BuyOrder.Fields().ByName("batch_key")
+	fd_BuyOrder_quantity = md_BuyOrder.Fields().ByName("quantity")
+	fd_BuyOrder_market_id = md_BuyOrder.Fields().ByName("market_id")
+	fd_BuyOrder_bid_amount = md_BuyOrder.Fields().ByName("bid_amount")
+	fd_BuyOrder_disable_auto_retire = md_BuyOrder.Fields().ByName("disable_auto_retire")
+	fd_BuyOrder_retirement_jurisdiction = md_BuyOrder.Fields().ByName("retirement_jurisdiction")
+	fd_BuyOrder_expiration = md_BuyOrder.Fields().ByName("expiration")
+	fd_BuyOrder_maker = md_BuyOrder.Fields().ByName("maker")
+	fd_BuyOrder_class_key = md_BuyOrder.Fields().ByName("class_key")
+	fd_BuyOrder_project_key = md_BuyOrder.Fields().ByName("project_key")
+	fd_BuyOrder_project_location = md_BuyOrder.Fields().ByName("project_location")
+	fd_BuyOrder_min_start_date = md_BuyOrder.Fields().ByName("min_start_date")
+	fd_BuyOrder_max_end_date = md_BuyOrder.Fields().ByName("max_end_date")
+	fd_BuyOrder_retirement_reason = md_BuyOrder.Fields().ByName("retirement_reason")
+	fd_BuyOrder_retirement_beneficiary = md_BuyOrder.Fields().ByName("retirement_beneficiary")
+	fd_BuyOrder_escrowed_fee_bps = md_BuyOrder.Fields().ByName("escrowed_fee_bps")
 }
 
-var _ protoreflect.Message = (*fastReflection_AllowedDenom)(nil)
+var _ protoreflect.Message = (*fastReflection_BuyOrder)(nil)
 
-type fastReflection_AllowedDenom AllowedDenom
+type fastReflection_BuyOrder BuyOrder
 
-func (x *AllowedDenom) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_AllowedDenom)(x)
+func (x *BuyOrder) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BuyOrder)(x)
 }
 
-func (x *AllowedDenom) slowProtoReflect() protoreflect.Message {
+func (x *BuyOrder) slowProtoReflect() protoreflect.Message {
 	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -930,43 +1149,43 @@ func (x *AllowedDenom) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_AllowedDenom_messageType fastReflection_AllowedDenom_messageType
-var _ protoreflect.MessageType = fastReflection_AllowedDenom_messageType{}
+var _fastReflection_BuyOrder_messageType fastReflection_BuyOrder_messageType
+var _ protoreflect.MessageType = fastReflection_BuyOrder_messageType{}
 
-type fastReflection_AllowedDenom_messageType struct{}
+type fastReflection_BuyOrder_messageType struct{}
 
-func (x fastReflection_AllowedDenom_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_AllowedDenom)(nil)
+func (x fastReflection_BuyOrder_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BuyOrder)(nil)
 }
-func (x fastReflection_AllowedDenom_messageType) New() protoreflect.Message {
-	return new(fastReflection_AllowedDenom)
+func (x fastReflection_BuyOrder_messageType) New() protoreflect.Message {
+	return new(fastReflection_BuyOrder)
 }
-func (x fastReflection_AllowedDenom_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_AllowedDenom
+func (x fastReflection_BuyOrder_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BuyOrder
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_AllowedDenom) Descriptor() protoreflect.MessageDescriptor {
-	return md_AllowedDenom
+func (x *fastReflection_BuyOrder) Descriptor() protoreflect.MessageDescriptor {
+	return md_BuyOrder
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_AllowedDenom) Type() protoreflect.MessageType {
-	return _fastReflection_AllowedDenom_messageType
+func (x *fastReflection_BuyOrder) Type() protoreflect.MessageType {
+	return _fastReflection_BuyOrder_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_AllowedDenom) New() protoreflect.Message {
-	return new(fastReflection_AllowedDenom)
+func (x *fastReflection_BuyOrder) New() protoreflect.Message {
+	return new(fastReflection_BuyOrder)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_AllowedDenom) Interface() protoreflect.ProtoMessage {
-	return (*AllowedDenom)(x)
+func (x *fastReflection_BuyOrder) Interface() protoreflect.ProtoMessage {
+	return (*BuyOrder)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -974,22 +1193,112 @@ func (x *fastReflection_AllowedDenom) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_AllowedDenom) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.BankDenom != "" {
-		value := protoreflect.ValueOfString(x.BankDenom)
-		if !f(fd_AllowedDenom_bank_denom, value) {
+func (x *fastReflection_BuyOrder) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_BuyOrder_id, value) {
 			return
 		}
 	}
-	if x.DisplayDenom != "" {
-		value := protoreflect.ValueOfString(x.DisplayDenom)
-		if !f(fd_AllowedDenom_display_denom, value) {
+	if len(x.Buyer) != 0 {
+		value := protoreflect.ValueOfBytes(x.Buyer)
+		if !f(fd_BuyOrder_buyer, value) {
 			return
 		}
 	}
-	if x.Exponent != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.Exponent)
-		if !f(fd_AllowedDenom_exponent, value) {
+	if x.BatchKey != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BatchKey)
+		if !f(fd_BuyOrder_batch_key, value) {
+			return
+		}
+	}
+	if x.Quantity != "" {
+		value := protoreflect.ValueOfString(x.Quantity)
+		if !f(fd_BuyOrder_quantity, value) {
+			return
+		}
+	}
+	if x.MarketId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MarketId)
+		if !f(fd_BuyOrder_market_id, value) {
+			return
+		}
+	}
+	if x.BidAmount != "" {
+		value := protoreflect.ValueOfString(x.BidAmount)
+		if !f(fd_BuyOrder_bid_amount, value) {
+			return
+		}
+	}
+	if x.DisableAutoRetire != false {
+		value := protoreflect.ValueOfBool(x.DisableAutoRetire)
+		if !f(fd_BuyOrder_disable_auto_retire, value) {
+			return
+		}
+	}
+	if x.RetirementJurisdiction != "" {
+		value := protoreflect.ValueOfString(x.RetirementJurisdiction)
+		if !f(fd_BuyOrder_retirement_jurisdiction, value) {
+			return
+		}
+	}
+	if x.Expiration != nil {
+		value := protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+		if !f(fd_BuyOrder_expiration, value) {
+			return
+		}
+	}
+	if x.Maker != false {
+		value := protoreflect.ValueOfBool(x.Maker)
+		if !f(fd_BuyOrder_maker, value) {
+			return
+		}
+	}
+	if x.ClassKey != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ClassKey)
+		if !f(fd_BuyOrder_class_key, value) {
+			return
+		}
+	}
+	if x.ProjectKey != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProjectKey)
+		if !f(fd_BuyOrder_project_key, value) {
+			return
+		}
+	}
+	if x.ProjectLocation != "" {
+		value := protoreflect.ValueOfString(x.ProjectLocation)
+		if !f(fd_BuyOrder_project_location, value) {
+			return
+		}
+	}
+	if x.MinStartDate != nil {
+		value := protoreflect.ValueOfMessage(x.MinStartDate.ProtoReflect())
+		if !f(fd_BuyOrder_min_start_date, value) {
+			return
+		}
+	}
+	if x.MaxEndDate != nil {
+		value := protoreflect.ValueOfMessage(x.MaxEndDate.ProtoReflect())
+		if !f(fd_BuyOrder_max_end_date, value) {
+			return
+		}
+	}
+	if x.RetirementReason != "" {
+		value := protoreflect.ValueOfString(x.RetirementReason)
+		if !f(fd_BuyOrder_retirement_reason, value) {
+			return
+		}
+	}
+	if x.RetirementBeneficiary != "" {
+		value := protoreflect.ValueOfString(x.RetirementBeneficiary)
+		if !f(fd_BuyOrder_retirement_beneficiary, value) {
+			return
+		}
+	}
+	if x.EscrowedFeeBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.EscrowedFeeBps)
+		if !f(fd_BuyOrder_escrowed_fee_bps, value) {
 			return
 		}
 	}
@@ -1006,19 +1315,49 @@ func (x *fastReflection_AllowedDenom) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_AllowedDenom) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_BuyOrder) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.bank_denom":
-		return x.BankDenom != ""
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.display_denom":
-		return x.DisplayDenom != ""
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.exponent":
-		return x.Exponent != uint32(0)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.id":
+		return x.Id != uint64(0)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.buyer":
+		return len(x.Buyer) != 0
+	case "regen.ecocredit.marketplace.v1.BuyOrder.batch_key":
+		return x.BatchKey != uint64(0)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.quantity":
+		return x.Quantity != ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.market_id":
+		return x.MarketId != uint64(0)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.bid_amount":
+		return x.BidAmount != ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.disable_auto_retire":
+		return x.DisableAutoRetire != false
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_jurisdiction":
+		return x.RetirementJurisdiction != ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.expiration":
+		return x.Expiration != nil
+	case "regen.ecocredit.marketplace.v1.BuyOrder.maker":
+		return x.Maker != false
+	case "regen.ecocredit.marketplace.v1.BuyOrder.class_key":
+		return x.ClassKey != uint64(0)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.project_key":
+		return x.ProjectKey != uint64(0)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.project_location":
+		return x.ProjectLocation != ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.min_start_date":
+		return x.MinStartDate != nil
+	case "regen.ecocredit.marketplace.v1.BuyOrder.max_end_date":
+		return x.MaxEndDate != nil
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_reason":
+		return x.RetirementReason != ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_beneficiary":
+		return x.RetirementBeneficiary != ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.escrowed_fee_bps":
+		return x.EscrowedFeeBps != uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AllowedDenom"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AllowedDenom does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1028,19 +1367,49 @@ func (x *fastReflection_AllowedDenom) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AllowedDenom) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_BuyOrder) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.bank_denom":
-		x.BankDenom = ""
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.display_denom":
-		x.DisplayDenom = ""
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.exponent":
-		x.Exponent = uint32(0)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.id":
+		x.Id = uint64(0)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.buyer":
+		x.Buyer = nil
+	case "regen.ecocredit.marketplace.v1.BuyOrder.batch_key":
+		x.BatchKey = uint64(0)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.quantity":
+		x.Quantity = ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.market_id":
+		x.MarketId = uint64(0)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.bid_amount":
+		x.BidAmount = ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.disable_auto_retire":
+		x.DisableAutoRetire = false
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_jurisdiction":
+		x.RetirementJurisdiction = ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.expiration":
+		x.Expiration = nil
+	case "regen.ecocredit.marketplace.v1.BuyOrder.maker":
+		x.Maker = false
+	case "regen.ecocredit.marketplace.v1.BuyOrder.class_key":
+		x.ClassKey = uint64(0)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.project_key":
+		x.ProjectKey = uint64(0)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.project_location":
+		x.ProjectLocation = ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.min_start_date":
+		x.MinStartDate = nil
+	case "regen.ecocredit.marketplace.v1.BuyOrder.max_end_date":
+		x.MaxEndDate = nil
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_reason":
+		x.RetirementReason = ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_beneficiary":
+		x.RetirementBeneficiary = ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.escrowed_fee_bps":
+		x.EscrowedFeeBps = uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AllowedDenom"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AllowedDenom does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1050,22 +1419,67 @@ func (x *fastReflection_AllowedDenom) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_AllowedDenom) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BuyOrder) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.bank_denom":
-		value := x.BankDenom
+	case "regen.ecocredit.marketplace.v1.BuyOrder.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.buyer":
+		value := x.Buyer
+		return protoreflect.ValueOfBytes(value)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.batch_key":
+		value := x.BatchKey
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.quantity":
+		value := x.Quantity
 		return protoreflect.ValueOfString(value)
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.display_denom":
-		value := x.DisplayDenom
+	case "regen.ecocredit.marketplace.v1.BuyOrder.market_id":
+		value := x.MarketId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.bid_amount":
+		value := x.BidAmount
 		return protoreflect.ValueOfString(value)
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.exponent":
-		value := x.Exponent
+	case "regen.ecocredit.marketplace.v1.BuyOrder.disable_auto_retire":
+		value := x.DisableAutoRetire
+		return protoreflect.ValueOfBool(value)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_jurisdiction":
+		value := x.RetirementJurisdiction
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.expiration":
+		value := x.Expiration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.maker":
+		value := x.Maker
+		return protoreflect.ValueOfBool(value)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.class_key":
+		value := x.ClassKey
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.project_key":
+		value := x.ProjectKey
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.project_location":
+		value := x.ProjectLocation
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.min_start_date":
+		value := x.MinStartDate
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.max_end_date":
+		value := x.MaxEndDate
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_reason":
+		value := x.RetirementReason
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_beneficiary":
+		value := x.RetirementBeneficiary
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.escrowed_fee_bps":
+		value := x.EscrowedFeeBps
 		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AllowedDenom"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AllowedDenom does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1079,19 +1493,49 @@ func (x *fastReflection_AllowedDenom) Get(descriptor protoreflect.FieldDescripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AllowedDenom) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_BuyOrder) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.bank_denom":
-		x.BankDenom = value.Interface().(string)
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.display_denom":
-		x.DisplayDenom = value.Interface().(string)
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.exponent":
-		x.Exponent = uint32(value.Uint())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.id":
+		x.Id = value.Uint()
+	case "regen.ecocredit.marketplace.v1.BuyOrder.buyer":
+		x.Buyer = value.Bytes()
+	case "regen.ecocredit.marketplace.v1.BuyOrder.batch_key":
+		x.BatchKey = value.Uint()
+	case "regen.ecocredit.marketplace.v1.BuyOrder.quantity":
+		x.Quantity = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.market_id":
+		x.MarketId = value.Uint()
+	case "regen.ecocredit.marketplace.v1.BuyOrder.bid_amount":
+		x.BidAmount = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.disable_auto_retire":
+		x.DisableAutoRetire = value.Bool()
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_jurisdiction":
+		x.RetirementJurisdiction = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.expiration":
+		x.Expiration = value.Message().Interface().(*timestamppb.Timestamp)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.maker":
+		x.Maker = value.Bool()
+	case "regen.ecocredit.marketplace.v1.BuyOrder.class_key":
+		x.ClassKey = value.Uint()
+	case "regen.ecocredit.marketplace.v1.BuyOrder.project_key":
+		x.ProjectKey = value.Uint()
+	case "regen.ecocredit.marketplace.v1.BuyOrder.project_location":
+		x.ProjectLocation = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.min_start_date":
+		x.MinStartDate = value.Message().Interface().(*timestamppb.Timestamp)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.max_end_date":
+		x.MaxEndDate = value.Message().Interface().(*timestamppb.Timestamp)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_reason":
+		x.RetirementReason = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_beneficiary":
+		x.RetirementBeneficiary = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.escrowed_fee_bps":
+		x.EscrowedFeeBps = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AllowedDenom"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AllowedDenom does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1105,48 +1549,120 @@ func (x *fastReflection_AllowedDenom) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AllowedDenom) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BuyOrder) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.bank_denom":
-		panic(fmt.Errorf("field bank_denom of message regen.ecocredit.marketplace.v1.AllowedDenom is not mutable"))
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.display_denom":
-		panic(fmt.Errorf("field display_denom of message regen.ecocredit.marketplace.v1.AllowedDenom is not mutable"))
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.exponent":
-		panic(fmt.Errorf("field exponent of message regen.ecocredit.marketplace.v1.AllowedDenom is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.expiration":
+		if x.Expiration == nil {
+			x.Expiration = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.min_start_date":
+		if x.MinStartDate == nil {
+			x.MinStartDate = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.MinStartDate.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.max_end_date":
+		if x.MaxEndDate == nil {
+			x.MaxEndDate = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.MaxEndDate.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.id":
+		panic(fmt.Errorf("field id of message regen.ecocredit.marketplace.v1.BuyOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.buyer":
+		panic(fmt.Errorf("field buyer of message regen.ecocredit.marketplace.v1.BuyOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.batch_key":
+		panic(fmt.Errorf("field batch_key of message regen.ecocredit.marketplace.v1.BuyOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.quantity":
+		panic(fmt.Errorf("field quantity of message regen.ecocredit.marketplace.v1.BuyOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.market_id":
+		panic(fmt.Errorf("field market_id of message regen.ecocredit.marketplace.v1.BuyOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.bid_amount":
+		panic(fmt.Errorf("field bid_amount of message regen.ecocredit.marketplace.v1.BuyOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.disable_auto_retire":
+		panic(fmt.Errorf("field disable_auto_retire of message regen.ecocredit.marketplace.v1.BuyOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_jurisdiction":
+		panic(fmt.Errorf("field retirement_jurisdiction of message regen.ecocredit.marketplace.v1.BuyOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.maker":
+		panic(fmt.Errorf("field maker of message regen.ecocredit.marketplace.v1.BuyOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.class_key":
+		panic(fmt.Errorf("field class_key of message regen.ecocredit.marketplace.v1.BuyOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.project_key":
+		panic(fmt.Errorf("field project_key of message regen.ecocredit.marketplace.v1.BuyOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.project_location":
+		panic(fmt.Errorf("field project_location of message regen.ecocredit.marketplace.v1.BuyOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_reason":
+		panic(fmt.Errorf("field retirement_reason of message regen.ecocredit.marketplace.v1.BuyOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_beneficiary":
+		panic(fmt.Errorf("field retirement_beneficiary of message regen.ecocredit.marketplace.v1.BuyOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.escrowed_fee_bps":
+		panic(fmt.Errorf("field escrowed_fee_bps of message regen.ecocredit.marketplace.v1.BuyOrder is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AllowedDenom"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AllowedDenom does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_AllowedDenom) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BuyOrder) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.bank_denom":
+	case "regen.ecocredit.marketplace.v1.BuyOrder.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.buyer":
+		return protoreflect.ValueOfBytes(nil)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.batch_key":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.quantity":
 		return protoreflect.ValueOfString("")
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.display_denom":
+	case "regen.ecocredit.marketplace.v1.BuyOrder.market_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.bid_amount":
 		return protoreflect.ValueOfString("")
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.exponent":
+	case "regen.ecocredit.marketplace.v1.BuyOrder.disable_auto_retire":
+		return protoreflect.ValueOfBool(false)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_jurisdiction":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.BuyOrder.expiration":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.maker":
+		return protoreflect.ValueOfBool(false)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.class_key":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.project_key":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.project_location":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.BuyOrder.min_start_date":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.max_end_date":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_reason":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_beneficiary":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.BuyOrder.escrowed_fee_bps":
 		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AllowedDenom"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AllowedDenom does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_AllowedDenom) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_BuyOrder) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.AllowedDenom", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.BuyOrder", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1154,7 +1670,7 @@ func (x *fastReflection_AllowedDenom) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_AllowedDenom) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_BuyOrder) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1165,7 +1681,7 @@ func (x *fastReflection_AllowedDenom) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AllowedDenom) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_BuyOrder) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1177,7 +1693,7 @@ func (x *fastReflection_AllowedDenom) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_AllowedDenom) IsValid() bool {
+func (x *fastReflection_BuyOrder) IsValid() bool {
 	return x != nil
 }
 
@@ -1187,9 +1703,9 @@ func (x *fastReflection_AllowedDenom) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_AllowedDenom) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_BuyOrder) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*AllowedDenom)
+		x := input.Message.Interface().(*BuyOrder)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1201,16 +1717,69 @@ func (x *fastReflection_AllowedDenom) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.BankDenom)
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Buyer)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.DisplayDenom)
+		if x.BatchKey != 0 {
+			n += 1 + runtime.Sov(uint64(x.BatchKey))
+		}
+		l = len(x.Quantity)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Exponent != 0 {
-			n += 1 + runtime.Sov(uint64(x.Exponent))
+		if x.MarketId != 0 {
+			n += 1 + runtime.Sov(uint64(x.MarketId))
+		}
+		l = len(x.BidAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DisableAutoRetire {
+			n += 2
+		}
+		l = len(x.RetirementJurisdiction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Expiration != nil {
+			l = options.Size(x.Expiration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Maker {
+			n += 2
+		}
+		if x.ClassKey != 0 {
+			n += 1 + runtime.Sov(uint64(x.ClassKey))
+		}
+		if x.ProjectKey != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProjectKey))
+		}
+		l = len(x.ProjectLocation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MinStartDate != nil {
+			l = options.Size(x.MinStartDate)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxEndDate != nil {
+			l = options.Size(x.MaxEndDate)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RetirementReason)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RetirementBeneficiary)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.EscrowedFeeBps != 0 {
+			n += 2 + runtime.Sov(uint64(x.EscrowedFeeBps))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1222,7 +1791,7 @@ func (x *fastReflection_AllowedDenom) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*AllowedDenom)
+		x := input.Message.Interface().(*BuyOrder)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1241,24 +1810,152 @@ func (x *fastReflection_AllowedDenom) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Exponent != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Exponent))
+		if x.EscrowedFeeBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EscrowedFeeBps))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x90
+		}
+		if len(x.RetirementBeneficiary) > 0 {
+			i -= len(x.RetirementBeneficiary)
+			copy(dAtA[i:], x.RetirementBeneficiary)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RetirementBeneficiary)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if len(x.RetirementReason) > 0 {
+			i -= len(x.RetirementReason)
+			copy(dAtA[i:], x.RetirementReason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RetirementReason)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if x.MaxEndDate != nil {
+			encoded, err := options.Marshal(x.MaxEndDate)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if x.MinStartDate != nil {
+			encoded, err := options.Marshal(x.MinStartDate)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if len(x.ProjectLocation) > 0 {
+			i -= len(x.ProjectLocation)
+			copy(dAtA[i:], x.ProjectLocation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProjectLocation)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if x.ProjectKey != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProjectKey))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.ClassKey != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ClassKey))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.Maker {
+			i--
+			if x.Maker {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.Expiration != nil {
+			encoded, err := options.Marshal(x.Expiration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.RetirementJurisdiction) > 0 {
+			i -= len(x.RetirementJurisdiction)
+			copy(dAtA[i:], x.RetirementJurisdiction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RetirementJurisdiction)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.DisableAutoRetire {
+			i--
+			if x.DisableAutoRetire {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.BidAmount) > 0 {
+			i -= len(x.BidAmount)
+			copy(dAtA[i:], x.BidAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BidAmount)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.MarketId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MarketId))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Quantity) > 0 {
+			i -= len(x.Quantity)
+			copy(dAtA[i:], x.Quantity)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Quantity)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.BatchKey != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BatchKey))
 			i--
 			dAtA[i] = 0x18
 		}
-		if len(x.DisplayDenom) > 0 {
-			i -= len(x.DisplayDenom)
-			copy(dAtA[i:], x.DisplayDenom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DisplayDenom)))
+		if len(x.Buyer) > 0 {
+			i -= len(x.Buyer)
+			copy(dAtA[i:], x.Buyer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Buyer)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.BankDenom) > 0 {
-			i -= len(x.BankDenom)
-			copy(dAtA[i:], x.BankDenom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BankDenom)))
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1271,7 +1968,7 @@ func (x *fastReflection_AllowedDenom) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*AllowedDenom)
+		x := input.Message.Interface().(*BuyOrder)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1303,17 +2000,17 @@ func (x *fastReflection_AllowedDenom) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AllowedDenom: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BuyOrder: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AllowedDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BuyOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 				}
-				var stringLen uint64
+				x.Id = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1323,29 +2020,16 @@ func (x *fastReflection_AllowedDenom) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.Id |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.BankDenom = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisplayDenom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
 				}
-				var stringLen uint64
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1355,29 +2039,31 @@ func (x *fastReflection_AllowedDenom) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.DisplayDenom = string(dAtA[iNdEx:postIndex])
+				x.Buyer = append(x.Buyer[:0], dAtA[iNdEx:postIndex]...)
+				if x.Buyer == nil {
+					x.Buyer = []byte{}
+				}
 				iNdEx = postIndex
 			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
 				}
-				x.Exponent = 0
+				x.BatchKey = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1387,494 +2073,48 @@ func (x *fastReflection_AllowedDenom) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Exponent |= uint32(b&0x7F) << shift
+					x.BatchKey |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.Quantity = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_Market                    protoreflect.MessageDescriptor
-	fd_Market_id                 protoreflect.FieldDescriptor
-	fd_Market_credit_type_abbrev protoreflect.FieldDescriptor
-	fd_Market_bank_denom         protoreflect.FieldDescriptor
-	fd_Market_precision_modifier protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_regen_ecocredit_marketplace_v1_state_proto_init()
-	md_Market = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("Market")
-	fd_Market_id = md_Market.Fields().ByName("id")
-	fd_Market_credit_type_abbrev = md_Market.Fields().ByName("credit_type_abbrev")
-	fd_Market_bank_denom = md_Market.Fields().ByName("bank_denom")
-	fd_Market_precision_modifier = md_Market.Fields().ByName("precision_modifier")
-}
-
-var _ protoreflect.Message = (*fastReflection_Market)(nil)
-
-type fastReflection_Market Market
-
-func (x *Market) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Market)(x)
-}
-
-func (x *Market) slowProtoReflect() protoreflect.Message {
-	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_Market_messageType fastReflection_Market_messageType
-var _ protoreflect.MessageType = fastReflection_Market_messageType{}
-
-type fastReflection_Market_messageType struct{}
-
-func (x fastReflection_Market_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Market)(nil)
-}
-func (x fastReflection_Market_messageType) New() protoreflect.Message {
-	return new(fastReflection_Market)
-}
-func (x fastReflection_Market_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Market
-}
-
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_Market) Descriptor() protoreflect.MessageDescriptor {
-	return md_Market
-}
-
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Market) Type() protoreflect.MessageType {
-	return _fastReflection_Market_messageType
-}
-
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Market) New() protoreflect.Message {
-	return new(fastReflection_Market)
-}
-
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_Market) Interface() protoreflect.ProtoMessage {
-	return (*Market)(x)
-}
-
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_Market) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Id != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Id)
-		if !f(fd_Market_id, value) {
-			return
-		}
-	}
-	if x.CreditTypeAbbrev != "" {
-		value := protoreflect.ValueOfString(x.CreditTypeAbbrev)
-		if !f(fd_Market_credit_type_abbrev, value) {
-			return
-		}
-	}
-	if x.BankDenom != "" {
-		value := protoreflect.ValueOfString(x.BankDenom)
-		if !f(fd_Market_bank_denom, value) {
-			return
-		}
-	}
-	if x.PrecisionModifier != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.PrecisionModifier)
-		if !f(fd_Market_precision_modifier, value) {
-			return
-		}
-	}
-}
-
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_Market) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "regen.ecocredit.marketplace.v1.Market.id":
-		return x.Id != uint64(0)
-	case "regen.ecocredit.marketplace.v1.Market.credit_type_abbrev":
-		return x.CreditTypeAbbrev != ""
-	case "regen.ecocredit.marketplace.v1.Market.bank_denom":
-		return x.BankDenom != ""
-	case "regen.ecocredit.marketplace.v1.Market.precision_modifier":
-		return x.PrecisionModifier != uint32(0)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Market"))
-		}
-		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Market does not contain field %s", fd.FullName()))
-	}
-}
-
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Market) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "regen.ecocredit.marketplace.v1.Market.id":
-		x.Id = uint64(0)
-	case "regen.ecocredit.marketplace.v1.Market.credit_type_abbrev":
-		x.CreditTypeAbbrev = ""
-	case "regen.ecocredit.marketplace.v1.Market.bank_denom":
-		x.BankDenom = ""
-	case "regen.ecocredit.marketplace.v1.Market.precision_modifier":
-		x.PrecisionModifier = uint32(0)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Market"))
-		}
-		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Market does not contain field %s", fd.FullName()))
-	}
-}
-
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Market) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "regen.ecocredit.marketplace.v1.Market.id":
-		value := x.Id
-		return protoreflect.ValueOfUint64(value)
-	case "regen.ecocredit.marketplace.v1.Market.credit_type_abbrev":
-		value := x.CreditTypeAbbrev
-		return protoreflect.ValueOfString(value)
-	case "regen.ecocredit.marketplace.v1.Market.bank_denom":
-		value := x.BankDenom
-		return protoreflect.ValueOfString(value)
-	case "regen.ecocredit.marketplace.v1.Market.precision_modifier":
-		value := x.PrecisionModifier
-		return protoreflect.ValueOfUint32(value)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Market"))
-		}
-		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Market does not contain field %s", descriptor.FullName()))
-	}
-}
-
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Market) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "regen.ecocredit.marketplace.v1.Market.id":
-		x.Id = value.Uint()
-	case "regen.ecocredit.marketplace.v1.Market.credit_type_abbrev":
-		x.CreditTypeAbbrev = value.Interface().(string)
-	case "regen.ecocredit.marketplace.v1.Market.bank_denom":
-		x.BankDenom = value.Interface().(string)
-	case "regen.ecocredit.marketplace.v1.Market.precision_modifier":
-		x.PrecisionModifier = uint32(value.Uint())
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Market"))
-		}
-		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Market does not contain field %s", fd.FullName()))
-	}
-}
-
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Market) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "regen.ecocredit.marketplace.v1.Market.id":
-		panic(fmt.Errorf("field id of message regen.ecocredit.marketplace.v1.Market is not mutable"))
-	case "regen.ecocredit.marketplace.v1.Market.credit_type_abbrev":
-		panic(fmt.Errorf("field credit_type_abbrev of message regen.ecocredit.marketplace.v1.Market is not mutable"))
-	case "regen.ecocredit.marketplace.v1.Market.bank_denom":
-		panic(fmt.Errorf("field bank_denom of message regen.ecocredit.marketplace.v1.Market is not mutable"))
-	case "regen.ecocredit.marketplace.v1.Market.precision_modifier":
-		panic(fmt.Errorf("field precision_modifier of message regen.ecocredit.marketplace.v1.Market is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Market"))
-		}
-		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Market does not contain field %s", fd.FullName()))
-	}
-}
-
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Market) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "regen.ecocredit.marketplace.v1.Market.id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "regen.ecocredit.marketplace.v1.Market.credit_type_abbrev":
-		return protoreflect.ValueOfString("")
-	case "regen.ecocredit.marketplace.v1.Market.bank_denom":
-		return protoreflect.ValueOfString("")
-	case "regen.ecocredit.marketplace.v1.Market.precision_modifier":
-		return protoreflect.ValueOfUint32(uint32(0))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Market"))
-		}
-		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Market does not contain field %s", fd.FullName()))
-	}
-}
-
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Market) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.Market", d.FullName()))
-	}
-	panic("unreachable")
-}
-
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Market) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
-}
-
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Market) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
-}
-
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_Market) IsValid() bool {
-	return x != nil
-}
-
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_Market) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Market)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if x.Id != 0 {
-			n += 1 + runtime.Sov(uint64(x.Id))
-		}
-		l = len(x.CreditTypeAbbrev)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.BankDenom)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.PrecisionModifier != 0 {
-			n += 1 + runtime.Sov(uint64(x.PrecisionModifier))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
-	}
-
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Market)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if x.PrecisionModifier != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.PrecisionModifier))
-			i--
-			dAtA[i] = 0x20
-		}
-		if len(x.BankDenom) > 0 {
-			i -= len(x.BankDenom)
-			copy(dAtA[i:], x.BankDenom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BankDenom)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.CreditTypeAbbrev) > 0 {
-			i -= len(x.CreditTypeAbbrev)
-			copy(dAtA[i:], x.CreditTypeAbbrev)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CreditTypeAbbrev)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if x.Id != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
-			i--
-			dAtA[i] = 0x8
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Market)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Market: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Market: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-				}
-				x.Id = 0
+				x.MarketId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1884,14 +2124,14 @@ func (x *fastReflection_Market) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Id |= uint64(b&0x7F) << shift
+					x.MarketId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 2:
+			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BidAmount", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1919,11 +2159,31 @@ func (x *fastReflection_Market) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
+				x.BidAmount = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.DisableAutoRetire = bool(v != 0)
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetirementJurisdiction", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1951,13 +2211,49 @@ func (x *fastReflection_Market) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BankDenom = string(dAtA[iNdEx:postIndex])
+				x.RetirementJurisdiction = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 4:
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Expiration == nil {
+					x.Expiration = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrecisionModifier", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Maker", wireType)
 				}
-				x.PrecisionModifier = 0
+				var v int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1967,329 +2263,13019 @@ func (x *fastReflection_Market) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.PrecisionModifier |= uint32(b&0x7F) << shift
+					v |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+				x.Maker = bool(v != 0)
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
+				}
+				x.ClassKey = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ClassKey |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
+				}
+				x.ProjectKey = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProjectKey |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProjectLocation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProjectLocation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinStartDate", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MinStartDate == nil {
+					x.MinStartDate = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinStartDate); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxEndDate", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if x.MaxEndDate == nil {
+					x.MaxEndDate = &timestamppb.Timestamp{}
 				}
-				iNdEx += skippy
-			}
-		}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxEndDate); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetirementReason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RetirementReason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetirementBeneficiary", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RetirementBeneficiary = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 18:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EscrowedFeeBps", wireType)
+				}
+				x.EscrowedFeeBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EscrowedFeeBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_AllowedDenom                protoreflect.MessageDescriptor
+	fd_AllowedDenom_bank_denom     protoreflect.FieldDescriptor
+	fd_AllowedDenom_display_denom  protoreflect.FieldDescriptor
+	fd_AllowedDenom_exponent       protoreflect.FieldDescriptor
+	fd_AllowedDenom_ibc_path       protoreflect.FieldDescriptor
+	fd_AllowedDenom_ibc_base_denom protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_AllowedDenom = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("AllowedDenom")
+	fd_AllowedDenom_bank_denom = md_AllowedDenom.Fields().ByName("bank_denom")
+	fd_AllowedDenom_display_denom = md_AllowedDenom.Fields().ByName("display_denom")
+	fd_AllowedDenom_exponent = md_AllowedDenom.Fields().ByName("exponent")
+	fd_AllowedDenom_ibc_path = md_AllowedDenom.Fields().ByName("ibc_path")
+	fd_AllowedDenom_ibc_base_denom = md_AllowedDenom.Fields().ByName("ibc_base_denom")
+}
+
+var _ protoreflect.Message = (*fastReflection_AllowedDenom)(nil)
+
+type fastReflection_AllowedDenom AllowedDenom
+
+func (x *AllowedDenom) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AllowedDenom)(x)
+}
+
+func (x *AllowedDenom) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AllowedDenom_messageType fastReflection_AllowedDenom_messageType
+var _ protoreflect.MessageType = fastReflection_AllowedDenom_messageType{}
+
+type fastReflection_AllowedDenom_messageType struct{}
+
+func (x fastReflection_AllowedDenom_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AllowedDenom)(nil)
+}
+func (x fastReflection_AllowedDenom_messageType) New() protoreflect.Message {
+	return new(fastReflection_AllowedDenom)
+}
+func (x fastReflection_AllowedDenom_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AllowedDenom
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AllowedDenom) Descriptor() protoreflect.MessageDescriptor {
+	return md_AllowedDenom
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AllowedDenom) Type() protoreflect.MessageType {
+	return _fastReflection_AllowedDenom_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AllowedDenom) New() protoreflect.Message {
+	return new(fastReflection_AllowedDenom)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AllowedDenom) Interface() protoreflect.ProtoMessage {
+	return (*AllowedDenom)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AllowedDenom) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BankDenom != "" {
+		value := protoreflect.ValueOfString(x.BankDenom)
+		if !f(fd_AllowedDenom_bank_denom, value) {
+			return
+		}
+	}
+	if x.DisplayDenom != "" {
+		value := protoreflect.ValueOfString(x.DisplayDenom)
+		if !f(fd_AllowedDenom_display_denom, value) {
+			return
+		}
+	}
+	if x.Exponent != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Exponent)
+		if !f(fd_AllowedDenom_exponent, value) {
+			return
+		}
+	}
+	if x.IbcPath != "" {
+		value := protoreflect.ValueOfString(x.IbcPath)
+		if !f(fd_AllowedDenom_ibc_path, value) {
+			return
+		}
+	}
+	if x.IbcBaseDenom != "" {
+		value := protoreflect.ValueOfString(x.IbcBaseDenom)
+		if !f(fd_AllowedDenom_ibc_base_denom, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AllowedDenom) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.bank_denom":
+		return x.BankDenom != ""
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.display_denom":
+		return x.DisplayDenom != ""
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.exponent":
+		return x.Exponent != uint32(0)
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.ibc_path":
+		return x.IbcPath != ""
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.ibc_base_denom":
+		return x.IbcBaseDenom != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AllowedDenom"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AllowedDenom does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AllowedDenom) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.bank_denom":
+		x.BankDenom = ""
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.display_denom":
+		x.DisplayDenom = ""
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.exponent":
+		x.Exponent = uint32(0)
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.ibc_path":
+		x.IbcPath = ""
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.ibc_base_denom":
+		x.IbcBaseDenom = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AllowedDenom"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AllowedDenom does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AllowedDenom) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.bank_denom":
+		value := x.BankDenom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.display_denom":
+		value := x.DisplayDenom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.exponent":
+		value := x.Exponent
+		return protoreflect.ValueOfUint32(value)
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.ibc_path":
+		value := x.IbcPath
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.ibc_base_denom":
+		value := x.IbcBaseDenom
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AllowedDenom"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AllowedDenom does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AllowedDenom) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.bank_denom":
+		x.BankDenom = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.display_denom":
+		x.DisplayDenom = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.exponent":
+		x.Exponent = uint32(value.Uint())
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.ibc_path":
+		x.IbcPath = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.ibc_base_denom":
+		x.IbcBaseDenom = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AllowedDenom"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AllowedDenom does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AllowedDenom) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.bank_denom":
+		panic(fmt.Errorf("field bank_denom of message regen.ecocredit.marketplace.v1.AllowedDenom is not mutable"))
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.display_denom":
+		panic(fmt.Errorf("field display_denom of message regen.ecocredit.marketplace.v1.AllowedDenom is not mutable"))
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.exponent":
+		panic(fmt.Errorf("field exponent of message regen.ecocredit.marketplace.v1.AllowedDenom is not mutable"))
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.ibc_path":
+		panic(fmt.Errorf("field ibc_path of message regen.ecocredit.marketplace.v1.AllowedDenom is not mutable"))
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.ibc_base_denom":
+		panic(fmt.Errorf("field ibc_base_denom of message regen.ecocredit.marketplace.v1.AllowedDenom is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AllowedDenom"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AllowedDenom does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AllowedDenom) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.bank_denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.display_denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.exponent":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.ibc_path":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.ibc_base_denom":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AllowedDenom"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AllowedDenom does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AllowedDenom) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.AllowedDenom", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AllowedDenom) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AllowedDenom) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AllowedDenom) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AllowedDenom) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AllowedDenom)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.BankDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DisplayDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Exponent != 0 {
+			n += 1 + runtime.Sov(uint64(x.Exponent))
+		}
+		l = len(x.IbcPath)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.IbcBaseDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AllowedDenom)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.IbcBaseDenom) > 0 {
+			i -= len(x.IbcBaseDenom)
+			copy(dAtA[i:], x.IbcBaseDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.IbcBaseDenom)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.IbcPath) > 0 {
+			i -= len(x.IbcPath)
+			copy(dAtA[i:], x.IbcPath)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.IbcPath)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Exponent != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Exponent))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.DisplayDenom) > 0 {
+			i -= len(x.DisplayDenom)
+			copy(dAtA[i:], x.DisplayDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DisplayDenom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.BankDenom) > 0 {
+			i -= len(x.BankDenom)
+			copy(dAtA[i:], x.BankDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BankDenom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AllowedDenom)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AllowedDenom: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AllowedDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BankDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisplayDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DisplayDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+				}
+				x.Exponent = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Exponent |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IbcPath", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.IbcPath = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IbcBaseDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.IbcBaseDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Market                    protoreflect.MessageDescriptor
+	fd_Market_id                 protoreflect.FieldDescriptor
+	fd_Market_credit_type_abbrev protoreflect.FieldDescriptor
+	fd_Market_bank_denom         protoreflect.FieldDescriptor
+	fd_Market_precision_modifier protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_Market = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("Market")
+	fd_Market_id = md_Market.Fields().ByName("id")
+	fd_Market_credit_type_abbrev = md_Market.Fields().ByName("credit_type_abbrev")
+	fd_Market_bank_denom = md_Market.Fields().ByName("bank_denom")
+	fd_Market_precision_modifier = md_Market.Fields().ByName("precision_modifier")
+}
+
+var _ protoreflect.Message = (*fastReflection_Market)(nil)
+
+type fastReflection_Market Market
+
+func (x *Market) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Market)(x)
+}
+
+func (x *Market) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Market_messageType fastReflection_Market_messageType
+var _ protoreflect.MessageType = fastReflection_Market_messageType{}
+
+type fastReflection_Market_messageType struct{}
+
+func (x fastReflection_Market_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Market)(nil)
+}
+func (x fastReflection_Market_messageType) New() protoreflect.Message {
+	return new(fastReflection_Market)
+}
+func (x fastReflection_Market_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Market
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Market) Descriptor() protoreflect.MessageDescriptor {
+	return md_Market
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Market) Type() protoreflect.MessageType {
+	return _fastReflection_Market_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Market) New() protoreflect.Message {
+	return new(fastReflection_Market)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Market) Interface() protoreflect.ProtoMessage {
+	return (*Market)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Market) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_Market_id, value) {
+			return
+		}
+	}
+	if x.CreditTypeAbbrev != "" {
+		value := protoreflect.ValueOfString(x.CreditTypeAbbrev)
+		if !f(fd_Market_credit_type_abbrev, value) {
+			return
+		}
+	}
+	if x.BankDenom != "" {
+		value := protoreflect.ValueOfString(x.BankDenom)
+		if !f(fd_Market_bank_denom, value) {
+			return
+		}
+	}
+	if x.PrecisionModifier != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.PrecisionModifier)
+		if !f(fd_Market_precision_modifier, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Market) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Market.id":
+		return x.Id != uint64(0)
+	case "regen.ecocredit.marketplace.v1.Market.credit_type_abbrev":
+		return x.CreditTypeAbbrev != ""
+	case "regen.ecocredit.marketplace.v1.Market.bank_denom":
+		return x.BankDenom != ""
+	case "regen.ecocredit.marketplace.v1.Market.precision_modifier":
+		return x.PrecisionModifier != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Market"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Market does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Market) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Market.id":
+		x.Id = uint64(0)
+	case "regen.ecocredit.marketplace.v1.Market.credit_type_abbrev":
+		x.CreditTypeAbbrev = ""
+	case "regen.ecocredit.marketplace.v1.Market.bank_denom":
+		x.BankDenom = ""
+	case "regen.ecocredit.marketplace.v1.Market.precision_modifier":
+		x.PrecisionModifier = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Market"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Market does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Market) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.Market.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.Market.credit_type_abbrev":
+		value := x.CreditTypeAbbrev
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Market.bank_denom":
+		value := x.BankDenom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Market.precision_modifier":
+		value := x.PrecisionModifier
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Market"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Market does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Market) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Market.id":
+		x.Id = value.Uint()
+	case "regen.ecocredit.marketplace.v1.Market.credit_type_abbrev":
+		x.CreditTypeAbbrev = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Market.bank_denom":
+		x.BankDenom = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Market.precision_modifier":
+		x.PrecisionModifier = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Market"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Market does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Market) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Market.id":
+		panic(fmt.Errorf("field id of message regen.ecocredit.marketplace.v1.Market is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Market.credit_type_abbrev":
+		panic(fmt.Errorf("field credit_type_abbrev of message regen.ecocredit.marketplace.v1.Market is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Market.bank_denom":
+		panic(fmt.Errorf("field bank_denom of message regen.ecocredit.marketplace.v1.Market is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Market.precision_modifier":
+		panic(fmt.Errorf("field precision_modifier of message regen.ecocredit.marketplace.v1.Market is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Market"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Market does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Market) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Market.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.Market.credit_type_abbrev":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Market.bank_denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Market.precision_modifier":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Market"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Market does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Market) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.Market", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Market) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Market) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Market) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Market) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Market)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.CreditTypeAbbrev)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BankDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PrecisionModifier != 0 {
+			n += 1 + runtime.Sov(uint64(x.PrecisionModifier))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Market)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.PrecisionModifier != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PrecisionModifier))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.BankDenom) > 0 {
+			i -= len(x.BankDenom)
+			copy(dAtA[i:], x.BankDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BankDenom)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.CreditTypeAbbrev) > 0 {
+			i -= len(x.CreditTypeAbbrev)
+			copy(dAtA[i:], x.CreditTypeAbbrev)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CreditTypeAbbrev)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Market)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Market: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Market: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BankDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrecisionModifier", wireType)
+				}
+				x.PrecisionModifier = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PrecisionModifier |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TradingFee                protoreflect.MessageDescriptor
+	fd_TradingFee_maker_fee_bps  protoreflect.FieldDescriptor
+	fd_TradingFee_taker_fee_bps  protoreflect.FieldDescriptor
+	fd_TradingFee_destination    protoreflect.FieldDescriptor
+	fd_TradingFee_module_account protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_TradingFee = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("TradingFee")
+	fd_TradingFee_maker_fee_bps = md_TradingFee.Fields().ByName("maker_fee_bps")
+	fd_TradingFee_taker_fee_bps = md_TradingFee.Fields().ByName("taker_fee_bps")
+	fd_TradingFee_destination = md_TradingFee.Fields().ByName("destination")
+	fd_TradingFee_module_account = md_TradingFee.Fields().ByName("module_account")
+}
+
+var _ protoreflect.Message = (*fastReflection_TradingFee)(nil)
+
+type fastReflection_TradingFee TradingFee
+
+func (x *TradingFee) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TradingFee)(x)
+}
+
+func (x *TradingFee) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TradingFee_messageType fastReflection_TradingFee_messageType
+var _ protoreflect.MessageType = fastReflection_TradingFee_messageType{}
+
+type fastReflection_TradingFee_messageType struct{}
+
+func (x fastReflection_TradingFee_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TradingFee)(nil)
+}
+func (x fastReflection_TradingFee_messageType) New() protoreflect.Message {
+	return new(fastReflection_TradingFee)
+}
+func (x fastReflection_TradingFee_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TradingFee
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TradingFee) Descriptor() protoreflect.MessageDescriptor {
+	return md_TradingFee
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TradingFee) Type() protoreflect.MessageType {
+	return _fastReflection_TradingFee_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TradingFee) New() protoreflect.Message {
+	return new(fastReflection_TradingFee)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TradingFee) Interface() protoreflect.ProtoMessage {
+	return (*TradingFee)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TradingFee) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MakerFeeBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MakerFeeBps)
+		if !f(fd_TradingFee_maker_fee_bps, value) {
+			return
+		}
+	}
+	if x.TakerFeeBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.TakerFeeBps)
+		if !f(fd_TradingFee_taker_fee_bps, value) {
+			return
+		}
+	}
+	if x.Destination != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Destination))
+		if !f(fd_TradingFee_destination, value) {
+			return
+		}
+	}
+	if x.ModuleAccount != "" {
+		value := protoreflect.ValueOfString(x.ModuleAccount)
+		if !f(fd_TradingFee_module_account, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TradingFee) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.TradingFee.maker_fee_bps":
+		return x.MakerFeeBps != uint32(0)
+	case "regen.ecocredit.marketplace.v1.TradingFee.taker_fee_bps":
+		return x.TakerFeeBps != uint32(0)
+	case "regen.ecocredit.marketplace.v1.TradingFee.destination":
+		return x.Destination != 0
+	case "regen.ecocredit.marketplace.v1.TradingFee.module_account":
+		return x.ModuleAccount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.TradingFee"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.TradingFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TradingFee) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.TradingFee.maker_fee_bps":
+		x.MakerFeeBps = uint32(0)
+	case "regen.ecocredit.marketplace.v1.TradingFee.taker_fee_bps":
+		x.TakerFeeBps = uint32(0)
+	case "regen.ecocredit.marketplace.v1.TradingFee.destination":
+		x.Destination = 0
+	case "regen.ecocredit.marketplace.v1.TradingFee.module_account":
+		x.ModuleAccount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.TradingFee"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.TradingFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TradingFee) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.TradingFee.maker_fee_bps":
+		value := x.MakerFeeBps
+		return protoreflect.ValueOfUint32(value)
+	case "regen.ecocredit.marketplace.v1.TradingFee.taker_fee_bps":
+		value := x.TakerFeeBps
+		return protoreflect.ValueOfUint32(value)
+	case "regen.ecocredit.marketplace.v1.TradingFee.destination":
+		value := x.Destination
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "regen.ecocredit.marketplace.v1.TradingFee.module_account":
+		value := x.ModuleAccount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.TradingFee"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.TradingFee does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TradingFee) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.TradingFee.maker_fee_bps":
+		x.MakerFeeBps = uint32(value.Uint())
+	case "regen.ecocredit.marketplace.v1.TradingFee.taker_fee_bps":
+		x.TakerFeeBps = uint32(value.Uint())
+	case "regen.ecocredit.marketplace.v1.TradingFee.destination":
+		x.Destination = (FeeDestination)(value.Enum())
+	case "regen.ecocredit.marketplace.v1.TradingFee.module_account":
+		x.ModuleAccount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.TradingFee"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.TradingFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TradingFee) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.TradingFee.maker_fee_bps":
+		panic(fmt.Errorf("field maker_fee_bps of message regen.ecocredit.marketplace.v1.TradingFee is not mutable"))
+	case "regen.ecocredit.marketplace.v1.TradingFee.taker_fee_bps":
+		panic(fmt.Errorf("field taker_fee_bps of message regen.ecocredit.marketplace.v1.TradingFee is not mutable"))
+	case "regen.ecocredit.marketplace.v1.TradingFee.destination":
+		panic(fmt.Errorf("field destination of message regen.ecocredit.marketplace.v1.TradingFee is not mutable"))
+	case "regen.ecocredit.marketplace.v1.TradingFee.module_account":
+		panic(fmt.Errorf("field module_account of message regen.ecocredit.marketplace.v1.TradingFee is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.TradingFee"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.TradingFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TradingFee) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.TradingFee.maker_fee_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "regen.ecocredit.marketplace.v1.TradingFee.taker_fee_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "regen.ecocredit.marketplace.v1.TradingFee.destination":
+		return protoreflect.ValueOfEnum(0)
+	case "regen.ecocredit.marketplace.v1.TradingFee.module_account":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.TradingFee"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.TradingFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TradingFee) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.TradingFee", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TradingFee) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TradingFee) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TradingFee) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TradingFee) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TradingFee)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.MakerFeeBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.MakerFeeBps))
+		}
+		if x.TakerFeeBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.TakerFeeBps))
+		}
+		if x.Destination != 0 {
+			n += 1 + runtime.Sov(uint64(x.Destination))
+		}
+		l = len(x.ModuleAccount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TradingFee)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ModuleAccount) > 0 {
+			i -= len(x.ModuleAccount)
+			copy(dAtA[i:], x.ModuleAccount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ModuleAccount)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Destination != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Destination))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.TakerFeeBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TakerFeeBps))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.MakerFeeBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MakerFeeBps))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TradingFee)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TradingFee: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TradingFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MakerFeeBps", wireType)
+				}
+				x.MakerFeeBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MakerFeeBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TakerFeeBps", wireType)
+				}
+				x.TakerFeeBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TakerFeeBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				}
+				x.Destination = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Destination |= FeeDestination(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ModuleAccount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ClassRoyalty             protoreflect.MessageDescriptor
+	fd_ClassRoyalty_class_key   protoreflect.FieldDescriptor
+	fd_ClassRoyalty_royalty_bps protoreflect.FieldDescriptor
+	fd_ClassRoyalty_recipient   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_ClassRoyalty = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("ClassRoyalty")
+	fd_ClassRoyalty_class_key = md_ClassRoyalty.Fields().ByName("class_key")
+	fd_ClassRoyalty_royalty_bps = md_ClassRoyalty.Fields().ByName("royalty_bps")
+	fd_ClassRoyalty_recipient = md_ClassRoyalty.Fields().ByName("recipient")
+}
+
+var _ protoreflect.Message = (*fastReflection_ClassRoyalty)(nil)
+
+type fastReflection_ClassRoyalty ClassRoyalty
+
+func (x *ClassRoyalty) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ClassRoyalty)(x)
+}
+
+func (x *ClassRoyalty) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ClassRoyalty_messageType fastReflection_ClassRoyalty_messageType
+var _ protoreflect.MessageType = fastReflection_ClassRoyalty_messageType{}
+
+type fastReflection_ClassRoyalty_messageType struct{}
+
+func (x fastReflection_ClassRoyalty_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ClassRoyalty)(nil)
+}
+func (x fastReflection_ClassRoyalty_messageType) New() protoreflect.Message {
+	return new(fastReflection_ClassRoyalty)
+}
+func (x fastReflection_ClassRoyalty_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ClassRoyalty
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ClassRoyalty) Descriptor() protoreflect.MessageDescriptor {
+	return md_ClassRoyalty
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ClassRoyalty) Type() protoreflect.MessageType {
+	return _fastReflection_ClassRoyalty_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ClassRoyalty) New() protoreflect.Message {
+	return new(fastReflection_ClassRoyalty)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ClassRoyalty) Interface() protoreflect.ProtoMessage {
+	return (*ClassRoyalty)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ClassRoyalty) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ClassKey != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ClassKey)
+		if !f(fd_ClassRoyalty_class_key, value) {
+			return
+		}
+	}
+	if x.RoyaltyBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.RoyaltyBps)
+		if !f(fd_ClassRoyalty_royalty_bps, value) {
+			return
+		}
+	}
+	if len(x.Recipient) != 0 {
+		value := protoreflect.ValueOfBytes(x.Recipient)
+		if !f(fd_ClassRoyalty_recipient, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ClassRoyalty) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.ClassRoyalty.class_key":
+		return x.ClassKey != uint64(0)
+	case "regen.ecocredit.marketplace.v1.ClassRoyalty.royalty_bps":
+		return x.RoyaltyBps != uint32(0)
+	case "regen.ecocredit.marketplace.v1.ClassRoyalty.recipient":
+		return len(x.Recipient) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.ClassRoyalty"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.ClassRoyalty does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ClassRoyalty) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.ClassRoyalty.class_key":
+		x.ClassKey = uint64(0)
+	case "regen.ecocredit.marketplace.v1.ClassRoyalty.royalty_bps":
+		x.RoyaltyBps = uint32(0)
+	case "regen.ecocredit.marketplace.v1.ClassRoyalty.recipient":
+		x.Recipient = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.ClassRoyalty"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.ClassRoyalty does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ClassRoyalty) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.ClassRoyalty.class_key":
+		value := x.ClassKey
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.ClassRoyalty.royalty_bps":
+		value := x.RoyaltyBps
+		return protoreflect.ValueOfUint32(value)
+	case "regen.ecocredit.marketplace.v1.ClassRoyalty.recipient":
+		value := x.Recipient
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.ClassRoyalty"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.ClassRoyalty does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ClassRoyalty) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.ClassRoyalty.class_key":
+		x.ClassKey = value.Uint()
+	case "regen.ecocredit.marketplace.v1.ClassRoyalty.royalty_bps":
+		x.RoyaltyBps = uint32(value.Uint())
+	case "regen.ecocredit.marketplace.v1.ClassRoyalty.recipient":
+		x.Recipient = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.ClassRoyalty"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.ClassRoyalty does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ClassRoyalty) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.ClassRoyalty.class_key":
+		panic(fmt.Errorf("field class_key of message regen.ecocredit.marketplace.v1.ClassRoyalty is not mutable"))
+	case "regen.ecocredit.marketplace.v1.ClassRoyalty.royalty_bps":
+		panic(fmt.Errorf("field royalty_bps of message regen.ecocredit.marketplace.v1.ClassRoyalty is not mutable"))
+	case "regen.ecocredit.marketplace.v1.ClassRoyalty.recipient":
+		panic(fmt.Errorf("field recipient of message regen.ecocredit.marketplace.v1.ClassRoyalty is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.ClassRoyalty"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.ClassRoyalty does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ClassRoyalty) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.ClassRoyalty.class_key":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.ClassRoyalty.royalty_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "regen.ecocredit.marketplace.v1.ClassRoyalty.recipient":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.ClassRoyalty"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.ClassRoyalty does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ClassRoyalty) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.ClassRoyalty", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ClassRoyalty) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ClassRoyalty) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ClassRoyalty) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ClassRoyalty) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ClassRoyalty)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ClassKey != 0 {
+			n += 1 + runtime.Sov(uint64(x.ClassKey))
+		}
+		if x.RoyaltyBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.RoyaltyBps))
+		}
+		l = len(x.Recipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ClassRoyalty)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Recipient) > 0 {
+			i -= len(x.Recipient)
+			copy(dAtA[i:], x.Recipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Recipient)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.RoyaltyBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RoyaltyBps))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.ClassKey != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ClassKey))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ClassRoyalty)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ClassRoyalty: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ClassRoyalty: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
+				}
+				x.ClassKey = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ClassKey |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RoyaltyBps", wireType)
+				}
+				x.RoyaltyBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RoyaltyBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Recipient = append(x.Recipient[:0], dAtA[iNdEx:postIndex]...)
+				if x.Recipient == nil {
+					x.Recipient = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Trade           protoreflect.MessageDescriptor
+	fd_Trade_id        protoreflect.FieldDescriptor
+	fd_Trade_buyer     protoreflect.FieldDescriptor
+	fd_Trade_seller    protoreflect.FieldDescriptor
+	fd_Trade_batch_key protoreflect.FieldDescriptor
+	fd_Trade_market_id protoreflect.FieldDescriptor
+	fd_Trade_quantity  protoreflect.FieldDescriptor
+	fd_Trade_price     protoreflect.FieldDescriptor
+	fd_Trade_timestamp protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_Trade = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("Trade")
+	fd_Trade_id = md_Trade.Fields().ByName("id")
+	fd_Trade_buyer = md_Trade.Fields().ByName("buyer")
+	fd_Trade_seller = md_Trade.Fields().ByName("seller")
+	fd_Trade_batch_key = md_Trade.Fields().ByName("batch_key")
+	fd_Trade_market_id = md_Trade.Fields().ByName("market_id")
+	fd_Trade_quantity = md_Trade.Fields().ByName("quantity")
+	fd_Trade_price = md_Trade.Fields().ByName("price")
+	fd_Trade_timestamp = md_Trade.Fields().ByName("timestamp")
+}
+
+var _ protoreflect.Message = (*fastReflection_Trade)(nil)
+
+type fastReflection_Trade Trade
+
+func (x *Trade) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Trade)(x)
+}
+
+func (x *Trade) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Trade_messageType fastReflection_Trade_messageType
+var _ protoreflect.MessageType = fastReflection_Trade_messageType{}
+
+type fastReflection_Trade_messageType struct{}
+
+func (x fastReflection_Trade_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Trade)(nil)
+}
+func (x fastReflection_Trade_messageType) New() protoreflect.Message {
+	return new(fastReflection_Trade)
+}
+func (x fastReflection_Trade_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Trade
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Trade) Descriptor() protoreflect.MessageDescriptor {
+	return md_Trade
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Trade) Type() protoreflect.MessageType {
+	return _fastReflection_Trade_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Trade) New() protoreflect.Message {
+	return new(fastReflection_Trade)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Trade) Interface() protoreflect.ProtoMessage {
+	return (*Trade)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Trade) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_Trade_id, value) {
+			return
+		}
+	}
+	if len(x.Buyer) != 0 {
+		value := protoreflect.ValueOfBytes(x.Buyer)
+		if !f(fd_Trade_buyer, value) {
+			return
+		}
+	}
+	if len(x.Seller) != 0 {
+		value := protoreflect.ValueOfBytes(x.Seller)
+		if !f(fd_Trade_seller, value) {
+			return
+		}
+	}
+	if x.BatchKey != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BatchKey)
+		if !f(fd_Trade_batch_key, value) {
+			return
+		}
+	}
+	if x.MarketId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MarketId)
+		if !f(fd_Trade_market_id, value) {
+			return
+		}
+	}
+	if x.Quantity != "" {
+		value := protoreflect.ValueOfString(x.Quantity)
+		if !f(fd_Trade_quantity, value) {
+			return
+		}
+	}
+	if x.Price != "" {
+		value := protoreflect.ValueOfString(x.Price)
+		if !f(fd_Trade_price, value) {
+			return
+		}
+	}
+	if x.Timestamp != nil {
+		value := protoreflect.ValueOfMessage(x.Timestamp.ProtoReflect())
+		if !f(fd_Trade_timestamp, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Trade) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Trade.id":
+		return x.Id != uint64(0)
+	case "regen.ecocredit.marketplace.v1.Trade.buyer":
+		return len(x.Buyer) != 0
+	case "regen.ecocredit.marketplace.v1.Trade.seller":
+		return len(x.Seller) != 0
+	case "regen.ecocredit.marketplace.v1.Trade.batch_key":
+		return x.BatchKey != uint64(0)
+	case "regen.ecocredit.marketplace.v1.Trade.market_id":
+		return x.MarketId != uint64(0)
+	case "regen.ecocredit.marketplace.v1.Trade.quantity":
+		return x.Quantity != ""
+	case "regen.ecocredit.marketplace.v1.Trade.price":
+		return x.Price != ""
+	case "regen.ecocredit.marketplace.v1.Trade.timestamp":
+		return x.Timestamp != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Trade"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Trade does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Trade) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Trade.id":
+		x.Id = uint64(0)
+	case "regen.ecocredit.marketplace.v1.Trade.buyer":
+		x.Buyer = nil
+	case "regen.ecocredit.marketplace.v1.Trade.seller":
+		x.Seller = nil
+	case "regen.ecocredit.marketplace.v1.Trade.batch_key":
+		x.BatchKey = uint64(0)
+	case "regen.ecocredit.marketplace.v1.Trade.market_id":
+		x.MarketId = uint64(0)
+	case "regen.ecocredit.marketplace.v1.Trade.quantity":
+		x.Quantity = ""
+	case "regen.ecocredit.marketplace.v1.Trade.price":
+		x.Price = ""
+	case "regen.ecocredit.marketplace.v1.Trade.timestamp":
+		x.Timestamp = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Trade"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Trade does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Trade) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.Trade.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.Trade.buyer":
+		value := x.Buyer
+		return protoreflect.ValueOfBytes(value)
+	case "regen.ecocredit.marketplace.v1.Trade.seller":
+		value := x.Seller
+		return protoreflect.ValueOfBytes(value)
+	case "regen.ecocredit.marketplace.v1.Trade.batch_key":
+		value := x.BatchKey
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.Trade.market_id":
+		value := x.MarketId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.Trade.quantity":
+		value := x.Quantity
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Trade.price":
+		value := x.Price
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Trade.timestamp":
+		value := x.Timestamp
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Trade"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Trade does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Trade) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Trade.id":
+		x.Id = value.Uint()
+	case "regen.ecocredit.marketplace.v1.Trade.buyer":
+		x.Buyer = value.Bytes()
+	case "regen.ecocredit.marketplace.v1.Trade.seller":
+		x.Seller = value.Bytes()
+	case "regen.ecocredit.marketplace.v1.Trade.batch_key":
+		x.BatchKey = value.Uint()
+	case "regen.ecocredit.marketplace.v1.Trade.market_id":
+		x.MarketId = value.Uint()
+	case "regen.ecocredit.marketplace.v1.Trade.quantity":
+		x.Quantity = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Trade.price":
+		x.Price = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Trade.timestamp":
+		x.Timestamp = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Trade"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Trade does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Trade) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Trade.timestamp":
+		if x.Timestamp == nil {
+			x.Timestamp = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Timestamp.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.Trade.id":
+		panic(fmt.Errorf("field id of message regen.ecocredit.marketplace.v1.Trade is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Trade.buyer":
+		panic(fmt.Errorf("field buyer of message regen.ecocredit.marketplace.v1.Trade is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Trade.seller":
+		panic(fmt.Errorf("field seller of message regen.ecocredit.marketplace.v1.Trade is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Trade.batch_key":
+		panic(fmt.Errorf("field batch_key of message regen.ecocredit.marketplace.v1.Trade is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Trade.market_id":
+		panic(fmt.Errorf("field market_id of message regen.ecocredit.marketplace.v1.Trade is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Trade.quantity":
+		panic(fmt.Errorf("field quantity of message regen.ecocredit.marketplace.v1.Trade is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Trade.price":
+		panic(fmt.Errorf("field price of message regen.ecocredit.marketplace.v1.Trade is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Trade"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Trade does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Trade) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Trade.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.Trade.buyer":
+		return protoreflect.ValueOfBytes(nil)
+	case "regen.ecocredit.marketplace.v1.Trade.seller":
+		return protoreflect.ValueOfBytes(nil)
+	case "regen.ecocredit.marketplace.v1.Trade.batch_key":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.Trade.market_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.Trade.quantity":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Trade.price":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Trade.timestamp":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Trade"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Trade does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Trade) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.Trade", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Trade) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Trade) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Trade) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Trade) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Trade)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Buyer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Seller)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BatchKey != 0 {
+			n += 1 + runtime.Sov(uint64(x.BatchKey))
+		}
+		if x.MarketId != 0 {
+			n += 1 + runtime.Sov(uint64(x.MarketId))
+		}
+		l = len(x.Quantity)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Price)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Timestamp != nil {
+			l = options.Size(x.Timestamp)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Trade)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Timestamp != nil {
+			encoded, err := options.Marshal(x.Timestamp)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.Price) > 0 {
+			i -= len(x.Price)
+			copy(dAtA[i:], x.Price)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Price)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Quantity) > 0 {
+			i -= len(x.Quantity)
+			copy(dAtA[i:], x.Quantity)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Quantity)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.MarketId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MarketId))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.BatchKey != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BatchKey))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Seller) > 0 {
+			i -= len(x.Seller)
+			copy(dAtA[i:], x.Seller)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Seller)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Buyer) > 0 {
+			i -= len(x.Buyer)
+			copy(dAtA[i:], x.Buyer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Buyer)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Trade)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Trade: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Trade: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Buyer = append(x.Buyer[:0], dAtA[iNdEx:postIndex]...)
+				if x.Buyer == nil {
+					x.Buyer = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Seller = append(x.Seller[:0], dAtA[iNdEx:postIndex]...)
+				if x.Seller == nil {
+					x.Seller = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+				}
+				x.BatchKey = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BatchKey |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				}
+				x.MarketId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MarketId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Quantity = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Price = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Timestamp == nil {
+					x.Timestamp = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Timestamp); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Auction                                     protoreflect.MessageDescriptor
+	fd_Auction_id                                  protoreflect.FieldDescriptor
+	fd_Auction_seller                              protoreflect.FieldDescriptor
+	fd_Auction_batch_key                           protoreflect.FieldDescriptor
+	fd_Auction_quantity                            protoreflect.FieldDescriptor
+	fd_Auction_market_id                           protoreflect.FieldDescriptor
+	fd_Auction_auction_type                        protoreflect.FieldDescriptor
+	fd_Auction_start_amount                        protoreflect.FieldDescriptor
+	fd_Auction_end_amount                          protoreflect.FieldDescriptor
+	fd_Auction_start_time                          protoreflect.FieldDescriptor
+	fd_Auction_end_time                            protoreflect.FieldDescriptor
+	fd_Auction_disable_auto_retire                 protoreflect.FieldDescriptor
+	fd_Auction_highest_bidder                      protoreflect.FieldDescriptor
+	fd_Auction_highest_bid_amount                  protoreflect.FieldDescriptor
+	fd_Auction_highest_bid_disable_auto_retire     protoreflect.FieldDescriptor
+	fd_Auction_highest_bid_retirement_jurisdiction protoreflect.FieldDescriptor
+	fd_Auction_highest_bid_retirement_reason       protoreflect.FieldDescriptor
+	fd_Auction_highest_bid_retirement_beneficiary  protoreflect.FieldDescriptor
+	fd_Auction_highest_bid_escrowed_fee_bps        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_Auction = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("Auction")
+	fd_Auction_id = md_Auction.Fields().ByName("id")
+	fd_Auction_seller = md_Auction.Fields().ByName("seller")
+	fd_Auction_batch_key = md_Auction.Fields().ByName("batch_key")
+	fd_Auction_quantity = md_Auction.Fields().ByName("quantity")
+	fd_Auction_market_id = md_Auction.Fields().ByName("market_id")
+	fd_Auction_auction_type = md_Auction.Fields().ByName("auction_type")
+	fd_Auction_start_amount = md_Auction.Fields().ByName("start_amount")
+	fd_Auction_end_amount = md_Auction.Fields().ByName("end_amount")
+	fd_Auction_start_time = md_Auction.Fields().ByName("start_time")
+	fd_Auction_end_time = md_Auction.Fields().ByName("end_time")
+	fd_Auction_disable_auto_retire = md_Auction.Fields().ByName("disable_auto_retire")
+	fd_Auction_highest_bidder = md_Auction.Fields().ByName("highest_bidder")
+	fd_Auction_highest_bid_amount = md_Auction.Fields().ByName("highest_bid_amount")
+	fd_Auction_highest_bid_disable_auto_retire = md_Auction.Fields().ByName("highest_bid_disable_auto_retire")
+	fd_Auction_highest_bid_retirement_jurisdiction = md_Auction.Fields().ByName("highest_bid_retirement_jurisdiction")
+	fd_Auction_highest_bid_retirement_reason = md_Auction.Fields().ByName("highest_bid_retirement_reason")
+	fd_Auction_highest_bid_retirement_beneficiary = md_Auction.Fields().ByName("highest_bid_retirement_beneficiary")
+	fd_Auction_highest_bid_escrowed_fee_bps = md_Auction.Fields().ByName("highest_bid_escrowed_fee_bps")
+}
+
+var _ protoreflect.Message = (*fastReflection_Auction)(nil)
+
+type fastReflection_Auction Auction
+
+func (x *Auction) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Auction)(x)
+}
+
+func (x *Auction) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Auction_messageType fastReflection_Auction_messageType
+var _ protoreflect.MessageType = fastReflection_Auction_messageType{}
+
+type fastReflection_Auction_messageType struct{}
+
+func (x fastReflection_Auction_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Auction)(nil)
+}
+func (x fastReflection_Auction_messageType) New() protoreflect.Message {
+	return new(fastReflection_Auction)
+}
+func (x fastReflection_Auction_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Auction
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Auction) Descriptor() protoreflect.MessageDescriptor {
+	return md_Auction
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Auction) Type() protoreflect.MessageType {
+	return _fastReflection_Auction_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Auction) New() protoreflect.Message {
+	return new(fastReflection_Auction)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Auction) Interface() protoreflect.ProtoMessage {
+	return (*Auction)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Auction) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_Auction_id, value) {
+			return
+		}
+	}
+	if len(x.Seller) != 0 {
+		value := protoreflect.ValueOfBytes(x.Seller)
+		if !f(fd_Auction_seller, value) {
+			return
+		}
+	}
+	if x.BatchKey != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BatchKey)
+		if !f(fd_Auction_batch_key, value) {
+			return
+		}
+	}
+	if x.Quantity != "" {
+		value := protoreflect.ValueOfString(x.Quantity)
+		if !f(fd_Auction_quantity, value) {
+			return
+		}
+	}
+	if x.MarketId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MarketId)
+		if !f(fd_Auction_market_id, value) {
+			return
+		}
+	}
+	if x.AuctionType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.AuctionType))
+		if !f(fd_Auction_auction_type, value) {
+			return
+		}
+	}
+	if x.StartAmount != "" {
+		value := protoreflect.ValueOfString(x.StartAmount)
+		if !f(fd_Auction_start_amount, value) {
+			return
+		}
+	}
+	if x.EndAmount != "" {
+		value := protoreflect.ValueOfString(x.EndAmount)
+		if !f(fd_Auction_end_amount, value) {
+			return
+		}
+	}
+	if x.StartTime != nil {
+		value := protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+		if !f(fd_Auction_start_time, value) {
+			return
+		}
+	}
+	if x.EndTime != nil {
+		value := protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+		if !f(fd_Auction_end_time, value) {
+			return
+		}
+	}
+	if x.DisableAutoRetire != false {
+		value := protoreflect.ValueOfBool(x.DisableAutoRetire)
+		if !f(fd_Auction_disable_auto_retire, value) {
+			return
+		}
+	}
+	if len(x.HighestBidder) != 0 {
+		value := protoreflect.ValueOfBytes(x.HighestBidder)
+		if !f(fd_Auction_highest_bidder, value) {
+			return
+		}
+	}
+	if x.HighestBidAmount != "" {
+		value := protoreflect.ValueOfString(x.HighestBidAmount)
+		if !f(fd_Auction_highest_bid_amount, value) {
+			return
+		}
+	}
+	if x.HighestBidDisableAutoRetire != false {
+		value := protoreflect.ValueOfBool(x.HighestBidDisableAutoRetire)
+		if !f(fd_Auction_highest_bid_disable_auto_retire, value) {
+			return
+		}
+	}
+	if x.HighestBidRetirementJurisdiction != "" {
+		value := protoreflect.ValueOfString(x.HighestBidRetirementJurisdiction)
+		if !f(fd_Auction_highest_bid_retirement_jurisdiction, value) {
+			return
+		}
+	}
+	if x.HighestBidRetirementReason != "" {
+		value := protoreflect.ValueOfString(x.HighestBidRetirementReason)
+		if !f(fd_Auction_highest_bid_retirement_reason, value) {
+			return
+		}
+	}
+	if x.HighestBidRetirementBeneficiary != "" {
+		value := protoreflect.ValueOfString(x.HighestBidRetirementBeneficiary)
+		if !f(fd_Auction_highest_bid_retirement_beneficiary, value) {
+			return
+		}
+	}
+	if x.HighestBidEscrowedFeeBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.HighestBidEscrowedFeeBps)
+		if !f(fd_Auction_highest_bid_escrowed_fee_bps, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Auction) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Auction.id":
+		return x.Id != uint64(0)
+	case "regen.ecocredit.marketplace.v1.Auction.seller":
+		return len(x.Seller) != 0
+	case "regen.ecocredit.marketplace.v1.Auction.batch_key":
+		return x.BatchKey != uint64(0)
+	case "regen.ecocredit.marketplace.v1.Auction.quantity":
+		return x.Quantity != ""
+	case "regen.ecocredit.marketplace.v1.Auction.market_id":
+		return x.MarketId != uint64(0)
+	case "regen.ecocredit.marketplace.v1.Auction.auction_type":
+		return x.AuctionType != 0
+	case "regen.ecocredit.marketplace.v1.Auction.start_amount":
+		return x.StartAmount != ""
+	case "regen.ecocredit.marketplace.v1.Auction.end_amount":
+		return x.EndAmount != ""
+	case "regen.ecocredit.marketplace.v1.Auction.start_time":
+		return x.StartTime != nil
+	case "regen.ecocredit.marketplace.v1.Auction.end_time":
+		return x.EndTime != nil
+	case "regen.ecocredit.marketplace.v1.Auction.disable_auto_retire":
+		return x.DisableAutoRetire != false
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bidder":
+		return len(x.HighestBidder) != 0
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bid_amount":
+		return x.HighestBidAmount != ""
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bid_disable_auto_retire":
+		return x.HighestBidDisableAutoRetire != false
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bid_retirement_jurisdiction":
+		return x.HighestBidRetirementJurisdiction != ""
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bid_retirement_reason":
+		return x.HighestBidRetirementReason != ""
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bid_retirement_beneficiary":
+		return x.HighestBidRetirementBeneficiary != ""
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bid_escrowed_fee_bps":
+		return x.HighestBidEscrowedFeeBps != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Auction"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Auction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Auction) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Auction.id":
+		x.Id = uint64(0)
+	case "regen.ecocredit.marketplace.v1.Auction.seller":
+		x.Seller = nil
+	case "regen.ecocredit.marketplace.v1.Auction.batch_key":
+		x.BatchKey = uint64(0)
+	case "regen.ecocredit.marketplace.v1.Auction.quantity":
+		x.Quantity = ""
+	case "regen.ecocredit.marketplace.v1.Auction.market_id":
+		x.MarketId = uint64(0)
+	case "regen.ecocredit.marketplace.v1.Auction.auction_type":
+		x.AuctionType = 0
+	case "regen.ecocredit.marketplace.v1.Auction.start_amount":
+		x.StartAmount = ""
+	case "regen.ecocredit.marketplace.v1.Auction.end_amount":
+		x.EndAmount = ""
+	case "regen.ecocredit.marketplace.v1.Auction.start_time":
+		x.StartTime = nil
+	case "regen.ecocredit.marketplace.v1.Auction.end_time":
+		x.EndTime = nil
+	case "regen.ecocredit.marketplace.v1.Auction.disable_auto_retire":
+		x.DisableAutoRetire = false
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bidder":
+		x.HighestBidder = nil
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bid_amount":
+		x.HighestBidAmount = ""
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bid_disable_auto_retire":
+		x.HighestBidDisableAutoRetire = false
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bid_retirement_jurisdiction":
+		x.HighestBidRetirementJurisdiction = ""
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bid_retirement_reason":
+		x.HighestBidRetirementReason = ""
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bid_retirement_beneficiary":
+		x.HighestBidRetirementBeneficiary = ""
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bid_escrowed_fee_bps":
+		x.HighestBidEscrowedFeeBps = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Auction"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Auction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Auction) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.Auction.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.Auction.seller":
+		value := x.Seller
+		return protoreflect.ValueOfBytes(value)
+	case "regen.ecocredit.marketplace.v1.Auction.batch_key":
+		value := x.BatchKey
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.Auction.quantity":
+		value := x.Quantity
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Auction.market_id":
+		value := x.MarketId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.Auction.auction_type":
+		value := x.AuctionType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "regen.ecocredit.marketplace.v1.Auction.start_amount":
+		value := x.StartAmount
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Auction.end_amount":
+		value := x.EndAmount
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Auction.start_time":
+		value := x.StartTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.Auction.end_time":
+		value := x.EndTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.Auction.disable_auto_retire":
+		value := x.DisableAutoRetire
+		return protoreflect.ValueOfBool(value)
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bidder":
+		value := x.HighestBidder
+		return protoreflect.ValueOfBytes(value)
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bid_amount":
+		value := x.HighestBidAmount
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bid_disable_auto_retire":
+		value := x.HighestBidDisableAutoRetire
+		return protoreflect.ValueOfBool(value)
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bid_retirement_jurisdiction":
+		value := x.HighestBidRetirementJurisdiction
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bid_retirement_reason":
+		value := x.HighestBidRetirementReason
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bid_retirement_beneficiary":
+		value := x.HighestBidRetirementBeneficiary
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bid_escrowed_fee_bps":
+		value := x.HighestBidEscrowedFeeBps
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Auction"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Auction does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Auction) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Auction.id":
+		x.Id = value.Uint()
+	case "regen.ecocredit.marketplace.v1.Auction.seller":
+		x.Seller = value.Bytes()
+	case "regen.ecocredit.marketplace.v1.Auction.batch_key":
+		x.BatchKey = value.Uint()
+	case "regen.ecocredit.marketplace.v1.Auction.quantity":
+		x.Quantity = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Auction.market_id":
+		x.MarketId = value.Uint()
+	case "regen.ecocredit.marketplace.v1.Auction.auction_type":
+		x.AuctionType = (AuctionType)(value.Enum())
+	case "regen.ecocredit.marketplace.v1.Auction.start_amount":
+		x.StartAmount = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Auction.end_amount":
+		x.EndAmount = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Auction.start_time":
+		x.StartTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "regen.ecocredit.marketplace.v1.Auction.end_time":
+		x.EndTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "regen.ecocredit.marketplace.v1.Auction.disable_auto_retire":
+		x.DisableAutoRetire = value.Bool()
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bidder":
+		x.HighestBidder = value.Bytes()
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bid_amount":
+		x.HighestBidAmount = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bid_disable_auto_retire":
+		x.HighestBidDisableAutoRetire = value.Bool()
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bid_retirement_jurisdiction":
+		x.HighestBidRetirementJurisdiction = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bid_retirement_reason":
+		x.HighestBidRetirementReason = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bid_retirement_beneficiary":
+		x.HighestBidRetirementBeneficiary = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bid_escrowed_fee_bps":
+		x.HighestBidEscrowedFeeBps = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Auction"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Auction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Auction) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Auction.start_time":
+		if x.StartTime == nil {
+			x.StartTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.Auction.end_time":
+		if x.EndTime == nil {
+			x.EndTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.Auction.id":
+		panic(fmt.Errorf("field id of message regen.ecocredit.marketplace.v1.Auction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Auction.seller":
+		panic(fmt.Errorf("field seller of message regen.ecocredit.marketplace.v1.Auction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Auction.batch_key":
+		panic(fmt.Errorf("field batch_key of message regen.ecocredit.marketplace.v1.Auction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Auction.quantity":
+		panic(fmt.Errorf("field quantity of message regen.ecocredit.marketplace.v1.Auction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Auction.market_id":
+		panic(fmt.Errorf("field market_id of message regen.ecocredit.marketplace.v1.Auction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Auction.auction_type":
+		panic(fmt.Errorf("field auction_type of message regen.ecocredit.marketplace.v1.Auction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Auction.start_amount":
+		panic(fmt.Errorf("field start_amount of message regen.ecocredit.marketplace.v1.Auction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Auction.end_amount":
+		panic(fmt.Errorf("field end_amount of message regen.ecocredit.marketplace.v1.Auction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Auction.disable_auto_retire":
+		panic(fmt.Errorf("field disable_auto_retire of message regen.ecocredit.marketplace.v1.Auction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bidder":
+		panic(fmt.Errorf("field highest_bidder of message regen.ecocredit.marketplace.v1.Auction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bid_amount":
+		panic(fmt.Errorf("field highest_bid_amount of message regen.ecocredit.marketplace.v1.Auction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bid_disable_auto_retire":
+		panic(fmt.Errorf("field highest_bid_disable_auto_retire of message regen.ecocredit.marketplace.v1.Auction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bid_retirement_jurisdiction":
+		panic(fmt.Errorf("field highest_bid_retirement_jurisdiction of message regen.ecocredit.marketplace.v1.Auction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bid_retirement_reason":
+		panic(fmt.Errorf("field highest_bid_retirement_reason of message regen.ecocredit.marketplace.v1.Auction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bid_retirement_beneficiary":
+		panic(fmt.Errorf("field highest_bid_retirement_beneficiary of message regen.ecocredit.marketplace.v1.Auction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bid_escrowed_fee_bps":
+		panic(fmt.Errorf("field highest_bid_escrowed_fee_bps of message regen.ecocredit.marketplace.v1.Auction is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Auction"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Auction does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Auction) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Auction.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.Auction.seller":
+		return protoreflect.ValueOfBytes(nil)
+	case "regen.ecocredit.marketplace.v1.Auction.batch_key":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.Auction.quantity":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Auction.market_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.Auction.auction_type":
+		return protoreflect.ValueOfEnum(0)
+	case "regen.ecocredit.marketplace.v1.Auction.start_amount":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Auction.end_amount":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Auction.start_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.Auction.end_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.Auction.disable_auto_retire":
+		return protoreflect.ValueOfBool(false)
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bidder":
+		return protoreflect.ValueOfBytes(nil)
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bid_amount":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bid_disable_auto_retire":
+		return protoreflect.ValueOfBool(false)
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bid_retirement_jurisdiction":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bid_retirement_reason":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bid_retirement_beneficiary":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Auction.highest_bid_escrowed_fee_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Auction"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Auction does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Auction) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.Auction", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Auction) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Auction) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Auction) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Auction) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Auction)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Seller)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BatchKey != 0 {
+			n += 1 + runtime.Sov(uint64(x.BatchKey))
+		}
+		l = len(x.Quantity)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MarketId != 0 {
+			n += 1 + runtime.Sov(uint64(x.MarketId))
+		}
+		if x.AuctionType != 0 {
+			n += 1 + runtime.Sov(uint64(x.AuctionType))
+		}
+		l = len(x.StartAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EndAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.StartTime != nil {
+			l = options.Size(x.StartTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EndTime != nil {
+			l = options.Size(x.EndTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DisableAutoRetire {
+			n += 2
+		}
+		l = len(x.HighestBidder)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.HighestBidAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.HighestBidDisableAutoRetire {
+			n += 2
+		}
+		l = len(x.HighestBidRetirementJurisdiction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.HighestBidRetirementReason)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.HighestBidRetirementBeneficiary)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.HighestBidEscrowedFeeBps != 0 {
+			n += 2 + runtime.Sov(uint64(x.HighestBidEscrowedFeeBps))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Auction)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.HighestBidEscrowedFeeBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HighestBidEscrowedFeeBps))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x90
+		}
+		if len(x.HighestBidRetirementBeneficiary) > 0 {
+			i -= len(x.HighestBidRetirementBeneficiary)
+			copy(dAtA[i:], x.HighestBidRetirementBeneficiary)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HighestBidRetirementBeneficiary)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if len(x.HighestBidRetirementReason) > 0 {
+			i -= len(x.HighestBidRetirementReason)
+			copy(dAtA[i:], x.HighestBidRetirementReason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HighestBidRetirementReason)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if len(x.HighestBidRetirementJurisdiction) > 0 {
+			i -= len(x.HighestBidRetirementJurisdiction)
+			copy(dAtA[i:], x.HighestBidRetirementJurisdiction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HighestBidRetirementJurisdiction)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if x.HighestBidDisableAutoRetire {
+			i--
+			if x.HighestBidDisableAutoRetire {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x70
+		}
+		if len(x.HighestBidAmount) > 0 {
+			i -= len(x.HighestBidAmount)
+			copy(dAtA[i:], x.HighestBidAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HighestBidAmount)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.HighestBidder) > 0 {
+			i -= len(x.HighestBidder)
+			copy(dAtA[i:], x.HighestBidder)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HighestBidder)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.DisableAutoRetire {
+			i--
+			if x.DisableAutoRetire {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.EndTime != nil {
+			encoded, err := options.Marshal(x.EndTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.StartTime != nil {
+			encoded, err := options.Marshal(x.StartTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.EndAmount) > 0 {
+			i -= len(x.EndAmount)
+			copy(dAtA[i:], x.EndAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EndAmount)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.StartAmount) > 0 {
+			i -= len(x.StartAmount)
+			copy(dAtA[i:], x.StartAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StartAmount)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.AuctionType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AuctionType))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.MarketId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MarketId))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Quantity) > 0 {
+			i -= len(x.Quantity)
+			copy(dAtA[i:], x.Quantity)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Quantity)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.BatchKey != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BatchKey))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Seller) > 0 {
+			i -= len(x.Seller)
+			copy(dAtA[i:], x.Seller)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Seller)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Auction)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Auction: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Auction: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Seller = append(x.Seller[:0], dAtA[iNdEx:postIndex]...)
+				if x.Seller == nil {
+					x.Seller = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+				}
+				x.BatchKey = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BatchKey |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Quantity = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				}
+				x.MarketId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MarketId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionType", wireType)
+				}
+				x.AuctionType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AuctionType |= AuctionType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StartAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EndAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.StartTime == nil {
+					x.StartTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StartTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EndTime == nil {
+					x.EndTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EndTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.DisableAutoRetire = bool(v != 0)
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HighestBidder", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HighestBidder = append(x.HighestBidder[:0], dAtA[iNdEx:postIndex]...)
+				if x.HighestBidder == nil {
+					x.HighestBidder = []byte{}
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HighestBidAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HighestBidAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HighestBidDisableAutoRetire", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.HighestBidDisableAutoRetire = bool(v != 0)
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HighestBidRetirementJurisdiction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HighestBidRetirementJurisdiction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HighestBidRetirementReason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HighestBidRetirementReason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HighestBidRetirementBeneficiary", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HighestBidRetirementBeneficiary = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 18:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HighestBidEscrowedFeeBps", wireType)
+				}
+				x.HighestBidEscrowedFeeBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HighestBidEscrowedFeeBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Offer                         protoreflect.MessageDescriptor
+	fd_Offer_id                      protoreflect.FieldDescriptor
+	fd_Offer_sell_order_id           protoreflect.FieldDescriptor
+	fd_Offer_buyer                   protoreflect.FieldDescriptor
+	fd_Offer_quantity                protoreflect.FieldDescriptor
+	fd_Offer_market_id               protoreflect.FieldDescriptor
+	fd_Offer_bid_amount              protoreflect.FieldDescriptor
+	fd_Offer_counter_amount          protoreflect.FieldDescriptor
+	fd_Offer_disable_auto_retire     protoreflect.FieldDescriptor
+	fd_Offer_retirement_jurisdiction protoreflect.FieldDescriptor
+	fd_Offer_expiration              protoreflect.FieldDescriptor
+	fd_Offer_retirement_reason       protoreflect.FieldDescriptor
+	fd_Offer_retirement_beneficiary  protoreflect.FieldDescriptor
+	fd_Offer_escrowed_fee_bps        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_Offer = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("Offer")
+	fd_Offer_id = md_Offer.Fields().ByName("id")
+	fd_Offer_sell_order_id = md_Offer.Fields().ByName("sell_order_id")
+	fd_Offer_buyer = md_Offer.Fields().ByName("buyer")
+	fd_Offer_quantity = md_Offer.Fields().ByName("quantity")
+	fd_Offer_market_id = md_Offer.Fields().ByName("market_id")
+	fd_Offer_bid_amount = md_Offer.Fields().ByName("bid_amount")
+	fd_Offer_counter_amount = md_Offer.Fields().ByName("counter_amount")
+	fd_Offer_disable_auto_retire = md_Offer.Fields().ByName("disable_auto_retire")
+	fd_Offer_retirement_jurisdiction = md_Offer.Fields().ByName("retirement_jurisdiction")
+	fd_Offer_expiration = md_Offer.Fields().ByName("expiration")
+	fd_Offer_retirement_reason = md_Offer.Fields().ByName("retirement_reason")
+	fd_Offer_retirement_beneficiary = md_Offer.Fields().ByName("retirement_beneficiary")
+	fd_Offer_escrowed_fee_bps = md_Offer.Fields().ByName("escrowed_fee_bps")
+}
+
+var _ protoreflect.Message = (*fastReflection_Offer)(nil)
+
+type fastReflection_Offer Offer
+
+func (x *Offer) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Offer)(x)
+}
+
+func (x *Offer) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Offer_messageType fastReflection_Offer_messageType
+var _ protoreflect.MessageType = fastReflection_Offer_messageType{}
+
+type fastReflection_Offer_messageType struct{}
+
+func (x fastReflection_Offer_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Offer)(nil)
+}
+func (x fastReflection_Offer_messageType) New() protoreflect.Message {
+	return new(fastReflection_Offer)
+}
+func (x fastReflection_Offer_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Offer
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Offer) Descriptor() protoreflect.MessageDescriptor {
+	return md_Offer
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Offer) Type() protoreflect.MessageType {
+	return _fastReflection_Offer_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Offer) New() protoreflect.Message {
+	return new(fastReflection_Offer)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Offer) Interface() protoreflect.ProtoMessage {
+	return (*Offer)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Offer) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_Offer_id, value) {
+			return
+		}
+	}
+	if x.SellOrderId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SellOrderId)
+		if !f(fd_Offer_sell_order_id, value) {
+			return
+		}
+	}
+	if len(x.Buyer) != 0 {
+		value := protoreflect.ValueOfBytes(x.Buyer)
+		if !f(fd_Offer_buyer, value) {
+			return
+		}
+	}
+	if x.Quantity != "" {
+		value := protoreflect.ValueOfString(x.Quantity)
+		if !f(fd_Offer_quantity, value) {
+			return
+		}
+	}
+	if x.MarketId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MarketId)
+		if !f(fd_Offer_market_id, value) {
+			return
+		}
+	}
+	if x.BidAmount != "" {
+		value := protoreflect.ValueOfString(x.BidAmount)
+		if !f(fd_Offer_bid_amount, value) {
+			return
+		}
+	}
+	if x.CounterAmount != "" {
+		value := protoreflect.ValueOfString(x.CounterAmount)
+		if !f(fd_Offer_counter_amount, value) {
+			return
+		}
+	}
+	if x.DisableAutoRetire != false {
+		value := protoreflect.ValueOfBool(x.DisableAutoRetire)
+		if !f(fd_Offer_disable_auto_retire, value) {
+			return
+		}
+	}
+	if x.RetirementJurisdiction != "" {
+		value := protoreflect.ValueOfString(x.RetirementJurisdiction)
+		if !f(fd_Offer_retirement_jurisdiction, value) {
+			return
+		}
+	}
+	if x.Expiration != nil {
+		value := protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+		if !f(fd_Offer_expiration, value) {
+			return
+		}
+	}
+	if x.RetirementReason != "" {
+		value := protoreflect.ValueOfString(x.RetirementReason)
+		if !f(fd_Offer_retirement_reason, value) {
+			return
+		}
+	}
+	if x.RetirementBeneficiary != "" {
+		value := protoreflect.ValueOfString(x.RetirementBeneficiary)
+		if !f(fd_Offer_retirement_beneficiary, value) {
+			return
+		}
+	}
+	if x.EscrowedFeeBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.EscrowedFeeBps)
+		if !f(fd_Offer_escrowed_fee_bps, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Offer) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Offer.id":
+		return x.Id != uint64(0)
+	case "regen.ecocredit.marketplace.v1.Offer.sell_order_id":
+		return x.SellOrderId != uint64(0)
+	case "regen.ecocredit.marketplace.v1.Offer.buyer":
+		return len(x.Buyer) != 0
+	case "regen.ecocredit.marketplace.v1.Offer.quantity":
+		return x.Quantity != ""
+	case "regen.ecocredit.marketplace.v1.Offer.market_id":
+		return x.MarketId != uint64(0)
+	case "regen.ecocredit.marketplace.v1.Offer.bid_amount":
+		return x.BidAmount != ""
+	case "regen.ecocredit.marketplace.v1.Offer.counter_amount":
+		return x.CounterAmount != ""
+	case "regen.ecocredit.marketplace.v1.Offer.disable_auto_retire":
+		return x.DisableAutoRetire != false
+	case "regen.ecocredit.marketplace.v1.Offer.retirement_jurisdiction":
+		return x.RetirementJurisdiction != ""
+	case "regen.ecocredit.marketplace.v1.Offer.expiration":
+		return x.Expiration != nil
+	case "regen.ecocredit.marketplace.v1.Offer.retirement_reason":
+		return x.RetirementReason != ""
+	case "regen.ecocredit.marketplace.v1.Offer.retirement_beneficiary":
+		return x.RetirementBeneficiary != ""
+	case "regen.ecocredit.marketplace.v1.Offer.escrowed_fee_bps":
+		return x.EscrowedFeeBps != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Offer"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Offer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Offer) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Offer.id":
+		x.Id = uint64(0)
+	case "regen.ecocredit.marketplace.v1.Offer.sell_order_id":
+		x.SellOrderId = uint64(0)
+	case "regen.ecocredit.marketplace.v1.Offer.buyer":
+		x.Buyer = nil
+	case "regen.ecocredit.marketplace.v1.Offer.quantity":
+		x.Quantity = ""
+	case "regen.ecocredit.marketplace.v1.Offer.market_id":
+		x.MarketId = uint64(0)
+	case "regen.ecocredit.marketplace.v1.Offer.bid_amount":
+		x.BidAmount = ""
+	case "regen.ecocredit.marketplace.v1.Offer.counter_amount":
+		x.CounterAmount = ""
+	case "regen.ecocredit.marketplace.v1.Offer.disable_auto_retire":
+		x.DisableAutoRetire = false
+	case "regen.ecocredit.marketplace.v1.Offer.retirement_jurisdiction":
+		x.RetirementJurisdiction = ""
+	case "regen.ecocredit.marketplace.v1.Offer.expiration":
+		x.Expiration = nil
+	case "regen.ecocredit.marketplace.v1.Offer.retirement_reason":
+		x.RetirementReason = ""
+	case "regen.ecocredit.marketplace.v1.Offer.retirement_beneficiary":
+		x.RetirementBeneficiary = ""
+	case "regen.ecocredit.marketplace.v1.Offer.escrowed_fee_bps":
+		x.EscrowedFeeBps = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Offer"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Offer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Offer) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.Offer.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.Offer.sell_order_id":
+		value := x.SellOrderId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.Offer.buyer":
+		value := x.Buyer
+		return protoreflect.ValueOfBytes(value)
+	case "regen.ecocredit.marketplace.v1.Offer.quantity":
+		value := x.Quantity
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Offer.market_id":
+		value := x.MarketId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.Offer.bid_amount":
+		value := x.BidAmount
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Offer.counter_amount":
+		value := x.CounterAmount
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Offer.disable_auto_retire":
+		value := x.DisableAutoRetire
+		return protoreflect.ValueOfBool(value)
+	case "regen.ecocredit.marketplace.v1.Offer.retirement_jurisdiction":
+		value := x.RetirementJurisdiction
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Offer.expiration":
+		value := x.Expiration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.Offer.retirement_reason":
+		value := x.RetirementReason
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Offer.retirement_beneficiary":
+		value := x.RetirementBeneficiary
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Offer.escrowed_fee_bps":
+		value := x.EscrowedFeeBps
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Offer"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Offer does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Offer) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Offer.id":
+		x.Id = value.Uint()
+	case "regen.ecocredit.marketplace.v1.Offer.sell_order_id":
+		x.SellOrderId = value.Uint()
+	case "regen.ecocredit.marketplace.v1.Offer.buyer":
+		x.Buyer = value.Bytes()
+	case "regen.ecocredit.marketplace.v1.Offer.quantity":
+		x.Quantity = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Offer.market_id":
+		x.MarketId = value.Uint()
+	case "regen.ecocredit.marketplace.v1.Offer.bid_amount":
+		x.BidAmount = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Offer.counter_amount":
+		x.CounterAmount = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Offer.disable_auto_retire":
+		x.DisableAutoRetire = value.Bool()
+	case "regen.ecocredit.marketplace.v1.Offer.retirement_jurisdiction":
+		x.RetirementJurisdiction = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Offer.expiration":
+		x.Expiration = value.Message().Interface().(*timestamppb.Timestamp)
+	case "regen.ecocredit.marketplace.v1.Offer.retirement_reason":
+		x.RetirementReason = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Offer.retirement_beneficiary":
+		x.RetirementBeneficiary = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Offer.escrowed_fee_bps":
+		x.EscrowedFeeBps = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Offer"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Offer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Offer) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Offer.expiration":
+		if x.Expiration == nil {
+			x.Expiration = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.Offer.id":
+		panic(fmt.Errorf("field id of message regen.ecocredit.marketplace.v1.Offer is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Offer.sell_order_id":
+		panic(fmt.Errorf("field sell_order_id of message regen.ecocredit.marketplace.v1.Offer is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Offer.buyer":
+		panic(fmt.Errorf("field buyer of message regen.ecocredit.marketplace.v1.Offer is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Offer.quantity":
+		panic(fmt.Errorf("field quantity of message regen.ecocredit.marketplace.v1.Offer is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Offer.market_id":
+		panic(fmt.Errorf("field market_id of message regen.ecocredit.marketplace.v1.Offer is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Offer.bid_amount":
+		panic(fmt.Errorf("field bid_amount of message regen.ecocredit.marketplace.v1.Offer is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Offer.counter_amount":
+		panic(fmt.Errorf("field counter_amount of message regen.ecocredit.marketplace.v1.Offer is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Offer.disable_auto_retire":
+		panic(fmt.Errorf("field disable_auto_retire of message regen.ecocredit.marketplace.v1.Offer is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Offer.retirement_jurisdiction":
+		panic(fmt.Errorf("field retirement_jurisdiction of message regen.ecocredit.marketplace.v1.Offer is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Offer.retirement_reason":
+		panic(fmt.Errorf("field retirement_reason of message regen.ecocredit.marketplace.v1.Offer is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Offer.retirement_beneficiary":
+		panic(fmt.Errorf("field retirement_beneficiary of message regen.ecocredit.marketplace.v1.Offer is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Offer.escrowed_fee_bps":
+		panic(fmt.Errorf("field escrowed_fee_bps of message regen.ecocredit.marketplace.v1.Offer is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Offer"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Offer does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Offer) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Offer.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.Offer.sell_order_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.Offer.buyer":
+		return protoreflect.ValueOfBytes(nil)
+	case "regen.ecocredit.marketplace.v1.Offer.quantity":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Offer.market_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.Offer.bid_amount":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Offer.counter_amount":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Offer.disable_auto_retire":
+		return protoreflect.ValueOfBool(false)
+	case "regen.ecocredit.marketplace.v1.Offer.retirement_jurisdiction":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Offer.expiration":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.Offer.retirement_reason":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Offer.retirement_beneficiary":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Offer.escrowed_fee_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Offer"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Offer does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Offer) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.Offer", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Offer) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Offer) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Offer) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Offer) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Offer)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.SellOrderId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SellOrderId))
+		}
+		l = len(x.Buyer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Quantity)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MarketId != 0 {
+			n += 1 + runtime.Sov(uint64(x.MarketId))
+		}
+		l = len(x.BidAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CounterAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DisableAutoRetire {
+			n += 2
+		}
+		l = len(x.RetirementJurisdiction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Expiration != nil {
+			l = options.Size(x.Expiration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RetirementReason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RetirementBeneficiary)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EscrowedFeeBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.EscrowedFeeBps))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Offer)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.EscrowedFeeBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EscrowedFeeBps))
+			i--
+			dAtA[i] = 0x68
+		}
+		if len(x.RetirementBeneficiary) > 0 {
+			i -= len(x.RetirementBeneficiary)
+			copy(dAtA[i:], x.RetirementBeneficiary)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RetirementBeneficiary)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.RetirementReason) > 0 {
+			i -= len(x.RetirementReason)
+			copy(dAtA[i:], x.RetirementReason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RetirementReason)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.Expiration != nil {
+			encoded, err := options.Marshal(x.Expiration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.RetirementJurisdiction) > 0 {
+			i -= len(x.RetirementJurisdiction)
+			copy(dAtA[i:], x.RetirementJurisdiction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RetirementJurisdiction)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.DisableAutoRetire {
+			i--
+			if x.DisableAutoRetire {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.CounterAmount) > 0 {
+			i -= len(x.CounterAmount)
+			copy(dAtA[i:], x.CounterAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CounterAmount)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.BidAmount) > 0 {
+			i -= len(x.BidAmount)
+			copy(dAtA[i:], x.BidAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BidAmount)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.MarketId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MarketId))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Quantity) > 0 {
+			i -= len(x.Quantity)
+			copy(dAtA[i:], x.Quantity)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Quantity)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Buyer) > 0 {
+			i -= len(x.Buyer)
+			copy(dAtA[i:], x.Buyer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Buyer)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.SellOrderId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SellOrderId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Offer)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Offer: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Offer: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SellOrderId", wireType)
+				}
+				x.SellOrderId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SellOrderId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Buyer = append(x.Buyer[:0], dAtA[iNdEx:postIndex]...)
+				if x.Buyer == nil {
+					x.Buyer = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Quantity = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				}
+				x.MarketId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MarketId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BidAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BidAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CounterAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CounterAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.DisableAutoRetire = bool(v != 0)
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetirementJurisdiction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RetirementJurisdiction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Expiration == nil {
+					x.Expiration = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetirementReason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RetirementReason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetirementBeneficiary", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RetirementBeneficiary = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EscrowedFeeBps", wireType)
+				}
+				x.EscrowedFeeBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EscrowedFeeBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Swap_6_list)(nil)
+
+type _Swap_6_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Swap_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Swap_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Swap_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Swap_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Swap_6_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Swap_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Swap_6_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Swap_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Swap                 protoreflect.MessageDescriptor
+	fd_Swap_id              protoreflect.FieldDescriptor
+	fd_Swap_proposer        protoreflect.FieldDescriptor
+	fd_Swap_counterparty    protoreflect.FieldDescriptor
+	fd_Swap_offer_batch_key protoreflect.FieldDescriptor
+	fd_Swap_offer_quantity  protoreflect.FieldDescriptor
+	fd_Swap_offer_coins     protoreflect.FieldDescriptor
+	fd_Swap_ask_batch_key   protoreflect.FieldDescriptor
+	fd_Swap_ask_quantity    protoreflect.FieldDescriptor
+	fd_Swap_expiration      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_Swap = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("Swap")
+	fd_Swap_id = md_Swap.Fields().ByName("id")
+	fd_Swap_proposer = md_Swap.Fields().ByName("proposer")
+	fd_Swap_counterparty = md_Swap.Fields().ByName("counterparty")
+	fd_Swap_offer_batch_key = md_Swap.Fields().ByName("offer_batch_key")
+	fd_Swap_offer_quantity = md_Swap.Fields().ByName("offer_quantity")
+	fd_Swap_offer_coins = md_Swap.Fields().ByName("offer_coins")
+	fd_Swap_ask_batch_key = md_Swap.Fields().ByName("ask_batch_key")
+	fd_Swap_ask_quantity = md_Swap.Fields().ByName("ask_quantity")
+	fd_Swap_expiration = md_Swap.Fields().ByName("expiration")
+}
+
+var _ protoreflect.Message = (*fastReflection_Swap)(nil)
+
+type fastReflection_Swap Swap
+
+func (x *Swap) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Swap)(x)
+}
+
+func (x *Swap) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Swap_messageType fastReflection_Swap_messageType
+var _ protoreflect.MessageType = fastReflection_Swap_messageType{}
+
+type fastReflection_Swap_messageType struct{}
+
+func (x fastReflection_Swap_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Swap)(nil)
+}
+func (x fastReflection_Swap_messageType) New() protoreflect.Message {
+	return new(fastReflection_Swap)
+}
+func (x fastReflection_Swap_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Swap
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Swap) Descriptor() protoreflect.MessageDescriptor {
+	return md_Swap
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Swap) Type() protoreflect.MessageType {
+	return _fastReflection_Swap_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Swap) New() protoreflect.Message {
+	return new(fastReflection_Swap)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Swap) Interface() protoreflect.ProtoMessage {
+	return (*Swap)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Swap) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_Swap_id, value) {
+			return
+		}
+	}
+	if len(x.Proposer) != 0 {
+		value := protoreflect.ValueOfBytes(x.Proposer)
+		if !f(fd_Swap_proposer, value) {
+			return
+		}
+	}
+	if len(x.Counterparty) != 0 {
+		value := protoreflect.ValueOfBytes(x.Counterparty)
+		if !f(fd_Swap_counterparty, value) {
+			return
+		}
+	}
+	if x.OfferBatchKey != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OfferBatchKey)
+		if !f(fd_Swap_offer_batch_key, value) {
+			return
+		}
+	}
+	if x.OfferQuantity != "" {
+		value := protoreflect.ValueOfString(x.OfferQuantity)
+		if !f(fd_Swap_offer_quantity, value) {
+			return
+		}
+	}
+	if len(x.OfferCoins) != 0 {
+		value := protoreflect.ValueOfList(&_Swap_6_list{list: &x.OfferCoins})
+		if !f(fd_Swap_offer_coins, value) {
+			return
+		}
+	}
+	if x.AskBatchKey != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AskBatchKey)
+		if !f(fd_Swap_ask_batch_key, value) {
+			return
+		}
+	}
+	if x.AskQuantity != "" {
+		value := protoreflect.ValueOfString(x.AskQuantity)
+		if !f(fd_Swap_ask_quantity, value) {
+			return
+		}
+	}
+	if x.Expiration != nil {
+		value := protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+		if !f(fd_Swap_expiration, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Swap) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Swap.id":
+		return x.Id != uint64(0)
+	case "regen.ecocredit.marketplace.v1.Swap.proposer":
+		return len(x.Proposer) != 0
+	case "regen.ecocredit.marketplace.v1.Swap.counterparty":
+		return len(x.Counterparty) != 0
+	case "regen.ecocredit.marketplace.v1.Swap.offer_batch_key":
+		return x.OfferBatchKey != uint64(0)
+	case "regen.ecocredit.marketplace.v1.Swap.offer_quantity":
+		return x.OfferQuantity != ""
+	case "regen.ecocredit.marketplace.v1.Swap.offer_coins":
+		return len(x.OfferCoins) != 0
+	case "regen.ecocredit.marketplace.v1.Swap.ask_batch_key":
+		return x.AskBatchKey != uint64(0)
+	case "regen.ecocredit.marketplace.v1.Swap.ask_quantity":
+		return x.AskQuantity != ""
+	case "regen.ecocredit.marketplace.v1.Swap.expiration":
+		return x.Expiration != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Swap"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Swap does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Swap) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Swap.id":
+		x.Id = uint64(0)
+	case "regen.ecocredit.marketplace.v1.Swap.proposer":
+		x.Proposer = nil
+	case "regen.ecocredit.marketplace.v1.Swap.counterparty":
+		x.Counterparty = nil
+	case "regen.ecocredit.marketplace.v1.Swap.offer_batch_key":
+		x.OfferBatchKey = uint64(0)
+	case "regen.ecocredit.marketplace.v1.Swap.offer_quantity":
+		x.OfferQuantity = ""
+	case "regen.ecocredit.marketplace.v1.Swap.offer_coins":
+		x.OfferCoins = nil
+	case "regen.ecocredit.marketplace.v1.Swap.ask_batch_key":
+		x.AskBatchKey = uint64(0)
+	case "regen.ecocredit.marketplace.v1.Swap.ask_quantity":
+		x.AskQuantity = ""
+	case "regen.ecocredit.marketplace.v1.Swap.expiration":
+		x.Expiration = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Swap"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Swap does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Swap) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.Swap.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.Swap.proposer":
+		value := x.Proposer
+		return protoreflect.ValueOfBytes(value)
+	case "regen.ecocredit.marketplace.v1.Swap.counterparty":
+		value := x.Counterparty
+		return protoreflect.ValueOfBytes(value)
+	case "regen.ecocredit.marketplace.v1.Swap.offer_batch_key":
+		value := x.OfferBatchKey
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.Swap.offer_quantity":
+		value := x.OfferQuantity
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Swap.offer_coins":
+		if len(x.OfferCoins) == 0 {
+			return protoreflect.ValueOfList(&_Swap_6_list{})
+		}
+		listValue := &_Swap_6_list{list: &x.OfferCoins}
+		return protoreflect.ValueOfList(listValue)
+	case "regen.ecocredit.marketplace.v1.Swap.ask_batch_key":
+		value := x.AskBatchKey
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.Swap.ask_quantity":
+		value := x.AskQuantity
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Swap.expiration":
+		value := x.Expiration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Swap"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Swap does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Swap) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Swap.id":
+		x.Id = value.Uint()
+	case "regen.ecocredit.marketplace.v1.Swap.proposer":
+		x.Proposer = value.Bytes()
+	case "regen.ecocredit.marketplace.v1.Swap.counterparty":
+		x.Counterparty = value.Bytes()
+	case "regen.ecocredit.marketplace.v1.Swap.offer_batch_key":
+		x.OfferBatchKey = value.Uint()
+	case "regen.ecocredit.marketplace.v1.Swap.offer_quantity":
+		x.OfferQuantity = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Swap.offer_coins":
+		lv := value.List()
+		clv := lv.(*_Swap_6_list)
+		x.OfferCoins = *clv.list
+	case "regen.ecocredit.marketplace.v1.Swap.ask_batch_key":
+		x.AskBatchKey = value.Uint()
+	case "regen.ecocredit.marketplace.v1.Swap.ask_quantity":
+		x.AskQuantity = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Swap.expiration":
+		x.Expiration = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Swap"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Swap does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Swap) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Swap.offer_coins":
+		if x.OfferCoins == nil {
+			x.OfferCoins = []*v1beta1.Coin{}
+		}
+		value := &_Swap_6_list{list: &x.OfferCoins}
+		return protoreflect.ValueOfList(value)
+	case "regen.ecocredit.marketplace.v1.Swap.expiration":
+		if x.Expiration == nil {
+			x.Expiration = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.Swap.id":
+		panic(fmt.Errorf("field id of message regen.ecocredit.marketplace.v1.Swap is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Swap.proposer":
+		panic(fmt.Errorf("field proposer of message regen.ecocredit.marketplace.v1.Swap is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Swap.counterparty":
+		panic(fmt.Errorf("field counterparty of message regen.ecocredit.marketplace.v1.Swap is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Swap.offer_batch_key":
+		panic(fmt.Errorf("field offer_batch_key of message regen.ecocredit.marketplace.v1.Swap is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Swap.offer_quantity":
+		panic(fmt.Errorf("field offer_quantity of message regen.ecocredit.marketplace.v1.Swap is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Swap.ask_batch_key":
+		panic(fmt.Errorf("field ask_batch_key of message regen.ecocredit.marketplace.v1.Swap is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Swap.ask_quantity":
+		panic(fmt.Errorf("field ask_quantity of message regen.ecocredit.marketplace.v1.Swap is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Swap"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Swap does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Swap) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Swap.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.Swap.proposer":
+		return protoreflect.ValueOfBytes(nil)
+	case "regen.ecocredit.marketplace.v1.Swap.counterparty":
+		return protoreflect.ValueOfBytes(nil)
+	case "regen.ecocredit.marketplace.v1.Swap.offer_batch_key":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.Swap.offer_quantity":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Swap.offer_coins":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Swap_6_list{list: &list})
+	case "regen.ecocredit.marketplace.v1.Swap.ask_batch_key":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.Swap.ask_quantity":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Swap.expiration":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Swap"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Swap does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Swap) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.Swap", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Swap) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Swap) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Swap) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Swap) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Swap)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Proposer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Counterparty)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.OfferBatchKey != 0 {
+			n += 1 + runtime.Sov(uint64(x.OfferBatchKey))
+		}
+		l = len(x.OfferQuantity)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.OfferCoins) > 0 {
+			for _, e := range x.OfferCoins {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.AskBatchKey != 0 {
+			n += 1 + runtime.Sov(uint64(x.AskBatchKey))
+		}
+		l = len(x.AskQuantity)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Expiration != nil {
+			l = options.Size(x.Expiration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Swap)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Expiration != nil {
+			encoded, err := options.Marshal(x.Expiration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.AskQuantity) > 0 {
+			i -= len(x.AskQuantity)
+			copy(dAtA[i:], x.AskQuantity)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AskQuantity)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.AskBatchKey != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AskBatchKey))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.OfferCoins) > 0 {
+			for iNdEx := len(x.OfferCoins) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.OfferCoins[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.OfferQuantity) > 0 {
+			i -= len(x.OfferQuantity)
+			copy(dAtA[i:], x.OfferQuantity)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OfferQuantity)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.OfferBatchKey != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OfferBatchKey))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Counterparty) > 0 {
+			i -= len(x.Counterparty)
+			copy(dAtA[i:], x.Counterparty)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Counterparty)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Proposer) > 0 {
+			i -= len(x.Proposer)
+			copy(dAtA[i:], x.Proposer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proposer)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Swap)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Swap: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Swap: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Proposer = append(x.Proposer[:0], dAtA[iNdEx:postIndex]...)
+				if x.Proposer == nil {
+					x.Proposer = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Counterparty = append(x.Counterparty[:0], dAtA[iNdEx:postIndex]...)
+				if x.Counterparty == nil {
+					x.Counterparty = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OfferBatchKey", wireType)
+				}
+				x.OfferBatchKey = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OfferBatchKey |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OfferQuantity", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OfferQuantity = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OfferCoins", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OfferCoins = append(x.OfferCoins, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OfferCoins[len(x.OfferCoins)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AskBatchKey", wireType)
+				}
+				x.AskBatchKey = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AskBatchKey |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AskQuantity", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AskQuantity = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Expiration == nil {
+					x.Expiration = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ForwardContract                    protoreflect.MessageDescriptor
+	fd_ForwardContract_id                 protoreflect.FieldDescriptor
+	fd_ForwardContract_buyer              protoreflect.FieldDescriptor
+	fd_ForwardContract_issuer             protoreflect.FieldDescriptor
+	fd_ForwardContract_project_key        protoreflect.FieldDescriptor
+	fd_ForwardContract_batch_key          protoreflect.FieldDescriptor
+	fd_ForwardContract_quantity           protoreflect.FieldDescriptor
+	fd_ForwardContract_delivered_quantity protoreflect.FieldDescriptor
+	fd_ForwardContract_market_id          protoreflect.FieldDescriptor
+	fd_ForwardContract_price_amount       protoreflect.FieldDescriptor
+	fd_ForwardContract_deadline           protoreflect.FieldDescriptor
+	fd_ForwardContract_accept_retired     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_ForwardContract = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("ForwardContract")
+	fd_ForwardContract_id = md_ForwardContract.Fields().ByName("id")
+	fd_ForwardContract_buyer = md_ForwardContract.Fields().ByName("buyer")
+	fd_ForwardContract_issuer = md_ForwardContract.Fields().ByName("issuer")
+	fd_ForwardContract_project_key = md_ForwardContract.Fields().ByName("project_key")
+	fd_ForwardContract_batch_key = md_ForwardContract.Fields().ByName("batch_key")
+	fd_ForwardContract_quantity = md_ForwardContract.Fields().ByName("quantity")
+	fd_ForwardContract_delivered_quantity = md_ForwardContract.Fields().ByName("delivered_quantity")
+	fd_ForwardContract_market_id = md_ForwardContract.Fields().ByName("market_id")
+	fd_ForwardContract_price_amount = md_ForwardContract.Fields().ByName("price_amount")
+	fd_ForwardContract_deadline = md_ForwardContract.Fields().ByName("deadline")
+	fd_ForwardContract_accept_retired = md_ForwardContract.Fields().ByName("accept_retired")
+}
+
+var _ protoreflect.Message = (*fastReflection_ForwardContract)(nil)
+
+type fastReflection_ForwardContract ForwardContract
+
+func (x *ForwardContract) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ForwardContract)(x)
+}
+
+func (x *ForwardContract) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ForwardContract_messageType fastReflection_ForwardContract_messageType
+var _ protoreflect.MessageType = fastReflection_ForwardContract_messageType{}
+
+type fastReflection_ForwardContract_messageType struct{}
+
+func (x fastReflection_ForwardContract_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ForwardContract)(nil)
+}
+func (x fastReflection_ForwardContract_messageType) New() protoreflect.Message {
+	return new(fastReflection_ForwardContract)
+}
+func (x fastReflection_ForwardContract_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ForwardContract
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ForwardContract) Descriptor() protoreflect.MessageDescriptor {
+	return md_ForwardContract
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ForwardContract) Type() protoreflect.MessageType {
+	return _fastReflection_ForwardContract_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ForwardContract) New() protoreflect.Message {
+	return new(fastReflection_ForwardContract)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ForwardContract) Interface() protoreflect.ProtoMessage {
+	return (*ForwardContract)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ForwardContract) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_ForwardContract_id, value) {
+			return
+		}
+	}
+	if len(x.Buyer) != 0 {
+		value := protoreflect.ValueOfBytes(x.Buyer)
+		if !f(fd_ForwardContract_buyer, value) {
+			return
+		}
+	}
+	if len(x.Issuer) != 0 {
+		value := protoreflect.ValueOfBytes(x.Issuer)
+		if !f(fd_ForwardContract_issuer, value) {
+			return
+		}
+	}
+	if x.ProjectKey != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProjectKey)
+		if !f(fd_ForwardContract_project_key, value) {
+			return
+		}
+	}
+	if x.BatchKey != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BatchKey)
+		if !f(fd_ForwardContract_batch_key, value) {
+			return
+		}
+	}
+	if x.Quantity != "" {
+		value := protoreflect.ValueOfString(x.Quantity)
+		if !f(fd_ForwardContract_quantity, value) {
+			return
+		}
+	}
+	if x.DeliveredQuantity != "" {
+		value := protoreflect.ValueOfString(x.DeliveredQuantity)
+		if !f(fd_ForwardContract_delivered_quantity, value) {
+			return
+		}
+	}
+	if x.MarketId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MarketId)
+		if !f(fd_ForwardContract_market_id, value) {
+			return
+		}
+	}
+	if x.PriceAmount != "" {
+		value := protoreflect.ValueOfString(x.PriceAmount)
+		if !f(fd_ForwardContract_price_amount, value) {
+			return
+		}
+	}
+	if x.Deadline != nil {
+		value := protoreflect.ValueOfMessage(x.Deadline.ProtoReflect())
+		if !f(fd_ForwardContract_deadline, value) {
+			return
+		}
+	}
+	if x.AcceptRetired != false {
+		value := protoreflect.ValueOfBool(x.AcceptRetired)
+		if !f(fd_ForwardContract_accept_retired, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ForwardContract) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.ForwardContract.id":
+		return x.Id != uint64(0)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.buyer":
+		return len(x.Buyer) != 0
+	case "regen.ecocredit.marketplace.v1.ForwardContract.issuer":
+		return len(x.Issuer) != 0
+	case "regen.ecocredit.marketplace.v1.ForwardContract.project_key":
+		return x.ProjectKey != uint64(0)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.batch_key":
+		return x.BatchKey != uint64(0)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.quantity":
+		return x.Quantity != ""
+	case "regen.ecocredit.marketplace.v1.ForwardContract.delivered_quantity":
+		return x.DeliveredQuantity != ""
+	case "regen.ecocredit.marketplace.v1.ForwardContract.market_id":
+		return x.MarketId != uint64(0)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.price_amount":
+		return x.PriceAmount != ""
+	case "regen.ecocredit.marketplace.v1.ForwardContract.deadline":
+		return x.Deadline != nil
+	case "regen.ecocredit.marketplace.v1.ForwardContract.accept_retired":
+		return x.AcceptRetired != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.ForwardContract"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.ForwardContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForwardContract) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.ForwardContract.id":
+		x.Id = uint64(0)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.buyer":
+		x.Buyer = nil
+	case "regen.ecocredit.marketplace.v1.ForwardContract.issuer":
+		x.Issuer = nil
+	case "regen.ecocredit.marketplace.v1.ForwardContract.project_key":
+		x.ProjectKey = uint64(0)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.batch_key":
+		x.BatchKey = uint64(0)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.quantity":
+		x.Quantity = ""
+	case "regen.ecocredit.marketplace.v1.ForwardContract.delivered_quantity":
+		x.DeliveredQuantity = ""
+	case "regen.ecocredit.marketplace.v1.ForwardContract.market_id":
+		x.MarketId = uint64(0)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.price_amount":
+		x.PriceAmount = ""
+	case "regen.ecocredit.marketplace.v1.ForwardContract.deadline":
+		x.Deadline = nil
+	case "regen.ecocredit.marketplace.v1.ForwardContract.accept_retired":
+		x.AcceptRetired = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.ForwardContract"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.ForwardContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ForwardContract) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.ForwardContract.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.buyer":
+		value := x.Buyer
+		return protoreflect.ValueOfBytes(value)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.issuer":
+		value := x.Issuer
+		return protoreflect.ValueOfBytes(value)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.project_key":
+		value := x.ProjectKey
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.batch_key":
+		value := x.BatchKey
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.quantity":
+		value := x.Quantity
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.delivered_quantity":
+		value := x.DeliveredQuantity
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.market_id":
+		value := x.MarketId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.price_amount":
+		value := x.PriceAmount
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.deadline":
+		value := x.Deadline
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.ForwardContract.accept_retired":
+		value := x.AcceptRetired
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.ForwardContract"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.ForwardContract does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForwardContract) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.ForwardContract.id":
+		x.Id = value.Uint()
+	case "regen.ecocredit.marketplace.v1.ForwardContract.buyer":
+		x.Buyer = value.Bytes()
+	case "regen.ecocredit.marketplace.v1.ForwardContract.issuer":
+		x.Issuer = value.Bytes()
+	case "regen.ecocredit.marketplace.v1.ForwardContract.project_key":
+		x.ProjectKey = value.Uint()
+	case "regen.ecocredit.marketplace.v1.ForwardContract.batch_key":
+		x.BatchKey = value.Uint()
+	case "regen.ecocredit.marketplace.v1.ForwardContract.quantity":
+		x.Quantity = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.delivered_quantity":
+		x.DeliveredQuantity = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.market_id":
+		x.MarketId = value.Uint()
+	case "regen.ecocredit.marketplace.v1.ForwardContract.price_amount":
+		x.PriceAmount = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.deadline":
+		x.Deadline = value.Message().Interface().(*timestamppb.Timestamp)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.accept_retired":
+		x.AcceptRetired = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.ForwardContract"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.ForwardContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForwardContract) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.ForwardContract.deadline":
+		if x.Deadline == nil {
+			x.Deadline = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Deadline.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.ForwardContract.id":
+		panic(fmt.Errorf("field id of message regen.ecocredit.marketplace.v1.ForwardContract is not mutable"))
+	case "regen.ecocredit.marketplace.v1.ForwardContract.buyer":
+		panic(fmt.Errorf("field buyer of message regen.ecocredit.marketplace.v1.ForwardContract is not mutable"))
+	case "regen.ecocredit.marketplace.v1.ForwardContract.issuer":
+		panic(fmt.Errorf("field issuer of message regen.ecocredit.marketplace.v1.ForwardContract is not mutable"))
+	case "regen.ecocredit.marketplace.v1.ForwardContract.project_key":
+		panic(fmt.Errorf("field project_key of message regen.ecocredit.marketplace.v1.ForwardContract is not mutable"))
+	case "regen.ecocredit.marketplace.v1.ForwardContract.batch_key":
+		panic(fmt.Errorf("field batch_key of message regen.ecocredit.marketplace.v1.ForwardContract is not mutable"))
+	case "regen.ecocredit.marketplace.v1.ForwardContract.quantity":
+		panic(fmt.Errorf("field quantity of message regen.ecocredit.marketplace.v1.ForwardContract is not mutable"))
+	case "regen.ecocredit.marketplace.v1.ForwardContract.delivered_quantity":
+		panic(fmt.Errorf("field delivered_quantity of message regen.ecocredit.marketplace.v1.ForwardContract is not mutable"))
+	case "regen.ecocredit.marketplace.v1.ForwardContract.market_id":
+		panic(fmt.Errorf("field market_id of message regen.ecocredit.marketplace.v1.ForwardContract is not mutable"))
+	case "regen.ecocredit.marketplace.v1.ForwardContract.price_amount":
+		panic(fmt.Errorf("field price_amount of message regen.ecocredit.marketplace.v1.ForwardContract is not mutable"))
+	case "regen.ecocredit.marketplace.v1.ForwardContract.accept_retired":
+		panic(fmt.Errorf("field accept_retired of message regen.ecocredit.marketplace.v1.ForwardContract is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.ForwardContract"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.ForwardContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ForwardContract) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.ForwardContract.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.ForwardContract.buyer":
+		return protoreflect.ValueOfBytes(nil)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.issuer":
+		return protoreflect.ValueOfBytes(nil)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.project_key":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.ForwardContract.batch_key":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.ForwardContract.quantity":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.ForwardContract.delivered_quantity":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.ForwardContract.market_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.ForwardContract.price_amount":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.ForwardContract.deadline":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.ForwardContract.accept_retired":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.ForwardContract"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.ForwardContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ForwardContract) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.ForwardContract", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ForwardContract) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForwardContract) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ForwardContract) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ForwardContract) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ForwardContract)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Buyer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Issuer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ProjectKey != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProjectKey))
+		}
+		if x.BatchKey != 0 {
+			n += 1 + runtime.Sov(uint64(x.BatchKey))
+		}
+		l = len(x.Quantity)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DeliveredQuantity)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MarketId != 0 {
+			n += 1 + runtime.Sov(uint64(x.MarketId))
+		}
+		l = len(x.PriceAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Deadline != nil {
+			l = options.Size(x.Deadline)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AcceptRetired {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ForwardContract)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.AcceptRetired {
+			i--
+			if x.AcceptRetired {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.Deadline != nil {
+			encoded, err := options.Marshal(x.Deadline)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.PriceAmount) > 0 {
+			i -= len(x.PriceAmount)
+			copy(dAtA[i:], x.PriceAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PriceAmount)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.MarketId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MarketId))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.DeliveredQuantity) > 0 {
+			i -= len(x.DeliveredQuantity)
+			copy(dAtA[i:], x.DeliveredQuantity)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DeliveredQuantity)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Quantity) > 0 {
+			i -= len(x.Quantity)
+			copy(dAtA[i:], x.Quantity)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Quantity)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.BatchKey != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BatchKey))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.ProjectKey != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProjectKey))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Issuer) > 0 {
+			i -= len(x.Issuer)
+			copy(dAtA[i:], x.Issuer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Issuer)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Buyer) > 0 {
+			i -= len(x.Buyer)
+			copy(dAtA[i:], x.Buyer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Buyer)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ForwardContract)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ForwardContract: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ForwardContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Buyer = append(x.Buyer[:0], dAtA[iNdEx:postIndex]...)
+				if x.Buyer == nil {
+					x.Buyer = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Issuer = append(x.Issuer[:0], dAtA[iNdEx:postIndex]...)
+				if x.Issuer == nil {
+					x.Issuer = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
+				}
+				x.ProjectKey = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProjectKey |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+				}
+				x.BatchKey = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BatchKey |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Quantity = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeliveredQuantity", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DeliveredQuantity = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				}
+				x.MarketId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MarketId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PriceAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Deadline == nil {
+					x.Deadline = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Deadline); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AcceptRetired", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.AcceptRetired = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SellOrderAsk               protoreflect.MessageDescriptor
+	fd_SellOrderAsk_sell_order_id protoreflect.FieldDescriptor
+	fd_SellOrderAsk_market_id     protoreflect.FieldDescriptor
+	fd_SellOrderAsk_ask_amount    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_SellOrderAsk = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("SellOrderAsk")
+	fd_SellOrderAsk_sell_order_id = md_SellOrderAsk.Fields().ByName("sell_order_id")
+	fd_SellOrderAsk_market_id = md_SellOrderAsk.Fields().ByName("market_id")
+	fd_SellOrderAsk_ask_amount = md_SellOrderAsk.Fields().ByName("ask_amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_SellOrderAsk)(nil)
+
+type fastReflection_SellOrderAsk SellOrderAsk
+
+func (x *SellOrderAsk) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SellOrderAsk)(x)
+}
+
+func (x *SellOrderAsk) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SellOrderAsk_messageType fastReflection_SellOrderAsk_messageType
+var _ protoreflect.MessageType = fastReflection_SellOrderAsk_messageType{}
+
+type fastReflection_SellOrderAsk_messageType struct{}
+
+func (x fastReflection_SellOrderAsk_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SellOrderAsk)(nil)
+}
+func (x fastReflection_SellOrderAsk_messageType) New() protoreflect.Message {
+	return new(fastReflection_SellOrderAsk)
+}
+func (x fastReflection_SellOrderAsk_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SellOrderAsk
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SellOrderAsk) Descriptor() protoreflect.MessageDescriptor {
+	return md_SellOrderAsk
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SellOrderAsk) Type() protoreflect.MessageType {
+	return _fastReflection_SellOrderAsk_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SellOrderAsk) New() protoreflect.Message {
+	return new(fastReflection_SellOrderAsk)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SellOrderAsk) Interface() protoreflect.ProtoMessage {
+	return (*SellOrderAsk)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SellOrderAsk) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SellOrderId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SellOrderId)
+		if !f(fd_SellOrderAsk_sell_order_id, value) {
+			return
+		}
+	}
+	if x.MarketId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MarketId)
+		if !f(fd_SellOrderAsk_market_id, value) {
+			return
+		}
+	}
+	if x.AskAmount != "" {
+		value := protoreflect.ValueOfString(x.AskAmount)
+		if !f(fd_SellOrderAsk_ask_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SellOrderAsk) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.SellOrderAsk.sell_order_id":
+		return x.SellOrderId != uint64(0)
+	case "regen.ecocredit.marketplace.v1.SellOrderAsk.market_id":
+		return x.MarketId != uint64(0)
+	case "regen.ecocredit.marketplace.v1.SellOrderAsk.ask_amount":
+		return x.AskAmount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrderAsk"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SellOrderAsk does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SellOrderAsk) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.SellOrderAsk.sell_order_id":
+		x.SellOrderId = uint64(0)
+	case "regen.ecocredit.marketplace.v1.SellOrderAsk.market_id":
+		x.MarketId = uint64(0)
+	case "regen.ecocredit.marketplace.v1.SellOrderAsk.ask_amount":
+		x.AskAmount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrderAsk"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SellOrderAsk does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SellOrderAsk) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.SellOrderAsk.sell_order_id":
+		value := x.SellOrderId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.SellOrderAsk.market_id":
+		value := x.MarketId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.SellOrderAsk.ask_amount":
+		value := x.AskAmount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrderAsk"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SellOrderAsk does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SellOrderAsk) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.SellOrderAsk.sell_order_id":
+		x.SellOrderId = value.Uint()
+	case "regen.ecocredit.marketplace.v1.SellOrderAsk.market_id":
+		x.MarketId = value.Uint()
+	case "regen.ecocredit.marketplace.v1.SellOrderAsk.ask_amount":
+		x.AskAmount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrderAsk"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SellOrderAsk does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SellOrderAsk) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.SellOrderAsk.sell_order_id":
+		panic(fmt.Errorf("field sell_order_id of message regen.ecocredit.marketplace.v1.SellOrderAsk is not mutable"))
+	case "regen.ecocredit.marketplace.v1.SellOrderAsk.market_id":
+		panic(fmt.Errorf("field market_id of message regen.ecocredit.marketplace.v1.SellOrderAsk is not mutable"))
+	case "regen.ecocredit.marketplace.v1.SellOrderAsk.ask_amount":
+		panic(fmt.Errorf("field ask_amount of message regen.ecocredit.marketplace.v1.SellOrderAsk is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrderAsk"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SellOrderAsk does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SellOrderAsk) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.SellOrderAsk.sell_order_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.SellOrderAsk.market_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.SellOrderAsk.ask_amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrderAsk"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SellOrderAsk does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SellOrderAsk) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.SellOrderAsk", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SellOrderAsk) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SellOrderAsk) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SellOrderAsk) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SellOrderAsk) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SellOrderAsk)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.SellOrderId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SellOrderId))
+		}
+		if x.MarketId != 0 {
+			n += 1 + runtime.Sov(uint64(x.MarketId))
+		}
+		l = len(x.AskAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SellOrderAsk)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.AskAmount) > 0 {
+			i -= len(x.AskAmount)
+			copy(dAtA[i:], x.AskAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AskAmount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.MarketId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MarketId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.SellOrderId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SellOrderId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SellOrderAsk)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SellOrderAsk: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SellOrderAsk: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SellOrderId", wireType)
+				}
+				x.SellOrderId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SellOrderId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				}
+				x.MarketId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MarketId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AskAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AskAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SellOrderPruneCursor            protoreflect.MessageDescriptor
+	fd_SellOrderPruneCursor_expiration protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_SellOrderPruneCursor = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("SellOrderPruneCursor")
+	fd_SellOrderPruneCursor_expiration = md_SellOrderPruneCursor.Fields().ByName("expiration")
+}
+
+var _ protoreflect.Message = (*fastReflection_SellOrderPruneCursor)(nil)
+
+type fastReflection_SellOrderPruneCursor SellOrderPruneCursor
+
+func (x *SellOrderPruneCursor) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SellOrderPruneCursor)(x)
+}
+
+func (x *SellOrderPruneCursor) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SellOrderPruneCursor_messageType fastReflection_SellOrderPruneCursor_messageType
+var _ protoreflect.MessageType = fastReflection_SellOrderPruneCursor_messageType{}
+
+type fastReflection_SellOrderPruneCursor_messageType struct{}
+
+func (x fastReflection_SellOrderPruneCursor_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SellOrderPruneCursor)(nil)
+}
+func (x fastReflection_SellOrderPruneCursor_messageType) New() protoreflect.Message {
+	return new(fastReflection_SellOrderPruneCursor)
+}
+func (x fastReflection_SellOrderPruneCursor_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SellOrderPruneCursor
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SellOrderPruneCursor) Descriptor() protoreflect.MessageDescriptor {
+	return md_SellOrderPruneCursor
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SellOrderPruneCursor) Type() protoreflect.MessageType {
+	return _fastReflection_SellOrderPruneCursor_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SellOrderPruneCursor) New() protoreflect.Message {
+	return new(fastReflection_SellOrderPruneCursor)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SellOrderPruneCursor) Interface() protoreflect.ProtoMessage {
+	return (*SellOrderPruneCursor)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SellOrderPruneCursor) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Expiration != nil {
+		value := protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+		if !f(fd_SellOrderPruneCursor_expiration, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SellOrderPruneCursor) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.SellOrderPruneCursor.expiration":
+		return x.Expiration != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrderPruneCursor"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SellOrderPruneCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SellOrderPruneCursor) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.SellOrderPruneCursor.expiration":
+		x.Expiration = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrderPruneCursor"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SellOrderPruneCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SellOrderPruneCursor) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.SellOrderPruneCursor.expiration":
+		value := x.Expiration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrderPruneCursor"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SellOrderPruneCursor does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SellOrderPruneCursor) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.SellOrderPruneCursor.expiration":
+		x.Expiration = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrderPruneCursor"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SellOrderPruneCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SellOrderPruneCursor) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.SellOrderPruneCursor.expiration":
+		if x.Expiration == nil {
+			x.Expiration = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrderPruneCursor"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SellOrderPruneCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SellOrderPruneCursor) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.SellOrderPruneCursor.expiration":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrderPruneCursor"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SellOrderPruneCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SellOrderPruneCursor) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.SellOrderPruneCursor", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SellOrderPruneCursor) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SellOrderPruneCursor) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SellOrderPruneCursor) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SellOrderPruneCursor) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SellOrderPruneCursor)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Expiration != nil {
+			l = options.Size(x.Expiration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SellOrderPruneCursor)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Expiration != nil {
+			encoded, err := options.Marshal(x.Expiration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SellOrderPruneCursor)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SellOrderPruneCursor: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SellOrderPruneCursor: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Expiration == nil {
+					x.Expiration = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_AuctionSettleCursor          protoreflect.MessageDescriptor
+	fd_AuctionSettleCursor_end_time protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_AuctionSettleCursor = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("AuctionSettleCursor")
+	fd_AuctionSettleCursor_end_time = md_AuctionSettleCursor.Fields().ByName("end_time")
+}
+
+var _ protoreflect.Message = (*fastReflection_AuctionSettleCursor)(nil)
+
+type fastReflection_AuctionSettleCursor AuctionSettleCursor
+
+func (x *AuctionSettleCursor) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AuctionSettleCursor)(x)
+}
+
+func (x *AuctionSettleCursor) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AuctionSettleCursor_messageType fastReflection_AuctionSettleCursor_messageType
+var _ protoreflect.MessageType = fastReflection_AuctionSettleCursor_messageType{}
+
+type fastReflection_AuctionSettleCursor_messageType struct{}
+
+func (x fastReflection_AuctionSettleCursor_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AuctionSettleCursor)(nil)
+}
+func (x fastReflection_AuctionSettleCursor_messageType) New() protoreflect.Message {
+	return new(fastReflection_AuctionSettleCursor)
+}
+func (x fastReflection_AuctionSettleCursor_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AuctionSettleCursor
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AuctionSettleCursor) Descriptor() protoreflect.MessageDescriptor {
+	return md_AuctionSettleCursor
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AuctionSettleCursor) Type() protoreflect.MessageType {
+	return _fastReflection_AuctionSettleCursor_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AuctionSettleCursor) New() protoreflect.Message {
+	return new(fastReflection_AuctionSettleCursor)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AuctionSettleCursor) Interface() protoreflect.ProtoMessage {
+	return (*AuctionSettleCursor)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AuctionSettleCursor) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.EndTime != nil {
+		value := protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+		if !f(fd_AuctionSettleCursor_end_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AuctionSettleCursor) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.AuctionSettleCursor.end_time":
+		return x.EndTime != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AuctionSettleCursor"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AuctionSettleCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AuctionSettleCursor) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.AuctionSettleCursor.end_time":
+		x.EndTime = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AuctionSettleCursor"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AuctionSettleCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AuctionSettleCursor) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.AuctionSettleCursor.end_time":
+		value := x.EndTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AuctionSettleCursor"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AuctionSettleCursor does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AuctionSettleCursor) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.AuctionSettleCursor.end_time":
+		x.EndTime = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AuctionSettleCursor"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AuctionSettleCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AuctionSettleCursor) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.AuctionSettleCursor.end_time":
+		if x.EndTime == nil {
+			x.EndTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AuctionSettleCursor"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AuctionSettleCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AuctionSettleCursor) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.AuctionSettleCursor.end_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AuctionSettleCursor"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AuctionSettleCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AuctionSettleCursor) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.AuctionSettleCursor", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AuctionSettleCursor) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AuctionSettleCursor) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AuctionSettleCursor) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AuctionSettleCursor) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AuctionSettleCursor)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.EndTime != nil {
+			l = options.Size(x.EndTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AuctionSettleCursor)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.EndTime != nil {
+			encoded, err := options.Marshal(x.EndTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AuctionSettleCursor)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AuctionSettleCursor: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AuctionSettleCursor: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EndTime == nil {
+					x.EndTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EndTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_OfferPruneCursor            protoreflect.MessageDescriptor
+	fd_OfferPruneCursor_expiration protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_OfferPruneCursor = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("OfferPruneCursor")
+	fd_OfferPruneCursor_expiration = md_OfferPruneCursor.Fields().ByName("expiration")
+}
+
+var _ protoreflect.Message = (*fastReflection_OfferPruneCursor)(nil)
+
+type fastReflection_OfferPruneCursor OfferPruneCursor
+
+func (x *OfferPruneCursor) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OfferPruneCursor)(x)
+}
+
+func (x *OfferPruneCursor) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_OfferPruneCursor_messageType fastReflection_OfferPruneCursor_messageType
+var _ protoreflect.MessageType = fastReflection_OfferPruneCursor_messageType{}
+
+type fastReflection_OfferPruneCursor_messageType struct{}
+
+func (x fastReflection_OfferPruneCursor_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OfferPruneCursor)(nil)
+}
+func (x fastReflection_OfferPruneCursor_messageType) New() protoreflect.Message {
+	return new(fastReflection_OfferPruneCursor)
+}
+func (x fastReflection_OfferPruneCursor_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OfferPruneCursor
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_OfferPruneCursor) Descriptor() protoreflect.MessageDescriptor {
+	return md_OfferPruneCursor
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_OfferPruneCursor) Type() protoreflect.MessageType {
+	return _fastReflection_OfferPruneCursor_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_OfferPruneCursor) New() protoreflect.Message {
+	return new(fastReflection_OfferPruneCursor)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_OfferPruneCursor) Interface() protoreflect.ProtoMessage {
+	return (*OfferPruneCursor)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_OfferPruneCursor) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Expiration != nil {
+		value := protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+		if !f(fd_OfferPruneCursor_expiration, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_OfferPruneCursor) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.OfferPruneCursor.expiration":
+		return x.Expiration != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.OfferPruneCursor"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.OfferPruneCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OfferPruneCursor) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.OfferPruneCursor.expiration":
+		x.Expiration = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.OfferPruneCursor"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.OfferPruneCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_OfferPruneCursor) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.OfferPruneCursor.expiration":
+		value := x.Expiration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.OfferPruneCursor"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.OfferPruneCursor does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OfferPruneCursor) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.OfferPruneCursor.expiration":
+		x.Expiration = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.OfferPruneCursor"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.OfferPruneCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OfferPruneCursor) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.OfferPruneCursor.expiration":
+		if x.Expiration == nil {
+			x.Expiration = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.OfferPruneCursor"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.OfferPruneCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_OfferPruneCursor) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.OfferPruneCursor.expiration":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.OfferPruneCursor"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.OfferPruneCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_OfferPruneCursor) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.OfferPruneCursor", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_OfferPruneCursor) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OfferPruneCursor) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_OfferPruneCursor) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_OfferPruneCursor) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*OfferPruneCursor)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Expiration != nil {
+			l = options.Size(x.Expiration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*OfferPruneCursor)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Expiration != nil {
+			encoded, err := options.Marshal(x.Expiration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*OfferPruneCursor)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OfferPruneCursor: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OfferPruneCursor: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Expiration == nil {
+					x.Expiration = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SwapPruneCursor            protoreflect.MessageDescriptor
+	fd_SwapPruneCursor_expiration protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_SwapPruneCursor = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("SwapPruneCursor")
+	fd_SwapPruneCursor_expiration = md_SwapPruneCursor.Fields().ByName("expiration")
+}
+
+var _ protoreflect.Message = (*fastReflection_SwapPruneCursor)(nil)
+
+type fastReflection_SwapPruneCursor SwapPruneCursor
+
+func (x *SwapPruneCursor) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SwapPruneCursor)(x)
+}
+
+func (x *SwapPruneCursor) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SwapPruneCursor_messageType fastReflection_SwapPruneCursor_messageType
+var _ protoreflect.MessageType = fastReflection_SwapPruneCursor_messageType{}
+
+type fastReflection_SwapPruneCursor_messageType struct{}
+
+func (x fastReflection_SwapPruneCursor_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SwapPruneCursor)(nil)
+}
+func (x fastReflection_SwapPruneCursor_messageType) New() protoreflect.Message {
+	return new(fastReflection_SwapPruneCursor)
+}
+func (x fastReflection_SwapPruneCursor_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SwapPruneCursor
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SwapPruneCursor) Descriptor() protoreflect.MessageDescriptor {
+	return md_SwapPruneCursor
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SwapPruneCursor) Type() protoreflect.MessageType {
+	return _fastReflection_SwapPruneCursor_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SwapPruneCursor) New() protoreflect.Message {
+	return new(fastReflection_SwapPruneCursor)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SwapPruneCursor) Interface() protoreflect.ProtoMessage {
+	return (*SwapPruneCursor)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SwapPruneCursor) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Expiration != nil {
+		value := protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+		if !f(fd_SwapPruneCursor_expiration, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SwapPruneCursor) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.SwapPruneCursor.expiration":
+		return x.Expiration != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SwapPruneCursor"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SwapPruneCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SwapPruneCursor) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.SwapPruneCursor.expiration":
+		x.Expiration = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SwapPruneCursor"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SwapPruneCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SwapPruneCursor) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.SwapPruneCursor.expiration":
+		value := x.Expiration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SwapPruneCursor"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SwapPruneCursor does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SwapPruneCursor) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.SwapPruneCursor.expiration":
+		x.Expiration = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SwapPruneCursor"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SwapPruneCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SwapPruneCursor) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.SwapPruneCursor.expiration":
+		if x.Expiration == nil {
+			x.Expiration = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SwapPruneCursor"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SwapPruneCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SwapPruneCursor) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.SwapPruneCursor.expiration":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SwapPruneCursor"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SwapPruneCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SwapPruneCursor) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.SwapPruneCursor", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SwapPruneCursor) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SwapPruneCursor) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SwapPruneCursor) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SwapPruneCursor) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SwapPruneCursor)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Expiration != nil {
+			l = options.Size(x.Expiration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SwapPruneCursor)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Expiration != nil {
+			encoded, err := options.Marshal(x.Expiration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SwapPruneCursor)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SwapPruneCursor: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SwapPruneCursor: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Expiration == nil {
+					x.Expiration = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ForwardContractPruneCursor          protoreflect.MessageDescriptor
+	fd_ForwardContractPruneCursor_deadline protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_ForwardContractPruneCursor = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("ForwardContractPruneCursor")
+	fd_ForwardContractPruneCursor_deadline = md_ForwardContractPruneCursor.Fields().ByName("deadline")
+}
+
+var _ protoreflect.Message = (*fastReflection_ForwardContractPruneCursor)(nil)
+
+type fastReflection_ForwardContractPruneCursor ForwardContractPruneCursor
+
+func (x *ForwardContractPruneCursor) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ForwardContractPruneCursor)(x)
+}
+
+func (x *ForwardContractPruneCursor) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ForwardContractPruneCursor_messageType fastReflection_ForwardContractPruneCursor_messageType
+var _ protoreflect.MessageType = fastReflection_ForwardContractPruneCursor_messageType{}
+
+type fastReflection_ForwardContractPruneCursor_messageType struct{}
+
+func (x fastReflection_ForwardContractPruneCursor_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ForwardContractPruneCursor)(nil)
+}
+func (x fastReflection_ForwardContractPruneCursor_messageType) New() protoreflect.Message {
+	return new(fastReflection_ForwardContractPruneCursor)
+}
+func (x fastReflection_ForwardContractPruneCursor_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ForwardContractPruneCursor
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ForwardContractPruneCursor) Descriptor() protoreflect.MessageDescriptor {
+	return md_ForwardContractPruneCursor
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ForwardContractPruneCursor) Type() protoreflect.MessageType {
+	return _fastReflection_ForwardContractPruneCursor_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ForwardContractPruneCursor) New() protoreflect.Message {
+	return new(fastReflection_ForwardContractPruneCursor)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ForwardContractPruneCursor) Interface() protoreflect.ProtoMessage {
+	return (*ForwardContractPruneCursor)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ForwardContractPruneCursor) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Deadline != nil {
+		value := protoreflect.ValueOfMessage(x.Deadline.ProtoReflect())
+		if !f(fd_ForwardContractPruneCursor_deadline, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ForwardContractPruneCursor) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.ForwardContractPruneCursor.deadline":
+		return x.Deadline != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.ForwardContractPruneCursor"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.ForwardContractPruneCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForwardContractPruneCursor) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.ForwardContractPruneCursor.deadline":
+		x.Deadline = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.ForwardContractPruneCursor"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.ForwardContractPruneCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ForwardContractPruneCursor) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.ForwardContractPruneCursor.deadline":
+		value := x.Deadline
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.ForwardContractPruneCursor"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.ForwardContractPruneCursor does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForwardContractPruneCursor) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.ForwardContractPruneCursor.deadline":
+		x.Deadline = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.ForwardContractPruneCursor"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.ForwardContractPruneCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForwardContractPruneCursor) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.ForwardContractPruneCursor.deadline":
+		if x.Deadline == nil {
+			x.Deadline = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Deadline.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.ForwardContractPruneCursor"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.ForwardContractPruneCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ForwardContractPruneCursor) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.ForwardContractPruneCursor.deadline":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.ForwardContractPruneCursor"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.ForwardContractPruneCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ForwardContractPruneCursor) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.ForwardContractPruneCursor", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ForwardContractPruneCursor) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForwardContractPruneCursor) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ForwardContractPruneCursor) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ForwardContractPruneCursor) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ForwardContractPruneCursor)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Deadline != nil {
+			l = options.Size(x.Deadline)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ForwardContractPruneCursor)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Deadline != nil {
+			encoded, err := options.Marshal(x.Deadline)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ForwardContractPruneCursor)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ForwardContractPruneCursor: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ForwardContractPruneCursor: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Deadline == nil {
+					x.Deadline = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Deadline); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_BuyOrderPruneCursor            protoreflect.MessageDescriptor
+	fd_BuyOrderPruneCursor_expiration protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_BuyOrderPruneCursor = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("BuyOrderPruneCursor")
+	fd_BuyOrderPruneCursor_expiration = md_BuyOrderPruneCursor.Fields().ByName("expiration")
+}
+
+var _ protoreflect.Message = (*fastReflection_BuyOrderPruneCursor)(nil)
+
+type fastReflection_BuyOrderPruneCursor BuyOrderPruneCursor
+
+func (x *BuyOrderPruneCursor) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BuyOrderPruneCursor)(x)
+}
+
+func (x *BuyOrderPruneCursor) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BuyOrderPruneCursor_messageType fastReflection_BuyOrderPruneCursor_messageType
+var _ protoreflect.MessageType = fastReflection_BuyOrderPruneCursor_messageType{}
+
+type fastReflection_BuyOrderPruneCursor_messageType struct{}
+
+func (x fastReflection_BuyOrderPruneCursor_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BuyOrderPruneCursor)(nil)
+}
+func (x fastReflection_BuyOrderPruneCursor_messageType) New() protoreflect.Message {
+	return new(fastReflection_BuyOrderPruneCursor)
+}
+func (x fastReflection_BuyOrderPruneCursor_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BuyOrderPruneCursor
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BuyOrderPruneCursor) Descriptor() protoreflect.MessageDescriptor {
+	return md_BuyOrderPruneCursor
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BuyOrderPruneCursor) Type() protoreflect.MessageType {
+	return _fastReflection_BuyOrderPruneCursor_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BuyOrderPruneCursor) New() protoreflect.Message {
+	return new(fastReflection_BuyOrderPruneCursor)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BuyOrderPruneCursor) Interface() protoreflect.ProtoMessage {
+	return (*BuyOrderPruneCursor)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BuyOrderPruneCursor) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Expiration != nil {
+		value := protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+		if !f(fd_BuyOrderPruneCursor_expiration, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BuyOrderPruneCursor) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrderPruneCursor.expiration":
+		return x.Expiration != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrderPruneCursor"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrderPruneCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BuyOrderPruneCursor) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrderPruneCursor.expiration":
+		x.Expiration = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrderPruneCursor"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrderPruneCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BuyOrderPruneCursor) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrderPruneCursor.expiration":
+		value := x.Expiration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrderPruneCursor"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrderPruneCursor does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BuyOrderPruneCursor) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrderPruneCursor.expiration":
+		x.Expiration = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrderPruneCursor"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrderPruneCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BuyOrderPruneCursor) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrderPruneCursor.expiration":
+		if x.Expiration == nil {
+			x.Expiration = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrderPruneCursor"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrderPruneCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BuyOrderPruneCursor) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrderPruneCursor.expiration":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrderPruneCursor"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrderPruneCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BuyOrderPruneCursor) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.BuyOrderPruneCursor", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BuyOrderPruneCursor) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BuyOrderPruneCursor) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BuyOrderPruneCursor) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BuyOrderPruneCursor) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BuyOrderPruneCursor)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Expiration != nil {
+			l = options.Size(x.Expiration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BuyOrderPruneCursor)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Expiration != nil {
+			encoded, err := options.Marshal(x.Expiration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BuyOrderPruneCursor)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BuyOrderPruneCursor: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BuyOrderPruneCursor: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Expiration == nil {
+					x.Expiration = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: regen/ecocredit/marketplace/v1/state.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// FeeDestination is the destination of the trading fees charged when credits
+// are purchased in the marketplace.
+//
+// Since Revision 1
+type FeeDestination int32
+
+const (
+	// FEE_DESTINATION_UNSPECIFIED is the default value and is not a valid
+	// destination.
+	FeeDestination_FEE_DESTINATION_UNSPECIFIED FeeDestination = 0
+	// FEE_DESTINATION_BURN burns the trading fees.
+	FeeDestination_FEE_DESTINATION_BURN FeeDestination = 1
+	// FEE_DESTINATION_COMMUNITY_POOL sends the trading fees to the community
+	// pool.
+	FeeDestination_FEE_DESTINATION_COMMUNITY_POOL FeeDestination = 2
+	// FEE_DESTINATION_MODULE_ACCOUNT sends the trading fees to a module account.
+	FeeDestination_FEE_DESTINATION_MODULE_ACCOUNT FeeDestination = 3
+)
+
+// Enum value maps for FeeDestination.
+var (
+	FeeDestination_name = map[int32]string{
+		0: "FEE_DESTINATION_UNSPECIFIED",
+		1: "FEE_DESTINATION_BURN",
+		2: "FEE_DESTINATION_COMMUNITY_POOL",
+		3: "FEE_DESTINATION_MODULE_ACCOUNT",
+	}
+	FeeDestination_value = map[string]int32{
+		"FEE_DESTINATION_UNSPECIFIED":    0,
+		"FEE_DESTINATION_BURN":           1,
+		"FEE_DESTINATION_COMMUNITY_POOL": 2,
+		"FEE_DESTINATION_MODULE_ACCOUNT": 3,
+	}
+)
+
+func (x FeeDestination) Enum() *FeeDestination {
+	p := new(FeeDestination)
+	*p = x
+	return p
+}
+
+func (x FeeDestination) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FeeDestination) Descriptor() protoreflect.EnumDescriptor {
+	return file_regen_ecocredit_marketplace_v1_state_proto_enumTypes[0].Descriptor()
+}
+
+func (FeeDestination) Type() protoreflect.EnumType {
+	return &file_regen_ecocredit_marketplace_v1_state_proto_enumTypes[0]
+}
+
+func (x FeeDestination) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FeeDestination.Descriptor instead.
+func (FeeDestination) EnumDescriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{0}
+}
+
+// AuctionType is the type of an auction.
+//
+// Since Revision 1
+type AuctionType int32
+
+const (
+	// AUCTION_TYPE_UNSPECIFIED is the default value and is not a valid auction
+	// type.
+	AuctionType_AUCTION_TYPE_UNSPECIFIED AuctionType = 0
+	// AUCTION_TYPE_ENGLISH is an ascending price auction. Each bid must be at
+	// least the start price and higher than the current highest bid. The highest
+	// bidder wins the auction when the end time is reached.
+	AuctionType_AUCTION_TYPE_ENGLISH AuctionType = 1
+	// AUCTION_TYPE_DUTCH is a descending price auction. The price decreases
+	// linearly from the start price to the end price over the duration of the
+	// auction and the first bid at or above the current price wins the auction
+	// at the current price.
+	AuctionType_AUCTION_TYPE_DUTCH AuctionType = 2
+)
+
+// Enum value maps for AuctionType.
+var (
+	AuctionType_name = map[int32]string{
+		0: "AUCTION_TYPE_UNSPECIFIED",
+		1: "AUCTION_TYPE_ENGLISH",
+		2: "AUCTION_TYPE_DUTCH",
+	}
+	AuctionType_value = map[string]int32{
+		"AUCTION_TYPE_UNSPECIFIED": 0,
+		"AUCTION_TYPE_ENGLISH":     1,
+		"AUCTION_TYPE_DUTCH":       2,
+	}
+)
+
+func (x AuctionType) Enum() *AuctionType {
+	p := new(AuctionType)
+	*p = x
+	return p
+}
+
+func (x AuctionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuctionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_regen_ecocredit_marketplace_v1_state_proto_enumTypes[1].Descriptor()
+}
+
+func (AuctionType) Type() protoreflect.EnumType {
+	return &file_regen_ecocredit_marketplace_v1_state_proto_enumTypes[1]
+}
+
+func (x AuctionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuctionType.Descriptor instead.
+func (AuctionType) EnumDescriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{1}
+}
+
+// SellOrder represents the information for a sell order.
+type SellOrder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique ID of sell order.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// seller is the address of the account that is selling credits.
+	Seller []byte `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// batch_key is the table row identifier of the credit batch used internally
+	// for efficient lookups. This links a sell order to a credit batch.
+	BatchKey uint64 `protobuf:"varint,3,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// quantity is the decimal quantity of credits being sold.
+	Quantity string `protobuf:"bytes,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// market_id is the market in which this sell order exists and specifies
+	// the bank_denom that ask_amount corresponds to forming the ask_price.
+	MarketId uint64 `protobuf:"varint,5,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// ask_amount is the integer amount (encoded as a string) that the seller is
+	// asking for each credit unit of the batch. Each credit unit of the batch
+	// will be sold for at least the ask_amount. The ask_amount corresponds to the
+	// Market.denom to form the ask price.
+	AskAmount string `protobuf:"bytes,6,opt,name=ask_amount,json=askAmount,proto3" json:"ask_amount,omitempty"`
+	// disable_auto_retire disables auto-retirement of credits which allows a
+	// buyer to disable auto-retirement in their buy order enabling them to
+	// resell the credits to another buyer.
+	DisableAutoRetire bool `protobuf:"varint,7,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
+	// expiration is an optional timestamp when the sell order expires. When the
+	// expiration time is reached, the sell order is removed from state.
+	Expiration *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// maker indicates that this is a maker order, meaning that when it hit
+	// the order book, there were no matching buy orders.
+	Maker bool `protobuf:"varint,10,opt,name=maker,proto3" json:"maker,omitempty"`
+	// allowed_buyers is an optional list of the addresses of the accounts that
+	// are allowed to buy credits from the sell order. An allowed buyer may be a
+	// group policy address, in which case credits can only be bought through a
+	// proposal executed by the group policy. If empty, any account can buy
+	// credits from the sell order.
+	//
+	// Since Revision 1
+	AllowedBuyers [][]byte `protobuf:"bytes,11,rep,name=allowed_buyers,json=allowedBuyers,proto3" json:"allowed_buyers,omitempty"`
+	// sortable_ask_amount is the ask_amount encoded as a zero-padded decimal
+	// string of fixed length so that sell orders can be sorted and filtered by
+	// ask amount using the market_id and sortable_ask_amount index. It is set
+	// by the module whenever ask_amount is set.
+	//
+	// Since Revision 1
+	SortableAskAmount string `protobuf:"bytes,12,opt,name=sortable_ask_amount,json=sortableAskAmount,proto3" json:"sortable_ask_amount,omitempty"`
+}
+
+func (x *SellOrder) Reset() {
+	*x = SellOrder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SellOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SellOrder) ProtoMessage() {}
+
+// Deprecated: Use SellOrder.ProtoReflect.Descriptor instead.
+func (*SellOrder) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SellOrder) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SellOrder) GetSeller() []byte {
+	if x != nil {
+		return x.Seller
+	}
+	return nil
+}
+
+func (x *SellOrder) GetBatchKey() uint64 {
+	if x != nil {
+		return x.BatchKey
+	}
+	return 0
+}
+
+func (x *SellOrder) GetQuantity() string {
+	if x != nil {
+		return x.Quantity
+	}
+	return ""
+}
+
+func (x *SellOrder) GetMarketId() uint64 {
+	if x != nil {
+		return x.MarketId
+	}
+	return 0
+}
+
+func (x *SellOrder) GetAskAmount() string {
+	if x != nil {
+		return x.AskAmount
+	}
+	return ""
+}
+
+func (x *SellOrder) GetDisableAutoRetire() bool {
+	if x != nil {
+		return x.DisableAutoRetire
+	}
+	return false
+}
+
+func (x *SellOrder) GetExpiration() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
+func (x *SellOrder) GetMaker() bool {
+	if x != nil {
+		return x.Maker
+	}
+	return false
+}
+
+func (x *SellOrder) GetAllowedBuyers() [][]byte {
+	if x != nil {
+		return x.AllowedBuyers
+	}
+	return nil
+}
+
+func (x *SellOrder) GetSortableAskAmount() string {
+	if x != nil {
+		return x.SortableAskAmount
+	}
+	return ""
+}
+
+// BuyOrder represents the information for a buy order. The bid amount
+// multiplied by the quantity is held in escrow by the ecocredit module account
+// until the buy order is filled, cancelled, or expired.
+//
+// Since Revision 1
+type BuyOrder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique ID of buy order.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// buyer is the address of the account that is buying credits.
+	Buyer []byte `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// batch_key is the table row identifier of the credit batch used internally
+	// for efficient lookups. This links a buy order to the credit batch that the
+	// buyer is bidding on. Exactly one of batch_key, class_key, or project_key is
+	// set.
+	BatchKey uint64 `protobuf:"varint,3,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// quantity is the decimal quantity of credits remaining to be bought.
+	Quantity string `protobuf:"bytes,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// market_id is the market in which this buy order exists and specifies
+	// the bank_denom that bid_amount corresponds to forming the bid_price.
+	MarketId uint64 `protobuf:"varint,5,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// bid_amount is the integer amount (encoded as a string) that the buyer is
+	// willing to pay for each credit unit. Each credit unit will be bought for
+	// at most the bid_amount. The bid_amount corresponds to the Market.denom to
+	// form the bid price.
+	BidAmount string `protobuf:"bytes,6,opt,name=bid_amount,json=bidAmount,proto3" json:"bid_amount,omitempty"`
+	// disable_auto_retire disables auto-retirement of credits which allows the
+	// buyer to receive credits in a tradable state. A buy order with
+	// disable_auto_retire set to true will only be matched with sell orders that
+	// also have disable_auto_retire set to true.
+	DisableAutoRetire bool `protobuf:"varint,7,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
+	// retirement_jurisdiction is the optional retirement jurisdiction for the
+	// credits which will be used only if disable_auto_retire is false.
+	RetirementJurisdiction string `protobuf:"bytes,8,opt,name=retirement_jurisdiction,json=retirementJurisdiction,proto3" json:"retirement_jurisdiction,omitempty"`
+	// expiration is an optional timestamp when the buy order expires. When the
+	// expiration time is reached, the buy order is removed from state and the
+	// remaining escrowed funds are returned to the buyer.
+	Expiration *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// maker indicates that this is a maker order, meaning that when it hit
+	// the order book, there were no matching sell orders.
+	Maker bool `protobuf:"varint,10,opt,name=maker,proto3" json:"maker,omitempty"`
+	// class_key is the table row identifier of the credit class used internally
+	// for efficient lookups. If set, the buy order can be filled with credits
+	// from any credit batch within the credit class that meets the criteria.
+	ClassKey uint64 `protobuf:"varint,11,opt,name=class_key,json=classKey,proto3" json:"class_key,omitempty"`
+	// project_key is the table row identifier of the project used internally
+	// for efficient lookups. If set, the buy order can be filled with credits
+	// from any credit batch within the project that meets the criteria.
+	ProjectKey uint64 `protobuf:"varint,12,opt,name=project_key,json=projectKey,proto3" json:"project_key,omitempty"`
+	// project_location is an optional jurisdiction that the project of the
+	// credit batch must be located in. Only used with class_key.
+	ProjectLocation string `protobuf:"bytes,13,opt,name=project_location,json=projectLocation,proto3" json:"project_location,omitempty"`
+	// min_start_date is an optional minimum start date of the credit batch.
+	// Only used with class_key or project_key.
+	MinStartDate *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=min_start_date,json=minStartDate,proto3" json:"min_start_date,omitempty"`
+	// max_end_date is an optional maximum end date of the credit batch. Only
+	// used with class_key or project_key.
+	MaxEndDate *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=max_end_date,json=maxEndDate,proto3" json:"max_end_date,omitempty"`
+	// retirement_reason is any arbitrary string that specifies the reason for
+	// retiring the credits which will be used only if disable_auto_retire is
+	// false.
+	//
+	// Since Revision 1
+	RetirementReason string `protobuf:"bytes,16,opt,name=retirement_reason,json=retirementReason,proto3" json:"retirement_reason,omitempty"`
+	// retirement_beneficiary is any arbitrary string that specifies the person
+	// or organization on whose behalf the credits are retired which will be
+	// used only if disable_auto_retire is false.
+	//
+	// Since Revision 1
+	RetirementBeneficiary string `protobuf:"bytes,17,opt,name=retirement_beneficiary,json=retirementBeneficiary,proto3" json:"retirement_beneficiary,omitempty"`
+	// escrowed_fee_bps is the trading fee in basis points that is held in escrow
+	// in addition to the total bid amount, i.e. the higher of the maker and taker
+	// fees when the buy order was created. The buyer fee of each fill is paid
+	// from the escrowed fee and the remainder is returned to the buyer.
+	//
+	// Since Revision 1
+	EscrowedFeeBps uint32 `protobuf:"varint,18,opt,name=escrowed_fee_bps,json=escrowedFeeBps,proto3" json:"escrowed_fee_bps,omitempty"`
+}
+
+func (x *BuyOrder) Reset() {
+	*x = BuyOrder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuyOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyOrder) ProtoMessage() {}
+
+// Deprecated: Use BuyOrder.ProtoReflect.Descriptor instead.
+func (*BuyOrder) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BuyOrder) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BuyOrder) GetBuyer() []byte {
+	if x != nil {
+		return x.Buyer
+	}
+	return nil
+}
+
+func (x *BuyOrder) GetBatchKey() uint64 {
+	if x != nil {
+		return x.BatchKey
+	}
+	return 0
+}
+
+func (x *BuyOrder) GetQuantity() string {
+	if x != nil {
+		return x.Quantity
+	}
+	return ""
+}
+
+func (x *BuyOrder) GetMarketId() uint64 {
+	if x != nil {
+		return x.MarketId
+	}
+	return 0
+}
+
+func (x *BuyOrder) GetBidAmount() string {
+	if x != nil {
+		return x.BidAmount
+	}
+	return ""
+}
+
+func (x *BuyOrder) GetDisableAutoRetire() bool {
+	if x != nil {
+		return x.DisableAutoRetire
+	}
+	return false
+}
+
+func (x *BuyOrder) GetRetirementJurisdiction() string {
+	if x != nil {
+		return x.RetirementJurisdiction
+	}
+	return ""
+}
+
+func (x *BuyOrder) GetExpiration() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
+func (x *BuyOrder) GetMaker() bool {
+	if x != nil {
+		return x.Maker
+	}
+	return false
+}
+
+func (x *BuyOrder) GetClassKey() uint64 {
+	if x != nil {
+		return x.ClassKey
+	}
+	return 0
+}
+
+func (x *BuyOrder) GetProjectKey() uint64 {
+	if x != nil {
+		return x.ProjectKey
+	}
+	return 0
+}
+
+func (x *BuyOrder) GetProjectLocation() string {
+	if x != nil {
+		return x.ProjectLocation
+	}
+	return ""
+}
+
+func (x *BuyOrder) GetMinStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MinStartDate
+	}
+	return nil
+}
+
+func (x *BuyOrder) GetMaxEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MaxEndDate
+	}
+	return nil
+}
+
+func (x *BuyOrder) GetRetirementReason() string {
+	if x != nil {
+		return x.RetirementReason
+	}
+	return ""
+}
+
+func (x *BuyOrder) GetRetirementBeneficiary() string {
+	if x != nil {
+		return x.RetirementBeneficiary
+	}
+	return ""
+}
+
+func (x *BuyOrder) GetEscrowedFeeBps() uint32 {
+	if x != nil {
+		return x.EscrowedFeeBps
+	}
+	return 0
+}
+
+// AllowedDenom represents the information for an allowed ask/bid denom.
+type AllowedDenom struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// denom is the bank denom to allow (ex. ibc/GLKHDSG423SGS)
+	BankDenom string `protobuf:"bytes,1,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
+	// display_denom is the denom to display to the user and is informational.
+	// Because the denom is likely an IBC denom, this should be chosen by
+	// governance to represent the consensus trusted name of the denom.
+	DisplayDenom string `protobuf:"bytes,2,opt,name=display_denom,json=displayDenom,proto3" json:"display_denom,omitempty"`
+	// exponent is the exponent that relates the denom to the display_denom and is
+	// informational
+	Exponent uint32 `protobuf:"varint,3,opt,name=exponent,proto3" json:"exponent,omitempty"`
+	// ibc_path is the IBC denom trace path (ex. transfer/channel-0) of an IBC
+	// denom, recorded when the denom is allowed. It is empty for denoms that are
+	// not IBC denoms.
+	//
+	// Since Revision 1
+	IbcPath string `protobuf:"bytes,4,opt,name=ibc_path,json=ibcPath,proto3" json:"ibc_path,omitempty"`
+	// ibc_base_denom is the IBC denom trace base denom (ex. uatom) of an IBC
+	// denom, recorded when the denom is allowed. It is empty for denoms that are
+	// not IBC denoms.
+	//
+	// Since Revision 1
+	IbcBaseDenom string `protobuf:"bytes,5,opt,name=ibc_base_denom,json=ibcBaseDenom,proto3" json:"ibc_base_denom,omitempty"`
+}
+
+func (x *AllowedDenom) Reset() {
+	*x = AllowedDenom{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllowedDenom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllowedDenom) ProtoMessage() {}
+
+// Deprecated: Use AllowedDenom.ProtoReflect.Descriptor instead.
+func (*AllowedDenom) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AllowedDenom) GetBankDenom() string {
+	if x != nil {
+		return x.BankDenom
+	}
+	return ""
+}
+
+func (x *AllowedDenom) GetDisplayDenom() string {
+	if x != nil {
+		return x.DisplayDenom
+	}
+	return ""
+}
+
+func (x *AllowedDenom) GetExponent() uint32 {
+	if x != nil {
+		return x.Exponent
+	}
+	return 0
+}
+
+func (x *AllowedDenom) GetIbcPath() string {
+	if x != nil {
+		return x.IbcPath
+	}
+	return ""
+}
+
+func (x *AllowedDenom) GetIbcBaseDenom() string {
+	if x != nil {
+		return x.IbcBaseDenom
+	}
+	return ""
+}
+
+// Market describes a distinctly processed market between a credit type and
+// allowed bank denom. Each market has its own precision in the order book
+// and is processed independently of other markets. Governance must enable
+// markets one by one. Every additional enabled market potentially adds more
+// processing overhead to the blockchain and potentially weakens liquidity in
+// competing markets. For instance, enabling side by side USD/Carbon and
+// EUR/Carbon markets may have the end result that each market individually has
+// less liquidity and longer settlement times. Such decisions should be taken
+// with care.
+type Market struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique ID of the market.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// credit_type_abbrev is the abbreviation of the credit type.
+	CreditTypeAbbrev string `protobuf:"bytes,2,opt,name=credit_type_abbrev,json=creditTypeAbbrev,proto3" json:"credit_type_abbrev,omitempty"`
+	// bank_denom is an allowed bank denom.
+	BankDenom string `protobuf:"bytes,3,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
+	// precision_modifier is an optional modifier used to convert arbitrary
+	// precision integer bank amounts to uint32 values used for sorting in the
+	// order book. Given an arbitrary precision integer x, its uint32 conversion
+	// will be x / 10^precision_modifier using round half away from zero
+	// rounding.
+	//
+	// uint32 values range from 0 to 4,294,967,295.
+	// This allows for a full 9 digits of precision. In most real world markets
+	// this amount of precision is sufficient and most common downside -
+	// that some orders with very miniscule price differences may be ordered
+	// equivalently (because of rounding) - is acceptable.
+	// Note that this rounding will not affect settlement price which will
+	// always be done exactly.
+	//
+	// Given a USD stable coin with 6 decimal digits, a precision_modifier
+	// of 0 is probably acceptable as long as credits are always less than
+	// $4,294/unit. With precision down to $0.001 (a precision_modifier of 3
+	// in this case), prices can rise up to $4,294,000/unit. Either scenario
+	// is probably quite acceptable given that carbon prices are unlikely to
+	// rise above $1000/ton any time in the near future.
+	//
+	// If credit prices, exceed the maximum range of uint32 with this
+	// precision_modifier, orders with high prices will fail and governance
+	// will need to adjust precision_modifier to allow for higher prices in
+	// exchange for less precision at the lower end.
+	PrecisionModifier uint32 `protobuf:"varint,4,opt,name=precision_modifier,json=precisionModifier,proto3" json:"precision_modifier,omitempty"`
+}
+
+func (x *Market) Reset() {
+	*x = Market{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Market) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Market) ProtoMessage() {}
+
+// Deprecated: Use Market.ProtoReflect.Descriptor instead.
+func (*Market) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Market) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Market) GetCreditTypeAbbrev() string {
+	if x != nil {
+		return x.CreditTypeAbbrev
+	}
+	return ""
+}
+
+func (x *Market) GetBankDenom() string {
+	if x != nil {
+		return x.BankDenom
+	}
+	return ""
+}
+
+func (x *Market) GetPrecisionModifier() uint32 {
+	if x != nil {
+		return x.PrecisionModifier
+	}
+	return 0
+}
+
+// TradingFee is the marketplace trading fee. Trading fees are a percentage of
+// the total cost of a purchase expressed in basis points (1/100th of a
+// percent) and are charged on direct purchases, filled buy orders, settled
+// auctions, accepted offers and accepted swaps. A party is charged the maker
+// fee if its order was on the market before the trade (a maker sell or buy
+// order, an auction, an offer or a counter offer that is accepted, or a swap
+// proposal) and the taker fee otherwise. The buyer fee is paid in addition to
+// the cost and the seller fee is deducted from the cost paid to the seller.
+// If not set, trading fees are not charged. This table is controlled via
+// governance.
+//
+// Since Revision 1
+type TradingFee struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// maker_fee_bps is the fee in basis points charged to the seller of a maker
+	// sell order.
+	MakerFeeBps uint32 `protobuf:"varint,1,opt,name=maker_fee_bps,json=makerFeeBps,proto3" json:"maker_fee_bps,omitempty"`
+	// taker_fee_bps is the fee in basis points charged to the buyer and to the
+	// seller of a taker sell order.
+	TakerFeeBps uint32 `protobuf:"varint,2,opt,name=taker_fee_bps,json=takerFeeBps,proto3" json:"taker_fee_bps,omitempty"`
+	// destination is the destination of the trading fees.
+	Destination FeeDestination `protobuf:"varint,3,opt,name=destination,proto3,enum=regen.ecocredit.marketplace.v1.FeeDestination" json:"destination,omitempty"`
+	// module_account is the name of the module account that receives the trading
+	// fees. Only set when destination is FEE_DESTINATION_MODULE_ACCOUNT.
+	ModuleAccount string `protobuf:"bytes,4,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty"`
+}
+
+func (x *TradingFee) Reset() {
+	*x = TradingFee{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TradingFee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradingFee) ProtoMessage() {}
+
+// Deprecated: Use TradingFee.ProtoReflect.Descriptor instead.
+func (*TradingFee) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TradingFee) GetMakerFeeBps() uint32 {
+	if x != nil {
+		return x.MakerFeeBps
+	}
+	return 0
+}
+
+func (x *TradingFee) GetTakerFeeBps() uint32 {
+	if x != nil {
+		return x.TakerFeeBps
+	}
+	return 0
+}
+
+func (x *TradingFee) GetDestination() FeeDestination {
+	if x != nil {
+		return x.Destination
+	}
+	return FeeDestination_FEE_DESTINATION_UNSPECIFIED
+}
+
+func (x *TradingFee) GetModuleAccount() string {
+	if x != nil {
+		return x.ModuleAccount
+	}
+	return ""
+}
+
+// ClassRoyalty is a royalty set by the admin of a credit class that is paid to
+// the recipient whenever credits from the batches of the credit class are sold
+// in the marketplace. The royalty is a percentage of the amount paid to the
+// seller (after trading fees) expressed in basis points (1/100th of a
+// percent).
+//
+// Since Revision 1
+type ClassRoyalty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// class_key is the table row identifier of the credit class used internally
+	// for efficient lookups. This links a class royalty to a credit class.
+	ClassKey uint64 `protobuf:"varint,1,opt,name=class_key,json=classKey,proto3" json:"class_key,omitempty"`
+	// royalty_bps is the royalty in basis points paid on each sale of credits
+	// from the credit class.
+	RoyaltyBps uint32 `protobuf:"varint,2,opt,name=royalty_bps,json=royaltyBps,proto3" json:"royalty_bps,omitempty"`
+	// recipient is the address of the account that receives the royalty.
+	Recipient []byte `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (x *ClassRoyalty) Reset() {
+	*x = ClassRoyalty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClassRoyalty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassRoyalty) ProtoMessage() {}
+
+// Deprecated: Use ClassRoyalty.ProtoReflect.Descriptor instead.
+func (*ClassRoyalty) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ClassRoyalty) GetClassKey() uint64 {
+	if x != nil {
+		return x.ClassKey
+	}
+	return 0
+}
+
+func (x *ClassRoyalty) GetRoyaltyBps() uint32 {
+	if x != nil {
+		return x.RoyaltyBps
+	}
+	return 0
+}
+
+func (x *ClassRoyalty) GetRecipient() []byte {
+	if x != nil {
+		return x.Recipient
+	}
+	return nil
+}
+
+// Trade is a record of credits purchased in the marketplace, either from a sell
+// order (directly or by a buy order being matched with the sell order) or by
+// winning an auction.
+//
+// Since Revision 1
+type Trade struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique identifier of the trade.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// buyer is the address of the account that purchased the credits.
+	Buyer []byte `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// seller is the address of the account that sold the credits.
+	Seller []byte `protobuf:"bytes,3,opt,name=seller,proto3" json:"seller,omitempty"`
+	// batch_key is the table row identifier of the credit batch used internally
+	// for efficient lookups. This links a trade to the credit batch traded.
+	BatchKey uint64 `protobuf:"varint,4,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// market_id is the market in which the trade was executed.
+	MarketId uint64 `protobuf:"varint,5,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// quantity is the quantity of credits purchased.
+	Quantity string `protobuf:"bytes,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// price is the price paid per credit in the bank denom of the market, i.e.
+	// the ask amount of the sell order or the winning bid amount of the auction.
+	Price string `protobuf:"bytes,7,opt,name=price,proto3" json:"price,omitempty"`
+	// timestamp is the block time at which the trade was executed.
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *Trade) Reset() {
+	*x = Trade{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Trade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Trade) ProtoMessage() {}
+
+// Deprecated: Use Trade.ProtoReflect.Descriptor instead.
+func (*Trade) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Trade) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Trade) GetBuyer() []byte {
+	if x != nil {
+		return x.Buyer
+	}
+	return nil
+}
+
+func (x *Trade) GetSeller() []byte {
+	if x != nil {
+		return x.Seller
+	}
+	return nil
+}
+
+func (x *Trade) GetBatchKey() uint64 {
+	if x != nil {
+		return x.BatchKey
+	}
+	return 0
+}
+
+func (x *Trade) GetMarketId() uint64 {
+	if x != nil {
+		return x.MarketId
+	}
+	return 0
+}
+
+func (x *Trade) GetQuantity() string {
+	if x != nil {
+		return x.Quantity
+	}
+	return ""
+}
+
+func (x *Trade) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *Trade) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+// Auction represents the information for an auction of credits. The credits
+// being auctioned are held in the escrowed balance of the seller and the
+// highest bid is held in escrow by the ecocredit module account until the
+// auction is settled at the beginning of the first block after the end time.
+//
+// Since Revision 1
+type Auction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique ID of the auction.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// seller is the address of the account that is selling credits.
+	Seller []byte `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// batch_key is the table row identifier of the credit batch used internally
+	// for efficient lookups. This links an auction to a credit batch.
+	BatchKey uint64 `protobuf:"varint,3,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// quantity is the decimal quantity of credits being auctioned.
+	Quantity string `protobuf:"bytes,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// market_id is the market in which this auction exists and specifies the
+	// bank_denom that the start_amount, end_amount, and bid amounts correspond
+	// to.
+	MarketId uint64 `protobuf:"varint,5,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// auction_type is the type of the auction.
+	AuctionType AuctionType `protobuf:"varint,6,opt,name=auction_type,json=auctionType,proto3,enum=regen.ecocredit.marketplace.v1.AuctionType" json:"auction_type,omitempty"`
+	// start_amount is the integer amount (encoded as a string) per credit at
+	// which the auction starts. For an English auction, this is the minimum bid
+	// amount. For a Dutch auction, this is the initial price.
+	StartAmount string `protobuf:"bytes,7,opt,name=start_amount,json=startAmount,proto3" json:"start_amount,omitempty"`
+	// end_amount is the integer amount (encoded as a string) per credit at which
+	// a Dutch auction ends, i.e. the lowest price the seller will accept. Only
+	// set for Dutch auctions.
+	EndAmount string `protobuf:"bytes,8,opt,name=end_amount,json=endAmount,proto3" json:"end_amount,omitempty"`
+	// start_time is the block time at which the auction was created.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time is the time at which the auction ends. The end time of a Dutch
+	// auction is set to the block time of the winning bid.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// disable_auto_retire allows the winning bidder to disable auto-retirement
+	// of the credits.
+	DisableAutoRetire bool `protobuf:"varint,11,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
+	// highest_bidder is the address of the account that placed the highest bid.
+	// Empty if there are no bids.
+	HighestBidder []byte `protobuf:"bytes,12,opt,name=highest_bidder,json=highestBidder,proto3" json:"highest_bidder,omitempty"`
+	// highest_bid_amount is the integer amount (encoded as a string) per credit
+	// of the highest bid. For a Dutch auction, this is the price of the auction
+	// at the time of the winning bid.
+	HighestBidAmount string `protobuf:"bytes,13,opt,name=highest_bid_amount,json=highestBidAmount,proto3" json:"highest_bid_amount,omitempty"`
+	// highest_bid_disable_auto_retire indicates that the highest bidder disabled
+	// auto-retirement of the credits.
+	HighestBidDisableAutoRetire bool `protobuf:"varint,14,opt,name=highest_bid_disable_auto_retire,json=highestBidDisableAutoRetire,proto3" json:"highest_bid_disable_auto_retire,omitempty"`
+	// highest_bid_retirement_jurisdiction is the retirement jurisdiction of the
+	// highest bidder which will be used only if auto-retirement is not disabled.
+	HighestBidRetirementJurisdiction string `protobuf:"bytes,15,opt,name=highest_bid_retirement_jurisdiction,json=highestBidRetirementJurisdiction,proto3" json:"highest_bid_retirement_jurisdiction,omitempty"`
+	// highest_bid_retirement_reason is the retirement reason of the highest
+	// bidder which will be used only if auto-retirement is not disabled.
+	HighestBidRetirementReason string `protobuf:"bytes,16,opt,name=highest_bid_retirement_reason,json=highestBidRetirementReason,proto3" json:"highest_bid_retirement_reason,omitempty"`
+	// highest_bid_retirement_beneficiary is the retirement beneficiary of the
+	// highest bidder which will be used only if auto-retirement is not disabled.
+	HighestBidRetirementBeneficiary string `protobuf:"bytes,17,opt,name=highest_bid_retirement_beneficiary,json=highestBidRetirementBeneficiary,proto3" json:"highest_bid_retirement_beneficiary,omitempty"`
+	// highest_bid_escrowed_fee_bps is the trading fee in basis points that is
+	// held in escrow in addition to the highest bid, i.e. the higher of the maker
+	// and taker fees when the highest bid was placed. The buyer fee is paid from
+	// the escrowed fee and the remainder is returned to the highest bidder.
+	HighestBidEscrowedFeeBps uint32 `protobuf:"varint,18,opt,name=highest_bid_escrowed_fee_bps,json=highestBidEscrowedFeeBps,proto3" json:"highest_bid_escrowed_fee_bps,omitempty"`
+}
+
+func (x *Auction) Reset() {
+	*x = Auction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Auction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auction) ProtoMessage() {}
+
+// Deprecated: Use Auction.ProtoReflect.Descriptor instead.
+func (*Auction) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Auction) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Auction) GetSeller() []byte {
+	if x != nil {
+		return x.Seller
+	}
+	return nil
+}
+
+func (x *Auction) GetBatchKey() uint64 {
+	if x != nil {
+		return x.BatchKey
+	}
+	return 0
+}
+
+func (x *Auction) GetQuantity() string {
+	if x != nil {
+		return x.Quantity
+	}
+	return ""
+}
+
+func (x *Auction) GetMarketId() uint64 {
+	if x != nil {
+		return x.MarketId
+	}
+	return 0
+}
+
+func (x *Auction) GetAuctionType() AuctionType {
+	if x != nil {
+		return x.AuctionType
+	}
+	return AuctionType_AUCTION_TYPE_UNSPECIFIED
+}
+
+func (x *Auction) GetStartAmount() string {
+	if x != nil {
+		return x.StartAmount
+	}
+	return ""
+}
+
+func (x *Auction) GetEndAmount() string {
+	if x != nil {
+		return x.EndAmount
+	}
+	return ""
+}
+
+func (x *Auction) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *Auction) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *Auction) GetDisableAutoRetire() bool {
+	if x != nil {
+		return x.DisableAutoRetire
+	}
+	return false
+}
+
+func (x *Auction) GetHighestBidder() []byte {
+	if x != nil {
+		return x.HighestBidder
+	}
+	return nil
+}
+
+func (x *Auction) GetHighestBidAmount() string {
+	if x != nil {
+		return x.HighestBidAmount
+	}
+	return ""
+}
+
+func (x *Auction) GetHighestBidDisableAutoRetire() bool {
+	if x != nil {
+		return x.HighestBidDisableAutoRetire
+	}
+	return false
+}
+
+func (x *Auction) GetHighestBidRetirementJurisdiction() string {
+	if x != nil {
+		return x.HighestBidRetirementJurisdiction
+	}
+	return ""
+}
+
+func (x *Auction) GetHighestBidRetirementReason() string {
+	if x != nil {
+		return x.HighestBidRetirementReason
+	}
+	return ""
+}
+
+func (x *Auction) GetHighestBidRetirementBeneficiary() string {
+	if x != nil {
+		return x.HighestBidRetirementBeneficiary
+	}
+	return ""
+}
+
+func (x *Auction) GetHighestBidEscrowedFeeBps() uint32 {
+	if x != nil {
+		return x.HighestBidEscrowedFeeBps
+	}
+	return 0
+}
+
+// Offer represents a negotiated offer to buy credits from a sell order at a
+// price below the ask price of the sell order. The total bid amount is held in
+// escrow by the ecocredit module account until the offer is accepted,
+// rejected, or expires. Open offers are rejected when the sell order is
+// cancelled, filled, or expires.
+//
+// Since Revision 1
+type Offer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique ID of the offer.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sell_order_id is the ID of the sell order the offer was made on.
+	SellOrderId uint64 `protobuf:"varint,2,opt,name=sell_order_id,json=sellOrderId,proto3" json:"sell_order_id,omitempty"`
+	// buyer is the address of the account that made the offer.
+	Buyer []byte `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// quantity is the decimal quantity of credits the buyer is offering to buy.
+	Quantity string `protobuf:"bytes,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// market_id is the market of the sell order and specifies the bank_denom
+	// that bid_amount and counter_amount correspond to.
+	MarketId uint64 `protobuf:"varint,5,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// bid_amount is the integer amount (encoded as a string) per credit that the
+	// buyer is offering to pay. The total bid amount is held in escrow.
+	BidAmount string `protobuf:"bytes,6,opt,name=bid_amount,json=bidAmount,proto3" json:"bid_amount,omitempty"`
+	// counter_amount is the integer amount (encoded as a string) per credit of
+	// a counter offer made by the seller. If set, the offer is awaiting a
+	// response from the buyer, otherwise the offer is awaiting a response from
+	// the seller.
+	CounterAmount string `protobuf:"bytes,7,opt,name=counter_amount,json=counterAmount,proto3" json:"counter_amount,omitempty"`
+	// disable_auto_retire disables auto-retirement of credits which allows the
+	// buyer to receive credits in a tradable state.
+	DisableAutoRetire bool `protobuf:"varint,8,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
+	// retirement_jurisdiction is the optional retirement jurisdiction for the
+	// credits which will be used only if disable_auto_retire is false.
+	RetirementJurisdiction string `protobuf:"bytes,9,opt,name=retirement_jurisdiction,json=retirementJurisdiction,proto3" json:"retirement_jurisdiction,omitempty"`
+	// expiration is the timestamp when the offer expires. When the expiration
+	// time is reached, the offer is removed from state and the escrowed funds
+	// are returned to the buyer.
+	Expiration *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// retirement_reason is any arbitrary string that specifies the reason for
+	// retiring the credits which will be used only if disable_auto_retire is
+	// false.
+	RetirementReason string `protobuf:"bytes,11,opt,name=retirement_reason,json=retirementReason,proto3" json:"retirement_reason,omitempty"`
+	// retirement_beneficiary is any arbitrary string that specifies the person
+	// or organization on whose behalf the credits are retired which will be
+	// used only if disable_auto_retire is false.
+	RetirementBeneficiary string `protobuf:"bytes,12,opt,name=retirement_beneficiary,json=retirementBeneficiary,proto3" json:"retirement_beneficiary,omitempty"`
+	// escrowed_fee_bps is the trading fee in basis points that is held in escrow
+	// in addition to the total bid amount, i.e. the higher of the maker and taker
+	// fees when the offer was made. The buyer fee is paid from the escrowed fee
+	// and the remainder is returned to the buyer.
+	EscrowedFeeBps uint32 `protobuf:"varint,13,opt,name=escrowed_fee_bps,json=escrowedFeeBps,proto3" json:"escrowed_fee_bps,omitempty"`
+}
+
+func (x *Offer) Reset() {
+	*x = Offer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Offer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Offer) ProtoMessage() {}
+
+// Deprecated: Use Offer.ProtoReflect.Descriptor instead.
+func (*Offer) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Offer) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Offer) GetSellOrderId() uint64 {
+	if x != nil {
+		return x.SellOrderId
+	}
+	return 0
+}
+
+func (x *Offer) GetBuyer() []byte {
+	if x != nil {
+		return x.Buyer
+	}
+	return nil
+}
+
+func (x *Offer) GetQuantity() string {
+	if x != nil {
+		return x.Quantity
+	}
+	return ""
+}
+
+func (x *Offer) GetMarketId() uint64 {
+	if x != nil {
+		return x.MarketId
+	}
+	return 0
+}
+
+func (x *Offer) GetBidAmount() string {
+	if x != nil {
+		return x.BidAmount
+	}
+	return ""
+}
+
+func (x *Offer) GetCounterAmount() string {
+	if x != nil {
+		return x.CounterAmount
+	}
+	return ""
+}
+
+func (x *Offer) GetDisableAutoRetire() bool {
+	if x != nil {
+		return x.DisableAutoRetire
+	}
+	return false
+}
+
+func (x *Offer) GetRetirementJurisdiction() string {
+	if x != nil {
+		return x.RetirementJurisdiction
+	}
+	return ""
+}
+
+func (x *Offer) GetExpiration() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
+func (x *Offer) GetRetirementReason() string {
+	if x != nil {
+		return x.RetirementReason
+	}
+	return ""
+}
+
+func (x *Offer) GetRetirementBeneficiary() string {
+	if x != nil {
+		return x.RetirementBeneficiary
+	}
+	return ""
+}
+
+func (x *Offer) GetEscrowedFeeBps() uint32 {
+	if x != nil {
+		return x.EscrowedFeeBps
+	}
+	return 0
+}
+
+// Swap represents a proposed exchange of credits from one credit batch,
+// optionally together with coins, for credits from another credit batch
+// between two parties. The credits and coins offered by the proposer are held
+// in escrow until the swap is accepted by the counterparty or expires.
+//
+// Since Revision 1
+type Swap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique ID of the swap.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// proposer is the address of the account that proposed the swap.
+	Proposer []byte `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// counterparty is the address of the only account that can accept the swap.
+	Counterparty []byte `protobuf:"bytes,3,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+	// offer_batch_key is the table row identifier of the credit batch offered
+	// by the proposer.
+	OfferBatchKey uint64 `protobuf:"varint,4,opt,name=offer_batch_key,json=offerBatchKey,proto3" json:"offer_batch_key,omitempty"`
+	// offer_quantity is the decimal quantity of credits offered by the
+	// proposer.
+	OfferQuantity string `protobuf:"bytes,5,opt,name=offer_quantity,json=offerQuantity,proto3" json:"offer_quantity,omitempty"`
+	// offer_coins are the optional coins offered by the proposer in addition to
+	// the credits.
+	OfferCoins []*v1beta1.Coin `protobuf:"bytes,6,rep,name=offer_coins,json=offerCoins,proto3" json:"offer_coins,omitempty"`
+	// ask_batch_key is the table row identifier of the credit batch requested
+	// from the counterparty.
+	AskBatchKey uint64 `protobuf:"varint,7,opt,name=ask_batch_key,json=askBatchKey,proto3" json:"ask_batch_key,omitempty"`
+	// ask_quantity is the decimal quantity of credits requested from the
+	// counterparty.
+	AskQuantity string `protobuf:"bytes,8,opt,name=ask_quantity,json=askQuantity,proto3" json:"ask_quantity,omitempty"`
+	// expiration is the timestamp when the swap expires. When the expiration
+	// time is reached, the swap is removed from state and the escrowed credits
+	// and coins are returned to the proposer.
+	Expiration *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expiration,proto3" json:"expiration,omitempty"`
+}
+
+func (x *Swap) Reset() {
+	*x = Swap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Swap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+func (*Swap) ProtoMessage() {}
+
+// Deprecated: Use Swap.ProtoReflect.Descriptor instead.
+func (*Swap) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Swap) GetId() uint64 {
+	if x != nil {
+		return x.Id
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	return 0
+}
+
+func (x *Swap) GetProposer() []byte {
+	if x != nil {
+		return x.Proposer
 	}
+	return nil
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: regen/ecocredit/marketplace/v1/state.proto
+func (x *Swap) GetCounterparty() []byte {
+	if x != nil {
+		return x.Counterparty
+	}
+	return nil
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+func (x *Swap) GetOfferBatchKey() uint64 {
+	if x != nil {
+		return x.OfferBatchKey
+	}
+	return 0
+}
 
-// SellOrder represents the information for a sell order.
-type SellOrder struct {
+func (x *Swap) GetOfferQuantity() string {
+	if x != nil {
+		return x.OfferQuantity
+	}
+	return ""
+}
+
+func (x *Swap) GetOfferCoins() []*v1beta1.Coin {
+	if x != nil {
+		return x.OfferCoins
+	}
+	return nil
+}
+
+func (x *Swap) GetAskBatchKey() uint64 {
+	if x != nil {
+		return x.AskBatchKey
+	}
+	return 0
+}
+
+func (x *Swap) GetAskQuantity() string {
+	if x != nil {
+		return x.AskQuantity
+	}
+	return ""
+}
+
+func (x *Swap) GetExpiration() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
+// ForwardContract represents a pre-purchase of credits to be delivered by an
+// issuer from an open credit batch or from the next credit batch issued for a
+// project. The payment for the credits is held in escrow and released to the
+// issuer per unit as credits are minted or issued to the buyer. Any payment
+// remaining in escrow when the deadline is reached is returned to the buyer.
+//
+// Since Revision 1
+type ForwardContract struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id is the unique ID of sell order.
+	// id is the unique ID of the forward contract.
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// seller is the address of the account that is selling credits.
-	Seller []byte `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
-	// batch_key is the table row identifier of the credit batch used internally
-	// for efficient lookups. This links a sell order to a credit batch.
-	BatchKey uint64 `protobuf:"varint,3,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
-	// quantity is the decimal quantity of credits being sold.
-	Quantity string `protobuf:"bytes,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	// market_id is the market in which this sell order exists and specifies
-	// the bank_denom that ask_amount corresponds to forming the ask_price.
-	MarketId uint64 `protobuf:"varint,5,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// ask_amount is the integer amount (encoded as a string) that the seller is
-	// asking for each credit unit of the batch. Each credit unit of the batch
-	// will be sold for at least the ask_amount. The ask_amount corresponds to the
-	// Market.denom to form the ask price.
-	AskAmount string `protobuf:"bytes,6,opt,name=ask_amount,json=askAmount,proto3" json:"ask_amount,omitempty"`
-	// disable_auto_retire disables auto-retirement of credits which allows a
-	// buyer to disable auto-retirement in their buy order enabling them to
-	// resell the credits to another buyer.
-	DisableAutoRetire bool `protobuf:"varint,7,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
-	// expiration is an optional timestamp when the sell order expires. When the
-	// expiration time is reached, the sell order is removed from state.
-	Expiration *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expiration,proto3" json:"expiration,omitempty"`
-	// maker indicates that this is a maker order, meaning that when it hit
-	// the order book, there were no matching buy orders.
-	Maker bool `protobuf:"varint,10,opt,name=maker,proto3" json:"maker,omitempty"`
+	// buyer is the address of the account that pre-purchased the credits.
+	Buyer []byte `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// issuer is the address of the account expected to deliver the credits.
+	Issuer []byte `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// project_key is the table row identifier of the project from which the
+	// credits will be delivered.
+	ProjectKey uint64 `protobuf:"varint,4,opt,name=project_key,json=projectKey,proto3" json:"project_key,omitempty"`
+	// batch_key is the table row identifier of the open credit batch from which
+	// the credits will be delivered. If empty, credits can be delivered from
+	// any credit batch of the project, including the next credit batch of the
+	// project.
+	BatchKey uint64 `protobuf:"varint,5,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// quantity is the decimal quantity of credits pre-purchased.
+	Quantity string `protobuf:"bytes,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// delivered_quantity is the decimal quantity of credits delivered so far.
+	DeliveredQuantity string `protobuf:"bytes,7,opt,name=delivered_quantity,json=deliveredQuantity,proto3" json:"delivered_quantity,omitempty"`
+	// market_id is the market in which the forward contract was created.
+	MarketId uint64 `protobuf:"varint,8,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// price_amount is the integer price per credit.
+	PriceAmount string `protobuf:"bytes,9,opt,name=price_amount,json=priceAmount,proto3" json:"price_amount,omitempty"`
+	// deadline is the timestamp by which the credits must be delivered. When
+	// the deadline is reached, the forward contract is removed from state and
+	// the payment for any undelivered credits is returned to the buyer.
+	Deadline *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// accept_retired allows credits retired upon issuance to the buyer to be
+	// delivered towards the forward contract. If false, only tradable credits
+	// are delivered.
+	AcceptRetired bool `protobuf:"varint,11,opt,name=accept_retired,json=acceptRetired,proto3" json:"accept_retired,omitempty"`
 }
 
-func (x *SellOrder) Reset() {
-	*x = SellOrder{}
+func (x *ForwardContract) Reset() {
+	*x = ForwardContract{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[0]
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SellOrder) String() string {
+func (x *ForwardContract) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SellOrder) ProtoMessage() {}
+func (*ForwardContract) ProtoMessage() {}
 
-// Deprecated: Use SellOrder.ProtoReflect.Descriptor instead.
-func (*SellOrder) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use ForwardContract.ProtoReflect.Descriptor instead.
+func (*ForwardContract) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *SellOrder) GetId() uint64 {
+func (x *ForwardContract) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *SellOrder) GetSeller() []byte {
+func (x *ForwardContract) GetBuyer() []byte {
 	if x != nil {
-		return x.Seller
+		return x.Buyer
 	}
 	return nil
 }
 
-func (x *SellOrder) GetBatchKey() uint64 {
+func (x *ForwardContract) GetIssuer() []byte {
+	if x != nil {
+		return x.Issuer
+	}
+	return nil
+}
+
+func (x *ForwardContract) GetProjectKey() uint64 {
+	if x != nil {
+		return x.ProjectKey
+	}
+	return 0
+}
+
+func (x *ForwardContract) GetBatchKey() uint64 {
 	if x != nil {
 		return x.BatchKey
 	}
 	return 0
 }
 
-func (x *SellOrder) GetQuantity() string {
+func (x *ForwardContract) GetQuantity() string {
 	if x != nil {
 		return x.Quantity
 	}
 	return ""
 }
 
-func (x *SellOrder) GetMarketId() uint64 {
+func (x *ForwardContract) GetDeliveredQuantity() string {
+	if x != nil {
+		return x.DeliveredQuantity
+	}
+	return ""
+}
+
+func (x *ForwardContract) GetMarketId() uint64 {
 	if x != nil {
 		return x.MarketId
 	}
 	return 0
 }
 
-func (x *SellOrder) GetAskAmount() string {
+func (x *ForwardContract) GetPriceAmount() string {
 	if x != nil {
-		return x.AskAmount
+		return x.PriceAmount
 	}
 	return ""
 }
 
-func (x *SellOrder) GetDisableAutoRetire() bool {
+func (x *ForwardContract) GetDeadline() *timestamppb.Timestamp {
 	if x != nil {
-		return x.DisableAutoRetire
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *ForwardContract) GetAcceptRetired() bool {
+	if x != nil {
+		return x.AcceptRetired
 	}
 	return false
 }
 
-func (x *SellOrder) GetExpiration() *timestamppb.Timestamp {
+// SellOrderAsk represents an additional ask price of a sell order in a market
+// other than the market of the sell order, allowing the credits of a single
+// sell order to be bought with different denoms.
+//
+// Since Revision 1
+type SellOrderAsk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sell_order_id is the ID of the sell order.
+	SellOrderId uint64 `protobuf:"varint,1,opt,name=sell_order_id,json=sellOrderId,proto3" json:"sell_order_id,omitempty"`
+	// market_id is the market of the additional ask price, which specifies the
+	// bank_denom that ask_amount corresponds to.
+	MarketId uint64 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// ask_amount is the integer amount (encoded as a string) that the seller is
+	// asking for each credit unit of the batch in the bank_denom of the market.
+	AskAmount string `protobuf:"bytes,3,opt,name=ask_amount,json=askAmount,proto3" json:"ask_amount,omitempty"`
+}
+
+func (x *SellOrderAsk) Reset() {
+	*x = SellOrderAsk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SellOrderAsk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SellOrderAsk) ProtoMessage() {}
+
+// Deprecated: Use SellOrderAsk.ProtoReflect.Descriptor instead.
+func (*SellOrderAsk) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SellOrderAsk) GetSellOrderId() uint64 {
+	if x != nil {
+		return x.SellOrderId
+	}
+	return 0
+}
+
+func (x *SellOrderAsk) GetMarketId() uint64 {
+	if x != nil {
+		return x.MarketId
+	}
+	return 0
+}
+
+func (x *SellOrderAsk) GetAskAmount() string {
+	if x != nil {
+		return x.AskAmount
+	}
+	return ""
+}
+
+// SellOrderPruneCursor stores the position in the sell order expiration index
+// up to which expired sell orders have been pruned. Expired sell orders are
+// pruned in the BeginBlocker with a limit on the number of sell orders pruned
+// per block, and the cursor allows pruning to resume in the next block without
+// iterating over the sell orders that have already been pruned.
+//
+// Since Revision 1
+type SellOrderPruneCursor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// expiration is the expiration of the last sell order pruned.
+	Expiration *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=expiration,proto3" json:"expiration,omitempty"`
+}
+
+func (x *SellOrderPruneCursor) Reset() {
+	*x = SellOrderPruneCursor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SellOrderPruneCursor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SellOrderPruneCursor) ProtoMessage() {}
+
+// Deprecated: Use SellOrderPruneCursor.ProtoReflect.Descriptor instead.
+func (*SellOrderPruneCursor) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SellOrderPruneCursor) GetExpiration() *timestamppb.Timestamp {
 	if x != nil {
 		return x.Expiration
 	}
 	return nil
 }
 
-func (x *SellOrder) GetMaker() bool {
+// AuctionSettleCursor stores the position in the auction end time index up to
+// which ended auctions have been settled. Ended auctions are settled in the
+// BeginBlocker with a limit on the number of auctions settled per block, and
+// the cursor allows settlement to resume in the next block without iterating
+// over the end times of the auctions that have already been settled.
+//
+// Since Revision 1
+type AuctionSettleCursor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// end_time is the end time of the last auction settled.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *AuctionSettleCursor) Reset() {
+	*x = AuctionSettleCursor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuctionSettleCursor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuctionSettleCursor) ProtoMessage() {}
+
+// Deprecated: Use AuctionSettleCursor.ProtoReflect.Descriptor instead.
+func (*AuctionSettleCursor) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AuctionSettleCursor) GetEndTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Maker
+		return x.EndTime
 	}
-	return false
+	return nil
 }
 
-// AllowedDenom represents the information for an allowed ask/bid denom.
-type AllowedDenom struct {
+// OfferPruneCursor stores the position in the offer expiration index up to
+// which expired offers have been pruned. Expired offers are pruned in the
+// BeginBlocker with a limit on the number of offers pruned per block, and the
+// cursor allows pruning to resume in the next block without iterating over the
+// offers that have already been pruned.
+//
+// Since Revision 1
+type OfferPruneCursor struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// denom is the bank denom to allow (ex. ibc/GLKHDSG423SGS)
-	BankDenom string `protobuf:"bytes,1,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
-	// display_denom is the denom to display to the user and is informational.
-	// Because the denom is likely an IBC denom, this should be chosen by
-	// governance to represent the consensus trusted name of the denom.
-	DisplayDenom string `protobuf:"bytes,2,opt,name=display_denom,json=displayDenom,proto3" json:"display_denom,omitempty"`
-	// exponent is the exponent that relates the denom to the display_denom and is
-	// informational
-	Exponent uint32 `protobuf:"varint,3,opt,name=exponent,proto3" json:"exponent,omitempty"`
+	// expiration is the expiration of the last offer pruned.
+	Expiration *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=expiration,proto3" json:"expiration,omitempty"`
 }
 
-func (x *AllowedDenom) Reset() {
-	*x = AllowedDenom{}
+func (x *OfferPruneCursor) Reset() {
+	*x = OfferPruneCursor{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[1]
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AllowedDenom) String() string {
+func (x *OfferPruneCursor) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AllowedDenom) ProtoMessage() {}
+func (*OfferPruneCursor) ProtoMessage() {}
 
-// Deprecated: Use AllowedDenom.ProtoReflect.Descriptor instead.
-func (*AllowedDenom) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use OfferPruneCursor.ProtoReflect.Descriptor instead.
+func (*OfferPruneCursor) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *AllowedDenom) GetBankDenom() string {
+func (x *OfferPruneCursor) GetExpiration() *timestamppb.Timestamp {
 	if x != nil {
-		return x.BankDenom
+		return x.Expiration
 	}
-	return ""
+	return nil
+}
+
+// SwapPruneCursor stores the position in the swap expiration index up to which
+// expired swaps have been pruned. Expired swaps are pruned in the BeginBlocker
+// with a limit on the number of swaps pruned per block, and the cursor allows
+// pruning to resume in the next block without iterating over the swaps that
+// have already been pruned.
+//
+// Since Revision 1
+type SwapPruneCursor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// expiration is the expiration of the last swap pruned.
+	Expiration *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=expiration,proto3" json:"expiration,omitempty"`
+}
+
+func (x *SwapPruneCursor) Reset() {
+	*x = SwapPruneCursor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapPruneCursor) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AllowedDenom) GetDisplayDenom() string {
-	if x != nil {
-		return x.DisplayDenom
-	}
-	return ""
+func (*SwapPruneCursor) ProtoMessage() {}
+
+// Deprecated: Use SwapPruneCursor.ProtoReflect.Descriptor instead.
+func (*SwapPruneCursor) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *AllowedDenom) GetExponent() uint32 {
+func (x *SwapPruneCursor) GetExpiration() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Exponent
+		return x.Expiration
 	}
-	return 0
+	return nil
 }
 
-// Market describes a distinctly processed market between a credit type and
-// allowed bank denom. Each market has its own precision in the order book
-// and is processed independently of other markets. Governance must enable
-// markets one by one. Every additional enabled market potentially adds more
-// processing overhead to the blockchain and potentially weakens liquidity in
-// competing markets. For instance, enabling side by side USD/Carbon and
-// EUR/Carbon markets may have the end result that each market individually has
-// less liquidity and longer settlement times. Such decisions should be taken
-// with care.
-type Market struct {
+// ForwardContractPruneCursor stores the position in the forward contract
+// deadline index up to which forward contracts that have reached their deadline
+// have been pruned. Forward contracts are pruned in the BeginBlocker with a
+// limit on the number of forward contracts pruned per block, and the cursor
+// allows pruning to resume in the next block without iterating over the
+// forward contracts that have already been pruned.
+//
+// Since Revision 1
+type ForwardContractPruneCursor struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id is the unique ID of the market.
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// credit_type_abbrev is the abbreviation of the credit type.
-	CreditTypeAbbrev string `protobuf:"bytes,2,opt,name=credit_type_abbrev,json=creditTypeAbbrev,proto3" json:"credit_type_abbrev,omitempty"`
-	// bank_denom is an allowed bank denom.
-	BankDenom string `protobuf:"bytes,3,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
-	// precision_modifier is an optional modifier used to convert arbitrary
-	// precision integer bank amounts to uint32 values used for sorting in the
-	// order book. Given an arbitrary precision integer x, its uint32 conversion
-	// will be x / 10^precision_modifier using round half away from zero
-	// rounding.
-	//
-	// uint32 values range from 0 to 4,294,967,295.
-	// This allows for a full 9 digits of precision. In most real world markets
-	// this amount of precision is sufficient and most common downside -
-	// that some orders with very miniscule price differences may be ordered
-	// equivalently (because of rounding) - is acceptable.
-	// Note that this rounding will not affect settlement price which will
-	// always be done exactly.
-	//
-	// Given a USD stable coin with 6 decimal digits, a precision_modifier
-	// of 0 is probably acceptable as long as credits are always less than
-	// $4,294/unit. With precision down to $0.001 (a precision_modifier of 3
-	// in this case), prices can rise up to $4,294,000/unit. Either scenario
-	// is probably quite acceptable given that carbon prices are unlikely to
-	// rise above $1000/ton any time in the near future.
-	//
-	// If credit prices, exceed the maximum range of uint32 with this
-	// precision_modifier, orders with high prices will fail and governance
-	// will need to adjust precision_modifier to allow for higher prices in
-	// exchange for less precision at the lower end.
-	PrecisionModifier uint32 `protobuf:"varint,4,opt,name=precision_modifier,json=precisionModifier,proto3" json:"precision_modifier,omitempty"`
+	// deadline is the deadline of the last forward contract pruned.
+	Deadline *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=deadline,proto3" json:"deadline,omitempty"`
 }
 
-func (x *Market) Reset() {
-	*x = Market{}
+func (x *ForwardContractPruneCursor) Reset() {
+	*x = ForwardContractPruneCursor{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[2]
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Market) String() string {
+func (x *ForwardContractPruneCursor) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Market) ProtoMessage() {}
+func (*ForwardContractPruneCursor) ProtoMessage() {}
 
-// Deprecated: Use Market.ProtoReflect.Descriptor instead.
-func (*Market) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ForwardContractPruneCursor.ProtoReflect.Descriptor instead.
+func (*ForwardContractPruneCursor) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *Market) GetId() uint64 {
+func (x *ForwardContractPruneCursor) GetDeadline() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Id
+		return x.Deadline
 	}
-	return 0
+	return nil
 }
 
-func (x *Market) GetCreditTypeAbbrev() string {
-	if x != nil {
-		return x.CreditTypeAbbrev
-	}
-	return ""
+// BuyOrderPruneCursor stores the position in the buy order expiration index up
+// to which expired buy orders have been pruned. Expired buy orders are pruned
+// in the BeginBlocker with a limit on the number of buy orders pruned per
+// block, and the cursor allows pruning to resume in the next block without
+// iterating over the buy orders that have already been pruned.
+//
+// Since Revision 1
+type BuyOrderPruneCursor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// expiration is the expiration of the last buy order pruned.
+	Expiration *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=expiration,proto3" json:"expiration,omitempty"`
 }
 
-func (x *Market) GetBankDenom() string {
-	if x != nil {
-		return x.BankDenom
+func (x *BuyOrderPruneCursor) Reset() {
+	*x = BuyOrderPruneCursor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Market) GetPrecisionModifier() uint32 {
+func (x *BuyOrderPruneCursor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyOrderPruneCursor) ProtoMessage() {}
+
+// Deprecated: Use BuyOrderPruneCursor.ProtoReflect.Descriptor instead.
+func (*BuyOrderPruneCursor) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BuyOrderPruneCursor) GetExpiration() *timestamppb.Timestamp {
 	if x != nil {
-		return x.PrecisionModifier
+		return x.Expiration
 	}
-	return 0
+	return nil
 }
 
 var File_regen_ecocredit_marketplace_v1_state_proto protoreflect.FileDescriptor
@@ -2299,11 +15285,13 @@ var file_regen_ecocredit_marketplace_v1_state_proto_rawDesc = []byte{
 	0x74, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x76, 0x31,
 	0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1e, 0x72, 0x65,
 	0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x6d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x17, 0x63, 0x6f,
+	0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x63, 0x6f,
 	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6f, 0x72, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x6d, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe7, 0x02, 0x0a, 0x09, 0x53, 0x65, 0x6c, 0x6c, 0x4f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf0, 0x03, 0x0a, 0x09, 0x53, 0x65, 0x6c, 0x6c, 0x4f,
 	0x72, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
 	0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09,
@@ -2322,52 +15310,364 @@ var file_regen_ecocredit_marketplace_v1_state_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
 	0x70, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
 	0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6d, 0x61,
-	0x6b, 0x65, 0x72, 0x3a, 0x3b, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x35, 0x0a, 0x06, 0x0a, 0x02, 0x69,
-	0x64, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79,
-	0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x10, 0x02, 0x12, 0x0e,
-	0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x03, 0x18, 0x01,
-	0x22, 0x9b, 0x01, 0x0a, 0x0c, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x44, 0x65, 0x6e, 0x6f,
+	0x6b, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x62,
+	0x75, 0x79, 0x65, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0d, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x42, 0x75, 0x79, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x6f,
+	0x72, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x73, 0x6b, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x6f, 0x72, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x41, 0x73, 0x6b, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x6d, 0xf2, 0x9e, 0xd3, 0x8e,
+	0x03, 0x67, 0x0a, 0x06, 0x0a, 0x02, 0x69, 0x64, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x65, 0x6c,
+	0x6c, 0x65, 0x72, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x10, 0x04, 0x12, 0x21, 0x0a, 0x1d, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x2c, 0x73, 0x6f, 0x72, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x73, 0x6b, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x10, 0x05, 0x18, 0x01, 0x22, 0x84, 0x06, 0x0a, 0x08, 0x42, 0x75,
+	0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x69, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x69, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x75, 0x74,
+	0x6f, 0x5f, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x74, 0x69, 0x72,
+	0x65, 0x12, 0x37, 0x0a, 0x17, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x6a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x16, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4a, 0x75,
+	0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0a, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09,
+	0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x65,
+	0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x45, 0x6e,
+	0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x16, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x15, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x65,
+	0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x73, 0x63,
+	0x72, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0e, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x46, 0x65, 0x65,
+	0x42, 0x70, 0x73, 0x3a, 0x2b, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x25, 0x0a, 0x06, 0x0a, 0x02, 0x69,
+	0x64, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x10, 0x01, 0x12, 0x0e,
+	0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x02, 0x18, 0x02,
+	0x22, 0xdc, 0x01, 0x0a, 0x0c, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x44, 0x65, 0x6e, 0x6f,
 	0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x6e, 0x6b, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
 	0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x64, 0x65, 0x6e, 0x6f,
 	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
 	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
 	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
-	0x74, 0x3a, 0x2b, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x25, 0x0a, 0x0c, 0x0a, 0x0a, 0x62, 0x61, 0x6e,
-	0x6b, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x13, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x70, 0x6c,
-	0x61, 0x79, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x10, 0x01, 0x18, 0x01, 0x18, 0x03, 0x22, 0xcb,
-	0x01, 0x0a, 0x06, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x72, 0x65,
-	0x64, 0x69, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x61, 0x62, 0x62, 0x72, 0x65, 0x76, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x41, 0x62, 0x62, 0x72, 0x65, 0x76, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x6e, 0x6b, 0x5f,
-	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x6e,
-	0x6b, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73,
-	0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x11, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x3a, 0x35, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x2f, 0x0a, 0x06, 0x0a,
-	0x02, 0x69, 0x64, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1d, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x5f, 0x61, 0x62, 0x62, 0x72, 0x65, 0x76, 0x2c, 0x62, 0x61, 0x6e, 0x6b,
-	0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x10, 0x01, 0x18, 0x01, 0x18, 0x04, 0x42, 0xa3, 0x02, 0x0a,
-	0x22, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72,
-	0x65, 0x64, 0x69, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65,
-	0x2e, 0x76, 0x31, 0x42, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x56, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x65,
-	0x67, 0x65, 0x6e, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x72, 0x65, 0x67, 0x65,
-	0x6e, 0x2d, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x67,
-	0x65, 0x6e, 0x2f, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2f, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x52, 0x45, 0x4d, 0xaa,
-	0x02, 0x1e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69,
-	0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x56, 0x31,
-	0xca, 0x02, 0x1e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64,
-	0x69, 0x74, 0x5c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5c, 0x56,
-	0x31, 0xe2, 0x02, 0x2a, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65,
-	0x64, 0x69, 0x74, 0x5c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5c,
-	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x21, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x3a, 0x3a, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69,
-	0x74, 0x3a, 0x3a, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x3a, 0x3a,
-	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x62, 0x63, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x62, 0x63, 0x50, 0x61, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x0e,
+	0x69, 0x62, 0x63, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x62, 0x63, 0x42, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6e,
+	0x6f, 0x6d, 0x3a, 0x2b, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x25, 0x0a, 0x0c, 0x0a, 0x0a, 0x62, 0x61,
+	0x6e, 0x6b, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x13, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x10, 0x01, 0x18, 0x01, 0x18, 0x03, 0x22,
+	0xcb, 0x01, 0x0a, 0x06, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x61, 0x62, 0x62, 0x72, 0x65, 0x76,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x41, 0x62, 0x62, 0x72, 0x65, 0x76, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x6e, 0x6b,
+	0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61,
+	0x6e, 0x6b, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x63, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x11, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x3a, 0x35, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x2f, 0x0a, 0x06,
+	0x0a, 0x02, 0x69, 0x64, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1d, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x61, 0x62, 0x62, 0x72, 0x65, 0x76, 0x2c, 0x62, 0x61, 0x6e,
+	0x6b, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x10, 0x01, 0x18, 0x01, 0x18, 0x04, 0x22, 0xd7, 0x01,
+	0x0a, 0x0a, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x12, 0x22, 0x0a, 0x0d,
+	0x6d, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x42, 0x70, 0x73,
+	0x12, 0x22, 0x0a, 0x0d, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x70,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65,
+	0x65, 0x42, 0x70, 0x73, 0x12, 0x50, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x72, 0x65, 0x67, 0x65,
+	0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x44, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x08, 0xfa,
+	0x9e, 0xd3, 0x8e, 0x03, 0x02, 0x08, 0x05, 0x22, 0x81, 0x01, 0x0a, 0x0c, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x52, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79,
+	0x5f, 0x62, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x6f, 0x79, 0x61,
+	0x6c, 0x74, 0x79, 0x42, 0x70, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70,
+	0x69, 0x65, 0x6e, 0x74, 0x3a, 0x15, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x0f, 0x0a, 0x0b, 0x0a, 0x09,
+	0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x22, 0xc8, 0x02, 0x0a, 0x05,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x65, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x65, 0x6c,
+	0x6c, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x3a, 0x5b, 0xf2, 0x9e, 0xd3, 0x8e, 0x03,
+	0x55, 0x0a, 0x06, 0x0a, 0x02, 0x69, 0x64, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x2c, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x2c, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x2c, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x10, 0x03, 0x18, 0x07, 0x22, 0xb0, 0x07, 0x0a, 0x07, 0x41, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64,
+	0x12, 0x4e, 0x0a, 0x0c, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65,
+	0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x0b, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x11, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65,
+	0x74, 0x69, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x5f,
+	0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x68, 0x69,
+	0x67, 0x68, 0x65, 0x73, 0x74, 0x42, 0x69, 0x64, 0x64, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x68,
+	0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x69, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74,
+	0x42, 0x69, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x1f, 0x68, 0x69, 0x67,
+	0x68, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x69, 0x64, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x1b, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x42, 0x69, 0x64, 0x44, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x74, 0x69, 0x72, 0x65, 0x12,
+	0x4d, 0x0a, 0x23, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x69, 0x64, 0x5f, 0x72,
+	0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6a, 0x75, 0x72, 0x69, 0x73, 0x64,
+	0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x20, 0x68, 0x69,
+	0x67, 0x68, 0x65, 0x73, 0x74, 0x42, 0x69, 0x64, 0x52, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x4a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x41,
+	0x0a, 0x1d, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x69, 0x64, 0x5f, 0x72, 0x65,
+	0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1a, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x42, 0x69,
+	0x64, 0x52, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x4b, 0x0a, 0x22, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x69, 0x64,
+	0x5f, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x65, 0x6e, 0x65,
+	0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1f, 0x68,
+	0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x42, 0x69, 0x64, 0x52, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x42, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x12, 0x3e,
+	0x0a, 0x1c, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x69, 0x64, 0x5f, 0x65, 0x73,
+	0x63, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x42, 0x69, 0x64,
+	0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x46, 0x65, 0x65, 0x42, 0x70, 0x73, 0x3a, 0x39,
+	0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x33, 0x0a, 0x06, 0x0a, 0x02, 0x69, 0x64, 0x10, 0x01, 0x12, 0x0d,
+	0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x10, 0x01, 0x12, 0x0a, 0x0a,
+	0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x65, 0x6e, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x10, 0x03, 0x18, 0x08, 0x22, 0xc3, 0x04, 0x0a, 0x05, 0x4f, 0x66,
+	0x66, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x65, 0x6c, 0x6c,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x12, 0x1a, 0x0a,
+	0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x69, 0x64, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x69, 0x64, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x74,
+	0x69, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x74, 0x69, 0x72, 0x65, 0x12, 0x37, 0x0a, 0x17,
+	0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6a, 0x75, 0x72, 0x69, 0x73,
+	0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x72,
+	0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65,
+	0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x35,
+	0x0a, 0x16, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x65, 0x6e,
+	0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15,
+	0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x65, 0x6e, 0x65, 0x66, 0x69,
+	0x63, 0x69, 0x61, 0x72, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x65,
+	0x64, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0e, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x46, 0x65, 0x65, 0x42, 0x70, 0x73, 0x3a,
+	0x3e, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x38, 0x0a, 0x06, 0x0a, 0x02, 0x69, 0x64, 0x10, 0x01, 0x12,
+	0x11, 0x0a, 0x0d, 0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x10, 0x02, 0x12, 0x0e, 0x0a,
+	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x03, 0x18, 0x09, 0x22,
+	0xa6, 0x03, 0x0a, 0x04, 0x53, 0x77, 0x61, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70,
+	0x61, 0x72, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x0f, 0x6f, 0x66, 0x66, 0x65,
+	0x72, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0d, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79,
+	0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x51,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x3a, 0x0a, 0x0b, 0x6f, 0x66, 0x66, 0x65, 0x72,
+	0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x0a, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x43, 0x6f,
+	0x69, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x73, 0x6b, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x73, 0x6b, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x73, 0x6b, 0x5f, 0x71,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
+	0x73, 0x6b, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x3a, 0x0a, 0x0a, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x40, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x3a, 0x0a, 0x06,
+	0x0a, 0x02, 0x69, 0x64, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x72, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70,
+	0x61, 0x72, 0x74, 0x79, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x10, 0x03, 0x18, 0x0a, 0x22, 0xb3, 0x03, 0x0a, 0x0f, 0x46, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x62, 0x75, 0x79, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x75, 0x79,
+	0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65,
+	0x64, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x51, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x61,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x64, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x74, 0x69, 0x72,
+	0x65, 0x64, 0x3a, 0x3a, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x34, 0x0a, 0x06, 0x0a, 0x02, 0x69, 0x64,
+	0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x10, 0x01, 0x12, 0x0f, 0x0a,
+	0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x10, 0x02, 0x12, 0x0c,
+	0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x10, 0x03, 0x18, 0x0b, 0x22, 0x93,
+	0x01, 0x0a, 0x0c, 0x53, 0x65, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x41, 0x73, 0x6b, 0x12,
+	0x22, 0x0a, 0x0d, 0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x65, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x73, 0x6b, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x6b, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x3a,
+	0x23, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x1d, 0x0a, 0x19, 0x0a, 0x17, 0x73, 0x65, 0x6c, 0x6c, 0x5f,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x2c, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x0c, 0x22, 0x5c, 0x0a, 0x14, 0x53, 0x65, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x3a, 0x0a, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x08, 0xfa, 0x9e, 0xd3, 0x8e, 0x03, 0x02,
+	0x08, 0x0d, 0x22, 0x56, 0x0a, 0x13, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x3a, 0x08, 0xfa, 0x9e, 0xd3, 0x8e, 0x03, 0x02, 0x08, 0x0e, 0x22, 0x58, 0x0a, 0x10, 0x4f, 0x66,
+	0x66, 0x65, 0x72, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x3a,
+	0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x08, 0xfa, 0x9e, 0xd3, 0x8e,
+	0x03, 0x02, 0x08, 0x0f, 0x22, 0x57, 0x0a, 0x0f, 0x53, 0x77, 0x61, 0x70, 0x50, 0x72, 0x75, 0x6e,
+	0x65, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x3a, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x3a, 0x08, 0xfa, 0x9e, 0xd3, 0x8e, 0x03, 0x02, 0x08, 0x10, 0x22, 0x5e, 0x0a,
+	0x1a, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x50, 0x72, 0x75, 0x6e, 0x65, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x36, 0x0a, 0x08, 0x64,
+	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c,
+	0x69, 0x6e, 0x65, 0x3a, 0x08, 0xfa, 0x9e, 0xd3, 0x8e, 0x03, 0x02, 0x08, 0x11, 0x22, 0x5b, 0x0a,
+	0x13, 0x42, 0x75, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x12, 0x3a, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x3a, 0x08, 0xfa, 0x9e, 0xd3, 0x8e, 0x03, 0x02, 0x08, 0x12, 0x2a, 0x93, 0x01, 0x0a, 0x0e, 0x46,
+	0x65, 0x65, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a,
+	0x1b, 0x46, 0x45, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18,
+	0x0a, 0x14, 0x46, 0x45, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x42, 0x55, 0x52, 0x4e, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x46, 0x45, 0x45, 0x5f,
+	0x44, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4d, 0x4d,
+	0x55, 0x4e, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x4f, 0x4f, 0x4c, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e,
+	0x46, 0x45, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x4d, 0x4f, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x03,
+	0x2a, 0x5d, 0x0a, 0x0b, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1c, 0x0a, 0x18, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a,
+	0x14, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x4e,
+	0x47, 0x4c, 0x49, 0x53, 0x48, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x55, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x55, 0x54, 0x43, 0x48, 0x10, 0x02, 0x42,
+	0xa3, 0x02, 0x0a, 0x22, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63,
+	0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x56, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x72,
+	0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2f,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x52,
+	0x45, 0x4d, 0xaa, 0x02, 0x1e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x45, 0x63, 0x6f, 0x63, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x2e, 0x56, 0x31, 0xca, 0x02, 0x1e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x5c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x2a, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45, 0x63, 0x6f,
+	0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x21, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x3a, 0x3a, 0x45, 0x63, 0x6f, 0x63, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x3a, 0x3a, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2382,20 +15682,57 @@ func file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP() []byte {
 	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescData
 }
 
-var file_regen_ecocredit_marketplace_v1_state_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_regen_ecocredit_marketplace_v1_state_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_regen_ecocredit_marketplace_v1_state_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_regen_ecocredit_marketplace_v1_state_proto_goTypes = []interface{}{
-	(*SellOrder)(nil),             // 0: regen.ecocredit.marketplace.v1.SellOrder
-	(*AllowedDenom)(nil),          // 1: regen.ecocredit.marketplace.v1.AllowedDenom
-	(*Market)(nil),                // 2: regen.ecocredit.marketplace.v1.Market
-	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+	(FeeDestination)(0),                // 0: regen.ecocredit.marketplace.v1.FeeDestination
+	(AuctionType)(0),                   // 1: regen.ecocredit.marketplace.v1.AuctionType
+	(*SellOrder)(nil),                  // 2: regen.ecocredit.marketplace.v1.SellOrder
+	(*BuyOrder)(nil),                   // 3: regen.ecocredit.marketplace.v1.BuyOrder
+	(*AllowedDenom)(nil),               // 4: regen.ecocredit.marketplace.v1.AllowedDenom
+	(*Market)(nil),                     // 5: regen.ecocredit.marketplace.v1.Market
+	(*TradingFee)(nil),                 // 6: regen.ecocredit.marketplace.v1.TradingFee
+	(*ClassRoyalty)(nil),               // 7: regen.ecocredit.marketplace.v1.ClassRoyalty
+	(*Trade)(nil),                      // 8: regen.ecocredit.marketplace.v1.Trade
+	(*Auction)(nil),                    // 9: regen.ecocredit.marketplace.v1.Auction
+	(*Offer)(nil),                      // 10: regen.ecocredit.marketplace.v1.Offer
+	(*Swap)(nil),                       // 11: regen.ecocredit.marketplace.v1.Swap
+	(*ForwardContract)(nil),            // 12: regen.ecocredit.marketplace.v1.ForwardContract
+	(*SellOrderAsk)(nil),               // 13: regen.ecocredit.marketplace.v1.SellOrderAsk
+	(*SellOrderPruneCursor)(nil),       // 14: regen.ecocredit.marketplace.v1.SellOrderPruneCursor
+	(*AuctionSettleCursor)(nil),        // 15: regen.ecocredit.marketplace.v1.AuctionSettleCursor
+	(*OfferPruneCursor)(nil),           // 16: regen.ecocredit.marketplace.v1.OfferPruneCursor
+	(*SwapPruneCursor)(nil),            // 17: regen.ecocredit.marketplace.v1.SwapPruneCursor
+	(*ForwardContractPruneCursor)(nil), // 18: regen.ecocredit.marketplace.v1.ForwardContractPruneCursor
+	(*BuyOrderPruneCursor)(nil),        // 19: regen.ecocredit.marketplace.v1.BuyOrderPruneCursor
+	(*timestamppb.Timestamp)(nil),      // 20: google.protobuf.Timestamp
+	(*v1beta1.Coin)(nil),               // 21: cosmos.base.v1beta1.Coin
 }
 var file_regen_ecocredit_marketplace_v1_state_proto_depIdxs = []int32{
-	3, // 0: regen.ecocredit.marketplace.v1.SellOrder.expiration:type_name -> google.protobuf.Timestamp
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	20, // 0: regen.ecocredit.marketplace.v1.SellOrder.expiration:type_name -> google.protobuf.Timestamp
+	20, // 1: regen.ecocredit.marketplace.v1.BuyOrder.expiration:type_name -> google.protobuf.Timestamp
+	20, // 2: regen.ecocredit.marketplace.v1.BuyOrder.min_start_date:type_name -> google.protobuf.Timestamp
+	20, // 3: regen.ecocredit.marketplace.v1.BuyOrder.max_end_date:type_name -> google.protobuf.Timestamp
+	0,  // 4: regen.ecocredit.marketplace.v1.TradingFee.destination:type_name -> regen.ecocredit.marketplace.v1.FeeDestination
+	20, // 5: regen.ecocredit.marketplace.v1.Trade.timestamp:type_name -> google.protobuf.Timestamp
+	1,  // 6: regen.ecocredit.marketplace.v1.Auction.auction_type:type_name -> regen.ecocredit.marketplace.v1.AuctionType
+	20, // 7: regen.ecocredit.marketplace.v1.Auction.start_time:type_name -> google.protobuf.Timestamp
+	20, // 8: regen.ecocredit.marketplace.v1.Auction.end_time:type_name -> google.protobuf.Timestamp
+	20, // 9: regen.ecocredit.marketplace.v1.Offer.expiration:type_name -> google.protobuf.Timestamp
+	21, // 10: regen.ecocredit.marketplace.v1.Swap.offer_coins:type_name -> cosmos.base.v1beta1.Coin
+	20, // 11: regen.ecocredit.marketplace.v1.Swap.expiration:type_name -> google.protobuf.Timestamp
+	20, // 12: regen.ecocredit.marketplace.v1.ForwardContract.deadline:type_name -> google.protobuf.Timestamp
+	20, // 13: regen.ecocredit.marketplace.v1.SellOrderPruneCursor.expiration:type_name -> google.protobuf.Timestamp
+	20, // 14: regen.ecocredit.marketplace.v1.AuctionSettleCursor.end_time:type_name -> google.protobuf.Timestamp
+	20, // 15: regen.ecocredit.marketplace.v1.OfferPruneCursor.expiration:type_name -> google.protobuf.Timestamp
+	20, // 16: regen.ecocredit.marketplace.v1.SwapPruneCursor.expiration:type_name -> google.protobuf.Timestamp
+	20, // 17: regen.ecocredit.marketplace.v1.ForwardContractPruneCursor.deadline:type_name -> google.protobuf.Timestamp
+	20, // 18: regen.ecocredit.marketplace.v1.BuyOrderPruneCursor.expiration:type_name -> google.protobuf.Timestamp
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_regen_ecocredit_marketplace_v1_state_proto_init() }
@@ -2417,7 +15754,7 @@ func file_regen_ecocredit_marketplace_v1_state_proto_init() {
 			}
 		}
 		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AllowedDenom); i {
+			switch v := v.(*BuyOrder); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2429,6 +15766,18 @@ func file_regen_ecocredit_marketplace_v1_state_proto_init() {
 			}
 		}
 		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllowedDenom); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Market); i {
 			case 0:
 				return &v.state
@@ -2440,19 +15789,188 @@ func file_regen_ecocredit_marketplace_v1_state_proto_init() {
 				return nil
 			}
 		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TradingFee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClassRoyalty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Trade); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Auction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Offer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Swap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForwardContract); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SellOrderAsk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SellOrderPruneCursor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuctionSettleCursor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OfferPruneCursor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapPruneCursor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForwardContractPruneCursor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuyOrderPruneCursor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_regen_ecocredit_marketplace_v1_state_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   3,
+			NumEnums:      2,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_regen_ecocredit_marketplace_v1_state_proto_goTypes,
 		DependencyIndexes: file_regen_ecocredit_marketplace_v1_state_proto_depIdxs,
+		EnumInfos:         file_regen_ecocredit_marketplace_v1_state_proto_enumTypes,
 		MessageInfos:      file_regen_ecocredit_marketplace_v1_state_proto_msgTypes,
 	}.Build()
 	File_regen_ecocredit_marketplace_v1_state_proto = out.File
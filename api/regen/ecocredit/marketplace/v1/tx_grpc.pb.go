@@ -39,6 +39,89 @@ type MsgClient interface {
 	//
 	// Since Revision 1
 	RemoveAllowedDenom(ctx context.Context, in *MsgRemoveAllowedDenom, opts ...grpc.CallOption) (*MsgRemoveAllowedDenomResponse, error)
+	// Buy creates new buy orders. The total bid amount of each buy order is held
+	// in escrow and buy orders are matched with sell orders in the order book at
+	// the end of each block.
+	//
+	// Since Revision 1
+	Buy(ctx context.Context, in *MsgBuyOrder, opts ...grpc.CallOption) (*MsgBuyOrderResponse, error)
+	// CancelBuyOrder cancels a buy order and returns the funds from escrow.
+	//
+	// Since Revision 1
+	CancelBuyOrder(ctx context.Context, in *MsgCancelBuyOrder, opts ...grpc.CallOption) (*MsgCancelBuyOrderResponse, error)
+	// UpdateTradingFee is a governance method that allows for updating the
+	// marketplace trading fee.
+	//
+	// Since Revision 1
+	UpdateTradingFee(ctx context.Context, in *MsgUpdateTradingFee, opts ...grpc.CallOption) (*MsgUpdateTradingFeeResponse, error)
+	// UpdateClassRoyalty allows the admin of a credit class to set or remove the
+	// royalty paid whenever credits from the credit class are sold in the
+	// marketplace.
+	//
+	// Since Revision 1
+	UpdateClassRoyalty(ctx context.Context, in *MsgUpdateClassRoyalty, opts ...grpc.CallOption) (*MsgUpdateClassRoyaltyResponse, error)
+	// CreateAuction creates a new English or Dutch auction. The credits being
+	// auctioned are held in escrow until the auction is settled.
+	//
+	// Since Revision 1
+	CreateAuction(ctx context.Context, in *MsgCreateAuction, opts ...grpc.CallOption) (*MsgCreateAuctionResponse, error)
+	// BidAuction places a bid on an auction. The total bid amount is held in
+	// escrow and returned to the bidder if the bidder is outbid.
+	//
+	// Since Revision 1
+	BidAuction(ctx context.Context, in *MsgBidAuction, opts ...grpc.CallOption) (*MsgBidAuctionResponse, error)
+	// MakeOffer makes an offer to buy credits from a sell order at a price
+	// below the ask price of the sell order. The total bid amount is held in
+	// escrow until the offer is accepted, rejected, or expires, or until the
+	// sell order is removed.
+	//
+	// Since Revision 1
+	MakeOffer(ctx context.Context, in *MsgMakeOffer, opts ...grpc.CallOption) (*MsgMakeOfferResponse, error)
+	// AcceptOffer accepts an offer, atomically transferring the credits from
+	// the sell order to the buyer and the escrowed funds to the seller. The
+	// seller accepts an offer made by the buyer and the buyer accepts a counter
+	// offer made by the seller.
+	//
+	// Since Revision 1
+	AcceptOffer(ctx context.Context, in *MsgAcceptOffer, opts ...grpc.CallOption) (*MsgAcceptOfferResponse, error)
+	// RejectOffer rejects an offer and returns the escrowed funds to the buyer.
+	// The offer can be rejected by the seller or withdrawn by the buyer.
+	//
+	// Since Revision 1
+	RejectOffer(ctx context.Context, in *MsgRejectOffer, opts ...grpc.CallOption) (*MsgRejectOfferResponse, error)
+	// CounterOffer responds to an offer with a new price. The seller counters
+	// an offer made by the buyer and the buyer counters a counter offer made by
+	// the seller.
+	//
+	// Since Revision 1
+	CounterOffer(ctx context.Context, in *MsgCounterOffer, opts ...grpc.CallOption) (*MsgCounterOfferResponse, error)
+	// ProposeSwap proposes to exchange credits from one credit batch, optionally
+	// together with coins, for credits from another credit batch held by the
+	// counterparty. The offered credits and coins are held in escrow until the
+	// swap is accepted or expires.
+	//
+	// Since Revision 1
+	ProposeSwap(ctx context.Context, in *MsgProposeSwap, opts ...grpc.CallOption) (*MsgProposeSwapResponse, error)
+	// AcceptSwap accepts a swap, atomically exchanging the escrowed credits and
+	// coins of the proposer for the requested credits of the counterparty.
+	//
+	// Since Revision 1
+	AcceptSwap(ctx context.Context, in *MsgAcceptSwap, opts ...grpc.CallOption) (*MsgAcceptSwapResponse, error)
+	// CreateForwardContract pre-purchases credits to be delivered by an issuer
+	// from an open credit batch or from the next credit batch issued for a
+	// project. The payment is held in escrow and released to the issuer per
+	// unit as credits are minted or issued to the buyer, and the payment for
+	// any undelivered credits is returned to the buyer after the deadline.
+	//
+	// Since Revision 1
+	CreateForwardContract(ctx context.Context, in *MsgCreateForwardContract, opts ...grpc.CallOption) (*MsgCreateForwardContractResponse, error)
+	// CancelSellOrders cancels multiple sell orders and returns the escrowed
+	// credits to the seller. The sell orders are either specified by id or, when
+	// cancelling all sell orders, all sell orders of the seller are cancelled,
+	// optionally filtered by credit batch.
+	//
+	// Since Revision 1
+	CancelSellOrders(ctx context.Context, in *MsgCancelSellOrders, opts ...grpc.CallOption) (*MsgCancelSellOrdersResponse, error)
 }
 
 type msgClient struct {
@@ -103,6 +186,132 @@ func (c *msgClient) RemoveAllowedDenom(ctx context.Context, in *MsgRemoveAllowed
 	return out, nil
 }
 
+func (c *msgClient) Buy(ctx context.Context, in *MsgBuyOrder, opts ...grpc.CallOption) (*MsgBuyOrderResponse, error) {
+	out := new(MsgBuyOrderResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/Buy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelBuyOrder(ctx context.Context, in *MsgCancelBuyOrder, opts ...grpc.CallOption) (*MsgCancelBuyOrderResponse, error) {
+	out := new(MsgCancelBuyOrderResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/CancelBuyOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateTradingFee(ctx context.Context, in *MsgUpdateTradingFee, opts ...grpc.CallOption) (*MsgUpdateTradingFeeResponse, error) {
+	out := new(MsgUpdateTradingFeeResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/UpdateTradingFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateClassRoyalty(ctx context.Context, in *MsgUpdateClassRoyalty, opts ...grpc.CallOption) (*MsgUpdateClassRoyaltyResponse, error) {
+	out := new(MsgUpdateClassRoyaltyResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/UpdateClassRoyalty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateAuction(ctx context.Context, in *MsgCreateAuction, opts ...grpc.CallOption) (*MsgCreateAuctionResponse, error) {
+	out := new(MsgCreateAuctionResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/CreateAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BidAuction(ctx context.Context, in *MsgBidAuction, opts ...grpc.CallOption) (*MsgBidAuctionResponse, error) {
+	out := new(MsgBidAuctionResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/BidAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MakeOffer(ctx context.Context, in *MsgMakeOffer, opts ...grpc.CallOption) (*MsgMakeOfferResponse, error) {
+	out := new(MsgMakeOfferResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/MakeOffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptOffer(ctx context.Context, in *MsgAcceptOffer, opts ...grpc.CallOption) (*MsgAcceptOfferResponse, error) {
+	out := new(MsgAcceptOfferResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/AcceptOffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RejectOffer(ctx context.Context, in *MsgRejectOffer, opts ...grpc.CallOption) (*MsgRejectOfferResponse, error) {
+	out := new(MsgRejectOfferResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/RejectOffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CounterOffer(ctx context.Context, in *MsgCounterOffer, opts ...grpc.CallOption) (*MsgCounterOfferResponse, error) {
+	out := new(MsgCounterOfferResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/CounterOffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProposeSwap(ctx context.Context, in *MsgProposeSwap, opts ...grpc.CallOption) (*MsgProposeSwapResponse, error) {
+	out := new(MsgProposeSwapResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/ProposeSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptSwap(ctx context.Context, in *MsgAcceptSwap, opts ...grpc.CallOption) (*MsgAcceptSwapResponse, error) {
+	out := new(MsgAcceptSwapResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/AcceptSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateForwardContract(ctx context.Context, in *MsgCreateForwardContract, opts ...grpc.CallOption) (*MsgCreateForwardContractResponse, error) {
+	out := new(MsgCreateForwardContractResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/CreateForwardContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelSellOrders(ctx context.Context, in *MsgCancelSellOrders, opts ...grpc.CallOption) (*MsgCancelSellOrdersResponse, error) {
+	out := new(MsgCancelSellOrdersResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/CancelSellOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -124,6 +333,89 @@ type MsgServer interface {
 	//
 	// Since Revision 1
 	RemoveAllowedDenom(context.Context, *MsgRemoveAllowedDenom) (*MsgRemoveAllowedDenomResponse, error)
+	// Buy creates new buy orders. The total bid amount of each buy order is held
+	// in escrow and buy orders are matched with sell orders in the order book at
+	// the end of each block.
+	//
+	// Since Revision 1
+	Buy(context.Context, *MsgBuyOrder) (*MsgBuyOrderResponse, error)
+	// CancelBuyOrder cancels a buy order and returns the funds from escrow.
+	//
+	// Since Revision 1
+	CancelBuyOrder(context.Context, *MsgCancelBuyOrder) (*MsgCancelBuyOrderResponse, error)
+	// UpdateTradingFee is a governance method that allows for updating the
+	// marketplace trading fee.
+	//
+	// Since Revision 1
+	UpdateTradingFee(context.Context, *MsgUpdateTradingFee) (*MsgUpdateTradingFeeResponse, error)
+	// UpdateClassRoyalty allows the admin of a credit class to set or remove the
+	// royalty paid whenever credits from the credit class are sold in the
+	// marketplace.
+	//
+	// Since Revision 1
+	UpdateClassRoyalty(context.Context, *MsgUpdateClassRoyalty) (*MsgUpdateClassRoyaltyResponse, error)
+	// CreateAuction creates a new English or Dutch auction. The credits being
+	// auctioned are held in escrow until the auction is settled.
+	//
+	// Since Revision 1
+	CreateAuction(context.Context, *MsgCreateAuction) (*MsgCreateAuctionResponse, error)
+	// BidAuction places a bid on an auction. The total bid amount is held in
+	// escrow and returned to the bidder if the bidder is outbid.
+	//
+	// Since Revision 1
+	BidAuction(context.Context, *MsgBidAuction) (*MsgBidAuctionResponse, error)
+	// MakeOffer makes an offer to buy credits from a sell order at a price
+	// below the ask price of the sell order. The total bid amount is held in
+	// escrow until the offer is accepted, rejected, or expires, or until the
+	// sell order is removed.
+	//
+	// Since Revision 1
+	MakeOffer(context.Context, *MsgMakeOffer) (*MsgMakeOfferResponse, error)
+	// AcceptOffer accepts an offer, atomically transferring the credits from
+	// the sell order to the buyer and the escrowed funds to the seller. The
+	// seller accepts an offer made by the buyer and the buyer accepts a counter
+	// offer made by the seller.
+	//
+	// Since Revision 1
+	AcceptOffer(context.Context, *MsgAcceptOffer) (*MsgAcceptOfferResponse, error)
+	// RejectOffer rejects an offer and returns the escrowed funds to the buyer.
+	// The offer can be rejected by the seller or withdrawn by the buyer.
+	//
+	// Since Revision 1
+	RejectOffer(context.Context, *MsgRejectOffer) (*MsgRejectOfferResponse, error)
+	// CounterOffer responds to an offer with a new price. The seller counters
+	// an offer made by the buyer and the buyer counters a counter offer made by
+	// the seller.
+	//
+	// Since Revision 1
+	CounterOffer(context.Context, *MsgCounterOffer) (*MsgCounterOfferResponse, error)
+	// ProposeSwap proposes to exchange credits from one credit batch, optionally
+	// together with coins, for credits from another credit batch held by the
+	// counterparty. The offered credits and coins are held in escrow until the
+	// swap is accepted or expires.
+	//
+	// Since Revision 1
+	ProposeSwap(context.Context, *MsgProposeSwap) (*MsgProposeSwapResponse, error)
+	// AcceptSwap accepts a swap, atomically exchanging the escrowed credits and
+	// coins of the proposer for the requested credits of the counterparty.
+	//
+	// Since Revision 1
+	AcceptSwap(context.Context, *MsgAcceptSwap) (*MsgAcceptSwapResponse, error)
+	// CreateForwardContract pre-purchases credits to be delivered by an issuer
+	// from an open credit batch or from the next credit batch issued for a
+	// project. The payment is held in escrow and released to the issuer per
+	// unit as credits are minted or issued to the buyer, and the payment for
+	// any undelivered credits is returned to the buyer after the deadline.
+	//
+	// Since Revision 1
+	CreateForwardContract(context.Context, *MsgCreateForwardContract) (*MsgCreateForwardContractResponse, error)
+	// CancelSellOrders cancels multiple sell orders and returns the escrowed
+	// credits to the seller. The sell orders are either specified by id or, when
+	// cancelling all sell orders, all sell orders of the seller are cancelled,
+	// optionally filtered by credit batch.
+	//
+	// Since Revision 1
+	CancelSellOrders(context.Context, *MsgCancelSellOrders) (*MsgCancelSellOrdersResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -149,6 +441,48 @@ func (UnimplementedMsgServer) AddAllowedDenom(context.Context, *MsgAddAllowedDen
 func (UnimplementedMsgServer) RemoveAllowedDenom(context.Context, *MsgRemoveAllowedDenom) (*MsgRemoveAllowedDenomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveAllowedDenom not implemented")
 }
+func (UnimplementedMsgServer) Buy(context.Context, *MsgBuyOrder) (*MsgBuyOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Buy not implemented")
+}
+func (UnimplementedMsgServer) CancelBuyOrder(context.Context, *MsgCancelBuyOrder) (*MsgCancelBuyOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBuyOrder not implemented")
+}
+func (UnimplementedMsgServer) UpdateTradingFee(context.Context, *MsgUpdateTradingFee) (*MsgUpdateTradingFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTradingFee not implemented")
+}
+func (UnimplementedMsgServer) UpdateClassRoyalty(context.Context, *MsgUpdateClassRoyalty) (*MsgUpdateClassRoyaltyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClassRoyalty not implemented")
+}
+func (UnimplementedMsgServer) CreateAuction(context.Context, *MsgCreateAuction) (*MsgCreateAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAuction not implemented")
+}
+func (UnimplementedMsgServer) BidAuction(context.Context, *MsgBidAuction) (*MsgBidAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BidAuction not implemented")
+}
+func (UnimplementedMsgServer) MakeOffer(context.Context, *MsgMakeOffer) (*MsgMakeOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MakeOffer not implemented")
+}
+func (UnimplementedMsgServer) AcceptOffer(context.Context, *MsgAcceptOffer) (*MsgAcceptOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptOffer not implemented")
+}
+func (UnimplementedMsgServer) RejectOffer(context.Context, *MsgRejectOffer) (*MsgRejectOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectOffer not implemented")
+}
+func (UnimplementedMsgServer) CounterOffer(context.Context, *MsgCounterOffer) (*MsgCounterOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CounterOffer not implemented")
+}
+func (UnimplementedMsgServer) ProposeSwap(context.Context, *MsgProposeSwap) (*MsgProposeSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeSwap not implemented")
+}
+func (UnimplementedMsgServer) AcceptSwap(context.Context, *MsgAcceptSwap) (*MsgAcceptSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptSwap not implemented")
+}
+func (UnimplementedMsgServer) CreateForwardContract(context.Context, *MsgCreateForwardContract) (*MsgCreateForwardContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateForwardContract not implemented")
+}
+func (UnimplementedMsgServer) CancelSellOrders(context.Context, *MsgCancelSellOrders) (*MsgCancelSellOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSellOrders not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -270,6 +604,258 @@ func _Msg_RemoveAllowedDenom_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Buy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBuyOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Buy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/Buy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Buy(ctx, req.(*MsgBuyOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelBuyOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelBuyOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelBuyOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/CancelBuyOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelBuyOrder(ctx, req.(*MsgCancelBuyOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateTradingFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTradingFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTradingFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/UpdateTradingFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTradingFee(ctx, req.(*MsgUpdateTradingFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateClassRoyalty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateClassRoyalty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateClassRoyalty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/UpdateClassRoyalty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateClassRoyalty(ctx, req.(*MsgUpdateClassRoyalty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/CreateAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateAuction(ctx, req.(*MsgCreateAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BidAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBidAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BidAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/BidAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BidAuction(ctx, req.(*MsgBidAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MakeOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMakeOffer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MakeOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/MakeOffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MakeOffer(ctx, req.(*MsgMakeOffer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptOffer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/AcceptOffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptOffer(ctx, req.(*MsgAcceptOffer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RejectOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejectOffer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RejectOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/RejectOffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RejectOffer(ctx, req.(*MsgRejectOffer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CounterOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCounterOffer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CounterOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/CounterOffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CounterOffer(ctx, req.(*MsgCounterOffer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProposeSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/ProposeSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeSwap(ctx, req.(*MsgProposeSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/AcceptSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptSwap(ctx, req.(*MsgAcceptSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateForwardContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateForwardContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateForwardContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/CreateForwardContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateForwardContract(ctx, req.(*MsgCreateForwardContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelSellOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelSellOrders)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelSellOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/CancelSellOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelSellOrders(ctx, req.(*MsgCancelSellOrders))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -301,6 +887,62 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveAllowedDenom",
 			Handler:    _Msg_RemoveAllowedDenom_Handler,
 		},
+		{
+			MethodName: "Buy",
+			Handler:    _Msg_Buy_Handler,
+		},
+		{
+			MethodName: "CancelBuyOrder",
+			Handler:    _Msg_CancelBuyOrder_Handler,
+		},
+		{
+			MethodName: "UpdateTradingFee",
+			Handler:    _Msg_UpdateTradingFee_Handler,
+		},
+		{
+			MethodName: "UpdateClassRoyalty",
+			Handler:    _Msg_UpdateClassRoyalty_Handler,
+		},
+		{
+			MethodName: "CreateAuction",
+			Handler:    _Msg_CreateAuction_Handler,
+		},
+		{
+			MethodName: "BidAuction",
+			Handler:    _Msg_BidAuction_Handler,
+		},
+		{
+			MethodName: "MakeOffer",
+			Handler:    _Msg_MakeOffer_Handler,
+		},
+		{
+			MethodName: "AcceptOffer",
+			Handler:    _Msg_AcceptOffer_Handler,
+		},
+		{
+			MethodName: "RejectOffer",
+			Handler:    _Msg_RejectOffer_Handler,
+		},
+		{
+			MethodName: "CounterOffer",
+			Handler:    _Msg_CounterOffer_Handler,
+		},
+		{
+			MethodName: "ProposeSwap",
+			Handler:    _Msg_ProposeSwap_Handler,
+		},
+		{
+			MethodName: "AcceptSwap",
+			Handler:    _Msg_AcceptSwap_Handler,
+		},
+		{
+			MethodName: "CreateForwardContract",
+			Handler:    _Msg_CreateForwardContract_Handler,
+		},
+		{
+			MethodName: "CancelSellOrders",
+			Handler:    _Msg_CancelSellOrders_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "regen/ecocredit/marketplace/v1/tx.proto",
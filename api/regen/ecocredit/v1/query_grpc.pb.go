@@ -35,6 +35,11 @@ type QueryClient interface {
 	Projects(ctx context.Context, in *QueryProjectsRequest, opts ...grpc.CallOption) (*QueryProjectsResponse, error)
 	// ProjectsByClass queries for all projects within a class with pagination.
 	ProjectsByClass(ctx context.Context, in *QueryProjectsByClassRequest, opts ...grpc.CallOption) (*QueryProjectsByClassResponse, error)
+	// ProjectsByStatus queries for all projects with the given status with
+	// pagination.
+	//
+	// Since Revision 1
+	ProjectsByStatus(ctx context.Context, in *QueryProjectsByStatusRequest, opts ...grpc.CallOption) (*QueryProjectsByStatusResponse, error)
 	// ProjectsByReferenceId queries for all projects by reference-id with
 	// pagination.
 	ProjectsByReferenceId(ctx context.Context, in *QueryProjectsByReferenceIdRequest, opts ...grpc.CallOption) (*QueryProjectsByReferenceIdResponse, error)
@@ -99,6 +104,46 @@ type QueryClient interface {
 	//
 	// Since Revision 1
 	AllowedBridgeChains(ctx context.Context, in *QueryAllowedBridgeChainsRequest, opts ...grpc.CallOption) (*QueryAllowedBridgeChainsResponse, error)
+	// Retirement queries a retirement record by its unique identifier.
+	//
+	// Since Revision 1
+	Retirement(ctx context.Context, in *QueryRetirementRequest, opts ...grpc.CallOption) (*QueryRetirementResponse, error)
+	// RetirementsByOwner queries all retirement records of a given owner.
+	//
+	// Since Revision 1
+	RetirementsByOwner(ctx context.Context, in *QueryRetirementsByOwnerRequest, opts ...grpc.CallOption) (*QueryRetirementsByOwnerResponse, error)
+	// RetirementsByBatch queries all retirement records of a given credit batch.
+	//
+	// Since Revision 1
+	RetirementsByBatch(ctx context.Context, in *QueryRetirementsByBatchRequest, opts ...grpc.CallOption) (*QueryRetirementsByBatchResponse, error)
+	// ClassIssuerQuota queries the quota of an issuer of a credit class.
+	//
+	// Since Revision 1
+	ClassIssuerQuota(ctx context.Context, in *QueryClassIssuerQuotaRequest, opts ...grpc.CallOption) (*QueryClassIssuerQuotaResponse, error)
+	// ClassIssuerQuotas queries the quotas of all issuers of a credit class with
+	// pagination.
+	//
+	// Since Revision 1
+	ClassIssuerQuotas(ctx context.Context, in *QueryClassIssuerQuotasRequest, opts ...grpc.CallOption) (*QueryClassIssuerQuotasResponse, error)
+	// PendingBatch queries a pending batch by id.
+	//
+	// Since Revision 1
+	PendingBatch(ctx context.Context, in *QueryPendingBatchRequest, opts ...grpc.CallOption) (*QueryPendingBatchResponse, error)
+	// PendingBatchesByClass queries the pending batches of a credit class with
+	// pagination.
+	//
+	// Since Revision 1
+	PendingBatchesByClass(ctx context.Context, in *QueryPendingBatchesByClassRequest, opts ...grpc.CallOption) (*QueryPendingBatchesByClassResponse, error)
+	// CreditAllowance queries the credit allowance of a spender for a credit
+	// batch of an owner.
+	//
+	// Since Revision 1
+	CreditAllowance(ctx context.Context, in *QueryCreditAllowanceRequest, opts ...grpc.CallOption) (*QueryCreditAllowanceResponse, error)
+	// CreditAllowancesBySpender queries the credit allowances of a spender with
+	// pagination.
+	//
+	// Since Revision 1
+	CreditAllowancesBySpender(ctx context.Context, in *QueryCreditAllowancesBySpenderRequest, opts ...grpc.CallOption) (*QueryCreditAllowancesBySpenderResponse, error)
 }
 
 type queryClient struct {
@@ -163,6 +208,15 @@ func (c *queryClient) ProjectsByClass(ctx context.Context, in *QueryProjectsByCl
 	return out, nil
 }
 
+func (c *queryClient) ProjectsByStatus(ctx context.Context, in *QueryProjectsByStatusRequest, opts ...grpc.CallOption) (*QueryProjectsByStatusResponse, error) {
+	out := new(QueryProjectsByStatusResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/ProjectsByStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ProjectsByReferenceId(ctx context.Context, in *QueryProjectsByReferenceIdRequest, opts ...grpc.CallOption) (*QueryProjectsByReferenceIdResponse, error) {
 	out := new(QueryProjectsByReferenceIdResponse)
 	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/ProjectsByReferenceId", in, out, opts...)
@@ -344,6 +398,87 @@ func (c *queryClient) AllowedBridgeChains(ctx context.Context, in *QueryAllowedB
 	return out, nil
 }
 
+func (c *queryClient) Retirement(ctx context.Context, in *QueryRetirementRequest, opts ...grpc.CallOption) (*QueryRetirementResponse, error) {
+	out := new(QueryRetirementResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/Retirement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RetirementsByOwner(ctx context.Context, in *QueryRetirementsByOwnerRequest, opts ...grpc.CallOption) (*QueryRetirementsByOwnerResponse, error) {
+	out := new(QueryRetirementsByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/RetirementsByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RetirementsByBatch(ctx context.Context, in *QueryRetirementsByBatchRequest, opts ...grpc.CallOption) (*QueryRetirementsByBatchResponse, error) {
+	out := new(QueryRetirementsByBatchResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/RetirementsByBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClassIssuerQuota(ctx context.Context, in *QueryClassIssuerQuotaRequest, opts ...grpc.CallOption) (*QueryClassIssuerQuotaResponse, error) {
+	out := new(QueryClassIssuerQuotaResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/ClassIssuerQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClassIssuerQuotas(ctx context.Context, in *QueryClassIssuerQuotasRequest, opts ...grpc.CallOption) (*QueryClassIssuerQuotasResponse, error) {
+	out := new(QueryClassIssuerQuotasResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/ClassIssuerQuotas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingBatch(ctx context.Context, in *QueryPendingBatchRequest, opts ...grpc.CallOption) (*QueryPendingBatchResponse, error) {
+	out := new(QueryPendingBatchResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/PendingBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingBatchesByClass(ctx context.Context, in *QueryPendingBatchesByClassRequest, opts ...grpc.CallOption) (*QueryPendingBatchesByClassResponse, error) {
+	out := new(QueryPendingBatchesByClassResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/PendingBatchesByClass", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CreditAllowance(ctx context.Context, in *QueryCreditAllowanceRequest, opts ...grpc.CallOption) (*QueryCreditAllowanceResponse, error) {
+	out := new(QueryCreditAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/CreditAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CreditAllowancesBySpender(ctx context.Context, in *QueryCreditAllowancesBySpenderRequest, opts ...grpc.CallOption) (*QueryCreditAllowancesBySpenderResponse, error) {
+	out := new(QueryCreditAllowancesBySpenderResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/CreditAllowancesBySpender", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -361,6 +496,11 @@ type QueryServer interface {
 	Projects(context.Context, *QueryProjectsRequest) (*QueryProjectsResponse, error)
 	// ProjectsByClass queries for all projects within a class with pagination.
 	ProjectsByClass(context.Context, *QueryProjectsByClassRequest) (*QueryProjectsByClassResponse, error)
+	// ProjectsByStatus queries for all projects with the given status with
+	// pagination.
+	//
+	// Since Revision 1
+	ProjectsByStatus(context.Context, *QueryProjectsByStatusRequest) (*QueryProjectsByStatusResponse, error)
 	// ProjectsByReferenceId queries for all projects by reference-id with
 	// pagination.
 	ProjectsByReferenceId(context.Context, *QueryProjectsByReferenceIdRequest) (*QueryProjectsByReferenceIdResponse, error)
@@ -425,6 +565,46 @@ type QueryServer interface {
 	//
 	// Since Revision 1
 	AllowedBridgeChains(context.Context, *QueryAllowedBridgeChainsRequest) (*QueryAllowedBridgeChainsResponse, error)
+	// Retirement queries a retirement record by its unique identifier.
+	//
+	// Since Revision 1
+	Retirement(context.Context, *QueryRetirementRequest) (*QueryRetirementResponse, error)
+	// RetirementsByOwner queries all retirement records of a given owner.
+	//
+	// Since Revision 1
+	RetirementsByOwner(context.Context, *QueryRetirementsByOwnerRequest) (*QueryRetirementsByOwnerResponse, error)
+	// RetirementsByBatch queries all retirement records of a given credit batch.
+	//
+	// Since Revision 1
+	RetirementsByBatch(context.Context, *QueryRetirementsByBatchRequest) (*QueryRetirementsByBatchResponse, error)
+	// ClassIssuerQuota queries the quota of an issuer of a credit class.
+	//
+	// Since Revision 1
+	ClassIssuerQuota(context.Context, *QueryClassIssuerQuotaRequest) (*QueryClassIssuerQuotaResponse, error)
+	// ClassIssuerQuotas queries the quotas of all issuers of a credit class with
+	// pagination.
+	//
+	// Since Revision 1
+	ClassIssuerQuotas(context.Context, *QueryClassIssuerQuotasRequest) (*QueryClassIssuerQuotasResponse, error)
+	// PendingBatch queries a pending batch by id.
+	//
+	// Since Revision 1
+	PendingBatch(context.Context, *QueryPendingBatchRequest) (*QueryPendingBatchResponse, error)
+	// PendingBatchesByClass queries the pending batches of a credit class with
+	// pagination.
+	//
+	// Since Revision 1
+	PendingBatchesByClass(context.Context, *QueryPendingBatchesByClassRequest) (*QueryPendingBatchesByClassResponse, error)
+	// CreditAllowance queries the credit allowance of a spender for a credit
+	// batch of an owner.
+	//
+	// Since Revision 1
+	CreditAllowance(context.Context, *QueryCreditAllowanceRequest) (*QueryCreditAllowanceResponse, error)
+	// CreditAllowancesBySpender queries the credit allowances of a spender with
+	// pagination.
+	//
+	// Since Revision 1
+	CreditAllowancesBySpender(context.Context, *QueryCreditAllowancesBySpenderRequest) (*QueryCreditAllowancesBySpenderResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -450,6 +630,9 @@ func (UnimplementedQueryServer) Projects(context.Context, *QueryProjectsRequest)
 func (UnimplementedQueryServer) ProjectsByClass(context.Context, *QueryProjectsByClassRequest) (*QueryProjectsByClassResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProjectsByClass not implemented")
 }
+func (UnimplementedQueryServer) ProjectsByStatus(context.Context, *QueryProjectsByStatusRequest) (*QueryProjectsByStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectsByStatus not implemented")
+}
 func (UnimplementedQueryServer) ProjectsByReferenceId(context.Context, *QueryProjectsByReferenceIdRequest) (*QueryProjectsByReferenceIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProjectsByReferenceId not implemented")
 }
@@ -510,6 +693,33 @@ func (UnimplementedQueryServer) ClassFee(context.Context, *QueryClassFeeRequest)
 func (UnimplementedQueryServer) AllowedBridgeChains(context.Context, *QueryAllowedBridgeChainsRequest) (*QueryAllowedBridgeChainsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllowedBridgeChains not implemented")
 }
+func (UnimplementedQueryServer) Retirement(context.Context, *QueryRetirementRequest) (*QueryRetirementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Retirement not implemented")
+}
+func (UnimplementedQueryServer) RetirementsByOwner(context.Context, *QueryRetirementsByOwnerRequest) (*QueryRetirementsByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetirementsByOwner not implemented")
+}
+func (UnimplementedQueryServer) RetirementsByBatch(context.Context, *QueryRetirementsByBatchRequest) (*QueryRetirementsByBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetirementsByBatch not implemented")
+}
+func (UnimplementedQueryServer) ClassIssuerQuota(context.Context, *QueryClassIssuerQuotaRequest) (*QueryClassIssuerQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassIssuerQuota not implemented")
+}
+func (UnimplementedQueryServer) ClassIssuerQuotas(context.Context, *QueryClassIssuerQuotasRequest) (*QueryClassIssuerQuotasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassIssuerQuotas not implemented")
+}
+func (UnimplementedQueryServer) PendingBatch(context.Context, *QueryPendingBatchRequest) (*QueryPendingBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingBatch not implemented")
+}
+func (UnimplementedQueryServer) PendingBatchesByClass(context.Context, *QueryPendingBatchesByClassRequest) (*QueryPendingBatchesByClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingBatchesByClass not implemented")
+}
+func (UnimplementedQueryServer) CreditAllowance(context.Context, *QueryCreditAllowanceRequest) (*QueryCreditAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreditAllowance not implemented")
+}
+func (UnimplementedQueryServer) CreditAllowancesBySpender(context.Context, *QueryCreditAllowancesBySpenderRequest) (*QueryCreditAllowancesBySpenderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreditAllowancesBySpender not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -631,6 +841,24 @@ func _Query_ProjectsByClass_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProjectsByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectsByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectsByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/ProjectsByStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectsByStatus(ctx, req.(*QueryProjectsByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ProjectsByReferenceId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryProjectsByReferenceIdRequest)
 	if err := dec(in); err != nil {
@@ -991,6 +1219,168 @@ func _Query_AllowedBridgeChains_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Retirement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRetirementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Retirement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/Retirement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Retirement(ctx, req.(*QueryRetirementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RetirementsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRetirementsByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RetirementsByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/RetirementsByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RetirementsByOwner(ctx, req.(*QueryRetirementsByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RetirementsByBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRetirementsByBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RetirementsByBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/RetirementsByBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RetirementsByBatch(ctx, req.(*QueryRetirementsByBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClassIssuerQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassIssuerQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClassIssuerQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/ClassIssuerQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassIssuerQuota(ctx, req.(*QueryClassIssuerQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClassIssuerQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassIssuerQuotasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClassIssuerQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/ClassIssuerQuotas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassIssuerQuotas(ctx, req.(*QueryClassIssuerQuotasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/PendingBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingBatch(ctx, req.(*QueryPendingBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingBatchesByClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingBatchesByClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingBatchesByClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/PendingBatchesByClass",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingBatchesByClass(ctx, req.(*QueryPendingBatchesByClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CreditAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCreditAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CreditAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/CreditAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CreditAllowance(ctx, req.(*QueryCreditAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CreditAllowancesBySpender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCreditAllowancesBySpenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CreditAllowancesBySpender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/CreditAllowancesBySpender",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CreditAllowancesBySpender(ctx, req.(*QueryCreditAllowancesBySpenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1022,6 +1412,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ProjectsByClass",
 			Handler:    _Query_ProjectsByClass_Handler,
 		},
+		{
+			MethodName: "ProjectsByStatus",
+			Handler:    _Query_ProjectsByStatus_Handler,
+		},
 		{
 			MethodName: "ProjectsByReferenceId",
 			Handler:    _Query_ProjectsByReferenceId_Handler,
@@ -1102,6 +1496,42 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllowedBridgeChains",
 			Handler:    _Query_AllowedBridgeChains_Handler,
 		},
+		{
+			MethodName: "Retirement",
+			Handler:    _Query_Retirement_Handler,
+		},
+		{
+			MethodName: "RetirementsByOwner",
+			Handler:    _Query_RetirementsByOwner_Handler,
+		},
+		{
+			MethodName: "RetirementsByBatch",
+			Handler:    _Query_RetirementsByBatch_Handler,
+		},
+		{
+			MethodName: "ClassIssuerQuota",
+			Handler:    _Query_ClassIssuerQuota_Handler,
+		},
+		{
+			MethodName: "ClassIssuerQuotas",
+			Handler:    _Query_ClassIssuerQuotas_Handler,
+		},
+		{
+			MethodName: "PendingBatch",
+			Handler:    _Query_PendingBatch_Handler,
+		},
+		{
+			MethodName: "PendingBatchesByClass",
+			Handler:    _Query_PendingBatchesByClass_Handler,
+		},
+		{
+			MethodName: "CreditAllowance",
+			Handler:    _Query_CreditAllowance_Handler,
+		},
+		{
+			MethodName: "CreditAllowancesBySpender",
+			Handler:    _Query_CreditAllowancesBySpender_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "regen/ecocredit/v1/query.proto",
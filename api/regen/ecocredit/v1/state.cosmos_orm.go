@@ -590,6 +590,19 @@ func (this ProjectClassKeyReferenceIdIndexKey) WithClassKeyReferenceId(class_key
 	return this
 }
 
+type ProjectStatusIndexKey struct {
+	vs []interface{}
+}
+
+func (x ProjectStatusIndexKey) id() uint32            { return 6 }
+func (x ProjectStatusIndexKey) values() []interface{} { return x.vs }
+func (x ProjectStatusIndexKey) projectIndexKey()      {}
+
+func (this ProjectStatusIndexKey) WithStatus(status ProjectStatus) ProjectStatusIndexKey {
+	this.vs = []interface{}{status}
+	return this
+}
+
 type projectTable struct {
 	table ormtable.AutoIncrementTable
 }
@@ -2058,6 +2071,658 @@ func NewAllowedBridgeChainTable(db ormtable.Schema) (AllowedBridgeChainTable, er
 	return allowedBridgeChainTable{table}, nil
 }
 
+type RetirementTable interface {
+	Insert(ctx context.Context, retirement *Retirement) error
+	InsertReturningID(ctx context.Context, retirement *Retirement) (uint64, error)
+	Update(ctx context.Context, retirement *Retirement) error
+	Save(ctx context.Context, retirement *Retirement) error
+	Delete(ctx context.Context, retirement *Retirement) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*Retirement, error)
+	List(ctx context.Context, prefixKey RetirementIndexKey, opts ...ormlist.Option) (RetirementIterator, error)
+	ListRange(ctx context.Context, from, to RetirementIndexKey, opts ...ormlist.Option) (RetirementIterator, error)
+	DeleteBy(ctx context.Context, prefixKey RetirementIndexKey) error
+	DeleteRange(ctx context.Context, from, to RetirementIndexKey) error
+
+	doNotImplement()
+}
+
+type RetirementIterator struct {
+	ormtable.Iterator
+}
+
+func (i RetirementIterator) Value() (*Retirement, error) {
+	var retirement Retirement
+	err := i.UnmarshalMessage(&retirement)
+	return &retirement, err
+}
+
+type RetirementIndexKey interface {
+	id() uint32
+	values() []interface{}
+	retirementIndexKey()
+}
+
+// primary key starting index..
+type RetirementPrimaryKey = RetirementIdIndexKey
+
+type RetirementIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x RetirementIdIndexKey) id() uint32            { return 0 }
+func (x RetirementIdIndexKey) values() []interface{} { return x.vs }
+func (x RetirementIdIndexKey) retirementIndexKey()   {}
+
+func (this RetirementIdIndexKey) WithId(id uint64) RetirementIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type RetirementOwnerIndexKey struct {
+	vs []interface{}
+}
+
+func (x RetirementOwnerIndexKey) id() uint32            { return 1 }
+func (x RetirementOwnerIndexKey) values() []interface{} { return x.vs }
+func (x RetirementOwnerIndexKey) retirementIndexKey()   {}
+
+func (this RetirementOwnerIndexKey) WithOwner(owner []byte) RetirementOwnerIndexKey {
+	this.vs = []interface{}{owner}
+	return this
+}
+
+type RetirementBatchKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x RetirementBatchKeyIndexKey) id() uint32            { return 2 }
+func (x RetirementBatchKeyIndexKey) values() []interface{} { return x.vs }
+func (x RetirementBatchKeyIndexKey) retirementIndexKey()   {}
+
+func (this RetirementBatchKeyIndexKey) WithBatchKey(batch_key uint64) RetirementBatchKeyIndexKey {
+	this.vs = []interface{}{batch_key}
+	return this
+}
+
+type retirementTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this retirementTable) Insert(ctx context.Context, retirement *Retirement) error {
+	return this.table.Insert(ctx, retirement)
+}
+
+func (this retirementTable) Update(ctx context.Context, retirement *Retirement) error {
+	return this.table.Update(ctx, retirement)
+}
+
+func (this retirementTable) Save(ctx context.Context, retirement *Retirement) error {
+	return this.table.Save(ctx, retirement)
+}
+
+func (this retirementTable) Delete(ctx context.Context, retirement *Retirement) error {
+	return this.table.Delete(ctx, retirement)
+}
+
+func (this retirementTable) InsertReturningID(ctx context.Context, retirement *Retirement) (uint64, error) {
+	return this.table.InsertReturningID(ctx, retirement)
+}
+
+func (this retirementTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this retirementTable) Get(ctx context.Context, id uint64) (*Retirement, error) {
+	var retirement Retirement
+	found, err := this.table.PrimaryKey().Get(ctx, &retirement, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &retirement, nil
+}
+
+func (this retirementTable) List(ctx context.Context, prefixKey RetirementIndexKey, opts ...ormlist.Option) (RetirementIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return RetirementIterator{it}, err
+}
+
+func (this retirementTable) ListRange(ctx context.Context, from, to RetirementIndexKey, opts ...ormlist.Option) (RetirementIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return RetirementIterator{it}, err
+}
+
+func (this retirementTable) DeleteBy(ctx context.Context, prefixKey RetirementIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this retirementTable) DeleteRange(ctx context.Context, from, to RetirementIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this retirementTable) doNotImplement() {}
+
+var _ RetirementTable = retirementTable{}
+
+func NewRetirementTable(db ormtable.Schema) (RetirementTable, error) {
+	table := db.GetTable(&Retirement{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&Retirement{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return retirementTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type ClassIssuerQuotaTable interface {
+	Insert(ctx context.Context, classIssuerQuota *ClassIssuerQuota) error
+	Update(ctx context.Context, classIssuerQuota *ClassIssuerQuota) error
+	Save(ctx context.Context, classIssuerQuota *ClassIssuerQuota) error
+	Delete(ctx context.Context, classIssuerQuota *ClassIssuerQuota) error
+	Has(ctx context.Context, class_key uint64, issuer []byte) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, class_key uint64, issuer []byte) (*ClassIssuerQuota, error)
+	List(ctx context.Context, prefixKey ClassIssuerQuotaIndexKey, opts ...ormlist.Option) (ClassIssuerQuotaIterator, error)
+	ListRange(ctx context.Context, from, to ClassIssuerQuotaIndexKey, opts ...ormlist.Option) (ClassIssuerQuotaIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ClassIssuerQuotaIndexKey) error
+	DeleteRange(ctx context.Context, from, to ClassIssuerQuotaIndexKey) error
+
+	doNotImplement()
+}
+
+type ClassIssuerQuotaIterator struct {
+	ormtable.Iterator
+}
+
+func (i ClassIssuerQuotaIterator) Value() (*ClassIssuerQuota, error) {
+	var classIssuerQuota ClassIssuerQuota
+	err := i.UnmarshalMessage(&classIssuerQuota)
+	return &classIssuerQuota, err
+}
+
+type ClassIssuerQuotaIndexKey interface {
+	id() uint32
+	values() []interface{}
+	classIssuerQuotaIndexKey()
+}
+
+// primary key starting index..
+type ClassIssuerQuotaPrimaryKey = ClassIssuerQuotaClassKeyIssuerIndexKey
+
+type ClassIssuerQuotaClassKeyIssuerIndexKey struct {
+	vs []interface{}
+}
+
+func (x ClassIssuerQuotaClassKeyIssuerIndexKey) id() uint32                { return 0 }
+func (x ClassIssuerQuotaClassKeyIssuerIndexKey) values() []interface{}     { return x.vs }
+func (x ClassIssuerQuotaClassKeyIssuerIndexKey) classIssuerQuotaIndexKey() {}
+
+func (this ClassIssuerQuotaClassKeyIssuerIndexKey) WithClassKey(class_key uint64) ClassIssuerQuotaClassKeyIssuerIndexKey {
+	this.vs = []interface{}{class_key}
+	return this
+}
+
+func (this ClassIssuerQuotaClassKeyIssuerIndexKey) WithClassKeyIssuer(class_key uint64, issuer []byte) ClassIssuerQuotaClassKeyIssuerIndexKey {
+	this.vs = []interface{}{class_key, issuer}
+	return this
+}
+
+type classIssuerQuotaTable struct {
+	table ormtable.Table
+}
+
+func (this classIssuerQuotaTable) Insert(ctx context.Context, classIssuerQuota *ClassIssuerQuota) error {
+	return this.table.Insert(ctx, classIssuerQuota)
+}
+
+func (this classIssuerQuotaTable) Update(ctx context.Context, classIssuerQuota *ClassIssuerQuota) error {
+	return this.table.Update(ctx, classIssuerQuota)
+}
+
+func (this classIssuerQuotaTable) Save(ctx context.Context, classIssuerQuota *ClassIssuerQuota) error {
+	return this.table.Save(ctx, classIssuerQuota)
+}
+
+func (this classIssuerQuotaTable) Delete(ctx context.Context, classIssuerQuota *ClassIssuerQuota) error {
+	return this.table.Delete(ctx, classIssuerQuota)
+}
+
+func (this classIssuerQuotaTable) Has(ctx context.Context, class_key uint64, issuer []byte) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, class_key, issuer)
+}
+
+func (this classIssuerQuotaTable) Get(ctx context.Context, class_key uint64, issuer []byte) (*ClassIssuerQuota, error) {
+	var classIssuerQuota ClassIssuerQuota
+	found, err := this.table.PrimaryKey().Get(ctx, &classIssuerQuota, class_key, issuer)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &classIssuerQuota, nil
+}
+
+func (this classIssuerQuotaTable) List(ctx context.Context, prefixKey ClassIssuerQuotaIndexKey, opts ...ormlist.Option) (ClassIssuerQuotaIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ClassIssuerQuotaIterator{it}, err
+}
+
+func (this classIssuerQuotaTable) ListRange(ctx context.Context, from, to ClassIssuerQuotaIndexKey, opts ...ormlist.Option) (ClassIssuerQuotaIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ClassIssuerQuotaIterator{it}, err
+}
+
+func (this classIssuerQuotaTable) DeleteBy(ctx context.Context, prefixKey ClassIssuerQuotaIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this classIssuerQuotaTable) DeleteRange(ctx context.Context, from, to ClassIssuerQuotaIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this classIssuerQuotaTable) doNotImplement() {}
+
+var _ ClassIssuerQuotaTable = classIssuerQuotaTable{}
+
+func NewClassIssuerQuotaTable(db ormtable.Schema) (ClassIssuerQuotaTable, error) {
+	table := db.GetTable(&ClassIssuerQuota{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&ClassIssuerQuota{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return classIssuerQuotaTable{table}, nil
+}
+
+type PendingBatchTable interface {
+	Insert(ctx context.Context, pendingBatch *PendingBatch) error
+	InsertReturningID(ctx context.Context, pendingBatch *PendingBatch) (uint64, error)
+	Update(ctx context.Context, pendingBatch *PendingBatch) error
+	Save(ctx context.Context, pendingBatch *PendingBatch) error
+	Delete(ctx context.Context, pendingBatch *PendingBatch) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*PendingBatch, error)
+	List(ctx context.Context, prefixKey PendingBatchIndexKey, opts ...ormlist.Option) (PendingBatchIterator, error)
+	ListRange(ctx context.Context, from, to PendingBatchIndexKey, opts ...ormlist.Option) (PendingBatchIterator, error)
+	DeleteBy(ctx context.Context, prefixKey PendingBatchIndexKey) error
+	DeleteRange(ctx context.Context, from, to PendingBatchIndexKey) error
+
+	doNotImplement()
+}
+
+type PendingBatchIterator struct {
+	ormtable.Iterator
+}
+
+func (i PendingBatchIterator) Value() (*PendingBatch, error) {
+	var pendingBatch PendingBatch
+	err := i.UnmarshalMessage(&pendingBatch)
+	return &pendingBatch, err
+}
+
+type PendingBatchIndexKey interface {
+	id() uint32
+	values() []interface{}
+	pendingBatchIndexKey()
+}
+
+// primary key starting index..
+type PendingBatchPrimaryKey = PendingBatchIdIndexKey
+
+type PendingBatchIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x PendingBatchIdIndexKey) id() uint32            { return 0 }
+func (x PendingBatchIdIndexKey) values() []interface{} { return x.vs }
+func (x PendingBatchIdIndexKey) pendingBatchIndexKey() {}
+
+func (this PendingBatchIdIndexKey) WithId(id uint64) PendingBatchIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type PendingBatchClassKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x PendingBatchClassKeyIndexKey) id() uint32            { return 1 }
+func (x PendingBatchClassKeyIndexKey) values() []interface{} { return x.vs }
+func (x PendingBatchClassKeyIndexKey) pendingBatchIndexKey() {}
+
+func (this PendingBatchClassKeyIndexKey) WithClassKey(class_key uint64) PendingBatchClassKeyIndexKey {
+	this.vs = []interface{}{class_key}
+	return this
+}
+
+type pendingBatchTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this pendingBatchTable) Insert(ctx context.Context, pendingBatch *PendingBatch) error {
+	return this.table.Insert(ctx, pendingBatch)
+}
+
+func (this pendingBatchTable) Update(ctx context.Context, pendingBatch *PendingBatch) error {
+	return this.table.Update(ctx, pendingBatch)
+}
+
+func (this pendingBatchTable) Save(ctx context.Context, pendingBatch *PendingBatch) error {
+	return this.table.Save(ctx, pendingBatch)
+}
+
+func (this pendingBatchTable) Delete(ctx context.Context, pendingBatch *PendingBatch) error {
+	return this.table.Delete(ctx, pendingBatch)
+}
+
+func (this pendingBatchTable) InsertReturningID(ctx context.Context, pendingBatch *PendingBatch) (uint64, error) {
+	return this.table.InsertReturningID(ctx, pendingBatch)
+}
+
+func (this pendingBatchTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this pendingBatchTable) Get(ctx context.Context, id uint64) (*PendingBatch, error) {
+	var pendingBatch PendingBatch
+	found, err := this.table.PrimaryKey().Get(ctx, &pendingBatch, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &pendingBatch, nil
+}
+
+func (this pendingBatchTable) List(ctx context.Context, prefixKey PendingBatchIndexKey, opts ...ormlist.Option) (PendingBatchIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return PendingBatchIterator{it}, err
+}
+
+func (this pendingBatchTable) ListRange(ctx context.Context, from, to PendingBatchIndexKey, opts ...ormlist.Option) (PendingBatchIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return PendingBatchIterator{it}, err
+}
+
+func (this pendingBatchTable) DeleteBy(ctx context.Context, prefixKey PendingBatchIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this pendingBatchTable) DeleteRange(ctx context.Context, from, to PendingBatchIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this pendingBatchTable) doNotImplement() {}
+
+var _ PendingBatchTable = pendingBatchTable{}
+
+func NewPendingBatchTable(db ormtable.Schema) (PendingBatchTable, error) {
+	table := db.GetTable(&PendingBatch{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&PendingBatch{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return pendingBatchTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type PendingBatchApprovalTable interface {
+	Insert(ctx context.Context, pendingBatchApproval *PendingBatchApproval) error
+	Update(ctx context.Context, pendingBatchApproval *PendingBatchApproval) error
+	Save(ctx context.Context, pendingBatchApproval *PendingBatchApproval) error
+	Delete(ctx context.Context, pendingBatchApproval *PendingBatchApproval) error
+	Has(ctx context.Context, pending_batch_id uint64, approver []byte) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, pending_batch_id uint64, approver []byte) (*PendingBatchApproval, error)
+	List(ctx context.Context, prefixKey PendingBatchApprovalIndexKey, opts ...ormlist.Option) (PendingBatchApprovalIterator, error)
+	ListRange(ctx context.Context, from, to PendingBatchApprovalIndexKey, opts ...ormlist.Option) (PendingBatchApprovalIterator, error)
+	DeleteBy(ctx context.Context, prefixKey PendingBatchApprovalIndexKey) error
+	DeleteRange(ctx context.Context, from, to PendingBatchApprovalIndexKey) error
+
+	doNotImplement()
+}
+
+type PendingBatchApprovalIterator struct {
+	ormtable.Iterator
+}
+
+func (i PendingBatchApprovalIterator) Value() (*PendingBatchApproval, error) {
+	var pendingBatchApproval PendingBatchApproval
+	err := i.UnmarshalMessage(&pendingBatchApproval)
+	return &pendingBatchApproval, err
+}
+
+type PendingBatchApprovalIndexKey interface {
+	id() uint32
+	values() []interface{}
+	pendingBatchApprovalIndexKey()
+}
+
+// primary key starting index..
+type PendingBatchApprovalPrimaryKey = PendingBatchApprovalPendingBatchIdApproverIndexKey
+
+type PendingBatchApprovalPendingBatchIdApproverIndexKey struct {
+	vs []interface{}
+}
+
+func (x PendingBatchApprovalPendingBatchIdApproverIndexKey) id() uint32                    { return 0 }
+func (x PendingBatchApprovalPendingBatchIdApproverIndexKey) values() []interface{}         { return x.vs }
+func (x PendingBatchApprovalPendingBatchIdApproverIndexKey) pendingBatchApprovalIndexKey() {}
+
+func (this PendingBatchApprovalPendingBatchIdApproverIndexKey) WithPendingBatchId(pending_batch_id uint64) PendingBatchApprovalPendingBatchIdApproverIndexKey {
+	this.vs = []interface{}{pending_batch_id}
+	return this
+}
+
+func (this PendingBatchApprovalPendingBatchIdApproverIndexKey) WithPendingBatchIdApprover(pending_batch_id uint64, approver []byte) PendingBatchApprovalPendingBatchIdApproverIndexKey {
+	this.vs = []interface{}{pending_batch_id, approver}
+	return this
+}
+
+type pendingBatchApprovalTable struct {
+	table ormtable.Table
+}
+
+func (this pendingBatchApprovalTable) Insert(ctx context.Context, pendingBatchApproval *PendingBatchApproval) error {
+	return this.table.Insert(ctx, pendingBatchApproval)
+}
+
+func (this pendingBatchApprovalTable) Update(ctx context.Context, pendingBatchApproval *PendingBatchApproval) error {
+	return this.table.Update(ctx, pendingBatchApproval)
+}
+
+func (this pendingBatchApprovalTable) Save(ctx context.Context, pendingBatchApproval *PendingBatchApproval) error {
+	return this.table.Save(ctx, pendingBatchApproval)
+}
+
+func (this pendingBatchApprovalTable) Delete(ctx context.Context, pendingBatchApproval *PendingBatchApproval) error {
+	return this.table.Delete(ctx, pendingBatchApproval)
+}
+
+func (this pendingBatchApprovalTable) Has(ctx context.Context, pending_batch_id uint64, approver []byte) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, pending_batch_id, approver)
+}
+
+func (this pendingBatchApprovalTable) Get(ctx context.Context, pending_batch_id uint64, approver []byte) (*PendingBatchApproval, error) {
+	var pendingBatchApproval PendingBatchApproval
+	found, err := this.table.PrimaryKey().Get(ctx, &pendingBatchApproval, pending_batch_id, approver)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &pendingBatchApproval, nil
+}
+
+func (this pendingBatchApprovalTable) List(ctx context.Context, prefixKey PendingBatchApprovalIndexKey, opts ...ormlist.Option) (PendingBatchApprovalIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return PendingBatchApprovalIterator{it}, err
+}
+
+func (this pendingBatchApprovalTable) ListRange(ctx context.Context, from, to PendingBatchApprovalIndexKey, opts ...ormlist.Option) (PendingBatchApprovalIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return PendingBatchApprovalIterator{it}, err
+}
+
+func (this pendingBatchApprovalTable) DeleteBy(ctx context.Context, prefixKey PendingBatchApprovalIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this pendingBatchApprovalTable) DeleteRange(ctx context.Context, from, to PendingBatchApprovalIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this pendingBatchApprovalTable) doNotImplement() {}
+
+var _ PendingBatchApprovalTable = pendingBatchApprovalTable{}
+
+func NewPendingBatchApprovalTable(db ormtable.Schema) (PendingBatchApprovalTable, error) {
+	table := db.GetTable(&PendingBatchApproval{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&PendingBatchApproval{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return pendingBatchApprovalTable{table}, nil
+}
+
+type CreditAllowanceTable interface {
+	Insert(ctx context.Context, creditAllowance *CreditAllowance) error
+	Update(ctx context.Context, creditAllowance *CreditAllowance) error
+	Save(ctx context.Context, creditAllowance *CreditAllowance) error
+	Delete(ctx context.Context, creditAllowance *CreditAllowance) error
+	Has(ctx context.Context, owner []byte, spender []byte, batch_key uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, owner []byte, spender []byte, batch_key uint64) (*CreditAllowance, error)
+	List(ctx context.Context, prefixKey CreditAllowanceIndexKey, opts ...ormlist.Option) (CreditAllowanceIterator, error)
+	ListRange(ctx context.Context, from, to CreditAllowanceIndexKey, opts ...ormlist.Option) (CreditAllowanceIterator, error)
+	DeleteBy(ctx context.Context, prefixKey CreditAllowanceIndexKey) error
+	DeleteRange(ctx context.Context, from, to CreditAllowanceIndexKey) error
+
+	doNotImplement()
+}
+
+type CreditAllowanceIterator struct {
+	ormtable.Iterator
+}
+
+func (i CreditAllowanceIterator) Value() (*CreditAllowance, error) {
+	var creditAllowance CreditAllowance
+	err := i.UnmarshalMessage(&creditAllowance)
+	return &creditAllowance, err
+}
+
+type CreditAllowanceIndexKey interface {
+	id() uint32
+	values() []interface{}
+	creditAllowanceIndexKey()
+}
+
+// primary key starting index..
+type CreditAllowancePrimaryKey = CreditAllowanceOwnerSpenderBatchKeyIndexKey
+
+type CreditAllowanceOwnerSpenderBatchKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x CreditAllowanceOwnerSpenderBatchKeyIndexKey) id() uint32               { return 0 }
+func (x CreditAllowanceOwnerSpenderBatchKeyIndexKey) values() []interface{}    { return x.vs }
+func (x CreditAllowanceOwnerSpenderBatchKeyIndexKey) creditAllowanceIndexKey() {}
+
+func (this CreditAllowanceOwnerSpenderBatchKeyIndexKey) WithOwner(owner []byte) CreditAllowanceOwnerSpenderBatchKeyIndexKey {
+	this.vs = []interface{}{owner}
+	return this
+}
+
+func (this CreditAllowanceOwnerSpenderBatchKeyIndexKey) WithOwnerSpender(owner []byte, spender []byte) CreditAllowanceOwnerSpenderBatchKeyIndexKey {
+	this.vs = []interface{}{owner, spender}
+	return this
+}
+
+func (this CreditAllowanceOwnerSpenderBatchKeyIndexKey) WithOwnerSpenderBatchKey(owner []byte, spender []byte, batch_key uint64) CreditAllowanceOwnerSpenderBatchKeyIndexKey {
+	this.vs = []interface{}{owner, spender, batch_key}
+	return this
+}
+
+type CreditAllowanceSpenderIndexKey struct {
+	vs []interface{}
+}
+
+func (x CreditAllowanceSpenderIndexKey) id() uint32               { return 1 }
+func (x CreditAllowanceSpenderIndexKey) values() []interface{}    { return x.vs }
+func (x CreditAllowanceSpenderIndexKey) creditAllowanceIndexKey() {}
+
+func (this CreditAllowanceSpenderIndexKey) WithSpender(spender []byte) CreditAllowanceSpenderIndexKey {
+	this.vs = []interface{}{spender}
+	return this
+}
+
+type creditAllowanceTable struct {
+	table ormtable.Table
+}
+
+func (this creditAllowanceTable) Insert(ctx context.Context, creditAllowance *CreditAllowance) error {
+	return this.table.Insert(ctx, creditAllowance)
+}
+
+func (this creditAllowanceTable) Update(ctx context.Context, creditAllowance *CreditAllowance) error {
+	return this.table.Update(ctx, creditAllowance)
+}
+
+func (this creditAllowanceTable) Save(ctx context.Context, creditAllowance *CreditAllowance) error {
+	return this.table.Save(ctx, creditAllowance)
+}
+
+func (this creditAllowanceTable) Delete(ctx context.Context, creditAllowance *CreditAllowance) error {
+	return this.table.Delete(ctx, creditAllowance)
+}
+
+func (this creditAllowanceTable) Has(ctx context.Context, owner []byte, spender []byte, batch_key uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, owner, spender, batch_key)
+}
+
+func (this creditAllowanceTable) Get(ctx context.Context, owner []byte, spender []byte, batch_key uint64) (*CreditAllowance, error) {
+	var creditAllowance CreditAllowance
+	found, err := this.table.PrimaryKey().Get(ctx, &creditAllowance, owner, spender, batch_key)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &creditAllowance, nil
+}
+
+func (this creditAllowanceTable) List(ctx context.Context, prefixKey CreditAllowanceIndexKey, opts ...ormlist.Option) (CreditAllowanceIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return CreditAllowanceIterator{it}, err
+}
+
+func (this creditAllowanceTable) ListRange(ctx context.Context, from, to CreditAllowanceIndexKey, opts ...ormlist.Option) (CreditAllowanceIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return CreditAllowanceIterator{it}, err
+}
+
+func (this creditAllowanceTable) DeleteBy(ctx context.Context, prefixKey CreditAllowanceIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this creditAllowanceTable) DeleteRange(ctx context.Context, from, to CreditAllowanceIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this creditAllowanceTable) doNotImplement() {}
+
+var _ CreditAllowanceTable = creditAllowanceTable{}
+
+func NewCreditAllowanceTable(db ormtable.Schema) (CreditAllowanceTable, error) {
+	table := db.GetTable(&CreditAllowance{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&CreditAllowance{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return creditAllowanceTable{table}, nil
+}
+
 type StateStore interface {
 	CreditTypeTable() CreditTypeTable
 	ClassTable() ClassTable
@@ -2075,6 +2740,11 @@ type StateStore interface {
 	AllowedClassCreatorTable() AllowedClassCreatorTable
 	ClassFeeTable() ClassFeeTable
 	AllowedBridgeChainTable() AllowedBridgeChainTable
+	RetirementTable() RetirementTable
+	ClassIssuerQuotaTable() ClassIssuerQuotaTable
+	PendingBatchTable() PendingBatchTable
+	PendingBatchApprovalTable() PendingBatchApprovalTable
+	CreditAllowanceTable() CreditAllowanceTable
 
 	doNotImplement()
 }
@@ -2096,6 +2766,11 @@ type stateStore struct {
 	allowedClassCreator   AllowedClassCreatorTable
 	classFee              ClassFeeTable
 	allowedBridgeChain    AllowedBridgeChainTable
+	retirement            RetirementTable
+	classIssuerQuota      ClassIssuerQuotaTable
+	pendingBatch          PendingBatchTable
+	pendingBatchApproval  PendingBatchApprovalTable
+	creditAllowance       CreditAllowanceTable
 }
 
 func (x stateStore) CreditTypeTable() CreditTypeTable {
@@ -2162,6 +2837,26 @@ func (x stateStore) AllowedBridgeChainTable() AllowedBridgeChainTable {
 	return x.allowedBridgeChain
 }
 
+func (x stateStore) RetirementTable() RetirementTable {
+	return x.retirement
+}
+
+func (x stateStore) ClassIssuerQuotaTable() ClassIssuerQuotaTable {
+	return x.classIssuerQuota
+}
+
+func (x stateStore) PendingBatchTable() PendingBatchTable {
+	return x.pendingBatch
+}
+
+func (x stateStore) PendingBatchApprovalTable() PendingBatchApprovalTable {
+	return x.pendingBatchApproval
+}
+
+func (x stateStore) CreditAllowanceTable() CreditAllowanceTable {
+	return x.creditAllowance
+}
+
 func (stateStore) doNotImplement() {}
 
 var _ StateStore = stateStore{}
@@ -2247,6 +2942,31 @@ func NewStateStore(db ormtable.Schema) (StateStore, error) {
 		return nil, err
 	}
 
+	retirementTable, err := NewRetirementTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	classIssuerQuotaTable, err := NewClassIssuerQuotaTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	pendingBatchTable, err := NewPendingBatchTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	pendingBatchApprovalTable, err := NewPendingBatchApprovalTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	creditAllowanceTable, err := NewCreditAllowanceTable(db)
+	if err != nil {
+		return nil, err
+	}
+
 	return stateStore{
 		creditTypeTable,
 		classTable,
@@ -2264,5 +2984,10 @@ func NewStateStore(db ormtable.Schema) (StateStore, error) {
 		allowedClassCreatorTable,
 		classFeeTable,
 		allowedBridgeChainTable,
+		retirementTable,
+		classIssuerQuotaTable,
+		pendingBatchTable,
+		pendingBatchApprovalTable,
+		creditAllowanceTable,
 	}, nil
 }
@@ -139,6 +139,97 @@ type MsgClient interface {
 	//
 	// Since Revision 1
 	RemoveAllowedBridgeChain(ctx context.Context, in *MsgRemoveAllowedBridgeChain, opts ...grpc.CallOption) (*MsgRemoveAllowedBridgeChainResponse, error)
+	// SuspendBatch suspends a credit batch. Credits from a suspended credit
+	// batch cannot be sent, retired, bridged, put into a basket or sold until
+	// the credit batch is unsuspended. Only the admin of the credit class or
+	// the governance account can suspend a credit batch.
+	//
+	// Since Revision 1
+	SuspendBatch(ctx context.Context, in *MsgSuspendBatch, opts ...grpc.CallOption) (*MsgSuspendBatchResponse, error)
+	// UnsuspendBatch unsuspends a suspended credit batch. An invalidated credit
+	// batch cannot be unsuspended. Only the admin of the credit class or the
+	// governance account can unsuspend a credit batch.
+	//
+	// Since Revision 1
+	UnsuspendBatch(ctx context.Context, in *MsgUnsuspendBatch, opts ...grpc.CallOption) (*MsgUnsuspendBatchResponse, error)
+	// InvalidateBatch invalidates a credit batch. The credit batch is sealed and
+	// permanently suspended, and all tradable credits held by accounts are
+	// cancelled. Retired credits, escrowed credits and credits held by baskets
+	// are not cancelled. Only the admin of the credit class or the governance
+	// account can invalidate a credit batch.
+	//
+	// Since Revision 1
+	InvalidateBatch(ctx context.Context, in *MsgInvalidateBatch, opts ...grpc.CallOption) (*MsgInvalidateBatchResponse, error)
+	// UpdateProjectStatus updates the lifecycle status of a project. Only an
+	// issuer of the credit class of the project can update the status of the
+	// project. The status update can reference data anchored in the data module
+	// with evidence supporting the new status (e.g. a verification report).
+	//
+	// Since Revision 1
+	UpdateProjectStatus(ctx context.Context, in *MsgUpdateProjectStatus, opts ...grpc.CallOption) (*MsgUpdateProjectStatusResponse, error)
+	// SetClassIssuerQuota sets or removes the quota of an issuer of a credit
+	// class. The quota caps the amount of credits the issuer can issue in total
+	// or within each period of time. Only the admin of the credit class can set
+	// the quota of an issuer.
+	//
+	// Since Revision 1
+	SetClassIssuerQuota(ctx context.Context, in *MsgSetClassIssuerQuota, opts ...grpc.CallOption) (*MsgSetClassIssuerQuotaResponse, error)
+	// UpdateClassBuffer sets or removes the buffer percentage of a credit class.
+	// The buffer percentage of each issuance of credits is withheld as tradable
+	// credits in the buffer account of the credit class, an account derived from
+	// the credit class id that no user controls. Only the admin of the credit
+	// class can update the buffer of the credit class.
+	//
+	// Since Revision 1
+	UpdateClassBuffer(ctx context.Context, in *MsgUpdateClassBuffer, opts ...grpc.CallOption) (*MsgUpdateClassBufferResponse, error)
+	// CancelBufferCredits cancels credits held in the buffer account of a credit
+	// class to cover reversals. Only the admin of the credit class can cancel
+	// buffer credits.
+	//
+	// Since Revision 1
+	CancelBufferCredits(ctx context.Context, in *MsgCancelBufferCredits, opts ...grpc.CallOption) (*MsgCancelBufferCreditsResponse, error)
+	// UpdateClassIssuanceThreshold updates the number of approvals from issuers
+	// of a credit class required to create a proposed credit batch. Only the
+	// admin of the credit class can update the issuance threshold.
+	//
+	// Since Revision 1
+	UpdateClassIssuanceThreshold(ctx context.Context, in *MsgUpdateClassIssuanceThreshold, opts ...grpc.CallOption) (*MsgUpdateClassIssuanceThresholdResponse, error)
+	// UpdateClassRequireVerifiedProject updates whether or not the project of a
+	// credit batch must be verified for credits to be issued in a credit class.
+	// Only the admin of the credit class can update the requirement.
+	//
+	// Since Revision 1
+	UpdateClassRequireVerifiedProject(ctx context.Context, in *MsgUpdateClassRequireVerifiedProject, opts ...grpc.CallOption) (*MsgUpdateClassRequireVerifiedProjectResponse, error)
+	// ProposeBatch proposes a credit batch that is created once the issuance
+	// threshold of the credit class is met. The approval of the proposer counts
+	// towards the issuance threshold and the credit batch is created immediately
+	// if the issuance threshold is met by the approval of the proposer.
+	//
+	// Since Revision 1
+	ProposeBatch(ctx context.Context, in *MsgProposeBatch, opts ...grpc.CallOption) (*MsgProposeBatchResponse, error)
+	// ApproveBatch approves a pending batch. The credit batch is created when
+	// the approval meets the issuance threshold of the credit class. Only issuers
+	// of the credit class can approve a pending batch.
+	//
+	// Since Revision 1
+	ApproveBatch(ctx context.Context, in *MsgApproveBatch, opts ...grpc.CallOption) (*MsgApproveBatchResponse, error)
+	// ApproveCredits sets the amount of credits from a credit batch that a
+	// spender is allowed to send or retire on behalf of the owner. An existing
+	// credit allowance of the spender is replaced.
+	//
+	// Since Revision 1
+	ApproveCredits(ctx context.Context, in *MsgApproveCredits, opts ...grpc.CallOption) (*MsgApproveCreditsResponse, error)
+	// RevokeCreditAllowance removes the credit allowance of a spender.
+	//
+	// Since Revision 1
+	RevokeCreditAllowance(ctx context.Context, in *MsgRevokeCreditAllowance, opts ...grpc.CallOption) (*MsgRevokeCreditAllowanceResponse, error)
+	// SendFrom sends credits on behalf of the owner of the credits using the
+	// credit allowances of the spender. SendFrom also retires credits if the
+	// amount to retire is specified in the request. Both tradable and retired
+	// amounts are deducted from the credit allowance.
+	//
+	// Since Revision 1
+	SendFrom(ctx context.Context, in *MsgSendFrom, opts ...grpc.CallOption) (*MsgSendFromResponse, error)
 }
 
 type msgClient struct {
@@ -347,6 +438,132 @@ func (c *msgClient) RemoveAllowedBridgeChain(ctx context.Context, in *MsgRemoveA
 	return out, nil
 }
 
+func (c *msgClient) SuspendBatch(ctx context.Context, in *MsgSuspendBatch, opts ...grpc.CallOption) (*MsgSuspendBatchResponse, error) {
+	out := new(MsgSuspendBatchResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/SuspendBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnsuspendBatch(ctx context.Context, in *MsgUnsuspendBatch, opts ...grpc.CallOption) (*MsgUnsuspendBatchResponse, error) {
+	out := new(MsgUnsuspendBatchResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/UnsuspendBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) InvalidateBatch(ctx context.Context, in *MsgInvalidateBatch, opts ...grpc.CallOption) (*MsgInvalidateBatchResponse, error) {
+	out := new(MsgInvalidateBatchResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/InvalidateBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateProjectStatus(ctx context.Context, in *MsgUpdateProjectStatus, opts ...grpc.CallOption) (*MsgUpdateProjectStatusResponse, error) {
+	out := new(MsgUpdateProjectStatusResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/UpdateProjectStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetClassIssuerQuota(ctx context.Context, in *MsgSetClassIssuerQuota, opts ...grpc.CallOption) (*MsgSetClassIssuerQuotaResponse, error) {
+	out := new(MsgSetClassIssuerQuotaResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/SetClassIssuerQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateClassBuffer(ctx context.Context, in *MsgUpdateClassBuffer, opts ...grpc.CallOption) (*MsgUpdateClassBufferResponse, error) {
+	out := new(MsgUpdateClassBufferResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/UpdateClassBuffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelBufferCredits(ctx context.Context, in *MsgCancelBufferCredits, opts ...grpc.CallOption) (*MsgCancelBufferCreditsResponse, error) {
+	out := new(MsgCancelBufferCreditsResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/CancelBufferCredits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateClassIssuanceThreshold(ctx context.Context, in *MsgUpdateClassIssuanceThreshold, opts ...grpc.CallOption) (*MsgUpdateClassIssuanceThresholdResponse, error) {
+	out := new(MsgUpdateClassIssuanceThresholdResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/UpdateClassIssuanceThreshold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateClassRequireVerifiedProject(ctx context.Context, in *MsgUpdateClassRequireVerifiedProject, opts ...grpc.CallOption) (*MsgUpdateClassRequireVerifiedProjectResponse, error) {
+	out := new(MsgUpdateClassRequireVerifiedProjectResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/UpdateClassRequireVerifiedProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProposeBatch(ctx context.Context, in *MsgProposeBatch, opts ...grpc.CallOption) (*MsgProposeBatchResponse, error) {
+	out := new(MsgProposeBatchResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/ProposeBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveBatch(ctx context.Context, in *MsgApproveBatch, opts ...grpc.CallOption) (*MsgApproveBatchResponse, error) {
+	out := new(MsgApproveBatchResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/ApproveBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveCredits(ctx context.Context, in *MsgApproveCredits, opts ...grpc.CallOption) (*MsgApproveCreditsResponse, error) {
+	out := new(MsgApproveCreditsResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/ApproveCredits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeCreditAllowance(ctx context.Context, in *MsgRevokeCreditAllowance, opts ...grpc.CallOption) (*MsgRevokeCreditAllowanceResponse, error) {
+	out := new(MsgRevokeCreditAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/RevokeCreditAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SendFrom(ctx context.Context, in *MsgSendFrom, opts ...grpc.CallOption) (*MsgSendFromResponse, error) {
+	out := new(MsgSendFromResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/SendFrom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -468,6 +685,97 @@ type MsgServer interface {
 	//
 	// Since Revision 1
 	RemoveAllowedBridgeChain(context.Context, *MsgRemoveAllowedBridgeChain) (*MsgRemoveAllowedBridgeChainResponse, error)
+	// SuspendBatch suspends a credit batch. Credits from a suspended credit
+	// batch cannot be sent, retired, bridged, put into a basket or sold until
+	// the credit batch is unsuspended. Only the admin of the credit class or
+	// the governance account can suspend a credit batch.
+	//
+	// Since Revision 1
+	SuspendBatch(context.Context, *MsgSuspendBatch) (*MsgSuspendBatchResponse, error)
+	// UnsuspendBatch unsuspends a suspended credit batch. An invalidated credit
+	// batch cannot be unsuspended. Only the admin of the credit class or the
+	// governance account can unsuspend a credit batch.
+	//
+	// Since Revision 1
+	UnsuspendBatch(context.Context, *MsgUnsuspendBatch) (*MsgUnsuspendBatchResponse, error)
+	// InvalidateBatch invalidates a credit batch. The credit batch is sealed and
+	// permanently suspended, and all tradable credits held by accounts are
+	// cancelled. Retired credits, escrowed credits and credits held by baskets
+	// are not cancelled. Only the admin of the credit class or the governance
+	// account can invalidate a credit batch.
+	//
+	// Since Revision 1
+	InvalidateBatch(context.Context, *MsgInvalidateBatch) (*MsgInvalidateBatchResponse, error)
+	// UpdateProjectStatus updates the lifecycle status of a project. Only an
+	// issuer of the credit class of the project can update the status of the
+	// project. The status update can reference data anchored in the data module
+	// with evidence supporting the new status (e.g. a verification report).
+	//
+	// Since Revision 1
+	UpdateProjectStatus(context.Context, *MsgUpdateProjectStatus) (*MsgUpdateProjectStatusResponse, error)
+	// SetClassIssuerQuota sets or removes the quota of an issuer of a credit
+	// class. The quota caps the amount of credits the issuer can issue in total
+	// or within each period of time. Only the admin of the credit class can set
+	// the quota of an issuer.
+	//
+	// Since Revision 1
+	SetClassIssuerQuota(context.Context, *MsgSetClassIssuerQuota) (*MsgSetClassIssuerQuotaResponse, error)
+	// UpdateClassBuffer sets or removes the buffer percentage of a credit class.
+	// The buffer percentage of each issuance of credits is withheld as tradable
+	// credits in the buffer account of the credit class, an account derived from
+	// the credit class id that no user controls. Only the admin of the credit
+	// class can update the buffer of the credit class.
+	//
+	// Since Revision 1
+	UpdateClassBuffer(context.Context, *MsgUpdateClassBuffer) (*MsgUpdateClassBufferResponse, error)
+	// CancelBufferCredits cancels credits held in the buffer account of a credit
+	// class to cover reversals. Only the admin of the credit class can cancel
+	// buffer credits.
+	//
+	// Since Revision 1
+	CancelBufferCredits(context.Context, *MsgCancelBufferCredits) (*MsgCancelBufferCreditsResponse, error)
+	// UpdateClassIssuanceThreshold updates the number of approvals from issuers
+	// of a credit class required to create a proposed credit batch. Only the
+	// admin of the credit class can update the issuance threshold.
+	//
+	// Since Revision 1
+	UpdateClassIssuanceThreshold(context.Context, *MsgUpdateClassIssuanceThreshold) (*MsgUpdateClassIssuanceThresholdResponse, error)
+	// UpdateClassRequireVerifiedProject updates whether or not the project of a
+	// credit batch must be verified for credits to be issued in a credit class.
+	// Only the admin of the credit class can update the requirement.
+	//
+	// Since Revision 1
+	UpdateClassRequireVerifiedProject(context.Context, *MsgUpdateClassRequireVerifiedProject) (*MsgUpdateClassRequireVerifiedProjectResponse, error)
+	// ProposeBatch proposes a credit batch that is created once the issuance
+	// threshold of the credit class is met. The approval of the proposer counts
+	// towards the issuance threshold and the credit batch is created immediately
+	// if the issuance threshold is met by the approval of the proposer.
+	//
+	// Since Revision 1
+	ProposeBatch(context.Context, *MsgProposeBatch) (*MsgProposeBatchResponse, error)
+	// ApproveBatch approves a pending batch. The credit batch is created when
+	// the approval meets the issuance threshold of the credit class. Only issuers
+	// of the credit class can approve a pending batch.
+	//
+	// Since Revision 1
+	ApproveBatch(context.Context, *MsgApproveBatch) (*MsgApproveBatchResponse, error)
+	// ApproveCredits sets the amount of credits from a credit batch that a
+	// spender is allowed to send or retire on behalf of the owner. An existing
+	// credit allowance of the spender is replaced.
+	//
+	// Since Revision 1
+	ApproveCredits(context.Context, *MsgApproveCredits) (*MsgApproveCreditsResponse, error)
+	// RevokeCreditAllowance removes the credit allowance of a spender.
+	//
+	// Since Revision 1
+	RevokeCreditAllowance(context.Context, *MsgRevokeCreditAllowance) (*MsgRevokeCreditAllowanceResponse, error)
+	// SendFrom sends credits on behalf of the owner of the credits using the
+	// credit allowances of the spender. SendFrom also retires credits if the
+	// amount to retire is specified in the request. Both tradable and retired
+	// amounts are deducted from the credit allowance.
+	//
+	// Since Revision 1
+	SendFrom(context.Context, *MsgSendFrom) (*MsgSendFromResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -541,6 +849,48 @@ func (UnimplementedMsgServer) AddAllowedBridgeChain(context.Context, *MsgAddAllo
 func (UnimplementedMsgServer) RemoveAllowedBridgeChain(context.Context, *MsgRemoveAllowedBridgeChain) (*MsgRemoveAllowedBridgeChainResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveAllowedBridgeChain not implemented")
 }
+func (UnimplementedMsgServer) SuspendBatch(context.Context, *MsgSuspendBatch) (*MsgSuspendBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendBatch not implemented")
+}
+func (UnimplementedMsgServer) UnsuspendBatch(context.Context, *MsgUnsuspendBatch) (*MsgUnsuspendBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsuspendBatch not implemented")
+}
+func (UnimplementedMsgServer) InvalidateBatch(context.Context, *MsgInvalidateBatch) (*MsgInvalidateBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvalidateBatch not implemented")
+}
+func (UnimplementedMsgServer) UpdateProjectStatus(context.Context, *MsgUpdateProjectStatus) (*MsgUpdateProjectStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProjectStatus not implemented")
+}
+func (UnimplementedMsgServer) SetClassIssuerQuota(context.Context, *MsgSetClassIssuerQuota) (*MsgSetClassIssuerQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetClassIssuerQuota not implemented")
+}
+func (UnimplementedMsgServer) UpdateClassBuffer(context.Context, *MsgUpdateClassBuffer) (*MsgUpdateClassBufferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClassBuffer not implemented")
+}
+func (UnimplementedMsgServer) CancelBufferCredits(context.Context, *MsgCancelBufferCredits) (*MsgCancelBufferCreditsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBufferCredits not implemented")
+}
+func (UnimplementedMsgServer) UpdateClassIssuanceThreshold(context.Context, *MsgUpdateClassIssuanceThreshold) (*MsgUpdateClassIssuanceThresholdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClassIssuanceThreshold not implemented")
+}
+func (UnimplementedMsgServer) UpdateClassRequireVerifiedProject(context.Context, *MsgUpdateClassRequireVerifiedProject) (*MsgUpdateClassRequireVerifiedProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClassRequireVerifiedProject not implemented")
+}
+func (UnimplementedMsgServer) ProposeBatch(context.Context, *MsgProposeBatch) (*MsgProposeBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeBatch not implemented")
+}
+func (UnimplementedMsgServer) ApproveBatch(context.Context, *MsgApproveBatch) (*MsgApproveBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveBatch not implemented")
+}
+func (UnimplementedMsgServer) ApproveCredits(context.Context, *MsgApproveCredits) (*MsgApproveCreditsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveCredits not implemented")
+}
+func (UnimplementedMsgServer) RevokeCreditAllowance(context.Context, *MsgRevokeCreditAllowance) (*MsgRevokeCreditAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCreditAllowance not implemented")
+}
+func (UnimplementedMsgServer) SendFrom(context.Context, *MsgSendFrom) (*MsgSendFromResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendFrom not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -950,6 +1300,258 @@ func _Msg_RemoveAllowedBridgeChain_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SuspendBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSuspendBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SuspendBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/SuspendBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SuspendBatch(ctx, req.(*MsgSuspendBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnsuspendBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnsuspendBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnsuspendBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/UnsuspendBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnsuspendBatch(ctx, req.(*MsgUnsuspendBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_InvalidateBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInvalidateBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InvalidateBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/InvalidateBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InvalidateBatch(ctx, req.(*MsgInvalidateBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateProjectStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateProjectStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateProjectStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/UpdateProjectStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateProjectStatus(ctx, req.(*MsgUpdateProjectStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetClassIssuerQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetClassIssuerQuota)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetClassIssuerQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/SetClassIssuerQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetClassIssuerQuota(ctx, req.(*MsgSetClassIssuerQuota))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateClassBuffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateClassBuffer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateClassBuffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/UpdateClassBuffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateClassBuffer(ctx, req.(*MsgUpdateClassBuffer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelBufferCredits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelBufferCredits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelBufferCredits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/CancelBufferCredits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelBufferCredits(ctx, req.(*MsgCancelBufferCredits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateClassIssuanceThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateClassIssuanceThreshold)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateClassIssuanceThreshold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/UpdateClassIssuanceThreshold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateClassIssuanceThreshold(ctx, req.(*MsgUpdateClassIssuanceThreshold))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateClassRequireVerifiedProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateClassRequireVerifiedProject)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateClassRequireVerifiedProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/UpdateClassRequireVerifiedProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateClassRequireVerifiedProject(ctx, req.(*MsgUpdateClassRequireVerifiedProject))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProposeBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/ProposeBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeBatch(ctx, req.(*MsgProposeBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/ApproveBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveBatch(ctx, req.(*MsgApproveBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveCredits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveCredits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveCredits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/ApproveCredits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveCredits(ctx, req.(*MsgApproveCredits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeCreditAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeCreditAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeCreditAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/RevokeCreditAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeCreditAllowance(ctx, req.(*MsgRevokeCreditAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendFrom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendFrom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendFrom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/SendFrom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendFrom(ctx, req.(*MsgSendFrom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1045,6 +1647,62 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveAllowedBridgeChain",
 			Handler:    _Msg_RemoveAllowedBridgeChain_Handler,
 		},
+		{
+			MethodName: "SuspendBatch",
+			Handler:    _Msg_SuspendBatch_Handler,
+		},
+		{
+			MethodName: "UnsuspendBatch",
+			Handler:    _Msg_UnsuspendBatch_Handler,
+		},
+		{
+			MethodName: "InvalidateBatch",
+			Handler:    _Msg_InvalidateBatch_Handler,
+		},
+		{
+			MethodName: "UpdateProjectStatus",
+			Handler:    _Msg_UpdateProjectStatus_Handler,
+		},
+		{
+			MethodName: "SetClassIssuerQuota",
+			Handler:    _Msg_SetClassIssuerQuota_Handler,
+		},
+		{
+			MethodName: "UpdateClassBuffer",
+			Handler:    _Msg_UpdateClassBuffer_Handler,
+		},
+		{
+			MethodName: "CancelBufferCredits",
+			Handler:    _Msg_CancelBufferCredits_Handler,
+		},
+		{
+			MethodName: "UpdateClassIssuanceThreshold",
+			Handler:    _Msg_UpdateClassIssuanceThreshold_Handler,
+		},
+		{
+			MethodName: "UpdateClassRequireVerifiedProject",
+			Handler:    _Msg_UpdateClassRequireVerifiedProject_Handler,
+		},
+		{
+			MethodName: "ProposeBatch",
+			Handler:    _Msg_ProposeBatch_Handler,
+		},
+		{
+			MethodName: "ApproveBatch",
+			Handler:    _Msg_ApproveBatch_Handler,
+		},
+		{
+			MethodName: "ApproveCredits",
+			Handler:    _Msg_ApproveCredits_Handler,
+		},
+		{
+			MethodName: "RevokeCreditAllowance",
+			Handler:    _Msg_RevokeCreditAllowance_Handler,
+		},
+		{
+			MethodName: "SendFrom",
+			Handler:    _Msg_SendFrom_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "regen/ecocredit/v1/tx.proto",
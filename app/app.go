@@ -112,6 +112,7 @@ import (
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket"
 	ecocreditmodule "github.com/regen-network/regen-ledger/x/ecocredit/module"
+	"github.com/regen-network/regen-ledger/x/ecocredit/orderbook"
 
 	// unnamed import of statik for swagger UI support
 	_ "github.com/regen-network/regen-ledger/v4/app/client/docs/statik"
@@ -223,6 +224,9 @@ type RegenApp struct {
 	AuthzKeeper      authzkeeper.Keeper
 	GroupKeeper      groupkeeper.Keeper
 
+	// the ecocredit keeper used by modules that hold, move or retire credits
+	CreditKeeper ecocredit.CreditKeeper
+
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
 	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
@@ -266,7 +270,7 @@ func NewRegenApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 	)
 
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
-	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
+	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey, orderbook.MemStoreKey)
 
 	var app = &RegenApp{
 		BaseApp:           bApp,
@@ -405,12 +409,20 @@ func NewRegenApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 	)
 	ecocreditMod := ecocreditmodule.NewModule(
 		app.keys[ecocredit.ModuleName],
+		app.memKeys[orderbook.MemStoreKey],
 		authtypes.NewModuleAddress(govtypes.ModuleName),
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.GetSubspace(ecocredit.DefaultParamspace),
 		app.GovKeeper,
+		app.DistrKeeper,
+		app.TransferKeeper,
+		app.IBCKeeper.ChannelKeeper,
 	)
+	// register the ecocredit hooks of modules that react to the movement of
+	// credits, such as retirement certificates or reward programs
+	ecocreditMod.SetHooks(ecocredit.NewMultiEcocreditHooks())
+	app.CreditKeeper = ecocreditMod.CreditKeeper()
 
 	skipGenesisInvariants := cast.ToBool(appOpts.Get(crisis.FlagSkipGenesisInvariants))
 
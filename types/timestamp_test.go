@@ -43,6 +43,36 @@ func TestGogoToProtobufDuration(t *testing.T) {
 	}
 }
 
+func TestProtobufToGogoDuration(t *testing.T) {
+	type args struct {
+		d *durationpb.Duration
+	}
+	tests := []struct {
+		name string
+		args args
+		want *gogotypes.Duration
+	}{
+		{
+			name: "valid",
+			args: args{d: &durationpb.Duration{Seconds: 45, Nanos: 4}},
+			want: &gogotypes.Duration{Seconds: 45, Nanos: 4},
+		},
+		{
+			name: "nil case",
+			args: args{d: nil},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		args, want := tt.args, tt.want
+		t.Run(tt.name, func(t *testing.T) {
+			if got := types.ProtobufToGogoDuration(args.d); !reflect.DeepEqual(got, want) {
+				t.Errorf("ProtobufToGogoDuration() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
 func TestGogoToProtobufTimestamp(t *testing.T) {
 	type args struct {
 		ts *gogotypes.Timestamp
@@ -1,6 +1,9 @@
 package client
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -495,6 +498,225 @@ regen q ecocredit projects-by-admin regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw
 	return qflags(cmd)
 }
 
+// QueryProjectsByStatusCmd returns a query command that retrieves projects by
+// lifecycle status.
+func QueryProjectsByStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projects-by-status [status]",
+		Short: "List projects by status",
+		Long: `List projects by lifecycle status with optional pagination flags.
+
+Parameters:
+
+- status:  the status of the projects (proposed, registered, verified, suspended or completed)`,
+		Example: `regen q ecocredit projects-by-status verified
+regen q ecocredit projects-by-status verified --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			status, err := parseProjectStatus(args[0])
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.ProjectsByStatus(cmd.Context(), &types.QueryProjectsByStatusRequest{
+				Status:     status,
+				Pagination: pagination,
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "projects-by-status")
+
+	return qflags(cmd)
+}
+
+// QueryClassIssuerQuotaCmd returns a query command that retrieves the quota of
+// an issuer of a credit class.
+func QueryClassIssuerQuotaCmd() *cobra.Command {
+	return qflags(&cobra.Command{
+		Use:     "class-issuer-quota [class-id] [issuer]",
+		Short:   "Retrieve the quota of an issuer of a credit class",
+		Long:    "Retrieve the quota of an issuer of a credit class, including the remaining amount of the current period.",
+		Example: "regen q ecocredit class-issuer-quota C01 regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := c.ClassIssuerQuota(cmd.Context(), &types.QueryClassIssuerQuotaRequest{
+				ClassId: args[0],
+				Issuer:  args[1],
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	})
+}
+
+// QueryClassIssuerQuotasCmd returns a query command that retrieves the quotas
+// of the issuers of a credit class.
+func QueryClassIssuerQuotasCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class-issuer-quotas [class-id]",
+		Short: "List the quotas of the issuers of a credit class",
+		Long:  "List the quotas of the issuers of a credit class with optional pagination flags.",
+		Example: `regen q ecocredit class-issuer-quotas C01
+regen q ecocredit class-issuer-quotas C01 --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.ClassIssuerQuotas(cmd.Context(), &types.QueryClassIssuerQuotasRequest{
+				ClassId:    args[0],
+				Pagination: pagination,
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "class-issuer-quotas")
+
+	return qflags(cmd)
+}
+
+// QueryPendingBatchCmd returns a query command that retrieves a pending batch.
+func QueryPendingBatchCmd() *cobra.Command {
+	return qflags(&cobra.Command{
+		Use:     "pending-batch [pending-batch-id]",
+		Short:   "Retrieve a pending batch",
+		Long:    "Retrieve a pending batch by id, including the issuers that approved the pending batch.",
+		Example: "regen q ecocredit pending-batch 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid pending batch id: %s", err)
+			}
+
+			res, err := c.PendingBatch(cmd.Context(), &types.QueryPendingBatchRequest{PendingBatchId: id})
+			return printQueryResponse(ctx, res, err)
+		},
+	})
+}
+
+// QueryPendingBatchesByClassCmd returns a query command that retrieves the
+// pending batches of a credit class.
+func QueryPendingBatchesByClassCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-batches-by-class [class-id]",
+		Short: "List the pending batches of a credit class",
+		Long:  "List the pending batches of a credit class with optional pagination flags.",
+		Example: `regen q ecocredit pending-batches-by-class C01
+regen q ecocredit pending-batches-by-class C01 --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.PendingBatchesByClass(cmd.Context(), &types.QueryPendingBatchesByClassRequest{
+				ClassId:    args[0],
+				Pagination: pagination,
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "pending-batches-by-class")
+
+	return qflags(cmd)
+}
+
+// QueryCreditAllowanceCmd returns a query command that retrieves the credit
+// allowance of a spender for a credit batch of an owner.
+func QueryCreditAllowanceCmd() *cobra.Command {
+	return qflags(&cobra.Command{
+		Use:     "credit-allowance [owner] [spender] [batch-denom]",
+		Short:   "Retrieve the credit allowance of a spender",
+		Long:    "Retrieve the credit allowance of a spender for a credit batch of an owner.",
+		Example: "regen q ecocredit credit-allowance regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf C01-001-20200101-20210101-001",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := c.CreditAllowance(cmd.Context(), &types.QueryCreditAllowanceRequest{
+				Owner:      args[0],
+				Spender:    args[1],
+				BatchDenom: args[2],
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	})
+}
+
+// QueryCreditAllowancesBySpenderCmd returns a query command that retrieves the
+// credit allowances of a spender.
+func QueryCreditAllowancesBySpenderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "credit-allowances-by-spender [spender]",
+		Short: "List the credit allowances of a spender",
+		Long:  "List the credit allowances of a spender with optional pagination flags.",
+		Example: `regen q ecocredit credit-allowances-by-spender regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw
+regen q ecocredit credit-allowances-by-spender regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.CreditAllowancesBySpender(cmd.Context(), &types.QueryCreditAllowancesBySpenderRequest{
+				Spender:    args[0],
+				Pagination: pagination,
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "credit-allowances-by-spender")
+
+	return qflags(cmd)
+}
+
 // QueryCreditTypeCmd returns a query command that retrieves credit type
 // information by abbreviation.
 func QueryCreditTypeCmd() *cobra.Command {
@@ -640,3 +862,101 @@ func QueryAllowedBridgeChains() *cobra.Command {
 	flags.AddPaginationFlagsToCmd(cmd, "allowed-bridge-chains")
 	return qflags(cmd)
 }
+
+// QueryRetirementCmd returns a query command that retrieves a retirement record.
+func QueryRetirementCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "retirement [retirement-id]",
+		Short:   "Retrieve retirement record",
+		Long:    "Retrieve the record of a credit retirement by its unique identifier.",
+		Example: "regen q ecocredit retirement 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid retirement id: %s", err)
+			}
+
+			res, err := c.Retirement(cmd.Context(), &types.QueryRetirementRequest{Id: id})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	return qflags(cmd)
+}
+
+// QueryRetirementsByOwnerCmd returns a query command that retrieves the
+// retirement records of an account.
+func QueryRetirementsByOwnerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retirements-by-owner [owner]",
+		Short: "List all retirement records by owner",
+		Long:  "List all retirement records by owner with optional pagination flags.",
+		Example: `regen q ecocredit retirements-by-owner regen1r9pl9gvr56kmclgkpjg3ynh4rm5am66f2a6y38
+regen q ecocredit retirements-by-owner regen1r9pl9gvr56kmclgkpjg3ynh4rm5am66f2a6y38 --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.RetirementsByOwner(cmd.Context(), &types.QueryRetirementsByOwnerRequest{
+				Owner:      args[0],
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "retirements-by-owner")
+
+	return qflags(cmd)
+}
+
+// QueryRetirementsByBatchCmd returns a query command that retrieves the
+// retirement records of a credit batch.
+func QueryRetirementsByBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retirements-by-batch [batch-denom]",
+		Short: "List all retirement records by credit batch",
+		Long:  "List all retirement records by credit batch with optional pagination flags.",
+		Example: `regen q ecocredit retirements-by-batch C01-001-20200101-20210101-001
+regen q ecocredit retirements-by-batch C01-001-20200101-20210101-001 --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.RetirementsByBatch(cmd.Context(), &types.QueryRetirementsByBatchRequest{
+				BatchDenom: args[0],
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "retirements-by-batch")
+
+	return qflags(cmd)
+}
@@ -6,13 +6,16 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
+	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/spf13/cobra"
 
 	sdkclient "github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 
 	regentypes "github.com/regen-network/regen-ledger/types"
 	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
@@ -24,6 +27,14 @@ const (
 	FlagReferenceID            string = "reference-id"
 	FlagRetirementJurisdiction string = "retirement-jurisdiction"
 	FlagClassFee               string = "class-fee"
+	FlagRetirementReason       string = "retirement-reason"
+	FlagRetirementBeneficiary  string = "retirement-beneficiary"
+	FlagReason                 string = "reason"
+	FlagBeneficiary            string = "beneficiary"
+	FlagIRI                    string = "iri"
+	FlagPeriod                 string = "period"
+	FlagExpiration             string = "expiration"
+	FlagAllowedRecipients      string = "allowed-recipients"
 )
 
 // TxCreateClassCmd returns a transaction command that creates a credit class.
@@ -309,6 +320,14 @@ Parameters:
 			if err != nil {
 				return err
 			}
+			reason, err := cmd.Flags().GetString(FlagRetirementReason)
+			if err != nil {
+				return err
+			}
+			beneficiary, err := cmd.Flags().GetString(FlagRetirementBeneficiary)
+			if err != nil {
+				return err
+			}
 			tradableAmount := args[0]
 			retiredAmount := "0"
 			if len(retireTo) > 0 {
@@ -320,6 +339,8 @@ Parameters:
 				BatchDenom:             args[1],
 				RetiredAmount:          retiredAmount,
 				RetirementJurisdiction: retireTo,
+				RetirementReason:       reason,
+				RetirementBeneficiary:  beneficiary,
 			}
 			msg := types.MsgSend{
 				Sender:    clientCtx.GetFromAddress().String(),
@@ -330,6 +351,8 @@ Parameters:
 		},
 	}
 	cmd.Flags().String(FlagRetirementJurisdiction, "", "Jurisdiction to retire the credits to. If empty, credits are not retired. (default empty)")
+	cmd.Flags().String(FlagRetirementReason, "", "the reason for the retirement, used only if --retirement-jurisdiction is set")
+	cmd.Flags().String(FlagRetirementBeneficiary, "", "the beneficiary of the retirement, used only if --retirement-jurisdiction is set")
 	return txFlags(cmd)
 }
 
@@ -400,6 +423,7 @@ Parameters:
 - retirement-jurisdiction:  the jurisdiction in which the credit will be retired`,
 		Example: `
 regen tx ecocredit retire credits.json "US-WA 98225"
+regen tx ecocredit retire credits.json "US-WA 98225" --reason "2022 carbon offset" --beneficiary "Acme Inc."
 
 Example JSON:
 [
@@ -425,16 +449,31 @@ Example JSON:
 				return sdkerrors.ErrInvalidRequest.Wrapf("failed to parse json: %s", err)
 			}
 
+			reason, err := cmd.Flags().GetString(FlagReason)
+			if err != nil {
+				return err
+			}
+
+			beneficiary, err := cmd.Flags().GetString(FlagBeneficiary)
+			if err != nil {
+				return err
+			}
+
 			msg := types.MsgRetire{
 				Owner:        clientCtx.GetFromAddress().String(),
 				Credits:      credits,
 				Jurisdiction: args[1],
+				Reason:       reason,
+				Beneficiary:  beneficiary,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
 
+	cmd.Flags().String(FlagReason, "", "the reason for the retirement")
+	cmd.Flags().String(FlagBeneficiary, "", "the beneficiary of the retirement")
+
 	return txFlags(cmd)
 }
 
@@ -678,3 +717,784 @@ func TxUpdateProjectMetadataCmd() *cobra.Command {
 
 	return txFlags(cmd)
 }
+
+// TxUpdateProjectStatusCmd returns a transaction command that updates the
+// lifecycle status of a project.
+func TxUpdateProjectStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-project-status [project-id] [status] [flags]",
+		Short: "Updates the lifecycle status of a project",
+		Long: `Updates the lifecycle status of a project. The status can optionally reference
+data anchored in the data module with evidence supporting the status (e.g. a verification report).
+
+The '--from' flag must equal an issuer of the credit class of the project.
+
+Parameters:
+
+- project-id:  the id of the project
+- status:      the new status of the project (proposed, registered, verified, suspended or completed)`,
+		Example: `regen tx ecocredit update-project-status C01-001 registered
+regen tx ecocredit update-project-status C01-001 verified --iri regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGxGREWGKjEdXYG.rdf`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			status, err := parseProjectStatus(args[1])
+			if err != nil {
+				return err
+			}
+
+			iri, err := cmd.Flags().GetString(FlagIRI)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgUpdateProjectStatus{
+				Issuer:    clientCtx.GetFromAddress().String(),
+				ProjectId: args[0],
+				NewStatus: status,
+				Iri:       iri,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagIRI, "", "the IRI of data with evidence supporting the status")
+
+	return txFlags(cmd)
+}
+
+// TxSetClassIssuerQuotaCmd returns a transaction command that sets or removes
+// the quota of an issuer of a credit class.
+func TxSetClassIssuerQuotaCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-class-issuer-quota [class-id] [issuer] [amount] [flags]",
+		Short: "Sets or removes the quota of an issuer of a credit class",
+		Long: `Sets or removes the quota of an issuer of a credit class. The quota caps the amount
+of credits (tradable and retired) the issuer can issue in total or, if the period is set, within
+each period. If the amount is omitted, the quota of the issuer is removed.
+
+The '--from' flag must equal the admin of the credit class.
+
+Parameters:
+
+- class-id:  the id of the credit class
+- issuer:    the address of the issuer of the credit class
+- amount:    the maximum amount of credits the issuer can issue (optional)`,
+		Example: `regen tx ecocredit set-class-issuer-quota C01 regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw 1000
+regen tx ecocredit set-class-issuer-quota C01 regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw 1000 --period 720h
+regen tx ecocredit set-class-issuer-quota C01 regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw`,
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgSetClassIssuerQuota{
+				Admin:   clientCtx.GetFromAddress().String(),
+				ClassId: args[0],
+				Issuer:  args[1],
+			}
+
+			if len(args) == 3 {
+				msg.Amount = args[2]
+			}
+
+			period, err := cmd.Flags().GetDuration(FlagPeriod)
+			if err != nil {
+				return err
+			}
+
+			if period != 0 {
+				msg.Period = gogotypes.DurationProto(period)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().Duration(FlagPeriod, 0, "the length of the period after which the issued amount is reset (e.g. 720h)")
+
+	return txFlags(cmd)
+}
+
+// TxUpdateClassBufferCmd returns a transaction command that sets or removes the
+// buffer of a credit class.
+func TxUpdateClassBufferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-class-buffer [class-id] [buffer-percentage] [flags]",
+		Short: "Sets or removes the buffer of a credit class",
+		Long: `Sets or removes the buffer of a credit class. The buffer percentage of the credits
+issued in each credit batch (tradable and retired) is withheld and issued as tradable credits
+to the buffer account of the credit class, an account derived from the credit class id that
+no user controls. If the buffer percentage is omitted, the buffer of the credit class is removed.
+
+The '--from' flag must equal the admin of the credit class.
+
+Parameters:
+
+- class-id:           the id of the credit class
+- buffer-percentage:  the percentage of issued credits withheld, less than 100 (optional)`,
+		Example: `regen tx ecocredit update-class-buffer C01 20
+regen tx ecocredit update-class-buffer C01`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgUpdateClassBuffer{
+				Admin:   clientCtx.GetFromAddress().String(),
+				ClassId: args[0],
+			}
+
+			if len(args) == 2 {
+				msg.BufferPercentage = args[1]
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxCancelBufferCreditsCmd returns a transaction command that cancels credits
+// held in the buffer account of a credit class.
+func TxCancelBufferCreditsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-buffer-credits [class-id] [credits-json] [reason] [flags]",
+		Short: "Cancels credits held in the buffer account of a credit class",
+		Long: `Cancels credits held in the buffer account of a credit class to cover reversals.
+Only credits from credit batches of the credit class can be cancelled.
+
+The '--from' flag must equal the admin of the credit class.
+
+Parameters:
+
+- class-id:      the id of the credit class
+- credits-json:  path to JSON file containing credits to cancel
+- reason:        the reason for cancelling the buffer credits`,
+		Example: `regen tx ecocredit cancel-buffer-credits C01 credits.json "reversal due to wildfire"
+
+Example JSON:
+
+[
+  {
+    "batch_denom": "C01-001-20200101-20210101-001",
+    "amount": "5"
+  }
+]`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			credits, err := parseCredits(args[1])
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("failed to parse json: %s", err)
+			}
+
+			msg := types.MsgCancelBufferCredits{
+				Admin:   clientCtx.GetFromAddress().String(),
+				ClassId: args[0],
+				Credits: credits,
+				Reason:  args[2],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxUpdateClassIssuanceThresholdCmd returns a transaction command that updates
+// the issuance threshold of a credit class.
+func TxUpdateClassIssuanceThresholdCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-class-issuance-threshold [class-id] [issuance-threshold] [flags]",
+		Short: "Updates the issuance threshold of a credit class",
+		Long: `Updates the number of approvals from issuers of a credit class required to create a
+proposed credit batch. If the issuance threshold is greater than one, credit batches cannot be
+created directly and must be proposed with 'propose-batch' and approved with 'approve-batch'.
+
+The '--from' flag must equal the admin of the credit class.
+
+Parameters:
+
+- class-id:            the id of the credit class
+- issuance-threshold:  the number of approvals required to create a proposed credit batch`,
+		Example: `regen tx ecocredit update-class-issuance-threshold C01 2`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			threshold, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid issuance threshold: %s", err)
+			}
+
+			msg := types.MsgUpdateClassIssuanceThreshold{
+				Admin:             clientCtx.GetFromAddress().String(),
+				ClassId:           args[0],
+				IssuanceThreshold: uint32(threshold),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxUpdateClassRequireVerifiedProjectCmd returns a transaction command that
+// updates whether or not a credit class requires a verified project.
+func TxUpdateClassRequireVerifiedProjectCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-class-require-verified-project [class-id] [require-verified-project] [flags]",
+		Short: "Updates whether or not a credit class requires a verified project",
+		Long: `Updates whether or not the project of a credit batch must be verified for credits to be
+issued in a credit class, i.e. when a credit batch is created, proposed or approved and when
+credits are minted to an open credit batch.
+
+The '--from' flag must equal the admin of the credit class.
+
+Parameters:
+
+- class-id:                  the id of the credit class
+- require-verified-project:  whether or not a verified project is required (true or false)`,
+		Example: `regen tx ecocredit update-class-require-verified-project C01 true`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			requireVerifiedProject, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid require verified project: %s", err)
+			}
+
+			msg := types.MsgUpdateClassRequireVerifiedProject{
+				Admin:                  clientCtx.GetFromAddress().String(),
+				ClassId:                args[0],
+				RequireVerifiedProject: requireVerifiedProject,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxProposeBatchCmd returns a transaction command that proposes a credit batch.
+func TxProposeBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-batch [batch-json]",
+		Short: "Proposes a new credit batch",
+		Long: `Proposes a new credit batch that is created once the number of approvals from issuers
+of the credit class meets the issuance threshold of the credit class. The proposal counts as an
+approval of the proposer.
+
+The '--from' flag must equal an issuer of the credit class.
+
+Parameters:
+
+- batch-json:  path to JSON file containing credit batch information`,
+		Example: `regen tx ecocredit propose-batch batch.json
+
+Example JSON:
+
+{
+  "project_id": "C01-001",
+  "issuance": [
+    {
+      "recipient": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "tradable_amount": "1000",
+      "retired_amount": "500",
+      "retirement_jurisdiction": "US-WA"
+    }
+  ],
+  "metadata": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGxGREWGKjEdXYG.rdf",
+  "start_date": "2020-01-01T00:00:00Z",
+  "end_date": "2021-01-01T00:00:00Z",
+  "open": false
+}`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := parseMsgProposeBatch(clientCtx, args[0])
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("failed to parse json: %s", err)
+			}
+
+			msg.Issuer = clientCtx.GetFromAddress().String()
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxApproveBatchCmd returns a transaction command that approves a pending
+// batch.
+func TxApproveBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-batch [pending-batch-id]",
+		Short: "Approves a pending batch",
+		Long: `Approves a pending batch. The credit batch is created when the approval meets the
+issuance threshold of the credit class.
+
+The '--from' flag must equal an issuer of the credit class.
+
+Parameters:
+
+- pending-batch-id:  the id of the pending batch`,
+		Example: `regen tx ecocredit approve-batch 1`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid pending batch id: %s", err)
+			}
+
+			msg := types.MsgApproveBatch{
+				Issuer:         clientCtx.GetFromAddress().String(),
+				PendingBatchId: id,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxApproveCreditsCmd returns a transaction command that sets the credit
+// allowance of a spender for a credit batch.
+func TxApproveCreditsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-credits [spender] [batch-denom] [amount] [flags]",
+		Short: "Allows a spender to send or retire credits on behalf of the transaction author (--from)",
+		Long: `Allows a spender to send or retire credits from a credit batch on behalf of the transaction
+author (--from) up to the amount. An existing credit allowance of the spender for the credit batch
+is replaced.
+
+Parameters:
+
+- spender:      the address of the account allowed to send or retire the credits
+- batch-denom:  the denomination of the credit batch
+- amount:       the maximum amount of credits (tradable and retired) the spender can send`,
+		Example: `regen tx ecocredit approve-credits regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw C01-001-20200101-20210101-001 100
+regen tx ecocredit approve-credits regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw C01-001-20200101-20210101-001 100 --expiration 2024-01-01T00:00:00Z`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgApproveCredits{
+				Owner:      clientCtx.GetFromAddress().String(),
+				Spender:    args[0],
+				BatchDenom: args[1],
+				Amount:     args[2],
+			}
+
+			expiration, err := cmd.Flags().GetString(FlagExpiration)
+			if err != nil {
+				return err
+			}
+
+			if expiration != "" {
+				t, err := time.Parse(time.RFC3339, expiration)
+				if err != nil {
+					return fmt.Errorf("invalid expiration: %s", err)
+				}
+				msg.Expiration = &t
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagExpiration, "", "the time at which the credit allowance expires (RFC3339)")
+
+	return txFlags(cmd)
+}
+
+// TxRevokeCreditAllowanceCmd returns a transaction command that removes the
+// credit allowance of a spender for a credit batch.
+func TxRevokeCreditAllowanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-credit-allowance [spender] [batch-denom]",
+		Short: "Removes the credit allowance of a spender granted by the transaction author (--from)",
+		Long: `Removes the credit allowance of a spender for a credit batch granted by the transaction
+author (--from).
+
+Parameters:
+
+- spender:      the address of the account allowed to send or retire the credits
+- batch-denom:  the denomination of the credit batch`,
+		Example: `regen tx ecocredit revoke-credit-allowance regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw C01-001-20200101-20210101-001`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgRevokeCreditAllowance{
+				Owner:      clientCtx.GetFromAddress().String(),
+				Spender:    args[0],
+				BatchDenom: args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxSendFromCmd returns a transaction command that sends credits on behalf of
+// the owner using the credit allowances of the transaction author.
+func TxSendFromCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-from [owner] [recipient] [credits-json]",
+		Short: "Sends credits of the owner to the recipient using the credit allowances of the transaction author (--from)",
+		Long: `Sends credits from multiple batches of the owner to the recipient using the credit allowances
+of the transaction author (--from). Both tradable and retired amounts are deducted from the credit
+allowances.
+
+Parameters:
+
+- owner:         the address of the account that owns the credits
+- recipient:     the recipient account address
+- credits-json:  path to JSON file containing credits to send`,
+		Example: `regen tx ecocredit send-from regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf credits.json
+
+Example JSON:
+
+[
+  {
+    "batch_denom": "C01-001-20200101-20210101-001",
+    "tradable_amount": "500"
+  },
+  {
+    "batch_denom": "C01-001-20200101-20210101-002",
+    "retired_amount": "100",
+    "retirement_jurisdiction": "YY-ZZ 12345"
+  }
+]`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			credits, err := parseSendCredits(args[2])
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("failed to parse json: %s", err)
+			}
+
+			msg := types.MsgSendFrom{
+				Spender:   clientCtx.GetFromAddress().String(),
+				Owner:     args[0],
+				Recipient: args[1],
+				Credits:   credits,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxGrantCreditSendCmd returns a transaction command that grants an account
+// the authorization to send credits on behalf of the transaction author.
+func TxGrantCreditSendCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-credit-send [grantee] [spend-limits-json] [flags]",
+		Short: "Grants an account the authorization to send credits of the transaction author (--from)",
+		Long: `Grants an account the authorization to send credits of the transaction author (--from) with
+MsgSend up to the spend limits. Each spend limit applies either to a credit batch or to the credit
+batches of a credit class and both tradable and retired amounts are deducted from the spend limit.
+The grant is removed once all spend limits are used up.
+
+Parameters:
+
+- grantee:            the address of the account granted the authorization
+- spend-limits-json:  path to JSON file containing the spend limits`,
+		Example: `regen tx ecocredit grant-credit-send regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw spend-limits.json
+regen tx ecocredit grant-credit-send regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw spend-limits.json --allowed-recipients regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf --expiration 2024-01-01T00:00:00Z
+
+Example JSON:
+
+[
+  {
+    "batch_denom": "C01-001-20200101-20210101-001",
+    "amount": "500"
+  },
+  {
+    "class_id": "C02",
+    "amount": "100"
+  }
+]`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			limits, err := parseCreditLimits(args[1])
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("failed to parse json: %s", err)
+			}
+
+			recipients, err := cmd.Flags().GetStringSlice(FlagAllowedRecipients)
+			if err != nil {
+				return err
+			}
+
+			authorization := &types.CreditSendAuthorization{
+				SpendLimits:       limits,
+				AllowedRecipients: recipients,
+			}
+
+			return grantAuthorization(cmd, clientCtx, args[0], authorization)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagAllowedRecipients, nil, "comma-separated list of the accounts that can receive the credits (default any account)")
+	cmd.Flags().String(FlagExpiration, "", "the time at which the grant expires (RFC3339)")
+
+	return txFlags(cmd)
+}
+
+// TxGrantCreditRetireCmd returns a transaction command that grants an account
+// the authorization to retire credits on behalf of the transaction author.
+func TxGrantCreditRetireCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-credit-retire [grantee] [retire-limits-json] [flags]",
+		Short: "Grants an account the authorization to retire credits of the transaction author (--from)",
+		Long: `Grants an account the authorization to retire credits of the transaction author (--from) with
+MsgRetire up to the retire limits. Each retire limit applies either to a credit batch or to the
+credit batches of a credit class. The grant is removed once all retire limits are used up.
+
+Parameters:
+
+- grantee:             the address of the account granted the authorization
+- retire-limits-json:  path to JSON file containing the retire limits`,
+		Example: `regen tx ecocredit grant-credit-retire regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw retire-limits.json
+regen tx ecocredit grant-credit-retire regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw retire-limits.json --expiration 2024-01-01T00:00:00Z
+
+Example JSON:
+
+[
+  {
+    "batch_denom": "C01-001-20200101-20210101-001",
+    "amount": "500"
+  },
+  {
+    "class_id": "C02",
+    "amount": "100"
+  }
+]`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			limits, err := parseCreditLimits(args[1])
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("failed to parse json: %s", err)
+			}
+
+			authorization := &types.CreditRetireAuthorization{
+				RetireLimits: limits,
+			}
+
+			return grantAuthorization(cmd, clientCtx, args[0], authorization)
+		},
+	}
+
+	cmd.Flags().String(FlagExpiration, "", "the time at which the grant expires (RFC3339)")
+
+	return txFlags(cmd)
+}
+
+// grantAuthorization generates or broadcasts a MsgGrant of the authorization
+// from the transaction author to the grantee.
+func grantAuthorization(cmd *cobra.Command, clientCtx sdkclient.Context, grantee string, authorization authz.Authorization) error {
+	granteeAddr, err := sdk.AccAddressFromBech32(grantee)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("grantee: %s", err)
+	}
+
+	var expiration *time.Time
+	exp, err := cmd.Flags().GetString(FlagExpiration)
+	if err != nil {
+		return err
+	}
+
+	if exp != "" {
+		t, err := time.Parse(time.RFC3339, exp)
+		if err != nil {
+			return fmt.Errorf("invalid expiration: %s", err)
+		}
+		expiration = &t
+	}
+
+	msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), granteeAddr, authorization, expiration)
+	if err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+// TxSuspendBatchCmd returns a transaction command that suspends a credit batch.
+func TxSuspendBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "suspend-batch [batch-denom] [flags]",
+		Short: "Suspends a credit batch",
+		Long: `Suspends a credit batch. Credits from a suspended credit batch cannot be sent,
+retired, bridged, put into a basket or sold until the credit batch is unsuspended.
+
+The '--from' flag must equal the admin of the credit class of the credit batch.
+
+Parameters:
+
+- batch-denom:  the denom of the credit batch to suspend`,
+		Example: `regen tx ecocredit suspend-batch C01-001-20200101-20210101-001 --reason "over-issuance"`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			reason, err := cmd.Flags().GetString(FlagReason)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgSuspendBatch{
+				Signer:     clientCtx.GetFromAddress().String(),
+				BatchDenom: args[0],
+				Reason:     reason,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagReason, "", "the reason the credit batch is suspended")
+
+	return txFlags(cmd)
+}
+
+// TxUnsuspendBatchCmd returns a transaction command that unsuspends a credit
+// batch.
+func TxUnsuspendBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unsuspend-batch [batch-denom]",
+		Short: "Unsuspends a suspended credit batch",
+		Long: `Unsuspends a suspended credit batch. An invalidated credit batch cannot be unsuspended.
+
+The '--from' flag must equal the admin of the credit class of the credit batch.
+
+Parameters:
+
+- batch-denom:  the denom of the credit batch to unsuspend`,
+		Example: "regen tx ecocredit unsuspend-batch C01-001-20200101-20210101-001",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgUnsuspendBatch{
+				Signer:     clientCtx.GetFromAddress().String(),
+				BatchDenom: args[0],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxInvalidateBatchCmd returns a transaction command that invalidates a credit
+// batch.
+func TxInvalidateBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "invalidate-batch [batch-denom] [flags]",
+		Short: "Invalidates a credit batch",
+		Long: `Invalidates a credit batch. The credit batch is sealed and permanently suspended,
+and all tradable credits held by accounts are cancelled.
+
+The '--from' flag must equal the admin of the credit class of the credit batch.
+
+Parameters:
+
+- batch-denom:  the denom of the credit batch to invalidate`,
+		Example: `regen tx ecocredit invalidate-batch C01-001-20200101-20210101-001 --reason "double counting"`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			reason, err := cmd.Flags().GetString(FlagReason)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgInvalidateBatch{
+				Signer:     clientCtx.GetFromAddress().String(),
+				BatchDenom: args[0],
+				Reason:     reason,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagReason, "", "the reason the credit batch is invalidated")
+
+	return txFlags(cmd)
+}
@@ -3,7 +3,9 @@ package client
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
+	"strings"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/spf13/cobra"
@@ -60,6 +62,25 @@ func parseMsgCreateBatch(clientCtx sdkclient.Context, jsonFile string) (*types.M
 	return &msg, nil
 }
 
+func parseMsgProposeBatch(clientCtx sdkclient.Context, jsonFile string) (*types.MsgProposeBatch, error) {
+	bz, err := ioutil.ReadFile(jsonFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := regentypes.CheckDuplicateKey(json.NewDecoder(bytes.NewReader(bz)), nil); err != nil {
+		return nil, err
+	}
+
+	var msg types.MsgProposeBatch
+	err = clientCtx.Codec.UnmarshalJSON(bz, &msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &msg, nil
+}
+
 func parseCredits(jsonFile string) ([]*types.Credits, error) {
 	bz, err := ioutil.ReadFile(jsonFile)
 	if err != nil {
@@ -101,3 +122,40 @@ func parseSendCredits(jsonFile string) ([]*types.MsgSend_SendCredits, error) {
 
 	return sendCredits, nil
 }
+
+func parseCreditLimits(jsonFile string) ([]*types.CreditLimit, error) {
+	bz, err := ioutil.ReadFile(jsonFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := regentypes.CheckDuplicateKey(json.NewDecoder(bytes.NewReader(bz)), nil); err != nil {
+		return nil, err
+	}
+
+	var limits []*types.CreditLimit
+
+	// using json package because array is not a proto message
+	err = json.Unmarshal(bz, &limits)
+	if err != nil {
+		return nil, err
+	}
+
+	return limits, nil
+}
+
+// parseProjectStatus parses a project status from either the full enum name
+// (e.g. PROJECT_STATUS_VERIFIED) or the short name (e.g. verified).
+func parseProjectStatus(s string) (types.ProjectStatus, error) {
+	name := strings.ToUpper(s)
+	if !strings.HasPrefix(name, "PROJECT_STATUS_") {
+		name = "PROJECT_STATUS_" + name
+	}
+
+	status, ok := types.ProjectStatus_value[name]
+	if !ok || status == int32(types.ProjectStatus_PROJECT_STATUS_UNSPECIFIED) {
+		return 0, fmt.Errorf("invalid project status: %s", s)
+	}
+
+	return types.ProjectStatus(status), nil
+}
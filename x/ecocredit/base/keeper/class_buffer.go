@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// withholdBuffer withholds the buffer percentage of the credit class from the
+// tradable and retired amounts of each issuance and adds an issuance of the
+// withheld credits as tradable credits to the buffer account. The issuance is
+// returned unchanged if the credit class does not have a buffer.
+func withholdBuffer(class *api.Class, precision uint32, issuance []*types.BatchIssuance) ([]*types.BatchIssuance, error) {
+	if class.BufferPercentage == "" {
+		return issuance, nil
+	}
+
+	percentage, err := math.NewPositiveDecFromString(class.BufferPercentage)
+	if err != nil {
+		return nil, err
+	}
+
+	withheld := math.NewDecFromInt64(0)
+	res := make([]*types.BatchIssuance, 0, len(issuance)+1)
+	for _, iss := range issuance {
+		decs, err := utils.GetNonNegativeFixedDecs(precision, iss.TradableAmount, iss.RetiredAmount)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+
+		amounts := make([]string, len(decs))
+		for i, amount := range decs {
+			buffer, err := getBufferAmount(amount, percentage, precision)
+			if err != nil {
+				return nil, err
+			}
+			if amount, err = amount.Sub(buffer); err != nil {
+				return nil, err
+			}
+			if withheld, err = withheld.Add(buffer); err != nil {
+				return nil, err
+			}
+			amounts[i] = amount.String()
+		}
+
+		recipientIssuance := *iss
+		recipientIssuance.TradableAmount, recipientIssuance.RetiredAmount = amounts[0], amounts[1]
+		res = append(res, &recipientIssuance)
+	}
+
+	if withheld.IsPositive() {
+		res = append(res, &types.BatchIssuance{
+			Recipient:      sdk.AccAddress(class.BufferAccount).String(),
+			TradableAmount: withheld.String(),
+		})
+	}
+
+	return res, nil
+}
+
+// getBufferAmount returns the percentage of the amount rounded down to the
+// precision of the credit type.
+func getBufferAmount(amount, percentage math.Dec, precision uint32) (math.Dec, error) {
+	buffer, err := amount.Mul(percentage)
+	if err != nil {
+		return math.Dec{}, err
+	}
+	if buffer, err = buffer.Quo(math.NewDecFromInt64(100)); err != nil {
+		return math.Dec{}, err
+	}
+
+	unit := math.NewDecFinite(1, -int32(precision))
+	units, err := buffer.QuoInteger(unit)
+	if err != nil {
+		return math.Dec{}, err
+	}
+	if buffer, err = units.Mul(unit); err != nil {
+		return math.Dec{}, err
+	}
+
+	buffer, _ = buffer.Reduce()
+	return buffer, nil
+}
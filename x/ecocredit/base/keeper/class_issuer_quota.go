@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+)
+
+// resetQuotaPeriod starts a new period and resets the issued amount of the
+// quota if the current period of the quota has ended. Periods are aligned to
+// the period start so that the start of each period does not depend on when
+// credits were issued.
+func resetQuotaPeriod(quota *api.ClassIssuerQuota, blockTime time.Time) {
+	if quota.Period == nil {
+		return
+	}
+
+	period := quota.Period.AsDuration()
+	start := quota.PeriodStart.AsTime()
+	if period <= 0 || blockTime.Before(start.Add(period)) {
+		return
+	}
+
+	elapsed := blockTime.Sub(start) / period
+	quota.PeriodStart = timestamppb.New(start.Add(elapsed * period))
+	quota.IssuedAmount = "0"
+}
+
+// getRemainingQuotaAmount returns the amount of credits the issuer of the quota
+// can still issue within the current period of the quota.
+func getRemainingQuotaAmount(quota *api.ClassIssuerQuota) (math.Dec, error) {
+	amount, err := math.NewDecFromString(quota.Amount)
+	if err != nil {
+		return math.Dec{}, err
+	}
+
+	issuedAmount, err := math.NewDecFromString(quota.IssuedAmount)
+	if err != nil {
+		return math.Dec{}, err
+	}
+
+	remaining, err := amount.Sub(issuedAmount)
+	if err != nil {
+		return math.Dec{}, err
+	}
+
+	if remaining.IsNegative() {
+		return math.NewDecFromInt64(0), nil
+	}
+
+	return remaining, nil
+}
+
+// useClassIssuerQuota adds the amount of issued credits to the issued amount
+// of the quota of the issuer of the credit class. An error is returned if the
+// amount exceeds the remaining amount of the quota. Issuers without a quota can
+// issue any amount of credits.
+func (k Keeper) useClassIssuerQuota(ctx context.Context, classKey uint64, issuer sdk.AccAddress, amount math.Dec) error {
+	quota, err := k.stateStore.ClassIssuerQuotaTable().Get(ctx, classKey, issuer)
+	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	resetQuotaPeriod(quota, sdk.UnwrapSDKContext(ctx).BlockTime())
+
+	remaining, err := getRemainingQuotaAmount(quota)
+	if err != nil {
+		return err
+	}
+
+	if amount.Cmp(remaining) == 1 {
+		return sdkerrors.ErrInvalidRequest.Wrapf(
+			"issuance of %s credits exceeds the remaining quota of %s credits of issuer %s",
+			amount, remaining, issuer,
+		)
+	}
+
+	issuedAmount, err := math.NewDecFromString(quota.IssuedAmount)
+	if err != nil {
+		return err
+	}
+
+	issuedAmount, err = issuedAmount.Add(amount)
+	if err != nil {
+		return err
+	}
+
+	quota.IssuedAmount = issuedAmount.String()
+
+	return k.stateStore.ClassIssuerQuotaTable().Update(ctx, quota)
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+var _ ecocredit.CreditKeeper = Keeper{}
+
+// GetBalance returns the tradable, retired and escrowed amounts of credits of
+// the credit batch owned by the account.
+func (k Keeper) GetBalance(ctx sdk.Context, addr sdk.AccAddress, batchDenom string) (tradable, retired, escrowed math.Dec, err error) {
+	goCtx := sdk.WrapSDKContext(ctx)
+	batch, err := k.stateStore.BatchTable().GetByDenom(goCtx, batchDenom)
+	if err != nil {
+		return math.Dec{}, math.Dec{}, math.Dec{}, sdkerrors.ErrInvalidRequest.Wrapf(
+			"could not get batch with denom %s: %s", batchDenom, err.Error(),
+		)
+	}
+
+	balance, err := utils.GetBalance(goCtx, k.stateStore.BatchBalanceTable(), addr, batch.Key)
+	if err != nil {
+		return math.Dec{}, math.Dec{}, math.Dec{}, err
+	}
+
+	decs := make([]math.Dec, 3)
+	for i, amount := range []string{balance.TradableAmount, balance.RetiredAmount, balance.EscrowedAmount} {
+		if decs[i], err = math.NewNonNegativeDecFromString(amount); err != nil {
+			return math.Dec{}, math.Dec{}, math.Dec{}, err
+		}
+	}
+
+	return decs[0], decs[1], decs[2], nil
+}
+
+// TransferCredits transfers tradable credits of the credit batch from the
+// sender to the recipient.
+func (k Keeper) TransferCredits(ctx sdk.Context, sender, recipient sdk.AccAddress, batchDenom string, amount math.Dec) error {
+	if !amount.IsPositive() {
+		return sdkerrors.ErrInvalidRequest.Wrapf("amount must be positive, got %s", amount)
+	}
+
+	return k.sendEcocredits(ctx, &types.MsgSend_SendCredits{
+		BatchDenom:     batchDenom,
+		TradableAmount: amount.String(),
+	}, recipient, sender)
+}
+
+// EscrowCredits transfers tradable credits of the credit batch from the owner
+// to the account of the module.
+func (k Keeper) EscrowCredits(ctx sdk.Context, owner sdk.AccAddress, moduleName, batchDenom string, amount math.Dec) error {
+	moduleAddr, err := k.getModuleAddress(moduleName)
+	if err != nil {
+		return err
+	}
+
+	return k.TransferCredits(ctx, owner, moduleAddr, batchDenom, amount)
+}
+
+// ReleaseCredits transfers tradable credits of the credit batch from the
+// account of the module to the recipient.
+func (k Keeper) ReleaseCredits(ctx sdk.Context, moduleName string, recipient sdk.AccAddress, batchDenom string, amount math.Dec) error {
+	moduleAddr, err := k.getModuleAddress(moduleName)
+	if err != nil {
+		return err
+	}
+
+	return k.TransferCredits(ctx, moduleAddr, recipient, batchDenom, amount)
+}
+
+// RetireCredits retires tradable credits of the credit batch owned by the
+// owner and returns the id of the retirement.
+func (k Keeper) RetireCredits(ctx sdk.Context, owner sdk.AccAddress, batchDenom string, amount math.Dec, jurisdiction, reason, beneficiary string) (uint64, error) {
+	if !amount.IsPositive() {
+		return 0, sdkerrors.ErrInvalidRequest.Wrapf("amount must be positive, got %s", amount)
+	}
+
+	if err := base.ValidateJurisdiction(jurisdiction); err != nil {
+		return 0, sdkerrors.ErrInvalidRequest.Wrapf("jurisdiction: %s", err)
+	}
+
+	if len(reason) > base.MaxNoteLength {
+		return 0, ecocredit.ErrMaxLimit.Wrapf("reason: max length %d", base.MaxNoteLength)
+	}
+
+	if len(beneficiary) > base.MaxNoteLength {
+		return 0, ecocredit.ErrMaxLimit.Wrapf("beneficiary: max length %d", base.MaxNoteLength)
+	}
+
+	return k.retireCredits(sdk.WrapSDKContext(ctx), owner, &types.Credits{
+		BatchDenom: batchDenom,
+		Amount:     amount.String(),
+	}, jurisdiction, reason, beneficiary)
+}
+
+// getModuleAddress returns the address of the module account and returns
+// ErrUnknownAddress if the module account does not exist.
+func (k Keeper) getModuleAddress(moduleName string) (sdk.AccAddress, error) {
+	addr := k.accountKeeper.GetModuleAddress(moduleName)
+	if addr == nil {
+		return nil, sdkerrors.ErrUnknownAddress.Wrapf("module account %s does not exist", moduleName)
+	}
+	return addr, nil
+}
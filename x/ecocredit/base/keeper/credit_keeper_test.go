@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// setupCreditClass sets up a credit class administered by the first test
+// account with both test accounts as issuers and a project of the credit class.
+func setupCreditClass(t *testing.T) *baseSuite {
+	s := setupBase(t)
+	s.sdkCtx = s.sdkCtx.WithBlockTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+
+	require.NoError(t, s.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: "C",
+		Name:         "carbon",
+		Unit:         "metric ton C02",
+		Precision:    6,
+	}))
+	classKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               testClassID,
+		Admin:            s.addr,
+		CreditTypeAbbrev: "C",
+	})
+	require.NoError(t, err)
+	for _, issuer := range []sdk.AccAddress{s.addr, s.addr2} {
+		require.NoError(t, s.stateStore.ClassIssuerTable().Insert(s.ctx, &api.ClassIssuer{
+			ClassKey: classKey,
+			Issuer:   issuer,
+		}))
+	}
+	require.NoError(t, s.stateStore.ProjectTable().Insert(s.ctx, &api.Project{
+		Id:       testProjectID,
+		ClassKey: classKey,
+	}))
+
+	return s
+}
+
+// createCreditBatch creates an open credit batch of the project set up with
+// setupCreditClass and issues the credits to the second test account.
+func (s *baseSuite) createCreditBatch(issuer sdk.AccAddress, tradableAmount, retiredAmount string) (*types.MsgCreateBatchResponse, error) {
+	startDate := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	return s.k.CreateBatch(s.ctx, &types.MsgCreateBatch{
+		Issuer:    issuer.String(),
+		ProjectId: testProjectID,
+		Issuance: []*types.BatchIssuance{
+			{
+				Recipient:      s.addr2.String(),
+				TradableAmount: tradableAmount,
+				RetiredAmount:  retiredAmount,
+			},
+		},
+		StartDate: &startDate,
+		EndDate:   &endDate,
+		Open:      true,
+	})
+}
+
+func TestCreditKeeper(t *testing.T) {
+	t.Parallel()
+	s := setupCreditClass(t)
+	_, err := s.createCreditBatch(s.addr, "10", "1")
+	require.NoError(t, err)
+
+	moduleAddr := address.Module("foo", []byte("foo"))
+	s.accountKeeper.EXPECT().GetModuleAddress("foo").Return(moduleAddr).AnyTimes()
+	s.accountKeeper.EXPECT().GetModuleAddress("bar").Return(nil).AnyTimes()
+
+	tradable, retired, escrowed, err := s.k.GetBalance(s.sdkCtx, s.addr2, testBatchDenom)
+	require.NoError(t, err)
+	require.Equal(t, "10", tradable.String())
+	require.Equal(t, "1", retired.String())
+	require.Equal(t, "0", escrowed.String())
+
+	_, _, _, err = s.k.GetBalance(s.sdkCtx, s.addr2, "C01-001-20200101-20210101-002")
+	require.ErrorContains(t, err, "could not get batch with denom C01-001-20200101-20210101-002")
+
+	// transfer
+	require.NoError(t, s.k.TransferCredits(s.sdkCtx, s.addr2, s.addr, testBatchDenom, math.NewDecFromInt64(2)))
+	s.requireBalance(t, s.addr, 1, "2", "0")
+	s.requireBalance(t, s.addr2, 1, "8", "1")
+
+	err = s.k.TransferCredits(s.sdkCtx, s.addr2, s.addr, testBatchDenom, math.NewDecFromInt64(0))
+	require.ErrorContains(t, err, "amount must be positive")
+
+	err = s.k.TransferCredits(s.sdkCtx, s.addr, s.addr2, testBatchDenom, math.NewDecFromInt64(3))
+	require.ErrorContains(t, err, "insufficient credit balance")
+
+	// escrow and release
+	require.NoError(t, s.k.EscrowCredits(s.sdkCtx, s.addr2, "foo", testBatchDenom, math.NewDecFromInt64(5)))
+	s.requireBalance(t, moduleAddr, 1, "5", "0")
+	s.requireBalance(t, s.addr2, 1, "3", "1")
+
+	require.NoError(t, s.k.ReleaseCredits(s.sdkCtx, "foo", s.addr, testBatchDenom, math.NewDecFromInt64(4)))
+	s.requireBalance(t, moduleAddr, 1, "1", "0")
+	s.requireBalance(t, s.addr, 1, "6", "0")
+
+	err = s.k.EscrowCredits(s.sdkCtx, s.addr2, "bar", testBatchDenom, math.NewDecFromInt64(1))
+	require.ErrorIs(t, err, sdkerrors.ErrUnknownAddress)
+
+	err = s.k.ReleaseCredits(s.sdkCtx, "bar", s.addr2, testBatchDenom, math.NewDecFromInt64(1))
+	require.ErrorIs(t, err, sdkerrors.ErrUnknownAddress)
+
+	// retire
+	id, err := s.k.RetireCredits(s.sdkCtx, moduleAddr, testBatchDenom, math.NewDecFromInt64(1), "US-WA", "offset", "")
+	require.NoError(t, err)
+	require.NotZero(t, id)
+	s.requireBalance(t, moduleAddr, 1, "0", "1")
+
+	_, err = s.k.RetireCredits(s.sdkCtx, s.addr, testBatchDenom, math.NewDecFromInt64(1), "foo", "", "")
+	require.ErrorContains(t, err, "jurisdiction")
+
+	// credits held by module accounts are accounted for in the batch supply
+	msg, broken := BatchSupplyInvariant(s.ctx, s.k, nil)
+	require.False(t, broken, msg)
+
+	msg, broken = RetiredSupplyInvariant(s.ctx, s.k)
+	require.False(t, broken, msg)
+}
+
+func (s *baseSuite) requireBalance(t *testing.T, addr sdk.AccAddress, batchKey uint64, tradable, retired string) {
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, addr, batchKey)
+	require.NoError(t, err)
+	require.Equal(t, tradable, balance.TradableAmount)
+	require.Equal(t, retired, balance.RetiredAmount)
+}
+
+// insertCreditBatch inserts a credit batch with an empty batch supply along with
+// the credit class and the project of the credit batch and returns the batch key.
+func insertCreditBatch(t gocuke.TestingT, s *baseSuite, batchDenom string) uint64 {
+	classID := base.GetClassIDFromBatchDenom(batchDenom)
+	projectID := base.GetProjectIDFromBatchDenom(batchDenom)
+
+	classKey, err := s.k.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               classID,
+		CreditTypeAbbrev: base.GetCreditTypeAbbrevFromClassID(classID),
+	})
+	require.NoError(t, err)
+
+	projectKey, err := s.k.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
+		Id:       projectID,
+		ClassKey: classKey,
+	})
+	require.NoError(t, err)
+
+	batchKey, err := s.k.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey: projectKey,
+		Denom:      batchDenom,
+	})
+	require.NoError(t, err)
+
+	err = s.k.stateStore.BatchSupplyTable().Insert(s.ctx, &api.BatchSupply{
+		BatchKey:        batchKey,
+		TradableAmount:  "0",
+		RetiredAmount:   "0",
+		CancelledAmount: "0",
+	})
+	require.NoError(t, err)
+
+	return batchKey
+}
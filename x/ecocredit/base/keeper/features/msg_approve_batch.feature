@@ -0,0 +1,187 @@
+Feature: Msg/ApproveBatch
+
+  A pending batch can be approved by an issuer of the credit class:
+  - when the pending batch exists
+  - when the approver is an issuer of the credit class
+  - when the approver has not approved the pending batch
+  - when the project is verified if a verified project is required
+  - the approval is added
+  - the credit batch is created once the approvals meet the issuance threshold
+  - the pending batch and the approvals are removed once the credit batch is created
+
+  Rule: The pending batch must exist
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and issuers alice and bob
+      And the credit class has an issuance threshold of "2"
+      And a project with project id "C01-001"
+      And alice proposed a batch with origin tx id "0x1"
+
+    Scenario: the pending batch exists
+      When bob attempts to approve the pending batch with id "1"
+      Then expect no error
+
+    Scenario: the pending batch does not exist
+      When bob attempts to approve the pending batch with id "2"
+      Then expect the error "could not get pending batch with id 2: not found: invalid request"
+
+    Scenario: the credit batch of the pending batch was created
+      Given bob approved the pending batch with id "1"
+      When carol attempts to approve the pending batch with id "1"
+      Then expect the error "could not get pending batch with id 1: not found: invalid request"
+
+  Rule: The approver must be an issuer of the credit class
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and issuers alice and bob
+      And the credit class has an issuance threshold of "2"
+      And a project with project id "C01-001"
+      And alice proposed a batch with origin tx id "0x1"
+
+    Scenario: the approver is an issuer of the credit class
+      When bob attempts to approve the pending batch with id "1"
+      Then expect no error
+
+    Scenario: the approver is not an issuer of the credit class
+      When carol attempts to approve the pending batch with id "1"
+      Then expect error contains "is not an issuer for the class: unauthorized"
+
+    Scenario: the approver was removed as an issuer of the credit class
+      Given bob is removed as an issuer of the credit class
+      When bob attempts to approve the pending batch with id "1"
+      Then expect error contains "is not an issuer for the class: unauthorized"
+
+  Rule: The approver must not have approved the pending batch
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and issuers alice and bob
+      And carol is an issuer of the credit class
+      And the credit class has an issuance threshold of "3"
+      And a project with project id "C01-001"
+      And alice proposed a batch with origin tx id "0x1"
+
+    Scenario: the approver has not approved the pending batch
+      When bob attempts to approve the pending batch with id "1"
+      Then expect no error
+
+    Scenario: the approver is the proposer of the pending batch
+      When alice attempts to approve the pending batch with id "1"
+      Then expect error contains "has already approved pending batch 1: invalid request"
+
+    Scenario: the approver has already approved the pending batch
+      Given bob approved the pending batch with id "1"
+      When bob attempts to approve the pending batch with id "1"
+      Then expect error contains "has already approved pending batch 1: invalid request"
+
+  Rule: The project must be verified if the credit class requires a verified project
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and issuers alice and bob
+      And the credit class has an issuance threshold of "2"
+      And the credit class requires a verified project
+      And a project with project id "C01-001" and status "PROJECT_STATUS_VERIFIED"
+      And alice proposed a batch with origin tx id "0x1"
+
+    Scenario: the project is verified
+      When bob attempts to approve the pending batch with id "1"
+      Then expect no error
+
+    Scenario: the project is no longer verified
+      Given the project status is "PROJECT_STATUS_SUSPENDED"
+      When bob attempts to approve the pending batch with id "1"
+      Then expect the error "project C01-001 is not verified: invalid request"
+
+  Rule: The credit batch is created once the approvals meet the issuance threshold
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and issuers alice and bob
+      And carol is an issuer of the credit class
+      And a project with project id "C01-001"
+
+    Scenario: the approvals do not meet the issuance threshold
+      Given the credit class has an issuance threshold of "3"
+      And alice proposed a batch with origin tx id "0x1"
+      When bob attempts to approve the pending batch with id "1"
+      Then expect the response
+      """
+      {}
+      """
+      And expect alice approved the pending batch with id "1"
+      And expect bob approved the pending batch with id "1"
+
+    Scenario: the approvals meet the issuance threshold
+      Given the credit class has an issuance threshold of "3"
+      And alice proposed a batch with origin tx id "0x1"
+      And bob approved the pending batch with id "1"
+      When carol attempts to approve the pending batch with id "1"
+      Then expect the response
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001"
+      }
+      """
+      And expect no pending batch with id "1"
+      And expect no approvals of the pending batch with id "1"
+      And expect bob batch balance with batch denom "C01-001-20200101-20210101-001" and tradable amount "10"
+
+    Scenario: the approval of an issuer removed from the credit class is not counted
+      Given the credit class has an issuance threshold of "2"
+      And bob proposed a batch with origin tx id "0x1"
+      And bob is removed as an issuer of the credit class
+      When carol attempts to approve the pending batch with id "1"
+      Then expect the response
+      """
+      {}
+      """
+
+    Scenario: the credit batch uses the batch sequence of the project
+      Given the credit class has an issuance threshold of "2"
+      And alice proposed a batch with origin tx id "0x1"
+      And alice proposed a batch with origin tx id "0x2"
+      And bob approved the pending batch with id "1"
+      When bob attempts to approve the pending batch with id "2"
+      Then expect the response
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-002"
+      }
+      """
+
+    Scenario: the credit batch of an open pending batch is open
+      Given the credit class has an issuance threshold of "2"
+      And alice proposed an open batch with origin tx id "0x1"
+      When bob attempts to approve the pending batch with id "1"
+      Then expect credit batch with batch denom "C01-001-20200101-20210101-001" and open "true"
+
+    Scenario: the origin tx of the pending batch was already used
+      Given the credit class has an issuance threshold of "2"
+      And alice proposed a batch with origin tx id "0x1"
+      And alice proposed a batch with origin tx id "0x1"
+      And bob approved the pending batch with id "1"
+      When bob attempts to approve the pending batch with id "2"
+      Then expect error contains "credits already issued with tx id: 0x1"
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: Event is emitted
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and issuers alice and bob
+      And the credit class has an issuance threshold of "2"
+      And a project with project id "C01-001"
+      And alice proposed a batch with origin tx id "0x1"
+
+    Scenario: EventApproveBatch is emitted
+      When bob attempts to approve the pending batch with id "1"
+      Then expect event with properties
+      """
+      {
+        "pending_batch_id": 1
+      }
+      """
@@ -0,0 +1,106 @@
+Feature: Msg/ApproveCredits
+
+  A spender can be allowed to send credits on behalf of the owner:
+  - when the credit batch exists
+  - when the decimal places in amount does not exceed credit type precision
+  - when the expiration is in the future
+  - the credit allowance of the spender is set or replaced
+
+  Rule: The credit batch must exist
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+
+    Scenario: the credit batch exists
+      Given a credit batch with batch denom "C01-001-20200101-20210101-001"
+      When alice attempts to approve bob with batch denom "C01-001-20200101-20210101-001" and amount "5"
+      Then expect no error
+
+    Scenario: the credit batch does not exist
+      When alice attempts to approve bob with batch denom "C01-001-20200101-20210101-001" and amount "5"
+      Then expect the error "could not get batch with denom C01-001-20200101-20210101-001: not found: invalid request"
+
+  Rule: The decimal places in amount must not exceed credit type precision
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit batch with batch denom "C01-001-20200101-20210101-001"
+
+    Scenario Outline: the decimal places in amount is less than or equal to credit type precision
+      When alice attempts to approve bob with batch denom "C01-001-20200101-20210101-001" and amount "<amount>"
+      Then expect no error
+
+      Examples:
+        | description | amount   |
+        | no decimals | 5        |
+        | one decimal | 5.1      |
+        | six decimal | 5.123456 |
+
+    Scenario: the decimal places in amount is greater than credit type precision
+      When alice attempts to approve bob with batch denom "C01-001-20200101-20210101-001" and amount "5.1234567"
+      Then expect the error "amount: 5.1234567 exceeds maximum decimal places: 6: invalid request"
+
+  Rule: The expiration must be in the future
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit batch with batch denom "C01-001-20200101-20210101-001"
+      And a block time with timestamp "2020-01-01"
+
+    Scenario: the expiration is in the future
+      When alice attempts to approve bob with amount "5" and expiration "2020-01-02"
+      Then expect no error
+
+    Scenario Outline: the expiration is not in the future
+      When alice attempts to approve bob with amount "5" and expiration "<expiration>"
+      Then expect error contains "expiration must be in the future"
+
+      Examples:
+        | description | expiration |
+        | block time  | 2020-01-01 |
+        | in the past | 2019-12-31 |
+
+  Rule: The credit allowance of the spender is set or replaced
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit batch with batch denom "C01-001-20200101-20210101-001"
+      And a block time with timestamp "2020-01-01"
+
+    Scenario: the credit allowance is set
+      When alice attempts to approve bob with batch denom "C01-001-20200101-20210101-001" and amount "5"
+      Then expect credit allowance of bob with properties
+      """
+      {
+        "amount": "5"
+      }
+      """
+
+    Scenario: the credit allowance is replaced
+      Given a credit allowance of bob with amount "5"
+      When alice attempts to approve bob with amount "3" and expiration "2020-01-02"
+      Then expect credit allowance of bob with properties
+      """
+      {
+        "amount": "3",
+        "expiration": "2020-01-02T00:00:00Z"
+      }
+      """
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: Event is emitted
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit batch with batch denom "C01-001-20200101-20210101-001"
+
+    Scenario: EventApproveCredits is emitted
+      When alice attempts to approve bob with batch denom "C01-001-20200101-20210101-001" and amount "5"
+      Then expect event with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "amount": "5"
+      }
+      """
@@ -2,6 +2,7 @@ Feature: Msg/Bridge
 
   Credits can be bridged to another chain:
   - when a batch contract entry exists
+  - when the credit batch is not suspended
   - the credits are cancelled and the owner balance is updated
   - the credits are cancelled and the total supply is updated
   - the bridge target is in the list of allowed bridge chains
@@ -22,6 +23,22 @@ Feature: Msg/Bridge
       When alice attempts to bridge credits from the credit batch
       Then expect the error "only credits previously bridged from another chain are supported: invalid request"
 
+  Rule: The credit batch must not be suspended
+
+    Background:
+      Given a credit batch exists with a batch contract entry
+      And the target is an allowed chain
+      And alice owns tradable credits from the credit batch
+
+    Scenario: the credit batch is not suspended
+      When alice attempts to bridge credits from the credit batch
+      Then expect no error
+
+    Scenario: the credit batch is suspended
+      Given the credit batch is suspended
+      When alice attempts to bridge credits from the credit batch
+      Then expect the error "credit batch C01-001-20200101-20210101-001 is suspended: invalid request"
+
   Rule: The credits are cancelled and the owner balance is updated
 
     Scenario: the owner balance is updated
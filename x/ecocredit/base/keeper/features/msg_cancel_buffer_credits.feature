@@ -0,0 +1,145 @@
+Feature: Msg/CancelBufferCredits
+
+  Credits held in the buffer account of a credit class can be cancelled:
+  - when the credit class exists
+  - when the admin is the admin of the credit class
+  - when the credit batch belongs to the credit class
+  - when the buffer account has a tradable credit balance greater than or equal to the amount to cancel
+  - the buffer account credit balance is updated
+  - the batch supply is updated
+
+  Rule: The credit class must exist
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and admin and issuer alice
+      And a project with project id "C01-001"
+      And a class buffer with buffer percentage "10"
+      And alice created a batch with tradable amount "100" and retired amount "0"
+
+    Scenario: the credit class exists
+      When alice attempts to cancel buffer credits with class id "C01" and amount "1"
+      Then expect no error
+
+    Scenario: the credit class does not exist
+      When alice attempts to cancel buffer credits with class id "C02" and amount "1"
+      Then expect the error "could not get credit class with id C02: not found: invalid request"
+
+  Rule: The admin must be the admin of the credit class
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and admin and issuer alice
+      And a project with project id "C01-001"
+      And a class buffer with buffer percentage "10"
+      And alice created a batch with tradable amount "100" and retired amount "0"
+
+    Scenario: the admin is the admin of the credit class
+      When alice attempts to cancel buffer credits with class id "C01" and amount "1"
+      Then expect no error
+
+    Scenario: the admin is not the admin of the credit class
+      When bob attempts to cancel buffer credits with class id "C01" and amount "1"
+      Then expect error contains "is not the admin of credit class C01: unauthorized"
+
+  Rule: The credit batch must belong to the credit class
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and admin and issuer alice
+      And a project with project id "C01-001"
+      And a class buffer with buffer percentage "10"
+      And alice created a batch with tradable amount "100" and retired amount "0"
+
+    Scenario: the credit batch belongs to the credit class
+      When alice attempts to cancel buffer credits with class id "C01" and amount "1"
+      Then expect no error
+
+    Scenario: the credit batch does not belong to the credit class
+      Given a credit class with class id "C02" and admin and issuer alice
+      When alice attempts to cancel buffer credits with class id "C02" and amount "1"
+      Then expect the error "credits[0]: credit batch C01-001-20200101-20210101-001 does not belong to credit class C02: invalid request"
+
+  Rule: The buffer account must have a tradable credit balance greater than or equal to the amount to cancel
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and admin and issuer alice
+      And a project with project id "C01-001"
+      And a class buffer with buffer percentage "10"
+      And alice created a batch with tradable amount "100" and retired amount "0"
+
+    Scenario Outline: tradable balance greater than or equal to amount to cancel
+      When alice attempts to cancel buffer credits with class id "C01" and amount "<amount>"
+      Then expect no error
+
+      Examples:
+        | description  | amount |
+        | greater than | 4      |
+        | equal to     | 10     |
+
+    Scenario: tradable balance less than amount to cancel
+      When alice attempts to cancel buffer credits with class id "C01" and amount "11"
+      Then expect the error "tradable balance: 10, cancel amount 11: insufficient credit balance"
+
+    Scenario: the credits withheld before the buffer was removed can be cancelled
+      Given a class buffer with buffer percentage ""
+      When alice attempts to cancel buffer credits with class id "C01" and amount "10"
+      Then expect no error
+
+  Rule: The buffer account balance and the batch supply are updated
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and admin and issuer alice
+      And a project with project id "C01-001"
+      And a class buffer with buffer percentage "10"
+      And alice created a batch with tradable amount "100" and retired amount "0"
+
+    Scenario: the buffer account balance and the batch supply are updated
+      When alice attempts to cancel buffer credits with class id "C01" and amount "4"
+      Then expect buffer account batch balance
+      """
+      {
+        "tradable_amount": "6",
+        "retired_amount": "0"
+      }
+      """
+      And expect bob batch balance
+      """
+      {
+        "tradable_amount": "90",
+        "retired_amount": "0"
+      }
+      """
+      And expect batch supply
+      """
+      {
+        "tradable_amount": "96",
+        "retired_amount": "0",
+        "cancelled_amount": "4"
+      }
+      """
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: Event is emitted
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and admin and issuer alice
+      And a project with project id "C01-001"
+      And a class buffer with buffer percentage "10"
+      And alice created a batch with tradable amount "100" and retired amount "0"
+
+    Scenario: EventCancel is emitted
+      When alice attempts to cancel buffer credits with class id "C01" and amount "4"
+      Then expect event cancel with properties
+      """
+      {
+        "owner": "regen100zn5s5nerkhk2amh942ekussq4vzl9lrcc9zw0y8kv03ezuk28ssevjk2",
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "amount": "4",
+        "reason": "reversal"
+      }
+      """
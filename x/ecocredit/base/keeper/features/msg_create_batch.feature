@@ -3,6 +3,8 @@ Feature: Msg/CreateBatch
   Credits can be issued in batches:
   - when the project exists
   - when the issuer is an allowed credit class issuer
+  - when the project is verified if a verified project is required
+  - when the credit class does not require proposed credit batches
   - when the decimal places in issuance amount does not exceed credit type precision
   - when the origin tx is unique within the scope of a credit class
   - when the contract is unique within the scope of a credit class
@@ -43,6 +45,51 @@ Feature: Msg/CreateBatch
       When bob attempts to create a batch with project id "C01-001"
       Then expect error contains "is not an issuer for the class: unauthorized"
 
+  Rule: The project must be verified if the credit class requires a verified project
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and issuer alice
+
+    Scenario: a verified project is required and the project is verified
+      Given the credit class requires a verified project
+      And a project with project id "C01-001" and status "PROJECT_STATUS_VERIFIED"
+      When alice attempts to create a batch with project id "C01-001"
+      Then expect no error
+
+    Scenario: a verified project is required and the project is not verified
+      Given the credit class requires a verified project
+      And a project with project id "C01-001" and status "PROJECT_STATUS_REGISTERED"
+      When alice attempts to create a batch with project id "C01-001"
+      Then expect the error "project C01-001 is not verified: invalid request"
+
+    Scenario: a verified project is not required and the project is not verified
+      Given a project with project id "C01-001" and status "PROJECT_STATUS_PROPOSED"
+      When alice attempts to create a batch with project id "C01-001"
+      Then expect no error
+
+  Rule: The credit class must not require proposed credit batches
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and issuer alice
+      And a project with project id "C01-001"
+
+    Scenario Outline: the credit class does not require proposed credit batches
+      Given the credit class has an issuance threshold of "<threshold>"
+      When alice attempts to create a batch with project id "C01-001"
+      Then expect no error
+
+      Examples:
+        | description      | threshold |
+        | no threshold     | 0         |
+        | threshold of one | 1         |
+
+    Scenario: the credit class requires proposed credit batches
+      Given the credit class has an issuance threshold of "2"
+      When alice attempts to create a batch with project id "C01-001"
+      Then expect the error "credit batches of credit class C01 must be proposed and approved by 2 issuers: invalid request"
+
   Rule: The decimal places in issuance amount must not exceed credit type precision
 
     Background:
@@ -0,0 +1,208 @@
+Feature: Msg/InvalidateBatch
+
+  A credit batch can be invalidated:
+  - when the credit batch exists
+  - when the signer is the admin of the credit class or the governance account
+  - when the credit batch has not been invalidated
+  - the credit batch is sealed, suspended and invalidated
+  - the tradable credits are cancelled and the balances are updated
+  - the batch supply is updated
+
+  Rule: The credit batch must exist
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20210101-001"
+
+    Scenario: the credit batch exists
+      When alice attempts to invalidate batch with denom "C01-001-20200101-20210101-001"
+      Then expect no error
+
+    Scenario: the credit batch does not exist
+      When alice attempts to invalidate batch with denom "C01-001-20200101-20210101-002"
+      Then expect the error "could not get batch with denom C01-001-20200101-20210101-002: not found: invalid request"
+
+  Rule: The signer must be the admin of the credit class or the governance account
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20210101-001"
+
+    Scenario: the signer is the admin of the credit class
+      When alice attempts to invalidate batch with denom "C01-001-20200101-20210101-001"
+      Then expect no error
+
+    Scenario: the signer is the governance account
+      When the governance account attempts to invalidate batch with denom "C01-001-20200101-20210101-001"
+      Then expect no error
+
+    Scenario: the signer is not the admin of the credit class or the governance account
+      When bob attempts to invalidate batch with denom "C01-001-20200101-20210101-001"
+      Then expect error contains "is not the admin of credit class C01 or the governance account: unauthorized"
+
+  Rule: The credit batch must not have been invalidated
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20210101-001"
+
+    Scenario: the credit batch has not been invalidated
+      When alice attempts to invalidate batch with denom "C01-001-20200101-20210101-001"
+      Then expect no error
+
+    Scenario: the credit batch has been invalidated
+      Given the credit batch is invalidated
+      When alice attempts to invalidate batch with denom "C01-001-20200101-20210101-001"
+      Then expect the error "credit batch C01-001-20200101-20210101-001 has already been invalidated: invalid request"
+
+  Rule: The credit batch is sealed, suspended and invalidated
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20210101-001"
+
+    Scenario: the credit batch is sealed, suspended and invalidated
+      When alice attempts to invalidate batch with denom "C01-001-20200101-20210101-001"
+      Then expect credit batch with properties
+      """
+      {
+        "open": false,
+        "suspended": true,
+        "invalidated": true
+      }
+      """
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: The tradable credits are cancelled and the balances are updated
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20210101-001"
+
+    Scenario: the tradable credits are cancelled and the retired credits are kept
+      Given alice has the batch balance
+      """
+      {
+        "retired_amount": "1",
+        "tradable_amount": "10",
+        "escrowed_amount": "0"
+      }
+      """
+      And bob has the batch balance
+      """
+      {
+        "retired_amount": "0",
+        "tradable_amount": "5",
+        "escrowed_amount": "0"
+      }
+      """
+      When alice attempts to invalidate batch with denom "C01-001-20200101-20210101-001"
+      Then expect alice batch balance
+      """
+      {
+        "retired_amount": "1",
+        "tradable_amount": "0",
+        "escrowed_amount": "0"
+      }
+      """
+      And expect bob batch balance
+      """
+      {
+        "retired_amount": "0",
+        "tradable_amount": "0",
+        "escrowed_amount": "0"
+      }
+      """
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: The batch supply is updated
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20210101-001"
+
+    Scenario: the batch supply is updated
+      Given alice has the batch balance
+      """
+      {
+        "retired_amount": "1",
+        "tradable_amount": "10",
+        "escrowed_amount": "0"
+      }
+      """
+      And bob has the batch balance
+      """
+      {
+        "retired_amount": "0",
+        "tradable_amount": "5",
+        "escrowed_amount": "0"
+      }
+      """
+      And the batch supply
+      """
+      {
+        "retired_amount": "1",
+        "tradable_amount": "15",
+        "cancelled_amount": "0"
+      }
+      """
+      When alice attempts to invalidate batch with denom "C01-001-20200101-20210101-001"
+      Then expect batch supply
+      """
+      {
+        "retired_amount": "1",
+        "tradable_amount": "0",
+        "cancelled_amount": "15"
+      }
+      """
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: Event is emitted
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20210101-001"
+
+    Scenario: EventInvalidateBatch is emitted
+      Given alice has the batch balance
+      """
+      {
+        "retired_amount": "0",
+        "tradable_amount": "10",
+        "escrowed_amount": "0"
+      }
+      """
+      And the batch supply
+      """
+      {
+        "retired_amount": "0",
+        "tradable_amount": "10",
+        "cancelled_amount": "0"
+      }
+      """
+      When alice attempts to invalidate batch with denom "C01-001-20200101-20210101-001" and reason "double counting"
+      Then expect event with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "reason": "double counting",
+        "cancelled_amount": "10"
+      }
+      """
@@ -4,6 +4,7 @@ Feature: Msg/MintBatchCredits
   - when the credit batch exists
   - when the credit batch is open
   - when the issuer is the issuer of the credit batch
+  - when the project is verified if the credit class requires a verified project
   - when the origin tx id and source is unique within the scope of the credit class
   - when the contract is unique within the scope of the credit class
   - the recipient batch balance is updated
@@ -58,6 +59,25 @@ Feature: Msg/MintBatchCredits
       When bob attempts to mint credits with batch denom "C01-001-20200101-20210101-001"
       Then expect the error "only the account that issued the batch can mint additional credits: unauthorized"
 
+  Rule: The project must be verified if the credit class requires a verified project
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with id "C01" and issuer alice
+      And the credit class requires a verified project
+
+    Scenario: the project is verified
+      Given a project with id "C01-001" and status "PROJECT_STATUS_VERIFIED"
+      And a credit batch with denom "C01-001-20200101-20210101-001" and issuer alice
+      When alice attempts to mint credits with batch denom "C01-001-20200101-20210101-001"
+      Then expect no error
+
+    Scenario: the project is not verified
+      Given a project with id "C01-001" and status "PROJECT_STATUS_SUSPENDED"
+      And a credit batch with denom "C01-001-20200101-20210101-001" and issuer alice
+      When alice attempts to mint credits with batch denom "C01-001-20200101-20210101-001"
+      Then expect the error "project C01-001 is not verified: invalid request"
+
   Rule: The origin tx must be unique within the scope of the credit class
 
     Background:
@@ -0,0 +1,149 @@
+Feature: Msg/ProposeBatch
+
+  Credit batches can be proposed by an issuer of a credit class:
+  - when the project exists
+  - when the proposer is an issuer of the credit class
+  - when the project is verified if a verified project is required
+  - when the decimal places in issuance amount does not exceed credit type precision
+  - the pending batch is added and approved by the proposer
+  - the credit batch is created if the approval of the proposer meets the issuance threshold
+
+  Rule: The project must exist
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and issuers alice and bob
+      And the credit class has an issuance threshold of "2"
+      And a project with project id "C01-001"
+
+    Scenario: the project exists
+      When alice attempts to propose a batch with project id "C01-001"
+      Then expect no error
+
+    Scenario: the project does not exist
+      When alice attempts to propose a batch with project id "C01-002"
+      Then expect the error "could not get project with id C01-002: not found: invalid request"
+
+  Rule: The proposer must be an issuer of the credit class
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and issuers alice and bob
+      And the credit class has an issuance threshold of "2"
+      And a project with project id "C01-001"
+
+    Scenario: the proposer is an issuer of the credit class
+      When alice attempts to propose a batch with project id "C01-001"
+      Then expect no error
+
+    Scenario: the proposer is not an issuer of the credit class
+      When carol attempts to propose a batch with project id "C01-001"
+      Then expect error contains "is not an issuer for the class: unauthorized"
+
+  Rule: The project must be verified if the credit class requires a verified project
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and issuers alice and bob
+      And the credit class has an issuance threshold of "2"
+      And the credit class requires a verified project
+
+    Scenario: the project is verified
+      Given a project with project id "C01-001" and status "PROJECT_STATUS_VERIFIED"
+      When alice attempts to propose a batch with project id "C01-001"
+      Then expect no error
+
+    Scenario: the project is not verified
+      Given a project with project id "C01-001" and status "PROJECT_STATUS_REGISTERED"
+      When alice attempts to propose a batch with project id "C01-001"
+      Then expect the error "project C01-001 is not verified: invalid request"
+
+  Rule: The decimal places in issuance amount must not exceed credit type precision
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and issuers alice and bob
+      And the credit class has an issuance threshold of "2"
+      And a project with project id "C01-001"
+
+    Scenario Outline: the decimal places in tradable amount is less than or equal to credit type precision
+      When alice attempts to propose a batch with tradable amount "<amount>"
+      Then expect no error
+
+      Examples:
+        | description | amount   |
+        | less than   | 9.12345  |
+        | equal to    | 9.123456 |
+
+    Scenario: the decimal places in tradable amount is greater than credit type precision
+      When alice attempts to propose a batch with tradable amount "9.1234567"
+      Then expect the error "issuance[0]: 9.1234567 exceeds maximum decimal places: 6: invalid request"
+
+  Rule: The pending batch is added and approved by the proposer
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and issuers alice and bob
+      And the credit class has an issuance threshold of "2"
+      And a project with project id "C01-001"
+
+    Scenario: the pending batch is added
+      When alice attempts to propose a batch with project id "C01-001"
+      Then expect the response
+      """
+      {
+        "pending_batch_id": 1
+      }
+      """
+      And expect pending batch with id "1" and open "false"
+      And expect alice approved the pending batch with id "1"
+
+    Scenario: the open pending batch is added
+      When alice attempts to propose an open batch with project id "C01-001"
+      Then expect pending batch with id "1" and open "true"
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: The credit batch is created if the approval of the proposer meets the issuance threshold
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and issuers alice and bob
+      And a project with project id "C01-001"
+
+    Scenario Outline: the approval of the proposer meets the issuance threshold
+      Given the credit class has an issuance threshold of "<threshold>"
+      When alice attempts to propose a batch with tradable amount "10"
+      Then expect the response
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001"
+      }
+      """
+      And expect no pending batch with id "1"
+      And expect bob batch balance with batch denom "C01-001-20200101-20210101-001" and tradable amount "10"
+
+      Examples:
+        | description      | threshold |
+        | no threshold     | 0         |
+        | threshold of one | 1         |
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: Event is emitted
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and issuers alice and bob
+      And the credit class has an issuance threshold of "2"
+      And a project with project id "C01-001"
+
+    Scenario: EventProposeBatch is emitted
+      When alice attempts to propose a batch with project id "C01-001"
+      Then expect event with properties
+      """
+      {
+        "pending_batch_id": 1,
+        "project_id": "C01-001"
+      }
+      """
@@ -2,6 +2,7 @@ Feature: Msg/Retire
 
   Credits can be retired by the owner:
   - when the credit batch exists
+  - when the credit batch is not suspended
   - when the owner has a tradable credit balance greater than or equal to the amount to cancel
   - when the decimal places in amount to cancel does not exceed credit type precision
   - the owner credit balance is updated
@@ -19,6 +20,21 @@ Feature: Msg/Retire
       When alice attempts to retire credits with batch denom "C01-001-20200101-20210101-001"
       Then expect the error "could not get batch with denom C01-001-20200101-20210101-001: not found: invalid request"
 
+  Rule: The credit batch must not be suspended
+
+    Background:
+      Given a credit batch
+      And alice owns tradable credit amount "10"
+
+    Scenario: the credit batch is not suspended
+      When alice attempts to retire credit amount "5"
+      Then expect no error
+
+    Scenario: the credit batch is suspended
+      Given the credit batch is suspended
+      When alice attempts to retire credit amount "5"
+      Then expect the error "credit batch C01-001-20200101-20210101-001 is suspended: invalid request"
+
   Rule: The owner must have a tradable credit balance greater than or equal to the amount to retire
 
     Background:
@@ -130,4 +146,29 @@ Feature: Msg/Retire
         "amount": "10",
         "jurisdiction": "US-WA"
       }
-      """
\ No newline at end of file
+      """
+  Rule: Retirement is recorded
+
+    Scenario: retirement record is stored
+      Given a credit batch
+      And alice owns tradable credit amount "10"
+      And the batch supply
+      """
+      {
+        "retired_amount": "0",
+        "tradable_amount": "10",
+        "cancelled_amount": "0"
+      }
+      """
+      When alice attempts to retire credit amount "10" with reason "carbon offset" and beneficiary "acme"
+      Then expect retirement with id "1"
+      """
+      {
+        "amount": "10",
+        "jurisdiction": "US-WA",
+        "reason": "carbon offset",
+        "beneficiary": "acme"
+      }
+      """
+
+    # no failing scenario - state transitions only occur upon successful message execution
@@ -0,0 +1,65 @@
+Feature: Msg/RevokeCreditAllowance
+
+  The credit allowance of a spender can be revoked by the owner:
+  - when the credit batch exists
+  - when the spender has a credit allowance for the credit batch
+  - the credit allowance of the spender is removed
+
+  Rule: The credit batch must exist
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+
+    Scenario: the credit batch exists
+      Given a credit batch with batch denom "C01-001-20200101-20210101-001"
+      And a credit allowance of bob with amount "5"
+      When alice attempts to revoke the credit allowance of bob with batch denom "C01-001-20200101-20210101-001"
+      Then expect no error
+
+    Scenario: the credit batch does not exist
+      When alice attempts to revoke the credit allowance of bob with batch denom "C01-001-20200101-20210101-001"
+      Then expect the error "could not get batch with denom C01-001-20200101-20210101-001: not found: invalid request"
+
+  Rule: The spender must have a credit allowance for the credit batch
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit batch with batch denom "C01-001-20200101-20210101-001"
+
+    Scenario: the spender has a credit allowance
+      Given a credit allowance of bob with amount "5"
+      When alice attempts to revoke the credit allowance of bob with batch denom "C01-001-20200101-20210101-001"
+      Then expect no error
+
+    Scenario: the spender does not have a credit allowance
+      When alice attempts to revoke the credit allowance of bob with batch denom "C01-001-20200101-20210101-001"
+      Then expect error contains "does not have a credit allowance for C01-001-20200101-20210101-001"
+
+  Rule: The credit allowance of the spender is removed
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit batch with batch denom "C01-001-20200101-20210101-001"
+      And a credit allowance of bob with amount "5"
+
+    Scenario: the credit allowance is removed
+      When alice attempts to revoke the credit allowance of bob with batch denom "C01-001-20200101-20210101-001"
+      Then expect no credit allowance of bob
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: Event is emitted
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit batch with batch denom "C01-001-20200101-20210101-001"
+      And a credit allowance of bob with amount "5"
+
+    Scenario: EventRevokeCreditAllowance is emitted
+      When alice attempts to revoke the credit allowance of bob with batch denom "C01-001-20200101-20210101-001"
+      Then expect event with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001"
+      }
+      """
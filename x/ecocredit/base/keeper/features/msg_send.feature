@@ -2,6 +2,7 @@ Feature: Msg/Send
 
   Credits can be sent to another account:
   - when the credit batch exists
+  - when the credit batch is not suspended
   - when the sender has a tradable credit balance greater than or equal to the amount to send
   - when the decimal places in amount to send does not exceed credit type precision
   - the sender credit balance is updated
@@ -20,6 +21,21 @@ Feature: Msg/Send
       When alice attempts to send credits to bob with batch denom "C01-001-20200101-20210101-001"
       Then expect the error "could not get batch with denom C01-001-20200101-20210101-001: not found: invalid request"
 
+  Rule: The credit batch must not be suspended
+
+    Background:
+      Given a credit batch
+      And alice owns tradable credit amount "10"
+
+    Scenario: the credit batch is not suspended
+      When alice attempts to send credits to bob with tradable amount "5"
+      Then expect no error
+
+    Scenario: the credit batch is suspended
+      Given the credit batch is suspended
+      When alice attempts to send credits to bob with tradable amount "5"
+      Then expect the error "credit batch C01-001-20200101-20210101-001 is suspended: invalid request"
+
   Rule: The sender must have a tradable credit balance greater that or equal to the amount to send
 
     Background:
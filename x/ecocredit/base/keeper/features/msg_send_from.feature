@@ -0,0 +1,178 @@
+Feature: Msg/SendFrom
+
+  Credits can be sent on behalf of the owner using a credit allowance:
+  - when the credit batch exists
+  - when the spender has a credit allowance for the credit batch
+  - when the credit allowance has not expired
+  - when the tradable and retired amounts do not exceed the credit allowance
+  - when the owner has a tradable credit balance greater than or equal to the amount to send
+  - the owner and recipient credit balances are updated
+  - the credit allowance is reduced and removed once used up
+
+  Rule: The credit batch must exist
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+
+    Scenario: the credit batch exists
+      Given a credit batch with batch denom "C01-001-20200101-20210101-001"
+      And alice owns tradable credit amount "10"
+      And a credit allowance of bob with amount "5"
+      When bob attempts to send credits from alice with batch denom "C01-001-20200101-20210101-001" and tradable amount "1"
+      Then expect no error
+
+    Scenario: the credit batch does not exist
+      When bob attempts to send credits from alice with batch denom "C01-001-20200101-20210101-001" and tradable amount "1"
+      Then expect the error "could not get batch with denom C01-001-20200101-20210101-001: not found: invalid request"
+
+  Rule: The spender must have a credit allowance for the credit batch
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit batch with batch denom "C01-001-20200101-20210101-001"
+      And alice owns tradable credit amount "10"
+
+    Scenario: the spender has a credit allowance
+      Given a credit allowance of bob with amount "5"
+      When bob attempts to send credits from alice with tradable amount "1"
+      Then expect no error
+
+    Scenario: the spender does not have a credit allowance
+      When bob attempts to send credits from alice with tradable amount "1"
+      Then expect error contains "does not have a credit allowance for C01-001-20200101-20210101-001"
+
+    Scenario: the credit allowance was revoked
+      Given a credit allowance of bob with amount "5"
+      And alice revoked the credit allowance of bob
+      When bob attempts to send credits from alice with tradable amount "1"
+      Then expect error contains "does not have a credit allowance for C01-001-20200101-20210101-001"
+
+  Rule: The credit allowance must not be expired
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit batch with batch denom "C01-001-20200101-20210101-001"
+      And alice owns tradable credit amount "10"
+      And a credit allowance of bob with amount "5" and expiration "2020-01-02"
+
+    Scenario: the credit allowance has not expired
+      Given a block time with timestamp "2020-01-01"
+      When bob attempts to send credits from alice with tradable amount "1"
+      Then expect no error
+
+    Scenario Outline: the credit allowance has expired
+      Given a block time with timestamp "<block_time>"
+      When bob attempts to send credits from alice with tradable amount "1"
+      Then expect error contains "has expired: unauthorized"
+
+      Examples:
+        | description             | block_time |
+        | equal to expiration     | 2020-01-02 |
+        | greater than expiration | 2020-01-03 |
+
+  Rule: The tradable and retired amounts must not exceed the credit allowance
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit batch with batch denom "C01-001-20200101-20210101-001"
+      And alice owns tradable credit amount "10"
+      And a credit allowance of bob with amount "5"
+
+    Scenario Outline: the amount is less than or equal to the credit allowance
+      When bob attempts to send credits from alice with tradable amount "<tradable>" and retired amount "<retired>"
+      Then expect no error
+
+      Examples:
+        | description          | tradable | retired |
+        | tradable             | 5        | 0       |
+        | retired              | 0        | 5       |
+        | tradable and retired | 2        | 1.5     |
+
+    Scenario Outline: the amount is greater than the credit allowance
+      When bob attempts to send credits from alice with tradable amount "<tradable>" and retired amount "<retired>"
+      Then expect the error "send amount <amount> exceeds the credit allowance of 5 for C01-001-20200101-20210101-001: unauthorized"
+
+      Examples:
+        | description          | tradable | retired | amount |
+        | tradable             | 6        | 0       | 6      |
+        | retired              | 0        | 6       | 6      |
+        | tradable and retired | 3        | 2.5     | 5.5    |
+
+  Rule: The owner must have a tradable credit balance greater than or equal to the amount to send
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit batch with batch denom "C01-001-20200101-20210101-001"
+      And alice owns tradable credit amount "10"
+      And a credit allowance of bob with amount "20"
+
+    Scenario: the owner has a tradable credit balance greater than or equal to the amount to send
+      When bob attempts to send credits from alice with tradable amount "10"
+      Then expect no error
+
+    Scenario: the owner has a tradable credit balance less than the amount to send
+      When bob attempts to send credits from alice with tradable amount "11"
+      Then expect the error "tradable balance: 10, send tradable amount 11: insufficient credit balance"
+
+  Rule: The owner and recipient credit balances and the credit allowance are updated
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit batch with batch denom "C01-001-20200101-20210101-001"
+      And alice owns tradable credit amount "10"
+      And a credit allowance of bob with amount "5"
+
+    Scenario: the credit balances and the credit allowance are updated
+      When bob attempts to send credits from alice with tradable amount "2" and retired amount "1.5"
+      Then expect alice batch balance
+      """
+      {
+        "tradable_amount": "6.5",
+        "retired_amount": "0"
+      }
+      """
+      And expect bob batch balance
+      """
+      {
+        "tradable_amount": "2",
+        "retired_amount": "1.5"
+      }
+      """
+      And expect credit allowance of bob with properties
+      """
+      {
+        "amount": "1.5"
+      }
+      """
+
+    Scenario: the credit allowance is removed once used up
+      When bob attempts to send credits from alice with tradable amount "5"
+      Then expect alice batch balance
+      """
+      {
+        "tradable_amount": "5",
+        "retired_amount": "0"
+      }
+      """
+      And expect no credit allowance of bob
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: Event is emitted
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit batch with batch denom "C01-001-20200101-20210101-001"
+      And alice owns tradable credit amount "10"
+      And a credit allowance of bob with amount "5"
+
+    Scenario: EventTransfer is emitted
+      When bob attempts to send credits from alice with tradable amount "2" and retired amount "1.5"
+      Then expect event transfer with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "tradable_amount": "2",
+        "retired_amount": "1.5"
+      }
+      """
@@ -0,0 +1,269 @@
+Feature: Msg/SetClassIssuerQuota
+
+  The quota of an issuer of a credit class can be set or removed:
+  - when the credit class exists
+  - when the admin is the admin of the credit class
+  - when the issuer is an issuer of the credit class
+  - when the decimal places in amount does not exceed credit type precision
+  - when the quota exists if the quota is removed
+  - the quota of the issuer is set, updated or removed
+  - the credits issued by the issuer count towards the quota
+  - the issued amount is reset when the period of the quota ends
+  - the quota of the issuer is removed with the issuer
+
+  Rule: The credit class must exist
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and admin alice
+      And bob is an issuer of the credit class
+
+    Scenario: the credit class exists
+      When alice attempts to set the quota of bob with class id "C01" and amount "100"
+      Then expect no error
+
+    Scenario: the credit class does not exist
+      When alice attempts to set the quota of bob with class id "C02" and amount "100"
+      Then expect the error "could not get credit class with id C02: not found: invalid request"
+
+  Rule: The admin must be the admin of the credit class
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and admin alice
+      And bob is an issuer of the credit class
+
+    Scenario: the admin is the admin of the credit class
+      When alice attempts to set the quota of bob with class id "C01" and amount "100"
+      Then expect no error
+
+    Scenario: the admin is not the admin of the credit class
+      When bob attempts to set the quota of bob with class id "C01" and amount "100"
+      Then expect error contains "is not the admin of credit class C01: unauthorized"
+
+  Rule: The issuer must be an issuer of the credit class
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and admin alice
+
+    Scenario: the issuer is an issuer of the credit class
+      Given bob is an issuer of the credit class
+      When alice attempts to set the quota of bob with class id "C01" and amount "100"
+      Then expect no error
+
+    Scenario: the issuer is not an issuer of the credit class
+      When alice attempts to set the quota of bob with class id "C01" and amount "100"
+      Then expect error contains "is not an issuer for the class: unauthorized"
+
+  Rule: The decimal places in amount must not exceed credit type precision
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and admin alice
+      And bob is an issuer of the credit class
+
+    Scenario Outline: the decimal places in amount is less than or equal to credit type precision
+      When alice attempts to set the quota of bob with class id "C01" and amount "<amount>"
+      Then expect no error
+
+      Examples:
+        | description | amount     |
+        | no decimals | 100        |
+        | one decimal | 100.1      |
+        | six decimal | 100.123456 |
+
+    Scenario: the decimal places in amount is greater than credit type precision
+      When alice attempts to set the quota of bob with class id "C01" and amount "100.1234567"
+      Then expect error contains "exceeds maximum decimal places: 6"
+
+  Rule: The quota must exist if the quota is removed
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and admin alice
+      And bob is an issuer of the credit class
+
+    Scenario: the quota exists
+      Given a quota of bob with amount "100"
+      When alice attempts to remove the quota of bob with class id "C01"
+      Then expect no error
+
+    Scenario: the quota does not exist
+      When alice attempts to remove the quota of bob with class id "C01"
+      Then expect error contains "does not have a quota: not found"
+
+  Rule: The quota of the issuer is set, updated or removed
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and admin alice
+      And bob is an issuer of the credit class
+      And a project with project id "C01-001"
+
+    Scenario: the quota is set
+      When alice attempts to set the quota of bob with class id "C01" and amount "100"
+      Then expect quota of bob with properties
+      """
+      {
+        "amount": "100",
+        "issued_amount": "0",
+        "period_start": "2020-01-01T00:00:00Z"
+      }
+      """
+
+    Scenario: the quota is updated and the issued amount is kept
+      Given a quota of bob with amount "100"
+      And bob issued credits with tradable amount "60" and retired amount "0"
+      When alice attempts to set the quota of bob with class id "C01" and amount "150"
+      Then expect quota of bob with properties
+      """
+      {
+        "amount": "150",
+        "issued_amount": "60",
+        "period_start": "2020-01-01T00:00:00Z"
+      }
+      """
+
+    Scenario: the quota is updated with a new period and the issued amount is reset
+      Given a quota of bob with amount "100"
+      And bob issued credits with tradable amount "60" and retired amount "0"
+      And a block time with timestamp "2020-01-02"
+      When alice attempts to set the quota of bob with class id "C01" amount "150" and period "24h"
+      Then expect quota of bob with properties
+      """
+      {
+        "amount": "150",
+        "period": "86400s",
+        "issued_amount": "0",
+        "period_start": "2020-01-02T00:00:00Z"
+      }
+      """
+
+    Scenario: the quota is removed
+      Given a quota of bob with amount "100"
+      When alice attempts to remove the quota of bob with class id "C01"
+      Then expect no quota of bob
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: The credits issued by the issuer count towards the quota
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and admin alice
+      And alice is an issuer of the credit class
+      And bob is an issuer of the credit class
+      And a project with project id "C01-001"
+      And a quota of bob with amount "100"
+
+    Scenario Outline: the issuance does not exceed the remaining quota
+      When bob attempts to create a batch with tradable amount "<tradable>" and retired amount "<retired>"
+      Then expect no error
+      And expect quota of bob with properties
+      """
+      {
+        "amount": "100",
+        "issued_amount": "<issued>"
+      }
+      """
+
+      Examples:
+        | description          | tradable | retired | issued |
+        | tradable             | 60       | 0       | 60     |
+        | tradable and retired | 50       | 10      | 60     |
+        | equal to remaining   | 90       | 10      | 100    |
+
+    Scenario: the issuance exceeds the remaining quota
+      Given bob issued credits with tradable amount "50" and retired amount "10"
+      When bob attempts to create a batch with tradable amount "41" and retired amount "0"
+      Then expect error contains "issuance of 41 credits exceeds the remaining quota of 40 credits"
+
+    Scenario: the minted credits exceed the remaining quota
+      Given bob issued credits with tradable amount "90" and retired amount "0"
+      When bob attempts to mint credits with tradable amount "11"
+      Then expect error contains "issuance of 11 credits exceeds the remaining quota of 10 credits"
+
+    Scenario: the minted credits do not exceed the remaining quota
+      Given bob issued credits with tradable amount "90" and retired amount "0"
+      When bob attempts to mint credits with tradable amount "10"
+      Then expect no error
+      And expect quota of bob with properties
+      """
+      {
+        "amount": "100",
+        "issued_amount": "100"
+      }
+      """
+
+    Scenario: the issuer does not have a quota
+      When alice attempts to create a batch with tradable amount "1000000" and retired amount "0"
+      Then expect no error
+
+  Rule: The issued amount is reset when the period of the quota ends
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and admin alice
+      And bob is an issuer of the credit class
+      And a project with project id "C01-001"
+      And a quota of bob with amount "10" and period "48h"
+      And bob issued credits with tradable amount "10" and retired amount "0"
+
+    Scenario: the period of the quota has not ended
+      Given a block time with timestamp "2020-01-02"
+      When bob attempts to create a batch with tradable amount "1" and retired amount "0"
+      Then expect error contains "issuance of 1 credits exceeds the remaining quota of 0 credits"
+
+    Scenario: the period of the quota has ended
+      Given a block time with timestamp "2020-01-04"
+      When bob attempts to create a batch with tradable amount "4" and retired amount "0"
+      Then expect no error
+      And expect quota of bob with properties
+      """
+      {
+        "amount": "10",
+        "period": "172800s",
+        "issued_amount": "4",
+        "period_start": "2020-01-03T00:00:00Z"
+      }
+      """
+
+  Rule: The quota of the issuer is removed with the issuer
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and admin alice
+      And bob is an issuer of the credit class
+      And a quota of bob with amount "10"
+
+    Scenario: the issuer is removed
+      When alice attempts to remove issuer bob with class id "C01"
+      Then expect no quota of bob
+
+  Rule: Event is emitted
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and admin alice
+      And bob is an issuer of the credit class
+
+    Scenario: EventSetClassIssuerQuota is emitted when the quota is set
+      When alice attempts to set the quota of bob with class id "C01" and amount "100"
+      Then expect event with properties
+      """
+      {
+        "class_id": "C01",
+        "amount": "100"
+      }
+      """
+
+    Scenario: EventSetClassIssuerQuota is emitted when the quota is removed
+      Given a quota of bob with amount "100"
+      When alice attempts to remove the quota of bob with class id "C01"
+      Then expect event with properties
+      """
+      {
+        "class_id": "C01"
+      }
+      """
@@ -0,0 +1,99 @@
+Feature: Msg/SuspendBatch
+
+  A credit batch can be suspended:
+  - when the credit batch exists
+  - when the signer is the admin of the credit class or the governance account
+  - when the credit batch is not suspended
+  - the credit batch is suspended
+
+  Rule: The credit batch must exist
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20210101-001"
+
+    Scenario: the credit batch exists
+      When alice attempts to suspend batch with denom "C01-001-20200101-20210101-001"
+      Then expect no error
+
+    Scenario: the credit batch does not exist
+      When alice attempts to suspend batch with denom "C01-001-20200101-20210101-002"
+      Then expect the error "could not get batch with denom C01-001-20200101-20210101-002: not found: invalid request"
+
+  Rule: The signer must be the admin of the credit class or the governance account
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20210101-001"
+
+    Scenario: the signer is the admin of the credit class
+      When alice attempts to suspend batch with denom "C01-001-20200101-20210101-001"
+      Then expect no error
+
+    Scenario: the signer is the governance account
+      When the governance account attempts to suspend batch with denom "C01-001-20200101-20210101-001"
+      Then expect no error
+
+    Scenario: the signer is not the admin of the credit class or the governance account
+      When bob attempts to suspend batch with denom "C01-001-20200101-20210101-001"
+      Then expect error contains "is not the admin of credit class C01 or the governance account: unauthorized"
+
+  Rule: The credit batch must not be suspended
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20210101-001"
+
+    Scenario: the credit batch is not suspended
+      When alice attempts to suspend batch with denom "C01-001-20200101-20210101-001"
+      Then expect no error
+
+    Scenario: the credit batch is suspended
+      Given the credit batch is suspended
+      When alice attempts to suspend batch with denom "C01-001-20200101-20210101-001"
+      Then expect the error "credit batch C01-001-20200101-20210101-001 is already suspended: invalid request"
+
+  Rule: The credit batch is suspended
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20210101-001"
+
+    Scenario: the credit batch is suspended
+      When alice attempts to suspend batch with denom "C01-001-20200101-20210101-001"
+      Then expect credit batch with properties
+      """
+      {
+        "open": true,
+        "suspended": true,
+        "invalidated": false
+      }
+      """
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: Event is emitted
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20210101-001"
+
+    Scenario: EventSuspendBatch is emitted
+      When alice attempts to suspend batch with denom "C01-001-20200101-20210101-001" and reason "over-issuance"
+      Then expect event with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "reason": "over-issuance"
+      }
+      """
@@ -0,0 +1,173 @@
+Feature: Msg/UpdateClassBuffer
+
+  The buffer of a credit class can be set or removed:
+  - when the credit class exists
+  - when the admin is the admin of the credit class
+  - the buffer percentage and the buffer account of the credit class are updated
+  - the buffer percentage of issued credits is withheld in the buffer account
+  - the buffer percentage of minted credits is withheld in the buffer account
+
+  Rule: The credit class must exist
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and admin and issuer alice
+
+    Scenario: the credit class exists
+      When alice attempts to update the class buffer with class id "C01" and buffer percentage "10"
+      Then expect no error
+
+    Scenario: the credit class does not exist
+      When alice attempts to update the class buffer with class id "C02" and buffer percentage "10"
+      Then expect the error "could not get credit class with id C02: not found: invalid request"
+
+  Rule: The admin must be the admin of the credit class
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and admin and issuer alice
+
+    Scenario: the admin is the admin of the credit class
+      When alice attempts to update the class buffer with class id "C01" and buffer percentage "10"
+      Then expect no error
+
+    Scenario: the admin is not the admin of the credit class
+      When bob attempts to update the class buffer with class id "C01" and buffer percentage "10"
+      Then expect error contains "is not the admin of credit class C01: unauthorized"
+
+  Rule: The buffer of the credit class is updated
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and admin and issuer alice
+
+    Scenario: the buffer is set
+      When alice attempts to update the class buffer with class id "C01" and buffer percentage "10"
+      Then expect credit class with class id "C01" and buffer percentage "10"
+      And expect credit class with class id "C01" and a buffer account
+
+    Scenario: the buffer is removed
+      Given a class buffer with buffer percentage "10"
+      When alice attempts to update the class buffer with class id "C01" and buffer percentage ""
+      Then expect credit class with class id "C01" and buffer percentage ""
+      And expect credit class with class id "C01" and no buffer account
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: The buffer percentage of issued credits is withheld in the buffer account
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and admin and issuer alice
+      And a project with project id "C01-001"
+
+    Scenario: the buffer is withheld from tradable and retired credits
+      Given a class buffer with buffer percentage "10"
+      When alice attempts to create a batch with tradable amount "10" and retired amount "5"
+      Then expect bob batch balance
+      """
+      {
+        "tradable_amount": "9",
+        "retired_amount": "4.5"
+      }
+      """
+      And expect buffer account batch balance
+      """
+      {
+        "tradable_amount": "1.5",
+        "retired_amount": "0"
+      }
+      """
+      And expect batch supply
+      """
+      {
+        "tradable_amount": "10.5",
+        "retired_amount": "4.5"
+      }
+      """
+
+    Scenario: the buffer is rounded down to the credit type precision
+      Given a class buffer with buffer percentage "10"
+      When alice attempts to create a batch with tradable amount "10.000005" and retired amount "0"
+      Then expect bob batch balance
+      """
+      {
+        "tradable_amount": "9.000005",
+        "retired_amount": "0"
+      }
+      """
+      And expect buffer account batch balance
+      """
+      {
+        "tradable_amount": "1",
+        "retired_amount": "0"
+      }
+      """
+
+    Scenario: the credit class does not have a buffer
+      When alice attempts to create a batch with tradable amount "10" and retired amount "0"
+      Then expect bob batch balance
+      """
+      {
+        "tradable_amount": "10",
+        "retired_amount": "0"
+      }
+      """
+      And expect no buffer account batch balance
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: The buffer percentage of minted credits is withheld in the buffer account
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and admin and issuer alice
+      And a project with project id "C01-001"
+      And a class buffer with buffer percentage "10"
+      And alice created a batch with tradable amount "10" and retired amount "0"
+
+    Scenario: the buffer is withheld from minted credits
+      When alice attempts to mint credits with tradable amount "20" and retired amount "30"
+      Then expect bob batch balance
+      """
+      {
+        "tradable_amount": "27",
+        "retired_amount": "27"
+      }
+      """
+      And expect buffer account batch balance
+      """
+      {
+        "tradable_amount": "6",
+        "retired_amount": "0"
+      }
+      """
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: Event is emitted
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and admin and issuer alice
+
+    Scenario: EventUpdateClassBuffer is emitted when the buffer is set
+      When alice attempts to update the class buffer with class id "C01" and buffer percentage "10"
+      Then expect event with properties
+      """
+      {
+        "class_id": "C01",
+        "buffer_percentage": "10",
+        "buffer_account": "regen100zn5s5nerkhk2amh942ekussq4vzl9lrcc9zw0y8kv03ezuk28ssevjk2"
+      }
+      """
+
+    Scenario: EventUpdateClassBuffer is emitted when the buffer is removed
+      Given a class buffer with buffer percentage "10"
+      When alice attempts to update the class buffer with class id "C01" and buffer percentage ""
+      Then expect event with properties
+      """
+      {
+        "class_id": "C01"
+      }
+      """
@@ -0,0 +1,67 @@
+Feature: Msg/UpdateClassIssuanceThreshold
+
+  The issuance threshold of a credit class can be updated:
+  - when the credit class exists
+  - when the admin is the admin of the credit class
+  - the issuance threshold of the credit class is updated
+
+  Rule: The credit class must exist
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+
+    Scenario: the credit class exists
+      When alice attempts to update the issuance threshold with class id "C01" and threshold "2"
+      Then expect no error
+
+    Scenario: the credit class does not exist
+      When alice attempts to update the issuance threshold with class id "C02" and threshold "2"
+      Then expect the error "could not get credit class with id C02: not found: invalid request"
+
+  Rule: The admin must be the admin of the credit class
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+
+    Scenario: the admin is the admin of the credit class
+      When alice attempts to update the issuance threshold with class id "C01" and threshold "2"
+      Then expect no error
+
+    Scenario: the admin is not the admin of the credit class
+      When bob attempts to update the issuance threshold with class id "C01" and threshold "2"
+      Then expect error contains "is not the admin of credit class C01: unauthorized"
+
+  Rule: The issuance threshold of the credit class is updated
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+
+    Scenario Outline: the issuance threshold is updated
+      When alice attempts to update the issuance threshold with class id "C01" and threshold "<threshold>"
+      Then expect credit class with class id "C01" and issuance threshold "<threshold>"
+
+      Examples:
+        | description      | threshold |
+        | threshold set    | 2         |
+        | threshold remove | 0         |
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: Event is emitted
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+
+    Scenario: EventUpdateClassIssuanceThreshold is emitted
+      When alice attempts to update the issuance threshold with class id "C01" and threshold "2"
+      Then expect event with properties
+      """
+      {
+        "class_id": "C01",
+        "issuance_threshold": 2
+      }
+      """
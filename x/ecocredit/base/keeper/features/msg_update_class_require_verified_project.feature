@@ -0,0 +1,67 @@
+Feature: Msg/UpdateClassRequireVerifiedProject
+
+  The requirement of a verified project for issuing credits in a credit class can be updated:
+  - when the credit class exists
+  - when the admin is the admin of the credit class
+  - the requirement of the credit class is updated
+
+  Rule: The credit class must exist
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+
+    Scenario: the credit class exists
+      When alice attempts to require a verified project with class id "C01"
+      Then expect no error
+
+    Scenario: the credit class does not exist
+      When alice attempts to require a verified project with class id "C02"
+      Then expect the error "could not get credit class with id C02: not found: invalid request"
+
+  Rule: The admin must be the admin of the credit class
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+
+    Scenario: the admin is the admin of the credit class
+      When alice attempts to require a verified project with class id "C01"
+      Then expect no error
+
+    Scenario: the admin is not the admin of the credit class
+      When bob attempts to require a verified project with class id "C01"
+      Then expect error contains "is not the admin of credit class C01: unauthorized"
+
+  Rule: The requirement of the credit class is updated
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+
+    Scenario: a verified project is required
+      When alice attempts to require a verified project with class id "C01"
+      Then expect credit class with class id "C01" and require verified project "true"
+
+    Scenario: a verified project is no longer required
+      Given alice attempts to require a verified project with class id "C01"
+      When alice attempts to remove the verified project requirement with class id "C01"
+      Then expect credit class with class id "C01" and require verified project "false"
+
+      # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: Event is emitted
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+
+    Scenario: EventUpdateClassRequireVerifiedProject is emitted
+      When alice attempts to require a verified project with class id "C01"
+      Then expect event with properties
+      """
+      {
+        "class_id": "C01",
+        "require_verified_project": true
+      }
+      """
@@ -0,0 +1,111 @@
+Feature: Msg/UpdateProjectStatus
+
+  The status of a project can be updated:
+  - when the project exists
+  - when the issuer is an issuer of the credit class of the project
+  - when the project can be updated from the current status to the new status
+  - the status and the status iri of the project are updated
+
+  Rule: The project must exist
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and issuer alice
+      And a project with id "C01-001" and status "PROJECT_STATUS_PROPOSED"
+
+    Scenario: the project exists
+      When alice attempts to update the status of project "C01-001" to "PROJECT_STATUS_REGISTERED"
+      Then expect no error
+
+    Scenario: the project does not exist
+      When alice attempts to update the status of project "C01-002" to "PROJECT_STATUS_REGISTERED"
+      Then expect the error "could not get project with id C01-002: not found: invalid request"
+
+  Rule: The issuer must be an issuer of the credit class of the project
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and issuer alice
+      And a project with id "C01-001" and status "PROJECT_STATUS_PROPOSED"
+
+    Scenario: the issuer is an issuer of the credit class
+      When alice attempts to update the status of project "C01-001" to "PROJECT_STATUS_REGISTERED"
+      Then expect no error
+
+    Scenario: the issuer is not an issuer of the credit class
+      When bob attempts to update the status of project "C01-001" to "PROJECT_STATUS_REGISTERED"
+      Then expect error contains "is not an issuer for the class: unauthorized"
+
+  Rule: The project must be able to be updated from the current status to the new status
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and issuer alice
+
+    Scenario Outline: the status can be updated
+      Given a project with id "C01-001" and status "<old_status>"
+      When alice attempts to update the status of project "C01-001" to "<new_status>"
+      Then expect no error
+
+      Examples:
+        | old_status                 | new_status                 |
+        | PROJECT_STATUS_UNSPECIFIED | PROJECT_STATUS_VERIFIED    |
+        | PROJECT_STATUS_PROPOSED    | PROJECT_STATUS_REGISTERED  |
+        | PROJECT_STATUS_PROPOSED    | PROJECT_STATUS_SUSPENDED   |
+        | PROJECT_STATUS_REGISTERED  | PROJECT_STATUS_VERIFIED    |
+        | PROJECT_STATUS_REGISTERED  | PROJECT_STATUS_COMPLETED   |
+        | PROJECT_STATUS_VERIFIED    | PROJECT_STATUS_REGISTERED  |
+        | PROJECT_STATUS_VERIFIED    | PROJECT_STATUS_SUSPENDED   |
+        | PROJECT_STATUS_SUSPENDED   | PROJECT_STATUS_VERIFIED    |
+        | PROJECT_STATUS_SUSPENDED   | PROJECT_STATUS_COMPLETED   |
+
+    Scenario Outline: the status cannot be updated
+      Given a project with id "C01-001" and status "<old_status>"
+      When alice attempts to update the status of project "C01-001" to "<new_status>"
+      Then expect the error "cannot update project status from <old_status> to <new_status>: invalid request"
+
+      Examples:
+        | old_status                | new_status                |
+        | PROJECT_STATUS_PROPOSED   | PROJECT_STATUS_VERIFIED   |
+        | PROJECT_STATUS_PROPOSED   | PROJECT_STATUS_COMPLETED  |
+        | PROJECT_STATUS_REGISTERED | PROJECT_STATUS_PROPOSED   |
+        | PROJECT_STATUS_VERIFIED   | PROJECT_STATUS_PROPOSED   |
+        | PROJECT_STATUS_COMPLETED  | PROJECT_STATUS_SUSPENDED  |
+        | PROJECT_STATUS_COMPLETED  | PROJECT_STATUS_VERIFIED   |
+
+  Rule: The status and the status iri of the project are updated
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and issuer alice
+      And a project with id "C01-001" and status "PROJECT_STATUS_REGISTERED"
+
+    Scenario: the status and the status iri are updated
+      When alice attempts to update the status of project "C01-001" to "PROJECT_STATUS_VERIFIED" with iri "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGxGREWGKjEdXYG.rdf"
+      Then expect project "C01-001" with status "PROJECT_STATUS_VERIFIED" and iri "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGxGREWGKjEdXYG.rdf"
+
+    Scenario: the status iri is removed when not provided
+      Given alice attempts to update the status of project "C01-001" to "PROJECT_STATUS_VERIFIED" with iri "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGxGREWGKjEdXYG.rdf"
+      When alice attempts to update the status of project "C01-001" to "PROJECT_STATUS_COMPLETED"
+      Then expect project "C01-001" with status "PROJECT_STATUS_COMPLETED" and iri ""
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: Event is emitted
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and issuer alice
+      And a project with id "C01-001" and status "PROJECT_STATUS_REGISTERED"
+
+    Scenario: EventUpdateProjectStatus is emitted
+      When alice attempts to update the status of project "C01-001" to "PROJECT_STATUS_VERIFIED" with iri "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGxGREWGKjEdXYG.rdf"
+      Then expect event with properties
+      """
+      {
+        "project_id": "C01-001",
+        "old_status": "PROJECT_STATUS_REGISTERED",
+        "new_status": "PROJECT_STATUS_VERIFIED",
+        "iri": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGxGREWGKjEdXYG.rdf"
+      }
+      """
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// SetHooks sets the ecocredit hooks called when credits are issued, moved or
+// when a credit batch is sealed.
+func (k *Keeper) SetHooks(hooks ecocredit.EcocreditHooks) *Keeper {
+	k.hooks.Set(hooks)
+	return k
+}
+
+// MarketplaceHooks defines the callbacks of the marketplace submodule that are
+// called when the state of a credit batch changes so that the marketplace can
+// update the orders of the credit batch, and when credits are issued so that
+// the marketplace can deliver them towards forward contracts.
+type MarketplaceHooks interface {
+	// AfterBatchSuspended is called after a credit batch has been suspended,
+	// including when the credit batch has been invalidated.
+	AfterBatchSuspended(ctx context.Context, batch *api.Batch) error
+
+	// AfterBatchUnsuspended is called after a credit batch has been unsuspended.
+	AfterBatchUnsuspended(ctx context.Context, batch *api.Batch) error
+
+	// AfterBatchCreditsIssued is called after credits of a credit batch have
+	// been issued to a recipient, either when the credit batch is created or
+	// when credits are minted to an open credit batch.
+	AfterBatchCreditsIssued(ctx context.Context, batch *api.Batch, recipient sdk.AccAddress, tradable, retired math.Dec) error
+}
+
+// SetMarketplaceHooks sets the marketplace hooks called when the state of a
+// credit batch changes or when credits are issued.
+func (k *Keeper) SetMarketplaceHooks(hooks MarketplaceHooks) *Keeper {
+	if k.marketplaceHooks != nil {
+		panic("cannot set marketplace hooks twice")
+	}
+	k.marketplaceHooks = hooks
+	return k
+}
+
+func (k Keeper) afterBatchSuspended(ctx context.Context, batch *api.Batch) error {
+	if k.marketplaceHooks == nil {
+		return nil
+	}
+	return k.marketplaceHooks.AfterBatchSuspended(ctx, batch)
+}
+
+func (k Keeper) afterBatchUnsuspended(ctx context.Context, batch *api.Batch) error {
+	if k.marketplaceHooks == nil {
+		return nil
+	}
+	return k.marketplaceHooks.AfterBatchUnsuspended(ctx, batch)
+}
+
+func (k Keeper) afterBatchCreditsIssued(ctx context.Context, batch *api.Batch, recipient sdk.AccAddress, tradable, retired math.Dec) error {
+	if k.marketplaceHooks == nil {
+		return nil
+	}
+	return k.marketplaceHooks.AfterBatchCreditsIssued(ctx, batch, recipient, tradable, retired)
+}
@@ -0,0 +1,213 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/mocks"
+)
+
+// setupHooks sets up a credit class with an issuer and a project and sets
+// hooks recording the calls of the hooks.
+func setupHooks(t *testing.T) (*baseSuite, *mocks.Hooks) {
+	s := setupBase(t)
+
+	require.NoError(t, s.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: "C",
+		Name:         "carbon",
+		Unit:         "metric ton C02",
+		Precision:    6,
+	}))
+	classKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               testClassID,
+		Admin:            s.addr,
+		CreditTypeAbbrev: "C",
+	})
+	require.NoError(t, err)
+	require.NoError(t, s.stateStore.ClassIssuerTable().Insert(s.ctx, &api.ClassIssuer{
+		ClassKey: classKey,
+		Issuer:   s.addr,
+	}))
+	require.NoError(t, s.stateStore.ProjectTable().Insert(s.ctx, &api.Project{
+		Id:       testProjectID,
+		ClassKey: classKey,
+	}))
+
+	hooks := &mocks.Hooks{}
+	s.k.SetHooks(hooks)
+
+	return s, hooks
+}
+
+// createBatch creates an open credit batch issued by the first test address with
+// the credits issued to the second test address.
+func (s *baseSuite) createBatch(tradableAmount, retiredAmount string) error {
+	startDate := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, err := s.k.CreateBatch(s.ctx, &types.MsgCreateBatch{
+		Issuer:    s.addr.String(),
+		ProjectId: testProjectID,
+		Issuance: []*types.BatchIssuance{
+			{
+				Recipient:              s.addr2.String(),
+				TradableAmount:         tradableAmount,
+				RetiredAmount:          retiredAmount,
+				RetirementJurisdiction: "US-WA",
+			},
+		},
+		StartDate: &startDate,
+		EndDate:   &endDate,
+		Open:      true,
+	})
+	return err
+}
+
+func TestHooks(t *testing.T) {
+	t.Parallel()
+	s, hooks := setupHooks(t)
+
+	require.NoError(t, s.createBatch("10", "1"))
+	require.Equal(t, []string{
+		fmt.Sprintf("issued %s %s 10 1", testBatchDenom, s.addr2),
+		fmt.Sprintf(`retired %s %s 1 1 US-WA "" ""`, testBatchDenom, s.addr2),
+	}, hooks.Calls)
+
+	hooks.Calls = nil
+	_, err := s.k.Send(s.ctx, &types.MsgSend{
+		Sender:    s.addr2.String(),
+		Recipient: s.addr.String(),
+		Credits: []*types.MsgSend_SendCredits{
+			{
+				BatchDenom:             testBatchDenom,
+				TradableAmount:         "3",
+				RetiredAmount:          "2",
+				RetirementJurisdiction: "US-WA",
+				RetirementReason:       "offsetting electricity consumption",
+				RetirementBeneficiary:  "Regen Network",
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		fmt.Sprintf("transferred %s %s %s 3 2", testBatchDenom, s.addr2, s.addr),
+		fmt.Sprintf(`retired %s %s 2 2 US-WA "offsetting electricity consumption" "Regen Network"`, testBatchDenom, s.addr),
+	}, hooks.Calls)
+
+	hooks.Calls = nil
+	_, err = s.k.Retire(s.ctx, &types.MsgRetire{
+		Owner:        s.addr2.String(),
+		Credits:      []*types.Credits{{BatchDenom: testBatchDenom, Amount: "1"}},
+		Jurisdiction: "US-OR",
+		Reason:       "offsetting travel emissions",
+		Beneficiary:  "Regen Foundation",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		fmt.Sprintf(`retired %s %s 1 3 US-OR "offsetting travel emissions" "Regen Foundation"`, testBatchDenom, s.addr2),
+	}, hooks.Calls)
+
+	hooks.Calls = nil
+	_, err = s.k.Cancel(s.ctx, &types.MsgCancel{
+		Owner:   s.addr2.String(),
+		Credits: []*types.Credits{{BatchDenom: testBatchDenom, Amount: "1"}},
+		Reason:  "transferring credits to another registry",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		fmt.Sprintf("cancelled %s %s 1", testBatchDenom, s.addr2),
+	}, hooks.Calls)
+
+	hooks.Calls = nil
+	_, err = s.k.SealBatch(s.ctx, &types.MsgSealBatch{
+		Issuer:     s.addr.String(),
+		BatchDenom: testBatchDenom,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		fmt.Sprintf("sealed %s", testBatchDenom),
+	}, hooks.Calls)
+}
+
+func TestHooks_Error(t *testing.T) {
+	t.Parallel()
+	s, hooks := setupHooks(t)
+	hooks.Err = fmt.Errorf("hook failed")
+
+	// an error returned by a hook fails the message
+	require.EqualError(t, s.createBatch("10", "0"), "hook failed")
+
+	require.Panics(t, func() {
+		s.k.SetHooks(&mocks.Hooks{})
+	})
+}
+
+// marketplaceHooks records the calls of the marketplace hooks. It cannot be
+// defined in the mocks package because the hooks interface is defined in this
+// package.
+type marketplaceHooks struct {
+	calls []string
+}
+
+var _ MarketplaceHooks = &marketplaceHooks{}
+
+func (h *marketplaceHooks) AfterBatchSuspended(_ context.Context, batch *api.Batch) error {
+	h.calls = append(h.calls, fmt.Sprintf("suspended %s", batch.Denom))
+	return nil
+}
+
+func (h *marketplaceHooks) AfterBatchUnsuspended(_ context.Context, batch *api.Batch) error {
+	h.calls = append(h.calls, fmt.Sprintf("unsuspended %s", batch.Denom))
+	return nil
+}
+
+func (h *marketplaceHooks) AfterBatchCreditsIssued(_ context.Context, batch *api.Batch, recipient sdk.AccAddress, tradable, retired math.Dec) error {
+	h.calls = append(h.calls, fmt.Sprintf("issued %s %s %s %s", batch.Denom, recipient, tradable, retired))
+	return nil
+}
+
+func TestMarketplaceHooks_CreditsIssued(t *testing.T) {
+	t.Parallel()
+	s, _ := setupHooks(t)
+	hooks := &marketplaceHooks{}
+	s.k.SetMarketplaceHooks(hooks)
+
+	require.NoError(t, s.createBatch("10", "1"))
+	require.Equal(t, []string{
+		fmt.Sprintf("issued %s %s 10 1", testBatchDenom, s.addr2),
+	}, hooks.calls)
+
+	hooks.calls = nil
+	_, err := s.k.MintBatchCredits(s.ctx, &types.MsgMintBatchCredits{
+		Issuer:     s.addr.String(),
+		BatchDenom: testBatchDenom,
+		Issuance: []*types.BatchIssuance{
+			{
+				Recipient:              s.addr2.String(),
+				TradableAmount:         "5",
+				RetiredAmount:          "2",
+				RetirementJurisdiction: "US-WA",
+			},
+		},
+		OriginTx: &types.OriginTx{
+			Id:     "0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e",
+			Source: "polygon",
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		fmt.Sprintf("issued %s %s 5 2", testBatchDenom, s.addr2),
+	}, hooks.calls)
+
+	require.Panics(t, func() {
+		s.k.SetMarketplaceHooks(&marketplaceHooks{})
+	})
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/types/math"
 )
@@ -109,3 +111,76 @@ func BatchSupplyInvariant(ctx context.Context, k Keeper, basketBalances map[uint
 
 	return msg, broken
 }
+
+// RetiredSupplyInvariant checks
+// - the retirements recorded for each account and credit batch do not exceed
+// the retired balance of the account
+//
+// Retired balances of credits retired before retirements were recorded are
+// not backed by a retirement, so the recorded retirements may be less than
+// the retired balance.
+func RetiredSupplyInvariant(ctx context.Context, k Keeper) (msg string, broken bool) {
+	type balanceKey struct {
+		owner    string
+		batchKey uint64
+	}
+	retired := make(map[balanceKey]math.Dec)
+	var keys []balanceKey
+
+	itr, err := k.stateStore.RetirementTable().List(ctx, api.RetirementPrimaryKey{})
+	if err != nil {
+		return err.Error(), true
+	}
+	defer itr.Close()
+
+	for itr.Next() {
+		retirement, err := itr.Value()
+		if err != nil {
+			return err.Error(), true
+		}
+
+		amount, err := math.NewNonNegativeDecFromString(retirement.Amount)
+		if err != nil {
+			broken = true
+			msg += fmt.Sprintf("\terror while parsing amount of retirement %d: %v\n", retirement.Id, err)
+			continue
+		}
+
+		key := balanceKey{owner: string(retirement.Owner), batchKey: retirement.BatchKey}
+		if total, ok := retired[key]; ok {
+			if amount, err = math.SafeAddBalance(total, amount); err != nil {
+				broken = true
+				msg += fmt.Sprintf("\terror adding amount of retirement %d: %v\n", retirement.Id, err)
+				continue
+			}
+		} else {
+			keys = append(keys, key)
+		}
+		retired[key] = amount
+	}
+
+	for _, key := range keys {
+		owner := sdk.AccAddress(key.owner)
+		balance, err := k.stateStore.BatchBalanceTable().Get(ctx, owner, key.batchKey)
+		if err != nil {
+			broken = true
+			msg += fmt.Sprintf("\tretired balance of %s is not found for %d credit batch\n", owner, key.batchKey)
+			continue
+		}
+
+		retiredBalance, err := math.NewNonNegativeDecFromString(balance.RetiredAmount)
+		if err != nil {
+			broken = true
+			msg += fmt.Sprintf("\terror while parsing retired balance of %s for %d credit batch: %v\n", owner, key.batchKey, err)
+			continue
+		}
+
+		if retired[key].Cmp(retiredBalance) == math.GreaterThan {
+			broken = true
+			msg += fmt.Sprintf("\tretirements of %s exceed the retired balance for %d credit batch, expected at most %v, got %v\n",
+				owner, key.batchKey, retiredBalance, retired[key])
+		}
+	}
+
+	return msg, broken
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"github.com/cosmos/cosmos-sdk/orm/model/ormdb"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormtable"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	basketapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
@@ -17,19 +19,35 @@ var (
 
 type Keeper struct {
 	stateStore    api.StateStore
+	accountKeeper ecocredit.AccountKeeper
 	bankKeeper    ecocredit.BankKeeper
 	moduleAddress sdk.AccAddress
 
 	basketStore basketapi.StateStore
 	marketStore marketplaceapi.StateStore
 
+	// projectStatusIndex is the status index of the project table. The index is
+	// listed directly because the generated index key passes the status as an
+	// enum type, which the ORM cannot encode, rather than as an enum number.
+	projectStatusIndex ormtable.Index
+
 	// the address capable of executing ecocredit params messages. Typically, this
 	// should be the x/gov module account.
 	authority sdk.AccAddress
+
+	// hooks are called when credits are issued, moved or when a credit batch is
+	// sealed. If nil, no hooks are called.
+	hooks ecocredit.KeeperHooks
+
+	// marketplaceHooks are called when the state of a credit batch changes. If
+	// nil, no marketplace hooks are called.
+	marketplaceHooks MarketplaceHooks
 }
 
 func NewKeeper(
+	db ormdb.ModuleDB,
 	ss api.StateStore,
+	ak ecocredit.AccountKeeper,
 	bk ecocredit.BankKeeper,
 	ma sdk.AccAddress,
 	basketStore basketapi.StateStore,
@@ -37,11 +55,13 @@ func NewKeeper(
 	authority sdk.AccAddress,
 ) Keeper {
 	return Keeper{
-		stateStore:    ss,
-		bankKeeper:    bk,
-		moduleAddress: ma,
-		basketStore:   basketStore,
-		authority:     authority,
-		marketStore:   marketStore,
+		stateStore:         ss,
+		accountKeeper:      ak,
+		bankKeeper:         bk,
+		moduleAddress:      ma,
+		basketStore:        basketStore,
+		authority:          authority,
+		marketStore:        marketStore,
+		projectStatusIndex: db.GetTable(&api.Project{}).GetIndex("status"),
 	}
 }
@@ -36,18 +36,19 @@ const (
 )
 
 type baseSuite struct {
-	t          gocuke.TestingT
-	db         ormdb.ModuleDB
-	stateStore api.StateStore
-	ctx        context.Context
-	k          Keeper
-	ctrl       *gomock.Controller
-	addr       sdk.AccAddress
-	addr2      sdk.AccAddress
-	bankKeeper *mocks.MockBankKeeper
-	storeKey   *storetypes.KVStoreKey
-	sdkCtx     sdk.Context
-	authority  sdk.AccAddress
+	t             gocuke.TestingT
+	db            ormdb.ModuleDB
+	stateStore    api.StateStore
+	ctx           context.Context
+	k             Keeper
+	ctrl          *gomock.Controller
+	addr          sdk.AccAddress
+	addr2         sdk.AccAddress
+	bankKeeper    *mocks.MockBankKeeper
+	accountKeeper *mocks.MockAccountKeeper
+	storeKey      *storetypes.KVStoreKey
+	sdkCtx        sdk.Context
+	authority     sdk.AccAddress
 }
 
 func setupBase(t gocuke.TestingT) *baseSuite {
@@ -72,6 +73,7 @@ func setupBase(t gocuke.TestingT) *baseSuite {
 	s.ctrl = gomock.NewController(t)
 	assert.NilError(t, err)
 	s.bankKeeper = mocks.NewMockBankKeeper(s.ctrl)
+	s.accountKeeper = mocks.NewMockAccountKeeper(s.ctrl)
 
 	_, _, moduleAddress := testdata.KeyTestPubAddr()
 	s.authority, err = sdk.AccAddressFromBech32("regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68")
@@ -83,7 +85,7 @@ func setupBase(t gocuke.TestingT) *baseSuite {
 	marketStore, err := marketplaceapi.NewStateStore(s.db)
 	assert.NilError(t, err)
 
-	s.k = NewKeeper(s.stateStore, s.bankKeeper, moduleAddress, basketStore, marketStore, s.authority)
+	s.k = NewKeeper(s.db, s.stateStore, s.accountKeeper, s.bankKeeper, moduleAddress, basketStore, marketStore, s.authority)
 	_, _, s.addr = testdata.KeyTestPubAddr()
 	_, _, s.addr2 = testdata.KeyTestPubAddr()
 
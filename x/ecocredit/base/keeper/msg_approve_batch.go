@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// ApproveBatch approves a pending batch and creates the credit batch if the
+// approval meets the issuance threshold of the credit class. Only issuers of
+// the credit class can approve a pending batch.
+func (k Keeper) ApproveBatch(ctx context.Context, req *types.MsgApproveBatch) (*types.MsgApproveBatchResponse, error) {
+	approver, err := sdk.AccAddressFromBech32(req.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	pendingBatch, err := k.stateStore.PendingBatchTable().Get(ctx, req.PendingBatchId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"could not get pending batch with id %d: %s", req.PendingBatchId, err,
+		)
+	}
+
+	if err = k.assertClassIssuer(ctx, pendingBatch.ClassKey, approver); err != nil {
+		return nil, err
+	}
+
+	if err = k.stateStore.PendingBatchApprovalTable().Insert(ctx, &api.PendingBatchApproval{
+		PendingBatchId: pendingBatch.Id,
+		Approver:       approver,
+	}); err != nil {
+		if ormerrors.AlreadyExists.Is(err) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"%s has already approved pending batch %d", req.Issuer, req.PendingBatchId,
+			)
+		}
+		return nil, err
+	}
+
+	if err = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventApproveBatch{
+		PendingBatchId: pendingBatch.Id,
+		Approver:       req.Issuer,
+	}); err != nil {
+		return nil, err
+	}
+
+	batchDenom, err := k.createPendingBatchIfApproved(ctx, pendingBatch)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgApproveBatchResponse{BatchDenom: batchDenom}, nil
+}
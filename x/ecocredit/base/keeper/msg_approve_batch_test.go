@@ -0,0 +1,298 @@
+//nolint:revive,stylecheck
+package keeper
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type approveBatch struct {
+	*baseSuite
+	alice    sdk.AccAddress
+	bob      sdk.AccAddress
+	carol    sdk.AccAddress
+	classKey uint64
+	res      *types.MsgApproveBatchResponse
+	err      error
+}
+
+func TestApproveBatch(t *testing.T) {
+	gocuke.NewRunner(t, &approveBatch{}).Path("./features/msg_approve_batch.feature").Run()
+}
+
+func (s *approveBatch) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+	_, _, s.carol = testdata.KeyTestPubAddr()
+}
+
+func (s *approveBatch) ACreditTypeWithAbbreviationAndPrecision(a, b string) {
+	precision, err := strconv.ParseUint(b, 10, 32)
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: a,
+		Name:         a,
+		Precision:    uint32(precision),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *approveBatch) ACreditClassWithClassIdAndIssuersAliceAndBob(a string) {
+	creditTypeAbbrev := base.GetCreditTypeAbbrevFromClassID(a)
+
+	cKey, err := s.k.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               a,
+		Admin:            s.alice,
+		CreditTypeAbbrev: creditTypeAbbrev,
+	})
+	require.NoError(s.t, err)
+
+	for _, issuer := range []sdk.AccAddress{s.alice, s.bob} {
+		err = s.k.stateStore.ClassIssuerTable().Insert(s.ctx, &api.ClassIssuer{
+			ClassKey: cKey,
+			Issuer:   issuer,
+		})
+		require.NoError(s.t, err)
+	}
+
+	s.classKey = cKey
+}
+
+func (s *approveBatch) CarolIsAnIssuerOfTheCreditClass() {
+	err := s.k.stateStore.ClassIssuerTable().Insert(s.ctx, &api.ClassIssuer{
+		ClassKey: s.classKey,
+		Issuer:   s.carol,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *approveBatch) BobIsRemovedAsAnIssuerOfTheCreditClass() {
+	class, err := s.k.stateStore.ClassTable().Get(s.ctx, s.classKey)
+	require.NoError(s.t, err)
+
+	_, err = s.k.UpdateClassIssuers(s.ctx, &types.MsgUpdateClassIssuers{
+		Admin:         s.alice.String(),
+		ClassId:       class.Id,
+		RemoveIssuers: []string{s.bob.String()},
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *approveBatch) TheCreditClassHasAnIssuanceThresholdOf(a string) {
+	threshold, err := strconv.ParseUint(a, 10, 32)
+	require.NoError(s.t, err)
+
+	class, err := s.k.stateStore.ClassTable().Get(s.ctx, s.classKey)
+	require.NoError(s.t, err)
+
+	class.IssuanceThreshold = uint32(threshold)
+	require.NoError(s.t, s.k.stateStore.ClassTable().Update(s.ctx, class))
+}
+
+func (s *approveBatch) TheCreditClassRequiresAVerifiedProject() {
+	class, err := s.k.stateStore.ClassTable().Get(s.ctx, s.classKey)
+	require.NoError(s.t, err)
+
+	class.RequireVerifiedProject = true
+	require.NoError(s.t, s.k.stateStore.ClassTable().Update(s.ctx, class))
+}
+
+func (s *approveBatch) AProjectWithProjectId(a string) {
+	err := s.k.stateStore.ProjectTable().Insert(s.ctx, &api.Project{
+		Id:       a,
+		ClassKey: s.classKey,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *approveBatch) AProjectWithProjectIdAndStatus(a, b string) {
+	err := s.k.stateStore.ProjectTable().Insert(s.ctx, &api.Project{
+		Id:       a,
+		ClassKey: s.classKey,
+		Status:   api.ProjectStatus(api.ProjectStatus_value[b]),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *approveBatch) TheProjectStatusIs(a string) {
+	project, err := s.k.stateStore.ProjectTable().GetById(s.ctx, testProjectID)
+	require.NoError(s.t, err)
+
+	project.Status = api.ProjectStatus(api.ProjectStatus_value[a])
+	require.NoError(s.t, s.k.stateStore.ProjectTable().Update(s.ctx, project))
+}
+
+func (s *approveBatch) AliceProposedABatchWithOriginTxId(a string) {
+	_, err := s.k.ProposeBatch(s.ctx, s.msgProposeBatch(s.alice, a))
+	require.NoError(s.t, err)
+}
+
+func (s *approveBatch) BobProposedABatchWithOriginTxId(a string) {
+	_, err := s.k.ProposeBatch(s.ctx, s.msgProposeBatch(s.bob, a))
+	require.NoError(s.t, err)
+}
+
+func (s *approveBatch) AliceProposedAnOpenBatchWithOriginTxId(a string) {
+	msg := s.msgProposeBatch(s.alice, a)
+	msg.Open = true
+
+	_, err := s.k.ProposeBatch(s.ctx, msg)
+	require.NoError(s.t, err)
+}
+
+func (s *approveBatch) BobApprovedThePendingBatchWithId(a string) {
+	s.BobAttemptsToApproveThePendingBatchWithId(a)
+	require.NoError(s.t, s.err)
+}
+
+func (s *approveBatch) AliceAttemptsToApproveThePendingBatchWithId(a string) {
+	s.approve(s.alice, a)
+}
+
+func (s *approveBatch) BobAttemptsToApproveThePendingBatchWithId(a string) {
+	s.approve(s.bob, a)
+}
+
+func (s *approveBatch) CarolAttemptsToApproveThePendingBatchWithId(a string) {
+	s.approve(s.carol, a)
+}
+
+func (s *approveBatch) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *approveBatch) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *approveBatch) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *approveBatch) ExpectTheResponse(a gocuke.DocString) {
+	var res types.MsgApproveBatchResponse
+	err := jsonpb.UnmarshalString(a.Content, &res)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, &res, s.res)
+}
+
+func (s *approveBatch) ExpectAliceApprovedThePendingBatchWithId(a string) {
+	s.expectApproval(s.alice, a)
+}
+
+func (s *approveBatch) ExpectBobApprovedThePendingBatchWithId(a string) {
+	s.expectApproval(s.bob, a)
+}
+
+func (s *approveBatch) ExpectNoPendingBatchWithId(a string) {
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	_, err = s.k.stateStore.PendingBatchTable().Get(s.ctx, id)
+	require.ErrorIs(s.t, err, ormerrors.NotFound)
+}
+
+func (s *approveBatch) ExpectNoApprovalsOfThePendingBatchWithId(a string) {
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	it, err := s.k.stateStore.PendingBatchApprovalTable().List(s.ctx, api.PendingBatchApprovalPrimaryKey{}.WithPendingBatchId(id))
+	require.NoError(s.t, err)
+	defer it.Close()
+
+	require.False(s.t, it.Next())
+}
+
+func (s *approveBatch) ExpectBobBatchBalanceWithBatchDenomAndTradableAmount(a, b string) {
+	batch, err := s.k.stateStore.BatchTable().GetByDenom(s.ctx, a)
+	require.NoError(s.t, err)
+
+	balance, err := s.k.stateStore.BatchBalanceTable().Get(s.ctx, s.bob, batch.Key)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, b, balance.TradableAmount)
+}
+
+func (s *approveBatch) ExpectCreditBatchWithBatchDenomAndOpen(a, b string) {
+	open, err := strconv.ParseBool(b)
+	require.NoError(s.t, err)
+
+	batch, err := s.k.stateStore.BatchTable().GetByDenom(s.ctx, a)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, s.alice.Bytes(), batch.Issuer)
+	require.Equal(s.t, open, batch.Open)
+}
+
+func (s *approveBatch) ExpectEventWithProperties(a gocuke.DocString) {
+	var event types.EventApproveBatch
+	err := json.Unmarshal([]byte(a.Content), &event)
+	require.NoError(s.t, err)
+
+	event.Approver = s.bob.String()
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
+
+func (s *approveBatch) approve(issuer sdk.AccAddress, pendingBatchID string) {
+	id, err := strconv.ParseUint(pendingBatchID, 10, 64)
+	require.NoError(s.t, err)
+
+	s.res, s.err = s.k.ApproveBatch(s.ctx, &types.MsgApproveBatch{
+		Issuer:         issuer.String(),
+		PendingBatchId: id,
+	})
+}
+
+func (s *approveBatch) expectApproval(approver sdk.AccAddress, pendingBatchID string) {
+	id, err := strconv.ParseUint(pendingBatchID, 10, 64)
+	require.NoError(s.t, err)
+
+	found, err := s.k.stateStore.PendingBatchApprovalTable().Has(s.ctx, id, approver)
+	require.NoError(s.t, err)
+	require.True(s.t, found)
+}
+
+func (s *approveBatch) msgProposeBatch(issuer sdk.AccAddress, txID string) *types.MsgProposeBatch {
+	startDate := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	return &types.MsgProposeBatch{
+		Issuer:    issuer.String(),
+		ProjectId: testProjectID,
+		Issuance: []*types.BatchIssuance{
+			{
+				Recipient:      s.bob.String(),
+				TradableAmount: "10",
+			},
+		},
+		StartDate: &startDate,
+		EndDate:   &endDate,
+		OriginTx: &types.OriginTx{
+			Id:     txID,
+			Source: "polygon",
+		},
+	}
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// ApproveCredits sets the amount of credits from a credit batch that a spender
+// is allowed to send or retire on behalf of the owner. An existing credit
+// allowance of the spender for the credit batch is replaced.
+func (k Keeper) ApproveCredits(ctx context.Context, req *types.MsgApproveCredits) (*types.MsgApproveCreditsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	spender, err := sdk.AccAddressFromBech32(req.Spender)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := k.stateStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", req.BatchDenom, err.Error())
+	}
+
+	creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.stateStore, batch.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = math.NewPositiveFixedDecFromString(req.Amount, creditType.Precision); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("amount: %s", err)
+	}
+
+	allowance := &api.CreditAllowance{
+		Owner:    owner,
+		Spender:  spender,
+		BatchKey: batch.Key,
+		Amount:   req.Amount,
+	}
+
+	if req.Expiration != nil {
+		if !req.Expiration.After(sdkCtx.BlockTime()) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"expiration must be in the future: %s", req.Expiration,
+			)
+		}
+		allowance.Expiration = timestamppb.New(*req.Expiration)
+	}
+
+	if err = k.stateStore.CreditAllowanceTable().Save(ctx, allowance); err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventApproveCredits{
+		Owner:      req.Owner,
+		Spender:    req.Spender,
+		BatchDenom: req.BatchDenom,
+		Amount:     req.Amount,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgApproveCreditsResponse{}, nil
+}
@@ -0,0 +1,143 @@
+//nolint:revive,stylecheck
+package keeper
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type approveCredits struct {
+	*baseSuite
+	alice      sdk.AccAddress
+	bob        sdk.AccAddress
+	batchDenom string
+	batchKey   uint64
+	res        *types.MsgApproveCreditsResponse
+	err        error
+}
+
+func TestApproveCredits(t *testing.T) {
+	gocuke.NewRunner(t, &approveCredits{}).Path("./features/msg_approve_credits.feature").Run()
+}
+
+func (s *approveCredits) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+	s.sdkCtx = s.sdkCtx.WithBlockTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+}
+
+func (s *approveCredits) ABlockTimeWithTimestamp(a string) {
+	blockTime, err := regentypes.ParseDate("block time", a)
+	require.NoError(s.t, err)
+
+	s.sdkCtx = s.sdkCtx.WithBlockTime(blockTime)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+}
+
+func (s *approveCredits) ACreditTypeWithAbbreviationAndPrecision(a, b string) {
+	precision, err := strconv.ParseUint(b, 10, 32)
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: a,
+		Name:         a,
+		Precision:    uint32(precision),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *approveCredits) ACreditBatchWithBatchDenom(a string) {
+	s.batchKey = insertCreditBatch(s.t, s.baseSuite, a)
+	s.batchDenom = a
+}
+
+func (s *approveCredits) ACreditAllowanceOfBobWithAmount(a string) {
+	err := s.k.stateStore.CreditAllowanceTable().Insert(s.ctx, &api.CreditAllowance{
+		Owner:    s.alice,
+		Spender:  s.bob,
+		BatchKey: s.batchKey,
+		Amount:   a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *approveCredits) AliceAttemptsToApproveBobWithBatchDenomAndAmount(a, b string) {
+	s.res, s.err = s.k.ApproveCredits(s.ctx, &types.MsgApproveCredits{
+		Owner:      s.alice.String(),
+		Spender:    s.bob.String(),
+		BatchDenom: a,
+		Amount:     b,
+	})
+}
+
+func (s *approveCredits) AliceAttemptsToApproveBobWithAmountAndExpiration(a, b string) {
+	expiration, err := regentypes.ParseDate("expiration", b)
+	require.NoError(s.t, err)
+
+	s.res, s.err = s.k.ApproveCredits(s.ctx, &types.MsgApproveCredits{
+		Owner:      s.alice.String(),
+		Spender:    s.bob.String(),
+		BatchDenom: s.batchDenom,
+		Amount:     a,
+		Expiration: &expiration,
+	})
+}
+
+func (s *approveCredits) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *approveCredits) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *approveCredits) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *approveCredits) ExpectCreditAllowanceOfBobWithProperties(a gocuke.DocString) {
+	var expected types.CreditAllowanceInfo
+	err := jsonpb.UnmarshalString(a.Content, &expected)
+	require.NoError(s.t, err)
+
+	allowance, err := s.k.stateStore.CreditAllowanceTable().Get(s.ctx, s.alice, s.bob, s.batchKey)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.Amount, allowance.Amount)
+
+	if expected.Expiration != nil {
+		require.Equal(s.t, regentypes.GogoToProtobufTimestamp(expected.Expiration).AsTime(), allowance.Expiration.AsTime())
+	} else {
+		require.Nil(s.t, allowance.Expiration)
+	}
+}
+
+func (s *approveCredits) ExpectEventWithProperties(a gocuke.DocString) {
+	var event types.EventApproveCredits
+	err := json.Unmarshal([]byte(a.Content), &event)
+	require.NoError(s.t, err)
+
+	event.Owner = s.alice.String()
+	event.Spender = s.bob.String()
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
@@ -10,6 +10,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
 )
 
 // Bridge cancel credits, removing them from the supply and balance of the holder
@@ -23,6 +24,16 @@ func (k Keeper) Bridge(ctx context.Context, req *types.MsgBridge) (*types.MsgBri
 		return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is not an authorized bridge target", req.Target)
 	}
 
+	for _, credit := range req.Credits {
+		batch, err := k.stateStore.BatchTable().GetByDenom(ctx, credit.BatchDenom)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", credit.BatchDenom, err.Error())
+		}
+		if err = utils.AssertBatchNotSuspended(batch); err != nil {
+			return nil, err
+		}
+	}
+
 	_, err = k.Cancel(ctx, &types.MsgCancel{
 		Owner:   req.Owner,
 		Credits: req.Credits,
@@ -116,8 +116,9 @@ func (k Keeper) BridgeReceive(ctx context.Context, req *types.MsgBridgeReceive)
 			project = &api.Project{Id: projectRes.ProjectId}
 		}
 
-		// create a new credit batch with the information provided
-		batchRes, err := k.CreateBatch(ctx, &types.MsgCreateBatch{
+		// create a new credit batch with the information provided, bridged credit
+		// batches are not subject to the issuance threshold of the credit class
+		batchRes, err := k.createBatch(ctx, &types.MsgCreateBatch{
 			Issuer:    req.Issuer,
 			ProjectId: project.Id,
 			Issuance: []*types.BatchIssuance{
@@ -68,6 +68,15 @@ func (s *bridgeSuite) ACreditBatchExistsWithABatchContractEntry() {
 	require.NoError(s.t, err)
 }
 
+func (s *bridgeSuite) TheCreditBatchIsSuspended() {
+	batch, err := s.k.stateStore.BatchTable().Get(s.ctx, s.batchKey)
+	require.NoError(s.t, err)
+
+	batch.Suspended = true
+	err = s.k.stateStore.BatchTable().Update(s.ctx, batch)
+	require.NoError(s.t, err)
+}
+
 func (s *bridgeSuite) TheTargetIsAnAllowedChain() {
 	_, err := s.k.AddAllowedBridgeChain(s.ctx, &types.MsgAddAllowedBridgeChain{
 		Authority: s.authority.String(),
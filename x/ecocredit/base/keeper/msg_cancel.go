@@ -22,71 +22,81 @@ func (k Keeper) Cancel(ctx context.Context, req *types.MsgCancel) (*types.MsgCan
 	}
 
 	for _, credit := range req.Credits {
-		batch, err := k.stateStore.BatchTable().GetByDenom(ctx, credit.BatchDenom)
-		if err != nil {
-			return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", credit.BatchDenom, err.Error())
-		}
-		creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.stateStore, batch.Denom)
-		if err != nil {
-			return nil, err
-		}
-		userBalance, err := k.stateStore.BatchBalanceTable().Get(ctx, owner, batch.Key)
-		if err != nil {
-			return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get %s balance for %s: %s", batch.Denom, owner.String(), err.Error())
-		}
-		batchSupply, err := k.stateStore.BatchSupplyTable().Get(ctx, batch.Key)
-		if err != nil {
-			return nil, err
-		}
-		decs, err := utils.GetNonNegativeFixedDecs(creditType.Precision, credit.Amount, batchSupply.TradableAmount, userBalance.TradableAmount, batchSupply.CancelledAmount)
-		if err != nil {
-			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
-		}
-		amtToCancelDec, supplyTradable, userBalTradable, cancelledDec := decs[0], decs[1], decs[2], decs[3]
-		userBalTradable, err = math.SafeSubBalance(userBalTradable, amtToCancelDec)
-		if err != nil {
-			return nil, ecocredit.ErrInsufficientCredits.Wrapf(
-				"tradable balance: %s, cancel amount %s", decs[2], amtToCancelDec,
-			)
-		}
-		supplyTradable, err = math.SafeSubBalance(supplyTradable, amtToCancelDec)
-		if err != nil {
-			return nil, err
-		}
-		cancelledDec, err = cancelledDec.Add(amtToCancelDec)
-		if err != nil {
+		if err = k.cancelCredits(ctx, owner, credit, req.Reason); err != nil {
 			return nil, err
 		}
 
-		if err = k.stateStore.BatchBalanceTable().Update(ctx, &api.BatchBalance{
-			BatchKey:       batch.Key,
-			Address:        owner,
-			TradableAmount: userBalTradable.String(),
-			RetiredAmount:  userBalance.RetiredAmount,
-			EscrowedAmount: userBalance.EscrowedAmount,
-		}); err != nil {
-			return nil, err
-		}
+		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/MsgCancel credit iteration")
+	}
+	return &types.MsgCancelResponse{}, nil
+}
 
-		if err = k.stateStore.BatchSupplyTable().Update(ctx, &api.BatchSupply{
-			BatchKey:        batch.Key,
-			TradableAmount:  supplyTradable.String(),
-			RetiredAmount:   batchSupply.RetiredAmount,
-			CancelledAmount: cancelledDec.String(),
-		}); err != nil {
-			return nil, err
-		}
+// cancelCredits cancels credits from the tradable balance of the owner, removing
+// the credits from the tradable supply of the credit batch.
+func (k Keeper) cancelCredits(ctx context.Context, owner sdk.AccAddress, credit *types.Credits, reason string) error {
+	batch, err := k.stateStore.BatchTable().GetByDenom(ctx, credit.BatchDenom)
+	if err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", credit.BatchDenom, err.Error())
+	}
+	creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.stateStore, batch.Denom)
+	if err != nil {
+		return err
+	}
+	userBalance, err := k.stateStore.BatchBalanceTable().Get(ctx, owner, batch.Key)
+	if err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("could not get %s balance for %s: %s", batch.Denom, owner.String(), err.Error())
+	}
+	batchSupply, err := k.stateStore.BatchSupplyTable().Get(ctx, batch.Key)
+	if err != nil {
+		return err
+	}
+	decs, err := utils.GetNonNegativeFixedDecs(creditType.Precision, credit.Amount, batchSupply.TradableAmount, userBalance.TradableAmount, batchSupply.CancelledAmount)
+	if err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	amtToCancelDec, supplyTradable, userBalTradable, cancelledDec := decs[0], decs[1], decs[2], decs[3]
+	userBalTradable, err = math.SafeSubBalance(userBalTradable, amtToCancelDec)
+	if err != nil {
+		return ecocredit.ErrInsufficientCredits.Wrapf(
+			"tradable balance: %s, cancel amount %s", decs[2], amtToCancelDec,
+		)
+	}
+	supplyTradable, err = math.SafeSubBalance(supplyTradable, amtToCancelDec)
+	if err != nil {
+		return err
+	}
+	cancelledDec, err = cancelledDec.Add(amtToCancelDec)
+	if err != nil {
+		return err
+	}
 
-		if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventCancel{
-			Owner:      owner.String(),
-			BatchDenom: credit.BatchDenom,
-			Amount:     credit.Amount,
-			Reason:     req.Reason,
-		}); err != nil {
-			return nil, err
-		}
+	if err = k.stateStore.BatchBalanceTable().Update(ctx, &api.BatchBalance{
+		BatchKey:       batch.Key,
+		Address:        owner,
+		TradableAmount: userBalTradable.String(),
+		RetiredAmount:  userBalance.RetiredAmount,
+		EscrowedAmount: userBalance.EscrowedAmount,
+	}); err != nil {
+		return err
+	}
 
-		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/MsgCancel credit iteration")
+	if err = k.stateStore.BatchSupplyTable().Update(ctx, &api.BatchSupply{
+		BatchKey:        batch.Key,
+		TradableAmount:  supplyTradable.String(),
+		RetiredAmount:   batchSupply.RetiredAmount,
+		CancelledAmount: cancelledDec.String(),
+	}); err != nil {
+		return err
 	}
-	return &types.MsgCancelResponse{}, nil
+
+	if err = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventCancel{
+		Owner:      owner.String(),
+		BatchDenom: credit.BatchDenom,
+		Amount:     credit.Amount,
+		Reason:     reason,
+	}); err != nil {
+		return err
+	}
+
+	return k.hooks.AfterCreditsCancelled(sdk.UnwrapSDKContext(ctx), credit.BatchDenom, owner, amtToCancelDec)
 }
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// CancelBufferCredits cancels credits held in the buffer account of a credit
+// class to cover reversals. Only the admin of the credit class can cancel buffer
+// credits and only credits from batches of the credit class can be cancelled.
+func (k Keeper) CancelBufferCredits(ctx context.Context, req *types.MsgCancelBufferCredits) (*types.MsgCancelBufferCreditsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	reqAddr, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"could not get credit class with id %s: %s", req.ClassId, err,
+		)
+	}
+
+	if !reqAddr.Equals(sdk.AccAddress(class.Admin)) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"%s is not the admin of credit class %s", req.Admin, req.ClassId,
+		)
+	}
+
+	// credits withheld before the buffer was removed remain in the buffer
+	// account, so the buffer account is derived even if the buffer is not set
+	bufferAccount := base.BufferAccount(class.Id)
+
+	for i, credit := range req.Credits {
+		batch, err := k.stateStore.BatchTable().GetByDenom(ctx, credit.BatchDenom)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"credits[%d]: could not get batch with denom %s: %s", i, credit.BatchDenom, err,
+			)
+		}
+
+		project, err := k.stateStore.ProjectTable().Get(ctx, batch.ProjectKey)
+		if err != nil {
+			return nil, err
+		}
+		if project.ClassKey != class.Key {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"credits[%d]: credit batch %s does not belong to credit class %s", i, batch.Denom, req.ClassId,
+			)
+		}
+
+		if err = k.cancelCredits(ctx, bufferAccount, credit, req.Reason); err != nil {
+			return nil, err
+		}
+
+		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/MsgCancelBufferCredits credit iteration")
+	}
+
+	return &types.MsgCancelBufferCreditsResponse{}, nil
+}
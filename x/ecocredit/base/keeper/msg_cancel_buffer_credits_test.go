@@ -0,0 +1,200 @@
+//nolint:revive,stylecheck
+package keeper
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type cancelBufferCredits struct {
+	*baseSuite
+	alice      sdk.AccAddress
+	bob        sdk.AccAddress
+	classKey   uint64
+	batchDenom string
+	batchKey   uint64
+	res        *types.MsgCancelBufferCreditsResponse
+	err        error
+}
+
+func TestCancelBufferCredits(t *testing.T) {
+	gocuke.NewRunner(t, &cancelBufferCredits{}).Path("./features/msg_cancel_buffer_credits.feature").Run()
+}
+
+func (s *cancelBufferCredits) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *cancelBufferCredits) ACreditTypeWithAbbreviationAndPrecision(a, b string) {
+	precision, err := strconv.ParseUint(b, 10, 32)
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: a,
+		Name:         a,
+		Precision:    uint32(precision),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *cancelBufferCredits) ACreditClassWithClassIdAndAdminAndIssuerAlice(a string) {
+	creditTypeAbbrev := base.GetCreditTypeAbbrevFromClassID(a)
+
+	cKey, err := s.k.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               a,
+		Admin:            s.alice,
+		CreditTypeAbbrev: creditTypeAbbrev,
+	})
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.ClassIssuerTable().Insert(s.ctx, &api.ClassIssuer{
+		ClassKey: cKey,
+		Issuer:   s.alice,
+	})
+	require.NoError(s.t, err)
+
+	s.classKey = cKey
+}
+
+func (s *cancelBufferCredits) AProjectWithProjectId(a string) {
+	err := s.k.stateStore.ProjectTable().Insert(s.ctx, &api.Project{
+		Id:       a,
+		ClassKey: s.classKey,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *cancelBufferCredits) AClassBufferWithBufferPercentage(a string) {
+	_, err := s.k.UpdateClassBuffer(s.ctx, &types.MsgUpdateClassBuffer{
+		Admin:            s.alice.String(),
+		ClassId:          testClassID,
+		BufferPercentage: a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *cancelBufferCredits) AliceCreatedABatchWithTradableAmountAndRetiredAmount(a, b string) {
+	startDate := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	res, err := s.k.CreateBatch(s.ctx, &types.MsgCreateBatch{
+		Issuer:    s.alice.String(),
+		ProjectId: testProjectID,
+		Issuance: []*types.BatchIssuance{
+			{
+				Recipient:      s.bob.String(),
+				TradableAmount: a,
+				RetiredAmount:  b,
+			},
+		},
+		StartDate: &startDate,
+		EndDate:   &endDate,
+	})
+	require.NoError(s.t, err)
+
+	batch, err := s.k.stateStore.BatchTable().GetByDenom(s.ctx, res.BatchDenom)
+	require.NoError(s.t, err)
+
+	s.batchDenom = batch.Denom
+	s.batchKey = batch.Key
+}
+
+func (s *cancelBufferCredits) AliceAttemptsToCancelBufferCreditsWithClassIdAndAmount(a, b string) {
+	s.res, s.err = s.k.CancelBufferCredits(s.ctx, &types.MsgCancelBufferCredits{
+		Admin:   s.alice.String(),
+		ClassId: a,
+		Credits: []*types.Credits{
+			{
+				BatchDenom: s.batchDenom,
+				Amount:     b,
+			},
+		},
+		Reason: "reversal",
+	})
+}
+
+func (s *cancelBufferCredits) BobAttemptsToCancelBufferCreditsWithClassIdAndAmount(a, b string) {
+	s.res, s.err = s.k.CancelBufferCredits(s.ctx, &types.MsgCancelBufferCredits{
+		Admin:   s.bob.String(),
+		ClassId: a,
+		Credits: []*types.Credits{
+			{
+				BatchDenom: s.batchDenom,
+				Amount:     b,
+			},
+		},
+		Reason: "reversal",
+	})
+}
+
+func (s *cancelBufferCredits) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *cancelBufferCredits) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *cancelBufferCredits) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *cancelBufferCredits) ExpectBobBatchBalance(a gocuke.DocString) {
+	s.expectBatchBalance(s.bob, a)
+}
+
+func (s *cancelBufferCredits) ExpectBufferAccountBatchBalance(a gocuke.DocString) {
+	s.expectBatchBalance(base.BufferAccount(testClassID), a)
+}
+
+func (s *cancelBufferCredits) ExpectBatchSupply(a gocuke.DocString) {
+	expected := &api.BatchSupply{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	supply, err := s.stateStore.BatchSupplyTable().Get(s.ctx, s.batchKey)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.TradableAmount, supply.TradableAmount)
+	require.Equal(s.t, expected.RetiredAmount, supply.RetiredAmount)
+	require.Equal(s.t, expected.CancelledAmount, supply.CancelledAmount)
+}
+
+func (s *cancelBufferCredits) ExpectEventCancelWithProperties(a gocuke.DocString) {
+	var event types.EventCancel
+	err := json.Unmarshal([]byte(a.Content), &event)
+	require.NoError(s.t, err)
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
+
+func (s *cancelBufferCredits) expectBatchBalance(addr sdk.AccAddress, a gocuke.DocString) {
+	expected := &api.BatchBalance{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, addr, s.batchKey)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.TradableAmount, balance.TradableAmount)
+	require.Equal(s.t, expected.RetiredAmount, balance.RetiredAmount)
+}
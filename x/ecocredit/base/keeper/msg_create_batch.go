@@ -19,7 +19,32 @@ import (
 
 // CreateBatch creates a new batch of credits.
 // Credits in the batch must not have more decimal places than the credit type's specified precision.
+// Credit batches of a credit class with an issuance threshold greater than one must be proposed with
+// MsgProposeBatch and approved by other issuers of the credit class.
 func (k Keeper) CreateBatch(ctx context.Context, req *types.MsgCreateBatch) (*types.MsgCreateBatchResponse, error) {
+	project, err := k.stateStore.ProjectTable().GetById(ctx, req.ProjectId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get project with id %s: %s", req.ProjectId, err.Error())
+	}
+
+	class, err := k.stateStore.ClassTable().Get(ctx, project.ClassKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if class.IssuanceThreshold > 1 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"credit batches of credit class %s must be proposed and approved by %d issuers",
+			class.Id, class.IssuanceThreshold,
+		)
+	}
+
+	return k.createBatch(ctx, req)
+}
+
+// createBatch creates a new batch of credits without checking the issuance
+// threshold of the credit class.
+func (k Keeper) createBatch(ctx context.Context, req *types.MsgCreateBatch) (*types.MsgCreateBatchResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
 	project, err := k.stateStore.ProjectTable().GetById(ctx, req.ProjectId)
@@ -42,6 +67,10 @@ func (k Keeper) CreateBatch(ctx context.Context, req *types.MsgCreateBatch) (*ty
 		return nil, err
 	}
 
+	if err = assertProjectVerified(class, project); err != nil {
+		return nil, err
+	}
+
 	batchSeqNo, err := k.getBatchSeqNo(ctx, project.Key)
 	if err != nil {
 		return nil, err
@@ -54,7 +83,7 @@ func (k Keeper) CreateBatch(ctx context.Context, req *types.MsgCreateBatch) (*ty
 
 	startDate, endDate := timestamppb.New(*req.StartDate), timestamppb.New(*req.EndDate)
 	issuanceDate := timestamppb.New(sdkCtx.BlockTime())
-	batchKey, err := k.stateStore.BatchTable().InsertReturningID(ctx, &api.Batch{
+	batch := &api.Batch{
 		Issuer:       issuer,
 		ProjectKey:   project.Key,
 		Denom:        batchDenom,
@@ -63,10 +92,12 @@ func (k Keeper) CreateBatch(ctx context.Context, req *types.MsgCreateBatch) (*ty
 		EndDate:      endDate,
 		IssuanceDate: issuanceDate,
 		Open:         req.Open,
-	})
+	}
+	batchKey, err := k.stateStore.BatchTable().InsertReturningID(ctx, batch)
 	if err != nil {
 		return nil, err
 	}
+	batch.Key = batchKey
 
 	creditType, err := k.stateStore.CreditTypeTable().Get(ctx, class.CreditTypeAbbrev)
 	if err != nil {
@@ -74,12 +105,17 @@ func (k Keeper) CreateBatch(ctx context.Context, req *types.MsgCreateBatch) (*ty
 	}
 	maxDecimalPlaces := creditType.Precision
 
+	issuances, err := withholdBuffer(class, maxDecimalPlaces, req.Issuance)
+	if err != nil {
+		return nil, err
+	}
+
 	tradableSupply, retiredSupply := math.NewDecFromInt64(0), math.NewDecFromInt64(0)
 
 	// set module address string once for better performance
 	moduleAddrString := k.moduleAddress.String()
 
-	for _, issuance := range req.Issuance {
+	for _, issuance := range issuances {
 		// get and set decimal values of tradable amount and retired amount
 		decs, err := utils.GetNonNegativeFixedDecs(maxDecimalPlaces, issuance.TradableAmount, issuance.RetiredAmount)
 		if err != nil {
@@ -135,6 +171,11 @@ func (k Keeper) CreateBatch(ctx context.Context, req *types.MsgCreateBatch) (*ty
 			return nil, err
 		}
 
+		// deliver issued credits towards any forward contracts of the recipient
+		if err = k.afterBatchCreditsIssued(ctx, batch, recipient, tradableAmount, retiredAmount); err != nil {
+			return nil, err
+		}
+
 		// update tradable supply (updated in state at the end of the loop)
 		if !tradableAmount.IsZero() {
 			tradableSupply, err = tradableSupply.Add(tradableAmount)
@@ -144,11 +185,21 @@ func (k Keeper) CreateBatch(ctx context.Context, req *types.MsgCreateBatch) (*ty
 		}
 
 		// update retired supply (updated in state at the end of the loop)
+		var retirementID uint64
 		if !retiredAmount.IsZero() {
 			retiredSupply, err = retiredSupply.Add(retiredAmount)
 			if err != nil {
 				return nil, err
 			}
+			retirementID, err = SaveRetirement(ctx, k.stateStore.RetirementTable(), &api.Retirement{
+				Owner:        recipient,
+				BatchKey:     batchKey,
+				Amount:       retiredAmount.String(),
+				Jurisdiction: issuance.RetirementJurisdiction,
+			})
+			if err != nil {
+				return nil, err
+			}
 			// emit retired event only if retired amount is positive
 			if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventRetire{
 				Owner:        issuance.Recipient,
@@ -178,9 +229,29 @@ func (k Keeper) CreateBatch(ctx context.Context, req *types.MsgCreateBatch) (*ty
 			return nil, err
 		}
 
+		if err = k.hooks.AfterCreditsIssued(sdkCtx, batchDenom, recipient, tradableAmount, retiredAmount); err != nil {
+			return nil, err
+		}
+
+		if !retiredAmount.IsZero() {
+			if err = k.hooks.AfterCreditsRetired(
+				sdkCtx, batchDenom, recipient, retiredAmount, retirementID, issuance.RetirementJurisdiction, "", "",
+			); err != nil {
+				return nil, err
+			}
+		}
+
 		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/MsgCreateBatch issuance iteration")
 	}
 
+	issuedSupply, err := tradableSupply.Add(retiredSupply)
+	if err != nil {
+		return nil, err
+	}
+	if err = k.useClassIssuerQuota(ctx, class.Key, issuer, issuedSupply); err != nil {
+		return nil, err
+	}
+
 	if err = k.stateStore.BatchSupplyTable().Insert(ctx, &api.BatchSupply{
 		BatchKey:        batchKey,
 		TradableAmount:  tradableSupply.String(),
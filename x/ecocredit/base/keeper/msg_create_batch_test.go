@@ -120,6 +120,25 @@ func (s *createBatchSuite) ACreditClassWithClassIdAndIssuerAlice(a string) {
 	s.classKey = cKey
 }
 
+func (s *createBatchSuite) TheCreditClassRequiresAVerifiedProject() {
+	class, err := s.k.stateStore.ClassTable().Get(s.ctx, s.classKey)
+	require.NoError(s.t, err)
+
+	class.RequireVerifiedProject = true
+	require.NoError(s.t, s.k.stateStore.ClassTable().Update(s.ctx, class))
+}
+
+func (s *createBatchSuite) TheCreditClassHasAnIssuanceThresholdOf(a string) {
+	threshold, err := strconv.ParseUint(a, 10, 32)
+	require.NoError(s.t, err)
+
+	class, err := s.k.stateStore.ClassTable().Get(s.ctx, s.classKey)
+	require.NoError(s.t, err)
+
+	class.IssuanceThreshold = uint32(threshold)
+	require.NoError(s.t, s.k.stateStore.ClassTable().Update(s.ctx, class))
+}
+
 func (s *createBatchSuite) AProjectWithProjectId(a string) {
 	classID := base.GetClassIDFromProjectID(a)
 
@@ -144,6 +163,16 @@ func (s *createBatchSuite) AProjectWithProjectId(a string) {
 	s.projectKey = pKey
 }
 
+func (s *createBatchSuite) AProjectWithProjectIdAndStatus(a, b string) {
+	s.AProjectWithProjectId(a)
+
+	project, err := s.k.stateStore.ProjectTable().Get(s.ctx, s.projectKey)
+	require.NoError(s.t, err)
+
+	project.Status = api.ProjectStatus(api.ProjectStatus_value[b])
+	require.NoError(s.t, s.k.stateStore.ProjectTable().Update(s.ctx, project))
+}
+
 func (s *createBatchSuite) ABatchSequenceWithProjectIdAndNextSequence(a string, b string) {
 	project, err := s.stateStore.ProjectTable().GetById(s.ctx, a)
 	require.NoError(s.t, err)
@@ -48,6 +48,7 @@ func (k Keeper) CreateProject(ctx context.Context, req *types.MsgCreateProject)
 		Jurisdiction: req.Jurisdiction,
 		Metadata:     req.Metadata,
 		ReferenceId:  req.ReferenceId,
+		Status:       api.ProjectStatus_PROJECT_STATUS_PROPOSED,
 	}); err != nil {
 		return nil, err
 	}
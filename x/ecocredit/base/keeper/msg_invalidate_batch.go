@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// InvalidateBatch seals and permanently suspends a credit batch and cancels
+// all tradable credits held by accounts. Retired and escrowed credits and
+// credits held by baskets are not cancelled.
+func (k Keeper) InvalidateBatch(ctx context.Context, req *types.MsgInvalidateBatch) (*types.MsgInvalidateBatchResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	signer, err := sdk.AccAddressFromBech32(req.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := k.stateStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", req.BatchDenom, err.Error())
+	}
+
+	if err = k.assertBatchAdmin(ctx, batch, signer); err != nil {
+		return nil, err
+	}
+
+	if batch.Invalidated {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("credit batch %s has already been invalidated", batch.Denom)
+	}
+
+	batch.Open = false
+	batch.Suspended = true
+	batch.Invalidated = true
+	if err = k.stateStore.BatchTable().Update(ctx, batch); err != nil {
+		return nil, err
+	}
+
+	if err = k.afterBatchSuspended(ctx, batch); err != nil {
+		return nil, err
+	}
+
+	// collect the balances before updating them to avoid writing to the
+	// table while iterating over it
+	it, err := k.stateStore.BatchBalanceTable().List(ctx, api.BatchBalanceBatchKeyAddressIndexKey{}.WithBatchKey(batch.Key))
+	if err != nil {
+		return nil, err
+	}
+	var balances []*api.BatchBalance
+	for it.Next() {
+		balance, err := it.Value()
+		if err != nil {
+			it.Close()
+			return nil, err
+		}
+		balances = append(balances, balance)
+	}
+	it.Close()
+
+	cancelled := math.NewDecFromInt64(0)
+	for _, balance := range balances {
+		tradable, err := math.NewDecFromString(balance.TradableAmount)
+		if err != nil {
+			return nil, err
+		}
+		if tradable.IsZero() {
+			continue
+		}
+
+		cancelled, err = math.SafeAddBalance(cancelled, tradable)
+		if err != nil {
+			return nil, err
+		}
+
+		balance.TradableAmount = "0"
+		if err = k.stateStore.BatchBalanceTable().Update(ctx, balance); err != nil {
+			return nil, err
+		}
+
+		if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventCancel{
+			Owner:      sdk.AccAddress(balance.Address).String(),
+			BatchDenom: batch.Denom,
+			Amount:     tradable.String(),
+			Reason:     req.Reason,
+		}); err != nil {
+			return nil, err
+		}
+
+		if err = k.hooks.AfterCreditsCancelled(sdkCtx, batch.Denom, balance.Address, tradable); err != nil {
+			return nil, err
+		}
+
+		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/MsgInvalidateBatch balance iteration")
+	}
+
+	supply, err := k.stateStore.BatchSupplyTable().Get(ctx, batch.Key)
+	if err != nil {
+		return nil, err
+	}
+	supplyTradable, err := math.NewDecFromString(supply.TradableAmount)
+	if err != nil {
+		return nil, err
+	}
+	supplyCancelled, err := math.NewDecFromString(supply.CancelledAmount)
+	if err != nil {
+		return nil, err
+	}
+	supplyTradable, err = math.SafeSubBalance(supplyTradable, cancelled)
+	if err != nil {
+		return nil, err
+	}
+	supplyCancelled, err = math.SafeAddBalance(supplyCancelled, cancelled)
+	if err != nil {
+		return nil, err
+	}
+
+	supply.TradableAmount = supplyTradable.String()
+	supply.CancelledAmount = supplyCancelled.String()
+	if err = k.stateStore.BatchSupplyTable().Update(ctx, supply); err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventInvalidateBatch{
+		BatchDenom:      batch.Denom,
+		Reason:          req.Reason,
+		CancelledAmount: cancelled.String(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgInvalidateBatchResponse{}, nil
+}
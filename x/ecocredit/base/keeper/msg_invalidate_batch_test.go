@@ -0,0 +1,230 @@
+//nolint:revive,stylecheck
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type invalidateBatch struct {
+	*baseSuite
+	alice    sdk.AccAddress
+	bob      sdk.AccAddress
+	classKey uint64
+	batchKey uint64
+	res      *types.MsgInvalidateBatchResponse
+	err      error
+}
+
+func TestInvalidateBatch(t *testing.T) {
+	gocuke.NewRunner(t, &invalidateBatch{}).Path("./features/msg_invalidate_batch.feature").Run()
+}
+
+func (s *invalidateBatch) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *invalidateBatch) ACreditTypeWithAbbreviation(a string) {
+	err := s.k.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: a,
+		Name:         a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *invalidateBatch) ACreditClassWithClassIdAndAdminAlice(a string) {
+	cKey, err := s.k.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               a,
+		Admin:            s.alice,
+		CreditTypeAbbrev: base.GetCreditTypeAbbrevFromClassID(a),
+	})
+	require.NoError(s.t, err)
+
+	s.classKey = cKey
+}
+
+func (s *invalidateBatch) AProjectWithId(a string) {
+	err := s.k.stateStore.ProjectTable().Insert(s.ctx, &api.Project{
+		Id:       a,
+		ClassKey: s.classKey,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *invalidateBatch) ACreditBatchWithDenom(a string) {
+	project, err := s.k.stateStore.ProjectTable().GetById(s.ctx, base.GetProjectIDFromBatchDenom(a))
+	require.NoError(s.t, err)
+
+	bKey, err := s.k.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey: project.Key,
+		Denom:      a,
+		Open:       true,
+	})
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.BatchSupplyTable().Insert(s.ctx, &api.BatchSupply{
+		BatchKey:        bKey,
+		TradableAmount:  "0",
+		RetiredAmount:   "0",
+		CancelledAmount: "0",
+	})
+	require.NoError(s.t, err)
+
+	s.batchKey = bKey
+}
+
+func (s *invalidateBatch) AliceHasTheBatchBalance(a gocuke.DocString) {
+	s.batchBalance(s.alice, a)
+}
+
+func (s *invalidateBatch) BobHasTheBatchBalance(a gocuke.DocString) {
+	s.batchBalance(s.bob, a)
+}
+
+func (s *invalidateBatch) TheBatchSupply(a gocuke.DocString) {
+	supply := &api.BatchSupply{}
+	err := jsonpb.UnmarshalString(a.Content, supply)
+	require.NoError(s.t, err)
+
+	supply.BatchKey = s.batchKey
+
+	// Save because the supply already exists from setup
+	err = s.stateStore.BatchSupplyTable().Save(s.ctx, supply)
+	require.NoError(s.t, err)
+}
+
+func (s *invalidateBatch) TheCreditBatchIsInvalidated() {
+	batch, err := s.k.stateStore.BatchTable().Get(s.ctx, s.batchKey)
+	require.NoError(s.t, err)
+
+	batch.Open = false
+	batch.Suspended = true
+	batch.Invalidated = true
+	err = s.k.stateStore.BatchTable().Update(s.ctx, batch)
+	require.NoError(s.t, err)
+}
+
+func (s *invalidateBatch) AliceAttemptsToInvalidateBatchWithDenom(a string) {
+	s.res, s.err = s.k.InvalidateBatch(s.ctx, &types.MsgInvalidateBatch{
+		Signer:     s.alice.String(),
+		BatchDenom: a,
+	})
+}
+
+func (s *invalidateBatch) BobAttemptsToInvalidateBatchWithDenom(a string) {
+	s.res, s.err = s.k.InvalidateBatch(s.ctx, &types.MsgInvalidateBatch{
+		Signer:     s.bob.String(),
+		BatchDenom: a,
+	})
+}
+
+func (s *invalidateBatch) TheGovernanceAccountAttemptsToInvalidateBatchWithDenom(a string) {
+	s.res, s.err = s.k.InvalidateBatch(s.ctx, &types.MsgInvalidateBatch{
+		Signer:     s.authority.String(),
+		BatchDenom: a,
+	})
+}
+
+func (s *invalidateBatch) AliceAttemptsToInvalidateBatchWithDenomAndReason(a, b string) {
+	s.res, s.err = s.k.InvalidateBatch(s.ctx, &types.MsgInvalidateBatch{
+		Signer:     s.alice.String(),
+		BatchDenom: a,
+		Reason:     b,
+	})
+}
+
+func (s *invalidateBatch) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *invalidateBatch) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *invalidateBatch) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *invalidateBatch) ExpectCreditBatchWithProperties(a gocuke.DocString) {
+	expected := &api.Batch{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	batch, err := s.k.stateStore.BatchTable().Get(s.ctx, s.batchKey)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.Open, batch.Open)
+	require.Equal(s.t, expected.Suspended, batch.Suspended)
+	require.Equal(s.t, expected.Invalidated, batch.Invalidated)
+}
+
+func (s *invalidateBatch) ExpectAliceBatchBalance(a gocuke.DocString) {
+	s.expectBatchBalance(s.alice, a)
+}
+
+func (s *invalidateBatch) ExpectBobBatchBalance(a gocuke.DocString) {
+	s.expectBatchBalance(s.bob, a)
+}
+
+func (s *invalidateBatch) ExpectBatchSupply(a gocuke.DocString) {
+	expected := &api.BatchSupply{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	supply, err := s.stateStore.BatchSupplyTable().Get(s.ctx, s.batchKey)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.RetiredAmount, supply.RetiredAmount)
+	require.Equal(s.t, expected.TradableAmount, supply.TradableAmount)
+	require.Equal(s.t, expected.CancelledAmount, supply.CancelledAmount)
+}
+
+func (s *invalidateBatch) ExpectEventWithProperties(a gocuke.DocString) {
+	var event types.EventInvalidateBatch
+	err := json.Unmarshal([]byte(a.Content), &event)
+	require.NoError(s.t, err)
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
+
+func (s *invalidateBatch) batchBalance(addr sdk.AccAddress, a gocuke.DocString) {
+	balance := &api.BatchBalance{}
+	err := jsonpb.UnmarshalString(a.Content, balance)
+	require.NoError(s.t, err)
+
+	balance.BatchKey = s.batchKey
+	balance.Address = addr
+
+	err = s.stateStore.BatchBalanceTable().Insert(s.ctx, balance)
+	require.NoError(s.t, err)
+}
+
+func (s *invalidateBatch) expectBatchBalance(addr sdk.AccAddress, a gocuke.DocString) {
+	expected := &api.BatchBalance{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, addr, s.batchKey)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.RetiredAmount, balance.RetiredAmount)
+	require.Equal(s.t, expected.TradableAmount, balance.TradableAmount)
+	require.Equal(s.t, expected.EscrowedAmount, balance.EscrowedAmount)
+}
@@ -8,6 +8,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
 	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
 	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
 )
@@ -49,8 +50,22 @@ func (k Keeper) MintBatchCredits(ctx context.Context, req *types.MsgMintBatchCre
 		return nil, err
 	}
 	precision := ct.Precision
+
+	class, err := k.stateStore.ClassTable().Get(ctx, project.ClassKey)
+	if err != nil {
+		return nil, err
+	}
+	if err = assertProjectVerified(class, project); err != nil {
+		return nil, err
+	}
+	issuance, err := withholdBuffer(class, precision, req.Issuance)
+	if err != nil {
+		return nil, err
+	}
+
 	moduleAddrString := k.moduleAddress.String()
-	for _, iss := range req.Issuance {
+	mintedSupply := math.NewDecFromInt64(0)
+	for _, iss := range issuance {
 		sdkCtx := sdk.UnwrapSDKContext(ctx)
 		recipient, err := sdk.AccAddressFromBech32(iss.Recipient)
 		if err != nil {
@@ -78,6 +93,7 @@ func (k Keeper) MintBatchCredits(ctx context.Context, req *types.MsgMintBatchCre
 		balanceTradable, balanceRetired := decs[0], decs[1]
 		supplyTradable, supplyRetired := decs[2], decs[3]
 
+		var retirementID uint64
 		if !retired.IsZero() {
 			balanceRetired, err = balanceRetired.Add(retired)
 			if err != nil {
@@ -87,6 +103,15 @@ func (k Keeper) MintBatchCredits(ctx context.Context, req *types.MsgMintBatchCre
 			if err != nil {
 				return nil, err
 			}
+			retirementID, err = SaveRetirement(ctx, k.stateStore.RetirementTable(), &api.Retirement{
+				Owner:        recipient,
+				BatchKey:     batch.Key,
+				Amount:       retired.String(),
+				Jurisdiction: iss.RetirementJurisdiction,
+			})
+			if err != nil {
+				return nil, err
+			}
 			if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventRetire{
 				Owner:        iss.Recipient,
 				BatchDenom:   req.BatchDenom,
@@ -126,6 +151,30 @@ func (k Keeper) MintBatchCredits(ctx context.Context, req *types.MsgMintBatchCre
 			return nil, err
 		}
 
+		if err := k.hooks.AfterCreditsIssued(sdkCtx, req.BatchDenom, recipient, tradable, retired); err != nil {
+			return nil, err
+		}
+		if !retired.IsZero() {
+			if err := k.hooks.AfterCreditsRetired(
+				sdkCtx, req.BatchDenom, recipient, retired, retirementID, iss.RetirementJurisdiction, "", "",
+			); err != nil {
+				return nil, err
+			}
+		}
+
+		// deliver minted credits towards any forward contracts of the recipient
+		if err := k.afterBatchCreditsIssued(ctx, batch, recipient, tradable, retired); err != nil {
+			return nil, err
+		}
+		minted, err := tradable.Add(retired)
+		if err != nil {
+			return nil, err
+		}
+		mintedSupply, err = mintedSupply.Add(minted)
+		if err != nil {
+			return nil, err
+		}
+
 		if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventMint{
 			BatchDenom:     batch.Denom,
 			TradableAmount: tradable.String(),
@@ -135,6 +184,10 @@ func (k Keeper) MintBatchCredits(ctx context.Context, req *types.MsgMintBatchCre
 		}
 	}
 
+	if err := k.useClassIssuerQuota(ctx, project.ClassKey, issuer, mintedSupply); err != nil {
+		return nil, err
+	}
+
 	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventMintBatchCredits{
 		BatchDenom: batch.Denom,
 		OriginTx:   req.OriginTx,
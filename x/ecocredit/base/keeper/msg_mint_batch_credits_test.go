@@ -83,6 +83,25 @@ func (s *mintBatchCredits) AProjectWithId(a string) {
 	s.projectKey = pKey
 }
 
+func (s *mintBatchCredits) TheCreditClassRequiresAVerifiedProject() {
+	class, err := s.k.stateStore.ClassTable().Get(s.ctx, s.classKey)
+	require.NoError(s.t, err)
+
+	class.RequireVerifiedProject = true
+	require.NoError(s.t, s.k.stateStore.ClassTable().Update(s.ctx, class))
+}
+
+func (s *mintBatchCredits) AProjectWithIdAndStatus(a, b string) {
+	pKey, err := s.k.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
+		Id:       a,
+		ClassKey: s.classKey,
+		Status:   api.ProjectStatus(api.ProjectStatus_value[b]),
+	})
+	require.NoError(s.t, err)
+
+	s.projectKey = pKey
+}
+
 func (s *mintBatchCredits) ACreditBatchWithDenomAndIssuerAlice(a string) {
 	projectID := base.GetProjectIDFromBatchDenom(a)
 
@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// ProposeBatch proposes a credit batch that is created once the issuance
+// threshold of the credit class is met. The proposal counts as an approval of
+// the proposer and the credit batch is created immediately if the approval of
+// the proposer meets the issuance threshold.
+func (k Keeper) ProposeBatch(ctx context.Context, req *types.MsgProposeBatch) (*types.MsgProposeBatchResponse, error) {
+	proposer, err := sdk.AccAddressFromBech32(req.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := k.stateStore.ProjectTable().GetById(ctx, req.ProjectId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get project with id %s: %s", req.ProjectId, err.Error())
+	}
+
+	class, err := k.stateStore.ClassTable().Get(ctx, project.ClassKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.assertClassIssuer(ctx, class.Key, proposer); err != nil {
+		return nil, err
+	}
+
+	// the project is also checked when the pending batch is approved because
+	// the status of the project may change while the batch is pending
+	if err = assertProjectVerified(class, project); err != nil {
+		return nil, err
+	}
+
+	creditType, err := k.stateStore.CreditTypeTable().Get(ctx, class.CreditTypeAbbrev)
+	if err != nil {
+		return nil, err
+	}
+
+	issuance := make([]*api.PendingBatch_Issuance, len(req.Issuance))
+	for i, iss := range req.Issuance {
+		// check the precision of the amounts when proposing the credit batch
+		// so that the pending batch can be created once approved
+		if _, err = utils.GetNonNegativeFixedDecs(creditType.Precision, iss.TradableAmount, iss.RetiredAmount); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("issuance[%d]: %s", i, err)
+		}
+
+		recipient, err := sdk.AccAddressFromBech32(iss.Recipient)
+		if err != nil {
+			return nil, err
+		}
+
+		issuance[i] = &api.PendingBatch_Issuance{
+			Recipient:              recipient,
+			TradableAmount:         iss.TradableAmount,
+			RetiredAmount:          iss.RetiredAmount,
+			RetirementJurisdiction: iss.RetirementJurisdiction,
+		}
+	}
+
+	pendingBatch := &api.PendingBatch{
+		ClassKey:   class.Key,
+		ProjectKey: project.Key,
+		Proposer:   proposer,
+		Issuance:   issuance,
+		Metadata:   req.Metadata,
+		StartDate:  timestamppb.New(*req.StartDate),
+		EndDate:    timestamppb.New(*req.EndDate),
+		ProposedAt: timestamppb.New(sdk.UnwrapSDKContext(ctx).BlockTime()),
+		Open:       req.Open,
+	}
+	if req.OriginTx != nil {
+		pendingBatch.OriginTx = &api.PendingBatch_OriginTx{
+			Id:       req.OriginTx.Id,
+			Source:   req.OriginTx.Source,
+			Contract: req.OriginTx.Contract,
+			Note:     req.OriginTx.Note,
+		}
+	}
+
+	pendingBatch.Id, err = k.stateStore.PendingBatchTable().InsertReturningID(ctx, pendingBatch)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.stateStore.PendingBatchApprovalTable().Insert(ctx, &api.PendingBatchApproval{
+		PendingBatchId: pendingBatch.Id,
+		Approver:       proposer,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventProposeBatch{
+		PendingBatchId: pendingBatch.Id,
+		ProjectId:      project.Id,
+		Proposer:       req.Issuer,
+	}); err != nil {
+		return nil, err
+	}
+
+	batchDenom, err := k.createPendingBatchIfApproved(ctx, pendingBatch)
+	if err != nil {
+		return nil, err
+	}
+
+	if batchDenom != "" {
+		return &types.MsgProposeBatchResponse{BatchDenom: batchDenom}, nil
+	}
+
+	return &types.MsgProposeBatchResponse{PendingBatchId: pendingBatch.Id}, nil
+}
@@ -0,0 +1,228 @@
+//nolint:revive,stylecheck
+package keeper
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type proposeBatch struct {
+	*baseSuite
+	alice    sdk.AccAddress
+	bob      sdk.AccAddress
+	carol    sdk.AccAddress
+	classKey uint64
+	res      *types.MsgProposeBatchResponse
+	err      error
+}
+
+func TestProposeBatch(t *testing.T) {
+	gocuke.NewRunner(t, &proposeBatch{}).Path("./features/msg_propose_batch.feature").Run()
+}
+
+func (s *proposeBatch) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+	_, _, s.carol = testdata.KeyTestPubAddr()
+}
+
+func (s *proposeBatch) ACreditTypeWithAbbreviationAndPrecision(a, b string) {
+	precision, err := strconv.ParseUint(b, 10, 32)
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: a,
+		Name:         a,
+		Precision:    uint32(precision),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *proposeBatch) ACreditClassWithClassIdAndIssuersAliceAndBob(a string) {
+	creditTypeAbbrev := base.GetCreditTypeAbbrevFromClassID(a)
+
+	cKey, err := s.k.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               a,
+		Admin:            s.alice,
+		CreditTypeAbbrev: creditTypeAbbrev,
+	})
+	require.NoError(s.t, err)
+
+	for _, issuer := range []sdk.AccAddress{s.alice, s.bob} {
+		err = s.k.stateStore.ClassIssuerTable().Insert(s.ctx, &api.ClassIssuer{
+			ClassKey: cKey,
+			Issuer:   issuer,
+		})
+		require.NoError(s.t, err)
+	}
+
+	s.classKey = cKey
+}
+
+func (s *proposeBatch) TheCreditClassHasAnIssuanceThresholdOf(a string) {
+	threshold, err := strconv.ParseUint(a, 10, 32)
+	require.NoError(s.t, err)
+
+	class, err := s.k.stateStore.ClassTable().Get(s.ctx, s.classKey)
+	require.NoError(s.t, err)
+
+	class.IssuanceThreshold = uint32(threshold)
+	require.NoError(s.t, s.k.stateStore.ClassTable().Update(s.ctx, class))
+}
+
+func (s *proposeBatch) TheCreditClassRequiresAVerifiedProject() {
+	class, err := s.k.stateStore.ClassTable().Get(s.ctx, s.classKey)
+	require.NoError(s.t, err)
+
+	class.RequireVerifiedProject = true
+	require.NoError(s.t, s.k.stateStore.ClassTable().Update(s.ctx, class))
+}
+
+func (s *proposeBatch) AProjectWithProjectId(a string) {
+	err := s.k.stateStore.ProjectTable().Insert(s.ctx, &api.Project{
+		Id:       a,
+		ClassKey: s.classKey,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *proposeBatch) AProjectWithProjectIdAndStatus(a, b string) {
+	err := s.k.stateStore.ProjectTable().Insert(s.ctx, &api.Project{
+		Id:       a,
+		ClassKey: s.classKey,
+		Status:   api.ProjectStatus(api.ProjectStatus_value[b]),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *proposeBatch) AliceAttemptsToProposeABatchWithProjectId(a string) {
+	s.res, s.err = s.k.ProposeBatch(s.ctx, s.msgProposeBatch(s.alice, a, "10"))
+}
+
+func (s *proposeBatch) CarolAttemptsToProposeABatchWithProjectId(a string) {
+	s.res, s.err = s.k.ProposeBatch(s.ctx, s.msgProposeBatch(s.carol, a, "10"))
+}
+
+func (s *proposeBatch) AliceAttemptsToProposeAnOpenBatchWithProjectId(a string) {
+	msg := s.msgProposeBatch(s.alice, a, "10")
+	msg.Open = true
+
+	s.res, s.err = s.k.ProposeBatch(s.ctx, msg)
+}
+
+func (s *proposeBatch) AliceAttemptsToProposeABatchWithTradableAmount(a string) {
+	s.res, s.err = s.k.ProposeBatch(s.ctx, s.msgProposeBatch(s.alice, testProjectID, a))
+}
+
+func (s *proposeBatch) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *proposeBatch) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *proposeBatch) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *proposeBatch) ExpectTheResponse(a gocuke.DocString) {
+	var res types.MsgProposeBatchResponse
+	err := jsonpb.UnmarshalString(a.Content, &res)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, &res, s.res)
+}
+
+func (s *proposeBatch) ExpectPendingBatchWithIdAndOpen(a, b string) {
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	open, err := strconv.ParseBool(b)
+	require.NoError(s.t, err)
+
+	pendingBatch, err := s.k.stateStore.PendingBatchTable().Get(s.ctx, id)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, s.alice.Bytes(), pendingBatch.Proposer)
+	require.Equal(s.t, open, pendingBatch.Open)
+}
+
+func (s *proposeBatch) ExpectAliceApprovedThePendingBatchWithId(a string) {
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	found, err := s.k.stateStore.PendingBatchApprovalTable().Has(s.ctx, id, s.alice)
+	require.NoError(s.t, err)
+	require.True(s.t, found)
+}
+
+func (s *proposeBatch) ExpectNoPendingBatchWithId(a string) {
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	_, err = s.k.stateStore.PendingBatchTable().Get(s.ctx, id)
+	require.ErrorIs(s.t, err, ormerrors.NotFound)
+}
+
+func (s *proposeBatch) ExpectBobBatchBalanceWithBatchDenomAndTradableAmount(a, b string) {
+	batch, err := s.k.stateStore.BatchTable().GetByDenom(s.ctx, a)
+	require.NoError(s.t, err)
+
+	balance, err := s.k.stateStore.BatchBalanceTable().Get(s.ctx, s.bob, batch.Key)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, b, balance.TradableAmount)
+}
+
+func (s *proposeBatch) ExpectEventWithProperties(a gocuke.DocString) {
+	var event types.EventProposeBatch
+	err := json.Unmarshal([]byte(a.Content), &event)
+	require.NoError(s.t, err)
+
+	event.Proposer = s.alice.String()
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
+
+func (s *proposeBatch) msgProposeBatch(issuer sdk.AccAddress, projectID, tradableAmount string) *types.MsgProposeBatch {
+	startDate := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	return &types.MsgProposeBatch{
+		Issuer:    issuer.String(),
+		ProjectId: projectID,
+		Issuance: []*types.BatchIssuance{
+			{
+				Recipient:      s.bob.String(),
+				TradableAmount: tradableAmount,
+			},
+		},
+		StartDate: &startDate,
+		EndDate:   &endDate,
+		OriginTx: &types.OriginTx{
+			Id:     "0x1",
+			Source: "polygon",
+		},
+	}
+}
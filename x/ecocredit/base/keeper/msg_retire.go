@@ -19,87 +19,122 @@ func (k Keeper) Retire(ctx context.Context, req *types.MsgRetire) (*types.MsgRet
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	owner, _ := sdk.AccAddressFromBech32(req.Owner)
 
+	retirementIDs := make([]uint64, 0, len(req.Credits))
 	for _, credit := range req.Credits {
-		batch, err := k.stateStore.BatchTable().GetByDenom(ctx, credit.BatchDenom)
-		if err != nil {
-			return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", credit.BatchDenom, err.Error())
-		}
-		creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.stateStore, batch.Denom)
+		retirementID, err := k.retireCredits(ctx, owner, credit, req.Jurisdiction, req.Reason, req.Beneficiary)
 		if err != nil {
 			return nil, err
 		}
-		userBalance, err := k.stateStore.BatchBalanceTable().Get(ctx, owner, batch.Key)
-		if err != nil {
-			return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get %s balance for %s: %s", batch.Denom, owner.String(), err.Error())
-		}
+		retirementIDs = append(retirementIDs, retirementID)
 
-		decs, err := utils.GetNonNegativeFixedDecs(creditType.Precision, credit.Amount, userBalance.TradableAmount)
-		if err != nil {
-			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
-		}
-		amtToRetire, userTradableBalance := decs[0], decs[1]
+		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/MsgRetire credit iteration")
+	}
+	return &types.MsgRetireResponse{RetirementIds: retirementIDs}, nil
+}
 
-		userTradableBalance, err = math.SafeSubBalance(userTradableBalance, amtToRetire)
-		if err != nil {
-			return nil, ecocredit.ErrInsufficientCredits.Wrapf(
-				"tradable balance: %s, retire amount %s", decs[1], amtToRetire,
-			)
-		}
-		userRetiredBalance, err := math.NewNonNegativeFixedDecFromString(userBalance.RetiredAmount, creditType.Precision)
-		if err != nil {
-			return nil, err
-		}
-		userRetiredBalance, err = userRetiredBalance.Add(amtToRetire)
-		if err != nil {
-			return nil, err
-		}
-		batchSupply, err := k.stateStore.BatchSupplyTable().Get(ctx, batch.Key)
-		if err != nil {
-			return nil, err
-		}
-		decs, err = utils.GetNonNegativeFixedDecs(creditType.Precision, batchSupply.RetiredAmount, batchSupply.TradableAmount)
-		if err != nil {
-			return nil, err
-		}
-		supplyRetired, supplyTradable := decs[0], decs[1]
-		supplyRetired, err = supplyRetired.Add(amtToRetire)
-		if err != nil {
-			return nil, err
-		}
-		supplyTradable, err = math.SafeSubBalance(supplyTradable, amtToRetire)
-		if err != nil {
-			return nil, err
-		}
+// retireCredits retires tradable credits of the owner and returns the id of
+// the retirement.
+func (k Keeper) retireCredits(ctx context.Context, owner sdk.AccAddress, credit *types.Credits, jurisdiction, reason, beneficiary string) (uint64, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	batch, err := k.stateStore.BatchTable().GetByDenom(ctx, credit.BatchDenom)
+	if err != nil {
+		return 0, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", credit.BatchDenom, err.Error())
+	}
+	if err = utils.AssertBatchNotSuspended(batch); err != nil {
+		return 0, err
+	}
+	creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.stateStore, batch.Denom)
+	if err != nil {
+		return 0, err
+	}
+	userBalance, err := k.stateStore.BatchBalanceTable().Get(ctx, owner, batch.Key)
+	if err != nil {
+		return 0, sdkerrors.ErrInvalidRequest.Wrapf("could not get %s balance for %s: %s", batch.Denom, owner.String(), err.Error())
+	}
 
-		if err = k.stateStore.BatchBalanceTable().Update(ctx, &api.BatchBalance{
-			BatchKey:       batch.Key,
-			Address:        owner,
-			TradableAmount: userTradableBalance.String(),
-			RetiredAmount:  userRetiredBalance.String(),
-			EscrowedAmount: userBalance.EscrowedAmount,
-		}); err != nil {
-			return nil, err
-		}
+	decs, err := utils.GetNonNegativeFixedDecs(creditType.Precision, credit.Amount, userBalance.TradableAmount)
+	if err != nil {
+		return 0, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	amtToRetire, userTradableBalance := decs[0], decs[1]
 
-		if err = k.stateStore.BatchSupplyTable().Update(ctx, &api.BatchSupply{
-			BatchKey:        batch.Key,
-			TradableAmount:  supplyTradable.String(),
-			RetiredAmount:   supplyRetired.String(),
-			CancelledAmount: batchSupply.CancelledAmount,
-		}); err != nil {
-			return nil, err
-		}
+	userTradableBalance, err = math.SafeSubBalance(userTradableBalance, amtToRetire)
+	if err != nil {
+		return 0, ecocredit.ErrInsufficientCredits.Wrapf(
+			"tradable balance: %s, retire amount %s", decs[1], amtToRetire,
+		)
+	}
+	userRetiredBalance, err := math.NewNonNegativeFixedDecFromString(userBalance.RetiredAmount, creditType.Precision)
+	if err != nil {
+		return 0, err
+	}
+	userRetiredBalance, err = userRetiredBalance.Add(amtToRetire)
+	if err != nil {
+		return 0, err
+	}
+	batchSupply, err := k.stateStore.BatchSupplyTable().Get(ctx, batch.Key)
+	if err != nil {
+		return 0, err
+	}
+	decs, err = utils.GetNonNegativeFixedDecs(creditType.Precision, batchSupply.RetiredAmount, batchSupply.TradableAmount)
+	if err != nil {
+		return 0, err
+	}
+	supplyRetired, supplyTradable := decs[0], decs[1]
+	supplyRetired, err = supplyRetired.Add(amtToRetire)
+	if err != nil {
+		return 0, err
+	}
+	supplyTradable, err = math.SafeSubBalance(supplyTradable, amtToRetire)
+	if err != nil {
+		return 0, err
+	}
 
-		if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventRetire{
-			Owner:        req.Owner,
-			BatchDenom:   credit.BatchDenom,
-			Amount:       credit.Amount,
-			Jurisdiction: req.Jurisdiction,
-		}); err != nil {
-			return nil, err
-		}
+	if err = k.stateStore.BatchBalanceTable().Update(ctx, &api.BatchBalance{
+		BatchKey:       batch.Key,
+		Address:        owner,
+		TradableAmount: userTradableBalance.String(),
+		RetiredAmount:  userRetiredBalance.String(),
+		EscrowedAmount: userBalance.EscrowedAmount,
+	}); err != nil {
+		return 0, err
+	}
 
-		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/MsgRetire credit iteration")
+	if err = k.stateStore.BatchSupplyTable().Update(ctx, &api.BatchSupply{
+		BatchKey:        batch.Key,
+		TradableAmount:  supplyTradable.String(),
+		RetiredAmount:   supplyRetired.String(),
+		CancelledAmount: batchSupply.CancelledAmount,
+	}); err != nil {
+		return 0, err
 	}
-	return &types.MsgRetireResponse{}, nil
+
+	retirementID, err := SaveRetirement(ctx, k.stateStore.RetirementTable(), &api.Retirement{
+		Owner:        owner,
+		BatchKey:     batch.Key,
+		Amount:       amtToRetire.String(),
+		Jurisdiction: jurisdiction,
+		Reason:       reason,
+		Beneficiary:  beneficiary,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventRetire{
+		Owner:        owner.String(),
+		BatchDenom:   credit.BatchDenom,
+		Amount:       credit.Amount,
+		Jurisdiction: jurisdiction,
+	}); err != nil {
+		return 0, err
+	}
+
+	if err = k.hooks.AfterCreditsRetired(
+		sdkCtx, credit.BatchDenom, owner, amtToRetire, retirementID, jurisdiction, reason, beneficiary,
+	); err != nil {
+		return 0, err
+	}
+
+	return retirementID, nil
 }
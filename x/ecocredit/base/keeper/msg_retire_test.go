@@ -82,6 +82,15 @@ func (s *retire) ACreditBatchWithDenom(a string) {
 	s.batchKey = bKey
 }
 
+func (s *retire) TheCreditBatchIsSuspended() {
+	batch, err := s.k.stateStore.BatchTable().Get(s.ctx, s.batchKey)
+	require.NoError(s.t, err)
+
+	batch.Suspended = true
+	err = s.k.stateStore.BatchTable().Update(s.ctx, batch)
+	require.NoError(s.t, err)
+}
+
 func (s *retire) AlicesAddress(a string) {
 	addr, err := sdk.AccAddressFromBech32(a)
 	require.NoError(s.t, err)
@@ -239,6 +248,44 @@ func (s *retire) AliceAttemptsToRetireCreditAmountFrom(a string, b string) {
 	require.NoError(s.t, s.err)
 }
 
+func (s *retire) AliceAttemptsToRetireCreditAmountWithReasonAndBeneficiary(a, b, c string) {
+	s.res, s.err = s.k.Retire(s.ctx, &types.MsgRetire{
+		Owner: s.alice.String(),
+		Credits: []*types.Credits{
+			{
+				BatchDenom: s.batchDenom,
+				Amount:     a,
+			},
+		},
+		Jurisdiction: "US-WA",
+		Reason:       b,
+		Beneficiary:  c,
+	})
+	require.NoError(s.t, s.err)
+}
+
+func (s *retire) ExpectRetirementWithId(a string, b gocuke.DocString) {
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	expected := &api.Retirement{}
+	err = jsonpb.UnmarshalString(b.Content, expected)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, []uint64{id}, s.res.RetirementIds)
+
+	retirement, err := s.stateStore.RetirementTable().Get(s.ctx, id)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, s.alice.Bytes(), retirement.Owner)
+	require.Equal(s.t, s.batchKey, retirement.BatchKey)
+	require.Equal(s.t, expected.Amount, retirement.Amount)
+	require.Equal(s.t, expected.Jurisdiction, retirement.Jurisdiction)
+	require.Equal(s.t, expected.Reason, retirement.Reason)
+	require.Equal(s.t, expected.Beneficiary, retirement.Beneficiary)
+	require.Equal(s.t, s.sdkCtx.BlockTime().Unix(), retirement.Timestamp.AsTime().Unix())
+}
+
 func (s *retire) ExpectEventWithProperties(a gocuke.DocString) {
 	var event types.EventRetire
 	err := json.Unmarshal([]byte(a.Content), &event)
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// RevokeCreditAllowance removes the credit allowance of a spender for a credit
+// batch of the owner.
+func (k Keeper) RevokeCreditAllowance(ctx context.Context, req *types.MsgRevokeCreditAllowance) (*types.MsgRevokeCreditAllowanceResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	spender, err := sdk.AccAddressFromBech32(req.Spender)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := k.stateStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", req.BatchDenom, err.Error())
+	}
+
+	allowance, err := k.stateStore.CreditAllowanceTable().Get(ctx, owner, spender, batch.Key)
+	if err != nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf(
+			"%s does not have a credit allowance for %s from %s", req.Spender, req.BatchDenom, req.Owner,
+		)
+	}
+
+	if err = k.stateStore.CreditAllowanceTable().Delete(ctx, allowance); err != nil {
+		return nil, err
+	}
+
+	if err = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventRevokeCreditAllowance{
+		Owner:      req.Owner,
+		Spender:    req.Spender,
+		BatchDenom: req.BatchDenom,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeCreditAllowanceResponse{}, nil
+}
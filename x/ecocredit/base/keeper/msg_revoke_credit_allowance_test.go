@@ -0,0 +1,103 @@
+//nolint:revive,stylecheck
+package keeper
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type revokeCreditAllowance struct {
+	*baseSuite
+	alice    sdk.AccAddress
+	bob      sdk.AccAddress
+	batchKey uint64
+	res      *types.MsgRevokeCreditAllowanceResponse
+	err      error
+}
+
+func TestRevokeCreditAllowance(t *testing.T) {
+	gocuke.NewRunner(t, &revokeCreditAllowance{}).Path("./features/msg_revoke_credit_allowance.feature").Run()
+}
+
+func (s *revokeCreditAllowance) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *revokeCreditAllowance) ACreditTypeWithAbbreviationAndPrecision(a, b string) {
+	precision, err := strconv.ParseUint(b, 10, 32)
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: a,
+		Name:         a,
+		Precision:    uint32(precision),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *revokeCreditAllowance) ACreditBatchWithBatchDenom(a string) {
+	s.batchKey = insertCreditBatch(s.t, s.baseSuite, a)
+}
+
+func (s *revokeCreditAllowance) ACreditAllowanceOfBobWithAmount(a string) {
+	err := s.k.stateStore.CreditAllowanceTable().Insert(s.ctx, &api.CreditAllowance{
+		Owner:    s.alice,
+		Spender:  s.bob,
+		BatchKey: s.batchKey,
+		Amount:   a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *revokeCreditAllowance) AliceAttemptsToRevokeTheCreditAllowanceOfBobWithBatchDenom(a string) {
+	s.res, s.err = s.k.RevokeCreditAllowance(s.ctx, &types.MsgRevokeCreditAllowance{
+		Owner:      s.alice.String(),
+		Spender:    s.bob.String(),
+		BatchDenom: a,
+	})
+}
+
+func (s *revokeCreditAllowance) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *revokeCreditAllowance) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *revokeCreditAllowance) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *revokeCreditAllowance) ExpectNoCreditAllowanceOfBob() {
+	_, err := s.k.stateStore.CreditAllowanceTable().Get(s.ctx, s.alice, s.bob, s.batchKey)
+	require.ErrorIs(s.t, err, ormerrors.NotFound)
+}
+
+func (s *revokeCreditAllowance) ExpectEventWithProperties(a gocuke.DocString) {
+	var event types.EventRevokeCreditAllowance
+	err := json.Unmarshal([]byte(a.Content), &event)
+	require.NoError(s.t, err)
+
+	event.Owner = s.alice.String()
+	event.Spender = s.bob.String()
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
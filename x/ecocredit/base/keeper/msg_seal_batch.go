@@ -39,5 +39,9 @@ func (k Keeper) SealBatch(ctx context.Context, req *types.MsgSealBatch) (*types.
 		return nil, err
 	}
 
+	if err := k.hooks.AfterBatchSealed(sdk.UnwrapSDKContext(ctx), batch.Denom); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgSealBatchResponse{}, nil
 }
@@ -38,6 +38,9 @@ func (k Keeper) sendEcocredits(sdkCtx sdk.Context, credit *types.MsgSend_SendCre
 	if err != nil {
 		return sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", credit.BatchDenom, err.Error())
 	}
+	if err = utils.AssertBatchNotSuspended(batch); err != nil {
+		return err
+	}
 	creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.stateStore, batch.Denom)
 	if err != nil {
 		return err
@@ -136,6 +139,7 @@ func (k Keeper) sendEcocredits(sdkCtx sdk.Context, credit *types.MsgSend_SendCre
 		return err
 	}
 	// update the "retired" supply only if credits were retired
+	var retirementID uint64
 	if didRetire {
 		if err := k.stateStore.BatchSupplyTable().Update(ctx, &api.BatchSupply{
 			BatchKey:        batch.Key,
@@ -145,6 +149,17 @@ func (k Keeper) sendEcocredits(sdkCtx sdk.Context, credit *types.MsgSend_SendCre
 		}); err != nil {
 			return err
 		}
+		retirementID, err = SaveRetirement(sdkCtx, k.stateStore.RetirementTable(), &api.Retirement{
+			Owner:        to,
+			BatchKey:     batch.Key,
+			Amount:       sendAmtRetired.String(),
+			Jurisdiction: credit.RetirementJurisdiction,
+			Reason:       credit.RetirementReason,
+			Beneficiary:  credit.RetirementBeneficiary,
+		})
+		if err != nil {
+			return err
+		}
 		if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventRetire{
 			Owner:        to.String(),
 			BatchDenom:   credit.BatchDenom,
@@ -163,5 +178,12 @@ func (k Keeper) sendEcocredits(sdkCtx sdk.Context, credit *types.MsgSend_SendCre
 	}); err != nil {
 		return err
 	}
+	if err = k.hooks.AfterCreditsTransferred(sdkCtx, credit.BatchDenom, from, to, sendAmtTradable, sendAmtRetired); err != nil {
+		return err
+	}
+	if didRetire {
+		return k.hooks.AfterCreditsRetired(sdkCtx, credit.BatchDenom, to, sendAmtRetired, retirementID,
+			credit.RetirementJurisdiction, credit.RetirementReason, credit.RetirementBeneficiary)
+	}
 	return nil
 }
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// SendFrom sends credits on behalf of the owner using the credit allowances of
+// the spender. SendFrom also retires credits if the amount to retire is
+// specified in the request. Both the tradable and retired amounts are deducted
+// from the credit allowance and the credit allowance is removed once used up.
+func (k Keeper) SendFrom(ctx context.Context, req *types.MsgSendFrom) (*types.MsgSendFromResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	spender, err := sdk.AccAddressFromBech32(req.Spender)
+	if err != nil {
+		return nil, err
+	}
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+	recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, credit := range req.Credits {
+		if err = k.useCreditAllowance(ctx, owner, spender, credit); err != nil {
+			return nil, err
+		}
+
+		if err = k.sendEcocredits(sdkCtx, credit, recipient, owner); err != nil {
+			return nil, err
+		}
+
+		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/MsgSendFrom credit iteration")
+	}
+
+	return &types.MsgSendFromResponse{}, nil
+}
+
+// useCreditAllowance deducts the tradable and retired amounts of the credits
+// from the credit allowance of the spender and removes the credit allowance
+// once used up.
+func (k Keeper) useCreditAllowance(ctx context.Context, owner, spender sdk.AccAddress, credit *types.MsgSend_SendCredits) error {
+	batch, err := k.stateStore.BatchTable().GetByDenom(ctx, credit.BatchDenom)
+	if err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", credit.BatchDenom, err.Error())
+	}
+
+	allowance, err := k.stateStore.CreditAllowanceTable().Get(ctx, owner, spender, batch.Key)
+	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			return sdkerrors.ErrUnauthorized.Wrapf(
+				"%s does not have a credit allowance for %s from %s", spender, credit.BatchDenom, owner,
+			)
+		}
+		return err
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	if allowance.Expiration != nil && !blockTime.Before(allowance.Expiration.AsTime()) {
+		return sdkerrors.ErrUnauthorized.Wrapf(
+			"credit allowance of %s for %s from %s has expired", spender, credit.BatchDenom, owner,
+		)
+	}
+
+	creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.stateStore, batch.Denom)
+	if err != nil {
+		return err
+	}
+
+	decs, err := utils.GetNonNegativeFixedDecs(creditType.Precision, allowance.Amount, credit.TradableAmount, credit.RetiredAmount)
+	if err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	remaining, tradable, retired := decs[0], decs[1], decs[2]
+
+	amount, err := tradable.Add(retired)
+	if err != nil {
+		return err
+	}
+
+	remaining, err = math.SafeSubBalance(remaining, amount)
+	if err != nil {
+		return sdkerrors.ErrUnauthorized.Wrapf(
+			"send amount %s exceeds the credit allowance of %s for %s", amount, allowance.Amount, credit.BatchDenom,
+		)
+	}
+
+	if remaining.IsZero() {
+		return k.stateStore.CreditAllowanceTable().Delete(ctx, allowance)
+	}
+
+	allowance.Amount = remaining.String()
+	return k.stateStore.CreditAllowanceTable().Update(ctx, allowance)
+}
@@ -0,0 +1,218 @@
+//nolint:revive,stylecheck
+package keeper
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type sendFrom struct {
+	*baseSuite
+	alice      sdk.AccAddress
+	bob        sdk.AccAddress
+	batchDenom string
+	batchKey   uint64
+	res        *types.MsgSendFromResponse
+	err        error
+}
+
+func TestSendFrom(t *testing.T) {
+	gocuke.NewRunner(t, &sendFrom{}).Path("./features/msg_send_from.feature").Run()
+}
+
+func (s *sendFrom) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+	s.batchDenom = testBatchDenom
+	s.sdkCtx = s.sdkCtx.WithBlockTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+}
+
+func (s *sendFrom) ABlockTimeWithTimestamp(a string) {
+	blockTime, err := regentypes.ParseDate("block time", a)
+	require.NoError(s.t, err)
+
+	s.sdkCtx = s.sdkCtx.WithBlockTime(blockTime)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+}
+
+func (s *sendFrom) ACreditTypeWithAbbreviationAndPrecision(a, b string) {
+	precision, err := strconv.ParseUint(b, 10, 32)
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: a,
+		Name:         a,
+		Precision:    uint32(precision),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *sendFrom) ACreditBatchWithBatchDenom(a string) {
+	s.batchKey = insertCreditBatch(s.t, s.baseSuite, a)
+	s.batchDenom = a
+}
+
+func (s *sendFrom) AliceOwnsTradableCreditAmount(a string) {
+	err := s.k.stateStore.BatchBalanceTable().Insert(s.ctx, &api.BatchBalance{
+		BatchKey:       s.batchKey,
+		Address:        s.alice,
+		TradableAmount: a,
+		RetiredAmount:  "0",
+	})
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.BatchSupplyTable().Update(s.ctx, &api.BatchSupply{
+		BatchKey:        s.batchKey,
+		TradableAmount:  a,
+		RetiredAmount:   "0",
+		CancelledAmount: "0",
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *sendFrom) ACreditAllowanceOfBobWithAmount(a string) {
+	err := s.k.stateStore.CreditAllowanceTable().Insert(s.ctx, &api.CreditAllowance{
+		Owner:    s.alice,
+		Spender:  s.bob,
+		BatchKey: s.batchKey,
+		Amount:   a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *sendFrom) ACreditAllowanceOfBobWithAmountAndExpiration(a, b string) {
+	expiration, err := regentypes.ParseDate("expiration", b)
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.CreditAllowanceTable().Insert(s.ctx, &api.CreditAllowance{
+		Owner:      s.alice,
+		Spender:    s.bob,
+		BatchKey:   s.batchKey,
+		Amount:     a,
+		Expiration: timestamppb.New(expiration),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *sendFrom) AliceRevokedTheCreditAllowanceOfBob() {
+	_, err := s.k.RevokeCreditAllowance(s.ctx, &types.MsgRevokeCreditAllowance{
+		Owner:      s.alice.String(),
+		Spender:    s.bob.String(),
+		BatchDenom: s.batchDenom,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *sendFrom) BobAttemptsToSendCreditsFromAliceWithBatchDenomAndTradableAmount(a, b string) {
+	s.res, s.err = s.k.SendFrom(s.ctx, &types.MsgSendFrom{
+		Spender:   s.bob.String(),
+		Owner:     s.alice.String(),
+		Recipient: s.bob.String(),
+		Credits: []*types.MsgSend_SendCredits{
+			{
+				BatchDenom:     a,
+				TradableAmount: b,
+			},
+		},
+	})
+}
+
+func (s *sendFrom) BobAttemptsToSendCreditsFromAliceWithTradableAmount(a string) {
+	s.BobAttemptsToSendCreditsFromAliceWithBatchDenomAndTradableAmount(s.batchDenom, a)
+}
+
+func (s *sendFrom) BobAttemptsToSendCreditsFromAliceWithTradableAmountAndRetiredAmount(a, b string) {
+	s.res, s.err = s.k.SendFrom(s.ctx, &types.MsgSendFrom{
+		Spender:   s.bob.String(),
+		Owner:     s.alice.String(),
+		Recipient: s.bob.String(),
+		Credits: []*types.MsgSend_SendCredits{
+			{
+				BatchDenom:             s.batchDenom,
+				TradableAmount:         a,
+				RetiredAmount:          b,
+				RetirementJurisdiction: "US-WA",
+			},
+		},
+	})
+}
+
+func (s *sendFrom) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *sendFrom) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *sendFrom) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *sendFrom) ExpectAliceBatchBalance(a gocuke.DocString) {
+	s.expectBatchBalance(s.alice, a)
+}
+
+func (s *sendFrom) ExpectBobBatchBalance(a gocuke.DocString) {
+	s.expectBatchBalance(s.bob, a)
+}
+
+func (s *sendFrom) ExpectCreditAllowanceOfBobWithProperties(a gocuke.DocString) {
+	var expected types.CreditAllowanceInfo
+	err := jsonpb.UnmarshalString(a.Content, &expected)
+	require.NoError(s.t, err)
+
+	allowance, err := s.k.stateStore.CreditAllowanceTable().Get(s.ctx, s.alice, s.bob, s.batchKey)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.Amount, allowance.Amount)
+}
+
+func (s *sendFrom) ExpectNoCreditAllowanceOfBob() {
+	_, err := s.k.stateStore.CreditAllowanceTable().Get(s.ctx, s.alice, s.bob, s.batchKey)
+	require.ErrorIs(s.t, err, ormerrors.NotFound)
+}
+
+func (s *sendFrom) ExpectEventTransferWithProperties(a gocuke.DocString) {
+	var event types.EventTransfer
+	err := json.Unmarshal([]byte(a.Content), &event)
+	require.NoError(s.t, err)
+
+	event.Sender = s.alice.String()
+	event.Recipient = s.bob.String()
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
+
+func (s *sendFrom) expectBatchBalance(addr sdk.AccAddress, a gocuke.DocString) {
+	expected := &api.BatchBalance{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, addr, s.batchKey)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.TradableAmount, balance.TradableAmount)
+	require.Equal(s.t, expected.RetiredAmount, balance.RetiredAmount)
+}
@@ -84,6 +84,15 @@ func (s *send) ACreditBatchWithDenom(a string) {
 	s.batchKey = bKey
 }
 
+func (s *send) TheCreditBatchIsSuspended() {
+	batch, err := s.k.stateStore.BatchTable().Get(s.ctx, s.batchKey)
+	require.NoError(s.t, err)
+
+	batch.Suspended = true
+	err = s.k.stateStore.BatchTable().Update(s.ctx, batch)
+	require.NoError(s.t, err)
+}
+
 func (s *send) ACreditBatchFromCreditClassWithCreditType(a string) {
 	cKey, err := s.k.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
 		Id:               s.classID,
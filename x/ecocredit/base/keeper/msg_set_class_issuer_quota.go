@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/math"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// SetClassIssuerQuota sets or removes the quota of an issuer of a credit class.
+// Only the admin of the credit class can set the quota of an issuer. The issued
+// amount of an existing quota is kept unless the period of the quota changes.
+func (k Keeper) SetClassIssuerQuota(ctx context.Context, req *types.MsgSetClassIssuerQuota) (*types.MsgSetClassIssuerQuotaResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	reqAddr, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"could not get credit class with id %s: %s", req.ClassId, err,
+		)
+	}
+
+	if !reqAddr.Equals(sdk.AccAddress(class.Admin)) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"%s is not the admin of credit class %s", req.Admin, req.ClassId,
+		)
+	}
+
+	issuer, err := sdk.AccAddressFromBech32(req.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.assertClassIssuer(ctx, class.Key, issuer); err != nil {
+		return nil, err
+	}
+
+	existing, err := k.stateStore.ClassIssuerQuotaTable().Get(ctx, class.Key, issuer)
+	if err != nil && !ormerrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	if req.Amount == "" {
+		if existing == nil {
+			return nil, sdkerrors.ErrNotFound.Wrapf(
+				"issuer %s of credit class %s does not have a quota", req.Issuer, req.ClassId,
+			)
+		}
+		if err = k.stateStore.ClassIssuerQuotaTable().Delete(ctx, existing); err != nil {
+			return nil, err
+		}
+	} else {
+		creditType, err := k.stateStore.CreditTypeTable().Get(ctx, class.CreditTypeAbbrev)
+		if err != nil {
+			return nil, err
+		}
+
+		amount, err := math.NewPositiveFixedDecFromString(req.Amount, creditType.Precision)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("amount: %s", err)
+		}
+
+		quota := &api.ClassIssuerQuota{
+			ClassKey:     class.Key,
+			Issuer:       issuer,
+			Amount:       amount.String(),
+			Period:       regentypes.GogoToProtobufDuration(req.Period),
+			PeriodStart:  timestamppb.New(sdkCtx.BlockTime()),
+			IssuedAmount: "0",
+		}
+
+		if existing != nil && existing.Period.AsDuration() == quota.Period.AsDuration() {
+			quota.PeriodStart = existing.PeriodStart
+			quota.IssuedAmount = existing.IssuedAmount
+		}
+
+		if err = k.stateStore.ClassIssuerQuotaTable().Save(ctx, quota); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventSetClassIssuerQuota{
+		ClassId: req.ClassId,
+		Issuer:  req.Issuer,
+		Amount:  req.Amount,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetClassIssuerQuotaResponse{}, nil
+}
@@ -0,0 +1,283 @@
+//nolint:revive,stylecheck
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type setClassIssuerQuota struct {
+	*baseSuite
+	alice      sdk.AccAddress
+	bob        sdk.AccAddress
+	classKey   uint64
+	batchDenom string
+	txCount    int
+	res        *types.MsgSetClassIssuerQuotaResponse
+	err        error
+}
+
+func TestSetClassIssuerQuota(t *testing.T) {
+	gocuke.NewRunner(t, &setClassIssuerQuota{}).Path("./features/msg_set_class_issuer_quota.feature").Run()
+}
+
+func (s *setClassIssuerQuota) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+	s.sdkCtx = s.sdkCtx.WithBlockTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+}
+
+func (s *setClassIssuerQuota) ABlockTimeWithTimestamp(a string) {
+	blockTime, err := regentypes.ParseDate("block time", a)
+	require.NoError(s.t, err)
+
+	s.sdkCtx = s.sdkCtx.WithBlockTime(blockTime)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+}
+
+func (s *setClassIssuerQuota) ACreditTypeWithAbbreviationAndPrecision(a, b string) {
+	precision, err := strconv.ParseUint(b, 10, 32)
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: a,
+		Name:         a,
+		Precision:    uint32(precision),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *setClassIssuerQuota) ACreditClassWithClassIdAndAdminAlice(a string) {
+	creditTypeAbbrev := base.GetCreditTypeAbbrevFromClassID(a)
+
+	cKey, err := s.k.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               a,
+		Admin:            s.alice,
+		CreditTypeAbbrev: creditTypeAbbrev,
+	})
+	require.NoError(s.t, err)
+
+	s.classKey = cKey
+}
+
+func (s *setClassIssuerQuota) AliceIsAnIssuerOfTheCreditClass() {
+	err := s.k.stateStore.ClassIssuerTable().Insert(s.ctx, &api.ClassIssuer{
+		ClassKey: s.classKey,
+		Issuer:   s.alice,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *setClassIssuerQuota) BobIsAnIssuerOfTheCreditClass() {
+	err := s.k.stateStore.ClassIssuerTable().Insert(s.ctx, &api.ClassIssuer{
+		ClassKey: s.classKey,
+		Issuer:   s.bob,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *setClassIssuerQuota) AProjectWithProjectId(a string) {
+	err := s.k.stateStore.ProjectTable().Insert(s.ctx, &api.Project{
+		Id:       a,
+		ClassKey: s.classKey,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *setClassIssuerQuota) AQuotaOfBobWithAmount(a string) {
+	err := s.k.stateStore.ClassIssuerQuotaTable().Insert(s.ctx, &api.ClassIssuerQuota{
+		ClassKey:     s.classKey,
+		Issuer:       s.bob,
+		Amount:       a,
+		PeriodStart:  timestamppb.New(s.sdkCtx.BlockTime()),
+		IssuedAmount: "0",
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *setClassIssuerQuota) AQuotaOfBobWithAmountAndPeriod(a, b string) {
+	period, err := time.ParseDuration(b)
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.ClassIssuerQuotaTable().Insert(s.ctx, &api.ClassIssuerQuota{
+		ClassKey:     s.classKey,
+		Issuer:       s.bob,
+		Amount:       a,
+		Period:       durationpb.New(period),
+		PeriodStart:  timestamppb.New(s.sdkCtx.BlockTime()),
+		IssuedAmount: "0",
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *setClassIssuerQuota) BobIssuedCreditsWithTradableAmountAndRetiredAmount(a, b string) {
+	s.BobAttemptsToCreateABatchWithTradableAmountAndRetiredAmount(a, b)
+	require.NoError(s.t, s.err)
+}
+
+func (s *setClassIssuerQuota) AliceAttemptsToSetTheQuotaOfBobWithClassIdAndAmount(a, b string) {
+	s.res, s.err = s.k.SetClassIssuerQuota(s.ctx, &types.MsgSetClassIssuerQuota{
+		Admin:   s.alice.String(),
+		ClassId: a,
+		Issuer:  s.bob.String(),
+		Amount:  b,
+	})
+}
+
+func (s *setClassIssuerQuota) AliceAttemptsToSetTheQuotaOfBobWithClassIdAmountAndPeriod(a, b, c string) {
+	period, err := time.ParseDuration(c)
+	require.NoError(s.t, err)
+
+	s.res, s.err = s.k.SetClassIssuerQuota(s.ctx, &types.MsgSetClassIssuerQuota{
+		Admin:   s.alice.String(),
+		ClassId: a,
+		Issuer:  s.bob.String(),
+		Amount:  b,
+		Period:  gogotypes.DurationProto(period),
+	})
+}
+
+func (s *setClassIssuerQuota) BobAttemptsToSetTheQuotaOfBobWithClassIdAndAmount(a, b string) {
+	s.res, s.err = s.k.SetClassIssuerQuota(s.ctx, &types.MsgSetClassIssuerQuota{
+		Admin:   s.bob.String(),
+		ClassId: a,
+		Issuer:  s.bob.String(),
+		Amount:  b,
+	})
+}
+
+func (s *setClassIssuerQuota) AliceAttemptsToRemoveTheQuotaOfBobWithClassId(a string) {
+	s.res, s.err = s.k.SetClassIssuerQuota(s.ctx, &types.MsgSetClassIssuerQuota{
+		Admin:   s.alice.String(),
+		ClassId: a,
+		Issuer:  s.bob.String(),
+	})
+}
+
+func (s *setClassIssuerQuota) AliceAttemptsToRemoveIssuerBobWithClassId(a string) {
+	_, s.err = s.k.UpdateClassIssuers(s.ctx, &types.MsgUpdateClassIssuers{
+		Admin:         s.alice.String(),
+		ClassId:       a,
+		RemoveIssuers: []string{s.bob.String()},
+	})
+}
+
+func (s *setClassIssuerQuota) AliceAttemptsToCreateABatchWithTradableAmountAndRetiredAmount(a, b string) {
+	s.createBatch(s.alice, a, b)
+}
+
+func (s *setClassIssuerQuota) BobAttemptsToCreateABatchWithTradableAmountAndRetiredAmount(a, b string) {
+	s.createBatch(s.bob, a, b)
+}
+
+func (s *setClassIssuerQuota) BobAttemptsToMintCreditsWithTradableAmount(a string) {
+	s.txCount++
+
+	_, s.err = s.k.MintBatchCredits(s.ctx, &types.MsgMintBatchCredits{
+		Issuer:     s.bob.String(),
+		BatchDenom: s.batchDenom,
+		Issuance: []*types.BatchIssuance{
+			{
+				Recipient:      s.alice.String(),
+				TradableAmount: a,
+			},
+		},
+		OriginTx: &types.OriginTx{
+			Id:     fmt.Sprintf("0x%d", s.txCount),
+			Source: "polygon",
+		},
+	})
+}
+
+func (s *setClassIssuerQuota) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *setClassIssuerQuota) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *setClassIssuerQuota) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *setClassIssuerQuota) ExpectQuotaOfBobWithProperties(a gocuke.DocString) {
+	var expected types.ClassIssuerQuota
+	err := jsonpb.UnmarshalString(a.Content, &expected)
+	require.NoError(s.t, err)
+
+	quota, err := s.k.stateStore.ClassIssuerQuotaTable().Get(s.ctx, s.classKey, s.bob)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.Amount, quota.Amount)
+	require.Equal(s.t, expected.IssuedAmount, quota.IssuedAmount)
+	require.Equal(s.t, regentypes.GogoToProtobufDuration(expected.Period).AsDuration(), quota.Period.AsDuration())
+
+	if expected.PeriodStart != nil {
+		require.Equal(s.t, regentypes.GogoToProtobufTimestamp(expected.PeriodStart).AsTime(), quota.PeriodStart.AsTime())
+	}
+}
+
+func (s *setClassIssuerQuota) ExpectNoQuotaOfBob() {
+	_, err := s.k.stateStore.ClassIssuerQuotaTable().Get(s.ctx, s.classKey, s.bob)
+	require.ErrorIs(s.t, err, ormerrors.NotFound)
+}
+
+func (s *setClassIssuerQuota) ExpectEventWithProperties(a gocuke.DocString) {
+	var event types.EventSetClassIssuerQuota
+	err := json.Unmarshal([]byte(a.Content), &event)
+	require.NoError(s.t, err)
+
+	event.Issuer = s.bob.String()
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
+
+func (s *setClassIssuerQuota) createBatch(issuer sdk.AccAddress, tradableAmount, retiredAmount string) {
+	startDate := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	res, err := s.k.CreateBatch(s.ctx, &types.MsgCreateBatch{
+		Issuer:    issuer.String(),
+		ProjectId: testProjectID,
+		Issuance: []*types.BatchIssuance{
+			{
+				Recipient:      s.alice.String(),
+				TradableAmount: tradableAmount,
+				RetiredAmount:  retiredAmount,
+			},
+		},
+		StartDate: &startDate,
+		EndDate:   &endDate,
+		Open:      true,
+	})
+	if err == nil {
+		s.batchDenom = res.BatchDenom
+	}
+	s.err = err
+}
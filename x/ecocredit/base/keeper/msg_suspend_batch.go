@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// SuspendBatch suspends a credit batch, blocking transfers, retirements,
+// bridging, basket deposits and sales of credits from the credit batch.
+func (k Keeper) SuspendBatch(ctx context.Context, req *types.MsgSuspendBatch) (*types.MsgSuspendBatchResponse, error) {
+	signer, err := sdk.AccAddressFromBech32(req.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := k.stateStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", req.BatchDenom, err.Error())
+	}
+
+	if err = k.assertBatchAdmin(ctx, batch, signer); err != nil {
+		return nil, err
+	}
+
+	if batch.Suspended {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("credit batch %s is already suspended", batch.Denom)
+	}
+
+	batch.Suspended = true
+	if err = k.stateStore.BatchTable().Update(ctx, batch); err != nil {
+		return nil, err
+	}
+
+	if err = k.afterBatchSuspended(ctx, batch); err != nil {
+		return nil, err
+	}
+
+	if err = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventSuspendBatch{
+		BatchDenom: batch.Denom,
+		Reason:     req.Reason,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSuspendBatchResponse{}, nil
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// UnsuspendBatch unsuspends a suspended credit batch. An invalidated credit
+// batch cannot be unsuspended.
+func (k Keeper) UnsuspendBatch(ctx context.Context, req *types.MsgUnsuspendBatch) (*types.MsgUnsuspendBatchResponse, error) {
+	signer, err := sdk.AccAddressFromBech32(req.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := k.stateStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", req.BatchDenom, err.Error())
+	}
+
+	if err = k.assertBatchAdmin(ctx, batch, signer); err != nil {
+		return nil, err
+	}
+
+	if batch.Invalidated {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("credit batch %s has been invalidated", batch.Denom)
+	}
+
+	if !batch.Suspended {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("credit batch %s is not suspended", batch.Denom)
+	}
+
+	batch.Suspended = false
+	if err = k.stateStore.BatchTable().Update(ctx, batch); err != nil {
+		return nil, err
+	}
+
+	if err = k.afterBatchUnsuspended(ctx, batch); err != nil {
+		return nil, err
+	}
+
+	if err = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventUnsuspendBatch{
+		BatchDenom: batch.Denom,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnsuspendBatchResponse{}, nil
+}
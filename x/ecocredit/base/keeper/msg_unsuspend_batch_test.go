@@ -0,0 +1,156 @@
+//nolint:revive,stylecheck
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type unsuspendBatch struct {
+	*baseSuite
+	alice    sdk.AccAddress
+	bob      sdk.AccAddress
+	classKey uint64
+	batchKey uint64
+	res      *types.MsgUnsuspendBatchResponse
+	err      error
+}
+
+func TestUnsuspendBatch(t *testing.T) {
+	gocuke.NewRunner(t, &unsuspendBatch{}).Path("./features/msg_unsuspend_batch.feature").Run()
+}
+
+func (s *unsuspendBatch) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *unsuspendBatch) ACreditTypeWithAbbreviation(a string) {
+	err := s.k.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: a,
+		Name:         a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *unsuspendBatch) ACreditClassWithClassIdAndAdminAlice(a string) {
+	cKey, err := s.k.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               a,
+		Admin:            s.alice,
+		CreditTypeAbbrev: base.GetCreditTypeAbbrevFromClassID(a),
+	})
+	require.NoError(s.t, err)
+
+	s.classKey = cKey
+}
+
+func (s *unsuspendBatch) AProjectWithId(a string) {
+	err := s.k.stateStore.ProjectTable().Insert(s.ctx, &api.Project{
+		Id:       a,
+		ClassKey: s.classKey,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *unsuspendBatch) ACreditBatchWithDenom(a string) {
+	project, err := s.k.stateStore.ProjectTable().GetById(s.ctx, base.GetProjectIDFromBatchDenom(a))
+	require.NoError(s.t, err)
+
+	bKey, err := s.k.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey: project.Key,
+		Denom:      a,
+		Open:       true,
+	})
+	require.NoError(s.t, err)
+
+	s.batchKey = bKey
+}
+
+func (s *unsuspendBatch) TheCreditBatchIsSuspended() {
+	batch, err := s.k.stateStore.BatchTable().Get(s.ctx, s.batchKey)
+	require.NoError(s.t, err)
+
+	batch.Suspended = true
+	err = s.k.stateStore.BatchTable().Update(s.ctx, batch)
+	require.NoError(s.t, err)
+}
+
+func (s *unsuspendBatch) TheCreditBatchIsInvalidated() {
+	batch, err := s.k.stateStore.BatchTable().Get(s.ctx, s.batchKey)
+	require.NoError(s.t, err)
+
+	batch.Open = false
+	batch.Invalidated = true
+	err = s.k.stateStore.BatchTable().Update(s.ctx, batch)
+	require.NoError(s.t, err)
+}
+
+func (s *unsuspendBatch) AliceAttemptsToUnsuspendBatchWithDenom(a string) {
+	s.res, s.err = s.k.UnsuspendBatch(s.ctx, &types.MsgUnsuspendBatch{
+		Signer:     s.alice.String(),
+		BatchDenom: a,
+	})
+}
+
+func (s *unsuspendBatch) BobAttemptsToUnsuspendBatchWithDenom(a string) {
+	s.res, s.err = s.k.UnsuspendBatch(s.ctx, &types.MsgUnsuspendBatch{
+		Signer:     s.bob.String(),
+		BatchDenom: a,
+	})
+}
+
+func (s *unsuspendBatch) TheGovernanceAccountAttemptsToUnsuspendBatchWithDenom(a string) {
+	s.res, s.err = s.k.UnsuspendBatch(s.ctx, &types.MsgUnsuspendBatch{
+		Signer:     s.authority.String(),
+		BatchDenom: a,
+	})
+}
+
+func (s *unsuspendBatch) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *unsuspendBatch) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *unsuspendBatch) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *unsuspendBatch) ExpectCreditBatchWithProperties(a gocuke.DocString) {
+	expected := &api.Batch{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	batch, err := s.k.stateStore.BatchTable().Get(s.ctx, s.batchKey)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.Open, batch.Open)
+	require.Equal(s.t, expected.Suspended, batch.Suspended)
+	require.Equal(s.t, expected.Invalidated, batch.Invalidated)
+}
+
+func (s *unsuspendBatch) ExpectEventWithProperties(a gocuke.DocString) {
+	var event types.EventUnsuspendBatch
+	err := json.Unmarshal([]byte(a.Content), &event)
+	require.NoError(s.t, err)
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// UpdateClassBuffer sets or removes the buffer percentage of a credit class and
+// sets the buffer account of the credit class when the buffer percentage is set.
+// Only the admin of the credit class can update the buffer.
+func (k Keeper) UpdateClassBuffer(ctx context.Context, req *types.MsgUpdateClassBuffer) (*types.MsgUpdateClassBufferResponse, error) {
+	reqAddr, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"could not get credit class with id %s: %s", req.ClassId, err,
+		)
+	}
+
+	if !reqAddr.Equals(sdk.AccAddress(class.Admin)) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"%s is not the admin of credit class %s", req.Admin, req.ClassId,
+		)
+	}
+
+	// the buffer account is derived from the credit class id such that the
+	// admin cannot withhold credits in or cancel credits from a user account
+	var bufferAccount string
+	class.BufferPercentage = req.BufferPercentage
+	class.BufferAccount = nil
+	if req.BufferPercentage != "" {
+		class.BufferAccount = base.BufferAccount(class.Id)
+		bufferAccount = sdk.AccAddress(class.BufferAccount).String()
+	}
+
+	if err = k.stateStore.ClassTable().Update(ctx, class); err != nil {
+		return nil, err
+	}
+
+	if err = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventUpdateClassBuffer{
+		ClassId:          req.ClassId,
+		BufferPercentage: req.BufferPercentage,
+		BufferAccount:    bufferAccount,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateClassBufferResponse{}, nil
+}
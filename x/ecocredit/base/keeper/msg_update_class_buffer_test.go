@@ -0,0 +1,237 @@
+//nolint:revive,stylecheck
+package keeper
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type updateClassBuffer struct {
+	*baseSuite
+	alice      sdk.AccAddress
+	bob        sdk.AccAddress
+	classKey   uint64
+	batchDenom string
+	batchKey   uint64
+	res        *types.MsgUpdateClassBufferResponse
+	err        error
+}
+
+func TestUpdateClassBuffer(t *testing.T) {
+	gocuke.NewRunner(t, &updateClassBuffer{}).Path("./features/msg_update_class_buffer.feature").Run()
+}
+
+func (s *updateClassBuffer) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *updateClassBuffer) ACreditTypeWithAbbreviationAndPrecision(a, b string) {
+	precision, err := strconv.ParseUint(b, 10, 32)
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: a,
+		Name:         a,
+		Precision:    uint32(precision),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateClassBuffer) ACreditClassWithClassIdAndAdminAndIssuerAlice(a string) {
+	creditTypeAbbrev := base.GetCreditTypeAbbrevFromClassID(a)
+
+	cKey, err := s.k.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               a,
+		Admin:            s.alice,
+		CreditTypeAbbrev: creditTypeAbbrev,
+	})
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.ClassIssuerTable().Insert(s.ctx, &api.ClassIssuer{
+		ClassKey: cKey,
+		Issuer:   s.alice,
+	})
+	require.NoError(s.t, err)
+
+	s.classKey = cKey
+}
+
+func (s *updateClassBuffer) AProjectWithProjectId(a string) {
+	err := s.k.stateStore.ProjectTable().Insert(s.ctx, &api.Project{
+		Id:       a,
+		ClassKey: s.classKey,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateClassBuffer) AClassBufferWithBufferPercentage(a string) {
+	s.AliceAttemptsToUpdateTheClassBufferWithClassIdAndBufferPercentage(testClassID, a)
+	require.NoError(s.t, s.err)
+}
+
+func (s *updateClassBuffer) AliceCreatedABatchWithTradableAmountAndRetiredAmount(a, b string) {
+	s.AliceAttemptsToCreateABatchWithTradableAmountAndRetiredAmount(a, b)
+	require.NoError(s.t, s.err)
+}
+
+func (s *updateClassBuffer) AliceAttemptsToUpdateTheClassBufferWithClassIdAndBufferPercentage(a, b string) {
+	s.res, s.err = s.k.UpdateClassBuffer(s.ctx, &types.MsgUpdateClassBuffer{
+		Admin:            s.alice.String(),
+		ClassId:          a,
+		BufferPercentage: b,
+	})
+}
+
+func (s *updateClassBuffer) BobAttemptsToUpdateTheClassBufferWithClassIdAndBufferPercentage(a, b string) {
+	s.res, s.err = s.k.UpdateClassBuffer(s.ctx, &types.MsgUpdateClassBuffer{
+		Admin:            s.bob.String(),
+		ClassId:          a,
+		BufferPercentage: b,
+	})
+}
+
+func (s *updateClassBuffer) AliceAttemptsToCreateABatchWithTradableAmountAndRetiredAmount(a, b string) {
+	startDate := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	res, err := s.k.CreateBatch(s.ctx, &types.MsgCreateBatch{
+		Issuer:    s.alice.String(),
+		ProjectId: testProjectID,
+		Issuance: []*types.BatchIssuance{
+			{
+				Recipient:      s.bob.String(),
+				TradableAmount: a,
+				RetiredAmount:  b,
+			},
+		},
+		StartDate: &startDate,
+		EndDate:   &endDate,
+		Open:      true,
+	})
+	s.err = err
+	if err != nil {
+		return
+	}
+
+	batch, err := s.k.stateStore.BatchTable().GetByDenom(s.ctx, res.BatchDenom)
+	require.NoError(s.t, err)
+
+	s.batchDenom = batch.Denom
+	s.batchKey = batch.Key
+}
+
+func (s *updateClassBuffer) AliceAttemptsToMintCreditsWithTradableAmountAndRetiredAmount(a, b string) {
+	_, s.err = s.k.MintBatchCredits(s.ctx, &types.MsgMintBatchCredits{
+		Issuer:     s.alice.String(),
+		BatchDenom: s.batchDenom,
+		Issuance: []*types.BatchIssuance{
+			{
+				Recipient:      s.bob.String(),
+				TradableAmount: a,
+				RetiredAmount:  b,
+			},
+		},
+		OriginTx: &types.OriginTx{
+			Id:     "0x1",
+			Source: "polygon",
+		},
+	})
+}
+
+func (s *updateClassBuffer) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *updateClassBuffer) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *updateClassBuffer) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *updateClassBuffer) ExpectCreditClassWithClassIdAndBufferPercentage(a, b string) {
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, b, class.BufferPercentage)
+}
+
+func (s *updateClassBuffer) ExpectCreditClassWithClassIdAndABufferAccount(a string) {
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, base.BufferAccount(a), sdk.AccAddress(class.BufferAccount))
+}
+
+func (s *updateClassBuffer) ExpectCreditClassWithClassIdAndNoBufferAccount(a string) {
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	require.Empty(s.t, class.BufferAccount)
+}
+
+func (s *updateClassBuffer) ExpectBobBatchBalance(a gocuke.DocString) {
+	s.expectBatchBalance(s.bob, a)
+}
+
+func (s *updateClassBuffer) ExpectBufferAccountBatchBalance(a gocuke.DocString) {
+	s.expectBatchBalance(base.BufferAccount(testClassID), a)
+}
+
+func (s *updateClassBuffer) ExpectNoBufferAccountBatchBalance() {
+	_, err := s.stateStore.BatchBalanceTable().Get(s.ctx, base.BufferAccount(testClassID), s.batchKey)
+	require.ErrorIs(s.t, err, ormerrors.NotFound)
+}
+
+func (s *updateClassBuffer) ExpectBatchSupply(a gocuke.DocString) {
+	expected := &api.BatchSupply{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	supply, err := s.stateStore.BatchSupplyTable().Get(s.ctx, s.batchKey)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.TradableAmount, supply.TradableAmount)
+	require.Equal(s.t, expected.RetiredAmount, supply.RetiredAmount)
+}
+
+func (s *updateClassBuffer) ExpectEventWithProperties(a gocuke.DocString) {
+	var event types.EventUpdateClassBuffer
+	err := json.Unmarshal([]byte(a.Content), &event)
+	require.NoError(s.t, err)
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
+
+func (s *updateClassBuffer) expectBatchBalance(addr sdk.AccAddress, a gocuke.DocString) {
+	expected := &api.BatchBalance{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, addr, s.batchKey)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.TradableAmount, balance.TradableAmount)
+	require.Equal(s.t, expected.RetiredAmount, balance.RetiredAmount)
+}
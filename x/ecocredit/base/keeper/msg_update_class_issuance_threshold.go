@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// UpdateClassIssuanceThreshold updates the number of approvals from issuers of
+// a credit class required to create a proposed credit batch. Only the admin of
+// the credit class can update the issuance threshold.
+func (k Keeper) UpdateClassIssuanceThreshold(ctx context.Context, req *types.MsgUpdateClassIssuanceThreshold) (*types.MsgUpdateClassIssuanceThresholdResponse, error) {
+	reqAddr, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"could not get credit class with id %s: %s", req.ClassId, err,
+		)
+	}
+
+	if !reqAddr.Equals(sdk.AccAddress(class.Admin)) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"%s is not the admin of credit class %s", req.Admin, req.ClassId,
+		)
+	}
+
+	class.IssuanceThreshold = req.IssuanceThreshold
+	if err = k.stateStore.ClassTable().Update(ctx, class); err != nil {
+		return nil, err
+	}
+
+	if err = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventUpdateClassIssuanceThreshold{
+		ClassId:           req.ClassId,
+		IssuanceThreshold: req.IssuanceThreshold,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateClassIssuanceThresholdResponse{}, nil
+}
@@ -0,0 +1,111 @@
+//nolint:revive,stylecheck
+package keeper
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type updateClassIssuanceThreshold struct {
+	*baseSuite
+	alice sdk.AccAddress
+	bob   sdk.AccAddress
+	res   *types.MsgUpdateClassIssuanceThresholdResponse
+	err   error
+}
+
+func TestUpdateClassIssuanceThreshold(t *testing.T) {
+	gocuke.NewRunner(t, &updateClassIssuanceThreshold{}).Path("./features/msg_update_class_issuance_threshold.feature").Run()
+}
+
+func (s *updateClassIssuanceThreshold) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *updateClassIssuanceThreshold) ACreditTypeWithAbbreviation(a string) {
+	err := s.k.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: a,
+		Name:         a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateClassIssuanceThreshold) ACreditClassWithClassIdAndAdminAlice(a string) {
+	creditTypeAbbrev := base.GetCreditTypeAbbrevFromClassID(a)
+
+	err := s.k.stateStore.ClassTable().Insert(s.ctx, &api.Class{
+		Id:               a,
+		Admin:            s.alice,
+		CreditTypeAbbrev: creditTypeAbbrev,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateClassIssuanceThreshold) AliceAttemptsToUpdateTheIssuanceThresholdWithClassIdAndThreshold(a, b string) {
+	threshold, err := strconv.ParseUint(b, 10, 32)
+	require.NoError(s.t, err)
+
+	s.res, s.err = s.k.UpdateClassIssuanceThreshold(s.ctx, &types.MsgUpdateClassIssuanceThreshold{
+		Admin:             s.alice.String(),
+		ClassId:           a,
+		IssuanceThreshold: uint32(threshold),
+	})
+}
+
+func (s *updateClassIssuanceThreshold) BobAttemptsToUpdateTheIssuanceThresholdWithClassIdAndThreshold(a, b string) {
+	threshold, err := strconv.ParseUint(b, 10, 32)
+	require.NoError(s.t, err)
+
+	s.res, s.err = s.k.UpdateClassIssuanceThreshold(s.ctx, &types.MsgUpdateClassIssuanceThreshold{
+		Admin:             s.bob.String(),
+		ClassId:           a,
+		IssuanceThreshold: uint32(threshold),
+	})
+}
+
+func (s *updateClassIssuanceThreshold) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *updateClassIssuanceThreshold) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *updateClassIssuanceThreshold) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *updateClassIssuanceThreshold) ExpectCreditClassWithClassIdAndIssuanceThreshold(a, b string) {
+	threshold, err := strconv.ParseUint(b, 10, 32)
+	require.NoError(s.t, err)
+
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, uint32(threshold), class.IssuanceThreshold)
+}
+
+func (s *updateClassIssuanceThreshold) ExpectEventWithProperties(a gocuke.DocString) {
+	var event types.EventUpdateClassIssuanceThreshold
+	err := json.Unmarshal([]byte(a.Content), &event)
+	require.NoError(s.t, err)
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
@@ -46,6 +46,13 @@ func (k Keeper) UpdateClassIssuers(ctx context.Context, req *types.MsgUpdateClas
 			return nil, err
 		}
 
+		// remove the quota of the issuer if the issuer has a quota
+		if err = k.stateStore.ClassIssuerQuotaTable().DeleteBy(ctx,
+			api.ClassIssuerQuotaClassKeyIssuerIndexKey{}.WithClassKeyIssuer(class.Key, issuerAcc),
+		); err != nil {
+			return nil, err
+		}
+
 		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/MsgUpdateClassIssuers issuer iteration")
 	}
 
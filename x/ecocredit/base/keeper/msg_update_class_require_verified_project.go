@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// UpdateClassRequireVerifiedProject updates whether or not the project of a
+// credit batch must be verified for credits to be issued in a credit class.
+// Only the admin of the credit class can update the requirement.
+func (k Keeper) UpdateClassRequireVerifiedProject(ctx context.Context, req *types.MsgUpdateClassRequireVerifiedProject) (*types.MsgUpdateClassRequireVerifiedProjectResponse, error) {
+	reqAddr, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"could not get credit class with id %s: %s", req.ClassId, err,
+		)
+	}
+
+	if !reqAddr.Equals(sdk.AccAddress(class.Admin)) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"%s is not the admin of credit class %s", req.Admin, req.ClassId,
+		)
+	}
+
+	class.RequireVerifiedProject = req.RequireVerifiedProject
+	if err = k.stateStore.ClassTable().Update(ctx, class); err != nil {
+		return nil, err
+	}
+
+	if err = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventUpdateClassRequireVerifiedProject{
+		ClassId:                req.ClassId,
+		RequireVerifiedProject: req.RequireVerifiedProject,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateClassRequireVerifiedProjectResponse{}, nil
+}
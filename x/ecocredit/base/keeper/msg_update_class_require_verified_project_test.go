@@ -0,0 +1,112 @@
+//nolint:revive,stylecheck
+package keeper
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type updateClassRequireVerifiedProject struct {
+	*baseSuite
+	alice sdk.AccAddress
+	bob   sdk.AccAddress
+	res   *types.MsgUpdateClassRequireVerifiedProjectResponse
+	err   error
+}
+
+func TestUpdateClassRequireVerifiedProject(t *testing.T) {
+	gocuke.NewRunner(t, &updateClassRequireVerifiedProject{}).Path("./features/msg_update_class_require_verified_project.feature").Run()
+}
+
+func (s *updateClassRequireVerifiedProject) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *updateClassRequireVerifiedProject) ACreditTypeWithAbbreviation(a string) {
+	// TODO: Save for now but credit type should not exist prior to unit test #893
+	err := s.k.stateStore.CreditTypeTable().Save(s.ctx, &api.CreditType{
+		Abbreviation: a,
+		Name:         a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateClassRequireVerifiedProject) ACreditClassWithClassIdAndAdminAlice(a string) {
+	creditTypeAbbrev := base.GetCreditTypeAbbrevFromClassID(a)
+	err := s.k.stateStore.ClassTable().Insert(s.ctx, &api.Class{
+		Id:               a,
+		Admin:            s.alice,
+		CreditTypeAbbrev: creditTypeAbbrev,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateClassRequireVerifiedProject) AliceAttemptsToRequireAVerifiedProjectWithClassId(a string) {
+	s.res, s.err = s.k.UpdateClassRequireVerifiedProject(s.ctx, &types.MsgUpdateClassRequireVerifiedProject{
+		Admin:                  s.alice.String(),
+		ClassId:                a,
+		RequireVerifiedProject: true,
+	})
+}
+
+func (s *updateClassRequireVerifiedProject) BobAttemptsToRequireAVerifiedProjectWithClassId(a string) {
+	s.res, s.err = s.k.UpdateClassRequireVerifiedProject(s.ctx, &types.MsgUpdateClassRequireVerifiedProject{
+		Admin:                  s.bob.String(),
+		ClassId:                a,
+		RequireVerifiedProject: true,
+	})
+}
+
+func (s *updateClassRequireVerifiedProject) AliceAttemptsToRemoveTheVerifiedProjectRequirementWithClassId(a string) {
+	s.res, s.err = s.k.UpdateClassRequireVerifiedProject(s.ctx, &types.MsgUpdateClassRequireVerifiedProject{
+		Admin:   s.alice.String(),
+		ClassId: a,
+	})
+}
+
+func (s *updateClassRequireVerifiedProject) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *updateClassRequireVerifiedProject) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *updateClassRequireVerifiedProject) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *updateClassRequireVerifiedProject) ExpectCreditClassWithClassIdAndRequireVerifiedProject(a, b string) {
+	requireVerifiedProject, err := strconv.ParseBool(b)
+	require.NoError(s.t, err)
+
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, requireVerifiedProject, class.RequireVerifiedProject)
+}
+
+func (s *updateClassRequireVerifiedProject) ExpectEventWithProperties(a gocuke.DocString) {
+	var event types.EventUpdateClassRequireVerifiedProject
+	err := json.Unmarshal([]byte(a.Content), &event)
+	require.NoError(s.t, err)
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
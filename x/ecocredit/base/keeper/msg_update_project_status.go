@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// UpdateProjectStatus updates the lifecycle status of a project. Only an issuer
+// of the credit class of the project can update the status of the project.
+func (k Keeper) UpdateProjectStatus(ctx context.Context, req *types.MsgUpdateProjectStatus) (*types.MsgUpdateProjectStatusResponse, error) {
+	issuer, err := sdk.AccAddressFromBech32(req.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := k.stateStore.ProjectTable().GetById(ctx, req.ProjectId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get project with id %s: %s", req.ProjectId, err.Error())
+	}
+
+	if err = k.assertClassIssuer(ctx, project.ClassKey, issuer); err != nil {
+		return nil, err
+	}
+
+	oldStatus := types.ProjectStatus(project.Status)
+	if !oldStatus.CanTransitionTo(req.NewStatus) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"cannot update project status from %s to %s", oldStatus, req.NewStatus,
+		)
+	}
+
+	project.Status = api.ProjectStatus(req.NewStatus)
+	project.StatusIri = req.Iri
+	if err = k.stateStore.ProjectTable().Update(ctx, project); err != nil {
+		return nil, err
+	}
+
+	if err = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventUpdateProjectStatus{
+		ProjectId: project.Id,
+		OldStatus: oldStatus,
+		NewStatus: req.NewStatus,
+		Iri:       req.Iri,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateProjectStatusResponse{}, nil
+}
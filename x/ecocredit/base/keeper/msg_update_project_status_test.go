@@ -0,0 +1,126 @@
+//nolint:revive,stylecheck
+package keeper
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type updateProjectStatus struct {
+	*baseSuite
+	alice    sdk.AccAddress
+	bob      sdk.AccAddress
+	classKey uint64
+	res      *types.MsgUpdateProjectStatusResponse
+	err      error
+}
+
+func TestUpdateProjectStatus(t *testing.T) {
+	gocuke.NewRunner(t, &updateProjectStatus{}).Path("./features/msg_update_project_status.feature").Run()
+}
+
+func (s *updateProjectStatus) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *updateProjectStatus) ACreditTypeWithAbbreviation(a string) {
+	err := s.k.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: a,
+		Name:         a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateProjectStatus) ACreditClassWithClassIdAndIssuerAlice(a string) {
+	cKey, err := s.k.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               a,
+		CreditTypeAbbrev: base.GetCreditTypeAbbrevFromClassID(a),
+	})
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.ClassIssuerTable().Insert(s.ctx, &api.ClassIssuer{
+		ClassKey: cKey,
+		Issuer:   s.alice,
+	})
+	require.NoError(s.t, err)
+
+	s.classKey = cKey
+}
+
+func (s *updateProjectStatus) AProjectWithIdAndStatus(a, b string) {
+	err := s.k.stateStore.ProjectTable().Insert(s.ctx, &api.Project{
+		Id:       a,
+		ClassKey: s.classKey,
+		Status:   api.ProjectStatus(api.ProjectStatus_value[b]),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateProjectStatus) AliceAttemptsToUpdateTheStatusOfProjectTo(a, b string) {
+	s.res, s.err = s.k.UpdateProjectStatus(s.ctx, &types.MsgUpdateProjectStatus{
+		Issuer:    s.alice.String(),
+		ProjectId: a,
+		NewStatus: types.ProjectStatus(types.ProjectStatus_value[b]),
+	})
+}
+
+func (s *updateProjectStatus) BobAttemptsToUpdateTheStatusOfProjectTo(a, b string) {
+	s.res, s.err = s.k.UpdateProjectStatus(s.ctx, &types.MsgUpdateProjectStatus{
+		Issuer:    s.bob.String(),
+		ProjectId: a,
+		NewStatus: types.ProjectStatus(types.ProjectStatus_value[b]),
+	})
+}
+
+func (s *updateProjectStatus) AliceAttemptsToUpdateTheStatusOfProjectToWithIri(a, b, c string) {
+	s.res, s.err = s.k.UpdateProjectStatus(s.ctx, &types.MsgUpdateProjectStatus{
+		Issuer:    s.alice.String(),
+		ProjectId: a,
+		NewStatus: types.ProjectStatus(types.ProjectStatus_value[b]),
+		Iri:       c,
+	})
+}
+
+func (s *updateProjectStatus) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *updateProjectStatus) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *updateProjectStatus) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *updateProjectStatus) ExpectProjectWithStatusAndIri(a, b, c string) {
+	project, err := s.k.stateStore.ProjectTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, b, project.Status.String())
+	require.Equal(s.t, c, project.StatusIri)
+}
+
+func (s *updateProjectStatus) ExpectEventWithProperties(a gocuke.DocString) {
+	var event types.EventUpdateProjectStatus
+	err := jsonpb.UnmarshalString(a.Content, &event)
+	require.NoError(s.t, err)
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// getPendingBatchApprovers returns the issuers of the credit class that
+// approved the pending batch. Approvals of accounts that are no longer issuers
+// of the credit class are not included.
+func (k Keeper) getPendingBatchApprovers(ctx context.Context, pendingBatch *api.PendingBatch) ([]sdk.AccAddress, error) {
+	it, err := k.stateStore.PendingBatchApprovalTable().List(ctx,
+		api.PendingBatchApprovalPendingBatchIdApproverIndexKey{}.WithPendingBatchId(pendingBatch.Id),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var approvers []sdk.AccAddress
+	for it.Next() {
+		approval, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		isIssuer, err := k.stateStore.ClassIssuerTable().Has(ctx, pendingBatch.ClassKey, approval.Approver)
+		if err != nil {
+			return nil, err
+		}
+		if isIssuer {
+			approvers = append(approvers, approval.Approver)
+		}
+	}
+
+	return approvers, nil
+}
+
+// createPendingBatchIfApproved creates the credit batch of the pending batch
+// and removes the pending batch if the approvals meet the issuance threshold of
+// the credit class. The batch denom is empty if the credit batch was not
+// created.
+func (k Keeper) createPendingBatchIfApproved(ctx context.Context, pendingBatch *api.PendingBatch) (string, error) {
+	class, err := k.stateStore.ClassTable().Get(ctx, pendingBatch.ClassKey)
+	if err != nil {
+		return "", err
+	}
+
+	approvers, err := k.getPendingBatchApprovers(ctx, pendingBatch)
+	if err != nil {
+		return "", err
+	}
+
+	if uint32(len(approvers)) < class.IssuanceThreshold {
+		return "", nil
+	}
+
+	project, err := k.stateStore.ProjectTable().Get(ctx, pendingBatch.ProjectKey)
+	if err != nil {
+		return "", err
+	}
+
+	issuance := make([]*types.BatchIssuance, len(pendingBatch.Issuance))
+	for i, iss := range pendingBatch.Issuance {
+		issuance[i] = &types.BatchIssuance{
+			Recipient:              sdk.AccAddress(iss.Recipient).String(),
+			TradableAmount:         iss.TradableAmount,
+			RetiredAmount:          iss.RetiredAmount,
+			RetirementJurisdiction: iss.RetirementJurisdiction,
+		}
+	}
+
+	startDate, endDate := pendingBatch.StartDate.AsTime(), pendingBatch.EndDate.AsTime()
+	req := &types.MsgCreateBatch{
+		Issuer:    sdk.AccAddress(pendingBatch.Proposer).String(),
+		ProjectId: project.Id,
+		Issuance:  issuance,
+		Metadata:  pendingBatch.Metadata,
+		StartDate: &startDate,
+		EndDate:   &endDate,
+		Open:      pendingBatch.Open,
+	}
+	if pendingBatch.OriginTx != nil {
+		req.OriginTx = &types.OriginTx{
+			Id:       pendingBatch.OriginTx.Id,
+			Source:   pendingBatch.OriginTx.Source,
+			Contract: pendingBatch.OriginTx.Contract,
+			Note:     pendingBatch.OriginTx.Note,
+		}
+	}
+
+	res, err := k.createBatch(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	if err = k.stateStore.PendingBatchApprovalTable().DeleteBy(ctx,
+		api.PendingBatchApprovalPendingBatchIdApproverIndexKey{}.WithPendingBatchId(pendingBatch.Id),
+	); err != nil {
+		return "", err
+	}
+
+	if err = k.stateStore.PendingBatchTable().Delete(ctx, pendingBatch); err != nil {
+		return "", err
+	}
+
+	return res.BatchDenom, nil
+}
@@ -38,6 +38,8 @@ func (k Keeper) Batch(ctx context.Context, request *types.QueryBatchRequest) (*t
 		EndDate:      regentypes.ProtobufToGogoTimestamp(batch.EndDate),
 		IssuanceDate: regentypes.ProtobufToGogoTimestamp(batch.IssuanceDate),
 		Open:         batch.Open,
+		Suspended:    batch.Suspended,
+		Invalidated:  batch.Invalidated,
 	}
 
 	return &types.QueryBatchResponse{Batch: &info}, nil
@@ -48,6 +48,8 @@ func (k Keeper) Batches(ctx context.Context, request *types.QueryBatchesRequest)
 			EndDate:      regentypes.ProtobufToGogoTimestamp(batch.EndDate),
 			IssuanceDate: regentypes.ProtobufToGogoTimestamp(batch.IssuanceDate),
 			Open:         batch.Open,
+			Suspended:    batch.Suspended,
+			Invalidated:  batch.Invalidated,
 		}
 
 		batches = append(batches, &info)
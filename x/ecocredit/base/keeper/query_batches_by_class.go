@@ -55,6 +55,8 @@ func (k Keeper) BatchesByClass(ctx context.Context, request *types.QueryBatchesB
 			EndDate:      regentypes.ProtobufToGogoTimestamp(batch.EndDate),
 			IssuanceDate: regentypes.ProtobufToGogoTimestamp(batch.IssuanceDate),
 			Open:         batch.Open,
+			Suspended:    batch.Suspended,
+			Invalidated:  batch.Invalidated,
 		}
 
 		batches = append(batches, &info)
@@ -53,6 +53,8 @@ func (k Keeper) BatchesByIssuer(ctx context.Context, req *types.QueryBatchesByIs
 			EndDate:      regentypes.ProtobufToGogoTimestamp(batch.EndDate),
 			IssuanceDate: regentypes.ProtobufToGogoTimestamp(batch.IssuanceDate),
 			Open:         batch.Open,
+			Suspended:    batch.Suspended,
+			Invalidated:  batch.Invalidated,
 		}
 
 		batches = append(batches, &info)
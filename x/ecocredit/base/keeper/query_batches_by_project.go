@@ -49,6 +49,8 @@ func (k Keeper) BatchesByProject(ctx context.Context, request *types.QueryBatche
 			EndDate:      regentypes.ProtobufToGogoTimestamp(batch.EndDate),
 			IssuanceDate: regentypes.ProtobufToGogoTimestamp(batch.IssuanceDate),
 			Open:         batch.Open,
+			Suspended:    batch.Suspended,
+			Invalidated:  batch.Invalidated,
 		}
 
 		batches = append(batches, &info)
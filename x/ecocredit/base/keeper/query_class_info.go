@@ -19,10 +19,14 @@ func (k Keeper) Class(ctx context.Context, request *types.QueryClassRequest) (*t
 	admin := sdk.AccAddress(class.Admin)
 
 	info := types.ClassInfo{
-		Id:               class.Id,
-		Admin:            admin.String(),
-		Metadata:         class.Metadata,
-		CreditTypeAbbrev: class.CreditTypeAbbrev,
+		Id:                     class.Id,
+		Admin:                  admin.String(),
+		Metadata:               class.Metadata,
+		CreditTypeAbbrev:       class.CreditTypeAbbrev,
+		BufferPercentage:       class.BufferPercentage,
+		BufferAccount:          sdk.AccAddress(class.BufferAccount).String(),
+		IssuanceThreshold:      class.IssuanceThreshold,
+		RequireVerifiedProject: class.RequireVerifiedProject,
 	}
 
 	return &types.QueryClassResponse{Class: &info}, nil
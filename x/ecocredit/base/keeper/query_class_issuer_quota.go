@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// ClassIssuerQuota queries the quota of an issuer of a credit class.
+func (k Keeper) ClassIssuerQuota(ctx context.Context, req *types.QueryClassIssuerQuotaRequest) (*types.QueryClassIssuerQuotaResponse, error) {
+	class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get class with id %s: %s", req.ClassId, err.Error())
+	}
+
+	issuer, err := sdk.AccAddressFromBech32(req.Issuer)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("issuer: %s", err)
+	}
+
+	quota, err := k.stateStore.ClassIssuerQuotaTable().Get(ctx, class.Key, issuer)
+	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			return nil, sdkerrors.ErrNotFound.Wrapf("quota of issuer %s of credit class %s", req.Issuer, req.ClassId)
+		}
+		return nil, err
+	}
+
+	info, err := getClassIssuerQuotaInfo(quota, class.Id, sdk.UnwrapSDKContext(ctx).BlockTime())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryClassIssuerQuotaResponse{Quota: info}, nil
+}
+
+// getClassIssuerQuotaInfo returns the human-readable information of the quota
+// of an issuer of the credit class with the given id. The issued amount and the
+// remaining amount are those of the period the block time is in.
+func getClassIssuerQuotaInfo(quota *api.ClassIssuerQuota, classID string, blockTime time.Time) (*types.ClassIssuerQuotaInfo, error) {
+	resetQuotaPeriod(quota, blockTime)
+
+	remaining, err := getRemainingQuotaAmount(quota)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ClassIssuerQuotaInfo{
+		ClassId:         classID,
+		Issuer:          sdk.AccAddress(quota.Issuer).String(),
+		Amount:          quota.Amount,
+		Period:          regentypes.ProtobufToGogoDuration(quota.Period),
+		PeriodStart:     regentypes.ProtobufToGogoTimestamp(quota.PeriodStart),
+		IssuedAmount:    quota.IssuedAmount,
+		RemainingAmount: remaining.String(),
+	}, nil
+}
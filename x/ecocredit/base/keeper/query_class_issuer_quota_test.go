@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_ClassIssuerQuota(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	periodStart := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	period := 24 * time.Hour
+
+	// insert credit class with a quota of the first test account
+	classKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id: "C01",
+	})
+	assert.NilError(t, err)
+	assert.NilError(t, s.stateStore.ClassIssuerQuotaTable().Insert(s.ctx, &api.ClassIssuerQuota{
+		ClassKey:     classKey,
+		Issuer:       s.addr,
+		Amount:       "10",
+		Period:       durationpb.New(period),
+		PeriodStart:  timestamppb.New(periodStart),
+		IssuedAmount: "4",
+	}))
+
+	// query the quota within the current period
+	s.sdkCtx = s.sdkCtx.WithBlockTime(periodStart.Add(time.Hour))
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+	res, err := s.k.ClassIssuerQuota(s.ctx, &types.QueryClassIssuerQuotaRequest{
+		ClassId: "C01",
+		Issuer:  s.addr.String(),
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "C01", res.Quota.ClassId)
+	assert.Equal(t, s.addr.String(), res.Quota.Issuer)
+	assert.Equal(t, "10", res.Quota.Amount)
+	assert.Equal(t, int64(period.Seconds()), res.Quota.Period.Seconds)
+	assert.Equal(t, periodStart.Unix(), res.Quota.PeriodStart.Seconds)
+	assert.Equal(t, "4", res.Quota.IssuedAmount)
+	assert.Equal(t, "6", res.Quota.RemainingAmount)
+
+	// query the quota after the period ended
+	s.sdkCtx = s.sdkCtx.WithBlockTime(periodStart.Add(2*period + time.Hour))
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+	res, err = s.k.ClassIssuerQuota(s.ctx, &types.QueryClassIssuerQuotaRequest{
+		ClassId: "C01",
+		Issuer:  s.addr.String(),
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, periodStart.Add(2*period).Unix(), res.Quota.PeriodStart.Seconds)
+	assert.Equal(t, "0", res.Quota.IssuedAmount)
+	assert.Equal(t, "10", res.Quota.RemainingAmount)
+
+	// query the quota of an issuer without a quota
+	_, err = s.k.ClassIssuerQuota(s.ctx, &types.QueryClassIssuerQuotaRequest{
+		ClassId: "C01",
+		Issuer:  s.addr2.String(),
+	})
+	assert.ErrorContains(t, err, "not found")
+
+	// query the quota of a credit class that does not exist
+	_, err = s.k.ClassIssuerQuota(s.ctx, &types.QueryClassIssuerQuotaRequest{
+		ClassId: "C02",
+		Issuer:  s.addr.String(),
+	})
+	assert.ErrorContains(t, err, "could not get class with id C02")
+}
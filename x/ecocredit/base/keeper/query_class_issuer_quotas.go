@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// ClassIssuerQuotas queries the quotas of all issuers of a credit class.
+func (k Keeper) ClassIssuerQuotas(ctx context.Context, req *types.QueryClassIssuerQuotasRequest) (*types.QueryClassIssuerQuotasResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get class with id %s: %s", req.ClassId, err.Error())
+	}
+
+	it, err := k.stateStore.ClassIssuerQuotaTable().List(ctx, api.ClassIssuerQuotaClassKeyIssuerIndexKey{}.WithClassKey(class.Key), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	quotas := make([]*types.ClassIssuerQuotaInfo, 0)
+	for it.Next() {
+		quota, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := getClassIssuerQuotaInfo(quota, class.Id, blockTime)
+		if err != nil {
+			return nil, err
+		}
+
+		quotas = append(quotas, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryClassIssuerQuotasResponse{
+		Quotas:     quotas,
+		Pagination: pr,
+	}, nil
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_ClassIssuerQuotas(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert two credit classes
+	classKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id: "C01",
+	})
+	assert.NilError(t, err)
+	classKey2, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id: "C02",
+	})
+	assert.NilError(t, err)
+
+	// insert two quotas of "C01" credit class and one quota of "C02" credit class
+	quotas := []*api.ClassIssuerQuota{
+		{ClassKey: classKey, Issuer: s.addr, Amount: "10", IssuedAmount: "4"},
+		{ClassKey: classKey, Issuer: s.addr2, Amount: "20", IssuedAmount: "0"},
+		{ClassKey: classKey2, Issuer: s.addr, Amount: "30", IssuedAmount: "0"},
+	}
+	for _, quota := range quotas {
+		quota.PeriodStart = timestamppb.New(s.sdkCtx.BlockTime())
+		assert.NilError(t, s.stateStore.ClassIssuerQuotaTable().Insert(s.ctx, quota))
+	}
+
+	// query quotas by "C01" credit class
+	res, err := s.k.ClassIssuerQuotas(s.ctx, &types.QueryClassIssuerQuotasRequest{
+		ClassId:    "C01",
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(res.Quotas))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+
+	for _, quota := range res.Quotas {
+		assert.Equal(t, "C01", quota.ClassId)
+		if quota.Issuer == s.addr.String() {
+			assert.Equal(t, "10", quota.Amount)
+			assert.Equal(t, "6", quota.RemainingAmount)
+		}
+	}
+
+	// query quotas by "C01" credit class with pagination
+	res, err = s.k.ClassIssuerQuotas(s.ctx, &types.QueryClassIssuerQuotasRequest{
+		ClassId:    "C01",
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Quotas))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+
+	// query quotas by unknown credit class
+	_, err = s.k.ClassIssuerQuotas(s.ctx, &types.QueryClassIssuerQuotasRequest{ClassId: "C03"})
+	assert.ErrorContains(t, err, "could not get class with id C03")
+}
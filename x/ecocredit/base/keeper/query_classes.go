@@ -34,10 +34,14 @@ func (k Keeper) Classes(ctx context.Context, request *types.QueryClassesRequest)
 		admin := sdk.AccAddress(class.Admin)
 
 		info := types.ClassInfo{
-			Id:               class.Id,
-			Admin:            admin.String(),
-			Metadata:         class.Metadata,
-			CreditTypeAbbrev: class.CreditTypeAbbrev,
+			Id:                     class.Id,
+			Admin:                  admin.String(),
+			Metadata:               class.Metadata,
+			CreditTypeAbbrev:       class.CreditTypeAbbrev,
+			BufferPercentage:       class.BufferPercentage,
+			BufferAccount:          sdk.AccAddress(class.BufferAccount).String(),
+			IssuanceThreshold:      class.IssuanceThreshold,
+			RequireVerifiedProject: class.RequireVerifiedProject,
 		}
 
 		classes = append(classes, &info)
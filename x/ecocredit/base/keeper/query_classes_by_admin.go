@@ -39,10 +39,14 @@ func (k Keeper) ClassesByAdmin(ctx context.Context, req *types.QueryClassesByAdm
 		}
 
 		info := types.ClassInfo{
-			Id:               class.Id,
-			Admin:            adminString,
-			Metadata:         class.Metadata,
-			CreditTypeAbbrev: class.CreditTypeAbbrev,
+			Id:                     class.Id,
+			Admin:                  adminString,
+			Metadata:               class.Metadata,
+			CreditTypeAbbrev:       class.CreditTypeAbbrev,
+			BufferPercentage:       class.BufferPercentage,
+			BufferAccount:          sdk.AccAddress(class.BufferAccount).String(),
+			IssuanceThreshold:      class.IssuanceThreshold,
+			RequireVerifiedProject: class.RequireVerifiedProject,
 		}
 
 		classes = append(classes, &info)
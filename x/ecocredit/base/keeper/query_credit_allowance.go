@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// CreditAllowance queries the credit allowance of a spender for a credit batch
+// of an owner.
+func (k Keeper) CreditAllowance(ctx context.Context, req *types.QueryCreditAllowanceRequest) (*types.QueryCreditAllowanceResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("owner: %s", err.Error())
+	}
+
+	spender, err := sdk.AccAddressFromBech32(req.Spender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("spender: %s", err.Error())
+	}
+
+	batch, err := k.stateStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", req.BatchDenom, err.Error())
+	}
+
+	allowance, err := k.stateStore.CreditAllowanceTable().Get(ctx, owner, spender, batch.Key)
+	if err != nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf(
+			"%s does not have a credit allowance for %s from %s", req.Spender, req.BatchDenom, req.Owner,
+		)
+	}
+
+	return &types.QueryCreditAllowanceResponse{
+		Allowance: getCreditAllowanceInfo(allowance, batch.Denom),
+	}, nil
+}
+
+// getCreditAllowanceInfo returns the human-readable information of the credit
+// allowance.
+func getCreditAllowanceInfo(allowance *api.CreditAllowance, batchDenom string) *types.CreditAllowanceInfo {
+	return &types.CreditAllowanceInfo{
+		Owner:      sdk.AccAddress(allowance.Owner).String(),
+		Spender:    sdk.AccAddress(allowance.Spender).String(),
+		BatchDenom: batchDenom,
+		Amount:     allowance.Amount,
+		Expiration: regentypes.ProtobufToGogoTimestamp(allowance.Expiration),
+	}
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_CreditAllowance(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert credit batch
+	batchKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		Denom: testBatchDenom,
+	})
+	assert.NilError(t, err)
+
+	// insert credit allowance with expiration
+	expiration := time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)
+	assert.NilError(t, s.stateStore.CreditAllowanceTable().Insert(s.ctx, &api.CreditAllowance{
+		Owner:      s.addr,
+		Spender:    s.addr2,
+		BatchKey:   batchKey,
+		Amount:     "5",
+		Expiration: timestamppb.New(expiration),
+	}))
+
+	// query credit allowance
+	res, err := s.k.CreditAllowance(s.ctx, &types.QueryCreditAllowanceRequest{
+		Owner:      s.addr.String(),
+		Spender:    s.addr2.String(),
+		BatchDenom: testBatchDenom,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, s.addr.String(), res.Allowance.Owner)
+	assert.Equal(t, s.addr2.String(), res.Allowance.Spender)
+	assert.Equal(t, testBatchDenom, res.Allowance.BatchDenom)
+	assert.Equal(t, "5", res.Allowance.Amount)
+	assert.Equal(t, expiration.Unix(), res.Allowance.Expiration.Seconds)
+
+	// query credit allowance of a spender without a credit allowance
+	_, err = s.k.CreditAllowance(s.ctx, &types.QueryCreditAllowanceRequest{
+		Owner:      s.addr2.String(),
+		Spender:    s.addr.String(),
+		BatchDenom: testBatchDenom,
+	})
+	assert.ErrorContains(t, err, "does not have a credit allowance")
+
+	// query credit allowance with unknown batch denom
+	_, err = s.k.CreditAllowance(s.ctx, &types.QueryCreditAllowanceRequest{
+		Owner:      s.addr.String(),
+		Spender:    s.addr2.String(),
+		BatchDenom: "C01-001-20200101-20210101-002",
+	})
+	assert.ErrorContains(t, err, "could not get batch with denom C01-001-20200101-20210101-002")
+
+	// query credit allowance with invalid owner address
+	_, err = s.k.CreditAllowance(s.ctx, &types.QueryCreditAllowanceRequest{
+		Owner:      "foo",
+		Spender:    s.addr2.String(),
+		BatchDenom: testBatchDenom,
+	})
+	assert.ErrorContains(t, err, "owner")
+}
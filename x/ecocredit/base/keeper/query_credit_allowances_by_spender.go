@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// CreditAllowancesBySpender queries the credit allowances of a spender.
+func (k Keeper) CreditAllowancesBySpender(ctx context.Context, req *types.QueryCreditAllowancesBySpenderRequest) (*types.QueryCreditAllowancesBySpenderResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	spender, err := sdk.AccAddressFromBech32(req.Spender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("spender: %s", err.Error())
+	}
+
+	it, err := k.stateStore.CreditAllowanceTable().List(ctx, api.CreditAllowanceSpenderIndexKey{}.WithSpender(spender), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	allowances := make([]*types.CreditAllowanceInfo, 0)
+	for it.Next() {
+		allowance, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		batch, err := k.stateStore.BatchTable().Get(ctx, allowance.BatchKey)
+		if err != nil {
+			return nil, err
+		}
+
+		allowances = append(allowances, getCreditAllowanceInfo(allowance, batch.Denom))
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCreditAllowancesBySpenderResponse{
+		Allowances: allowances,
+		Pagination: pr,
+	}, nil
+}
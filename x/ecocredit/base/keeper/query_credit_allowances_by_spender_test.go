@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_CreditAllowancesBySpender(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert two credit batches
+	batchKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		Denom: "C01-001-20200101-20210101-001",
+	})
+	assert.NilError(t, err)
+	batchKey2, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		Denom: "C01-001-20200101-20210101-002",
+	})
+	assert.NilError(t, err)
+
+	// insert two credit allowances of addr2 and one credit allowance of addr
+	allowances := []*api.CreditAllowance{
+		{Owner: s.addr, Spender: s.addr2, BatchKey: batchKey, Amount: "5"},
+		{Owner: s.addr, Spender: s.addr2, BatchKey: batchKey2, Amount: "10"},
+		{Owner: s.addr2, Spender: s.addr, BatchKey: batchKey, Amount: "15"},
+	}
+	for _, allowance := range allowances {
+		assert.NilError(t, s.stateStore.CreditAllowanceTable().Insert(s.ctx, allowance))
+	}
+
+	// query credit allowances of addr2
+	res, err := s.k.CreditAllowancesBySpender(s.ctx, &types.QueryCreditAllowancesBySpenderRequest{
+		Spender:    s.addr2.String(),
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(res.Allowances))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+
+	for _, allowance := range res.Allowances {
+		assert.Equal(t, s.addr.String(), allowance.Owner)
+		assert.Equal(t, s.addr2.String(), allowance.Spender)
+		if allowance.BatchDenom == "C01-001-20200101-20210101-002" {
+			assert.Equal(t, "10", allowance.Amount)
+		}
+	}
+
+	// query credit allowances of addr2 with pagination
+	res, err = s.k.CreditAllowancesBySpender(s.ctx, &types.QueryCreditAllowancesBySpenderRequest{
+		Spender:    s.addr2.String(),
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Allowances))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+
+	// query credit allowances of a spender without credit allowances
+	res, err = s.k.CreditAllowancesBySpender(s.ctx, &types.QueryCreditAllowancesBySpenderRequest{
+		Spender: genAddrs(1)[0].String(),
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(res.Allowances))
+
+	// query credit allowances with invalid spender address
+	_, err = s.k.CreditAllowancesBySpender(s.ctx, &types.QueryCreditAllowancesBySpenderRequest{
+		Spender: "foo",
+	})
+	assert.ErrorContains(t, err, "spender")
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// PendingBatch queries a pending batch by id.
+func (k Keeper) PendingBatch(ctx context.Context, req *types.QueryPendingBatchRequest) (*types.QueryPendingBatchResponse, error) {
+	pendingBatch, err := k.stateStore.PendingBatchTable().Get(ctx, req.PendingBatchId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"could not get pending batch with id %d: %s", req.PendingBatchId, err,
+		)
+	}
+
+	class, err := k.stateStore.ClassTable().Get(ctx, pendingBatch.ClassKey)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := k.getPendingBatchInfo(ctx, pendingBatch, class.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPendingBatchResponse{PendingBatch: info}, nil
+}
+
+// getPendingBatchInfo returns the human-readable information of the pending
+// batch including the issuers that approved the pending batch.
+func (k Keeper) getPendingBatchInfo(ctx context.Context, pendingBatch *api.PendingBatch, classID string) (*types.PendingBatchInfo, error) {
+	project, err := k.stateStore.ProjectTable().Get(ctx, pendingBatch.ProjectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	approvers, err := k.getPendingBatchApprovers(ctx, pendingBatch)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &types.PendingBatchInfo{
+		Id:         pendingBatch.Id,
+		ClassId:    classID,
+		ProjectId:  project.Id,
+		Proposer:   sdk.AccAddress(pendingBatch.Proposer).String(),
+		Issuance:   make([]*types.BatchIssuance, len(pendingBatch.Issuance)),
+		Metadata:   pendingBatch.Metadata,
+		StartDate:  regentypes.ProtobufToGogoTimestamp(pendingBatch.StartDate),
+		EndDate:    regentypes.ProtobufToGogoTimestamp(pendingBatch.EndDate),
+		ProposedAt: regentypes.ProtobufToGogoTimestamp(pendingBatch.ProposedAt),
+		Approvers:  make([]string, len(approvers)),
+		Open:       pendingBatch.Open,
+	}
+
+	for i, iss := range pendingBatch.Issuance {
+		info.Issuance[i] = &types.BatchIssuance{
+			Recipient:              sdk.AccAddress(iss.Recipient).String(),
+			TradableAmount:         iss.TradableAmount,
+			RetiredAmount:          iss.RetiredAmount,
+			RetirementJurisdiction: iss.RetirementJurisdiction,
+		}
+	}
+
+	if pendingBatch.OriginTx != nil {
+		info.OriginTx = &types.OriginTx{
+			Id:       pendingBatch.OriginTx.Id,
+			Source:   pendingBatch.OriginTx.Source,
+			Contract: pendingBatch.OriginTx.Contract,
+			Note:     pendingBatch.OriginTx.Note,
+		}
+	}
+
+	for i, approver := range approvers {
+		info.Approvers[i] = approver.String()
+	}
+
+	return info, nil
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_PendingBatch(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert credit class with two issuers and a project
+	classKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id: "C01",
+	})
+	assert.NilError(t, err)
+	for _, issuer := range [][]byte{s.addr, s.addr2} {
+		assert.NilError(t, s.stateStore.ClassIssuerTable().Insert(s.ctx, &api.ClassIssuer{
+			ClassKey: classKey,
+			Issuer:   issuer,
+		}))
+	}
+	projectKey, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
+		Id:       "C01-001",
+		ClassKey: classKey,
+	})
+	assert.NilError(t, err)
+
+	startDate := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	id, err := s.stateStore.PendingBatchTable().InsertReturningID(s.ctx, &api.PendingBatch{
+		ClassKey:   classKey,
+		ProjectKey: projectKey,
+		Proposer:   s.addr,
+		Issuance: []*api.PendingBatch_Issuance{
+			{Recipient: s.addr2, TradableAmount: "10"},
+		},
+		StartDate:  timestamppb.New(startDate),
+		EndDate:    timestamppb.New(endDate),
+		ProposedAt: timestamppb.New(startDate),
+		OriginTx:   &api.PendingBatch_OriginTx{Id: "0x1", Source: "polygon"},
+		Open:       true,
+	})
+	assert.NilError(t, err)
+
+	// insert approvals of the proposer and an account that is not an issuer
+	for _, approver := range [][]byte{s.addr, genAddrs(1)[0]} {
+		assert.NilError(t, s.stateStore.PendingBatchApprovalTable().Insert(s.ctx, &api.PendingBatchApproval{
+			PendingBatchId: id,
+			Approver:       approver,
+		}))
+	}
+
+	// query pending batch by id
+	res, err := s.k.PendingBatch(s.ctx, &types.QueryPendingBatchRequest{PendingBatchId: id})
+	assert.NilError(t, err)
+	assert.Equal(t, id, res.PendingBatch.Id)
+	assert.Equal(t, "C01", res.PendingBatch.ClassId)
+	assert.Equal(t, "C01-001", res.PendingBatch.ProjectId)
+	assert.Equal(t, s.addr.String(), res.PendingBatch.Proposer)
+	assert.Equal(t, s.addr2.String(), res.PendingBatch.Issuance[0].Recipient)
+	assert.Equal(t, "10", res.PendingBatch.Issuance[0].TradableAmount)
+	assert.Equal(t, startDate.Unix(), res.PendingBatch.StartDate.Seconds)
+	assert.Equal(t, endDate.Unix(), res.PendingBatch.EndDate.Seconds)
+	assert.Equal(t, "0x1", res.PendingBatch.OriginTx.Id)
+	assert.Equal(t, true, res.PendingBatch.Open)
+
+	// approvals of accounts that are not issuers are not included
+	assert.DeepEqual(t, []string{s.addr.String()}, res.PendingBatch.Approvers)
+
+	// query pending batch by unknown id
+	_, err = s.k.PendingBatch(s.ctx, &types.QueryPendingBatchRequest{PendingBatchId: 2})
+	assert.ErrorContains(t, err, "could not get pending batch with id 2")
+}
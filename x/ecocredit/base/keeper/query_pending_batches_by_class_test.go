@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_PendingBatchesByClass(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert two credit classes with a project each
+	for _, id := range []string{"C01", "C02"} {
+		classKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+			Id: id,
+		})
+		assert.NilError(t, err)
+		assert.NilError(t, s.stateStore.ProjectTable().Insert(s.ctx, &api.Project{
+			Id:       id + "-001",
+			ClassKey: classKey,
+		}))
+	}
+
+	// insert two pending batches of "C01" credit class and one pending batch
+	// of "C02" credit class
+	for _, key := range []uint64{1, 1, 2} {
+		assert.NilError(t, s.stateStore.PendingBatchTable().Insert(s.ctx, &api.PendingBatch{
+			ClassKey:   key,
+			ProjectKey: key,
+			Proposer:   s.addr,
+			StartDate:  timestamppb.Now(),
+			EndDate:    timestamppb.Now(),
+			ProposedAt: timestamppb.Now(),
+		}))
+	}
+
+	// query pending batches by "C01" credit class
+	res, err := s.k.PendingBatchesByClass(s.ctx, &types.QueryPendingBatchesByClassRequest{
+		ClassId:    "C01",
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(res.PendingBatches))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+	for _, pendingBatch := range res.PendingBatches {
+		assert.Equal(t, "C01", pendingBatch.ClassId)
+		assert.Equal(t, "C01-001", pendingBatch.ProjectId)
+	}
+
+	// query pending batches by "C01" credit class with pagination
+	res, err = s.k.PendingBatchesByClass(s.ctx, &types.QueryPendingBatchesByClassRequest{
+		ClassId:    "C01",
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.PendingBatches))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+
+	// query pending batches by unknown credit class
+	_, err = s.k.PendingBatchesByClass(s.ctx, &types.QueryPendingBatchesByClassRequest{ClassId: "C03"})
+	assert.ErrorContains(t, err, "could not get class with id C03")
+}
@@ -30,6 +30,8 @@ func (k Keeper) Project(ctx context.Context, request *types.QueryProjectRequest)
 		Jurisdiction: project.Jurisdiction,
 		Metadata:     project.Metadata,
 		ReferenceId:  project.ReferenceId,
+		Status:       types.ProjectStatus(project.Status),
+		StatusIri:    project.StatusIri,
 	}
 
 	return &types.QueryProjectResponse{Project: &info}, nil
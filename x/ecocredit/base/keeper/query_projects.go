@@ -45,6 +45,8 @@ func (k Keeper) Projects(ctx context.Context, request *types.QueryProjectsReques
 			Jurisdiction: project.Jurisdiction,
 			Metadata:     project.Metadata,
 			ReferenceId:  project.ReferenceId,
+			Status:       types.ProjectStatus(project.Status),
+			StatusIri:    project.StatusIri,
 		}
 
 		projects = append(projects, &info)
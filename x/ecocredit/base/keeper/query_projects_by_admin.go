@@ -48,6 +48,8 @@ func (k Keeper) ProjectsByAdmin(ctx context.Context, req *types.QueryProjectsByA
 			Jurisdiction: project.Jurisdiction,
 			Metadata:     project.Metadata,
 			ReferenceId:  project.ReferenceId,
+			Status:       types.ProjectStatus(project.Status),
+			StatusIri:    project.StatusIri,
 		})
 	}
 
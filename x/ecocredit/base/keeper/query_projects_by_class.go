@@ -51,6 +51,8 @@ func (k Keeper) ProjectsByClass(ctx context.Context, request *types.QueryProject
 			Jurisdiction: project.Jurisdiction,
 			Metadata:     project.Metadata,
 			ReferenceId:  project.ReferenceId,
+			Status:       types.ProjectStatus(project.Status),
+			StatusIri:    project.StatusIri,
 		}
 
 		projects = append(projects, &info)
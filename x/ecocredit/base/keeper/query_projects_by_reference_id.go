@@ -50,6 +50,8 @@ func (k Keeper) ProjectsByReferenceId(ctx context.Context, req *types.QueryProje
 			Jurisdiction: project.Jurisdiction,
 			Metadata:     project.Metadata,
 			ReferenceId:  project.ReferenceId,
+			Status:       types.ProjectStatus(project.Status),
+			StatusIri:    project.StatusIri,
 		}
 
 		projects = append(projects, info)
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// ProjectsByStatus queries projects by lifecycle status.
+func (k Keeper) ProjectsByStatus(ctx context.Context, req *types.QueryProjectsByStatusRequest) (*types.QueryProjectsByStatusResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	ormIt, err := k.projectStatusIndex.List(ctx, []interface{}{protoreflect.EnumNumber(req.Status)}, ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	it := api.ProjectIterator{Iterator: ormIt}
+	defer it.Close()
+
+	projects := make([]*types.ProjectInfo, 0)
+	for it.Next() {
+		project, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		class, err := k.stateStore.ClassTable().Get(ctx, project.ClassKey)
+		if err != nil {
+			return nil, err
+		}
+
+		projects = append(projects, &types.ProjectInfo{
+			Id:           project.Id,
+			Admin:        sdk.AccAddress(project.Admin).String(),
+			ClassId:      class.Id,
+			Jurisdiction: project.Jurisdiction,
+			Metadata:     project.Metadata,
+			ReferenceId:  project.ReferenceId,
+			Status:       types.ProjectStatus(project.Status),
+			StatusIri:    project.StatusIri,
+		})
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryProjectsByStatusResponse{Projects: projects, Pagination: pr}, nil
+}
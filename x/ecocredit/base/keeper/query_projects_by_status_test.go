@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_ProjectsByStatus(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert credit class
+	classKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id: "C01",
+	})
+	assert.NilError(t, err)
+
+	project := &api.Project{
+		Id:        "C01-001",
+		Admin:     s.addr,
+		ClassKey:  classKey,
+		Status:    api.ProjectStatus_PROJECT_STATUS_VERIFIED,
+		StatusIri: "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGxGREWGKjEdXYG.rdf",
+	}
+
+	// insert one verified and two proposed projects under "C01" credit class
+	assert.NilError(t, s.stateStore.ProjectTable().Insert(s.ctx, project))
+	for _, id := range []string{"C01-002", "C01-003"} {
+		assert.NilError(t, s.stateStore.ProjectTable().Insert(s.ctx, &api.Project{
+			Id:       id,
+			ClassKey: classKey,
+			Status:   api.ProjectStatus_PROJECT_STATUS_PROPOSED,
+		}))
+	}
+
+	// query verified projects
+	res, err := s.k.ProjectsByStatus(s.ctx, &types.QueryProjectsByStatusRequest{
+		Status: types.ProjectStatus_PROJECT_STATUS_VERIFIED,
+	})
+	assert.NilError(t, err)
+
+	// check project properties
+	assert.Equal(t, 1, len(res.Projects))
+	assert.Equal(t, project.Id, res.Projects[0].Id)
+	assert.Equal(t, "C01", res.Projects[0].ClassId)
+	assert.Equal(t, s.addr.String(), res.Projects[0].Admin)
+	assert.Equal(t, types.ProjectStatus_PROJECT_STATUS_VERIFIED, res.Projects[0].Status)
+	assert.Equal(t, project.StatusIri, res.Projects[0].StatusIri)
+
+	// query proposed projects with offset pagination
+	res, err = s.k.ProjectsByStatus(s.ctx, &types.QueryProjectsByStatusRequest{
+		Status:     types.ProjectStatus_PROJECT_STATUS_PROPOSED,
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+
+	// check pagination
+	assert.Equal(t, 1, len(res.Projects))
+	assert.Equal(t, "C01-003", res.Projects[0].Id)
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+
+	// query proposed projects with key pagination
+	res, err = s.k.ProjectsByStatus(s.ctx, &types.QueryProjectsByStatusRequest{
+		Status:     types.ProjectStatus_PROJECT_STATUS_PROPOSED,
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Projects))
+	assert.Equal(t, "C01-002", res.Projects[0].Id)
+
+	res, err = s.k.ProjectsByStatus(s.ctx, &types.QueryProjectsByStatusRequest{
+		Status:     types.ProjectStatus_PROJECT_STATUS_PROPOSED,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Projects))
+	assert.Equal(t, "C01-003", res.Projects[0].Id)
+
+	// query completed projects
+	res, err = s.k.ProjectsByStatus(s.ctx, &types.QueryProjectsByStatusRequest{
+		Status: types.ProjectStatus_PROJECT_STATUS_COMPLETED,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(res.Projects))
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// Retirement queries a retirement record by its unique identifier.
+func (k Keeper) Retirement(ctx context.Context, req *types.QueryRetirementRequest) (*types.QueryRetirementResponse, error) {
+	retirement, err := k.stateStore.RetirementTable().Get(ctx, req.Id)
+	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			return nil, sdkerrors.ErrNotFound.Wrapf("retirement with id %d", req.Id)
+		}
+		return nil, err
+	}
+
+	batch, err := k.stateStore.BatchTable().Get(ctx, retirement.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRetirementResponse{
+		Retirement: getRetirementInfo(retirement, batch.Denom),
+	}, nil
+}
+
+// getRetirementInfo returns the human-readable information of the retirement
+// record of a credit batch with the given denom.
+func getRetirementInfo(retirement *api.Retirement, batchDenom string) *types.RetirementInfo {
+	return &types.RetirementInfo{
+		Id:           retirement.Id,
+		Owner:        sdk.AccAddress(retirement.Owner).String(),
+		BatchDenom:   batchDenom,
+		Amount:       retirement.Amount,
+		Jurisdiction: retirement.Jurisdiction,
+		Reason:       retirement.Reason,
+		Beneficiary:  retirement.Beneficiary,
+		Timestamp:    regentypes.ProtobufToGogoTimestamp(retirement.Timestamp),
+	}
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// RetirementsByBatch queries all retirement records of a given credit batch.
+func (k Keeper) RetirementsByBatch(ctx context.Context, req *types.QueryRetirementsByBatchRequest) (*types.QueryRetirementsByBatchResponse, error) {
+	batch, err := k.stateStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", req.BatchDenom, err.Error())
+	}
+
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.RetirementTable().List(ctx, api.RetirementBatchKeyIndexKey{}.WithBatchKey(batch.Key), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	retirements := make([]*types.RetirementInfo, 0)
+	for it.Next() {
+		retirement, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		retirements = append(retirements, getRetirementInfo(retirement, batch.Denom))
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRetirementsByBatchResponse{
+		Retirements: retirements,
+		Pagination:  pr,
+	}, nil
+}
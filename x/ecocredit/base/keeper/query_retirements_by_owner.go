@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// RetirementsByOwner queries all retirement records of a given owner.
+func (k Keeper) RetirementsByOwner(ctx context.Context, req *types.QueryRetirementsByOwnerRequest) (*types.QueryRetirementsByOwnerResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("owner: %s", err)
+	}
+
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.RetirementTable().List(ctx, api.RetirementOwnerIndexKey{}.WithOwner(owner), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	batchDenoms := make(map[uint64]string)
+	retirements := make([]*types.RetirementInfo, 0)
+	for it.Next() {
+		retirement, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		batchDenom, ok := batchDenoms[retirement.BatchKey]
+		if !ok {
+			batch, err := k.stateStore.BatchTable().Get(ctx, retirement.BatchKey)
+			if err != nil {
+				return nil, err
+			}
+			batchDenom = batch.Denom
+			batchDenoms[retirement.BatchKey] = batchDenom
+		}
+
+		retirements = append(retirements, getRetirementInfo(retirement, batchDenom))
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRetirementsByOwnerResponse{
+		Retirements: retirements,
+		Pagination:  pr,
+	}, nil
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestRetirements(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	batchDenom := testBatchDenom
+	batchKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{Denom: batchDenom})
+	require.NoError(t, err)
+
+	retirements := []*api.Retirement{
+		{Owner: s.addr, BatchKey: batchKey, Amount: "10", Jurisdiction: "US-WA", Reason: "offset", Beneficiary: "acme"},
+		{Owner: s.addr, BatchKey: batchKey, Amount: "5", Jurisdiction: "US-OR"},
+		{Owner: s.addr2, BatchKey: batchKey, Amount: "1", Jurisdiction: "US-WA"},
+	}
+	for _, retirement := range retirements {
+		retirement.Timestamp = timestamppb.Now()
+		retirement.Id, err = s.stateStore.RetirementTable().InsertReturningID(s.ctx, retirement)
+		require.NoError(t, err)
+	}
+
+	// query retirement by id
+	res, err := s.k.Retirement(s.ctx, &types.QueryRetirementRequest{Id: 1})
+	require.NoError(t, err)
+	s.assertRetirementEqual(retirements[0], batchDenom, res.Retirement)
+
+	// retirement not found
+	_, err = s.k.Retirement(s.ctx, &types.QueryRetirementRequest{Id: 4})
+	require.ErrorContains(t, err, "retirement with id 4: not found")
+
+	// query retirements by owner
+	ownerRes, err := s.k.RetirementsByOwner(s.ctx, &types.QueryRetirementsByOwnerRequest{
+		Owner:      s.addr.String(),
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, ownerRes.Retirements, 1)
+	require.Equal(t, uint64(2), ownerRes.Pagination.Total)
+	s.assertRetirementEqual(retirements[0], batchDenom, ownerRes.Retirements[0])
+
+	// invalid owner address
+	_, err = s.k.RetirementsByOwner(s.ctx, &types.QueryRetirementsByOwnerRequest{Owner: "foo"})
+	require.ErrorContains(t, err, "owner")
+
+	// query retirements by batch
+	batchRes, err := s.k.RetirementsByBatch(s.ctx, &types.QueryRetirementsByBatchRequest{
+		BatchDenom: batchDenom,
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, batchRes.Retirements, 3)
+	require.Equal(t, uint64(3), batchRes.Pagination.Total)
+	for i, retirement := range batchRes.Retirements {
+		s.assertRetirementEqual(retirements[i], batchDenom, retirement)
+	}
+
+	// batch not found
+	_, err = s.k.RetirementsByBatch(s.ctx, &types.QueryRetirementsByBatchRequest{BatchDenom: "C01-001-20200101-20210101-099"})
+	require.ErrorContains(t, err, "could not get batch with denom C01-001-20200101-20210101-099: not found")
+}
+
+func (s *baseSuite) assertRetirementEqual(expected *api.Retirement, batchDenom string, info *types.RetirementInfo) {
+	require.Equal(s.t, expected.Id, info.Id)
+	require.Equal(s.t, sdk.AccAddress(expected.Owner).String(), info.Owner)
+	require.Equal(s.t, batchDenom, info.BatchDenom)
+	require.Equal(s.t, expected.Amount, info.Amount)
+	require.Equal(s.t, expected.Jurisdiction, info.Jurisdiction)
+	require.Equal(s.t, expected.Reason, info.Reason)
+	require.Equal(s.t, expected.Beneficiary, info.Beneficiary)
+	require.Equal(s.t, expected.Timestamp.Seconds, info.Timestamp.Seconds)
+}
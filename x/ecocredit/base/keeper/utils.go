@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/types/math"
@@ -25,6 +26,43 @@ func (k Keeper) assertClassIssuer(goCtx context.Context, classKey uint64, addr s
 	return nil
 }
 
+// assertBatchAdmin makes sure that the signer is either the admin of the credit
+// class of the credit batch or the governance account. Returns ErrUnauthorized
+// otherwise.
+func (k Keeper) assertBatchAdmin(ctx context.Context, batch *api.Batch, signer sdk.AccAddress) error {
+	if k.authority.Equals(signer) {
+		return nil
+	}
+
+	project, err := k.stateStore.ProjectTable().Get(ctx, batch.ProjectKey)
+	if err != nil {
+		return err
+	}
+
+	class, err := k.stateStore.ClassTable().Get(ctx, project.ClassKey)
+	if err != nil {
+		return err
+	}
+
+	if !sdk.AccAddress(class.Admin).Equals(signer) {
+		return sdkerrors.ErrUnauthorized.Wrapf(
+			"%s is not the admin of credit class %s or the governance account", signer, class.Id,
+		)
+	}
+
+	return nil
+}
+
+// assertProjectVerified makes sure that the project is verified if the credit
+// class of the project requires a verified project to issue credits. Returns
+// ErrInvalidRequest otherwise.
+func assertProjectVerified(class *api.Class, project *api.Project) error {
+	if class.RequireVerifiedProject && project.Status != api.ProjectStatus_PROJECT_STATUS_VERIFIED {
+		return sdkerrors.ErrInvalidRequest.Wrapf("project %s is not verified", project.Id)
+	}
+	return nil
+}
+
 // AddAndSaveBalance adds 'amt' to the addr's tradable balance.
 func AddAndSaveBalance(ctx context.Context, table api.BatchBalanceTable, addr sdk.AccAddress, batchKey uint64, amt math.Dec) error {
 	bal, err := utils.GetBalance(ctx, table, addr, batchKey)
@@ -102,3 +140,10 @@ func RetireSupply(ctx context.Context, table api.BatchSupplyTable, batchKey uint
 
 	return table.Update(ctx, supply)
 }
+
+// SaveRetirement stores a record of the retirement at the current block time
+// and returns the id of the retirement.
+func SaveRetirement(ctx context.Context, table api.RetirementTable, retirement *api.Retirement) (uint64, error) {
+	retirement.Timestamp = timestamppb.New(sdk.UnwrapSDKContext(ctx).BlockTime())
+	return table.InsertReturningID(ctx, retirement)
+}
@@ -0,0 +1,872 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: regen/ecocredit/v1/authz.proto
+
+package v1
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CreditLimit defines the remaining amount of credits that can be sent or
+// retired from a credit batch or from the credit batches of a credit class.
+//
+// Since Revision 1
+type CreditLimit struct {
+	// batch_denom is the unique identifier of the credit batch. Either
+	// batch_denom or class_id must be set.
+	BatchDenom string `protobuf:"bytes,1,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// class_id is the unique identifier of the credit class. The limit applies
+	// to all credit batches of the credit class without a limit of their own.
+	// Either batch_denom or class_id must be set.
+	ClassId string `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// amount is the remaining amount of credits (tradable and retired) that can
+	// be sent or retired.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *CreditLimit) Reset()         { *m = CreditLimit{} }
+func (m *CreditLimit) String() string { return proto.CompactTextString(m) }
+func (*CreditLimit) ProtoMessage()    {}
+func (*CreditLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_286496a791d9f056, []int{0}
+}
+func (m *CreditLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreditLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreditLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreditLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreditLimit.Merge(m, src)
+}
+func (m *CreditLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreditLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreditLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreditLimit proto.InternalMessageInfo
+
+func (m *CreditLimit) GetBatchDenom() string {
+	if m != nil {
+		return m.BatchDenom
+	}
+	return ""
+}
+
+func (m *CreditLimit) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *CreditLimit) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+// CreditSendAuthorization allows the grantee to send credits of the granter
+// with MsgSend up to the credit limits and only to the allowed recipients.
+//
+// Since Revision 1
+type CreditSendAuthorization struct {
+	// spend_limits are the limits of the credits that can be sent. Credits of
+	// a credit batch without a matching limit cannot be sent.
+	SpendLimits []*CreditLimit `protobuf:"bytes,1,rep,name=spend_limits,json=spendLimits,proto3" json:"spend_limits,omitempty"`
+	// allowed_recipients are the addresses of the accounts that can receive the
+	// credits. If empty, the credits can be sent to any account.
+	AllowedRecipients []string `protobuf:"bytes,2,rep,name=allowed_recipients,json=allowedRecipients,proto3" json:"allowed_recipients,omitempty"`
+}
+
+func (m *CreditSendAuthorization) Reset()         { *m = CreditSendAuthorization{} }
+func (m *CreditSendAuthorization) String() string { return proto.CompactTextString(m) }
+func (*CreditSendAuthorization) ProtoMessage()    {}
+func (*CreditSendAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_286496a791d9f056, []int{1}
+}
+func (m *CreditSendAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreditSendAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreditSendAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreditSendAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreditSendAuthorization.Merge(m, src)
+}
+func (m *CreditSendAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreditSendAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreditSendAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreditSendAuthorization proto.InternalMessageInfo
+
+func (m *CreditSendAuthorization) GetSpendLimits() []*CreditLimit {
+	if m != nil {
+		return m.SpendLimits
+	}
+	return nil
+}
+
+func (m *CreditSendAuthorization) GetAllowedRecipients() []string {
+	if m != nil {
+		return m.AllowedRecipients
+	}
+	return nil
+}
+
+// CreditRetireAuthorization allows the grantee to retire credits of the
+// granter with MsgRetire up to the credit limits.
+//
+// Since Revision 1
+type CreditRetireAuthorization struct {
+	// retire_limits are the limits of the credits that can be retired. Credits
+	// of a credit batch without a matching limit cannot be retired.
+	RetireLimits []*CreditLimit `protobuf:"bytes,1,rep,name=retire_limits,json=retireLimits,proto3" json:"retire_limits,omitempty"`
+}
+
+func (m *CreditRetireAuthorization) Reset()         { *m = CreditRetireAuthorization{} }
+func (m *CreditRetireAuthorization) String() string { return proto.CompactTextString(m) }
+func (*CreditRetireAuthorization) ProtoMessage()    {}
+func (*CreditRetireAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_286496a791d9f056, []int{2}
+}
+func (m *CreditRetireAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreditRetireAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreditRetireAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreditRetireAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreditRetireAuthorization.Merge(m, src)
+}
+func (m *CreditRetireAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreditRetireAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreditRetireAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreditRetireAuthorization proto.InternalMessageInfo
+
+func (m *CreditRetireAuthorization) GetRetireLimits() []*CreditLimit {
+	if m != nil {
+		return m.RetireLimits
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*CreditLimit)(nil), "regen.ecocredit.v1.CreditLimit")
+	proto.RegisterType((*CreditSendAuthorization)(nil), "regen.ecocredit.v1.CreditSendAuthorization")
+	proto.RegisterType((*CreditRetireAuthorization)(nil), "regen.ecocredit.v1.CreditRetireAuthorization")
+}
+
+func init() { proto.RegisterFile("regen/ecocredit/v1/authz.proto", fileDescriptor_286496a791d9f056) }
+
+var fileDescriptor_286496a791d9f056 = []byte{
+	// 357 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xb1, 0x6e, 0xe2, 0x30,
+	0x1c, 0xc6, 0x09, 0x48, 0xdc, 0xe1, 0xc0, 0x80, 0x87, 0xbb, 0x70, 0x43, 0x40, 0x4c, 0x2c, 0xc4,
+	0xe2, 0x6e, 0xbb, 0xa5, 0x2a, 0x65, 0xa9, 0xd4, 0x29, 0x9d, 0xda, 0x25, 0x72, 0xe2, 0xbf, 0x88,
+	0xd5, 0xc4, 0x8e, 0x6c, 0x07, 0x5a, 0x9e, 0xa2, 0x6f, 0xd0, 0x97, 0xe8, 0x43, 0x54, 0x9d, 0x18,
+	0x3b, 0x56, 0xf0, 0x22, 0x15, 0x0e, 0x42, 0xa0, 0x76, 0xe9, 0x96, 0xef, 0xff, 0xfb, 0xfe, 0xf9,
+	0x3e, 0xcb, 0x46, 0xbe, 0x82, 0x39, 0x08, 0x02, 0x89, 0x4c, 0x14, 0x30, 0x6e, 0xc8, 0x62, 0x42,
+	0x68, 0x69, 0xd2, 0x55, 0x50, 0x28, 0x69, 0x24, 0xc6, 0x96, 0x07, 0x07, 0x1e, 0x2c, 0x26, 0x7f,
+	0x7a, 0x89, 0xd4, 0xb9, 0xd4, 0x91, 0x75, 0x90, 0x4a, 0x54, 0xf6, 0x21, 0x45, 0xee, 0x85, 0xf5,
+	0x5d, 0xf1, 0x9c, 0x1b, 0xdc, 0x47, 0x6e, 0x4c, 0x4d, 0x92, 0x46, 0x0c, 0x84, 0xcc, 0x3d, 0x67,
+	0xe0, 0x8c, 0x5a, 0x21, 0xb2, 0xa3, 0xd9, 0x6e, 0x82, 0x7b, 0xe8, 0x67, 0x92, 0x51, 0xad, 0x23,
+	0xce, 0xbc, 0xba, 0xa5, 0x3f, 0xac, 0xbe, 0x64, 0xf8, 0x17, 0x6a, 0xd2, 0x5c, 0x96, 0xc2, 0x78,
+	0x0d, 0x0b, 0xf6, 0x6a, 0xf8, 0xe4, 0xa0, 0xdf, 0x55, 0xc6, 0x35, 0x08, 0x76, 0x5e, 0x9a, 0x54,
+	0x2a, 0xbe, 0xa2, 0x86, 0x4b, 0x81, 0xa7, 0xa8, 0xad, 0x0b, 0x10, 0x2c, 0xca, 0x76, 0xf1, 0xda,
+	0x73, 0x06, 0x8d, 0x91, 0xfb, 0xb7, 0x1f, 0x7c, 0x3e, 0x44, 0x70, 0x54, 0x33, 0x74, 0xed, 0x92,
+	0xfd, 0xd6, 0x78, 0x8c, 0x30, 0xcd, 0x32, 0xb9, 0x04, 0x16, 0x29, 0x48, 0x78, 0xc1, 0x41, 0x18,
+	0xed, 0xd5, 0x07, 0x8d, 0x51, 0x2b, 0xec, 0xee, 0x49, 0x78, 0x00, 0xff, 0xbb, 0xaf, 0xcf, 0xe3,
+	0xce, 0x49, 0x8b, 0xa1, 0x41, 0xbd, 0xea, 0xef, 0x21, 0x18, 0xae, 0xe0, 0xb4, 0xe2, 0x0c, 0x75,
+	0x94, 0x1d, 0x7f, 0xb3, 0x63, 0xbb, 0xda, 0xaa, 0x4a, 0x7e, 0x91, 0x3a, 0xbd, 0x79, 0xd9, 0xf8,
+	0xce, 0x7a, 0xe3, 0x3b, 0xef, 0x1b, 0xdf, 0x79, 0xdc, 0xfa, 0xb5, 0xf5, 0xd6, 0xaf, 0xbd, 0x6d,
+	0xfd, 0xda, 0xed, 0xd9, 0x9c, 0x9b, 0xb4, 0x8c, 0x83, 0x44, 0xe6, 0xc4, 0xa6, 0x8c, 0x05, 0x98,
+	0xa5, 0x54, 0x77, 0x7b, 0x95, 0x01, 0x9b, 0x83, 0x22, 0xf7, 0x47, 0xaf, 0x20, 0xa6, 0x1a, 0x88,
+	0x79, 0x28, 0x40, 0x93, 0xc5, 0x24, 0x6e, 0xda, 0xcb, 0xfd, 0xf7, 0x31, 0x00, 0x8a, 0xb7, 0x38,
+	0xdf, 0x2d, 0x02, 0x00, 0x00,
+}
+
+func (m *CreditLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreditLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreditLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BatchDenom) > 0 {
+		i -= len(m.BatchDenom)
+		copy(dAtA[i:], m.BatchDenom)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.BatchDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreditSendAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreditSendAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreditSendAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedRecipients) > 0 {
+		for iNdEx := len(m.AllowedRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedRecipients[iNdEx])
+			copy(dAtA[i:], m.AllowedRecipients[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedRecipients[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SpendLimits) > 0 {
+		for iNdEx := len(m.SpendLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreditRetireAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreditRetireAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreditRetireAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RetireLimits) > 0 {
+		for iNdEx := len(m.RetireLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RetireLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CreditLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BatchDenom)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func (m *CreditSendAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpendLimits) > 0 {
+		for _, e := range m.SpendLimits {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.AllowedRecipients) > 0 {
+		for _, s := range m.AllowedRecipients {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CreditRetireAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RetireLimits) > 0 {
+		for _, e := range m.RetireLimits {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CreditLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreditLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreditLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreditSendAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreditSendAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreditSendAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimits = append(m.SpendLimits, &CreditLimit{})
+			if err := m.SpendLimits[len(m.SpendLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedRecipients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedRecipients = append(m.AllowedRecipients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreditRetireAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreditRetireAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreditRetireAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetireLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetireLimits = append(m.RetireLimits, &CreditLimit{})
+			if err := m.RetireLimits[len(m.RetireLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
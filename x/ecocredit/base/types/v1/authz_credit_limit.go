@@ -0,0 +1,121 @@
+package v1
+
+import (
+	"cosmossdk.io/errors"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+// Validate checks if CreditLimit is valid.
+func (l *CreditLimit) Validate() error {
+	if (l.BatchDenom == "") == (l.ClassId == "") {
+		return sdkerrors.ErrInvalidRequest.Wrap("exactly one of batch denom or class id must be set")
+	}
+
+	if l.BatchDenom != "" {
+		if err := base.ValidateBatchDenom(l.BatchDenom); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("batch denom: %s", err)
+		}
+	}
+
+	if l.ClassId != "" {
+		if err := base.ValidateClassID(l.ClassId); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("class id: %s", err)
+		}
+	}
+
+	if _, err := math.NewPositiveDecFromString(l.Amount); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("amount: %s", err)
+	}
+
+	return nil
+}
+
+// validateCreditLimits checks that the credit limits are not empty, are valid
+// and do not contain more than one limit for a credit batch or credit class.
+func validateCreditLimits(name string, limits []*CreditLimit) error {
+	if len(limits) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("%s cannot be empty", name)
+	}
+
+	seen := make(map[string]bool, len(limits))
+	for i, limit := range limits {
+		if err := limit.Validate(); err != nil {
+			return errors.Wrapf(err, "%s[%d]", name, i)
+		}
+
+		id := limit.BatchDenom + limit.ClassId
+		if seen[id] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s[%d]: duplicate credit limit for %s", name, i, id)
+		}
+		seen[id] = true
+	}
+
+	return nil
+}
+
+// copyCreditLimits returns a deep copy of the credit limits so that the
+// credit limits of a stored authorization are not modified when a message
+// is rejected.
+func copyCreditLimits(limits []*CreditLimit) []*CreditLimit {
+	res := make([]*CreditLimit, len(limits))
+	for i, limit := range limits {
+		l := *limit
+		res[i] = &l
+	}
+	return res
+}
+
+// useCreditLimit deducts the amount from the credit limit of the credit batch
+// or, if the credit batch does not have a credit limit, from the credit limit
+// of the credit class of the credit batch.
+func useCreditLimit(limits []*CreditLimit, batchDenom string, amount math.Dec) error {
+	var limit *CreditLimit
+	classID := base.GetClassIDFromBatchDenom(batchDenom)
+	for _, l := range limits {
+		if l.BatchDenom == batchDenom {
+			limit = l
+			break
+		}
+		if l.ClassId != "" && l.ClassId == classID {
+			limit = l
+		}
+	}
+
+	if limit == nil {
+		return sdkerrors.ErrUnauthorized.Wrapf("no credit limit for %s", batchDenom)
+	}
+
+	limitAmount, err := math.NewNonNegativeDecFromString(limit.Amount)
+	if err != nil {
+		return err
+	}
+
+	remaining, err := math.SafeSubBalance(limitAmount, amount)
+	if err != nil {
+		return sdkerrors.ErrInsufficientFunds.Wrapf(
+			"amount %s of %s exceeds credit limit %s", amount, batchDenom, limit.Amount,
+		)
+	}
+
+	limit.Amount = remaining.String()
+	return nil
+}
+
+// remainingCreditLimits returns the credit limits with a positive amount.
+func remainingCreditLimits(limits []*CreditLimit) ([]*CreditLimit, error) {
+	res := make([]*CreditLimit, 0, len(limits))
+	for _, limit := range limits {
+		amount, err := math.NewNonNegativeDecFromString(limit.Amount)
+		if err != nil {
+			return nil, err
+		}
+		if amount.IsPositive() {
+			res = append(res, limit)
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,56 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/regen-network/regen-ledger/types/math"
+)
+
+var _ authz.Authorization = &CreditRetireAuthorization{}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a CreditRetireAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgRetire{})
+}
+
+// Accept implements Authorization.Accept. The amounts of the credits are
+// deducted from the retire limits and the authorization is deleted once all
+// retire limits are used up.
+func (a CreditRetireAuthorization) Accept(_ sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	msgRetire, ok := msg.(*MsgRetire)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	limits := copyCreditLimits(a.RetireLimits)
+	for _, credits := range msgRetire.Credits {
+		amount, err := math.NewPositiveDecFromString(credits.Amount)
+		if err != nil {
+			return authz.AcceptResponse{}, err
+		}
+
+		if err = useCreditLimit(limits, credits.BatchDenom, amount); err != nil {
+			return authz.AcceptResponse{}, err
+		}
+	}
+
+	limits, err := remainingCreditLimits(limits)
+	if err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
+	if len(limits) == 0 {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{Accept: true, Updated: &CreditRetireAuthorization{
+		RetireLimits: limits,
+	}}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a CreditRetireAuthorization) ValidateBasic() error {
+	return validateCreditLimits("retire limits", a.RetireLimits)
+}
@@ -0,0 +1,71 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+type creditRetireAuthorization struct {
+	t   gocuke.TestingT
+	a   *CreditRetireAuthorization
+	msg *MsgRetire
+	res authz.AcceptResponse
+	err error
+}
+
+func TestCreditRetireAuthorization(t *testing.T) {
+	gocuke.NewRunner(t, &creditRetireAuthorization{}).Path("./features/authz_credit_retire.feature").Run()
+}
+
+func (s *creditRetireAuthorization) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *creditRetireAuthorization) TheAuthorization(a gocuke.DocString) {
+	s.a = &CreditRetireAuthorization{}
+	err := jsonpb.UnmarshalString(a.Content, s.a)
+	require.NoError(s.t, err)
+}
+
+func (s *creditRetireAuthorization) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgRetire{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *creditRetireAuthorization) TheAuthorizationIsValidated() {
+	s.err = s.a.ValidateBasic()
+}
+
+func (s *creditRetireAuthorization) TheMessageIsAccepted() {
+	s.res, s.err = s.a.Accept(sdk.Context{}, s.msg)
+}
+
+func (s *creditRetireAuthorization) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *creditRetireAuthorization) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *creditRetireAuthorization) ExpectTheAuthorizationIsDeleted() {
+	require.True(s.t, s.res.Accept)
+	require.True(s.t, s.res.Delete)
+}
+
+func (s *creditRetireAuthorization) ExpectTheUpdatedAuthorization(a gocuke.DocString) {
+	expected := &CreditRetireAuthorization{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	require.True(s.t, s.res.Accept)
+	require.False(s.t, s.res.Delete)
+	require.Equal(s.t, expected, s.res.Updated)
+}
@@ -0,0 +1,97 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/regen-network/regen-ledger/types/math"
+)
+
+var _ authz.Authorization = &CreditSendAuthorization{}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a CreditSendAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgSend{})
+}
+
+// Accept implements Authorization.Accept. The tradable and retired amounts of
+// the credits are deducted from the spend limits and the authorization is
+// deleted once all spend limits are used up.
+func (a CreditSendAuthorization) Accept(_ sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	msgSend, ok := msg.(*MsgSend)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if len(a.AllowedRecipients) > 0 && !containsString(a.AllowedRecipients, msgSend.Recipient) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf(
+			"%s is not an allowed recipient", msgSend.Recipient,
+		)
+	}
+
+	limits := copyCreditLimits(a.SpendLimits)
+	for _, credits := range msgSend.Credits {
+		tradable, err := math.NewNonNegativeDecFromString(credits.TradableAmount)
+		if err != nil {
+			return authz.AcceptResponse{}, err
+		}
+
+		retired, err := math.NewNonNegativeDecFromString(credits.RetiredAmount)
+		if err != nil {
+			return authz.AcceptResponse{}, err
+		}
+
+		amount, err := tradable.Add(retired)
+		if err != nil {
+			return authz.AcceptResponse{}, err
+		}
+
+		if err = useCreditLimit(limits, credits.BatchDenom, amount); err != nil {
+			return authz.AcceptResponse{}, err
+		}
+	}
+
+	limits, err := remainingCreditLimits(limits)
+	if err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
+	if len(limits) == 0 {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{Accept: true, Updated: &CreditSendAuthorization{
+		SpendLimits:       limits,
+		AllowedRecipients: a.AllowedRecipients,
+	}}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a CreditSendAuthorization) ValidateBasic() error {
+	if err := validateCreditLimits("spend limits", a.SpendLimits); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(a.AllowedRecipients))
+	for i, recipient := range a.AllowedRecipients {
+		if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("allowed recipients[%d]: %s", i, err)
+		}
+		if seen[recipient] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("allowed recipients[%d]: duplicate address %s", i, recipient)
+		}
+		seen[recipient] = true
+	}
+
+	return nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+type creditSendAuthorization struct {
+	t   gocuke.TestingT
+	a   *CreditSendAuthorization
+	msg *MsgSend
+	res authz.AcceptResponse
+	err error
+}
+
+func TestCreditSendAuthorization(t *testing.T) {
+	gocuke.NewRunner(t, &creditSendAuthorization{}).Path("./features/authz_credit_send.feature").Run()
+}
+
+func (s *creditSendAuthorization) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *creditSendAuthorization) TheAuthorization(a gocuke.DocString) {
+	s.a = &CreditSendAuthorization{}
+	err := jsonpb.UnmarshalString(a.Content, s.a)
+	require.NoError(s.t, err)
+}
+
+func (s *creditSendAuthorization) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgSend{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *creditSendAuthorization) TheAuthorizationIsValidated() {
+	s.err = s.a.ValidateBasic()
+}
+
+func (s *creditSendAuthorization) TheMessageIsAccepted() {
+	s.res, s.err = s.a.Accept(sdk.Context{}, s.msg)
+}
+
+func (s *creditSendAuthorization) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *creditSendAuthorization) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *creditSendAuthorization) ExpectTheAuthorizationIsDeleted() {
+	require.True(s.t, s.res.Accept)
+	require.True(s.t, s.res.Delete)
+}
+
+func (s *creditSendAuthorization) ExpectTheUpdatedAuthorization(a gocuke.DocString) {
+	expected := &CreditSendAuthorization{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	require.True(s.t, s.res.Accept)
+	require.False(s.t, s.res.Delete)
+	require.Equal(s.t, expected, s.res.Updated)
+}
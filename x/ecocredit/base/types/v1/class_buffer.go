@@ -0,0 +1,24 @@
+package v1
+
+import (
+	"fmt"
+
+	"github.com/regen-network/regen-ledger/types/math"
+)
+
+// maxBufferPercentage is the exclusive upper bound of the buffer percentage of
+// a credit class.
+var maxBufferPercentage = math.NewDecFromInt64(100)
+
+// validateBufferPercentage checks that the buffer percentage of a credit class
+// is a decimal greater than 0 and less than 100.
+func validateBufferPercentage(percentage string) error {
+	dec, err := math.NewPositiveDecFromString(percentage)
+	if err != nil {
+		return err
+	}
+	if dec.Cmp(maxBufferPercentage) != math.LessThan {
+		return fmt.Errorf("must be less than 100")
+	}
+	return nil
+}
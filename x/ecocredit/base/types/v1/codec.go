@@ -6,12 +6,18 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
 func RegisterTypes(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*govv1beta1.Content)(nil), &CreditTypeProposal{})
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&CreditSendAuthorization{},
+		&CreditRetireAuthorization{},
+	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
@@ -37,6 +43,22 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRemoveClassCreator{}, "regen/MsgRemoveClassCreator", nil)
 	cdc.RegisterConcrete(&MsgSetClassCreatorAllowlist{}, "regen/MsgSetClassCreatorAllowlist", nil)
 	cdc.RegisterConcrete(&MsgUpdateClassFee{}, "regen/MsgUpdateClassFee", nil)
+	cdc.RegisterConcrete(&MsgSuspendBatch{}, "regen/MsgSuspendBatch", nil)
+	cdc.RegisterConcrete(&MsgUnsuspendBatch{}, "regen/MsgUnsuspendBatch", nil)
+	cdc.RegisterConcrete(&MsgInvalidateBatch{}, "regen/MsgInvalidateBatch", nil)
+	cdc.RegisterConcrete(&MsgUpdateProjectStatus{}, "regen/MsgUpdateProjectStatus", nil)
+	cdc.RegisterConcrete(&MsgSetClassIssuerQuota{}, "regen/MsgSetClassIssuerQuota", nil)
+	cdc.RegisterConcrete(&MsgUpdateClassBuffer{}, "regen/MsgUpdateClassBuffer", nil)
+	cdc.RegisterConcrete(&MsgCancelBufferCredits{}, "regen/MsgCancelBufferCredits", nil)
+	cdc.RegisterConcrete(&MsgUpdateClassIssuanceThreshold{}, "regen/MsgUpdateClassIssuanceThreshold", nil)
+	cdc.RegisterConcrete(&MsgUpdateClassRequireVerifiedProject{}, "regen/MsgUpdateClassRequireVerifiedProject", nil)
+	cdc.RegisterConcrete(&MsgProposeBatch{}, "regen/MsgProposeBatch", nil)
+	cdc.RegisterConcrete(&MsgApproveBatch{}, "regen/MsgApproveBatch", nil)
+	cdc.RegisterConcrete(&MsgApproveCredits{}, "regen/MsgApproveCredits", nil)
+	cdc.RegisterConcrete(&MsgRevokeCreditAllowance{}, "regen/MsgRevokeCreditAllowance", nil)
+	cdc.RegisterConcrete(&MsgSendFrom{}, "regen/MsgSendFrom", nil)
+	cdc.RegisterConcrete(&CreditSendAuthorization{}, "regen/CreditSendAuthorization", nil)
+	cdc.RegisterConcrete(&CreditRetireAuthorization{}, "regen/CreditRetireAuthorization", nil)
 }
 
 var (
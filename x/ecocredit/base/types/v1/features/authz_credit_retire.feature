@@ -0,0 +1,139 @@
+Feature: CreditRetireAuthorization
+
+  Scenario: a valid authorization
+    Given the authorization
+    """
+    {
+      "retire_limits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "100"
+        }
+      ]
+    }
+    """
+    When the authorization is validated
+    Then expect no error
+
+  Scenario: an error is returned if retire limits are empty
+    Given the authorization
+    """
+    {}
+    """
+    When the authorization is validated
+    Then expect the error "retire limits cannot be empty: invalid request"
+
+  Scenario: an error is returned if a retire limit class id is not formatted
+    Given the authorization
+    """
+    {
+      "retire_limits": [
+        {
+          "class_id": "foo",
+          "amount": "100"
+        }
+      ]
+    }
+    """
+    When the authorization is validated
+    Then expect the error "retire limits[0]: class id: expected format <credit-type-abbrev><class-sequence>: parse error: invalid request"
+
+  Scenario: the retire limits are deducted by the retired amounts
+    Given the authorization
+    """
+    {
+      "retire_limits": [
+        {
+          "class_id": "C01",
+          "amount": "100"
+        }
+      ]
+    }
+    """
+    And the message
+    """
+    {
+      "owner": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "10"
+        },
+        {
+          "batch_denom": "C01-001-20200101-20210101-002",
+          "amount": "2.5"
+        }
+      ],
+      "jurisdiction": "US-WA"
+    }
+    """
+    When the message is accepted
+    Then expect no error
+    And expect the updated authorization
+    """
+    {
+      "retire_limits": [
+        {
+          "class_id": "C01",
+          "amount": "87.5"
+        }
+      ]
+    }
+    """
+
+  Scenario: the authorization is deleted once the retire limits are used up
+    Given the authorization
+    """
+    {
+      "retire_limits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "10"
+        }
+      ]
+    }
+    """
+    And the message
+    """
+    {
+      "owner": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "10"
+        }
+      ],
+      "jurisdiction": "US-WA"
+    }
+    """
+    When the message is accepted
+    Then expect no error
+    And expect the authorization is deleted
+
+  Scenario: an error is returned if the amount exceeds the retire limit
+    Given the authorization
+    """
+    {
+      "retire_limits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "10"
+        }
+      ]
+    }
+    """
+    And the message
+    """
+    {
+      "owner": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "11"
+        }
+      ],
+      "jurisdiction": "US-WA"
+    }
+    """
+    When the message is accepted
+    Then expect the error "amount 11 of C01-001-20200101-20210101-001 exceeds credit limit 10: insufficient funds"
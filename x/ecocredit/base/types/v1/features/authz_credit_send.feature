@@ -0,0 +1,350 @@
+Feature: CreditSendAuthorization
+
+  Scenario: a valid authorization
+    Given the authorization
+    """
+    {
+      "spend_limits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "100"
+        },
+        {
+          "class_id": "C01",
+          "amount": "50.5"
+        }
+      ],
+      "allowed_recipients": [
+        "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+      ]
+    }
+    """
+    When the authorization is validated
+    Then expect no error
+
+  Scenario: an error is returned if spend limits are empty
+    Given the authorization
+    """
+    {}
+    """
+    When the authorization is validated
+    Then expect the error "spend limits cannot be empty: invalid request"
+
+  Scenario: an error is returned if a spend limit has neither batch denom nor class id
+    Given the authorization
+    """
+    {
+      "spend_limits": [
+        {
+          "amount": "100"
+        }
+      ]
+    }
+    """
+    When the authorization is validated
+    Then expect the error "spend limits[0]: exactly one of batch denom or class id must be set: invalid request"
+
+  Scenario: an error is returned if a spend limit has both batch denom and class id
+    Given the authorization
+    """
+    {
+      "spend_limits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "class_id": "C01",
+          "amount": "100"
+        }
+      ]
+    }
+    """
+    When the authorization is validated
+    Then expect the error "spend limits[0]: exactly one of batch denom or class id must be set: invalid request"
+
+  Scenario: an error is returned if a spend limit batch denom is not formatted
+    Given the authorization
+    """
+    {
+      "spend_limits": [
+        {
+          "batch_denom": "foo",
+          "amount": "100"
+        }
+      ]
+    }
+    """
+    When the authorization is validated
+    Then expect the error "spend limits[0]: batch denom: expected format <project-id>-<start_date>-<end_date>-<batch_sequence>: parse error: invalid request"
+
+  Scenario: an error is returned if a spend limit amount is not positive
+    Given the authorization
+    """
+    {
+      "spend_limits": [
+        {
+          "class_id": "C01",
+          "amount": "0"
+        }
+      ]
+    }
+    """
+    When the authorization is validated
+    Then expect the error "spend limits[0]: amount: expected a positive decimal, got 0: invalid decimal string: invalid request"
+
+  Scenario: an error is returned if spend limits are duplicated
+    Given the authorization
+    """
+    {
+      "spend_limits": [
+        {
+          "class_id": "C01",
+          "amount": "10"
+        },
+        {
+          "class_id": "C01",
+          "amount": "20"
+        }
+      ]
+    }
+    """
+    When the authorization is validated
+    Then expect the error "spend limits[1]: duplicate credit limit for C01: invalid request"
+
+  Scenario: an error is returned if an allowed recipient is not a bech32 address
+    Given the authorization
+    """
+    {
+      "spend_limits": [
+        {
+          "class_id": "C01",
+          "amount": "10"
+        }
+      ],
+      "allowed_recipients": [
+        "foo"
+      ]
+    }
+    """
+    When the authorization is validated
+    Then expect the error "allowed recipients[0]: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: the spend limits are deducted by the tradable and retired amounts
+    Given the authorization
+    """
+    {
+      "spend_limits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "100"
+        },
+        {
+          "class_id": "C01",
+          "amount": "50"
+        }
+      ]
+    }
+    """
+    And the message
+    """
+    {
+      "sender": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "tradable_amount": "10",
+          "retired_amount": "5.5",
+          "retirement_jurisdiction": "US-WA"
+        },
+        {
+          "batch_denom": "C01-001-20200101-20210101-002",
+          "tradable_amount": "20"
+        }
+      ]
+    }
+    """
+    When the message is accepted
+    Then expect no error
+    And expect the updated authorization
+    """
+    {
+      "spend_limits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "84.5"
+        },
+        {
+          "class_id": "C01",
+          "amount": "30"
+        }
+      ]
+    }
+    """
+
+  Scenario: the authorization is deleted once the spend limits are used up
+    Given the authorization
+    """
+    {
+      "spend_limits": [
+        {
+          "class_id": "C01",
+          "amount": "50"
+        }
+      ]
+    }
+    """
+    And the message
+    """
+    {
+      "sender": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "tradable_amount": "50"
+        }
+      ]
+    }
+    """
+    When the message is accepted
+    Then expect no error
+    And expect the authorization is deleted
+
+  Scenario: used up spend limits are removed from the authorization
+    Given the authorization
+    """
+    {
+      "spend_limits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "10"
+        },
+        {
+          "class_id": "C02",
+          "amount": "50"
+        }
+      ],
+      "allowed_recipients": [
+        "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+      ]
+    }
+    """
+    And the message
+    """
+    {
+      "sender": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "tradable_amount": "10"
+        }
+      ]
+    }
+    """
+    When the message is accepted
+    Then expect no error
+    And expect the updated authorization
+    """
+    {
+      "spend_limits": [
+        {
+          "class_id": "C02",
+          "amount": "50"
+        }
+      ],
+      "allowed_recipients": [
+        "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+      ]
+    }
+    """
+
+  Scenario: an error is returned if the recipient is not allowed
+    Given the authorization
+    """
+    {
+      "spend_limits": [
+        {
+          "class_id": "C01",
+          "amount": "50"
+        }
+      ],
+      "allowed_recipients": [
+        "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+      ]
+    }
+    """
+    And the message
+    """
+    {
+      "sender": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "recipient": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "tradable_amount": "10"
+        }
+      ]
+    }
+    """
+    When the message is accepted
+    Then expect the error "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw is not an allowed recipient: unauthorized"
+
+  Scenario: an error is returned if the credit batch does not have a spend limit
+    Given the authorization
+    """
+    {
+      "spend_limits": [
+        {
+          "class_id": "C02",
+          "amount": "50"
+        }
+      ]
+    }
+    """
+    And the message
+    """
+    {
+      "sender": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "tradable_amount": "10"
+        }
+      ]
+    }
+    """
+    When the message is accepted
+    Then expect the error "no credit limit for C01-001-20200101-20210101-001: unauthorized"
+
+  Scenario: an error is returned if the amount exceeds the spend limit
+    Given the authorization
+    """
+    {
+      "spend_limits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "10"
+        }
+      ]
+    }
+    """
+    And the message
+    """
+    {
+      "sender": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "tradable_amount": "5"
+        },
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "tradable_amount": "6"
+        }
+      ]
+    }
+    """
+    When the message is accepted
+    Then expect the error "amount 6 of C01-001-20200101-20210101-001 exceeds credit limit 5: insufficient funds"
@@ -0,0 +1,40 @@
+Feature: MsgApproveBatch
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "pending_batch_id": "1"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if issuer is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "issuer: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if issuer is not a bech32 address
+    Given the message
+    """
+    {
+      "issuer": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "issuer: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if pending batch id is empty
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "pending batch id cannot be empty: invalid request"
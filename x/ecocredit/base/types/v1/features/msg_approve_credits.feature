@@ -0,0 +1,93 @@
+Feature: MsgApproveCredits
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "spender": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "amount": "100"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with expiration
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "spender": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "amount": "100.5",
+      "expiration": "2030-01-01T00:00:00Z"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if owner is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "owner: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if spender is empty
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "spender: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if owner and spender are the same
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "spender": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "owner and spender cannot be the same account: invalid request"
+
+  Scenario: an error is returned if batch denom is empty
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "spender": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+    }
+    """
+    When the message is validated
+    Then expect the error "batch denom: empty string is not allowed: parse error: invalid request"
+
+  Scenario: an error is returned if amount is empty
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "spender": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001"
+    }
+    """
+    When the message is validated
+    Then expect the error "amount: expected a positive decimal, got : invalid decimal string: invalid request"
+
+  Scenario: an error is returned if amount is zero
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "spender": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "amount": "0"
+    }
+    """
+    When the message is validated
+    Then expect the error "amount: expected a positive decimal, got 0: invalid decimal string: invalid request"
@@ -0,0 +1,102 @@
+Feature: MsgCancelBufferCredits
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "100"
+        }
+      ],
+      "reason": "reversal due to wildfire"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if admin is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "admin: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if class id is not formatted
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "class id: expected format <credit-type-abbrev><class-sequence>: parse error: invalid request"
+
+  Scenario: an error is returned if credits is empty
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01"
+    }
+    """
+    When the message is validated
+    Then expect the error "credits cannot be empty: invalid request"
+
+  Scenario: an error is returned if credits amount is empty
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "credits[0]: amount cannot be empty: invalid request"
+
+  # Note: additional validation for credits covered in types_credits.feature
+
+  Scenario: an error is returned if reason is empty
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "100"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "reason cannot be empty: invalid request"
+
+  Scenario: an error is returned if reason exceeds 512 characters
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "100"
+        }
+      ]
+    }
+    """
+    And a reason with length "513"
+    When the message is validated
+    Then expect the error "reason: max length 512: limit exceeded"
@@ -0,0 +1,116 @@
+Feature: MsgProposeBatch
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "issuance": [
+        {
+          "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+          "tradable_amount": "100",
+          "retired_amount": "100",
+          "retirement_jurisdiction": "US-WA"
+        }
+      ],
+      "metadata": "regen:13toVgf5aZqSVSeJQv562xkkeoe3rr3bJWa29PHVKVf77VAkVMcDvVd.rdf",
+      "start_date": "2020-01-01T00:00:00Z",
+      "end_date": "2021-01-01T00:00:00Z"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with origin tx
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "issuance": [
+        {
+          "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+          "tradable_amount": "100"
+        }
+      ],
+      "start_date": "2020-01-01T00:00:00Z",
+      "end_date": "2021-01-01T00:00:00Z",
+      "origin_tx": {
+        "id": "0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e",
+        "source": "polygon"
+      }
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if issuer is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "issuer: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if project id is empty
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "project id: empty string is not allowed: parse error: invalid request"
+
+  Scenario: an error is returned if issuance is empty
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001"
+    }
+    """
+    When the message is validated
+    Then expect the error "issuance cannot be empty: invalid request"
+
+  Scenario: an error is returned if start date is after end date
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "issuance": [
+        {
+          "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+          "tradable_amount": "100"
+        }
+      ],
+      "start_date": "2021-01-01T00:00:00Z",
+      "end_date": "2020-01-01T00:00:00Z"
+    }
+    """
+    When the message is validated
+    Then expect the error "start date cannot be after end date: invalid request"
+
+  Scenario: an error is returned if origin tx source is empty
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "issuance": [
+        {
+          "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+          "tradable_amount": "100"
+        }
+      ],
+      "start_date": "2020-01-01T00:00:00Z",
+      "end_date": "2021-01-01T00:00:00Z",
+      "origin_tx": {
+        "id": "0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "origin_tx.source cannot be empty: invalid request"
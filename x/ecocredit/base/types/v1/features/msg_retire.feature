@@ -128,3 +128,58 @@ Feature: MsgRetire
     """
     When the message is validated
     Then expect the error "jurisdiction: expected format <country-code>[-<region-code>[ <postal-code>]]: parse error: invalid request"
+
+  Scenario: a valid message with reason and beneficiary
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "100"
+        }
+      ],
+      "jurisdiction": "US-WA",
+      "reason": "offsetting emissions of fiscal year 2022",
+      "beneficiary": "Acme Corporation"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if reason exceeds 512 characters
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "100"
+        }
+      ],
+      "jurisdiction": "US-WA"
+    }
+    """
+    And a reason with length "513"
+    When the message is validated
+    Then expect the error "reason: max length 512: limit exceeded"
+
+  Scenario: an error is returned if beneficiary exceeds 512 characters
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "100"
+        }
+      ],
+      "jurisdiction": "US-WA"
+    }
+    """
+    And a beneficiary with length "513"
+    When the message is validated
+    Then expect the error "beneficiary: max length 512: limit exceeded"
@@ -0,0 +1,72 @@
+Feature: MsgSendFrom
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "spender": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "recipient": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "tradable_amount": "10",
+          "retired_amount": "5",
+          "retirement_jurisdiction": "US-WA"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if spender is empty
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "spender: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if recipient is empty
+    Given the message
+    """
+    {
+      "spender": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "recipient: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if credits are empty
+    Given the message
+    """
+    {
+      "spender": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "recipient": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+    }
+    """
+    When the message is validated
+    Then expect the error "credits cannot be empty: invalid request"
+
+  Scenario: an error is returned if retirement jurisdiction is empty
+    Given the message
+    """
+    {
+      "spender": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "recipient": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "retired_amount": "5"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "retirement jurisdiction: empty string is not allowed: parse error: invalid request"
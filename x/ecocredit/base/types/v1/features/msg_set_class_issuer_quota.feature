@@ -0,0 +1,109 @@
+Feature: MsgSetClassIssuerQuota
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "issuer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "amount": "1000"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with period
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "issuer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "amount": "1000",
+      "period": "2592000s"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message removing a quota
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "issuer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if admin is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "admin: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if class id is empty
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "class id: empty string is not allowed: parse error: invalid request"
+
+  Scenario: an error is returned if issuer is empty
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01"
+    }
+    """
+    When the message is validated
+    Then expect the error "issuer: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if amount is not positive
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "issuer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "amount": "0"
+    }
+    """
+    When the message is validated
+    Then expect the error "amount: expected a positive decimal, got 0: invalid decimal string"
+
+  Scenario: an error is returned if period is not positive
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "issuer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "amount": "1000",
+      "period": "-1s"
+    }
+    """
+    When the message is validated
+    Then expect the error "period: must be positive: invalid request"
+
+  Scenario: an error is returned if period is set when removing a quota
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "issuer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "period": "2592000s"
+    }
+    """
+    When the message is validated
+    Then expect the error "period cannot be set when removing a quota: invalid request"
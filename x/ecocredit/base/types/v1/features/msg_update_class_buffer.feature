@@ -0,0 +1,66 @@
+Feature: MsgUpdateClassBuffer
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "buffer_percentage": "20"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message removing the buffer
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if admin is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "admin: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if class id is empty
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "class id: empty string is not allowed: parse error: invalid request"
+
+  Scenario: an error is returned if buffer percentage is not positive
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "buffer_percentage": "0"
+    }
+    """
+    When the message is validated
+    Then expect the error "buffer percentage: expected a positive decimal, got 0: invalid decimal string: invalid request"
+
+  Scenario: an error is returned if buffer percentage is not less than 100
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "buffer_percentage": "100"
+    }
+    """
+    When the message is validated
+    Then expect the error "buffer percentage: must be less than 100: invalid request"
@@ -0,0 +1,101 @@
+Feature: MsgUpdateProjectStatus
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "new_status": "PROJECT_STATUS_VERIFIED"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with iri
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "new_status": "PROJECT_STATUS_VERIFIED",
+      "iri": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGxGREWGKjEdXYG.rdf"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if issuer is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "issuer: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if issuer is not a bech32 address
+    Given the message
+    """
+    {
+      "issuer": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "issuer: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if project id is empty
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "project id: empty string is not allowed: parse error: invalid request"
+
+  Scenario: an error is returned if project id is not formatted
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "project id: expected format <class-id>-<project-sequence>: parse error: invalid request"
+
+  Scenario: an error is returned if new status is empty
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001"
+    }
+    """
+    When the message is validated
+    Then expect the error "new status: invalid project status PROJECT_STATUS_UNSPECIFIED: parse error: invalid request"
+
+  Scenario: an error is returned if new status is unknown
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "new_status": 6
+    }
+    """
+    When the message is validated
+    Then expect the error "new status: invalid project status 6: parse error: invalid request"
+
+  Scenario: an error is returned if iri is not formatted
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "new_status": "PROJECT_STATUS_VERIFIED",
+      "iri": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "iri: expected format regen:<hash>.<extension>: parse error: invalid request"
@@ -179,3 +179,78 @@ Feature: Batch
     """
     When the batch is validated
     Then expect the error "must provide an issuance date for the credit batch: parse error"
+
+  Scenario: a valid suspended batch
+    Given the batch
+    """
+    {
+      "key": 1,
+      "issuer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "project_key": 1,
+      "denom": "C01-001-20200101-20210101-001",
+      "metadata": "regen:13toVgf5aZqSVSeJQv562xkkeoe3rr3bJWa29PHVKVf77VAkVMcDvVd.rdf",
+      "start_date": "2020-01-01T00:00:00Z",
+      "end_date": "2021-01-01T00:00:00Z",
+      "issuance_date": "2022-01-01T00:00:00Z",
+      "suspended": true
+    }
+    """
+    When the batch is validated
+    Then expect no error
+
+  Scenario: a valid invalidated batch
+    Given the batch
+    """
+    {
+      "key": 1,
+      "issuer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "project_key": 1,
+      "denom": "C01-001-20200101-20210101-001",
+      "metadata": "regen:13toVgf5aZqSVSeJQv562xkkeoe3rr3bJWa29PHVKVf77VAkVMcDvVd.rdf",
+      "start_date": "2020-01-01T00:00:00Z",
+      "end_date": "2021-01-01T00:00:00Z",
+      "issuance_date": "2022-01-01T00:00:00Z",
+      "suspended": true,
+      "invalidated": true
+    }
+    """
+    When the batch is validated
+    Then expect no error
+
+  Scenario: an error is returned if an invalidated batch is not suspended
+    Given the batch
+    """
+    {
+      "key": 1,
+      "issuer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "project_key": 1,
+      "denom": "C01-001-20200101-20210101-001",
+      "metadata": "regen:13toVgf5aZqSVSeJQv562xkkeoe3rr3bJWa29PHVKVf77VAkVMcDvVd.rdf",
+      "start_date": "2020-01-01T00:00:00Z",
+      "end_date": "2021-01-01T00:00:00Z",
+      "issuance_date": "2022-01-01T00:00:00Z",
+      "invalidated": true
+    }
+    """
+    When the batch is validated
+    Then expect the error "an invalidated credit batch must be suspended: parse error"
+
+  Scenario: an error is returned if an invalidated batch is open
+    Given the batch
+    """
+    {
+      "key": 1,
+      "issuer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "project_key": 1,
+      "denom": "C01-001-20200101-20210101-001",
+      "metadata": "regen:13toVgf5aZqSVSeJQv562xkkeoe3rr3bJWa29PHVKVf77VAkVMcDvVd.rdf",
+      "start_date": "2020-01-01T00:00:00Z",
+      "end_date": "2021-01-01T00:00:00Z",
+      "issuance_date": "2022-01-01T00:00:00Z",
+      "open": true,
+      "suspended": true,
+      "invalidated": true
+    }
+    """
+    When the batch is validated
+    Then expect the error "an invalidated credit batch cannot be open: parse error"
@@ -93,3 +93,76 @@ Feature: Class
     """
     When the class is validated
     Then expect the error "credit type abbrev: must be 1-3 uppercase alphabetic characters: parse error"
+
+  Scenario: a valid class with buffer
+    Given the class
+    """
+    {
+      "key": 1,
+      "id": "C01",
+      "admin": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "credit_type_abbrev": "C",
+      "buffer_percentage": "20",
+      "buffer_account": "e8U6QpPI7Xsru7lqrNuQgCrBfL8eMFE55D2Y+ORcso8="
+    }
+    """
+    When the class is validated
+    Then expect no error
+
+  Scenario: an error is returned if buffer percentage is not less than 100
+    Given the class
+    """
+    {
+      "key": 1,
+      "id": "C01",
+      "admin": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "credit_type_abbrev": "C",
+      "buffer_percentage": "100",
+      "buffer_account": "e8U6QpPI7Xsru7lqrNuQgCrBfL8eMFE55D2Y+ORcso8="
+    }
+    """
+    When the class is validated
+    Then expect the error "buffer percentage: must be less than 100: parse error"
+
+  Scenario: an error is returned if buffer account is empty
+    Given the class
+    """
+    {
+      "key": 1,
+      "id": "C01",
+      "admin": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "credit_type_abbrev": "C",
+      "buffer_percentage": "20"
+    }
+    """
+    When the class is validated
+    Then expect the error "buffer account must be the buffer account of credit class C01: parse error"
+
+  Scenario: an error is returned if buffer account is not the buffer account of the class
+    Given the class
+    """
+    {
+      "key": 1,
+      "id": "C01",
+      "admin": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "credit_type_abbrev": "C",
+      "buffer_percentage": "20",
+      "buffer_account": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y="
+    }
+    """
+    When the class is validated
+    Then expect the error "buffer account must be the buffer account of credit class C01: parse error"
+
+  Scenario: an error is returned if buffer account is set without buffer percentage
+    Given the class
+    """
+    {
+      "key": 1,
+      "id": "C01",
+      "admin": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "credit_type_abbrev": "C",
+      "buffer_account": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y="
+    }
+    """
+    When the class is validated
+    Then expect the error "buffer account cannot be set without buffer percentage: parse error"
@@ -0,0 +1,110 @@
+Feature: ClassIssuerQuota
+
+  Scenario: a valid class issuer quota
+    Given the class issuer quota
+    """
+    {
+      "class_key": 1,
+      "issuer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "amount": "1000",
+      "period": "2592000s",
+      "period_start": "2022-01-01T00:00:00Z",
+      "issued_amount": "100"
+    }
+    """
+    When the class issuer quota is validated
+    Then expect no error
+
+  Scenario: a valid class issuer quota without period
+    Given the class issuer quota
+    """
+    {
+      "class_key": 1,
+      "issuer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "amount": "1000",
+      "period_start": "2022-01-01T00:00:00Z",
+      "issued_amount": "0"
+    }
+    """
+    When the class issuer quota is validated
+    Then expect no error
+
+  Scenario: an error is returned if class key is empty
+    Given the class issuer quota
+    """
+    {}
+    """
+    When the class issuer quota is validated
+    Then expect the error "class key cannot be zero: parse error"
+
+  Scenario: an error is returned if issuer is empty
+    Given the class issuer quota
+    """
+    {
+      "class_key": 1
+    }
+    """
+    When the class issuer quota is validated
+    Then expect the error "issuer: empty address string is not allowed: parse error"
+
+  Scenario: an error is returned if amount is empty
+    Given the class issuer quota
+    """
+    {
+      "class_key": 1,
+      "issuer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y="
+    }
+    """
+    When the class issuer quota is validated
+    Then expect the error "amount: expected a positive decimal, got : invalid decimal string: parse error"
+
+  Scenario: an error is returned if amount is zero
+    Given the class issuer quota
+    """
+    {
+      "class_key": 1,
+      "issuer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "amount": "0"
+    }
+    """
+    When the class issuer quota is validated
+    Then expect the error "amount: expected a positive decimal, got 0: invalid decimal string: parse error"
+
+  Scenario: an error is returned if period is not positive
+    Given the class issuer quota
+    """
+    {
+      "class_key": 1,
+      "issuer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "amount": "1000",
+      "period": "0s"
+    }
+    """
+    When the class issuer quota is validated
+    Then expect the error "period: must be positive: parse error"
+
+  Scenario: an error is returned if period start is empty
+    Given the class issuer quota
+    """
+    {
+      "class_key": 1,
+      "issuer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "amount": "1000"
+    }
+    """
+    When the class issuer quota is validated
+    Then expect the error "period start cannot be empty: parse error"
+
+  Scenario: an error is returned if issued amount is negative
+    Given the class issuer quota
+    """
+    {
+      "class_key": 1,
+      "issuer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "amount": "1000",
+      "period_start": "2022-01-01T00:00:00Z",
+      "issued_amount": "-100"
+    }
+    """
+    When the class issuer quota is validated
+    Then expect the error "issued amount: expected a non-negative decimal, got -100: invalid decimal string: parse error"
@@ -0,0 +1,57 @@
+Feature: CreditAllowance
+
+  Scenario: a valid credit allowance
+    Given the credit allowance
+    """
+    {
+      "owner": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "spender": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "amount": "100",
+      "expiration": "2030-01-01T00:00:00Z"
+    }
+    """
+    When the credit allowance is validated
+    Then expect no error
+
+  Scenario: an error is returned if owner is empty
+    Given the credit allowance
+    """
+    {}
+    """
+    When the credit allowance is validated
+    Then expect the error "owner: empty address string is not allowed: parse error"
+
+  Scenario: an error is returned if spender is empty
+    Given the credit allowance
+    """
+    {
+      "owner": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y="
+    }
+    """
+    When the credit allowance is validated
+    Then expect the error "spender: empty address string is not allowed: parse error"
+
+  Scenario: an error is returned if batch key is empty
+    Given the credit allowance
+    """
+    {
+      "owner": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "spender": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y="
+    }
+    """
+    When the credit allowance is validated
+    Then expect the error "batch key cannot be zero: parse error"
+
+  Scenario: an error is returned if amount is zero
+    Given the credit allowance
+    """
+    {
+      "owner": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "spender": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "amount": "0"
+    }
+    """
+    When the credit allowance is validated
+    Then expect the error "amount: expected a positive decimal, got 0: invalid decimal string: parse error"
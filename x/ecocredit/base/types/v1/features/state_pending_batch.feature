@@ -0,0 +1,225 @@
+Feature: PendingBatch
+  Scenario: a valid pending batch
+    Given the pending batch
+    """
+    {
+      "id": 1,
+      "class_key": 1,
+      "project_key": 1,
+      "proposer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "issuance": [
+        {
+          "recipient": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+          "tradable_amount": "100"
+        }
+      ],
+      "start_date": "2020-01-01T00:00:00Z",
+      "end_date": "2021-01-01T00:00:00Z",
+      "proposed_at": "2022-01-01T00:00:00Z"
+    }
+    """
+    When the pending batch is validated
+    Then expect no error
+
+  Scenario: a valid pending batch with origin tx
+    Given the pending batch
+    """
+    {
+      "id": 1,
+      "class_key": 1,
+      "project_key": 1,
+      "proposer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "issuance": [
+        {
+          "recipient": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+          "tradable_amount": "100"
+        }
+      ],
+      "start_date": "2020-01-01T00:00:00Z",
+      "end_date": "2021-01-01T00:00:00Z",
+      "proposed_at": "2022-01-01T00:00:00Z",
+      "origin_tx": {
+        "id": "0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e",
+        "source": "polygon"
+      }
+    }
+    """
+    When the pending batch is validated
+    Then expect no error
+
+  Scenario: an error is returned if id is empty
+    Given the pending batch
+    """
+    {}
+    """
+    When the pending batch is validated
+    Then expect the error "id cannot be zero: parse error"
+
+  Scenario: an error is returned if class key is empty
+    Given the pending batch
+    """
+    {
+      "id": 1
+    }
+    """
+    When the pending batch is validated
+    Then expect the error "class key cannot be zero: parse error"
+
+  Scenario: an error is returned if project key is empty
+    Given the pending batch
+    """
+    {
+      "id": 1,
+      "class_key": 1
+    }
+    """
+    When the pending batch is validated
+    Then expect the error "project key cannot be zero: parse error"
+
+  Scenario: an error is returned if proposer is empty
+    Given the pending batch
+    """
+    {
+      "id": 1,
+      "class_key": 1,
+      "project_key": 1
+    }
+    """
+    When the pending batch is validated
+    Then expect the error "proposer: empty address string is not allowed: parse error"
+
+  Scenario: an error is returned if issuance is empty
+    Given the pending batch
+    """
+    {
+      "id": 1,
+      "class_key": 1,
+      "project_key": 1,
+      "proposer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y="
+    }
+    """
+    When the pending batch is validated
+    Then expect the error "issuance cannot be empty: parse error"
+
+  Scenario: an error is returned if issuance amounts are empty
+    Given the pending batch
+    """
+    {
+      "id": 1,
+      "class_key": 1,
+      "project_key": 1,
+      "proposer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "issuance": [
+        {
+          "recipient": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y="
+        }
+      ]
+    }
+    """
+    When the pending batch is validated
+    Then expect the error "issuance[0]: tradable amount or retired amount required: invalid request: parse error"
+
+  Scenario: an error is returned if start date is empty
+    Given the pending batch
+    """
+    {
+      "id": 1,
+      "class_key": 1,
+      "project_key": 1,
+      "proposer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "issuance": [
+        {
+          "recipient": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+          "tradable_amount": "100"
+        }
+      ]
+    }
+    """
+    When the pending batch is validated
+    Then expect the error "start date cannot be empty: parse error"
+
+  Scenario: an error is returned if end date is empty
+    Given the pending batch
+    """
+    {
+      "id": 1,
+      "class_key": 1,
+      "project_key": 1,
+      "proposer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "issuance": [
+        {
+          "recipient": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+          "tradable_amount": "100"
+        }
+      ],
+      "start_date": "2020-01-01T00:00:00Z"
+    }
+    """
+    When the pending batch is validated
+    Then expect the error "end date cannot be empty: parse error"
+
+  Scenario: an error is returned if start date is after end date
+    Given the pending batch
+    """
+    {
+      "id": 1,
+      "class_key": 1,
+      "project_key": 1,
+      "proposer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "issuance": [
+        {
+          "recipient": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+          "tradable_amount": "100"
+        }
+      ],
+      "start_date": "2021-01-01T00:00:00Z",
+      "end_date": "2020-01-01T00:00:00Z"
+    }
+    """
+    When the pending batch is validated
+    Then expect the error "start date cannot be after end date: parse error"
+
+  Scenario: an error is returned if origin tx source is empty
+    Given the pending batch
+    """
+    {
+      "id": 1,
+      "class_key": 1,
+      "project_key": 1,
+      "proposer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "issuance": [
+        {
+          "recipient": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+          "tradable_amount": "100"
+        }
+      ],
+      "start_date": "2020-01-01T00:00:00Z",
+      "end_date": "2021-01-01T00:00:00Z",
+      "origin_tx": {
+        "id": "0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e"
+      }
+    }
+    """
+    When the pending batch is validated
+    Then expect the error "origin_tx.source cannot be empty: invalid request: parse error"
+
+  Scenario: an error is returned if proposed at is empty
+    Given the pending batch
+    """
+    {
+      "id": 1,
+      "class_key": 1,
+      "project_key": 1,
+      "proposer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "issuance": [
+        {
+          "recipient": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+          "tradable_amount": "100"
+        }
+      ],
+      "start_date": "2020-01-01T00:00:00Z",
+      "end_date": "2021-01-01T00:00:00Z"
+    }
+    """
+    When the pending batch is validated
+    Then expect the error "proposed at cannot be empty: parse error"
@@ -0,0 +1,30 @@
+Feature: PendingBatchApproval
+
+  Scenario: a valid pending batch approval
+    Given the pending batch approval
+    """
+    {
+      "pending_batch_id": 1,
+      "approver": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y="
+    }
+    """
+    When the pending batch approval is validated
+    Then expect no error
+
+  Scenario: an error is returned if pending batch id is empty
+    Given the pending batch approval
+    """
+    {}
+    """
+    When the pending batch approval is validated
+    Then expect the error "pending batch id cannot be zero: parse error"
+
+  Scenario: an error is returned if approver is empty
+    Given the pending batch approval
+    """
+    {
+      "pending_batch_id": 1
+    }
+    """
+    When the pending batch approval is validated
+    Then expect the error "approver: empty address string is not allowed: parse error"
@@ -138,3 +138,35 @@ Feature: Project
     And metadata with length "257"
     When the project is validated
     Then expect the error "metadata exceeds 256 characters: parse error"
+
+  Scenario: a valid project with status
+    Given the project
+    """
+    {
+      "key": 1,
+      "id": "C01-001",
+      "admin": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "class_key": 1,
+      "jurisdiction": "US-WA",
+      "status": "PROJECT_STATUS_VERIFIED",
+      "status_iri": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGxGREWGKjEdXYG.rdf"
+    }
+    """
+    When the project is validated
+    Then expect no error
+
+  Scenario: an error is returned if status iri is not formatted
+    Given the project
+    """
+    {
+      "key": 1,
+      "id": "C01-001",
+      "admin": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "class_key": 1,
+      "jurisdiction": "US-WA",
+      "status": "PROJECT_STATUS_VERIFIED",
+      "status_iri": "foo"
+    }
+    """
+    When the project is validated
+    Then expect the error "status iri: expected format regen:<hash>.<extension>: parse error"
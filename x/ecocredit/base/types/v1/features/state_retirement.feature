@@ -0,0 +1,101 @@
+Feature: Retirement
+
+  Scenario: a valid retirement
+    Given the retirement
+    """
+    {
+      "id": 1,
+      "owner": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "amount": "100",
+      "jurisdiction": "US-WA",
+      "reason": "offsetting emissions of fiscal year 2022",
+      "beneficiary": "Acme Corporation",
+      "timestamp": "2022-01-01T00:00:00Z"
+    }
+    """
+    When the retirement is validated
+    Then expect no error
+
+  Scenario: a valid retirement without jurisdiction, reason and beneficiary
+    Given the retirement
+    """
+    {
+      "id": 1,
+      "owner": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "amount": "100",
+      "timestamp": "2022-01-01T00:00:00Z"
+    }
+    """
+    When the retirement is validated
+    Then expect no error
+
+  Scenario: an error is returned if id is empty
+    Given the retirement
+    """
+    {}
+    """
+    When the retirement is validated
+    Then expect the error "id cannot be zero: parse error"
+
+  Scenario: an error is returned if owner is empty
+    Given the retirement
+    """
+    {
+      "id": 1
+    }
+    """
+    When the retirement is validated
+    Then expect the error "owner: empty address string is not allowed: parse error"
+
+  Scenario: an error is returned if batch key is empty
+    Given the retirement
+    """
+    {
+      "id": 1,
+      "owner": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y="
+    }
+    """
+    When the retirement is validated
+    Then expect the error "batch key cannot be zero: parse error"
+
+  Scenario: an error is returned if amount is not positive
+    Given the retirement
+    """
+    {
+      "id": 1,
+      "owner": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "amount": "0"
+    }
+    """
+    When the retirement is validated
+    Then expect the error "amount: expected a positive decimal, got 0: invalid decimal string: parse error"
+
+  Scenario: an error is returned if jurisdiction is not formatted
+    Given the retirement
+    """
+    {
+      "id": 1,
+      "owner": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "amount": "100",
+      "jurisdiction": "foo"
+    }
+    """
+    When the retirement is validated
+    Then expect the error "jurisdiction: expected format <country-code>[-<region-code>[ <postal-code>]]: parse error"
+
+  Scenario: an error is returned if timestamp is empty
+    Given the retirement
+    """
+    {
+      "id": 1,
+      "owner": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "amount": "100"
+    }
+    """
+    When the retirement is validated
+    Then expect the error "timestamp cannot be empty: parse error"
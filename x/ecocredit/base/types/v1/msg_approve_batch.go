@@ -0,0 +1,39 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var _ legacytx.LegacyMsg = &MsgApproveBatch{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgApproveBatch) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgApproveBatch) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgApproveBatch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgApproveBatch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Issuer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("issuer: %s", err)
+	}
+
+	if m.PendingBatchId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("pending batch id cannot be empty")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgApproveBatch.
+func (m *MsgApproveBatch) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Issuer)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgApproveBatch struct {
+	t   gocuke.TestingT
+	msg *MsgApproveBatch
+	err error
+}
+
+func TestMsgApproveBatch(t *testing.T) {
+	gocuke.NewRunner(t, &msgApproveBatch{}).Path("./features/msg_approve_batch.feature").Run()
+}
+
+func (s *msgApproveBatch) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgApproveBatch) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgApproveBatch{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgApproveBatch) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgApproveBatch) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgApproveBatch) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -0,0 +1,64 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+var _ legacytx.LegacyMsg = &MsgApproveCredits{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgApproveCredits) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgApproveCredits) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgApproveCredits) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgApproveCredits) ValidateBasic() error {
+	if err := validateOwnerAndSpender(m.Owner, m.Spender); err != nil {
+		return err
+	}
+
+	if err := base.ValidateBatchDenom(m.BatchDenom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("batch denom: %s", err)
+	}
+
+	if _, err := math.NewPositiveDecFromString(m.Amount); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("amount: %s", err)
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgApproveCredits.
+func (m *MsgApproveCredits) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{addr}
+}
+
+// validateOwnerAndSpender checks that the owner and the spender of a credit
+// allowance are valid and different addresses.
+func validateOwnerAndSpender(owner, spender string) error {
+	if _, err := sdk.AccAddressFromBech32(owner); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("owner: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(spender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("spender: %s", err)
+	}
+
+	if owner == spender {
+		return sdkerrors.ErrInvalidRequest.Wrap("owner and spender cannot be the same account")
+	}
+
+	return nil
+}
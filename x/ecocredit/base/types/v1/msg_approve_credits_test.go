@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgApproveCredits struct {
+	t   gocuke.TestingT
+	msg *MsgApproveCredits
+	err error
+}
+
+func TestMsgApproveCredits(t *testing.T) {
+	gocuke.NewRunner(t, &msgApproveCredits{}).Path("./features/msg_approve_credits.feature").Run()
+}
+
+func (s *msgApproveCredits) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgApproveCredits) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgApproveCredits{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgApproveCredits) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgApproveCredits) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgApproveCredits) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
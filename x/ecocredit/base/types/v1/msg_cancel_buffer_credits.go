@@ -0,0 +1,62 @@
+package v1
+
+import (
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+var _ legacytx.LegacyMsg = &MsgCancelBufferCredits{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgCancelBufferCredits) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgCancelBufferCredits) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCancelBufferCredits) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgCancelBufferCredits) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("admin: %s", err)
+	}
+
+	if err := base.ValidateClassID(m.ClassId); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("class id: %s", err)
+	}
+
+	if len(m.Credits) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("credits cannot be empty")
+	}
+
+	for i, credits := range m.Credits {
+		if err := credits.Validate(); err != nil {
+			return errors.Wrapf(err, "credits[%d]", i)
+		}
+	}
+
+	if len(m.Reason) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("reason cannot be empty")
+	}
+
+	if len(m.Reason) > base.MaxNoteLength {
+		return ecocredit.ErrMaxLimit.Wrapf("reason: max length %d", base.MaxNoteLength)
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgCancelBufferCredits.
+func (m *MsgCancelBufferCredits) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Admin)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgCancelBufferCredits struct {
+	t   gocuke.TestingT
+	msg *MsgCancelBufferCredits
+	err error
+}
+
+func TestMsgCancelBufferCredits(t *testing.T) {
+	gocuke.NewRunner(t, &msgCancelBufferCredits{}).Path("./features/msg_cancel_buffer_credits.feature").Run()
+}
+
+func (s *msgCancelBufferCredits) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgCancelBufferCredits) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgCancelBufferCredits{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgCancelBufferCredits) AReasonWithLength(a string) {
+	length, err := strconv.ParseInt(a, 10, 64)
+	require.NoError(s.t, err)
+
+	s.msg.Reason = strings.Repeat("x", int(length))
+}
+
+func (s *msgCancelBufferCredits) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgCancelBufferCredits) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgCancelBufferCredits) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -0,0 +1,46 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+var _ legacytx.LegacyMsg = &MsgInvalidateBatch{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgInvalidateBatch) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgInvalidateBatch) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgInvalidateBatch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgInvalidateBatch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("signer: %s", err)
+	}
+
+	if err := base.ValidateBatchDenom(m.BatchDenom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("batch denom: %s", err)
+	}
+
+	if len(m.Reason) > base.MaxNoteLength {
+		return ecocredit.ErrMaxLimit.Wrapf("reason: max length %d", base.MaxNoteLength)
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgInvalidateBatch.
+func (m *MsgInvalidateBatch) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Signer)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgInvalidateBatch struct {
+	t   gocuke.TestingT
+	msg *MsgInvalidateBatch
+	err error
+}
+
+func TestMsgInvalidateBatch(t *testing.T) {
+	gocuke.NewRunner(t, &msgInvalidateBatch{}).Path("./features/msg_invalidate_batch.feature").Run()
+}
+
+func (s *msgInvalidateBatch) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgInvalidateBatch) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgInvalidateBatch{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgInvalidateBatch) AReasonWithLength(a string) {
+	length, err := strconv.ParseInt(a, 10, 64)
+	require.NoError(s.t, err)
+
+	s.msg.Reason = strings.Repeat("x", int(length))
+}
+
+func (s *msgInvalidateBatch) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgInvalidateBatch) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgInvalidateBatch) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
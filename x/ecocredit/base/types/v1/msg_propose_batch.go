@@ -0,0 +1,45 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var _ legacytx.LegacyMsg = &MsgProposeBatch{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgProposeBatch) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgProposeBatch) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgProposeBatch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data. A proposed credit
+// batch is validated the same as a credit batch created with MsgCreateBatch.
+func (m *MsgProposeBatch) ValidateBasic() error {
+	return m.ToMsgCreateBatch().ValidateBasic()
+}
+
+// GetSigners returns the expected signers for MsgProposeBatch.
+func (m *MsgProposeBatch) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Issuer)
+	return []sdk.AccAddress{addr}
+}
+
+// ToMsgCreateBatch returns the MsgCreateBatch that creates the proposed credit
+// batch on behalf of the issuer that proposed the credit batch.
+func (m *MsgProposeBatch) ToMsgCreateBatch() *MsgCreateBatch {
+	return &MsgCreateBatch{
+		Issuer:    m.Issuer,
+		ProjectId: m.ProjectId,
+		Issuance:  m.Issuance,
+		Metadata:  m.Metadata,
+		StartDate: m.StartDate,
+		EndDate:   m.EndDate,
+		OriginTx:  m.OriginTx,
+	}
+}
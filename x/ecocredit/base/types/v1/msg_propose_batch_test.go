@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgProposeBatch struct {
+	t   gocuke.TestingT
+	msg *MsgProposeBatch
+	err error
+}
+
+func TestMsgProposeBatch(t *testing.T) {
+	gocuke.NewRunner(t, &msgProposeBatch{}).Path("./features/msg_propose_batch.feature").Run()
+}
+
+func (s *msgProposeBatch) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgProposeBatch) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgProposeBatch{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgProposeBatch) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgProposeBatch) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgProposeBatch) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -7,6 +7,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 
+	"github.com/regen-network/regen-ledger/x/ecocredit"
 	"github.com/regen-network/regen-ledger/x/ecocredit/base"
 )
 
@@ -43,6 +44,14 @@ func (m *MsgRetire) ValidateBasic() error {
 		return sdkerrors.ErrInvalidRequest.Wrapf("jurisdiction: %s", err)
 	}
 
+	if len(m.Reason) > base.MaxNoteLength {
+		return ecocredit.ErrMaxLimit.Wrapf("reason: max length %d", base.MaxNoteLength)
+	}
+
+	if len(m.Beneficiary) > base.MaxNoteLength {
+		return ecocredit.ErrMaxLimit.Wrapf("beneficiary: max length %d", base.MaxNoteLength)
+	}
+
 	return nil
 }
 
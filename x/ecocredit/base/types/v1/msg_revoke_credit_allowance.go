@@ -0,0 +1,41 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+var _ legacytx.LegacyMsg = &MsgRevokeCreditAllowance{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgRevokeCreditAllowance) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgRevokeCreditAllowance) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRevokeCreditAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgRevokeCreditAllowance) ValidateBasic() error {
+	if err := validateOwnerAndSpender(m.Owner, m.Spender); err != nil {
+		return err
+	}
+
+	if err := base.ValidateBatchDenom(m.BatchDenom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("batch denom: %s", err)
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgRevokeCreditAllowance.
+func (m *MsgRevokeCreditAllowance) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgRevokeCreditAllowance struct {
+	t   gocuke.TestingT
+	msg *MsgRevokeCreditAllowance
+	err error
+}
+
+func TestMsgRevokeCreditAllowance(t *testing.T) {
+	gocuke.NewRunner(t, &msgRevokeCreditAllowance{}).Path("./features/msg_revoke_credit_allowance.feature").Run()
+}
+
+func (s *msgRevokeCreditAllowance) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgRevokeCreditAllowance) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgRevokeCreditAllowance{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgRevokeCreditAllowance) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgRevokeCreditAllowance) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgRevokeCreditAllowance) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 
 	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
 	"github.com/regen-network/regen-ledger/x/ecocredit/base"
 )
 
@@ -63,6 +64,14 @@ func (m *MsgSend) ValidateBasic() error {
 				return sdkerrors.ErrInvalidRequest.Wrapf("retirement jurisdiction: %s", err)
 			}
 		}
+
+		if len(credits.RetirementReason) > base.MaxNoteLength {
+			return ecocredit.ErrMaxLimit.Wrapf("%s: retirement reason: max length %d", creditIndex, base.MaxNoteLength)
+		}
+
+		if len(credits.RetirementBeneficiary) > base.MaxNoteLength {
+			return ecocredit.ErrMaxLimit.Wrapf("%s: retirement beneficiary: max length %d", creditIndex, base.MaxNoteLength)
+		}
 	}
 
 	return nil
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var _ legacytx.LegacyMsg = &MsgSendFrom{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgSendFrom) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgSendFrom) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSendFrom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data. The credits are
+// validated the same as credits sent by the owner with MsgSend.
+func (m *MsgSendFrom) ValidateBasic() error {
+	if err := validateOwnerAndSpender(m.Owner, m.Spender); err != nil {
+		return err
+	}
+
+	msgSend := &MsgSend{
+		Sender:    m.Owner,
+		Recipient: m.Recipient,
+		Credits:   m.Credits,
+	}
+
+	return msgSend.ValidateBasic()
+}
+
+// GetSigners returns the expected signers for MsgSendFrom.
+func (m *MsgSendFrom) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Spender)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgSendFrom struct {
+	t   gocuke.TestingT
+	msg *MsgSendFrom
+	err error
+}
+
+func TestMsgSendFrom(t *testing.T) {
+	gocuke.NewRunner(t, &msgSendFrom{}).Path("./features/msg_send_from.feature").Run()
+}
+
+func (s *msgSendFrom) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgSendFrom) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgSendFrom{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgSendFrom) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgSendFrom) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgSendFrom) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
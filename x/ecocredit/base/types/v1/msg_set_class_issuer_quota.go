@@ -0,0 +1,65 @@
+package v1
+
+import (
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+var _ legacytx.LegacyMsg = &MsgSetClassIssuerQuota{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgSetClassIssuerQuota) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgSetClassIssuerQuota) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetClassIssuerQuota) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSetClassIssuerQuota) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("admin: %s", err)
+	}
+
+	if err := base.ValidateClassID(m.ClassId); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("class id: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Issuer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("issuer: %s", err)
+	}
+
+	if m.Amount == "" {
+		if m.Period != nil {
+			return sdkerrors.ErrInvalidRequest.Wrap("period cannot be set when removing a quota")
+		}
+		return nil
+	}
+
+	if _, err := math.NewPositiveDecFromString(m.Amount); err != nil {
+		return errors.Wrap(err, "amount")
+	}
+
+	if m.Period != nil {
+		if err := validateQuotaPeriod(m.Period); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("period: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgSetClassIssuerQuota.
+func (m *MsgSetClassIssuerQuota) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Admin)
+	return []sdk.AccAddress{addr}
+}
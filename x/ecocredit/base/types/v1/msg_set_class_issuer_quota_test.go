@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgSetClassIssuerQuota struct {
+	t   gocuke.TestingT
+	msg *MsgSetClassIssuerQuota
+	err error
+}
+
+func TestMsgSetClassIssuerQuota(t *testing.T) {
+	gocuke.NewRunner(t, &msgSetClassIssuerQuota{}).Path("./features/msg_set_class_issuer_quota.feature").Run()
+}
+
+func (s *msgSetClassIssuerQuota) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgSetClassIssuerQuota) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgSetClassIssuerQuota{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgSetClassIssuerQuota) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgSetClassIssuerQuota) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgSetClassIssuerQuota) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgSuspendBatch struct {
+	t   gocuke.TestingT
+	msg *MsgSuspendBatch
+	err error
+}
+
+func TestMsgSuspendBatch(t *testing.T) {
+	gocuke.NewRunner(t, &msgSuspendBatch{}).Path("./features/msg_suspend_batch.feature").Run()
+}
+
+func (s *msgSuspendBatch) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgSuspendBatch) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgSuspendBatch{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgSuspendBatch) AReasonWithLength(a string) {
+	length, err := strconv.ParseInt(a, 10, 64)
+	require.NoError(s.t, err)
+
+	s.msg.Reason = strings.Repeat("x", int(length))
+}
+
+func (s *msgSuspendBatch) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgSuspendBatch) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgSuspendBatch) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
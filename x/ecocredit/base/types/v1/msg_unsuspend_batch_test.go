@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgUnsuspendBatch struct {
+	t   gocuke.TestingT
+	msg *MsgUnsuspendBatch
+	err error
+}
+
+func TestMsgUnsuspendBatch(t *testing.T) {
+	gocuke.NewRunner(t, &msgUnsuspendBatch{}).Path("./features/msg_unsuspend_batch.feature").Run()
+}
+
+func (s *msgUnsuspendBatch) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgUnsuspendBatch) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgUnsuspendBatch{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgUnsuspendBatch) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgUnsuspendBatch) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgUnsuspendBatch) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
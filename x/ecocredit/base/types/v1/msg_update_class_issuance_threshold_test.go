@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgUpdateClassIssuanceThreshold struct {
+	t   gocuke.TestingT
+	msg *MsgUpdateClassIssuanceThreshold
+	err error
+}
+
+func TestMsgUpdateClassIssuanceThreshold(t *testing.T) {
+	gocuke.NewRunner(t, &msgUpdateClassIssuanceThreshold{}).Path("./features/msg_update_class_issuance_threshold.feature").Run()
+}
+
+func (s *msgUpdateClassIssuanceThreshold) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgUpdateClassIssuanceThreshold) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgUpdateClassIssuanceThreshold{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgUpdateClassIssuanceThreshold) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgUpdateClassIssuanceThreshold) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgUpdateClassIssuanceThreshold) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgUpdateClassRequireVerifiedProject struct {
+	t   gocuke.TestingT
+	msg *MsgUpdateClassRequireVerifiedProject
+	err error
+}
+
+func TestMsgUpdateClassRequireVerifiedProject(t *testing.T) {
+	gocuke.NewRunner(t, &msgUpdateClassRequireVerifiedProject{}).Path("./features/msg_update_class_require_verified_project.feature").Run()
+}
+
+func (s *msgUpdateClassRequireVerifiedProject) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgUpdateClassRequireVerifiedProject) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgUpdateClassRequireVerifiedProject{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgUpdateClassRequireVerifiedProject) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgUpdateClassRequireVerifiedProject) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgUpdateClassRequireVerifiedProject) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -0,0 +1,51 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+var _ legacytx.LegacyMsg = &MsgUpdateProjectStatus{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgUpdateProjectStatus) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgUpdateProjectStatus) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateProjectStatus) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUpdateProjectStatus) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Issuer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("issuer: %s", err)
+	}
+
+	if err := base.ValidateProjectID(m.ProjectId); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("project id: %s", err)
+	}
+
+	if err := m.NewStatus.Validate(); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("new status: %s", err)
+	}
+
+	if m.Iri != "" {
+		if err := base.ValidateDataIRI(m.Iri); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("iri: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgUpdateProjectStatus.
+func (m *MsgUpdateProjectStatus) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Issuer)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgUpdateProjectStatus struct {
+	t   gocuke.TestingT
+	msg *MsgUpdateProjectStatus
+	err error
+}
+
+func TestMsgUpdateProjectStatus(t *testing.T) {
+	gocuke.NewRunner(t, &msgUpdateProjectStatus{}).Path("./features/msg_update_project_status.feature").Run()
+}
+
+func (s *msgUpdateProjectStatus) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgUpdateProjectStatus) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgUpdateProjectStatus{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgUpdateProjectStatus) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgUpdateProjectStatus) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgUpdateProjectStatus) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
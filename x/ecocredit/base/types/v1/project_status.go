@@ -0,0 +1,56 @@
+package v1
+
+import "github.com/regen-network/regen-ledger/x/ecocredit"
+
+// projectStatusTransitions maps each project status to the statuses a project
+// with that status can be updated to. Projects created before project statuses
+// were introduced have the unspecified status and can be updated to any status.
+var projectStatusTransitions = map[ProjectStatus][]ProjectStatus{
+	ProjectStatus_PROJECT_STATUS_UNSPECIFIED: {
+		ProjectStatus_PROJECT_STATUS_PROPOSED,
+		ProjectStatus_PROJECT_STATUS_REGISTERED,
+		ProjectStatus_PROJECT_STATUS_VERIFIED,
+		ProjectStatus_PROJECT_STATUS_SUSPENDED,
+		ProjectStatus_PROJECT_STATUS_COMPLETED,
+	},
+	ProjectStatus_PROJECT_STATUS_PROPOSED: {
+		ProjectStatus_PROJECT_STATUS_REGISTERED,
+		ProjectStatus_PROJECT_STATUS_SUSPENDED,
+	},
+	ProjectStatus_PROJECT_STATUS_REGISTERED: {
+		ProjectStatus_PROJECT_STATUS_VERIFIED,
+		ProjectStatus_PROJECT_STATUS_SUSPENDED,
+		ProjectStatus_PROJECT_STATUS_COMPLETED,
+	},
+	ProjectStatus_PROJECT_STATUS_VERIFIED: {
+		ProjectStatus_PROJECT_STATUS_REGISTERED,
+		ProjectStatus_PROJECT_STATUS_SUSPENDED,
+		ProjectStatus_PROJECT_STATUS_COMPLETED,
+	},
+	ProjectStatus_PROJECT_STATUS_SUSPENDED: {
+		ProjectStatus_PROJECT_STATUS_REGISTERED,
+		ProjectStatus_PROJECT_STATUS_VERIFIED,
+		ProjectStatus_PROJECT_STATUS_COMPLETED,
+	},
+	ProjectStatus_PROJECT_STATUS_COMPLETED: {},
+}
+
+// Validate checks that the project status is a known status other than the
+// unspecified status.
+func (s ProjectStatus) Validate() error {
+	if _, ok := ProjectStatus_name[int32(s)]; !ok || s == ProjectStatus_PROJECT_STATUS_UNSPECIFIED {
+		return ecocredit.ErrParseFailure.Wrapf("invalid project status %s", s)
+	}
+	return nil
+}
+
+// CanTransitionTo returns whether a project with the status can be updated to
+// the new status.
+func (s ProjectStatus) CanTransitionTo(newStatus ProjectStatus) bool {
+	for _, status := range projectStatusTransitions[s] {
+		if status == newStatus {
+			return true
+		}
+	}
+	return false
+}
@@ -25,6 +25,59 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ProjectStatus is the lifecycle status of a project. Projects are created
+// with the proposed status and the status is updated by the issuers of the
+// credit class.
+//
+// Since Revision 1
+type ProjectStatus int32
+
+const (
+	// PROJECT_STATUS_UNSPECIFIED is the status of projects created before
+	// project statuses were introduced.
+	ProjectStatus_PROJECT_STATUS_UNSPECIFIED ProjectStatus = 0
+	// PROJECT_STATUS_PROPOSED is the status of a newly created project.
+	ProjectStatus_PROJECT_STATUS_PROPOSED ProjectStatus = 1
+	// PROJECT_STATUS_REGISTERED is the status of a project that has been
+	// registered by an issuer of the credit class.
+	ProjectStatus_PROJECT_STATUS_REGISTERED ProjectStatus = 2
+	// PROJECT_STATUS_VERIFIED is the status of a project that has been verified
+	// by an issuer of the credit class.
+	ProjectStatus_PROJECT_STATUS_VERIFIED ProjectStatus = 3
+	// PROJECT_STATUS_SUSPENDED is the status of a project that has been
+	// suspended by an issuer of the credit class.
+	ProjectStatus_PROJECT_STATUS_SUSPENDED ProjectStatus = 4
+	// PROJECT_STATUS_COMPLETED is the status of a project that has completed.
+	// The status of a completed project cannot be updated.
+	ProjectStatus_PROJECT_STATUS_COMPLETED ProjectStatus = 5
+)
+
+var ProjectStatus_name = map[int32]string{
+	0: "PROJECT_STATUS_UNSPECIFIED",
+	1: "PROJECT_STATUS_PROPOSED",
+	2: "PROJECT_STATUS_REGISTERED",
+	3: "PROJECT_STATUS_VERIFIED",
+	4: "PROJECT_STATUS_SUSPENDED",
+	5: "PROJECT_STATUS_COMPLETED",
+}
+
+var ProjectStatus_value = map[string]int32{
+	"PROJECT_STATUS_UNSPECIFIED": 0,
+	"PROJECT_STATUS_PROPOSED":    1,
+	"PROJECT_STATUS_REGISTERED":  2,
+	"PROJECT_STATUS_VERIFIED":    3,
+	"PROJECT_STATUS_SUSPENDED":   4,
+	"PROJECT_STATUS_COMPLETED":   5,
+}
+
+func (x ProjectStatus) String() string {
+	return proto.EnumName(ProjectStatus_name, int32(x))
+}
+
+func (ProjectStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6cfdca0a4aaabb36, []int{0}
+}
+
 // CreditType defines the measurement unit/precision of a certain credit type
 // (e.g. carbon, biodiversity...)
 type CreditType struct {
@@ -114,6 +167,35 @@ type Class struct {
 	Metadata string `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	// credit_type_abbrev is the abbreviation of the credit type.
 	CreditTypeAbbrev string `protobuf:"bytes,5,opt,name=credit_type_abbrev,json=creditTypeAbbrev,proto3" json:"credit_type_abbrev,omitempty"`
+	// buffer_percentage is the optional percentage of each issuance of credits
+	// that is withheld as tradable credits in the buffer account to cover
+	// reversals (e.g. a non-permanence buffer). The buffer percentage is a
+	// decimal greater than 0 and less than 100.
+	//
+	// Since Revision 1
+	BufferPercentage string `protobuf:"bytes,6,opt,name=buffer_percentage,json=bufferPercentage,proto3" json:"buffer_percentage,omitempty"`
+	// buffer_account is the address of the account that receives the credits
+	// withheld from each issuance of credits. The buffer account is derived from
+	// the credit class id such that no user controls the buffer account and the
+	// buffer account is set if and only if the buffer percentage is set.
+	//
+	// Since Revision 1
+	BufferAccount []byte `protobuf:"bytes,7,opt,name=buffer_account,json=bufferAccount,proto3" json:"buffer_account,omitempty"`
+	// issuance_threshold is the number of approvals from issuers of the credit
+	// class required to create a proposed credit batch. If the issuance threshold
+	// is greater than one, credit batches cannot be created directly and must be
+	// proposed and approved by other issuers of the credit class.
+	//
+	// Since Revision 1
+	IssuanceThreshold uint32 `protobuf:"varint,8,opt,name=issuance_threshold,json=issuanceThreshold,proto3" json:"issuance_threshold,omitempty"`
+	// require_verified_project determines whether or not the project of a credit
+	// batch must be verified for credits to be issued in the credit class, i.e.
+	// when a credit batch is created, proposed or approved and when credits are
+	// minted to an open credit batch. Only the admin of the credit class can
+	// update the requirement.
+	//
+	// Since Revision 1
+	RequireVerifiedProject bool `protobuf:"varint,9,opt,name=require_verified_project,json=requireVerifiedProject,proto3" json:"require_verified_project,omitempty"`
 }
 
 func (m *Class) Reset()         { *m = Class{} }
@@ -184,6 +266,34 @@ func (m *Class) GetCreditTypeAbbrev() string {
 	return ""
 }
 
+func (m *Class) GetBufferPercentage() string {
+	if m != nil {
+		return m.BufferPercentage
+	}
+	return ""
+}
+
+func (m *Class) GetBufferAccount() []byte {
+	if m != nil {
+		return m.BufferAccount
+	}
+	return nil
+}
+
+func (m *Class) GetIssuanceThreshold() uint32 {
+	if m != nil {
+		return m.IssuanceThreshold
+	}
+	return 0
+}
+
+func (m *Class) GetRequireVerifiedProject() bool {
+	if m != nil {
+		return m.RequireVerifiedProject
+	}
+	return false
+}
+
 // ClassIssuers is a JOIN table for Class Info that stores the credit class
 // issuers
 type ClassIssuer struct {
@@ -261,6 +371,15 @@ type Project struct {
 	Metadata string `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	// reference_id is any arbitrary string used to reference the project.
 	ReferenceId string `protobuf:"bytes,7,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	// status is the lifecycle status of the project.
+	//
+	// Since Revision 1
+	Status ProjectStatus `protobuf:"varint,8,opt,name=status,proto3,enum=regen.ecocredit.v1.ProjectStatus" json:"status,omitempty"`
+	// status_iri is the IRI of data anchored in the data module with evidence
+	// supporting the current status of the project (e.g. a verification report).
+	//
+	// Since Revision 1
+	StatusIri string `protobuf:"bytes,9,opt,name=status_iri,json=statusIri,proto3" json:"status_iri,omitempty"`
 }
 
 func (m *Project) Reset()         { *m = Project{} }
@@ -345,6 +464,20 @@ func (m *Project) GetReferenceId() string {
 	return ""
 }
 
+func (m *Project) GetStatus() ProjectStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ProjectStatus_PROJECT_STATUS_UNSPECIFIED
+}
+
+func (m *Project) GetStatusIri() string {
+	if m != nil {
+		return m.StatusIri
+	}
+	return ""
+}
+
 // Batch represents the high-level on-chain information for a credit batch.
 type Batch struct {
 	// key is the table row identifier of the credit batch used internally for
@@ -373,6 +506,16 @@ type Batch struct {
 	// open tells if it's possible to mint new credits in the future.
 	// Once `open` is set to false, it can't be toggled any more.
 	Open bool `protobuf:"varint,9,opt,name=open,proto3" json:"open,omitempty"`
+	// suspended tells if the credit batch is suspended. Credits from a suspended
+	// credit batch cannot be sent, retired, bridged, put into a basket or sold.
+	//
+	// Since Revision 1
+	Suspended bool `protobuf:"varint,10,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	// invalidated tells if the credit batch has been invalidated. An invalidated
+	// credit batch is suspended and cannot be unsuspended.
+	//
+	// Since Revision 1
+	Invalidated bool `protobuf:"varint,11,opt,name=invalidated,proto3" json:"invalidated,omitempty"`
 }
 
 func (m *Batch) Reset()         { *m = Batch{} }
@@ -471,6 +614,20 @@ func (m *Batch) GetOpen() bool {
 	return false
 }
 
+func (m *Batch) GetSuspended() bool {
+	if m != nil {
+		return m.Suspended
+	}
+	return false
+}
+
+func (m *Batch) GetInvalidated() bool {
+	if m != nil {
+		return m.Invalidated
+	}
+	return false
+}
+
 // ClassSequence stores and increments the sequence number for credit classes
 // within a credit type.
 type ClassSequence struct {
@@ -1162,482 +1319,822 @@ func (m *AllowedBridgeChain) GetChainName() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*CreditType)(nil), "regen.ecocredit.v1.CreditType")
-	proto.RegisterType((*Class)(nil), "regen.ecocredit.v1.Class")
-	proto.RegisterType((*ClassIssuer)(nil), "regen.ecocredit.v1.ClassIssuer")
-	proto.RegisterType((*Project)(nil), "regen.ecocredit.v1.Project")
-	proto.RegisterType((*Batch)(nil), "regen.ecocredit.v1.Batch")
-	proto.RegisterType((*ClassSequence)(nil), "regen.ecocredit.v1.ClassSequence")
-	proto.RegisterType((*ProjectSequence)(nil), "regen.ecocredit.v1.ProjectSequence")
-	proto.RegisterType((*BatchSequence)(nil), "regen.ecocredit.v1.BatchSequence")
-	proto.RegisterType((*BatchBalance)(nil), "regen.ecocredit.v1.BatchBalance")
-	proto.RegisterType((*BatchSupply)(nil), "regen.ecocredit.v1.BatchSupply")
-	proto.RegisterType((*OriginTxIndex)(nil), "regen.ecocredit.v1.OriginTxIndex")
-	proto.RegisterType((*BatchContract)(nil), "regen.ecocredit.v1.BatchContract")
-	proto.RegisterType((*ClassCreatorAllowlist)(nil), "regen.ecocredit.v1.ClassCreatorAllowlist")
-	proto.RegisterType((*AllowedClassCreator)(nil), "regen.ecocredit.v1.AllowedClassCreator")
-	proto.RegisterType((*ClassFee)(nil), "regen.ecocredit.v1.ClassFee")
-	proto.RegisterType((*AllowedBridgeChain)(nil), "regen.ecocredit.v1.AllowedBridgeChain")
+// Retirement is a record of credits retired by an account. A retirement record
+// is stored for every retirement, including retirements upon issuance,
+// transfer, taking from a basket, and purchase in the marketplace.
+//
+// Since Revision 1
+type Retirement struct {
+	// id is the unique identifier of the retirement.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address of the account that owns the retired credits.
+	Owner []byte `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// batch_key is the table row identifier of the credit batch used internally
+	// for efficient lookups. This links a retirement to a credit batch.
+	BatchKey uint64 `protobuf:"varint,3,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// amount is the decimal number of credits that were retired.
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// jurisdiction is the jurisdiction of the beneficiary or buyer of the retired
+	// credits.
+	Jurisdiction string `protobuf:"bytes,5,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"`
+	// reason is any arbitrary string that specifies the reason for retiring the
+	// credits.
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	// beneficiary is any arbitrary string that specifies the person or
+	// organization on whose behalf the credits were retired.
+	Beneficiary string `protobuf:"bytes,7,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	// timestamp is the block time at which the credits were retired.
+	Timestamp *types.Timestamp `protobuf:"bytes,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *Retirement) Reset()         { *m = Retirement{} }
+func (m *Retirement) String() string { return proto.CompactTextString(m) }
+func (*Retirement) ProtoMessage()    {}
+func (*Retirement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cfdca0a4aaabb36, []int{16}
+}
+func (m *Retirement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Retirement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Retirement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Retirement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Retirement.Merge(m, src)
+}
+func (m *Retirement) XXX_Size() int {
+	return m.Size()
+}
+func (m *Retirement) XXX_DiscardUnknown() {
+	xxx_messageInfo_Retirement.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("regen/ecocredit/v1/state.proto", fileDescriptor_6cfdca0a4aaabb36) }
+var xxx_messageInfo_Retirement proto.InternalMessageInfo
 
-var fileDescriptor_6cfdca0a4aaabb36 = []byte{
-	// 1179 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x8e, 0xdc, 0xc4,
-	0x13, 0x8f, 0xe7, 0x63, 0xc7, 0x53, 0xf3, 0xe5, 0xf4, 0x66, 0x37, 0xce, 0xfe, 0xf3, 0x9f, 0x04,
-	0x03, 0x4a, 0x22, 0x16, 0x8f, 0x36, 0x80, 0x04, 0x46, 0x22, 0xda, 0xdd, 0x80, 0x14, 0x45, 0x02,
-	0x34, 0xe4, 0x02, 0x97, 0xa1, 0xc7, 0xae, 0xcc, 0x3a, 0x19, 0xdb, 0x43, 0xbb, 0x67, 0xb3, 0x7b,
-	0xe5, 0x01, 0x10, 0x27, 0xc4, 0x01, 0xf1, 0x02, 0xbc, 0x02, 0x0f, 0x00, 0xb7, 0x48, 0x5c, 0x38,
-	0xa2, 0xe4, 0x0d, 0x10, 0x27, 0x4e, 0xa8, 0xcb, 0x6d, 0xcf, 0x78, 0x98, 0x6c, 0x56, 0xdc, 0x5c,
-	0xd5, 0xf5, 0xf1, 0xab, 0x5f, 0x57, 0xb5, 0x0b, 0xfa, 0x02, 0x27, 0x18, 0x0f, 0xd0, 0x4f, 0x7c,
-	0x81, 0x41, 0x28, 0x07, 0xc7, 0x7b, 0x83, 0x54, 0x72, 0x89, 0xee, 0x4c, 0x24, 0x32, 0x61, 0x8c,
-	0xce, 0xdd, 0xe2, 0xdc, 0x3d, 0xde, 0xdb, 0xe9, 0xfb, 0x49, 0x1a, 0x25, 0xe9, 0x60, 0xcc, 0x53,
-	0x1c, 0x1c, 0xef, 0x8d, 0x51, 0xf2, 0xbd, 0x81, 0x9f, 0x84, 0x71, 0xe6, 0xb3, 0x73, 0x59, 0x9f,
-	0x27, 0x22, 0x52, 0xe1, 0x12, 0x11, 0xe9, 0x83, 0x6b, 0x93, 0x24, 0x99, 0x4c, 0x71, 0x40, 0xd2,
-	0x78, 0xfe, 0x70, 0x20, 0xc3, 0x08, 0x53, 0xc9, 0xa3, 0x59, 0x66, 0xe0, 0xfc, 0x60, 0x00, 0x1c,
-	0x52, 0x9e, 0x07, 0xa7, 0x33, 0x64, 0x0e, 0xb4, 0xf9, 0x78, 0x2c, 0xf0, 0x38, 0xe4, 0x32, 0x4c,
-	0x62, 0xdb, 0xb8, 0x6e, 0xdc, 0x6c, 0x0e, 0x4b, 0x3a, 0xc6, 0xa0, 0x16, 0xf3, 0x08, 0xed, 0x0a,
-	0x9d, 0xd1, 0xb7, 0xd2, 0xcd, 0xe3, 0x50, 0xda, 0xd5, 0x4c, 0xa7, 0xbe, 0xd9, 0x55, 0x68, 0xce,
-	0x04, 0xfa, 0x61, 0xaa, 0x02, 0xd5, 0xae, 0x1b, 0x37, 0x3b, 0xc3, 0x85, 0xc2, 0x7b, 0xed, 0xcf,
-	0x1f, 0x7f, 0xfb, 0xa6, 0xda, 0x87, 0x6e, 0x39, 0x23, 0x83, 0x2c, 0xba, 0x65, 0xd8, 0x86, 0x6d,
-	0x38, 0xbf, 0x1a, 0x50, 0x3f, 0x9c, 0xf2, 0x34, 0x65, 0x16, 0x54, 0x1f, 0xe3, 0x29, 0x01, 0xaa,
-	0x0d, 0xd5, 0x27, 0xeb, 0x42, 0x25, 0x0c, 0x34, 0x8a, 0x4a, 0x18, 0xb0, 0x4b, 0x50, 0xe7, 0x41,
-	0x14, 0xc6, 0x04, 0xa2, 0x3d, 0xcc, 0x04, 0xb6, 0x03, 0x66, 0x84, 0x92, 0x07, 0x5c, 0x72, 0x02,
-	0xd1, 0x1c, 0x16, 0x32, 0xdb, 0x05, 0x96, 0x71, 0x3c, 0x92, 0xa7, 0x33, 0x1c, 0x65, 0x38, 0xec,
-	0x3a, 0x59, 0x59, 0x7e, 0xc1, 0xca, 0x3e, 0xe9, 0xbd, 0x0f, 0x08, 0xf1, 0xbb, 0xd0, 0x20, 0x24,
-	0x96, 0xc1, 0x4c, 0x05, 0x40, 0x01, 0x65, 0x4d, 0x9d, 0xda, 0xaa, 0xb0, 0xed, 0x75, 0x31, 0xad,
-	0xaa, 0x5d, 0x71, 0xbe, 0x84, 0x16, 0x95, 0x72, 0x2f, 0x4d, 0xe7, 0x28, 0xd8, 0xff, 0xa0, 0xe9,
-	0x2b, 0x71, 0xb4, 0x28, 0xcb, 0x24, 0xc5, 0x7d, 0x3c, 0x65, 0xdb, 0xb0, 0x11, 0x92, 0x19, 0xd5,
-	0xd7, 0x1e, 0x6a, 0xc9, 0xbb, 0x4a, 0x18, 0xb6, 0x81, 0x81, 0x55, 0x38, 0xef, 0x6a, 0xcb, 0xaa,
-	0xf3, 0x53, 0x05, 0x1a, 0x9f, 0x8a, 0xe4, 0x11, 0xfa, 0xf2, 0x3f, 0xf3, 0x55, 0x82, 0x55, 0x5b,
-	0x81, 0xe5, 0x40, 0xfb, 0xd1, 0x5c, 0x84, 0x69, 0x10, 0xfa, 0xd4, 0x1e, 0x19, 0x55, 0x25, 0x5d,
-	0x89, 0xf0, 0x8d, 0x15, 0xc2, 0x5f, 0x81, 0xb6, 0xc0, 0x87, 0x28, 0x30, 0xf6, 0x71, 0x14, 0x06,
-	0x76, 0x83, 0xce, 0x5b, 0x85, 0xee, 0x5e, 0xe0, 0x1d, 0x51, 0x85, 0xe3, 0x75, 0x2c, 0x33, 0x68,
-	0x2f, 0x15, 0x1d, 0x58, 0x95, 0x65, 0xe6, 0xab, 0xcc, 0x2a, 0x07, 0xb7, 0x6a, 0x6c, 0x07, 0xb6,
-	0x17, 0x0e, 0xa5, 0xb3, 0xba, 0x5d, 0x73, 0xbe, 0xaf, 0x42, 0xfd, 0x80, 0x4b, 0xff, 0x68, 0x0d,
-	0x57, 0x2f, 0xe0, 0x9f, 0x5d, 0x83, 0xd6, 0x2c, 0x23, 0x98, 0xf8, 0xa9, 0x92, 0x07, 0x68, 0x95,
-	0x62, 0xe8, 0x12, 0xd4, 0x03, 0x8c, 0x93, 0x48, 0xf7, 0x5a, 0x26, 0x94, 0x38, 0xa9, 0xaf, 0x70,
-	0xf2, 0x1e, 0x40, 0x2a, 0xb9, 0x90, 0xa3, 0x80, 0x4b, 0x24, 0xc6, 0x5a, 0xb7, 0x77, 0xdc, 0x6c,
-	0x6e, 0xdd, 0x7c, 0x6e, 0xdd, 0x07, 0xf9, 0xdc, 0x0e, 0x9b, 0x64, 0x7d, 0x97, 0x4b, 0x64, 0xef,
-	0x80, 0x89, 0x71, 0x90, 0x39, 0x36, 0x5e, 0xea, 0xd8, 0xc0, 0x38, 0x20, 0xb7, 0x3b, 0xd0, 0x51,
-	0xe5, 0x70, 0xc5, 0x05, 0xf9, 0x9a, 0x2f, 0xf5, 0x6d, 0xe7, 0x0e, 0x14, 0x80, 0x41, 0x2d, 0x99,
-	0x61, 0x6c, 0x37, 0xaf, 0x1b, 0x37, 0xcd, 0x21, 0x7d, 0x7b, 0xf7, 0xe9, 0xde, 0x3e, 0x5c, 0xdc,
-	0x5b, 0x4b, 0x33, 0x41, 0x57, 0xd7, 0x2b, 0xf1, 0x66, 0x55, 0x58, 0x77, 0xb9, 0x6a, 0xab, 0xca,
-	0x20, 0x27, 0xdc, 0xaa, 0xd9, 0x75, 0xe7, 0x6b, 0x03, 0x3a, 0x34, 0x2b, 0x9f, 0xe1, 0x57, 0x73,
-	0x75, 0x67, 0x2f, 0x18, 0x55, 0x63, 0xfd, 0xa8, 0xb2, 0x57, 0xa1, 0x13, 0xe3, 0x89, 0x1c, 0xa5,
-	0xda, 0x9d, 0x6e, 0xb1, 0x36, 0x6c, 0x2b, 0x65, 0x1e, 0xd2, 0xeb, 0x13, 0x62, 0x1b, 0x2e, 0xad,
-	0x0d, 0xbd, 0xe1, 0x3c, 0x82, 0x9e, 0x1e, 0xa6, 0x02, 0xc5, 0x99, 0x33, 0x7b, 0xae, 0xa4, 0x5b,
-	0x94, 0xb4, 0x07, 0xad, 0xe5, 0x48, 0x0d, 0x27, 0x86, 0x0e, 0xb5, 0x62, 0x91, 0x69, 0xa5, 0xd1,
-	0x8c, 0x7f, 0x35, 0xda, 0xb9, 0xb2, 0x5d, 0xa6, 0x6c, 0x17, 0xa1, 0x53, 0x8e, 0x66, 0x3a, 0x7f,
-	0x19, 0xd0, 0xa6, 0x84, 0x07, 0x7c, 0xca, 0x75, 0x65, 0x63, 0x25, 0x2f, 0x57, 0x46, 0x0a, 0x95,
-	0xcb, 0x86, 0x06, 0x0f, 0x02, 0x81, 0x69, 0xaa, 0xc7, 0x21, 0x17, 0xd9, 0x0d, 0xe8, 0x49, 0xc1,
-	0x03, 0x3e, 0x9e, 0xe2, 0x88, 0x47, 0xc9, 0x3c, 0xce, 0x7f, 0x01, 0xdd, 0x5c, 0xbd, 0x4f, 0x5a,
-	0xf6, 0x3a, 0x74, 0x05, 0xca, 0x50, 0x60, 0x90, 0xdb, 0x65, 0x03, 0xd2, 0xd1, 0x5a, 0x6d, 0x76,
-	0x03, 0x7a, 0x98, 0xfa, 0x22, 0x79, 0xb2, 0xb0, 0xcb, 0xe6, 0xa5, 0x9b, 0xab, 0x33, 0x43, 0xef,
-	0x6d, 0xaa, 0xcc, 0x85, 0x4d, 0xb8, 0xa8, 0xb1, 0xec, 0x16, 0xf8, 0xd9, 0x16, 0x5c, 0x2c, 0x84,
-	0x5d, 0x7d, 0x6c, 0x19, 0x76, 0xd3, 0xf9, 0xd9, 0x80, 0x56, 0xc6, 0xf3, 0x7c, 0x36, 0x9b, 0x9e,
-	0x9e, 0x5d, 0xf5, 0x9a, 0xda, 0x2a, 0xe7, 0xac, 0xad, 0xba, 0xae, 0xb6, 0x5b, 0x60, 0xf9, 0x8a,
-	0xeb, 0xe9, 0x74, 0x95, 0x84, 0x5e, 0xa1, 0xd7, 0xd5, 0x2d, 0x75, 0xc9, 0x02, 0x1f, 0x38, 0x73,
-	0xe8, 0x7c, 0x22, 0xc2, 0x49, 0x18, 0x3f, 0x38, 0xb9, 0x17, 0x07, 0x78, 0x72, 0x76, 0x3f, 0xae,
-	0xbe, 0xf7, 0xdb, 0xb0, 0x91, 0x26, 0x73, 0xe1, 0xa3, 0x86, 0xa7, 0x25, 0xef, 0x1a, 0x25, 0xbb,
-	0x02, 0x5b, 0xb0, 0xb9, 0xfc, 0xbc, 0xee, 0x6a, 0xe3, 0x96, 0xf3, 0x9d, 0xa1, 0xbb, 0xf3, 0x30,
-	0x89, 0xa5, 0xe0, 0xbe, 0x3c, 0x9b, 0xb7, 0x12, 0xa8, 0xca, 0x0a, 0xa8, 0x1d, 0x30, 0x7d, 0x1d,
-	0x45, 0xc3, 0x28, 0x64, 0x6f, 0x40, 0x40, 0x6e, 0x95, 0xaa, 0x66, 0x36, 0xb0, 0x05, 0xaa, 0xdc,
-	0x94, 0xb6, 0x83, 0xb6, 0xf3, 0x3e, 0x6c, 0xd1, 0x2b, 0x71, 0x28, 0x90, 0xcb, 0x44, 0xec, 0x4f,
-	0xa7, 0xc9, 0x93, 0x69, 0x98, 0x4a, 0xd5, 0xb0, 0x18, 0xab, 0x1b, 0x0a, 0x08, 0x9d, 0x39, 0xcc,
-	0x45, 0xcf, 0xfc, 0x5b, 0xe5, 0xa8, 0x98, 0x1d, 0xe7, 0x2e, 0x6c, 0x92, 0x03, 0x06, 0xcb, 0x31,
-	0x96, 0x7b, 0xdd, 0x28, 0xf5, 0xba, 0xb7, 0x49, 0xf0, 0x3a, 0xd0, 0x5c, 0x58, 0x74, 0x9d, 0x7d,
-	0x30, 0xc9, 0xfd, 0x23, 0x44, 0xf6, 0x06, 0x54, 0x1f, 0x22, 0x92, 0x5b, 0xeb, 0xf6, 0x15, 0x37,
-	0xdb, 0xc9, 0x5c, 0xb5, 0xb3, 0xb9, 0x7a, 0x67, 0x73, 0x0f, 0x93, 0x30, 0x1e, 0x2a, 0xab, 0x02,
-	0x48, 0xcf, 0xb9, 0x0f, 0x4c, 0x03, 0x39, 0x10, 0x61, 0x30, 0xc1, 0xc3, 0x23, 0x1e, 0xc6, 0xec,
-	0xff, 0x00, 0xbe, 0xfa, 0x18, 0xd1, 0xae, 0x95, 0x3d, 0x74, 0x4d, 0xd2, 0x7c, 0xcc, 0x23, 0xf4,
-	0xb6, 0x09, 0x8c, 0x05, 0xed, 0x92, 0x99, 0x75, 0xf0, 0xf9, 0x2f, 0xcf, 0xfa, 0xc6, 0xd3, 0x67,
-	0x7d, 0xe3, 0x8f, 0x67, 0x7d, 0xe3, 0xdb, 0xe7, 0xfd, 0x0b, 0x4f, 0x9f, 0xf7, 0x2f, 0xfc, 0xfe,
-	0xbc, 0x7f, 0xe1, 0x8b, 0x3b, 0x93, 0x50, 0x1e, 0xcd, 0xc7, 0xae, 0x9f, 0x44, 0x03, 0x5a, 0x31,
-	0xdf, 0x8c, 0x51, 0x3e, 0x49, 0xc4, 0x63, 0x2d, 0x4d, 0x31, 0x98, 0xa0, 0x18, 0x9c, 0x2c, 0x6d,
-	0xa6, 0xb4, 0x6e, 0xaa, 0x47, 0x31, 0x55, 0x4b, 0xe7, 0x06, 0xfd, 0x17, 0xde, 0xfa, 0x27, 0x00,
-	0x00, 0xff, 0xff, 0x04, 0xa3, 0xef, 0x4e, 0xc1, 0x0a, 0x00, 0x00,
+func (m *Retirement) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *CreditType) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Retirement) GetOwner() []byte {
+	if m != nil {
+		return m.Owner
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CreditType) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Retirement) GetBatchKey() uint64 {
+	if m != nil {
+		return m.BatchKey
+	}
+	return 0
 }
 
-func (m *CreditType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Precision != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.Precision))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Unit) > 0 {
-		i -= len(m.Unit)
-		copy(dAtA[i:], m.Unit)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Unit)))
-		i--
-		dAtA[i] = 0x1a
+func (m *Retirement) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *Retirement) GetJurisdiction() string {
+	if m != nil {
+		return m.Jurisdiction
 	}
-	if len(m.Abbreviation) > 0 {
-		i -= len(m.Abbreviation)
-		copy(dAtA[i:], m.Abbreviation)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Abbreviation)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *Retirement) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Class) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Retirement) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Class) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Retirement) GetTimestamp() *types.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
 }
 
-func (m *Class) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CreditTypeAbbrev) > 0 {
-		i -= len(m.CreditTypeAbbrev)
-		copy(dAtA[i:], m.CreditTypeAbbrev)
-		i = encodeVarintState(dAtA, i, uint64(len(m.CreditTypeAbbrev)))
-		i--
-		dAtA[i] = 0x2a
+// ClassIssuerQuota defines the maximum amount of credits an issuer of a credit
+// class can issue, either in total or within each period of time. The amount is
+// measured in the credit type of the credit class.
+//
+// Since Revision 1
+type ClassIssuerQuota struct {
+	// class_key is the table row identifier of the credit class used internally
+	// for efficient lookups. This links a class issuer quota to a credit class.
+	ClassKey uint64 `protobuf:"varint,1,opt,name=class_key,json=classKey,proto3" json:"class_key,omitempty"`
+	// issuer is the address of the issuer of the credit class.
+	Issuer []byte `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// amount is the maximum decimal number of credits (tradable and retired)
+	// the issuer can issue in total or, if the period is set, within each
+	// period.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// period is the optional length of the period after which the issued amount
+	// is reset. If the period is not set, the amount is a total quota.
+	Period *types.Duration `protobuf:"bytes,4,opt,name=period,proto3" json:"period,omitempty"`
+	// period_start is the start of the current period or, if the period is not
+	// set, the time at which the quota was set.
+	PeriodStart *types.Timestamp `protobuf:"bytes,5,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	// issued_amount is the decimal number of credits issued by the issuer within
+	// the current period or, if the period is not set, since the quota was set.
+	IssuedAmount string `protobuf:"bytes,6,opt,name=issued_amount,json=issuedAmount,proto3" json:"issued_amount,omitempty"`
+}
+
+func (m *ClassIssuerQuota) Reset()         { *m = ClassIssuerQuota{} }
+func (m *ClassIssuerQuota) String() string { return proto.CompactTextString(m) }
+func (*ClassIssuerQuota) ProtoMessage()    {}
+func (*ClassIssuerQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cfdca0a4aaabb36, []int{17}
+}
+func (m *ClassIssuerQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClassIssuerQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClassIssuerQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *ClassIssuerQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClassIssuerQuota.Merge(m, src)
+}
+func (m *ClassIssuerQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClassIssuerQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClassIssuerQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClassIssuerQuota proto.InternalMessageInfo
+
+func (m *ClassIssuerQuota) GetClassKey() uint64 {
+	if m != nil {
+		return m.ClassKey
 	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *ClassIssuerQuota) GetIssuer() []byte {
+	if m != nil {
+		return m.Issuer
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *ClassIssuerQuota) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	if m.Key != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.Key))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *ClassIssuerQuota) GetPeriod() *types.Duration {
+	if m != nil {
+		return m.Period
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ClassIssuer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ClassIssuerQuota) GetPeriodStart() *types.Timestamp {
+	if m != nil {
+		return m.PeriodStart
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ClassIssuer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ClassIssuerQuota) GetIssuedAmount() string {
+	if m != nil {
+		return m.IssuedAmount
+	}
+	return ""
 }
 
-func (m *ClassIssuer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Issuer) > 0 {
-		i -= len(m.Issuer)
-		copy(dAtA[i:], m.Issuer)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Issuer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ClassKey != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
-		i--
-		dAtA[i] = 0x8
+// PendingBatch stores a credit batch proposed by an issuer of a credit class
+// that is created once the issuance threshold of the credit class is met.
+//
+// Since Revision 1
+type PendingBatch struct {
+	// id is the unique identifier of the pending batch. This identifier is
+	// auto-incrementing.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// class_key is the table row identifier of the credit class used internally
+	// for efficient lookups. This links a pending batch to a credit class.
+	ClassKey uint64 `protobuf:"varint,2,opt,name=class_key,json=classKey,proto3" json:"class_key,omitempty"`
+	// project_key is the table row identifier of the project under which the
+	// credit batch will be created.
+	ProjectKey uint64 `protobuf:"varint,3,opt,name=project_key,json=projectKey,proto3" json:"project_key,omitempty"`
+	// proposer is the address of the issuer that proposed the credit batch.
+	Proposer []byte `protobuf:"bytes,4,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// issuance is the amount of tradable and retired credits that will be issued
+	// to each recipient.
+	Issuance []*PendingBatch_Issuance `protobuf:"bytes,5,rep,name=issuance,proto3" json:"issuance,omitempty"`
+	// metadata is any arbitrary metadata to attach to the credit batch.
+	Metadata string `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// start_date is the beginning of the period during which the credit batch
+	// was quantified and verified.
+	StartDate *types.Timestamp `protobuf:"bytes,7,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// end_date is the end of the period during which the credit batch was
+	// quantified and verified.
+	EndDate *types.Timestamp `protobuf:"bytes,8,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// origin_tx is the optional transaction from another chain or registry that
+	// triggered the creation of the credit batch.
+	OriginTx *PendingBatch_OriginTx `protobuf:"bytes,9,opt,name=origin_tx,json=originTx,proto3" json:"origin_tx,omitempty"`
+	// proposed_at is the time at which the credit batch was proposed.
+	ProposedAt *types.Timestamp `protobuf:"bytes,10,opt,name=proposed_at,json=proposedAt,proto3" json:"proposed_at,omitempty"`
+	// open determines whether or not the credits can be dynamically minted to the
+	// credit batch following the creation of the credit batch.
+	Open bool `protobuf:"varint,11,opt,name=open,proto3" json:"open,omitempty"`
+}
+
+func (m *PendingBatch) Reset()         { *m = PendingBatch{} }
+func (m *PendingBatch) String() string { return proto.CompactTextString(m) }
+func (*PendingBatch) ProtoMessage()    {}
+func (*PendingBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cfdca0a4aaabb36, []int{18}
+}
+func (m *PendingBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PendingBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingBatch.Merge(m, src)
+}
+func (m *PendingBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingBatch.DiscardUnknown(m)
 }
 
-func (m *Project) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PendingBatch proto.InternalMessageInfo
+
+func (m *PendingBatch) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Project) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PendingBatch) GetClassKey() uint64 {
+	if m != nil {
+		return m.ClassKey
+	}
+	return 0
 }
 
-func (m *Project) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ReferenceId) > 0 {
-		i -= len(m.ReferenceId)
-		copy(dAtA[i:], m.ReferenceId)
-		i = encodeVarintState(dAtA, i, uint64(len(m.ReferenceId)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Jurisdiction) > 0 {
-		i -= len(m.Jurisdiction)
-		copy(dAtA[i:], m.Jurisdiction)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Jurisdiction)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.ClassKey != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Key != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.Key))
-		i--
-		dAtA[i] = 0x8
+func (m *PendingBatch) GetProjectKey() uint64 {
+	if m != nil {
+		return m.ProjectKey
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Batch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PendingBatch) GetProposer() []byte {
+	if m != nil {
+		return m.Proposer
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Batch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PendingBatch) GetIssuance() []*PendingBatch_Issuance {
+	if m != nil {
+		return m.Issuance
+	}
+	return nil
 }
 
-func (m *Batch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Open {
-		i--
-		if m.Open {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.IssuanceDate != nil {
-		{
-			size, err := m.IssuanceDate.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintState(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.EndDate != nil {
-		{
-			size, err := m.EndDate.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintState(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.StartDate != nil {
-		{
-			size, err := m.StartDate.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintState(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.ProjectKey != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.ProjectKey))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Issuer) > 0 {
-		i -= len(m.Issuer)
-		copy(dAtA[i:], m.Issuer)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Issuer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Key != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.Key))
-		i--
-		dAtA[i] = 0x8
+func (m *PendingBatch) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ClassSequence) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PendingBatch) GetStartDate() *types.Timestamp {
+	if m != nil {
+		return m.StartDate
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ClassSequence) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PendingBatch) GetEndDate() *types.Timestamp {
+	if m != nil {
+		return m.EndDate
+	}
+	return nil
 }
 
-func (m *ClassSequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NextSequence != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.NextSequence))
-		i--
-		dAtA[i] = 0x10
+func (m *PendingBatch) GetOriginTx() *PendingBatch_OriginTx {
+	if m != nil {
+		return m.OriginTx
 	}
-	if len(m.CreditTypeAbbrev) > 0 {
-		i -= len(m.CreditTypeAbbrev)
-		copy(dAtA[i:], m.CreditTypeAbbrev)
-		i = encodeVarintState(dAtA, i, uint64(len(m.CreditTypeAbbrev)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *PendingBatch) GetProposedAt() *types.Timestamp {
+	if m != nil {
+		return m.ProposedAt
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ProjectSequence) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PendingBatch) GetOpen() bool {
+	if m != nil {
+		return m.Open
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *ProjectSequence) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Issuance is the issuance of credits to a recipient.
+type PendingBatch_Issuance struct {
+	// recipient is the address of the account receiving the credits.
+	Recipient []byte `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// tradable_amount is the decimal number of tradable credits.
+	TradableAmount string `protobuf:"bytes,2,opt,name=tradable_amount,json=tradableAmount,proto3" json:"tradable_amount,omitempty"`
+	// retired_amount is the decimal number of retired credits.
+	RetiredAmount string `protobuf:"bytes,3,opt,name=retired_amount,json=retiredAmount,proto3" json:"retired_amount,omitempty"`
+	// retirement_jurisdiction is the jurisdiction of the recipient and is only
+	// required if retired_amount is positive.
+	RetirementJurisdiction string `protobuf:"bytes,4,opt,name=retirement_jurisdiction,json=retirementJurisdiction,proto3" json:"retirement_jurisdiction,omitempty"`
 }
 
-func (m *ProjectSequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NextSequence != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.NextSequence))
-		i--
-		dAtA[i] = 0x10
+func (m *PendingBatch_Issuance) Reset()         { *m = PendingBatch_Issuance{} }
+func (m *PendingBatch_Issuance) String() string { return proto.CompactTextString(m) }
+func (*PendingBatch_Issuance) ProtoMessage()    {}
+func (*PendingBatch_Issuance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cfdca0a4aaabb36, []int{18, 0}
+}
+func (m *PendingBatch_Issuance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingBatch_Issuance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingBatch_Issuance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.ClassKey != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *PendingBatch_Issuance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingBatch_Issuance.Merge(m, src)
+}
+func (m *PendingBatch_Issuance) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingBatch_Issuance) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingBatch_Issuance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingBatch_Issuance proto.InternalMessageInfo
+
+func (m *PendingBatch_Issuance) GetRecipient() []byte {
+	if m != nil {
+		return m.Recipient
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *BatchSequence) Marshal() (dAtA []byte, err error) {
+func (m *PendingBatch_Issuance) GetTradableAmount() string {
+	if m != nil {
+		return m.TradableAmount
+	}
+	return ""
+}
+
+func (m *PendingBatch_Issuance) GetRetiredAmount() string {
+	if m != nil {
+		return m.RetiredAmount
+	}
+	return ""
+}
+
+func (m *PendingBatch_Issuance) GetRetirementJurisdiction() string {
+	if m != nil {
+		return m.RetirementJurisdiction
+	}
+	return ""
+}
+
+// OriginTx is the transaction from another chain or registry that triggered
+// the creation of the credit batch.
+type PendingBatch_OriginTx struct {
+	// id is the transaction ID of the originating transaction or operation.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// source is the source chain or registry of the transaction.
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// contract is the address of the contract on the source chain.
+	Contract string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	// note is a reference note for accounting.
+	Note string `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (m *PendingBatch_OriginTx) Reset()         { *m = PendingBatch_OriginTx{} }
+func (m *PendingBatch_OriginTx) String() string { return proto.CompactTextString(m) }
+func (*PendingBatch_OriginTx) ProtoMessage()    {}
+func (*PendingBatch_OriginTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cfdca0a4aaabb36, []int{18, 1}
+}
+func (m *PendingBatch_OriginTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingBatch_OriginTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingBatch_OriginTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingBatch_OriginTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingBatch_OriginTx.Merge(m, src)
+}
+func (m *PendingBatch_OriginTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingBatch_OriginTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingBatch_OriginTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingBatch_OriginTx proto.InternalMessageInfo
+
+func (m *PendingBatch_OriginTx) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PendingBatch_OriginTx) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *PendingBatch_OriginTx) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *PendingBatch_OriginTx) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+// PendingBatchApproval stores the approval of a pending batch by an issuer of
+// the credit class. The approval of the proposer is stored when the credit
+// batch is proposed.
+//
+// Since Revision 1
+type PendingBatchApproval struct {
+	// pending_batch_id is the unique identifier of the pending batch.
+	PendingBatchId uint64 `protobuf:"varint,1,opt,name=pending_batch_id,json=pendingBatchId,proto3" json:"pending_batch_id,omitempty"`
+	// approver is the address of the issuer that approved the pending batch.
+	Approver []byte `protobuf:"bytes,2,opt,name=approver,proto3" json:"approver,omitempty"`
+}
+
+func (m *PendingBatchApproval) Reset()         { *m = PendingBatchApproval{} }
+func (m *PendingBatchApproval) String() string { return proto.CompactTextString(m) }
+func (*PendingBatchApproval) ProtoMessage()    {}
+func (*PendingBatchApproval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cfdca0a4aaabb36, []int{19}
+}
+func (m *PendingBatchApproval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingBatchApproval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingBatchApproval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingBatchApproval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingBatchApproval.Merge(m, src)
+}
+func (m *PendingBatchApproval) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingBatchApproval) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingBatchApproval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingBatchApproval proto.InternalMessageInfo
+
+func (m *PendingBatchApproval) GetPendingBatchId() uint64 {
+	if m != nil {
+		return m.PendingBatchId
+	}
+	return 0
+}
+
+func (m *PendingBatchApproval) GetApprover() []byte {
+	if m != nil {
+		return m.Approver
+	}
+	return nil
+}
+
+// CreditAllowance stores the amount of credits from a credit batch that a
+// spender is allowed to send or retire on behalf of the owner of the credits.
+//
+// Since Revision 1
+type CreditAllowance struct {
+	// owner is the address of the account that owns the credits.
+	Owner []byte `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// spender is the address of the account allowed to send or retire credits
+	// on behalf of the owner.
+	Spender []byte `protobuf:"bytes,2,opt,name=spender,proto3" json:"spender,omitempty"`
+	// batch_key is the table row identifier of the credit batch used internally
+	// for efficient lookups. This links a credit allowance to a credit batch.
+	BatchKey uint64 `protobuf:"varint,3,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// amount is the remaining decimal number of credits (tradable and retired)
+	// the spender is allowed to send on behalf of the owner.
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// expiration is the optional time at which the credit allowance expires.
+	Expiration *types.Timestamp `protobuf:"bytes,5,opt,name=expiration,proto3" json:"expiration,omitempty"`
+}
+
+func (m *CreditAllowance) Reset()         { *m = CreditAllowance{} }
+func (m *CreditAllowance) String() string { return proto.CompactTextString(m) }
+func (*CreditAllowance) ProtoMessage()    {}
+func (*CreditAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cfdca0a4aaabb36, []int{20}
+}
+func (m *CreditAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreditAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreditAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreditAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreditAllowance.Merge(m, src)
+}
+func (m *CreditAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreditAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreditAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreditAllowance proto.InternalMessageInfo
+
+func (m *CreditAllowance) GetOwner() []byte {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *CreditAllowance) GetSpender() []byte {
+	if m != nil {
+		return m.Spender
+	}
+	return nil
+}
+
+func (m *CreditAllowance) GetBatchKey() uint64 {
+	if m != nil {
+		return m.BatchKey
+	}
+	return 0
+}
+
+func (m *CreditAllowance) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *CreditAllowance) GetExpiration() *types.Timestamp {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("regen.ecocredit.v1.ProjectStatus", ProjectStatus_name, ProjectStatus_value)
+	proto.RegisterType((*CreditType)(nil), "regen.ecocredit.v1.CreditType")
+	proto.RegisterType((*Class)(nil), "regen.ecocredit.v1.Class")
+	proto.RegisterType((*ClassIssuer)(nil), "regen.ecocredit.v1.ClassIssuer")
+	proto.RegisterType((*Project)(nil), "regen.ecocredit.v1.Project")
+	proto.RegisterType((*Batch)(nil), "regen.ecocredit.v1.Batch")
+	proto.RegisterType((*ClassSequence)(nil), "regen.ecocredit.v1.ClassSequence")
+	proto.RegisterType((*ProjectSequence)(nil), "regen.ecocredit.v1.ProjectSequence")
+	proto.RegisterType((*BatchSequence)(nil), "regen.ecocredit.v1.BatchSequence")
+	proto.RegisterType((*BatchBalance)(nil), "regen.ecocredit.v1.BatchBalance")
+	proto.RegisterType((*BatchSupply)(nil), "regen.ecocredit.v1.BatchSupply")
+	proto.RegisterType((*OriginTxIndex)(nil), "regen.ecocredit.v1.OriginTxIndex")
+	proto.RegisterType((*BatchContract)(nil), "regen.ecocredit.v1.BatchContract")
+	proto.RegisterType((*ClassCreatorAllowlist)(nil), "regen.ecocredit.v1.ClassCreatorAllowlist")
+	proto.RegisterType((*AllowedClassCreator)(nil), "regen.ecocredit.v1.AllowedClassCreator")
+	proto.RegisterType((*ClassFee)(nil), "regen.ecocredit.v1.ClassFee")
+	proto.RegisterType((*AllowedBridgeChain)(nil), "regen.ecocredit.v1.AllowedBridgeChain")
+	proto.RegisterType((*Retirement)(nil), "regen.ecocredit.v1.Retirement")
+	proto.RegisterType((*ClassIssuerQuota)(nil), "regen.ecocredit.v1.ClassIssuerQuota")
+	proto.RegisterType((*PendingBatch)(nil), "regen.ecocredit.v1.PendingBatch")
+	proto.RegisterType((*PendingBatch_Issuance)(nil), "regen.ecocredit.v1.PendingBatch.Issuance")
+	proto.RegisterType((*PendingBatch_OriginTx)(nil), "regen.ecocredit.v1.PendingBatch.OriginTx")
+	proto.RegisterType((*PendingBatchApproval)(nil), "regen.ecocredit.v1.PendingBatchApproval")
+	proto.RegisterType((*CreditAllowance)(nil), "regen.ecocredit.v1.CreditAllowance")
+}
+
+func init() { proto.RegisterFile("regen/ecocredit/v1/state.proto", fileDescriptor_6cfdca0a4aaabb36) }
+
+var fileDescriptor_6cfdca0a4aaabb36 = []byte{
+	// 1942 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x0f, 0xf5, 0x65, 0xea, 0x49, 0xb2, 0xe9, 0xb1, 0x2d, 0xd3, 0x4a, 0x56, 0xf1, 0xb2, 0xbb,
+	0x58, 0x27, 0xeb, 0x95, 0xea, 0xb4, 0x45, 0xb3, 0x5a, 0xb4, 0x0b, 0xc7, 0x56, 0x0a, 0x6f, 0xda,
+	0xd8, 0xa5, 0x9c, 0x05, 0xda, 0x0b, 0x4b, 0x91, 0x63, 0x9b, 0x59, 0x89, 0xe4, 0x0e, 0x47, 0x8e,
+	0x7d, 0x6d, 0x81, 0x1e, 0x8b, 0x16, 0x28, 0x7a, 0x2a, 0xda, 0x3f, 0xa0, 0xa7, 0xde, 0x7b, 0xec,
+	0xa1, 0xc7, 0x05, 0x7a, 0xd9, 0x63, 0x91, 0xf4, 0x2f, 0x28, 0x7a, 0xea, 0xa9, 0xe0, 0x9b, 0x21,
+	0x45, 0x2a, 0x8a, 0x6d, 0x04, 0x7b, 0x9b, 0xf7, 0x35, 0xf3, 0x7b, 0x1f, 0xf3, 0xe6, 0x91, 0xd0,
+	0x66, 0xf4, 0x94, 0xfa, 0x5d, 0xea, 0x04, 0x0e, 0xa3, 0xae, 0xc7, 0xbb, 0xe7, 0x3b, 0xdd, 0x88,
+	0xdb, 0x9c, 0x76, 0x42, 0x16, 0xf0, 0x80, 0x10, 0x94, 0x77, 0x52, 0x79, 0xe7, 0x7c, 0xa7, 0xd5,
+	0x76, 0x82, 0x68, 0x1c, 0x44, 0xdd, 0xa1, 0x1d, 0xd1, 0xee, 0xf9, 0xce, 0x90, 0x72, 0x7b, 0xa7,
+	0xeb, 0x04, 0x9e, 0x2f, 0x6c, 0x5a, 0xeb, 0x52, 0x1e, 0xb0, 0x71, 0xbc, 0x5d, 0xc0, 0xc6, 0x52,
+	0xd0, 0x3e, 0x0d, 0x82, 0xd3, 0x11, 0xed, 0x22, 0x35, 0x9c, 0x9c, 0x74, 0xdd, 0x09, 0xb3, 0xb9,
+	0x17, 0x24, 0x86, 0x77, 0x67, 0xe5, 0xdc, 0x1b, 0xd3, 0x88, 0xdb, 0xe3, 0x50, 0x28, 0x18, 0x7f,
+	0x54, 0x00, 0xf6, 0x10, 0xc7, 0xf1, 0x65, 0x48, 0x89, 0x01, 0x75, 0x7b, 0x38, 0x64, 0xf4, 0xdc,
+	0xc3, 0x5d, 0x74, 0x65, 0x53, 0xd9, 0xaa, 0x9a, 0x39, 0x1e, 0x21, 0x50, 0xf2, 0xed, 0x31, 0xd5,
+	0x0b, 0x28, 0xc3, 0x75, 0xcc, 0x9b, 0xf8, 0x1e, 0xd7, 0x8b, 0x82, 0x17, 0xaf, 0xc9, 0x1d, 0xa8,
+	0x86, 0x8c, 0x3a, 0x5e, 0x14, 0x6f, 0x54, 0xda, 0x54, 0xb6, 0x1a, 0xe6, 0x94, 0xd1, 0x7b, 0xef,
+	0x3f, 0x7f, 0xfa, 0xe7, 0x6f, 0x8a, 0x6d, 0x58, 0xcc, 0x9f, 0x48, 0x40, 0xec, 0xae, 0x29, 0xba,
+	0xa2, 0x2b, 0xc6, 0xaf, 0x8b, 0x50, 0xde, 0x1b, 0xd9, 0x51, 0x44, 0x34, 0x28, 0x7e, 0x41, 0x2f,
+	0x11, 0x50, 0xc9, 0x8c, 0x97, 0x64, 0x11, 0x0a, 0x9e, 0x2b, 0x51, 0x14, 0x3c, 0x97, 0xac, 0x42,
+	0xd9, 0x76, 0xc7, 0x9e, 0x8f, 0x20, 0xea, 0xa6, 0x20, 0x48, 0x0b, 0xd4, 0x31, 0xe5, 0xb6, 0x6b,
+	0x73, 0x1b, 0x41, 0x54, 0xcd, 0x94, 0x26, 0xdb, 0x40, 0x44, 0x0e, 0x2c, 0x7e, 0x19, 0x52, 0x4b,
+	0xe0, 0xd0, 0xcb, 0xa8, 0xa5, 0x39, 0x69, 0x54, 0x76, 0x91, 0x4f, 0x3e, 0x84, 0xe5, 0xe1, 0xe4,
+	0xe4, 0x84, 0x32, 0x2b, 0xa4, 0xcc, 0xa1, 0x3e, 0xb7, 0x4f, 0xa9, 0x5e, 0x11, 0xca, 0x42, 0x70,
+	0x94, 0xf2, 0xc9, 0xfb, 0xb0, 0x28, 0x95, 0x6d, 0xc7, 0x09, 0x26, 0x3e, 0xd7, 0x17, 0x10, 0x55,
+	0x43, 0x70, 0x77, 0x05, 0x93, 0x7c, 0x04, 0xc4, 0x8b, 0xa2, 0x89, 0xed, 0x3b, 0xd4, 0xe2, 0x67,
+	0x8c, 0x46, 0x67, 0xc1, 0xc8, 0xd5, 0x55, 0x0c, 0xd6, 0x72, 0x22, 0x39, 0x4e, 0x04, 0xe4, 0x21,
+	0xe8, 0x8c, 0x7e, 0x39, 0xf1, 0x18, 0xb5, 0xce, 0x29, 0xf3, 0x4e, 0x3c, 0xea, 0x5a, 0x21, 0x0b,
+	0x9e, 0x53, 0x87, 0xeb, 0xd5, 0x4d, 0x65, 0x4b, 0x35, 0x9b, 0x52, 0xfe, 0xb9, 0x14, 0x1f, 0x09,
+	0x69, 0xef, 0x87, 0x18, 0xee, 0x87, 0xb0, 0x80, 0x61, 0xd4, 0x14, 0xa2, 0xc6, 0xd1, 0x8b, 0xa3,
+	0x4c, 0xaa, 0x32, 0x6e, 0x5a, 0x81, 0x34, 0xe7, 0x05, 0x44, 0x2b, 0xea, 0x05, 0xe3, 0x17, 0x50,
+	0xc3, 0x3c, 0x1c, 0x44, 0xd1, 0x84, 0x32, 0x72, 0x1b, 0xaa, 0x4e, 0x4c, 0x5a, 0xd3, 0x9c, 0xa8,
+	0xc8, 0x78, 0x42, 0x2f, 0x49, 0x13, 0x2a, 0x1e, 0xaa, 0x61, 0x72, 0xea, 0xa6, 0xa4, 0x7a, 0x77,
+	0x10, 0x43, 0x13, 0x08, 0x68, 0xa9, 0xf1, 0xb6, 0xd4, 0x2c, 0x1a, 0xbf, 0x2b, 0xc2, 0x82, 0x44,
+	0xfb, 0xd6, 0xc9, 0xce, 0xc1, 0x2a, 0xcd, 0xc0, 0x32, 0xa0, 0xfe, 0x7c, 0xc2, 0xbc, 0xc8, 0xf5,
+	0x1c, 0xac, 0x6d, 0x91, 0xe7, 0x1c, 0x2f, 0x57, 0x2d, 0x95, 0x99, 0x6a, 0x79, 0x17, 0xea, 0x8c,
+	0x9e, 0x50, 0x46, 0xe3, 0x64, 0x79, 0x2e, 0x26, 0xb4, 0x6a, 0xd6, 0x52, 0xde, 0x81, 0x4b, 0x3e,
+	0x86, 0x4a, 0x7c, 0xd5, 0x27, 0x11, 0xa6, 0x70, 0xf1, 0xc1, 0xbb, 0x9d, 0xd7, 0x2f, 0x7b, 0x47,
+	0x3a, 0x39, 0x40, 0x45, 0x53, 0x1a, 0x90, 0x77, 0x00, 0xc4, 0xca, 0xf2, 0x98, 0x87, 0xc9, 0xac,
+	0x9a, 0x55, 0xc1, 0x39, 0x60, 0x5e, 0x8f, 0x63, 0xec, 0xfc, 0x79, 0xf9, 0x23, 0x50, 0xcf, 0x84,
+	0xd3, 0xd5, 0x0a, 0xd9, 0x9c, 0x16, 0x89, 0x96, 0x87, 0xad, 0x95, 0x48, 0x0b, 0x9a, 0x53, 0x83,
+	0x9c, 0xac, 0x4c, 0x20, 0xf1, 0x40, 0xab, 0xe8, 0x25, 0xe3, 0xeb, 0x22, 0x94, 0x1f, 0xd9, 0xdc,
+	0x39, 0x9b, 0x93, 0x91, 0x37, 0x64, 0x99, 0xdc, 0x85, 0x9a, 0x2c, 0x49, 0xcc, 0x42, 0x11, 0x2d,
+	0x40, 0xb2, 0xe2, 0x3c, 0xac, 0x42, 0xd9, 0xa5, 0x7e, 0x30, 0x96, 0xd7, 0x51, 0x10, 0xb9, 0xc8,
+	0x97, 0x67, 0x22, 0xff, 0x31, 0xc6, 0x86, 0x71, 0xcb, 0xb5, 0xb9, 0xb8, 0x72, 0xb5, 0x07, 0xad,
+	0x8e, 0x68, 0x6d, 0x9d, 0xa4, 0xb5, 0x75, 0x8e, 0x93, 0xd6, 0x86, 0x71, 0x63, 0x7c, 0xdf, 0xe6,
+	0x94, 0x7c, 0x0f, 0x54, 0xea, 0xbb, 0xc2, 0x70, 0xe1, 0x5a, 0xc3, 0x05, 0xea, 0xbb, 0x68, 0xf6,
+	0x29, 0x34, 0xd2, 0x7b, 0x89, 0xb6, 0xea, 0xb5, 0xb6, 0xf5, 0xc4, 0x00, 0x37, 0x20, 0x50, 0x0a,
+	0x42, 0xea, 0xcb, 0x5b, 0x89, 0xeb, 0xb8, 0x21, 0x46, 0x93, 0x28, 0xa4, 0xbe, 0x4b, 0x5d, 0x1d,
+	0x50, 0x30, 0x65, 0x90, 0x4d, 0xa8, 0x79, 0xfe, 0xb9, 0x3d, 0xf2, 0xe2, 0xf3, 0x5c, 0xbd, 0x86,
+	0xf2, 0x2c, 0xab, 0xf7, 0x04, 0x6b, 0xa0, 0x3f, 0xad, 0x81, 0x9a, 0x8c, 0x24, 0x96, 0xc1, 0x52,
+	0x2e, 0xee, 0x5a, 0x81, 0x2c, 0x66, 0xa3, 0xa6, 0x15, 0xe3, 0xd4, 0x8a, 0x14, 0x69, 0x25, 0xbd,
+	0x6c, 0xfc, 0x52, 0x81, 0x06, 0xde, 0xe8, 0x01, 0xfd, 0x72, 0x12, 0xe7, 0xff, 0x0d, 0xdd, 0x50,
+	0x79, 0x43, 0x37, 0xfc, 0x16, 0x34, 0x7c, 0x7a, 0xc1, 0xad, 0x48, 0x9a, 0x63, 0x15, 0x94, 0xcc,
+	0x7a, 0xcc, 0x4c, 0xb6, 0xec, 0xb5, 0x11, 0xb1, 0x0e, 0xab, 0x73, 0xb7, 0xae, 0x18, 0xcf, 0x61,
+	0x29, 0xb9, 0x0d, 0x09, 0x8a, 0x2b, 0x3b, 0xcb, 0x8d, 0x0e, 0x5d, 0xc3, 0x43, 0x97, 0xa0, 0x96,
+	0xdd, 0x69, 0xc1, 0xf0, 0xa1, 0x81, 0xa5, 0x9c, 0x9e, 0x34, 0x53, 0xa8, 0xca, 0x6b, 0x85, 0x7a,
+	0xa3, 0xd3, 0xd6, 0xf1, 0xb4, 0x65, 0x68, 0xe4, 0x77, 0x53, 0x8d, 0xff, 0x2a, 0x50, 0xc7, 0x03,
+	0x1f, 0xd9, 0x23, 0x5b, 0x7a, 0x36, 0x8c, 0xe9, 0xac, 0x67, 0xc8, 0x88, 0xcf, 0xd2, 0x61, 0xc1,
+	0x76, 0x5d, 0x46, 0xa3, 0x48, 0x5e, 0xa7, 0x84, 0x24, 0x1f, 0xc0, 0x12, 0x67, 0xb6, 0x6b, 0x0f,
+	0x47, 0xd4, 0xb2, 0xc7, 0xf8, 0x94, 0x88, 0x57, 0x76, 0x31, 0x61, 0xef, 0x22, 0x37, 0x7e, 0x72,
+	0x18, 0xe5, 0x1e, 0xa3, 0x6e, 0xa2, 0x27, 0x2e, 0x58, 0x43, 0x72, 0xa5, 0xda, 0x07, 0xb0, 0x44,
+	0x23, 0x87, 0x05, 0x2f, 0xa6, 0x7a, 0xe2, 0xbe, 0x2d, 0x26, 0x6c, 0xa1, 0xd8, 0xfb, 0x2e, 0x7a,
+	0xd6, 0x81, 0x15, 0x58, 0x96, 0x58, 0xb6, 0x53, 0xfc, 0x64, 0x0d, 0x96, 0x53, 0x62, 0x5b, 0x8a,
+	0x35, 0x45, 0xaf, 0x1a, 0x7f, 0x53, 0xa0, 0x26, 0xe2, 0x3c, 0x09, 0xc3, 0xd1, 0xe5, 0xd5, 0x5e,
+	0xcf, 0xf1, 0xad, 0x70, 0x43, 0xdf, 0x8a, 0xf3, 0x7c, 0xbb, 0x07, 0x9a, 0x13, 0xc7, 0x7a, 0x34,
+	0x9a, 0x0d, 0xc2, 0x52, 0xca, 0x97, 0xde, 0x65, 0xaa, 0x64, 0x8a, 0x0f, 0x8c, 0x09, 0x34, 0x0e,
+	0x99, 0x77, 0xea, 0xf9, 0xc7, 0x17, 0x07, 0xbe, 0x4b, 0x2f, 0xae, 0xae, 0xc7, 0xd9, 0x57, 0xa9,
+	0x09, 0x95, 0x28, 0x98, 0x30, 0x87, 0x4a, 0x78, 0x92, 0xea, 0xdd, 0xc5, 0xc3, 0x36, 0x60, 0x0d,
+	0x56, 0xb2, 0xad, 0x7a, 0x5b, 0x2a, 0xd7, 0x8c, 0x3f, 0x28, 0xb2, 0x3a, 0xf7, 0x02, 0x9f, 0x33,
+	0xdb, 0xe1, 0x57, 0xc7, 0x2d, 0x07, 0xaa, 0x30, 0x03, 0xaa, 0x05, 0xaa, 0x23, 0x77, 0x91, 0x30,
+	0x52, 0xba, 0xd7, 0x45, 0x20, 0xf7, 0x72, 0x5e, 0x13, 0x1d, 0xc8, 0x14, 0x55, 0xa2, 0x8a, 0x03,
+	0x58, 0xdd, 0xf8, 0x04, 0xd6, 0xb0, 0x4b, 0xec, 0x31, 0x6a, 0xf3, 0x80, 0xed, 0x8e, 0x46, 0xc1,
+	0x8b, 0x91, 0x17, 0xf1, 0xb8, 0x60, 0xa9, 0x1f, 0x67, 0xc8, 0x45, 0x74, 0xaa, 0x99, 0x90, 0x3d,
+	0xf5, 0x7f, 0xf1, 0x19, 0x05, 0xb5, 0x61, 0xec, 0xc3, 0x0a, 0x1a, 0x50, 0x37, 0xbb, 0x47, 0xb6,
+	0xd6, 0x95, 0x5c, 0xad, 0xf7, 0x56, 0x10, 0x5e, 0x03, 0xaa, 0x53, 0x8d, 0x45, 0x63, 0x17, 0x54,
+	0x34, 0x7f, 0x4c, 0x29, 0xf9, 0x10, 0x8a, 0x27, 0x94, 0xa2, 0x59, 0xed, 0xc1, 0x46, 0x47, 0x8c,
+	0xc5, 0x9d, 0x78, 0x6c, 0xee, 0xc8, 0xb1, 0xb9, 0xb3, 0x17, 0x78, 0xbe, 0x19, 0x6b, 0xa5, 0x40,
+	0x96, 0x8c, 0x27, 0x40, 0x24, 0x90, 0x47, 0xcc, 0x73, 0x4f, 0xe9, 0xde, 0x99, 0xed, 0xf9, 0xf1,
+	0x93, 0xeb, 0xc4, 0x0b, 0x0b, 0xc7, 0x59, 0xd1, 0xe8, 0xaa, 0xc8, 0x79, 0x6a, 0x8f, 0x69, 0xaf,
+	0x89, 0x60, 0x34, 0xa8, 0xe7, 0xd4, 0x34, 0xe3, 0x2f, 0x05, 0x00, 0x13, 0xcb, 0x6e, 0x4c, 0x7d,
+	0x2e, 0x6b, 0x40, 0x64, 0x48, 0x4e, 0x26, 0xc1, 0x0b, 0x3f, 0x7d, 0x16, 0x05, 0x91, 0x4f, 0x67,
+	0x71, 0x26, 0x9d, 0x4d, 0xa8, 0xe4, 0x8a, 0x55, 0x52, 0x37, 0x9a, 0x58, 0x9a, 0x50, 0x61, 0xd4,
+	0x8e, 0x02, 0x5f, 0xce, 0x2b, 0x92, 0x8a, 0x9f, 0x93, 0x21, 0xf5, 0xe9, 0x89, 0xe7, 0x78, 0x36,
+	0xbb, 0x4c, 0x86, 0x95, 0x0c, 0x8b, 0x3c, 0x84, 0x6a, 0xfa, 0x35, 0x70, 0x83, 0xf7, 0x6d, 0xaa,
+	0xdc, 0xbb, 0x8f, 0x91, 0x79, 0x0f, 0x2a, 0x62, 0x06, 0x89, 0xa7, 0x0d, 0xf4, 0x52, 0x53, 0x48,
+	0x23, 0xe3, 0xa7, 0x56, 0xd0, 0x97, 0x8d, 0xdf, 0x17, 0x40, 0xcb, 0x4c, 0x8e, 0x3f, 0x9d, 0x04,
+	0xdc, 0x7e, 0xab, 0xf1, 0x31, 0x13, 0xa5, 0x62, 0x2e, 0x4a, 0x3b, 0x50, 0x09, 0x29, 0xf3, 0x02,
+	0x57, 0x2f, 0xc9, 0xb2, 0x98, 0x75, 0x62, 0x5f, 0x7e, 0x14, 0x99, 0x52, 0x91, 0xfc, 0x00, 0xea,
+	0x62, 0x65, 0xe1, 0x0b, 0xa9, 0x97, 0xaf, 0xf5, 0xbe, 0x26, 0xf4, 0x07, 0xb1, 0x7a, 0xfc, 0x30,
+	0x20, 0xa6, 0xb4, 0xc7, 0x88, 0xd0, 0xd7, 0x05, 0x53, 0x36, 0x98, 0xab, 0xa6, 0x5d, 0x62, 0xfc,
+	0xb9, 0x02, 0xf5, 0x23, 0xea, 0xbb, 0x9e, 0x7f, 0x2a, 0x06, 0xac, 0xd9, 0x32, 0xba, 0xf2, 0x8a,
+	0x5f, 0x3b, 0x63, 0xb5, 0x40, 0x0d, 0x59, 0x10, 0x06, 0x11, 0x65, 0x18, 0x95, 0xba, 0x99, 0xd2,
+	0xa4, 0x0f, 0x6a, 0x32, 0xaa, 0xe8, 0xe5, 0xcd, 0xe2, 0x56, 0xed, 0xc1, 0xbd, 0xb9, 0x63, 0x6a,
+	0x06, 0x5d, 0xe7, 0x40, 0x1a, 0x98, 0xa9, 0xe9, 0x95, 0xa3, 0x72, 0x7e, 0x60, 0x5b, 0x78, 0xdb,
+	0x81, 0x4d, 0xbd, 0xf9, 0xc0, 0xf6, 0x18, 0xaa, 0x01, 0xf6, 0x6d, 0x8b, 0x5f, 0xe0, 0xd0, 0x75,
+	0x13, 0xaf, 0x92, 0x4e, 0x6f, 0xaa, 0x81, 0x5c, 0x91, 0x4f, 0xa0, 0x26, 0x03, 0xe5, 0x5a, 0x36,
+	0xd7, 0xe1, 0x5a, 0x04, 0x90, 0xa8, 0xef, 0xf2, 0x74, 0xe8, 0xab, 0x4d, 0x87, 0xbe, 0xd6, 0x5f,
+	0x15, 0x50, 0x93, 0xe8, 0xc5, 0x13, 0x60, 0xfc, 0x01, 0x1c, 0x7a, 0xd4, 0xe7, 0xb2, 0xf7, 0x4d,
+	0x19, 0xdf, 0xf8, 0x6b, 0xf8, 0x7d, 0x58, 0x67, 0x69, 0x9f, 0xb2, 0x72, 0x9d, 0x44, 0xf4, 0x99,
+	0xe6, 0x54, 0xfc, 0x59, 0x46, 0xda, 0x1a, 0x82, 0x9a, 0x84, 0x26, 0x53, 0x97, 0xb3, 0x4f, 0x5c,
+	0x21, 0xfb, 0xc4, 0x5d, 0xf5, 0xea, 0xe0, 0x1f, 0x83, 0x80, 0x53, 0x79, 0x2a, 0xae, 0xd3, 0x27,
+	0x31, 0xe9, 0x21, 0x8d, 0x4c, 0xbd, 0x6b, 0x8a, 0xbe, 0x62, 0xfc, 0x4a, 0x81, 0xd5, 0x6c, 0xb6,
+	0x76, 0xc3, 0x90, 0x05, 0xe7, 0xf6, 0x88, 0x6c, 0x81, 0x16, 0x0a, 0xbe, 0x25, 0x5a, 0x4d, 0x7a,
+	0x6f, 0x16, 0xc3, 0x8c, 0xfe, 0x81, 0x1b, 0x63, 0xb2, 0xd1, 0x2a, 0xed, 0x25, 0x29, 0xdd, 0x7b,
+	0x1f, 0xcf, 0xbf, 0x0b, 0xb7, 0x61, 0x63, 0x76, 0xb7, 0xed, 0xd4, 0x68, 0xd5, 0xf8, 0xb7, 0x02,
+	0x4b, 0xe2, 0xff, 0x08, 0x3e, 0x20, 0x98, 0xc5, 0xb4, 0xc3, 0x2b, 0xd9, 0x0e, 0xaf, 0xc3, 0x82,
+	0x18, 0xe5, 0x93, 0xb3, 0x12, 0xf2, 0xed, 0x7a, 0x7f, 0x0f, 0x80, 0x5e, 0x84, 0x9e, 0x68, 0x5c,
+	0x37, 0x68, 0x50, 0x19, 0xed, 0xde, 0xb7, 0xd1, 0xb7, 0xfb, 0xb0, 0x01, 0xeb, 0x88, 0x6d, 0x5b,
+	0x22, 0xc9, 0xcc, 0x6f, 0xb5, 0x14, 0xad, 0xa6, 0xe8, 0x6b, 0xf7, 0xff, 0xae, 0x40, 0x23, 0xf7,
+	0x5d, 0x4a, 0xda, 0xd0, 0x3a, 0x32, 0x0f, 0x3f, 0xeb, 0xef, 0x1d, 0x5b, 0x83, 0xe3, 0xdd, 0xe3,
+	0x67, 0x03, 0xeb, 0xd9, 0xd3, 0xc1, 0x51, 0x7f, 0xef, 0xe0, 0xf1, 0x41, 0x7f, 0x5f, 0xbb, 0x45,
+	0x6e, 0xc3, 0xfa, 0x8c, 0xfc, 0xc8, 0x3c, 0x3c, 0x3a, 0x1c, 0xf4, 0xf7, 0x35, 0x85, 0xbc, 0x03,
+	0x1b, 0x33, 0x42, 0xb3, 0xff, 0xa3, 0x83, 0xc1, 0x71, 0xdf, 0xec, 0xef, 0x6b, 0x85, 0x39, 0xb6,
+	0x9f, 0xf7, 0x4d, 0xb1, 0x71, 0x91, 0xdc, 0x01, 0x7d, 0x46, 0x38, 0x78, 0x36, 0x38, 0xea, 0x3f,
+	0xdd, 0xef, 0xef, 0x6b, 0xa5, 0x39, 0xd2, 0xbd, 0xc3, 0x9f, 0x1c, 0xfd, 0xb8, 0x7f, 0xdc, 0xdf,
+	0xd7, 0xca, 0x8f, 0x7e, 0xf6, 0x8f, 0x97, 0x6d, 0xe5, 0xab, 0x97, 0x6d, 0xe5, 0x5f, 0x2f, 0xdb,
+	0xca, 0x6f, 0x5f, 0xb5, 0x6f, 0x7d, 0xf5, 0xaa, 0x7d, 0xeb, 0xeb, 0x57, 0xed, 0x5b, 0x3f, 0xff,
+	0xf4, 0xd4, 0xe3, 0x67, 0x93, 0x61, 0xc7, 0x09, 0xc6, 0x5d, 0x6c, 0x0b, 0x1f, 0xf9, 0x94, 0xbf,
+	0x08, 0xd8, 0x17, 0x92, 0x1a, 0x51, 0xf7, 0x94, 0xb2, 0xee, 0x45, 0xe6, 0xbf, 0x1d, 0xfe, 0x8c,
+	0x8b, 0xbf, 0x57, 0xa2, 0xf8, 0x97, 0x5c, 0x05, 0x63, 0xfe, 0x9d, 0xff, 0x0f, 0x00, 0x88, 0xb4,
+	0x45, 0xd1, 0xdf, 0x13, 0x00, 0x00,
+}
+
+func (m *CreditType) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1647,30 +2144,46 @@ func (m *BatchSequence) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BatchSequence) MarshalTo(dAtA []byte) (int, error) {
+func (m *CreditType) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BatchSequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CreditType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.NextSequence != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.NextSequence))
+	if m.Precision != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Precision))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x20
 	}
-	if m.ProjectKey != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.ProjectKey))
+	if len(m.Unit) > 0 {
+		i -= len(m.Unit)
+		copy(dAtA[i:], m.Unit)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Unit)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Abbreviation) > 0 {
+		i -= len(m.Abbreviation)
+		copy(dAtA[i:], m.Abbreviation)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Abbreviation)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BatchBalance) Marshal() (dAtA []byte, err error) {
+func (m *Class) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1680,53 +2193,82 @@ func (m *BatchBalance) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BatchBalance) MarshalTo(dAtA []byte) (int, error) {
+func (m *Class) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BatchBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Class) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.EscrowedAmount) > 0 {
-		i -= len(m.EscrowedAmount)
-		copy(dAtA[i:], m.EscrowedAmount)
-		i = encodeVarintState(dAtA, i, uint64(len(m.EscrowedAmount)))
+	if m.RequireVerifiedProject {
 		i--
-		dAtA[i] = 0x2a
+		if m.RequireVerifiedProject {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
 	}
-	if len(m.RetiredAmount) > 0 {
-		i -= len(m.RetiredAmount)
-		copy(dAtA[i:], m.RetiredAmount)
-		i = encodeVarintState(dAtA, i, uint64(len(m.RetiredAmount)))
+	if m.IssuanceThreshold != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.IssuanceThreshold))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.BufferAccount) > 0 {
+		i -= len(m.BufferAccount)
+		copy(dAtA[i:], m.BufferAccount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.BufferAccount)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.BufferPercentage) > 0 {
+		i -= len(m.BufferPercentage)
+		copy(dAtA[i:], m.BufferPercentage)
+		i = encodeVarintState(dAtA, i, uint64(len(m.BufferPercentage)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.CreditTypeAbbrev) > 0 {
+		i -= len(m.CreditTypeAbbrev)
+		copy(dAtA[i:], m.CreditTypeAbbrev)
+		i = encodeVarintState(dAtA, i, uint64(len(m.CreditTypeAbbrev)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Metadata)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.TradableAmount) > 0 {
-		i -= len(m.TradableAmount)
-		copy(dAtA[i:], m.TradableAmount)
-		i = encodeVarintState(dAtA, i, uint64(len(m.TradableAmount)))
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Admin)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Address)))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Id)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.BatchKey != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+	if m.Key != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Key))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BatchSupply) Marshal() (dAtA []byte, err error) {
+func (m *ClassIssuer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1736,46 +2278,32 @@ func (m *BatchSupply) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BatchSupply) MarshalTo(dAtA []byte) (int, error) {
+func (m *ClassIssuer) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BatchSupply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ClassIssuer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.CancelledAmount) > 0 {
-		i -= len(m.CancelledAmount)
-		copy(dAtA[i:], m.CancelledAmount)
-		i = encodeVarintState(dAtA, i, uint64(len(m.CancelledAmount)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.RetiredAmount) > 0 {
-		i -= len(m.RetiredAmount)
-		copy(dAtA[i:], m.RetiredAmount)
-		i = encodeVarintState(dAtA, i, uint64(len(m.RetiredAmount)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.TradableAmount) > 0 {
-		i -= len(m.TradableAmount)
-		copy(dAtA[i:], m.TradableAmount)
-		i = encodeVarintState(dAtA, i, uint64(len(m.TradableAmount)))
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Issuer)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.BatchKey != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+	if m.ClassKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OriginTxIndex) Marshal() (dAtA []byte, err error) {
+func (m *Project) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1785,20 +2313,58 @@ func (m *OriginTxIndex) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OriginTxIndex) MarshalTo(dAtA []byte) (int, error) {
+func (m *Project) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OriginTxIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Project) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Source)))
+	if len(m.StatusIri) > 0 {
+		i -= len(m.StatusIri)
+		copy(dAtA[i:], m.StatusIri)
+		i = encodeVarintState(dAtA, i, uint64(len(m.StatusIri)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Status != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ReferenceId) > 0 {
+		i -= len(m.ReferenceId)
+		copy(dAtA[i:], m.ReferenceId)
+		i = encodeVarintState(dAtA, i, uint64(len(m.ReferenceId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Jurisdiction) > 0 {
+		i -= len(m.Jurisdiction)
+		copy(dAtA[i:], m.Jurisdiction)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Jurisdiction)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ClassKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Admin)))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -1809,15 +2375,15 @@ func (m *OriginTxIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.ClassKey != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
+	if m.Key != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Key))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BatchContract) Marshal() (dAtA []byte, err error) {
+func (m *Batch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1827,37 +2393,117 @@ func (m *BatchContract) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BatchContract) MarshalTo(dAtA []byte) (int, error) {
+func (m *Batch) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BatchContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Batch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Contract)))
+	if m.Invalidated {
 		i--
-		dAtA[i] = 0x1a
+		if m.Invalidated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
 	}
-	if m.ClassKey != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
+	if m.Suspended {
 		i--
-		dAtA[i] = 0x10
+		if m.Suspended {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
 	}
-	if m.BatchKey != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+	if m.Open {
+		i--
+		if m.Open {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.IssuanceDate != nil {
+		{
+			size, err := m.IssuanceDate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.EndDate != nil {
+		{
+			size, err := m.EndDate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.StartDate != nil {
+		{
+			size, err := m.StartDate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ProjectKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ProjectKey))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Key != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Key))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ClassCreatorAllowlist) Marshal() (dAtA []byte, err error) {
+func (m *ClassSequence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1867,30 +2513,32 @@ func (m *ClassCreatorAllowlist) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ClassCreatorAllowlist) MarshalTo(dAtA []byte) (int, error) {
+func (m *ClassSequence) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClassCreatorAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ClassSequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Enabled {
+	if m.NextSequence != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.NextSequence))
 		i--
-		if m.Enabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x10
+	}
+	if len(m.CreditTypeAbbrev) > 0 {
+		i -= len(m.CreditTypeAbbrev)
+		copy(dAtA[i:], m.CreditTypeAbbrev)
+		i = encodeVarintState(dAtA, i, uint64(len(m.CreditTypeAbbrev)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AllowedClassCreator) Marshal() (dAtA []byte, err error) {
+func (m *ProjectSequence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1900,27 +2548,30 @@ func (m *AllowedClassCreator) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AllowedClassCreator) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProjectSequence) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AllowedClassCreator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProjectSequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Address)))
+	if m.NextSequence != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.NextSequence))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x10
+	}
+	if m.ClassKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ClassFee) Marshal() (dAtA []byte, err error) {
+func (m *BatchSequence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1930,32 +2581,30 @@ func (m *ClassFee) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ClassFee) MarshalTo(dAtA []byte) (int, error) {
+func (m *BatchSequence) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClassFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BatchSequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Fee != nil {
-		{
-			size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintState(dAtA, i, uint64(size))
-		}
+	if m.NextSequence != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.NextSequence))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x10
+	}
+	if m.ProjectKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ProjectKey))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AllowedBridgeChain) Marshal() (dAtA []byte, err error) {
+func (m *BatchBalance) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1965,377 +2614,3248 @@ func (m *AllowedBridgeChain) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AllowedBridgeChain) MarshalTo(dAtA []byte) (int, error) {
+func (m *BatchBalance) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AllowedBridgeChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BatchBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ChainName) > 0 {
-		i -= len(m.ChainName)
-		copy(dAtA[i:], m.ChainName)
-		i = encodeVarintState(dAtA, i, uint64(len(m.ChainName)))
+	if len(m.EscrowedAmount) > 0 {
+		i -= len(m.EscrowedAmount)
+		copy(dAtA[i:], m.EscrowedAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.EscrowedAmount)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x2a
+	}
+	if len(m.RetiredAmount) > 0 {
+		i -= len(m.RetiredAmount)
+		copy(dAtA[i:], m.RetiredAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.RetiredAmount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TradableAmount) > 0 {
+		i -= len(m.TradableAmount)
+		copy(dAtA[i:], m.TradableAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.TradableAmount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BatchKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintState(dAtA []byte, offset int, v uint64) int {
-	offset -= sovState(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BatchSupply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *CreditType) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *BatchSupply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchSupply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Abbreviation)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if len(m.CancelledAmount) > 0 {
+		i -= len(m.CancelledAmount)
+		copy(dAtA[i:], m.CancelledAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.CancelledAmount)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if len(m.RetiredAmount) > 0 {
+		i -= len(m.RetiredAmount)
+		copy(dAtA[i:], m.RetiredAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.RetiredAmount)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Unit)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if len(m.TradableAmount) > 0 {
+		i -= len(m.TradableAmount)
+		copy(dAtA[i:], m.TradableAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.TradableAmount)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Precision != 0 {
-		n += 1 + sovState(uint64(m.Precision))
+	if m.BatchKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Class) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OriginTxIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OriginTxIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OriginTxIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Key != 0 {
-		n += 1 + sovState(uint64(m.Key))
-	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
-	}
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.CreditTypeAbbrev)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if m.ClassKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ClassIssuer) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BatchContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.ClassKey != 0 {
-		n += 1 + sovState(uint64(m.ClassKey))
+		i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if m.BatchKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Project) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ClassCreatorAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassCreatorAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassCreatorAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Key != 0 {
-		n += 1 + sovState(uint64(m.Key))
-	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
-	}
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
-	}
-	if m.ClassKey != 0 {
-		n += 1 + sovState(uint64(m.ClassKey))
-	}
-	l = len(m.Jurisdiction)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
-	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
-	}
-	l = len(m.ReferenceId)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Batch) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Key != 0 {
-		n += 1 + sovState(uint64(m.Key))
-	}
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
-	}
-	if m.ProjectKey != 0 {
-		n += 1 + sovState(uint64(m.ProjectKey))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
-	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
-	}
-	if m.StartDate != nil {
-		l = m.StartDate.Size()
-		n += 1 + l + sovState(uint64(l))
-	}
-	if m.EndDate != nil {
-		l = m.EndDate.Size()
-		n += 1 + l + sovState(uint64(l))
-	}
-	if m.IssuanceDate != nil {
-		l = m.IssuanceDate.Size()
-		n += 1 + l + sovState(uint64(l))
-	}
-	if m.Open {
-		n += 2
+func (m *AllowedClassCreator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ClassSequence) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AllowedClassCreator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedClassCreator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CreditTypeAbbrev)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
-	}
-	if m.NextSequence != 0 {
-		n += 1 + sovState(uint64(m.NextSequence))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ProjectSequence) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ClassFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ClassKey != 0 {
-		n += 1 + sovState(uint64(m.ClassKey))
-	}
-	if m.NextSequence != 0 {
-		n += 1 + sovState(uint64(m.NextSequence))
+	if m.Fee != nil {
+		{
+			size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BatchSequence) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AllowedBridgeChain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedBridgeChain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedBridgeChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ProjectKey != 0 {
-		n += 1 + sovState(uint64(m.ProjectKey))
-	}
-	if m.NextSequence != 0 {
-		n += 1 + sovState(uint64(m.NextSequence))
+	if len(m.ChainName) > 0 {
+		i -= len(m.ChainName)
+		copy(dAtA[i:], m.ChainName)
+		i = encodeVarintState(dAtA, i, uint64(len(m.ChainName)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BatchBalance) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Retirement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Retirement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Retirement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BatchKey != 0 {
-		n += 1 + sovState(uint64(m.BatchKey))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if m.Timestamp != nil {
+		{
+			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	l = len(m.TradableAmount)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.RetiredAmount)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.EscrowedAmount)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if len(m.Jurisdiction) > 0 {
+		i -= len(m.Jurisdiction)
+		copy(dAtA[i:], m.Jurisdiction)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Jurisdiction)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
-}
-
-func (m *BatchSupply) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
 	if m.BatchKey != 0 {
-		n += 1 + sovState(uint64(m.BatchKey))
-	}
-	l = len(m.TradableAmount)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.RetiredAmount)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.CancelledAmount)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OriginTxIndex) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ClassIssuerQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassIssuerQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassIssuerQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ClassKey != 0 {
-		n += 1 + sovState(uint64(m.ClassKey))
+	if len(m.IssuedAmount) > 0 {
+		i -= len(m.IssuedAmount)
+		copy(dAtA[i:], m.IssuedAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.IssuedAmount)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if m.PeriodStart != nil {
+		{
+			size, err := m.PeriodStart.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if m.Period != nil {
+		{
+			size, err := m.Period.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ClassKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *BatchContract) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PendingBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BatchKey != 0 {
-		n += 1 + sovState(uint64(m.BatchKey))
+	if m.Open {
+		i--
+		if m.Open {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ProposedAt != nil {
+		{
+			size, err := m.ProposedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.OriginTx != nil {
+		{
+			size, err := m.OriginTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.EndDate != nil {
+		{
+			size, err := m.EndDate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.StartDate != nil {
+		{
+			size, err := m.StartDate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Issuance) > 0 {
+		for iNdEx := len(m.Issuance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Issuance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ProjectKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ProjectKey))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.ClassKey != 0 {
-		n += 1 + sovState(uint64(m.ClassKey))
+		i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ClassCreatorAllowlist) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Enabled {
-		n += 2
+func (m *PendingBatch_Issuance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AllowedClassCreator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PendingBatch_Issuance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingBatch_Issuance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if len(m.RetirementJurisdiction) > 0 {
+		i -= len(m.RetirementJurisdiction)
+		copy(dAtA[i:], m.RetirementJurisdiction)
+		i = encodeVarintState(dAtA, i, uint64(len(m.RetirementJurisdiction)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	if len(m.RetiredAmount) > 0 {
+		i -= len(m.RetiredAmount)
+		copy(dAtA[i:], m.RetiredAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.RetiredAmount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TradableAmount) > 0 {
+		i -= len(m.TradableAmount)
+		copy(dAtA[i:], m.TradableAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.TradableAmount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ClassFee) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PendingBatch_OriginTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingBatch_OriginTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingBatch_OriginTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Fee != nil {
-		l = m.Fee.Size()
-		n += 1 + l + sovState(uint64(l))
+	if len(m.Note) > 0 {
+		i -= len(m.Note)
+		copy(dAtA[i:], m.Note)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Note)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AllowedBridgeChain) Size() (n int) {
-	if m == nil {
+func (m *PendingBatchApproval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingBatchApproval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingBatchApproval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Approver) > 0 {
+		i -= len(m.Approver)
+		copy(dAtA[i:], m.Approver)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Approver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PendingBatchId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.PendingBatchId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreditAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreditAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreditAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		{
+			size, err := m.Expiration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BatchKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Spender) > 0 {
+		i -= len(m.Spender)
+		copy(dAtA[i:], m.Spender)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Spender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintState(dAtA []byte, offset int, v uint64) int {
+	offset -= sovState(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CreditType) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ChainName)
+	l = len(m.Abbreviation)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Unit)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Precision != 0 {
+		n += 1 + sovState(uint64(m.Precision))
+	}
+	return n
+}
+
+func (m *Class) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Key != 0 {
+		n += 1 + sovState(uint64(m.Key))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.CreditTypeAbbrev)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.BufferPercentage)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.BufferAccount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.IssuanceThreshold != 0 {
+		n += 1 + sovState(uint64(m.IssuanceThreshold))
+	}
+	if m.RequireVerifiedProject {
+		n += 2
+	}
+	return n
+}
+
+func (m *ClassIssuer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClassKey != 0 {
+		n += 1 + sovState(uint64(m.ClassKey))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *Project) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Key != 0 {
+		n += 1 + sovState(uint64(m.Key))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.ClassKey != 0 {
+		n += 1 + sovState(uint64(m.ClassKey))
+	}
+	l = len(m.Jurisdiction)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.ReferenceId)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovState(uint64(m.Status))
+	}
+	l = len(m.StatusIri)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *Batch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Key != 0 {
+		n += 1 + sovState(uint64(m.Key))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.ProjectKey != 0 {
+		n += 1 + sovState(uint64(m.ProjectKey))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.StartDate != nil {
+		l = m.StartDate.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.EndDate != nil {
+		l = m.EndDate.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.IssuanceDate != nil {
+		l = m.IssuanceDate.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Open {
+		n += 2
+	}
+	if m.Suspended {
+		n += 2
+	}
+	if m.Invalidated {
+		n += 2
+	}
+	return n
+}
+
+func (m *ClassSequence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CreditTypeAbbrev)
 	if l > 0 {
 		n += 1 + l + sovState(uint64(l))
 	}
-	return n
-}
+	if m.NextSequence != 0 {
+		n += 1 + sovState(uint64(m.NextSequence))
+	}
+	return n
+}
+
+func (m *ProjectSequence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClassKey != 0 {
+		n += 1 + sovState(uint64(m.ClassKey))
+	}
+	if m.NextSequence != 0 {
+		n += 1 + sovState(uint64(m.NextSequence))
+	}
+	return n
+}
+
+func (m *BatchSequence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProjectKey != 0 {
+		n += 1 + sovState(uint64(m.ProjectKey))
+	}
+	if m.NextSequence != 0 {
+		n += 1 + sovState(uint64(m.NextSequence))
+	}
+	return n
+}
+
+func (m *BatchBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchKey != 0 {
+		n += 1 + sovState(uint64(m.BatchKey))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.TradableAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.RetiredAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.EscrowedAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchSupply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchKey != 0 {
+		n += 1 + sovState(uint64(m.BatchKey))
+	}
+	l = len(m.TradableAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.RetiredAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.CancelledAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *OriginTxIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClassKey != 0 {
+		n += 1 + sovState(uint64(m.ClassKey))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchKey != 0 {
+		n += 1 + sovState(uint64(m.BatchKey))
+	}
+	if m.ClassKey != 0 {
+		n += 1 + sovState(uint64(m.ClassKey))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *ClassCreatorAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *AllowedClassCreator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *ClassFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Fee != nil {
+		l = m.Fee.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *AllowedBridgeChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainName)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *Retirement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.BatchKey != 0 {
+		n += 1 + sovState(uint64(m.BatchKey))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Jurisdiction)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *ClassIssuerQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClassKey != 0 {
+		n += 1 + sovState(uint64(m.ClassKey))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Period != nil {
+		l = m.Period.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.PeriodStart != nil {
+		l = m.PeriodStart.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.IssuedAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	if m.ClassKey != 0 {
+		n += 1 + sovState(uint64(m.ClassKey))
+	}
+	if m.ProjectKey != 0 {
+		n += 1 + sovState(uint64(m.ProjectKey))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if len(m.Issuance) > 0 {
+		for _, e := range m.Issuance {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.StartDate != nil {
+		l = m.StartDate.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.EndDate != nil {
+		l = m.EndDate.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.OriginTx != nil {
+		l = m.OriginTx.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.ProposedAt != nil {
+		l = m.ProposedAt.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Open {
+		n += 2
+	}
+	return n
+}
+
+func (m *PendingBatch_Issuance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.TradableAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.RetiredAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.RetirementJurisdiction)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingBatch_OriginTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Note)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingBatchApproval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PendingBatchId != 0 {
+		n += 1 + sovState(uint64(m.PendingBatchId))
+	}
+	l = len(m.Approver)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *CreditAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Spender)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.BatchKey != 0 {
+		n += 1 + sovState(uint64(m.BatchKey))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = m.Expiration.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func sovState(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozState(x uint64) (n int) {
+	return sovState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CreditType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreditType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreditType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abbreviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Abbreviation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
+			}
+			m.Precision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Precision |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Class) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Class: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Class: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			m.Key = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Key |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
+			if m.Admin == nil {
+				m.Admin = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BufferPercentage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferAccount", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BufferAccount = append(m.BufferAccount[:0], dAtA[iNdEx:postIndex]...)
+			if m.BufferAccount == nil {
+				m.BufferAccount = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceThreshold", wireType)
+			}
+			m.IssuanceThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuanceThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireVerifiedProject", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireVerifiedProject = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClassIssuer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassIssuer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassIssuer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
+			}
+			m.ClassKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClassKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = append(m.Issuer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Issuer == nil {
+				m.Issuer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Project) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Project: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Project: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			m.Key = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Key |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
+			if m.Admin == nil {
+				m.Admin = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
+			}
+			m.ClassKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClassKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProjectStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusIri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatusIri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Batch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Batch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Batch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			m.Key = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Key |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = append(m.Issuer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Issuer == nil {
+				m.Issuer = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
+			}
+			m.ProjectKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProjectKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartDate == nil {
+				m.StartDate = &types.Timestamp{}
+			}
+			if err := m.StartDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndDate == nil {
+				m.EndDate = &types.Timestamp{}
+			}
+			if err := m.EndDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IssuanceDate == nil {
+				m.IssuanceDate = &types.Timestamp{}
+			}
+			if err := m.IssuanceDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Open = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspended", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Suspended = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invalidated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Invalidated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClassSequence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassSequence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassSequence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequence", wireType)
+			}
+			m.NextSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectSequence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectSequence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectSequence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
+			}
+			m.ClassKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClassKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequence", wireType)
+			}
+			m.NextSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchSequence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchSequence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchSequence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
+			}
+			m.ProjectKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProjectKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequence", wireType)
+			}
+			m.NextSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+			}
+			m.BatchKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradableAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TradableAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetiredAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetiredAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowedAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchSupply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchSupply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchSupply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+			}
+			m.BatchKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradableAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TradableAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetiredAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetiredAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CancelledAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovState(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozState(x uint64) (n int) {
-	return sovState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *CreditType) Unmarshal(dAtA []byte) error {
+func (m *OriginTxIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2358,17 +5878,17 @@ func (m *CreditType) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreditType: wiretype end group for non-group")
+			return fmt.Errorf("proto: OriginTxIndex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreditType: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OriginTxIndex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Abbreviation", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
 			}
-			var stringLen uint64
+			m.ClassKey = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2378,27 +5898,14 @@ func (m *CreditType) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ClassKey |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Abbreviation = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2426,11 +5933,11 @@ func (m *CreditType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2458,27 +5965,8 @@ func (m *CreditType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Unit = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
-			}
-			m.Precision = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Precision |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -2500,7 +5988,7 @@ func (m *CreditType) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Class) Unmarshal(dAtA []byte) error {
+func (m *BatchContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2523,17 +6011,17 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Class: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Class: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
 			}
-			m.Key = 0
+			m.BatchKey = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2543,14 +6031,33 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Key |= uint64(b&0x7F) << shift
+				m.BatchKey |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
+			}
+			m.ClassKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClassKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2578,13 +6085,63 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var byteLen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClassCreatorAllowlist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassCreatorAllowlist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassCreatorAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2594,31 +6151,67 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthState
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthState
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
-			if m.Admin == nil {
-				m.Admin = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowedClassCreator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
 			}
-			iNdEx = postIndex
-		case 4:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedClassCreator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedClassCreator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2628,29 +6221,81 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClassFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2660,23 +6305,27 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
+			if m.Fee == nil {
+				m.Fee = &types1.Coin{}
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2699,7 +6348,7 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClassIssuer) Unmarshal(dAtA []byte) error {
+func (m *AllowedBridgeChain) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2722,36 +6371,17 @@ func (m *ClassIssuer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClassIssuer: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllowedBridgeChain: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClassIssuer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllowedBridgeChain: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
-			}
-			m.ClassKey = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ClassKey |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainName", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2761,25 +6391,23 @@ func (m *ClassIssuer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Issuer = append(m.Issuer[:0], dAtA[iNdEx:postIndex]...)
-			if m.Issuer == nil {
-				m.Issuer = []byte{}
-			}
+			m.ChainName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2802,7 +6430,7 @@ func (m *ClassIssuer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Project) Unmarshal(dAtA []byte) error {
+func (m *Retirement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2825,17 +6453,17 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Project: wiretype end group for non-group")
+			return fmt.Errorf("proto: Retirement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Project: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Retirement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Key = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2845,16 +6473,16 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Key |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2864,29 +6492,31 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
 			}
-			var byteLen int
+			m.BatchKey = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2896,31 +6526,16 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.BatchKey |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
-			if m.Admin == nil {
-				m.Admin = []byte{}
-			}
-			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.ClassKey = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2930,11 +6545,24 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClassKey |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Jurisdiction", wireType)
@@ -2969,7 +6597,7 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2997,11 +6625,11 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3029,7 +6657,43 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReferenceId = string(dAtA[iNdEx:postIndex])
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &types.Timestamp{}
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3052,7 +6716,7 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Batch) Unmarshal(dAtA []byte) error {
+func (m *ClassIssuerQuota) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3070,75 +6734,22 @@ func (m *Batch) Unmarshal(dAtA []byte) error {
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Batch: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Batch: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			m.Key = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Key |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Issuer = append(m.Issuer[:0], dAtA[iNdEx:postIndex]...)
-			if m.Issuer == nil {
-				m.Issuer = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassIssuerQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassIssuerQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
 			}
-			m.ProjectKey = 0
+			m.ClassKey = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3148,16 +6759,16 @@ func (m *Batch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProjectKey |= uint64(b&0x7F) << shift
+				m.ClassKey |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3167,27 +6778,29 @@ func (m *Batch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Issuer = append(m.Issuer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Issuer == nil {
+				m.Issuer = []byte{}
+			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3215,11 +6828,11 @@ func (m *Batch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3246,16 +6859,16 @@ func (m *Batch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartDate == nil {
-				m.StartDate = &types.Timestamp{}
+			if m.Period == nil {
+				m.Period = &types.Duration{}
 			}
-			if err := m.StartDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Period.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodStart", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3282,18 +6895,18 @@ func (m *Batch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EndDate == nil {
-				m.EndDate = &types.Timestamp{}
+			if m.PeriodStart == nil {
+				m.PeriodStart = &types.Timestamp{}
 			}
-			if err := m.EndDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PeriodStart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3303,48 +6916,24 @@ func (m *Batch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.IssuanceDate == nil {
-				m.IssuanceDate = &types.Timestamp{}
-			}
-			if err := m.IssuanceDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IssuedAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Open = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -3366,7 +6955,7 @@ func (m *Batch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClassSequence) Unmarshal(dAtA []byte) error {
+func (m *PendingBatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3389,17 +6978,17 @@ func (m *ClassSequence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClassSequence: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingBatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClassSequence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingBatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3409,29 +6998,16 @@ func (m *ClassSequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextSequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
 			}
-			m.NextSequence = 0
+			m.ClassKey = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3441,66 +7017,16 @@ func (m *ClassSequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextSequence |= uint64(b&0x7F) << shift
+				m.ClassKey |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipState(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthState
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ProjectSequence) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowState
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectSequence: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectSequence: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
 			}
-			m.ClassKey = 0
+			m.ProjectKey = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3510,16 +7036,16 @@ func (m *ProjectSequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClassKey |= uint64(b&0x7F) << shift
+				m.ProjectKey |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextSequence", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
-			m.NextSequence = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3529,85 +7055,31 @@ func (m *ProjectSequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextSequence |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipState(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthState
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BatchSequence) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowState
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BatchSequence: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchSequence: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
-			}
-			m.ProjectKey = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProjectKey |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextSequence", wireType)
-			}
-			m.NextSequence = 0
+			m.Proposer = append(m.Proposer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proposer == nil {
+				m.Proposer = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuance", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3617,66 +7089,31 @@ func (m *BatchSequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextSequence |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipState(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BatchBalance) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowState
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Issuance = append(m.Issuance, &PendingBatch_Issuance{})
+			if err := m.Issuance[len(m.Issuance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BatchBalance: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchBalance: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			m.BatchKey = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3686,16 +7123,29 @@ func (m *BatchBalance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchKey |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3705,31 +7155,33 @@ func (m *BatchBalance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
+			if m.StartDate == nil {
+				m.StartDate = &types.Timestamp{}
+			}
+			if err := m.StartDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TradableAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3739,29 +7191,33 @@ func (m *BatchBalance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TradableAmount = string(dAtA[iNdEx:postIndex])
+			if m.EndDate == nil {
+				m.EndDate = &types.Timestamp{}
+			}
+			if err := m.EndDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetiredAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginTx", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3771,29 +7227,33 @@ func (m *BatchBalance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RetiredAmount = string(dAtA[iNdEx:postIndex])
+			if m.OriginTx == nil {
+				m.OriginTx = &PendingBatch_OriginTx{}
+			}
+			if err := m.OriginTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowedAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3803,24 +7263,48 @@ func (m *BatchBalance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EscrowedAmount = string(dAtA[iNdEx:postIndex])
+			if m.ProposedAt == nil {
+				m.ProposedAt = &types.Timestamp{}
+			}
+			if err := m.ProposedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Open = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -3842,7 +7326,7 @@ func (m *BatchBalance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchSupply) Unmarshal(dAtA []byte) error {
+func (m *PendingBatch_Issuance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3865,17 +7349,17 @@ func (m *BatchSupply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchSupply: wiretype end group for non-group")
+			return fmt.Errorf("proto: Issuance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchSupply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Issuance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
-			m.BatchKey = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3885,11 +7369,26 @@ func (m *BatchSupply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchKey |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = append(m.Recipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.Recipient == nil {
+				m.Recipient = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TradableAmount", wireType)
@@ -3956,7 +7455,7 @@ func (m *BatchSupply) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelledAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementJurisdiction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3984,7 +7483,7 @@ func (m *BatchSupply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CancelledAmount = string(dAtA[iNdEx:postIndex])
+			m.RetirementJurisdiction = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4007,7 +7506,7 @@ func (m *BatchSupply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OriginTxIndex) Unmarshal(dAtA []byte) error {
+func (m *PendingBatch_OriginTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4030,17 +7529,17 @@ func (m *OriginTxIndex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OriginTxIndex: wiretype end group for non-group")
+			return fmt.Errorf("proto: OriginTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OriginTxIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OriginTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.ClassKey = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -4050,14 +7549,27 @@ func (m *OriginTxIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClassKey |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4085,11 +7597,43 @@ func (m *OriginTxIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Note", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4117,7 +7661,7 @@ func (m *OriginTxIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			m.Note = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4140,7 +7684,7 @@ func (m *OriginTxIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchContract) Unmarshal(dAtA []byte) error {
+func (m *PendingBatchApproval) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4163,17 +7707,17 @@ func (m *BatchContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingBatchApproval: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingBatchApproval: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingBatchId", wireType)
 			}
-			m.BatchKey = 0
+			m.PendingBatchId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -4183,35 +7727,16 @@ func (m *BatchContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchKey |= uint64(b&0x7F) << shift
+				m.PendingBatchId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
-			}
-			m.ClassKey = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ClassKey |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Approver", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -4221,23 +7746,25 @@ func (m *BatchContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			m.Approver = append(m.Approver[:0], dAtA[iNdEx:postIndex]...)
+			if m.Approver == nil {
+				m.Approver = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4260,7 +7787,7 @@ func (m *BatchContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClassCreatorAllowlist) Unmarshal(dAtA []byte) error {
+func (m *CreditAllowance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4283,17 +7810,17 @@ func (m *ClassCreatorAllowlist) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClassCreatorAllowlist: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreditAllowance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClassCreatorAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreditAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var v int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -4303,65 +7830,29 @@ func (m *ClassCreatorAllowlist) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipState(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthState
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AllowedClassCreator) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowState
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AllowedClassCreator: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllowedClassCreator: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4388,66 +7879,35 @@ func (m *AllowedClassCreator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
+			m.Spender = append(m.Spender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Spender == nil {
+				m.Spender = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipState(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthState
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClassFee) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowState
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.BatchKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClassFee: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClassFee: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -4457,83 +7917,29 @@ func (m *ClassFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Fee == nil {
-				m.Fee = &types1.Coin{}
-			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipState(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthState
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AllowedBridgeChain) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowState
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AllowedBridgeChain: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllowedBridgeChain: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -4543,23 +7949,27 @@ func (m *AllowedBridgeChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainName = string(dAtA[iNdEx:postIndex])
+			if m.Expiration == nil {
+				m.Expiration = &types.Timestamp{}
+			}
+			if err := m.Expiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
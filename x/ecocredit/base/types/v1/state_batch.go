@@ -50,5 +50,13 @@ func (m *Batch) Validate() error {
 		return ecocredit.ErrParseFailure.Wrapf("must provide an issuance date for the credit batch")
 	}
 
+	if m.Invalidated && !m.Suspended {
+		return ecocredit.ErrParseFailure.Wrap("an invalidated credit batch must be suspended")
+	}
+
+	if m.Invalidated && m.Open {
+		return ecocredit.ErrParseFailure.Wrap("an invalidated credit batch cannot be open")
+	}
+
 	return nil
 }
@@ -31,5 +31,16 @@ func (m *Class) Validate() error {
 		return errors.Wrap(err, "credit type abbrev") // returns parse error
 	}
 
+	if m.BufferPercentage != "" {
+		if err := validateBufferPercentage(m.BufferPercentage); err != nil {
+			return ecocredit.ErrParseFailure.Wrapf("buffer percentage: %s", err)
+		}
+		if !base.BufferAccount(m.Id).Equals(sdk.AccAddress(m.BufferAccount)) {
+			return ecocredit.ErrParseFailure.Wrapf("buffer account must be the buffer account of credit class %s", m.Id)
+		}
+	} else if len(m.BufferAccount) != 0 {
+		return ecocredit.ErrParseFailure.Wrap("buffer account cannot be set without buffer percentage")
+	}
+
 	return nil
 }
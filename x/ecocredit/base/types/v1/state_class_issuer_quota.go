@@ -0,0 +1,56 @@
+package v1
+
+import (
+	"fmt"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// Validate performs basic validation of the ClassIssuerQuota state type
+func (m *ClassIssuerQuota) Validate() error {
+	if m.ClassKey == 0 {
+		return ecocredit.ErrParseFailure.Wrapf("class key cannot be zero")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(sdk.AccAddress(m.Issuer).String()); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("issuer: %s", err)
+	}
+
+	if _, err := math.NewPositiveDecFromString(m.Amount); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("amount: %s", err)
+	}
+
+	if m.Period != nil {
+		if err := validateQuotaPeriod(m.Period); err != nil {
+			return ecocredit.ErrParseFailure.Wrapf("period: %s", err)
+		}
+	}
+
+	if m.PeriodStart == nil {
+		return ecocredit.ErrParseFailure.Wrap("period start cannot be empty")
+	}
+
+	if _, err := math.NewNonNegativeDecFromString(m.IssuedAmount); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("issued amount: %s", err)
+	}
+
+	return nil
+}
+
+// validateQuotaPeriod checks that the period of a class issuer quota is a
+// valid positive duration.
+func validateQuotaPeriod(period *gogotypes.Duration) error {
+	d, err := gogotypes.DurationFromProto(period)
+	if err != nil {
+		return err
+	}
+	if d <= 0 {
+		return fmt.Errorf("must be positive")
+	}
+	return nil
+}
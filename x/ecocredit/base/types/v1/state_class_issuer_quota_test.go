@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type classIssuerQuota struct {
+	t                gocuke.TestingT
+	classIssuerQuota *ClassIssuerQuota
+	err              error
+}
+
+func TestClassIssuerQuota(t *testing.T) {
+	gocuke.NewRunner(t, &classIssuerQuota{}).Path("./features/state_class_issuer_quota.feature").Run()
+}
+
+func (s *classIssuerQuota) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *classIssuerQuota) TheClassIssuerQuota(a gocuke.DocString) {
+	s.classIssuerQuota = &ClassIssuerQuota{}
+	err := jsonpb.UnmarshalString(a.Content, s.classIssuerQuota)
+	require.NoError(s.t, err)
+}
+
+func (s *classIssuerQuota) TheClassIssuerQuotaIsValidated() {
+	s.err = s.classIssuerQuota.Validate()
+}
+
+func (s *classIssuerQuota) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *classIssuerQuota) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type creditAllowance struct {
+	t               gocuke.TestingT
+	creditAllowance *CreditAllowance
+	err             error
+}
+
+func TestCreditAllowance(t *testing.T) {
+	gocuke.NewRunner(t, &creditAllowance{}).Path("./features/state_credit_allowance.feature").Run()
+}
+
+func (s *creditAllowance) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *creditAllowance) TheCreditAllowance(a gocuke.DocString) {
+	s.creditAllowance = &CreditAllowance{}
+	err := jsonpb.UnmarshalString(a.Content, s.creditAllowance)
+	require.NoError(s.t, err)
+}
+
+func (s *creditAllowance) TheCreditAllowanceIsValidated() {
+	s.err = s.creditAllowance.Validate()
+}
+
+func (s *creditAllowance) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *creditAllowance) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -0,0 +1,79 @@
+package v1
+
+import (
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+// Validate performs basic validation of the PendingBatch state type
+func (m *PendingBatch) Validate() error {
+	if m.Id == 0 {
+		return ecocredit.ErrParseFailure.Wrapf("id cannot be zero")
+	}
+
+	if m.ClassKey == 0 {
+		return ecocredit.ErrParseFailure.Wrapf("class key cannot be zero")
+	}
+
+	if m.ProjectKey == 0 {
+		return ecocredit.ErrParseFailure.Wrapf("project key cannot be zero")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(sdk.AccAddress(m.Proposer).String()); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("proposer: %s", err)
+	}
+
+	if len(m.Issuance) == 0 {
+		return ecocredit.ErrParseFailure.Wrap("issuance cannot be empty")
+	}
+
+	for i, issuance := range m.Issuance {
+		batchIssuance := &BatchIssuance{
+			Recipient:              sdk.AccAddress(issuance.Recipient).String(),
+			TradableAmount:         issuance.TradableAmount,
+			RetiredAmount:          issuance.RetiredAmount,
+			RetirementJurisdiction: issuance.RetirementJurisdiction,
+		}
+		if err := batchIssuance.Validate(); err != nil {
+			return ecocredit.ErrParseFailure.Wrapf("issuance[%d]: %s", i, err)
+		}
+	}
+
+	if len(m.Metadata) > base.MaxMetadataLength {
+		return ecocredit.ErrParseFailure.Wrapf("metadata: max length %d", base.MaxMetadataLength)
+	}
+
+	if m.StartDate == nil {
+		return ecocredit.ErrParseFailure.Wrap("start date cannot be empty")
+	}
+
+	if m.EndDate == nil {
+		return ecocredit.ErrParseFailure.Wrap("end date cannot be empty")
+	}
+
+	if m.StartDate.Compare(m.EndDate) == 1 {
+		return ecocredit.ErrParseFailure.Wrap("start date cannot be after end date")
+	}
+
+	if m.OriginTx != nil {
+		originTx := &OriginTx{
+			Id:       m.OriginTx.Id,
+			Source:   m.OriginTx.Source,
+			Contract: m.OriginTx.Contract,
+			Note:     m.OriginTx.Note,
+		}
+		if err := originTx.Validate(); err != nil {
+			return errors.Wrap(ecocredit.ErrParseFailure, err.Error())
+		}
+	}
+
+	if m.ProposedAt == nil {
+		return ecocredit.ErrParseFailure.Wrap("proposed at cannot be empty")
+	}
+
+	return nil
+}
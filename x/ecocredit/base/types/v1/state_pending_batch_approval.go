@@ -0,0 +1,20 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// Validate performs basic validation of the PendingBatchApproval state type
+func (m *PendingBatchApproval) Validate() error {
+	if m.PendingBatchId == 0 {
+		return ecocredit.ErrParseFailure.Wrapf("pending batch id cannot be zero")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(sdk.AccAddress(m.Approver).String()); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("approver: %s", err)
+	}
+
+	return nil
+}
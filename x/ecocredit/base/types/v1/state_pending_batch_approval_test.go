@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type pendingBatchApproval struct {
+	t                    gocuke.TestingT
+	pendingBatchApproval *PendingBatchApproval
+	err                  error
+}
+
+func TestPendingBatchApproval(t *testing.T) {
+	gocuke.NewRunner(t, &pendingBatchApproval{}).Path("./features/state_pending_batch_approval.feature").Run()
+}
+
+func (s *pendingBatchApproval) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *pendingBatchApproval) ThePendingBatchApproval(a gocuke.DocString) {
+	s.pendingBatchApproval = &PendingBatchApproval{}
+	err := jsonpb.UnmarshalString(a.Content, s.pendingBatchApproval)
+	require.NoError(s.t, err)
+}
+
+func (s *pendingBatchApproval) ThePendingBatchApprovalIsValidated() {
+	s.err = s.pendingBatchApproval.Validate()
+}
+
+func (s *pendingBatchApproval) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *pendingBatchApproval) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
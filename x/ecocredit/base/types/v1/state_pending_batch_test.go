@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type pendingBatch struct {
+	t            gocuke.TestingT
+	pendingBatch *PendingBatch
+	err          error
+}
+
+func TestPendingBatch(t *testing.T) {
+	gocuke.NewRunner(t, &pendingBatch{}).Path("./features/state_pending_batch.feature").Run()
+}
+
+func (s *pendingBatch) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *pendingBatch) ThePendingBatch(a gocuke.DocString) {
+	s.pendingBatch = &PendingBatch{}
+	err := jsonpb.UnmarshalString(a.Content, s.pendingBatch)
+	require.NoError(s.t, err)
+}
+
+func (s *pendingBatch) ThePendingBatchIsValidated() {
+	s.err = s.pendingBatch.Validate()
+}
+
+func (s *pendingBatch) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *pendingBatch) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
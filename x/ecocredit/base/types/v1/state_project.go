@@ -35,5 +35,15 @@ func (m *Project) Validate() error {
 		return ecocredit.ErrParseFailure.Wrapf("metadata exceeds 256 characters")
 	}
 
+	if _, ok := ProjectStatus_name[int32(m.Status)]; !ok {
+		return ecocredit.ErrParseFailure.Wrapf("invalid status %d", m.Status)
+	}
+
+	if m.StatusIri != "" {
+		if err := base.ValidateDataIRI(m.StatusIri); err != nil {
+			return errors.Wrap(err, "status iri") // returns parse error
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+// Validate performs basic validation of the Retirement state type
+func (m *Retirement) Validate() error {
+	if m.Id == 0 {
+		return ecocredit.ErrParseFailure.Wrapf("id cannot be zero")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(sdk.AccAddress(m.Owner).String()); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("owner: %s", err)
+	}
+
+	if m.BatchKey == 0 {
+		return ecocredit.ErrParseFailure.Wrapf("batch key cannot be zero")
+	}
+
+	if _, err := math.NewPositiveDecFromString(m.Amount); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("amount: %s", err)
+	}
+
+	if m.Jurisdiction != "" {
+		if err := base.ValidateJurisdiction(m.Jurisdiction); err != nil {
+			return errors.Wrap(err, "jurisdiction") // returns parse error
+		}
+	}
+
+	if len(m.Reason) > base.MaxNoteLength {
+		return ecocredit.ErrParseFailure.Wrapf("reason: max length %d", base.MaxNoteLength)
+	}
+
+	if len(m.Beneficiary) > base.MaxNoteLength {
+		return ecocredit.ErrParseFailure.Wrapf("beneficiary: max length %d", base.MaxNoteLength)
+	}
+
+	if m.Timestamp == nil {
+		return ecocredit.ErrParseFailure.Wrap("timestamp cannot be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type retirement struct {
+	t          gocuke.TestingT
+	retirement *Retirement
+	err        error
+}
+
+func TestRetirement(t *testing.T) {
+	gocuke.NewRunner(t, &retirement{}).Path("./features/state_retirement.feature").Run()
+}
+
+func (s *retirement) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *retirement) TheRetirement(a gocuke.DocString) {
+	s.retirement = &Retirement{}
+	err := jsonpb.UnmarshalString(a.Content, s.retirement)
+	require.NoError(s.t, err)
+}
+
+func (s *retirement) TheRetirementIsValidated() {
+	s.err = s.retirement.Validate()
+}
+
+func (s *retirement) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *retirement) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
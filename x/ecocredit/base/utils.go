@@ -8,6 +8,9 @@ import (
 	"time"
 	"unicode"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 )
 
@@ -26,14 +29,23 @@ var (
 	RegexProjectID        = fmt.Sprintf(`%s-[0-9]{3,}`, RegexClassID)
 	RegexBatchDenom       = fmt.Sprintf(`%s-[0-9]{8}-[0-9]{8}-[0-9]{3,}`, RegexProjectID)
 	RegexJurisdiction     = `([A-Z]{2})(?:-([A-Z0-9]{1,3})(?: ([a-zA-Z0-9 \-]{1,64}))?)?`
+	RegexDataIRI          = `regen:[1-9A-HJ-NP-Za-km-z]+\.[a-z]+`
 
 	regexCreditTypeAbbrev = regexp.MustCompile(fmt.Sprintf(`^%s$`, RegexCreditTypeAbbrev))
 	regexClassID          = regexp.MustCompile(fmt.Sprintf(`^%s$`, RegexClassID))
 	regexProjectID        = regexp.MustCompile(fmt.Sprintf(`^%s$`, RegexProjectID))
 	regexBatchDenom       = regexp.MustCompile(fmt.Sprintf(`^%s$`, RegexBatchDenom))
 	regexJurisdiction     = regexp.MustCompile(fmt.Sprintf(`^%s$`, RegexJurisdiction))
+	regexDataIRI          = regexp.MustCompile(fmt.Sprintf(`^%s$`, RegexDataIRI))
 )
 
+// BufferAccount returns the address of the buffer account of a credit class.
+// The address is derived from the module name and the credit class id such
+// that no user controls the buffer account.
+func BufferAccount(classID string) sdk.AccAddress {
+	return address.Module(ecocredit.ModuleName, []byte("buffer/"+classID))
+}
+
 // FormatClassID formats the unique identifier for a new credit class, based
 // on the credit type abbreviation and the credit class sequence number. This
 // format may evolve over time, but will maintain backwards compatibility.
@@ -170,6 +182,23 @@ func ValidateJurisdiction(jurisdiction string) error {
 	return nil
 }
 
+// ValidateDataIRI checks that the IRI has the format of an IRI of data anchored
+// in the data module. This is a simple regex check and doesn't check that the
+// data has been anchored. The return is nil if the IRI is valid.
+func ValidateDataIRI(iri string) error {
+	if iri == "" {
+		return ecocredit.ErrParseFailure.Wrap("empty string is not allowed")
+	}
+	if len(iri) > MaxMetadataLength {
+		return ecocredit.ErrParseFailure.Wrapf("max length %d", MaxMetadataLength)
+	}
+	matches := regexDataIRI.FindStringSubmatch(iri)
+	if matches == nil {
+		return ecocredit.ErrParseFailure.Wrap("expected format regen:<hash>.<extension>")
+	}
+	return nil
+}
+
 // GetClassIDFromProjectID returns the credit class ID in a project ID.
 func GetClassIDFromProjectID(projectID string) string {
 	var s strings.Builder
@@ -27,6 +27,8 @@ const (
 	FlagDenomDescription       = "description"
 	FlagRetirementJurisdiction = "retirement-jurisdiction"
 	FlagRetireOnTake           = "retire-on-take"
+	FlagRetirementReason       = "retirement-reason"
+	FlagRetirementBeneficiary  = "retirement-beneficiary"
 )
 
 func TxCreateBasketCmd() *cobra.Command {
@@ -258,12 +260,24 @@ regen tx ecocredit take-from-basket eco.uC.NCT 1000 --retire-on-take=true --reti
 				return err
 			}
 
+			retirementReason, err := cmd.Flags().GetString(FlagRetirementReason)
+			if err != nil {
+				return err
+			}
+
+			retirementBeneficiary, err := cmd.Flags().GetString(FlagRetirementBeneficiary)
+			if err != nil {
+				return err
+			}
+
 			msg := types.MsgTake{
 				Owner:                  clientCtx.FromAddress.String(),
 				BasketDenom:            args[0],
 				Amount:                 args[1],
 				RetirementJurisdiction: retirementJurisdiction,
 				RetireOnTake:           retireOnTake,
+				RetirementReason:       retirementReason,
+				RetirementBeneficiary:  retirementBeneficiary,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -275,6 +289,8 @@ regen tx ecocredit take-from-basket eco.uC.NCT 1000 --retire-on-take=true --reti
 	}
 
 	cmd.Flags().String(FlagRetirementJurisdiction, "", "jurisdiction for the credits which will be used only if --retire-on-take flag is true")
+	cmd.Flags().String(FlagRetirementReason, "", "the reason for the retirement which will be used only if --retire-on-take flag is true")
+	cmd.Flags().String(FlagRetirementBeneficiary, "", "the beneficiary of the retirement which will be used only if --retire-on-take flag is true")
 	cmd.Flags().Bool(FlagRetireOnTake, false, "dictates whether the ecocredits received in exchange for the basket tokens will be received as retired or tradable credits")
 
 	return txFlags(cmd)
@@ -46,6 +46,22 @@ Feature: Msg/Put
       When alice attempts to put credits from credit batch "C01-001-20200101-20210101-001" into the basket
       Then expect the error "could not get batch C01-001-20200101-20210101-001: not found: invalid request"
 
+  Rule: The credit batch must not be suspended
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a basket
+      And alice owns credits from credit batch "C01-001-20200101-20210101-001"
+
+    Scenario: the credit batch is not suspended
+      When alice attempts to put credits from credit batch "C01-001-20200101-20210101-001" into the basket
+      Then expect no error
+
+    Scenario: the credit batch is suspended
+      Given the credit batch is suspended
+      When alice attempts to put credits from credit batch "C01-001-20200101-20210101-001" into the basket
+      Then expect the error "credit batch C01-001-20200101-20210101-001 is suspended: invalid request"
+
   Rule: The credit batch must be from a credit class that is allowed in the basket
 
     Background:
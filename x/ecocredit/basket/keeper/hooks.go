@@ -0,0 +1,11 @@
+package keeper
+
+import (
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// SetHooks sets the ecocredit hooks called when credits are moved.
+func (k *Keeper) SetHooks(hooks ecocredit.EcocreditHooks) *Keeper {
+	k.hooks.Set(hooks)
+	return k
+}
@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/basket/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/mocks"
+)
+
+const testBatchDenom = "C01-001-20200101-20210101-001"
+
+// setupHooks sets up a basket accepting credits of the test credit class, from
+// which credits can be taken without retiring them, with the first test address
+// owning 10 credits and sets hooks recording the calls
+// of the hooks.
+func setupHooks(t *testing.T) (*baseSuite, *mocks.Hooks) {
+	s := setupBase(t)
+
+	require.NoError(t, s.baseStore.CreditTypeTable().Insert(s.ctx, &baseapi.CreditType{
+		Abbreviation: "C",
+		Precision:    6,
+	}))
+	basketID, err := s.stateStore.BasketTable().InsertReturningID(s.ctx, &api.Basket{
+		BasketDenom:       testBasketDenom,
+		CreditTypeAbbrev:  "C",
+		DisableAutoRetire: true,
+	})
+	require.NoError(t, err)
+	require.NoError(t, s.stateStore.BasketClassTable().Insert(s.ctx, &api.BasketClass{
+		BasketId: basketID,
+		ClassId:  testClassID,
+	}))
+	classKey, err := s.baseStore.ClassTable().InsertReturningID(s.ctx, &baseapi.Class{
+		Id:               testClassID,
+		CreditTypeAbbrev: "C",
+	})
+	require.NoError(t, err)
+	projectKey, err := s.baseStore.ProjectTable().InsertReturningID(s.ctx, &baseapi.Project{
+		ClassKey: classKey,
+	})
+	require.NoError(t, err)
+	batchKey, err := s.baseStore.BatchTable().InsertReturningID(s.ctx, &baseapi.Batch{
+		ProjectKey: projectKey,
+		Denom:      testBatchDenom,
+		StartDate:  &timestamppb.Timestamp{Seconds: 1577836800},
+	})
+	require.NoError(t, err)
+	require.NoError(t, s.baseStore.BatchSupplyTable().Insert(s.ctx, &baseapi.BatchSupply{
+		BatchKey:       batchKey,
+		TradableAmount: "10",
+	}))
+	require.NoError(t, s.baseStore.BatchBalanceTable().Insert(s.ctx, &baseapi.BatchBalance{
+		BatchKey:       batchKey,
+		Address:        s.addrs[0],
+		TradableAmount: "10",
+	}))
+
+	// the basket tokens are minted on put and burned on take
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), gomock.Any(), testBasketDenom).
+		Return(sdk.NewInt64Coin(testBasketDenom, 10_000_000)).AnyTimes()
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	hooks := &mocks.Hooks{}
+	s.k.SetHooks(hooks)
+
+	return s, hooks
+}
+
+func (s *baseSuite) put(amount string) error {
+	_, err := s.k.Put(s.ctx, &types.MsgPut{
+		Owner:       s.addrs[0].String(),
+		BasketDenom: testBasketDenom,
+		Credits:     []*types.BasketCredit{{BatchDenom: testBatchDenom, Amount: amount}},
+	})
+	return err
+}
+
+func TestHooks(t *testing.T) {
+	t.Parallel()
+	s, hooks := setupHooks(t)
+	owner := s.addrs[0]
+
+	require.NoError(t, s.put("4"))
+	require.Equal(t, []string{
+		fmt.Sprintf("transferred %s %s %s 4 0", testBatchDenom, owner, s.k.moduleAddress),
+	}, hooks.Calls)
+
+	hooks.Calls = nil
+	_, err := s.k.Take(s.ctx, &types.MsgTake{
+		Owner:                  owner.String(),
+		BasketDenom:            testBasketDenom,
+		Amount:                 "1000000",
+		RetirementJurisdiction: "US-WA",
+		RetirementReason:       "offsetting electricity consumption",
+		RetirementBeneficiary:  "Regen Network",
+		RetireOnTake:           true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		fmt.Sprintf("transferred %s %s %s 0 1.000000", testBatchDenom, s.k.moduleAddress, owner),
+		fmt.Sprintf(`retired %s %s 1.000000 1 US-WA "offsetting electricity consumption" "Regen Network"`, testBatchDenom, owner),
+	}, hooks.Calls)
+
+	hooks.Calls = nil
+	_, err = s.k.Take(s.ctx, &types.MsgTake{
+		Owner:       owner.String(),
+		BasketDenom: testBasketDenom,
+		Amount:      "2000000",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		fmt.Sprintf("transferred %s %s %s 2.000000 0", testBatchDenom, s.k.moduleAddress, owner),
+	}, hooks.Calls)
+}
+
+func TestHooks_Error(t *testing.T) {
+	t.Parallel()
+	s, hooks := setupHooks(t)
+	hooks.Err = fmt.Errorf("hook failed")
+
+	// an error returned by a hook fails the message
+	require.EqualError(t, s.put("4"), "hook failed")
+}
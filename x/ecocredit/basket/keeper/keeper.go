@@ -22,6 +22,9 @@ type Keeper struct {
 	paramsKeeper  ecocredit.ParamKeeper
 	moduleAddress sdk.AccAddress
 	authority     sdk.AccAddress
+
+	// hooks are called when credits are moved. If nil, no hooks are called.
+	hooks ecocredit.KeeperHooks
 }
 
 // NewKeeper returns a new keeper instance.
@@ -16,6 +16,7 @@ import (
 	basetypes "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
 	basketsub "github.com/regen-network/regen-ledger/x/ecocredit/basket"
 	types "github.com/regen-network/regen-ledger/x/ecocredit/basket/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
 )
 
 // Put deposits ecocredits into a basket, returning fungible coins to the depositor.
@@ -53,6 +54,10 @@ func (k Keeper) Put(ctx context.Context, req *types.MsgPut) (*types.MsgPutRespon
 			return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch %s: %s", credit.BatchDenom, err.Error())
 		}
 
+		if err = utils.AssertBatchNotSuspended(batch); err != nil {
+			return nil, err
+		}
+
 		// validate that the credit batch adheres to the basket's specifications
 		if err := k.canBasketAcceptCredit(ctx, basket, batch); err != nil {
 			return nil, err
@@ -86,6 +91,10 @@ func (k Keeper) Put(ctx context.Context, req *types.MsgPut) (*types.MsgPutRespon
 			return nil, err
 		}
 
+		if err = k.hooks.AfterCreditsTransferred(sdkCtx, credit.BatchDenom, ownerAddr, k.moduleAddress, amt, regenmath.NewDecFromInt64(0)); err != nil {
+			return nil, err
+		}
+
 		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/basket/MsgPut credit iteration")
 	}
 
@@ -240,6 +240,15 @@ func (s *putSuite) ACreditBatchWithDenom(a string) {
 	require.NoError(s.t, err)
 }
 
+func (s *putSuite) TheCreditBatchIsSuspended() {
+	batch, err := s.baseStore.BatchTable().GetByDenom(s.ctx, s.batchDenom)
+	require.NoError(s.t, err)
+
+	batch.Suspended = true
+	err = s.baseStore.BatchTable().Update(s.ctx, batch)
+	require.NoError(s.t, err)
+}
+
 func (s *putSuite) AlicesAddress(a string) {
 	addr, err := sdk.AccAddressFromBech32(a)
 	require.NoError(s.t, err)
@@ -11,6 +11,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/types/math"
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 	basekeeper "github.com/regen-network/regen-ledger/x/ecocredit/base/keeper"
@@ -123,6 +124,8 @@ func (k Keeper) Take(ctx context.Context, msg *types.MsgTake) (*types.MsgTakeRes
 				amountCreditsNeeded,
 				retire,
 				retirementJurisdiction,
+				msg.RetirementReason,
+				msg.RetirementBeneficiary,
 			)
 			if err != nil {
 				return nil, err
@@ -153,6 +156,8 @@ func (k Keeper) Take(ctx context.Context, msg *types.MsgTake) (*types.MsgTakeRes
 				balance,
 				retire,
 				retirementJurisdiction,
+				msg.RetirementReason,
+				msg.RetirementBeneficiary,
 			)
 			if err != nil {
 				return nil, err
@@ -188,7 +193,8 @@ func (k Keeper) Take(ctx context.Context, msg *types.MsgTake) (*types.MsgTakeRes
 	}, err
 }
 
-func (k Keeper) addCreditBalance(ctx context.Context, owner sdk.AccAddress, batchDenom string, amount math.Dec, retire bool, jurisdiction string) error {
+func (k Keeper) addCreditBalance(ctx context.Context, owner sdk.AccAddress, batchDenom string, amount math.Dec, retire bool,
+	jurisdiction, reason, beneficiary string) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	batch, err := k.baseStore.BatchTable().GetByDenom(ctx, batchDenom)
 	if err != nil {
@@ -198,12 +204,15 @@ func (k Keeper) addCreditBalance(ctx context.Context, owner sdk.AccAddress, batc
 		if err = basekeeper.AddAndSaveBalance(ctx, k.baseStore.BatchBalanceTable(), owner, batch.Key, amount); err != nil {
 			return err
 		}
-		return sdkCtx.EventManager().EmitTypedEvent(&basetypes.EventTransfer{
+		if err = sdkCtx.EventManager().EmitTypedEvent(&basetypes.EventTransfer{
 			Sender:         k.moduleAddress.String(), // basket submodule
 			Recipient:      owner.String(),
 			BatchDenom:     batchDenom,
 			TradableAmount: amount.String(),
-		})
+		}); err != nil {
+			return err
+		}
+		return k.hooks.AfterCreditsTransferred(sdkCtx, batchDenom, k.moduleAddress, owner, amount, math.NewDecFromInt64(0))
 	}
 
 	if err = basekeeper.RetireAndSaveBalance(ctx, k.baseStore.BatchBalanceTable(), owner, batch.Key, amount); err != nil {
@@ -212,6 +221,17 @@ func (k Keeper) addCreditBalance(ctx context.Context, owner sdk.AccAddress, batc
 	if err = basekeeper.RetireSupply(ctx, k.baseStore.BatchSupplyTable(), batch.Key, amount); err != nil {
 		return err
 	}
+	retirementID, err := basekeeper.SaveRetirement(ctx, k.baseStore.RetirementTable(), &baseapi.Retirement{
+		Owner:        owner,
+		BatchKey:     batch.Key,
+		Amount:       amount.String(),
+		Jurisdiction: jurisdiction,
+		Reason:       reason,
+		Beneficiary:  beneficiary,
+	})
+	if err != nil {
+		return err
+	}
 	err = sdkCtx.EventManager().EmitTypedEvent(&basetypes.EventTransfer{
 		Sender:        k.moduleAddress.String(), // basket submodule
 		Recipient:     owner.String(),
@@ -221,10 +241,18 @@ func (k Keeper) addCreditBalance(ctx context.Context, owner sdk.AccAddress, batc
 	if err != nil {
 		return err
 	}
-	return sdkCtx.EventManager().EmitTypedEvent(&basetypes.EventRetire{
+	err = sdkCtx.EventManager().EmitTypedEvent(&basetypes.EventRetire{
 		Owner:        owner.String(),
 		BatchDenom:   batchDenom,
 		Amount:       amount.String(),
 		Jurisdiction: jurisdiction,
 	})
+	if err != nil {
+		return err
+	}
+	err = k.hooks.AfterCreditsTransferred(sdkCtx, batchDenom, k.moduleAddress, owner, math.NewDecFromInt64(0), amount)
+	if err != nil {
+		return err
+	}
+	return k.hooks.AfterCreditsRetired(sdkCtx, batchDenom, owner, amount, retirementID, jurisdiction, reason, beneficiary)
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/regen-network/regen-ledger/x/ecocredit/basket"
 
+	"github.com/regen-network/regen-ledger/x/ecocredit"
 	"github.com/regen-network/regen-ledger/x/ecocredit/base"
 )
 
@@ -66,6 +67,14 @@ func (m MsgTake) ValidateBasic() error {
 		}
 	}
 
+	if len(m.RetirementReason) > base.MaxNoteLength {
+		return ecocredit.ErrMaxLimit.Wrapf("retirement reason: max length %d", base.MaxNoteLength)
+	}
+
+	if len(m.RetirementBeneficiary) > base.MaxNoteLength {
+		return ecocredit.ErrMaxLimit.Wrapf("retirement beneficiary: max length %d", base.MaxNoteLength)
+	}
+
 	return nil
 }
 
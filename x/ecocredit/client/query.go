@@ -24,6 +24,12 @@ func QueryCmd(name string) *cobra.Command {
 		baseclient.QueryClassesCmd(),
 		baseclient.QueryClassCmd(),
 		baseclient.QueryClassIssuersCmd(),
+		baseclient.QueryClassIssuerQuotaCmd(),
+		baseclient.QueryClassIssuerQuotasCmd(),
+		baseclient.QueryPendingBatchCmd(),
+		baseclient.QueryPendingBatchesByClassCmd(),
+		baseclient.QueryCreditAllowanceCmd(),
+		baseclient.QueryCreditAllowancesBySpenderCmd(),
 		baseclient.QueryBatchesCmd(),
 		baseclient.QueryBatchesByIssuerCmd(),
 		baseclient.QueryBatchesByClassCmd(),
@@ -36,6 +42,7 @@ func QueryCmd(name string) *cobra.Command {
 		baseclient.QueryProjectsByClassCmd(),
 		baseclient.QueryProjectsByReferenceIDCmd(),
 		baseclient.QueryProjectsByAdminCmd(),
+		baseclient.QueryProjectsByStatusCmd(),
 		baseclient.QueryProjectCmd(),
 		baseclient.QueryParamsCmd(),
 		baseclient.QueryCreditTypeCmd(),
@@ -44,6 +51,9 @@ func QueryCmd(name string) *cobra.Command {
 		baseclient.QueryClassFeeCmd(),
 		baseclient.QueryAllBalances(),
 		baseclient.QueryAllowedBridgeChains(),
+		baseclient.QueryRetirementCmd(),
+		baseclient.QueryRetirementsByOwnerCmd(),
+		baseclient.QueryRetirementsByBatchCmd(),
 		basketclient.QueryBasketCmd(),
 		basketclient.QueryBasketsCmd(),
 		basketclient.QueryBasketBalanceCmd(),
@@ -53,7 +63,29 @@ func QueryCmd(name string) *cobra.Command {
 		marketclient.QuerySellOrdersCmd(),
 		marketclient.QuerySellOrdersBySellerCmd(),
 		marketclient.QuerySellOrdersByBatchCmd(),
+		marketclient.QuerySellOrdersByClassCmd(),
+		marketclient.QuerySellOrdersByProjectCmd(),
+		marketclient.QuerySellOrdersByMarketCmd(),
 		marketclient.QueryAllowedDenomsCmd(),
+		marketclient.QueryAllowedDenomTraceCmd(),
+		marketclient.QueryBuyOrderCmd(),
+		marketclient.QueryBuyOrdersCmd(),
+		marketclient.QueryBuyOrdersByBuyerCmd(),
+		marketclient.QueryTradingFeeCmd(),
+		marketclient.QueryClassRoyaltyCmd(),
+		marketclient.QueryTradesByBatchCmd(),
+		marketclient.QueryTradesByMarketCmd(),
+		marketclient.QueryLastPriceCmd(),
+		marketclient.QueryVWAPCmd(),
+		marketclient.QueryAuctionCmd(),
+		marketclient.QueryAuctionsCmd(),
+		marketclient.QueryOfferCmd(),
+		marketclient.QueryOffersBySellOrderCmd(),
+		marketclient.QueryOffersByBuyerCmd(),
+		marketclient.QuerySwapCmd(),
+		marketclient.QuerySwapsByAddressCmd(),
+		marketclient.QueryForwardContractCmd(),
+		marketclient.QueryForwardContractsByProjectCmd(),
 	)
 	return cmd
 }
@@ -41,6 +41,22 @@ func TxCmd(name string) *cobra.Command {
 		baseclient.TxUpdateClassAdminCmd(),
 		baseclient.TxUpdateProjectAdminCmd(),
 		baseclient.TxUpdateProjectMetadataCmd(),
+		baseclient.TxUpdateProjectStatusCmd(),
+		baseclient.TxSetClassIssuerQuotaCmd(),
+		baseclient.TxUpdateClassBufferCmd(),
+		baseclient.TxCancelBufferCreditsCmd(),
+		baseclient.TxUpdateClassIssuanceThresholdCmd(),
+		baseclient.TxUpdateClassRequireVerifiedProjectCmd(),
+		baseclient.TxProposeBatchCmd(),
+		baseclient.TxApproveBatchCmd(),
+		baseclient.TxApproveCreditsCmd(),
+		baseclient.TxRevokeCreditAllowanceCmd(),
+		baseclient.TxSendFromCmd(),
+		baseclient.TxGrantCreditSendCmd(),
+		baseclient.TxGrantCreditRetireCmd(),
+		baseclient.TxSuspendBatchCmd(),
+		baseclient.TxUnsuspendBatchCmd(),
+		baseclient.TxInvalidateBatchCmd(),
 		basketclient.TxCreateBasketCmd(),
 		basketclient.TxPutInBasketCmd(),
 		basketclient.TxTakeFromBasketCmd(),
@@ -50,6 +66,20 @@ func TxCmd(name string) *cobra.Command {
 		marketclient.TxBuyDirectCmd(),
 		marketclient.TxBuyDirectBulkCmd(),
 		marketclient.TxCancelSellOrderCmd(),
+		marketclient.TxCancelSellOrdersCmd(),
+		marketclient.TxCancelAllSellOrdersCmd(),
+		marketclient.TxBuyCmd(),
+		marketclient.TxCancelBuyOrderCmd(),
+		marketclient.TxUpdateClassRoyaltyCmd(),
+		marketclient.TxCreateAuctionCmd(),
+		marketclient.TxBidAuctionCmd(),
+		marketclient.TxMakeOfferCmd(),
+		marketclient.TxAcceptOfferCmd(),
+		marketclient.TxRejectOfferCmd(),
+		marketclient.TxCounterOfferCmd(),
+		marketclient.TxProposeSwapCmd(),
+		marketclient.TxAcceptSwapCmd(),
+		marketclient.TxCreateForwardContractCmd(),
 	)
 	return cmd
 }
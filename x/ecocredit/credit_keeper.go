@@ -0,0 +1,34 @@
+package ecocredit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/types/math"
+)
+
+// CreditKeeper defines the methods other modules use to hold, move and retire
+// credits without going through the message services of the ecocredit module.
+// The methods apply the same checks and update the same state as the
+// corresponding messages, such that credits moved with the CreditKeeper are
+// accounted for in the batch supply and the ecocredit hooks are called.
+type CreditKeeper interface {
+	// GetBalance returns the tradable, retired and escrowed amounts of credits
+	// of the credit batch owned by the account.
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, batchDenom string) (tradable, retired, escrowed math.Dec, err error)
+
+	// TransferCredits transfers tradable credits of the credit batch from the
+	// sender to the recipient.
+	TransferCredits(ctx sdk.Context, sender, recipient sdk.AccAddress, batchDenom string, amount math.Dec) error
+
+	// EscrowCredits transfers tradable credits of the credit batch from the
+	// owner to the account of the module.
+	EscrowCredits(ctx sdk.Context, owner sdk.AccAddress, moduleName, batchDenom string, amount math.Dec) error
+
+	// ReleaseCredits transfers tradable credits of the credit batch from the
+	// account of the module to the recipient.
+	ReleaseCredits(ctx sdk.Context, moduleName string, recipient sdk.AccAddress, batchDenom string, amount math.Dec) error
+
+	// RetireCredits retires tradable credits of the credit batch owned by the
+	// owner and returns the id of the retirement.
+	RetireCredits(ctx sdk.Context, owner sdk.AccAddress, batchDenom string, amount math.Dec, jurisdiction, reason, beneficiary string) (uint64, error)
+}
@@ -6,6 +6,9 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/params/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	ibcexported "github.com/cosmos/ibc-go/v5/modules/core/exported"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 )
 
 //go:generate mockgen -source=expected_keepers.go -package mocks -destination mocks/expected_keepers.go
@@ -31,11 +34,19 @@ type BankKeeper interface {
 	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	SetDenomMetaData(ctx sdk.Context, denomMetaData banktypes.Metadata)
 	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	BlockedAddr(addr sdk.AccAddress) bool
+}
+
+// DistributionKeeper defines the expected interface needed to fund the community pool.
+type DistributionKeeper interface {
+	// FundCommunityPool sends coins from the sender account to the community pool.
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
 
 // GovKeeper defines the expected interface needed to query governance params
@@ -44,6 +55,20 @@ type GovKeeper interface {
 	GetDepositParams(ctx sdk.Context) govv1.DepositParams
 }
 
+// TransferKeeper defines the expected interface needed to retrieve IBC denom traces.
+type TransferKeeper interface {
+	// GetDenomTrace retrieves the denom trace of an IBC denom by its hash.
+	GetDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) (ibctransfertypes.DenomTrace, bool)
+}
+
+// ChannelKeeper defines the expected interface needed to retrieve the counterparty
+// client state of IBC channels.
+type ChannelKeeper interface {
+	// GetChannelClientState returns the client id and client state of the client
+	// associated with the channel.
+	GetChannelClientState(ctx sdk.Context, portID, channelID string) (string, ibcexported.ClientState, error)
+}
+
 type ParamKeeper interface {
 
 	// Get fetches a parameter by key from the Subspace's KVStore and sets the provided pointer to the fetched value.
@@ -340,6 +340,36 @@ func validateMsg(m proto.Message) error {
 			return err
 		}
 		return msg.Validate()
+	case *baseapi.Retirement:
+		msg := &basetypes.Retirement{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.ClassIssuerQuota:
+		msg := &basetypes.ClassIssuerQuota{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.PendingBatch:
+		msg := &basetypes.PendingBatch{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.PendingBatchApproval:
+		msg := &basetypes.PendingBatchApproval{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.CreditAllowance:
+		msg := &basetypes.CreditAllowance{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
 
 	// basket submodule
 	case *basketapi.Basket:
@@ -374,6 +404,12 @@ func validateMsg(m proto.Message) error {
 			return err
 		}
 		return msg.Validate()
+	case *marketapi.BuyOrder:
+		msg := &markettypes.BuyOrder{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
 	case *marketapi.AllowedDenom:
 		msg := &markettypes.AllowedDenom{}
 		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
@@ -386,6 +422,54 @@ func validateMsg(m proto.Message) error {
 			return err
 		}
 		return msg.Validate()
+	case *marketapi.TradingFee:
+		msg := &markettypes.TradingFee{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *marketapi.ClassRoyalty:
+		msg := &markettypes.ClassRoyalty{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *marketapi.Trade:
+		msg := &markettypes.Trade{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *marketapi.Auction:
+		msg := &markettypes.Auction{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *marketapi.Offer:
+		msg := &markettypes.Offer{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *marketapi.Swap:
+		msg := &markettypes.Swap{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *marketapi.ForwardContract:
+		msg := &markettypes.ForwardContract{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *marketapi.SellOrderAsk:
+		msg := &markettypes.SellOrderAsk{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
 	}
 
 	return nil
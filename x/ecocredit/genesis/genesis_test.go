@@ -25,6 +25,7 @@ import (
 	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/types"
 	"github.com/regen-network/regen-ledger/x/ecocredit"
+	markettypes "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
 )
 
 func TestValidateGenesis(t *testing.T) {
@@ -748,12 +749,13 @@ func TestValidateGenesisMarketplace(t *testing.T) {
 			"valid: sell order",
 			func(ctx context.Context, ss marketapi.StateStore) {
 				require.NoError(t, ss.SellOrderTable().Insert(ctx, &marketapi.SellOrder{
-					Seller:    addr1,
-					BatchKey:  1,
-					Quantity:  "100",
-					MarketId:  1,
-					AskAmount: "100",
-					Maker:     true,
+					Seller:            addr1,
+					BatchKey:          1,
+					Quantity:          "100",
+					MarketId:          1,
+					AskAmount:         "100",
+					Maker:             true,
+					SortableAskAmount: markettypes.SortableAskAmount(sdk.NewInt(100)),
 				}))
 			},
 			false,
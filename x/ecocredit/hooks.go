@@ -0,0 +1,149 @@
+package ecocredit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/types/math"
+)
+
+// EcocreditHooks defines the callbacks other modules can register to react to
+// the movement of credits. The callbacks are called after the state has been
+// updated. An error returned by a callback fails the message that moved the
+// credits, whereas an error returned by a callback called at the beginning or
+// end of a block (e.g. when a buy order is filled or an auction is settled) is
+// logged and the state changes made by the callback are discarded.
+//
+//nolint:revive
+type EcocreditHooks interface {
+	// AfterCreditsIssued is called after credits of a credit batch have been
+	// issued to the recipient, including credits minted to an open batch.
+	AfterCreditsIssued(ctx sdk.Context, batchDenom string, recipient sdk.AccAddress, tradableAmount, retiredAmount math.Dec) error
+
+	// AfterCreditsTransferred is called after credits of a credit batch have
+	// been transferred from the sender to the recipient. The retired amount has
+	// been retired upon receipt.
+	AfterCreditsTransferred(ctx sdk.Context, batchDenom string, sender, recipient sdk.AccAddress, tradableAmount, retiredAmount math.Dec) error
+
+	// AfterCreditsRetired is called after credits of a credit batch have been
+	// retired by the owner, including credits retired upon issuance or receipt.
+	// The retirement ID is the ID of the retirement recorded in state, and the
+	// jurisdiction, reason and beneficiary are those of the retirement.
+	AfterCreditsRetired(ctx sdk.Context, batchDenom string, owner sdk.AccAddress, amount math.Dec, retirementID uint64,
+		jurisdiction, reason, beneficiary string) error
+
+	// AfterCreditsCancelled is called after credits of a credit batch owned by
+	// the owner have been cancelled.
+	AfterCreditsCancelled(ctx sdk.Context, batchDenom string, owner sdk.AccAddress, amount math.Dec) error
+
+	// AfterBatchSealed is called after an open credit batch has been sealed.
+	AfterBatchSealed(ctx sdk.Context, batchDenom string) error
+}
+
+var _ EcocreditHooks = MultiEcocreditHooks{}
+
+// MultiEcocreditHooks combines multiple ecocredit hooks. The hooks are called
+// in the order they are provided and the first error is returned.
+type MultiEcocreditHooks []EcocreditHooks
+
+// NewMultiEcocreditHooks returns the ecocredit hooks combined.
+func NewMultiEcocreditHooks(hooks ...EcocreditHooks) MultiEcocreditHooks {
+	return hooks
+}
+
+func (h MultiEcocreditHooks) AfterCreditsIssued(ctx sdk.Context, batchDenom string, recipient sdk.AccAddress, tradableAmount, retiredAmount math.Dec) error {
+	for _, hook := range h {
+		if err := hook.AfterCreditsIssued(ctx, batchDenom, recipient, tradableAmount, retiredAmount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiEcocreditHooks) AfterCreditsTransferred(ctx sdk.Context, batchDenom string, sender, recipient sdk.AccAddress, tradableAmount, retiredAmount math.Dec) error {
+	for _, hook := range h {
+		if err := hook.AfterCreditsTransferred(ctx, batchDenom, sender, recipient, tradableAmount, retiredAmount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiEcocreditHooks) AfterCreditsRetired(ctx sdk.Context, batchDenom string, owner sdk.AccAddress, amount math.Dec, retirementID uint64,
+	jurisdiction, reason, beneficiary string) error {
+	for _, hook := range h {
+		if err := hook.AfterCreditsRetired(ctx, batchDenom, owner, amount, retirementID, jurisdiction, reason, beneficiary); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiEcocreditHooks) AfterCreditsCancelled(ctx sdk.Context, batchDenom string, owner sdk.AccAddress, amount math.Dec) error {
+	for _, hook := range h {
+		if err := hook.AfterCreditsCancelled(ctx, batchDenom, owner, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiEcocreditHooks) AfterBatchSealed(ctx sdk.Context, batchDenom string) error {
+	for _, hook := range h {
+		if err := hook.AfterBatchSealed(ctx, batchDenom); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var _ EcocreditHooks = KeeperHooks{}
+
+// KeeperHooks holds the ecocredit hooks set on a keeper. The callbacks do
+// nothing if no hooks have been set.
+type KeeperHooks struct {
+	hooks EcocreditHooks
+}
+
+// Set sets the ecocredit hooks. The hooks can only be set once.
+func (h *KeeperHooks) Set(hooks EcocreditHooks) {
+	if h.hooks != nil {
+		panic("cannot set ecocredit hooks twice")
+	}
+	h.hooks = hooks
+}
+
+func (h KeeperHooks) AfterCreditsIssued(ctx sdk.Context, batchDenom string, recipient sdk.AccAddress, tradableAmount, retiredAmount math.Dec) error {
+	if h.hooks == nil {
+		return nil
+	}
+	return h.hooks.AfterCreditsIssued(ctx, batchDenom, recipient, tradableAmount, retiredAmount)
+}
+
+func (h KeeperHooks) AfterCreditsTransferred(ctx sdk.Context, batchDenom string, sender, recipient sdk.AccAddress, tradableAmount, retiredAmount math.Dec) error {
+	if h.hooks == nil {
+		return nil
+	}
+	return h.hooks.AfterCreditsTransferred(ctx, batchDenom, sender, recipient, tradableAmount, retiredAmount)
+}
+
+func (h KeeperHooks) AfterCreditsRetired(ctx sdk.Context, batchDenom string, owner sdk.AccAddress, amount math.Dec, retirementID uint64,
+	jurisdiction, reason, beneficiary string) error {
+	if h.hooks == nil {
+		return nil
+	}
+	return h.hooks.AfterCreditsRetired(ctx, batchDenom, owner, amount, retirementID, jurisdiction, reason, beneficiary)
+}
+
+func (h KeeperHooks) AfterCreditsCancelled(ctx sdk.Context, batchDenom string, owner sdk.AccAddress, amount math.Dec) error {
+	if h.hooks == nil {
+		return nil
+	}
+	return h.hooks.AfterCreditsCancelled(ctx, batchDenom, owner, amount)
+}
+
+func (h KeeperHooks) AfterBatchSealed(ctx sdk.Context, batchDenom string) error {
+	if h.hooks == nil {
+		return nil
+	}
+	return h.hooks.AfterBatchSealed(ctx, batchDenom)
+}
@@ -1,8 +1,11 @@
 package client
 
 import (
+	"fmt"
 	"strconv"
+	"time"
 
+	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/spf13/cobra"
 
 	sdkclient "github.com/cosmos/cosmos-sdk/client"
@@ -12,6 +15,17 @@ import (
 	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
 )
 
+const (
+	FlagStartTime      = "start-time"
+	FlagEndTime        = "end-time"
+	FlagCounterparty   = "counterparty"
+	FlagAskDenom       = "ask-denom"
+	FlagMinAskAmount   = "min-ask-amount"
+	FlagMaxAskAmount   = "max-ask-amount"
+	FlagSort           = "sort"
+	FlagExcludeExpired = "exclude-expired"
+)
+
 // QuerySellOrderCmd returns a query command that retrieves information for a given sell order.
 func QuerySellOrderCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -155,6 +169,190 @@ regen q ecocredit sell-orders-by-batch C01-001-20200101-20210101-001 --limit 10
 	return cmd
 }
 
+// QuerySellOrdersByClassCmd returns a query command that retrieves sell orders for credits from a credit class.
+func QuerySellOrdersByClassCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sell-orders-by-class [class_id]",
+		Short: "List all sell orders by credit class",
+		Long:  "List all sell orders for credits from a credit class with optional filter and pagination flags.",
+		Example: `regen q ecocredit sell-orders-by-class C01
+regen q ecocredit sell-orders-by-class C01 --ask-denom uregen --max-ask-amount 1000000 --sort asc --exclude-expired`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := types.NewQueryClient(ctx)
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			filter, err := readSellOrderFilter(cmd)
+			if err != nil {
+				return err
+			}
+			res, err := client.SellOrdersByClass(cmd.Context(), &types.QuerySellOrdersByClassRequest{
+				ClassId:    args[0],
+				Filter:     filter,
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "sell-orders-by-class")
+	addSellOrderFilterFlags(cmd)
+	return cmd
+}
+
+// QuerySellOrdersByProjectCmd returns a query command that retrieves sell orders for credits from a project.
+func QuerySellOrdersByProjectCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sell-orders-by-project [project_id]",
+		Short: "List all sell orders by project",
+		Long:  "List all sell orders for credits from a project with optional filter and pagination flags.",
+		Example: `regen q ecocredit sell-orders-by-project C01-001
+regen q ecocredit sell-orders-by-project C01-001 --ask-denom uregen --min-ask-amount 1000 --sort desc`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := types.NewQueryClient(ctx)
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			filter, err := readSellOrderFilter(cmd)
+			if err != nil {
+				return err
+			}
+			res, err := client.SellOrdersByProject(cmd.Context(), &types.QuerySellOrdersByProjectRequest{
+				ProjectId:  args[0],
+				Filter:     filter,
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "sell-orders-by-project")
+	addSellOrderFilterFlags(cmd)
+	return cmd
+}
+
+// QuerySellOrdersByMarketCmd returns a query command that retrieves sell orders in a market.
+func QuerySellOrdersByMarketCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sell-orders-by-market [market_id]",
+		Short: "List all sell orders by market",
+		Long:  "List all sell orders in a market with optional filter and pagination flags.",
+		Example: `regen q ecocredit sell-orders-by-market 1
+regen q ecocredit sell-orders-by-market 1 --min-ask-amount 1000 --max-ask-amount 2000 --sort asc`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			marketID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid market id: %s", err)
+			}
+
+			client := types.NewQueryClient(ctx)
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			filter, err := readSellOrderFilter(cmd)
+			if err != nil {
+				return err
+			}
+			res, err := client.SellOrdersByMarket(cmd.Context(), &types.QuerySellOrdersByMarketRequest{
+				MarketId:   marketID,
+				Filter:     filter,
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "sell-orders-by-market")
+	addSellOrderFilterFlags(cmd)
+	return cmd
+}
+
+// addSellOrderFilterFlags adds the sell order filter flags to the command.
+func addSellOrderFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagAskDenom, "", "the bank denom of the ask price of the sell orders")
+	cmd.Flags().String(FlagMinAskAmount, "", "the minimum ask amount of the sell orders")
+	cmd.Flags().String(FlagMaxAskAmount, "", "the maximum ask amount of the sell orders")
+	cmd.Flags().String(FlagSort, "", "sort the sell orders by ask price (asc|desc)")
+	cmd.Flags().Bool(FlagExcludeExpired, false, "exclude expired sell orders")
+}
+
+// readSellOrderFilter reads the sell order filter from the command flags.
+func readSellOrderFilter(cmd *cobra.Command) (*types.SellOrderFilter, error) {
+	askDenom, err := cmd.Flags().GetString(FlagAskDenom)
+	if err != nil {
+		return nil, err
+	}
+	minAskAmount, err := cmd.Flags().GetString(FlagMinAskAmount)
+	if err != nil {
+		return nil, err
+	}
+	maxAskAmount, err := cmd.Flags().GetString(FlagMaxAskAmount)
+	if err != nil {
+		return nil, err
+	}
+	sortStr, err := cmd.Flags().GetString(FlagSort)
+	if err != nil {
+		return nil, err
+	}
+	excludeExpired, err := cmd.Flags().GetBool(FlagExcludeExpired)
+	if err != nil {
+		return nil, err
+	}
+
+	var sort types.SellOrderSort
+	switch sortStr {
+	case "":
+		sort = types.SellOrderSort_SELL_ORDER_SORT_UNSPECIFIED
+	case "asc":
+		sort = types.SellOrderSort_SELL_ORDER_SORT_ASK_PRICE_ASC
+	case "desc":
+		sort = types.SellOrderSort_SELL_ORDER_SORT_ASK_PRICE_DESC
+	default:
+		return nil, fmt.Errorf("invalid sort: %s, expected asc or desc", sortStr)
+	}
+
+	return &types.SellOrderFilter{
+		AskDenom:       askDenom,
+		MinAskAmount:   minAskAmount,
+		MaxAskAmount:   maxAskAmount,
+		Sort:           sort,
+		ExcludeExpired: excludeExpired,
+	}, nil
+}
+
 // QueryAllowedDenomsCmd returns a query command that retrieves allowed denoms with pagination.
 func QueryAllowedDenomsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -190,3 +388,704 @@ regen q ecocredit allowed-denoms --limit 10 --offset 10`,
 
 	return cmd
 }
+
+// QueryAllowedDenomTraceCmd returns a query command that retrieves the IBC denom trace of an allowed denom.
+func QueryAllowedDenomTraceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "allowed-denom-trace [bank-denom]",
+		Short:   "Retrieve the IBC denom trace of an allowed denom",
+		Long:    "Retrieve the IBC denom trace of an allowed denom, i.e. the base denom and the chain the denom originates from.",
+		Example: `regen q ecocredit allowed-denom-trace ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := types.NewQueryClient(ctx)
+			res, err := client.AllowedDenomTrace(cmd.Context(), &types.QueryAllowedDenomTraceRequest{
+				BankDenom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryBuyOrderCmd returns a query command that retrieves information for a given buy order.
+func QueryBuyOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "buy-order [buy-order-id]",
+		Short:   "Retrieve information for a given buy order",
+		Long:    "Retrieve information for a given buy order.",
+		Example: "regen q ecocredit buy-order 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := types.NewQueryClient(ctx)
+			buyOrderID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return ecocredit.ErrInvalidBuyOrder.Wrap(err.Error())
+			}
+			res, err := client.BuyOrder(cmd.Context(), &types.QueryBuyOrderRequest{
+				BuyOrderId: buyOrderID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryBuyOrdersCmd returns a query command that retrieves all buy orders with pagination.
+func QueryBuyOrdersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "buy-orders",
+		Short: "List all buy orders",
+		Long:  `List all buy orders with optional pagination flags.`,
+		Example: `regen q ecocredit buy-orders
+regen q ecocredit buy-orders --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := types.NewQueryClient(ctx)
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := client.BuyOrders(cmd.Context(), &types.QueryBuyOrdersRequest{
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "buy-orders")
+
+	return cmd
+}
+
+// QueryBuyOrdersByBuyerCmd returns a query command that retrieves all buy orders by address with pagination.
+func QueryBuyOrdersByBuyerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "buy-orders-by-buyer [buyer]",
+		Short: "List all buy orders by buyer",
+		Long:  `List all buy orders by buyer with optional pagination flags.`,
+		Example: `regen q ecocredit buy-orders-by-buyer regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf
+regen q ecocredit buy-orders-by-buyer regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := types.NewQueryClient(ctx)
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := client.BuyOrdersByBuyer(cmd.Context(), &types.QueryBuyOrdersByBuyerRequest{
+				Buyer:      args[0],
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "buy-orders-by-buyer")
+
+	return cmd
+}
+
+// QueryTradingFeeCmd returns a query command that retrieves the marketplace trading fee.
+func QueryTradingFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "trading-fee",
+		Short:   "Retrieve the marketplace trading fee",
+		Long:    "Retrieve the maker and taker fees charged on marketplace trades and the destination of the fees.",
+		Example: "regen q ecocredit trading-fee",
+		Args:    cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := types.NewQueryClient(ctx)
+			res, err := client.TradingFee(cmd.Context(), &types.QueryTradingFeeRequest{})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryClassRoyaltyCmd returns a query command that retrieves the royalty of a credit class.
+func QueryClassRoyaltyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "class-royalty [class-id]",
+		Short:   "Retrieve the royalty of a credit class",
+		Long:    "Retrieve the royalty paid whenever credits from the credit class are sold in the marketplace.",
+		Example: "regen q ecocredit class-royalty C01",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := types.NewQueryClient(ctx)
+			res, err := client.ClassRoyalty(cmd.Context(), &types.QueryClassRoyaltyRequest{
+				ClassId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryTradesByBatchCmd returns a query command that retrieves all trades of credits from a credit batch.
+func QueryTradesByBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trades-by-batch [batch-denom]",
+		Short: "List all trades by batch denom",
+		Long:  "List all trades of credits from a credit batch with optional pagination flags.",
+		Example: `regen q ecocredit trades-by-batch C01-001-20200101-20210101-001
+regen q ecocredit trades-by-batch C01-001-20200101-20210101-001 --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := types.NewQueryClient(ctx)
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := client.TradesByBatch(cmd.Context(), &types.QueryTradesByBatchRequest{
+				BatchDenom: args[0],
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "trades-by-batch")
+
+	return cmd
+}
+
+// QueryTradesByMarketCmd returns a query command that retrieves all trades in a market.
+func QueryTradesByMarketCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trades-by-market [credit-type-abbrev] [bank-denom]",
+		Short: "List all trades by market",
+		Long:  "List all trades of credits of a credit type in exchange for a bank denom with optional pagination flags.",
+		Example: `regen q ecocredit trades-by-market C uregen
+regen q ecocredit trades-by-market C uregen --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := types.NewQueryClient(ctx)
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := client.TradesByMarket(cmd.Context(), &types.QueryTradesByMarketRequest{
+				CreditTypeAbbrev: args[0],
+				BankDenom:        args[1],
+				Pagination:       pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "trades-by-market")
+
+	return cmd
+}
+
+// QueryLastPriceCmd returns a query command that retrieves the price of the most recent trade.
+func QueryLastPriceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "last-price [batch-denom] [bank-denom]",
+		Short:   "Retrieve the price of the most recent trade",
+		Long:    "Retrieve the price of the most recent trade of credits from a credit batch in a bank denom.",
+		Example: "regen q ecocredit last-price C01-001-20200101-20210101-001 uregen",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := types.NewQueryClient(ctx)
+			res, err := client.LastPrice(cmd.Context(), &types.QueryLastPriceRequest{
+				BatchDenom: args[0],
+				BankDenom:  args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryVWAPCmd returns a query command that retrieves the volume-weighted average price of trades.
+func QueryVWAPCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vwap [batch-denom] [bank-denom]",
+		Short: "Retrieve the volume-weighted average price of trades",
+		Long: `Retrieve the volume-weighted average price of trades of credits from a credit batch in a bank denom.
+
+The time range can be limited with the optional --start-time and --end-time flags (RFC3339, inclusive).`,
+		Example: `regen q ecocredit vwap C01-001-20200101-20210101-001 uregen
+regen q ecocredit vwap C01-001-20200101-20210101-001 uregen --start-time 2022-01-01T00:00:00Z`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			startTime, err := parseTimeFlag(cmd, FlagStartTime)
+			if err != nil {
+				return err
+			}
+
+			endTime, err := parseTimeFlag(cmd, FlagEndTime)
+			if err != nil {
+				return err
+			}
+
+			client := types.NewQueryClient(ctx)
+			res, err := client.VWAP(cmd.Context(), &types.QueryVWAPRequest{
+				BatchDenom: args[0],
+				BankDenom:  args[1],
+				StartTime:  startTime,
+				EndTime:    endTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagStartTime, "", "the start of the time range (e.g. \"2022-01-01T00:00:00Z\")")
+	cmd.Flags().String(FlagEndTime, "", "the end of the time range (e.g. \"2022-02-01T00:00:00Z\")")
+
+	return cmd
+}
+
+// QueryAuctionCmd returns a query command that retrieves information for a given auction.
+func QueryAuctionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "auction [auction-id]",
+		Short:   "Retrieve information for a given auction",
+		Long:    "Retrieve information for a given auction, including the current price of a dutch auction.",
+		Example: "regen q ecocredit auction 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auctionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid auction id: %s", err)
+			}
+
+			client := types.NewQueryClient(ctx)
+			res, err := client.Auction(cmd.Context(), &types.QueryAuctionRequest{
+				AuctionId: auctionID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryAuctionsCmd returns a query command that retrieves all auctions.
+func QueryAuctionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auctions",
+		Short: "List all auctions",
+		Long:  "List all auctions with optional pagination flags.",
+		Example: `regen q ecocredit auctions
+regen q ecocredit auctions --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := types.NewQueryClient(ctx)
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := client.Auctions(cmd.Context(), &types.QueryAuctionsRequest{
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "auctions")
+
+	return cmd
+}
+
+// QueryOfferCmd returns a query command that retrieves information for a given offer.
+func QueryOfferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "offer [offer-id]",
+		Short:   "Retrieve information for a given offer",
+		Long:    "Retrieve information for a given offer.",
+		Example: "regen q ecocredit offer 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			offerID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid offer id: %s", err)
+			}
+
+			client := types.NewQueryClient(ctx)
+			res, err := client.Offer(cmd.Context(), &types.QueryOfferRequest{
+				OfferId: offerID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryOffersBySellOrderCmd returns a query command that retrieves all offers made on a sell order.
+func QueryOffersBySellOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "offers-by-sell-order [sell-order-id]",
+		Short: "List all offers made on a sell order",
+		Long:  "List all offers made on a sell order with optional pagination flags.",
+		Example: `regen q ecocredit offers-by-sell-order 1
+regen q ecocredit offers-by-sell-order 1 --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sellOrderID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid sell order id: %s", err)
+			}
+
+			client := types.NewQueryClient(ctx)
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := client.OffersBySellOrder(cmd.Context(), &types.QueryOffersBySellOrderRequest{
+				SellOrderId: sellOrderID,
+				Pagination:  pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "offers-by-sell-order")
+
+	return cmd
+}
+
+// QueryOffersByBuyerCmd returns a query command that retrieves all offers made by a buyer.
+func QueryOffersByBuyerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "offers-by-buyer [buyer]",
+		Short: "List all offers made by a buyer",
+		Long:  "List all offers made by a buyer with optional pagination flags.",
+		Example: `regen q ecocredit offers-by-buyer regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf
+regen q ecocredit offers-by-buyer regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := types.NewQueryClient(ctx)
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := client.OffersByBuyer(cmd.Context(), &types.QueryOffersByBuyerRequest{
+				Buyer:      args[0],
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "offers-by-buyer")
+
+	return cmd
+}
+
+// QuerySwapCmd returns a query command that retrieves information for a given swap.
+func QuerySwapCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "swap [swap-id]",
+		Short:   "Retrieve information for a given swap",
+		Long:    "Retrieve information for a given swap.",
+		Example: "regen q ecocredit swap 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			swapID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid swap id: %s", err)
+			}
+
+			client := types.NewQueryClient(ctx)
+			res, err := client.Swap(cmd.Context(), &types.QuerySwapRequest{
+				SwapId: swapID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QuerySwapsByAddressCmd returns a query command that retrieves all swaps proposed by or to an address.
+func QuerySwapsByAddressCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swaps-by-address [address]",
+		Short: "List all swaps proposed by an address",
+		Long: `List all swaps proposed by an address, or proposed to an address if --counterparty
+is set, with optional pagination flags.`,
+		Example: `regen q ecocredit swaps-by-address regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf
+regen q ecocredit swaps-by-address regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf --counterparty --limit 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			counterparty, err := cmd.Flags().GetBool(FlagCounterparty)
+			if err != nil {
+				return err
+			}
+
+			client := types.NewQueryClient(ctx)
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := client.SwapsByAddress(cmd.Context(), &types.QuerySwapsByAddressRequest{
+				Address:      args[0],
+				Counterparty: counterparty,
+				Pagination:   pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "swaps-by-address")
+	cmd.Flags().Bool(FlagCounterparty, false, "list swaps proposed to the address instead of swaps proposed by the address")
+
+	return cmd
+}
+
+// parseTimeFlag parses an optional RFC3339 time flag.
+func parseTimeFlag(cmd *cobra.Command, flag string) (*gogotypes.Timestamp, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil || value == "" {
+		return nil, err
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %s", flag, err)
+	}
+
+	return gogotypes.TimestampProto(t)
+}
+
+// QueryForwardContractCmd returns a query command that retrieves information for a given forward contract.
+func QueryForwardContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "forward-contract [forward-contract-id]",
+		Short:   "Retrieve information for a given forward contract",
+		Long:    "Retrieve information for a given forward contract.",
+		Example: "regen q ecocredit forward-contract 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid forward contract id: %s", err)
+			}
+
+			client := types.NewQueryClient(ctx)
+			res, err := client.ForwardContract(cmd.Context(), &types.QueryForwardContractRequest{
+				ForwardContractId: contractID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryForwardContractsByProjectCmd returns a query command that retrieves the forward contracts for a project.
+func QueryForwardContractsByProjectCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "forward-contracts-by-project [project-id]",
+		Short: "List all forward contracts for credits from a project",
+		Long:  "List all forward contracts for credits from a project with optional pagination flags.",
+		Example: `regen q ecocredit forward-contracts-by-project C01-001
+regen q ecocredit forward-contracts-by-project C01-001 --limit 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := types.NewQueryClient(ctx)
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := client.ForwardContractsByProject(cmd.Context(), &types.QueryForwardContractsByProjectRequest{
+				ProjectId:  args[0],
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "forward-contracts-by-project")
+
+	return cmd
+}
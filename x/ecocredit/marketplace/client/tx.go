@@ -3,6 +3,8 @@ package client
 import (
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -16,6 +18,13 @@ import (
 
 const (
 	FlagRetirementJurisdiction = "retirement-jurisdiction"
+	FlagEndPrice               = "end-price"
+	FlagDisableAutoRetire      = "disable-auto-retire"
+	FlagOfferCoins             = "offer-coins"
+	FlagBatchDenom             = "batch-denom"
+	FlagRetirementReason       = "retirement-reason"
+	FlagRetirementBeneficiary  = "retirement-beneficiary"
+	FlagAcceptRetired          = "accept-retired"
 )
 
 // TxSellCmd returns a transaction command that creates sell orders.
@@ -49,7 +58,14 @@ Example JSON:
       "amount" "100000000"
 	},
     "disable_auto_retire": false,
-    "expiration": "2024-01-01T00:00:00Z"
+    "expiration": "2024-01-01T00:00:00Z",
+    "allowed_buyers": ["regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"],
+    "additional_ask_prices": [
+      {
+        "denom": "ibc/CDC4587874B85BEA4FCEC3CEA5A1195139799A1FEE711A07D972537E18FDA39D",
+        "amount": "100000000"
+      }
+    ]
   }
 ]`,
 		Args:    cobra.ExactArgs(1),
@@ -110,7 +126,8 @@ Example JSON:
       "amount" "100000000"
 	},
     "disable_auto_retire": false,
-    "new_expiration": "2024-01-01T00:00:00Z"
+    "new_expiration": "2024-01-01T00:00:00Z",
+    "clear_additional_ask_prices": true
   }
 ]`,
 		Args:    cobra.ExactArgs(1),
@@ -181,6 +198,16 @@ NOTE: The bid price is the price paid PER credit. The total cost will be quantit
 				return err
 			}
 
+			retirementReason, err := cmd.Flags().GetString(FlagRetirementReason)
+			if err != nil {
+				return err
+			}
+
+			retirementBeneficiary, err := cmd.Flags().GetString(FlagRetirementBeneficiary)
+			if err != nil {
+				return err
+			}
+
 			msg := types.MsgBuyDirect{
 				Buyer: clientCtx.GetFromAddress().String(),
 				Orders: []*types.MsgBuyDirect_Order{
@@ -190,6 +217,8 @@ NOTE: The bid price is the price paid PER credit. The total cost will be quantit
 						BidPrice:               &bidPrice,
 						DisableAutoRetire:      disableAutoRetire,
 						RetirementJurisdiction: retireJurisdiction,
+						RetirementReason:       retirementReason,
+						RetirementBeneficiary:  retirementBeneficiary,
 					},
 				},
 			}
@@ -199,6 +228,8 @@ NOTE: The bid price is the price paid PER credit. The total cost will be quantit
 	}
 
 	cmd.Flags().String(FlagRetirementJurisdiction, "", "the jurisdiction to use for retirement when auto retire is true.")
+	cmd.Flags().String(FlagRetirementReason, "", "the reason for the retirement when auto retire is true.")
+	cmd.Flags().String(FlagRetirementBeneficiary, "", "the beneficiary of the retirement when auto retire is true.")
 
 	return txFlags(cmd)
 }
@@ -294,3 +325,781 @@ func TxCancelSellOrderCmd() *cobra.Command {
 
 	return txFlags(cmd)
 }
+
+// TxCancelSellOrdersCmd returns a transaction command that cancels multiple sell orders.
+func TxCancelSellOrdersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-sell-orders [order-ids]",
+		Short: "Cancel multiple existing sell orders with transaction author (--from) as seller",
+		Long: `Cancel multiple existing sell orders with transaction author (--from) as seller.
+
+Parameters:
+
+- order-ids:  comma-separated list of the ids of the sell orders to cancel`,
+		Example: "regen tx ecocredit cancel-sell-orders 1,2,3",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var ids []uint64
+			for _, arg := range strings.Split(args[0], ",") {
+				id, err := strconv.ParseUint(strings.TrimSpace(arg), 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid order id: %s", err)
+				}
+				ids = append(ids, id)
+			}
+
+			msg := types.MsgCancelSellOrders{
+				Seller:       clientCtx.GetFromAddress().String(),
+				SellOrderIds: ids,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxCancelAllSellOrdersCmd returns a transaction command that cancels all sell
+// orders of the seller, optionally filtered by credit batch.
+func TxCancelAllSellOrdersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-all-sell-orders",
+		Short: "Cancel all existing sell orders with transaction author (--from) as seller",
+		Long: `Cancel all existing sell orders with transaction author (--from) as seller.
+
+If the --batch-denom flag is set, only the sell orders of the credit batch are cancelled.`,
+		Example: `regen tx ecocredit cancel-all-sell-orders
+regen tx ecocredit cancel-all-sell-orders --batch-denom C01-001-20200101-20210101-001`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			batchDenom, err := cmd.Flags().GetString(FlagBatchDenom)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgCancelSellOrders{
+				Seller:     clientCtx.GetFromAddress().String(),
+				CancelAll:  true,
+				BatchDenom: batchDenom,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagBatchDenom, "", "the denom of the credit batch of the sell orders to cancel.")
+
+	return txFlags(cmd)
+}
+
+// TxBuyCmd returns a transaction command that creates buy orders.
+func TxBuyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "buy [orders-json]",
+		Short: "Creates new buy orders with transaction author (--from) as buyer",
+		Long: `Creates new buy orders with transaction author (--from) as buyer.
+
+The total bid amount (quantity * bid_price) of each buy order is held in escrow
+until the buy order is filled, cancelled, or expired. Buy orders are matched with
+sell orders at the end of each block and credits are always bought at the ask
+price of the sell order.
+
+DisableAutoRetire can be set to true to receive the credits in a tradable state,
+in which case the buy order will only be matched with sell orders that also have
+auto retire disabled.
+
+Instead of a batch denom, a buy order can bid on any credits from a credit class
+using class_selector (optionally filtered by project location and batch dates) or
+on any credits from a project using project_selector (optionally filtered by batch
+dates). Exactly one of batch_denom, class_selector, or project_selector must be set.
+
+Parameters:
+
+- orders-json:  path to JSON file containing orders to create
+
+Example JSON:
+
+[
+  {
+    "batch_denom": "C01-001-20200101-20210101-001",
+    "quantity": "5",
+    "bid_price": {
+      "denom": "uregen",
+      "amount": "100000000"
+    },
+    "disable_auto_retire": false,
+    "retirement_jurisdiction": "US-WA"
+  },
+  {
+    "batch_denom": "C01-001-20200101-20210101-002",
+    "quantity": "10",
+    "bid_price": {
+      "denom": "uregen",
+      "amount": "100000000"
+    },
+    "disable_auto_retire": true,
+    "expiration": "2024-01-01T00:00:00Z"
+  },
+  {
+    "class_selector": {
+      "class_id": "C01",
+      "project_location": "US-OR",
+      "min_start_date": "2020-01-01T00:00:00Z"
+    },
+    "quantity": "10",
+    "bid_price": {
+      "denom": "uregen",
+      "amount": "100000000"
+    },
+    "retirement_jurisdiction": "US-WA"
+  },
+  {
+    "project_selector": {
+      "project_id": "C01-001",
+      "max_end_date": "2022-01-01T00:00:00Z"
+    },
+    "quantity": "10",
+    "bid_price": {
+      "denom": "uregen",
+      "amount": "100000000"
+    },
+    "retirement_jurisdiction": "US-WA"
+  }
+]`,
+		Args:    cobra.ExactArgs(1),
+		Example: "regen tx ecocredit buy orders.json",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			orders, err := parseBids(args[0])
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("failed to parse json: %s", err)
+			}
+
+			// create buy message
+			msg := types.MsgBuyOrder{
+				Buyer:  clientCtx.GetFromAddress().String(),
+				Orders: orders,
+			}
+
+			// generate and broadcast transaction
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxCancelBuyOrderCmd returns a transaction command that cancels a buy order.
+func TxCancelBuyOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-buy-order [order-id]",
+		Short:   "Cancel an existing buy order with transaction author (--from) as buyer",
+		Long:    "Cancel an existing buy order with transaction author (--from) as buyer. The escrowed funds are returned to the buyer.",
+		Example: "regen tx ecocredit cancel-buy-order 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid order id: %s", err)
+			}
+
+			msg := types.MsgCancelBuyOrder{
+				Buyer:      clientCtx.GetFromAddress().String(),
+				BuyOrderId: id,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxUpdateClassRoyaltyCmd returns a transaction command that updates the royalty of a credit class.
+func TxUpdateClassRoyaltyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-class-royalty [class-id] [royalty-bps] [recipient]",
+		Short: "Update the royalty of a credit class with transaction author (--from) as admin",
+		Long: `Update the royalty of a credit class with transaction author (--from) as admin.
+
+The royalty is paid to the recipient out of the amount paid to the seller whenever
+credits from the credit class are sold in the marketplace. The royalty is expressed
+in basis points (1/100th of a percent). A royalty of zero (with no recipient)
+removes the royalty.
+
+Parameters:
+
+- class-id:    the ID of the credit class
+- royalty-bps: the royalty in basis points
+- recipient:   the address of the account that receives the royalty`,
+		Example: `regen tx ecocredit update-class-royalty C01 500 regen1...
+regen tx ecocredit update-class-royalty C01 0`,
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			royaltyBps, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid royalty: %s", err)
+			}
+
+			msg := types.MsgUpdateClassRoyalty{
+				Admin:      clientCtx.GetFromAddress().String(),
+				ClassId:    args[0],
+				RoyaltyBps: uint32(royaltyBps),
+			}
+
+			if len(args) == 3 {
+				msg.Recipient = args[2]
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxCreateAuctionCmd returns a transaction command that creates an auction.
+func TxCreateAuctionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-auction [batch-denom] [quantity] [auction-type] [start-price] [end-time]",
+		Short: "Create an auction with transaction author (--from) as seller",
+		Long: `Create an english or dutch auction with transaction author (--from) as seller.
+
+The credits being auctioned are held in escrow until the auction is settled at the
+beginning of the first block after the end time.
+
+An english auction is an ascending price auction. Each bid must be at least the start
+price and higher than the highest bid. The highest bidder wins when the auction ends.
+
+A dutch auction is a descending price auction. The price decreases linearly from the
+start price to the end price (--end-price) over the duration of the auction and the
+first bid at or above the current price wins the auction at the current price.
+
+Parameters:
+
+- batch-denom:  the denom of the credit batch being auctioned
+- quantity:     the quantity of credits being auctioned
+- auction-type: the type of the auction (english or dutch)
+- start-price:  the price per credit at which the auction starts
+- end-time:     the time at which the auction ends (RFC3339)`,
+		Example: `regen tx ecocredit create-auction C01-001-20200101-20210101-001 100 english 10uregen 2022-01-01T00:00:00Z
+regen tx ecocredit create-auction C01-001-20200101-20210101-001 100 dutch 10uregen 2022-01-01T00:00:00Z --end-price 5uregen`,
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auctionType, ok := types.AuctionType_value["AUCTION_TYPE_"+strings.ToUpper(args[2])]
+			if !ok {
+				return fmt.Errorf("invalid auction type: %s", args[2])
+			}
+
+			startPrice, err := sdk.ParseCoinNormalized(args[3])
+			if err != nil {
+				return err
+			}
+
+			endTime, err := time.Parse(time.RFC3339, args[4])
+			if err != nil {
+				return fmt.Errorf("invalid end time: %s", err)
+			}
+
+			disableAutoRetire, err := cmd.Flags().GetBool(FlagDisableAutoRetire)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgCreateAuction{
+				Seller:            clientCtx.GetFromAddress().String(),
+				BatchDenom:        args[0],
+				Quantity:          args[1],
+				AuctionType:       types.AuctionType(auctionType),
+				StartPrice:        &startPrice,
+				EndTime:           &endTime,
+				DisableAutoRetire: disableAutoRetire,
+			}
+
+			endPriceStr, err := cmd.Flags().GetString(FlagEndPrice)
+			if err != nil {
+				return err
+			}
+			if endPriceStr != "" {
+				endPrice, err := sdk.ParseCoinNormalized(endPriceStr)
+				if err != nil {
+					return err
+				}
+				msg.EndPrice = &endPrice
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagEndPrice, "", "the price per credit at which a dutch auction ends.")
+	cmd.Flags().Bool(FlagDisableAutoRetire, false, "allow the winning bidder to disable auto-retirement of the credits.")
+
+	return txFlags(cmd)
+}
+
+// TxBidAuctionCmd returns a transaction command that places a bid on an auction.
+func TxBidAuctionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bid-auction [auction-id] [bid-price] [disable-auto-retire] [flags]",
+		Short: "Place a bid on an auction with transaction author (--from) as bidder",
+		Long: `Place a bid on an auction with transaction author (--from) as bidder.
+
+The total bid amount (quantity of the auction * bid price) is held in escrow and
+returned if the bid is outbid. For a dutch auction, the bid price must be at least
+the current price and the bidder pays the current price.
+
+DisableAutoRetire can be set to false to retire the credits when the auction is
+settled. When set to true, credits will be received in a tradable state, IF AND
+ONLY IF the auction also has auto retire disabled.
+
+NOTE: The bid price is the price paid PER credit.`,
+		Example: `regen tx ecocredit bid-auction 1 10000000uregen true
+regen tx ecocredit bid-auction 1 10000000uregen false --retirement-jurisdiction "US-WA 98225"`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auctionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid auction id: %s", err)
+			}
+
+			bidPrice, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			disableAutoRetire, err := strconv.ParseBool(args[2])
+			if err != nil {
+				return err
+			}
+
+			retireJurisdiction, err := cmd.Flags().GetString(FlagRetirementJurisdiction)
+			if err != nil {
+				return err
+			}
+
+			retirementReason, err := cmd.Flags().GetString(FlagRetirementReason)
+			if err != nil {
+				return err
+			}
+
+			retirementBeneficiary, err := cmd.Flags().GetString(FlagRetirementBeneficiary)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgBidAuction{
+				Bidder:                 clientCtx.GetFromAddress().String(),
+				AuctionId:              auctionID,
+				BidPrice:               &bidPrice,
+				DisableAutoRetire:      disableAutoRetire,
+				RetirementJurisdiction: retireJurisdiction,
+				RetirementReason:       retirementReason,
+				RetirementBeneficiary:  retirementBeneficiary,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagRetirementJurisdiction, "", "the jurisdiction to use for retirement when auto retire is true.")
+	cmd.Flags().String(FlagRetirementReason, "", "the reason for the retirement when auto retire is true.")
+	cmd.Flags().String(FlagRetirementBeneficiary, "", "the beneficiary of the retirement when auto retire is true.")
+
+	return txFlags(cmd)
+}
+
+// TxMakeOfferCmd returns a transaction command that makes an offer on a sell order.
+func TxMakeOfferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "make-offer [sell-order-id] [quantity] [bid-price] [disable-auto-retire] [expiration] [flags]",
+		Short: "Make an offer on a sell order with transaction author (--from) as buyer",
+		Long: `Make an offer to buy credits from a sell order at a price below the ask price with
+transaction author (--from) as buyer.
+
+The total bid amount (quantity * bid price) is held in escrow until the offer is
+accepted, rejected, or expires. The seller can accept, reject, or counter the offer.
+
+DisableAutoRetire can be set to false to retire the credits when the offer is
+accepted. When set to true, credits will be received in a tradable state, IF AND
+ONLY IF the sell order also has auto retire disabled.
+
+NOTE: The bid price is the price paid PER credit.`,
+		Example: `regen tx ecocredit make-offer 1 10 8000000uregen true 2024-01-01T00:00:00Z
+regen tx ecocredit make-offer 1 10 8000000uregen false 2024-01-01T00:00:00Z --retirement-jurisdiction "US-WA 98225"`,
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sellOrderID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid sell order id: %s", err)
+			}
+
+			bidPrice, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			disableAutoRetire, err := strconv.ParseBool(args[3])
+			if err != nil {
+				return err
+			}
+
+			expiration, err := time.Parse(time.RFC3339, args[4])
+			if err != nil {
+				return fmt.Errorf("invalid expiration: %s", err)
+			}
+
+			retireJurisdiction, err := cmd.Flags().GetString(FlagRetirementJurisdiction)
+			if err != nil {
+				return err
+			}
+
+			retirementReason, err := cmd.Flags().GetString(FlagRetirementReason)
+			if err != nil {
+				return err
+			}
+
+			retirementBeneficiary, err := cmd.Flags().GetString(FlagRetirementBeneficiary)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgMakeOffer{
+				Buyer:                  clientCtx.GetFromAddress().String(),
+				SellOrderId:            sellOrderID,
+				Quantity:               args[1],
+				BidPrice:               &bidPrice,
+				DisableAutoRetire:      disableAutoRetire,
+				RetirementJurisdiction: retireJurisdiction,
+				RetirementReason:       retirementReason,
+				RetirementBeneficiary:  retirementBeneficiary,
+				Expiration:             &expiration,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagRetirementJurisdiction, "", "the jurisdiction to use for retirement when auto retire is true.")
+	cmd.Flags().String(FlagRetirementReason, "", "the reason for the retirement when auto retire is true.")
+	cmd.Flags().String(FlagRetirementBeneficiary, "", "the beneficiary of the retirement when auto retire is true.")
+
+	return txFlags(cmd)
+}
+
+// TxAcceptOfferCmd returns a transaction command that accepts an offer.
+func TxAcceptOfferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-offer [offer-id]",
+		Short: "Accept an offer with transaction author (--from) as seller or buyer",
+		Long: `Accept an offer with transaction author (--from) as seller or buyer.
+
+The seller of the sell order accepts an offer at the bid price and the buyer accepts
+a counter offer at the counter price, paying the difference from their account.`,
+		Example: "regen tx ecocredit accept-offer 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			offerID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid offer id: %s", err)
+			}
+
+			msg := types.MsgAcceptOffer{
+				Sender:  clientCtx.GetFromAddress().String(),
+				OfferId: offerID,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxRejectOfferCmd returns a transaction command that rejects an offer.
+func TxRejectOfferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reject-offer [offer-id]",
+		Short: "Reject an offer with transaction author (--from) as seller or buyer",
+		Long: `Reject an offer with transaction author (--from) as seller or buyer.
+
+The seller of the sell order can reject the offer and the buyer can withdraw the
+offer. The escrowed funds are returned to the buyer.`,
+		Example: "regen tx ecocredit reject-offer 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			offerID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid offer id: %s", err)
+			}
+
+			msg := types.MsgRejectOffer{
+				Sender:  clientCtx.GetFromAddress().String(),
+				OfferId: offerID,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxCounterOfferCmd returns a transaction command that counters an offer.
+func TxCounterOfferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "counter-offer [offer-id] [price]",
+		Short: "Counter an offer with transaction author (--from) as seller or buyer",
+		Long: `Counter an offer with a new price per credit with transaction author (--from) as
+seller or buyer.
+
+The seller of the sell order counters an offer with a price higher than the bid price.
+The buyer counters a counter offer with a new bid price lower than the counter price,
+in which case the escrowed funds are updated.`,
+		Example: "regen tx ecocredit counter-offer 1 9000000uregen",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			offerID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid offer id: %s", err)
+			}
+
+			price, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgCounterOffer{
+				Sender:  clientCtx.GetFromAddress().String(),
+				OfferId: offerID,
+				Price:   &price,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxProposeSwapCmd returns a transaction command that proposes a swap.
+func TxProposeSwapCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-swap [counterparty] [offer-batch-denom] [offer-quantity] [ask-batch-denom] [ask-quantity] [expiration] [flags]",
+		Short: "Propose a swap of credits with transaction author (--from) as proposer",
+		Long: `Propose to exchange credits from one credit batch, optionally together with coins
+(--offer-coins), for credits from another credit batch held by the counterparty with
+transaction author (--from) as proposer.
+
+The offered credits and coins are held in escrow until the counterparty accepts the
+swap or the swap expires. The credits are received in a tradable state.
+
+Parameters:
+
+- counterparty:      the address of the only account that can accept the swap
+- offer-batch-denom: the denom of the credit batch offered
+- offer-quantity:    the quantity of credits offered
+- ask-batch-denom:   the denom of the credit batch requested
+- ask-quantity:      the quantity of credits requested
+- expiration:        the time at which the swap expires (RFC3339)`,
+		Example: `regen tx ecocredit propose-swap regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf C01-001-20200101-20210101-001 100 C01-001-20210101-20220101-001 90 2024-01-01T00:00:00Z
+regen tx ecocredit propose-swap regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf C01-001-20200101-20210101-001 100 C01-001-20210101-20220101-001 100 2024-01-01T00:00:00Z --offer-coins 10000000uregen`,
+		Args: cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			expiration, err := time.Parse(time.RFC3339, args[5])
+			if err != nil {
+				return fmt.Errorf("invalid expiration: %s", err)
+			}
+
+			offerCoinsStr, err := cmd.Flags().GetString(FlagOfferCoins)
+			if err != nil {
+				return err
+			}
+			offerCoins, err := sdk.ParseCoinsNormalized(offerCoinsStr)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgProposeSwap{
+				Proposer:        clientCtx.GetFromAddress().String(),
+				Counterparty:    args[0],
+				OfferBatchDenom: args[1],
+				OfferQuantity:   args[2],
+				OfferCoins:      offerCoins,
+				AskBatchDenom:   args[3],
+				AskQuantity:     args[4],
+				Expiration:      &expiration,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagOfferCoins, "", "the coins offered in addition to the credits.")
+
+	return txFlags(cmd)
+}
+
+// TxAcceptSwapCmd returns a transaction command that accepts a swap.
+func TxAcceptSwapCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-swap [swap-id]",
+		Short: "Accept a swap with transaction author (--from) as counterparty",
+		Long: `Accept a swap with transaction author (--from) as counterparty.
+
+The requested credits are sent to the proposer and the escrowed credits and coins
+are sent to the counterparty in a single transaction.`,
+		Example: "regen tx ecocredit accept-swap 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			swapID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid swap id: %s", err)
+			}
+
+			msg := types.MsgAcceptSwap{
+				Counterparty: clientCtx.GetFromAddress().String(),
+				SwapId:       swapID,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxCreateForwardContractCmd returns a transaction command that creates a forward contract.
+func TxCreateForwardContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-forward-contract [issuer] [project-id] [quantity] [price] [deadline] [flags]",
+		Short: "Pre-purchase credits for future delivery with transaction author (--from) as buyer",
+		Long: `Pre-purchase credits to be delivered by an issuer from an open credit batch (--batch-denom)
+or from any credit batch of a project with transaction author (--from) as buyer.
+
+The total cost (quantity * price) is held in escrow and released to the issuer per credit
+as credits are minted or issued to the buyer. Only tradable credits are delivered unless
+retired credits are accepted (--accept-retired). The payment for any credits not delivered
+by the deadline is returned to the buyer.
+
+Parameters:
+
+- issuer:     the address of the account expected to deliver the credits
+- project-id: the id of the project from which the credits will be delivered
+- quantity:   the quantity of credits to pre-purchase
+- price:      the price per credit
+- deadline:   the time by which the credits must be delivered (RFC3339)`,
+		Example: `regen tx ecocredit create-forward-contract regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf C01-001 100 10000uregen 2024-01-01T00:00:00Z
+regen tx ecocredit create-forward-contract regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf C01-001 100 10000uregen 2024-01-01T00:00:00Z --batch-denom C01-001-20200101-20210101-001`,
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			price, err := sdk.ParseCoinNormalized(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid price: %s", err)
+			}
+
+			deadline, err := time.Parse(time.RFC3339, args[4])
+			if err != nil {
+				return fmt.Errorf("invalid deadline: %s", err)
+			}
+
+			batchDenom, err := cmd.Flags().GetString(FlagBatchDenom)
+			if err != nil {
+				return err
+			}
+
+			acceptRetired, err := cmd.Flags().GetBool(FlagAcceptRetired)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgCreateForwardContract{
+				Buyer:         clientCtx.GetFromAddress().String(),
+				Issuer:        args[0],
+				ProjectId:     args[1],
+				BatchDenom:    batchDenom,
+				Quantity:      args[2],
+				Price:         &price,
+				Deadline:      &deadline,
+				AcceptRetired: acceptRetired,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagBatchDenom, "", "the denom of the open credit batch from which the credits will be delivered.")
+	cmd.Flags().Bool(FlagAcceptRetired, false, "deliver credits retired upon issuance to the buyer towards the forward contract.")
+
+	return txFlags(cmd)
+}
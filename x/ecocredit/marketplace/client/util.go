@@ -81,3 +81,24 @@ func parseBuyOrders(jsonFile string) ([]*types.MsgBuyDirect_Order, error) {
 
 	return orders, nil
 }
+
+func parseBids(jsonFile string) ([]*types.MsgBuyOrder_Order, error) {
+	bz, err := ioutil.ReadFile(jsonFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := regentypes.CheckDuplicateKey(json.NewDecoder(bytes.NewReader(bz)), nil); err != nil {
+		return nil, err
+	}
+
+	var orders []*types.MsgBuyOrder_Order
+
+	// using json package because array is not a proto message
+	err = json.Unmarshal(bz, &orders)
+	if err != nil {
+		return nil, err
+	}
+
+	return orders, nil
+}
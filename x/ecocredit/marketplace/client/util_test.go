@@ -285,3 +285,138 @@ func TestParseBuyOrders(t *testing.T) {
 		})
 	}
 }
+
+func TestParseBids(t *testing.T) {
+	emptyJSON := testutil.WriteToNewTempFile(t, `{}`).Name()
+	invalidJSON := testutil.WriteToNewTempFile(t, `{foo:bar}`).Name()
+	duplicateJSON := testutil.WriteToNewTempFile(t, `{"foo":"bar","foo":"baz"}`).Name()
+	validJSON := testutil.WriteToNewTempFile(t, `[
+		{
+			"batch_denom": "C01-001-20200101-20210101-001",
+			"quantity": "10",
+			"bid_price": {
+				"denom": "regen",
+				"amount": "10"
+			},
+			"disable_auto_retire": true
+		},
+		{
+			"batch_denom": "C01-001-20200101-20210101-002",
+			"quantity": "20",
+			"bid_price": {
+				"denom": "regen",
+				"amount": "20"
+			},
+			"retirement_jurisdiction": "US-WA",
+			"expiration": "2022-01-01T00:00:00Z"
+		},
+		{
+			"class_selector": {
+				"class_id": "C01",
+				"project_location": "US-OR",
+				"min_start_date": "2020-01-01T00:00:00Z"
+			},
+			"quantity": "30",
+			"bid_price": {
+				"denom": "regen",
+				"amount": "30"
+			}
+		},
+		{
+			"project_selector": {
+				"project_id": "C01-001",
+				"max_end_date": "2022-01-01T00:00:00Z"
+			},
+			"quantity": "40",
+			"bid_price": {
+				"denom": "regen",
+				"amount": "40"
+			}
+		}
+	]`).Name()
+
+	expiration := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	minStartDate := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name      string
+		file      string
+		expErr    bool
+		expErrMsg string
+		expRes    []*types.MsgBuyOrder_Order
+	}{
+		{
+			name:      "empty file path",
+			file:      "",
+			expErr:    true,
+			expErrMsg: "no such file or directory",
+		},
+		{
+			name:      "empty json object",
+			file:      emptyJSON,
+			expErr:    true,
+			expErrMsg: "cannot unmarshal object",
+		},
+		{
+			name:      "invalid json format",
+			file:      invalidJSON,
+			expErr:    true,
+			expErrMsg: "invalid character",
+		},
+		{
+			name:      "duplicate json keys",
+			file:      duplicateJSON,
+			expErr:    true,
+			expErrMsg: "duplicate key",
+		},
+		{
+			name: "valid",
+			file: validJSON,
+			expRes: []*types.MsgBuyOrder_Order{
+				{
+					BatchDenom:        "C01-001-20200101-20210101-001",
+					Quantity:          "10",
+					BidPrice:          &sdk.Coin{Denom: "regen", Amount: sdk.NewInt(10)},
+					DisableAutoRetire: true,
+				},
+				{
+					BatchDenom:             "C01-001-20200101-20210101-002",
+					Quantity:               "20",
+					BidPrice:               &sdk.Coin{Denom: "regen", Amount: sdk.NewInt(20)},
+					RetirementJurisdiction: "US-WA",
+					Expiration:             &expiration,
+				},
+				{
+					ClassSelector: &types.MsgBuyOrder_ClassSelector{
+						ClassId:         "C01",
+						ProjectLocation: "US-OR",
+						MinStartDate:    &minStartDate,
+					},
+					Quantity: "30",
+					BidPrice: &sdk.Coin{Denom: "regen", Amount: sdk.NewInt(30)},
+				},
+				{
+					ProjectSelector: &types.MsgBuyOrder_ProjectSelector{
+						ProjectId:  "C01-001",
+						MaxEndDate: &expiration,
+					},
+					Quantity: "40",
+					BidPrice: &sdk.Coin{Denom: "regen", Amount: sdk.NewInt(40)},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := parseBids(tc.file)
+			if tc.expErr {
+				require.Error(t, err)
+				require.ErrorContains(t, err, tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expRes, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+)
+
+// AfterBatchSuspended removes the sell orders of the suspended credit batch
+// from the order book. The sell orders remain in state and are matched again
+// once the credit batch is unsuspended.
+func (k Keeper) AfterBatchSuspended(ctx context.Context, batch *baseapi.Batch) error {
+	sellOrders, err := k.batchSellOrders(ctx, batch.Key)
+	if err != nil {
+		return err
+	}
+
+	for _, sellOrder := range sellOrders {
+		if err = k.orderBook.OnRemoveSellOrder(ctx, sellOrder.Id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AfterBatchUnsuspended matches the sell orders of the unsuspended credit batch
+// with the buy orders in the order book.
+func (k Keeper) AfterBatchUnsuspended(ctx context.Context, batch *baseapi.Batch) error {
+	sellOrders, err := k.batchSellOrders(ctx, batch.Key)
+	if err != nil {
+		return err
+	}
+
+	for _, sellOrder := range sellOrders {
+		if _, err = k.orderBook.OnInsertSellOrder(ctx, sellOrder, batch); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AfterBatchCreditsIssued delivers the credits issued to the recipient towards
+// the forward contracts the recipient has with the issuer of the credit batch.
+func (k Keeper) AfterBatchCreditsIssued(ctx context.Context, batch *baseapi.Batch, recipient sdk.AccAddress, tradable, retired math.Dec) error {
+	return k.deliverForwardContracts(ctx, batch, recipient, tradable, retired)
+}
+
+// batchSellOrders returns the sell orders of the credit batch.
+func (k Keeper) batchSellOrders(ctx context.Context, batchKey uint64) ([]*api.SellOrder, error) {
+	it, err := k.stateStore.SellOrderTable().List(ctx, api.SellOrderBatchKeyIndexKey{}.WithBatchKey(batchKey))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var sellOrders []*api.SellOrder
+	for it.Next() {
+		sellOrder, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		sellOrders = append(sellOrders, sellOrder)
+	}
+
+	return sellOrders, nil
+}
@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// deliverForwardContracts delivers the credits issued to the recipient towards
+// the forward contracts the recipient has with the issuer of the batch. Only
+// tradable credits are delivered unless the forward contract accepts retired
+// credits, in which case tradable credits are delivered first. Credits are
+// applied to the contracts in the order they were created and, for each credit
+// delivered, the escrowed payment is released to the issuer. Contracts that
+// have been fully delivered are removed from state.
+func (k Keeper) deliverForwardContracts(ctx context.Context, batch *baseapi.Batch, recipient sdk.AccAddress, tradable, retired math.Dec) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if tradable.IsZero() && retired.IsZero() {
+		return nil
+	}
+
+	it, err := k.stateStore.ForwardContractTable().List(ctx, api.ForwardContractBuyerIndexKey{}.WithBuyer(recipient))
+	if err != nil {
+		return err
+	}
+
+	// collect the contracts before updating state to avoid modifying the
+	// table while iterating over it.
+	contracts := make([]*api.ForwardContract, 0)
+	for it.Next() {
+		contract, err := it.Value()
+		if err != nil {
+			it.Close()
+			return err
+		}
+		if !sdk.AccAddress(contract.Issuer).Equals(sdk.AccAddress(batch.Issuer)) ||
+			contract.ProjectKey != batch.ProjectKey ||
+			(contract.BatchKey != 0 && contract.BatchKey != batch.Key) ||
+			!contract.Deadline.AsTime().After(sdkCtx.BlockTime()) {
+			continue
+		}
+		contracts = append(contracts, contract)
+	}
+	it.Close()
+
+	for _, contract := range contracts {
+		available := tradable
+		if contract.AcceptRetired {
+			if available, err = tradable.Add(retired); err != nil {
+				return err
+			}
+		}
+		if available.IsZero() {
+			continue
+		}
+
+		quantity, err := math.NewDecFromString(contract.Quantity)
+		if err != nil {
+			return err
+		}
+		delivered, err := math.NewDecFromString(contract.DeliveredQuantity)
+		if err != nil {
+			return err
+		}
+		remaining, err := quantity.Sub(delivered)
+		if err != nil {
+			return err
+		}
+
+		deliverQty := remaining
+		if available.Cmp(remaining) == math.LessThan {
+			deliverQty = available
+		}
+
+		newDelivered, err := delivered.Add(deliverQty)
+		if err != nil {
+			return err
+		}
+
+		// the payment is the cost of all credits delivered minus the cost of the
+		// credits previously delivered so that the sum of the payments is equal
+		// to the total amount escrowed regardless of rounding.
+		priceAmount, err := parseAmount(contract.PriceAmount)
+		if err != nil {
+			return err
+		}
+		paid, err := utils.GetTotalCost(priceAmount, delivered)
+		if err != nil {
+			return err
+		}
+		cost, err := utils.GetTotalCost(priceAmount, newDelivered)
+		if err != nil {
+			return err
+		}
+
+		market, err := k.stateStore.MarketTable().Get(ctx, contract.MarketId)
+		if err != nil {
+			return err
+		}
+		payment := sdk.NewCoin(market.BankDenom, cost.Sub(paid))
+		if payment.IsPositive() {
+			if err = k.bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, ecocredit.ModuleName, contract.Issuer, sdk.NewCoins(payment)); err != nil {
+				return err
+			}
+		}
+
+		if newDelivered.Cmp(quantity) == math.EqualTo {
+			err = k.stateStore.ForwardContractTable().Delete(ctx, contract)
+		} else {
+			contract.DeliveredQuantity = newDelivered.String()
+			err = k.stateStore.ForwardContractTable().Update(ctx, contract)
+		}
+		if err != nil {
+			return err
+		}
+
+		if err = k.stateStore.TradeTable().Insert(ctx, &api.Trade{
+			Buyer:     recipient,
+			Seller:    contract.Issuer,
+			BatchKey:  batch.Key,
+			MarketId:  contract.MarketId,
+			Quantity:  deliverQty.String(),
+			Price:     contract.PriceAmount,
+			Timestamp: timestamppb.New(sdkCtx.BlockTime()),
+		}); err != nil {
+			return err
+		}
+
+		if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventDeliverForwardContract{
+			ForwardContractId: contract.Id,
+			BatchDenom:        batch.Denom,
+			Quantity:          deliverQty.String(),
+			Payment:           &payment,
+		}); err != nil {
+			return err
+		}
+
+		// tradable credits are delivered before retired credits
+		fromTradable := deliverQty
+		if tradable.Cmp(deliverQty) == math.LessThan {
+			fromTradable = tradable
+		}
+		fromRetired, err := deliverQty.Sub(fromTradable)
+		if err != nil {
+			return err
+		}
+		if tradable, err = tradable.Sub(fromTradable); err != nil {
+			return err
+		}
+		if retired, err = retired.Sub(fromRetired); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// issueToBuyer calls the hook called after credits of the batch have been
+// issued to the first test account, the buyer of the forward contracts.
+func (s *baseSuite) issueToBuyer(batch *baseapi.Batch, tradable, retired string) {
+	tradableDec, err := math.NewDecFromString(tradable)
+	assert.NilError(s.t, err)
+	retiredDec, err := math.NewDecFromString(retired)
+	assert.NilError(s.t, err)
+	assert.NilError(s.t, s.k.AfterBatchCreditsIssued(s.ctx, batch, s.addrs[0], tradableDec, retiredDec))
+}
+
+func TestDeliverForwardContracts(t *testing.T) {
+	t.Parallel()
+	s, balances := setupForwardContract(t)
+	issuer := s.addrs[1]
+
+	id, err := s.createForwardContract(batchDenom)
+	assert.NilError(t, err)
+	batch, err := s.baseStore.BatchTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+
+	// the payment is released per credit delivered
+	s.issueToBuyer(batch, "2.5", "0")
+	contract, err := s.marketStore.ForwardContractTable().Get(s.ctx, id)
+	assert.NilError(t, err)
+	assert.Equal(t, "2.5", contract.DeliveredQuantity)
+	assert.Equal(t, int64(25), balances[issuer.String()].AmountOf(ask.Denom).Int64())
+
+	// credits issued beyond the contract quantity are not paid for and the
+	// fully delivered contract is removed from state
+	s.issueToBuyer(batch, "10", "0")
+	_, err = s.marketStore.ForwardContractTable().Get(s.ctx, id)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+	assert.Equal(t, int64(100), balances[issuer.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(0), balances[ecocredit.ModuleName].AmountOf(ask.Denom).Int64())
+
+	// the deliveries are recorded as trades
+	it, err := s.marketStore.TradeTable().List(s.ctx, api.TradePrimaryKey{})
+	assert.NilError(t, err)
+	defer it.Close()
+	var quantities []string
+	for it.Next() {
+		trade, err := it.Value()
+		assert.NilError(t, err)
+		assert.Equal(t, "10", trade.Price)
+		quantities = append(quantities, trade.Quantity)
+	}
+	assert.DeepEqual(t, []string{"2.5", "7.5"}, quantities)
+}
+
+func TestDeliverForwardContracts_Retired(t *testing.T) {
+	t.Parallel()
+	s, _ := setupForwardContract(t)
+
+	tradableOnly, err := s.createForwardContract("")
+	assert.NilError(t, err)
+	acceptRetired, err := s.createForwardContract("")
+	assert.NilError(t, err)
+	contract, err := s.marketStore.ForwardContractTable().Get(s.ctx, acceptRetired)
+	assert.NilError(t, err)
+	contract.AcceptRetired = true
+	assert.NilError(t, s.marketStore.ForwardContractTable().Update(s.ctx, contract))
+
+	// credits issued in the next batch of the project are delivered and the
+	// retired credits are only delivered towards the contract accepting them
+	s.issueToBuyer(&baseapi.Batch{
+		Key:        2,
+		Issuer:     s.addrs[1],
+		ProjectKey: 1,
+		Denom:      "C01-001-20200101-20200201-002",
+	}, "1", "3")
+
+	contract, err = s.marketStore.ForwardContractTable().Get(s.ctx, tradableOnly)
+	assert.NilError(t, err)
+	assert.Equal(t, "1", contract.DeliveredQuantity)
+
+	contract, err = s.marketStore.ForwardContractTable().Get(s.ctx, acceptRetired)
+	assert.NilError(t, err)
+	assert.Equal(t, "3", contract.DeliveredQuantity)
+}
+
+func TestDeliverForwardContracts_Deadline(t *testing.T) {
+	t.Parallel()
+	s, balances := setupForwardContract(t)
+
+	id, err := s.createForwardContract(batchDenom)
+	assert.NilError(t, err)
+	batch, err := s.baseStore.BatchTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+
+	// credits issued at the deadline are not delivered
+	s.setBlockTime(forwardStart.Add(10 * time.Hour))
+	s.issueToBuyer(batch, "5", "0")
+
+	contract, err := s.marketStore.ForwardContractTable().Get(s.ctx, id)
+	assert.NilError(t, err)
+	assert.Equal(t, "0", contract.DeliveredQuantity)
+	assert.Equal(t, int64(0), balances[s.addrs[1].String()].AmountOf(ask.Denom).Int64())
+}
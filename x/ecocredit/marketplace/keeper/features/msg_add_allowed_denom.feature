@@ -3,7 +3,9 @@ Feature: Msg/AddAllowedDenom
   An allowed denom can be added:
   - when the authority is a governance account address
   - when the denom does not exist
+  - when the denom trace exists if the denom is an IBC denom
   - the allowed denom is added
+  - the denom trace is recorded if the denom is an IBC denom
 
   Rule: The authority must be governance account address
 
@@ -87,6 +89,57 @@ Feature: Msg/AddAllowedDenom
       """
       Then expect the error "display denom REGEN already exists: conflict"
 
+  Rule: An IBC denom must have a denom trace
+
+    Background:
+      Given a denom trace with path "transfer/channel-0" and base denom "uatom"
+
+    Scenario: The denom trace exists
+      When alice attempts to add a denom with properties
+      """
+      {
+        "authority":"regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "bank_denom":"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+        "display_denom":"ATOM",
+        "exponent":6
+      }
+      """
+      Then expect no error
+      And expect allowed denom with properties
+      """
+      {
+        "bank_denom":"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+        "display_denom":"ATOM",
+        "exponent":6,
+        "ibc_path":"transfer/channel-0",
+        "ibc_base_denom":"uatom"
+      }
+      """
+
+    Scenario: The denom trace does not exist
+      When alice attempts to add a denom with properties
+      """
+      {
+        "authority":"regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "bank_denom":"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB3",
+        "display_denom":"ATOM",
+        "exponent":6
+      }
+      """
+      Then expect the error "denom trace for ibc denom ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB3: not found"
+
+    Scenario: The denom trace exists for a different case of the hash
+      When alice attempts to add a denom with properties
+      """
+      {
+        "authority":"regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "bank_denom":"ibc/27394fb092d2eccd56123c74f36e4c1f926001ceada9ca97ea622b25f41e5eb2",
+        "display_denom":"ATOM",
+        "exponent":6
+      }
+      """
+      Then expect the error "ibc denom ibc/27394fb092d2eccd56123c74f36e4c1f926001ceada9ca97ea622b25f41e5eb2 does not match the denom of its denom trace ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2: invalid request"
+
   Rule: Event is emitted
 
     Scenario: EventAllowDenom is emitted
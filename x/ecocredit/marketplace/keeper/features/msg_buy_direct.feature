@@ -46,6 +46,21 @@ Feature: Msg/BuyDirect
       When alice attempts to buy credits with sell order id "1"
       Then expect the error "orders[0]: buyer account cannot be the same as seller account: unauthorized"
 
+  Rule: The buyer must be an allowed buyer of a private sell order
+
+    Background:
+      Given a credit type
+
+    Scenario: the buyer is an allowed buyer
+      Given alice created a sell order allowing only bob to buy
+      When bob attempts to buy credits with sell order id "1"
+      Then expect no error
+
+    Scenario: the buyer is not an allowed buyer
+      Given alice created a sell order allowing only carol to buy
+      When bob attempts to buy credits with sell order id "1"
+      Then expect the error "orders[0]: buyer is not allowed to buy credits from sell order 1: unauthorized"
+
   Rule: The bid denom must match the sell denom
 
     Background:
@@ -339,6 +354,132 @@ Feature: Msg/BuyDirect
 
     # no failing scenario - state transitions only occur upon successful message execution
 
+  Rule: The buyer pays the taker fee and the seller pays the maker or taker fee
+
+    Background:
+      Given a credit type
+      And alice has bank balance "0regen"
+      And bob has the bank balance "150regen"
+      And the trading fee
+      """
+      {
+        "maker_fee_bps": 100,
+        "taker_fee_bps": 200,
+        "destination": "FEE_DESTINATION_BURN"
+      }
+      """
+
+    Scenario: the seller pays the taker fee
+      Given alice created a sell order with quantity "10" and ask amount "10"
+      When bob attempts to buy credits with quantity "10" and bid amount "10"
+      Then expect no error
+      And expect alice bank balance "98regen"
+      And expect bob bank balance "48regen"
+      And expect burned trading fees "4regen"
+
+    Scenario: the seller pays the maker fee
+      Given alice created a maker sell order with quantity "10" and ask amount "10"
+      When bob attempts to buy credits with quantity "10" and bid amount "10"
+      Then expect no error
+      And expect alice bank balance "99regen"
+      And expect bob bank balance "48regen"
+      And expect burned trading fees "3regen"
+
+    Scenario: the buyer cannot pay the taker fee
+      Given bob has the bank balance "101regen"
+      And alice created a sell order with quantity "10" and ask amount "10"
+      When bob attempts to buy credits with quantity "10" and bid amount "10"
+      Then expect the error "orders[0]: quantity: 10, ask price: 10regen, total price: 102regen, bank balance: 101regen: insufficient funds"
+
+    Scenario: the fee is rounded down
+      Given alice created a sell order with quantity "1" and ask amount "49"
+      When bob attempts to buy credits with quantity "1" and bid amount "49"
+      Then expect no error
+      And expect alice bank balance "49regen"
+      And expect bob bank balance "101regen"
+      And expect burned trading fees ""
+
+  Rule: The trading fee is sent to the destination
+
+    Background:
+      Given a credit type
+      And alice has bank balance "0regen"
+      And bob has the bank balance "150regen"
+
+    Scenario Outline: trading fees are charged to the destination
+      Given the trading fee
+      """
+      {
+        "maker_fee_bps": 100,
+        "taker_fee_bps": 100,
+        "destination": "<destination>",
+        "module_account": "<module-account>"
+      }
+      """
+      And alice created a sell order with quantity "10" and ask amount "10"
+      When bob attempts to buy credits with quantity "10" and bid amount "10"
+      Then expect no error
+      And expect trading fees "2regen" sent to "<recipient>"
+
+      Examples:
+        | description       | destination                     | module-account | recipient      |
+        | burn              | FEE_DESTINATION_BURN            |                | burn           |
+        | community pool    | FEE_DESTINATION_COMMUNITY_POOL  |                | community pool |
+        | module account    | FEE_DESTINATION_MODULE_ACCOUNT  | fee_collector  | fee_collector  |
+
+  Rule: The credit class royalty is paid to the recipient
+
+    Background:
+      Given a credit type
+      And alice has bank balance "0regen"
+      And bob has the bank balance "150regen"
+
+    Scenario: the royalty is paid
+      Given alice created a sell order with quantity "10" and ask amount "10"
+      And the credit class has a royalty of "500" paid to carol
+      When bob attempts to buy credits with quantity "10" and bid amount "10"
+      Then expect no error
+      And expect alice bank balance "95regen"
+      And expect bob bank balance "50regen"
+      And expect carol bank balance "5regen"
+
+    Scenario: the royalty is paid out of the proceeds after trading fees
+      Given the trading fee
+      """
+      {
+        "maker_fee_bps": 100,
+        "taker_fee_bps": 200,
+        "destination": "FEE_DESTINATION_BURN"
+      }
+      """
+      And alice created a sell order with quantity "10" and ask amount "10"
+      And the credit class has a royalty of "500" paid to carol
+      When bob attempts to buy credits with quantity "10" and bid amount "10"
+      Then expect no error
+      And expect alice bank balance "94regen"
+      And expect bob bank balance "48regen"
+      And expect carol bank balance "4regen"
+      And expect burned trading fees "4regen"
+
+    Scenario: the royalty is not paid if the seller is the recipient
+      Given alice created a sell order with quantity "10" and ask amount "10"
+      And the credit class has a royalty of "500" paid to alice
+      When bob attempts to buy credits with quantity "10" and bid amount "10"
+      Then expect no error
+      And expect alice bank balance "100regen"
+      And expect bob bank balance "50regen"
+
+  Rule: The trade is recorded
+
+    Background:
+      Given a credit type
+
+    Scenario: the trade is recorded at the ask price
+      Given alice created a sell order with quantity "10" and ask amount "8"
+      When bob attempts to buy credits with quantity "10" and bid amount "10"
+      Then expect no error
+      And expect a trade from alice to bob with quantity "10" and price "8"
+
   Rule: Event is emitted
 
     Background:
@@ -350,6 +491,73 @@ Feature: Msg/BuyDirect
       Then expect event with properties
       """
       {
-        "sell_order_id": 1
+        "sell_order_id": 1,
+        "buyer_fee": {
+          "denom": "regen",
+          "amount": "0"
+        },
+        "seller_fee": {
+          "denom": "regen",
+          "amount": "0"
+        },
+        "royalty": {
+          "denom": "regen",
+          "amount": "0"
+        }
+      }
+      """
+
+    Scenario: EventBuyDirect is emitted with trading fees
+      Given the trading fee
+      """
+      {
+        "maker_fee_bps": 100,
+        "taker_fee_bps": 200,
+        "destination": "FEE_DESTINATION_BURN"
+      }
+      """
+      And bob has the bank balance "150regen"
+      And alice created a sell order with id "1"
+      When bob attempts to buy credits with sell order id "1"
+      Then expect event with properties
+      """
+      {
+        "sell_order_id": 1,
+        "buyer_fee": {
+          "denom": "regen",
+          "amount": "2"
+        },
+        "seller_fee": {
+          "denom": "regen",
+          "amount": "2"
+        },
+        "royalty": {
+          "denom": "regen",
+          "amount": "0"
+        }
+      }
+      """
+
+    Scenario: EventBuyDirect is emitted with a royalty
+      Given bob has the bank balance "150regen"
+      And alice created a sell order with id "1"
+      And the credit class has a royalty of "500" paid to carol
+      When bob attempts to buy credits with sell order id "1"
+      Then expect event with properties
+      """
+      {
+        "sell_order_id": 1,
+        "buyer_fee": {
+          "denom": "regen",
+          "amount": "0"
+        },
+        "seller_fee": {
+          "denom": "regen",
+          "amount": "0"
+        },
+        "royalty": {
+          "denom": "regen",
+          "amount": "5"
+        }
       }
       """
@@ -0,0 +1,380 @@
+Feature: Msg/Buy
+
+  A buy order can be created:
+    - when the credit batch exists
+    - when the credit class of the class selector exists
+    - when the project of the project selector exists
+    - when the bid denom is an allowed denom
+    - when the expiration is after the block time
+    - when the number of decimal places in quantity is less than or equal to the credit type precision
+    - when the buyer has a bank balance greater than or equal to the total bid amount
+    - the total bid amount is held in escrow by the ecocredit module account
+    - the buy order is a maker order when no sell orders are matched
+    - the buy order is a taker order when at least one sell order is matched
+    - the buy orders are stored in state
+    - the class and project selectors are stored in state
+    - the response includes the buy order ids
+
+  Rule: The credit batch must exist
+
+    Background:
+      Given a credit type
+      And an allowed denom
+      And bob has the bank balance "1000regen"
+
+    Scenario: credit batch exists
+      Given a credit batch with batch denom "C01-001-20200101-20210101-001"
+      When bob attempts to create a buy order with batch denom "C01-001-20200101-20210101-001"
+      Then expect no error
+
+    Scenario: credit batch does not exist
+      When bob attempts to create a buy order with batch denom "C01-001-20200101-20210101-001"
+      Then expect the error "orders[0]: batch denom C01-001-20200101-20210101-001: not found: invalid request"
+
+  Rule: The credit class of the class selector must exist
+
+    Background:
+      Given a credit type
+      And a credit batch with batch denom "C01-001-20200101-20210101-001"
+      And an allowed denom
+      And bob has the bank balance "1000regen"
+
+    Scenario: credit class exists
+      When bob attempts to create a buy order with the properties
+      """
+      {
+        "class_selector": {
+          "class_id": "C01"
+        },
+        "quantity": "10",
+        "bid_price": {
+          "denom": "regen",
+          "amount": "10"
+        }
+      }
+      """
+      Then expect no error
+
+    Scenario: credit class does not exist
+      When bob attempts to create a buy order with the properties
+      """
+      {
+        "class_selector": {
+          "class_id": "C02"
+        },
+        "quantity": "10",
+        "bid_price": {
+          "denom": "regen",
+          "amount": "10"
+        }
+      }
+      """
+      Then expect the error "orders[0]: class id C02: not found: invalid request"
+
+  Rule: The project of the project selector must exist
+
+    Background:
+      Given a credit type
+      And a credit batch with batch denom "C01-001-20200101-20210101-001"
+      And an allowed denom
+      And bob has the bank balance "1000regen"
+
+    Scenario: project exists
+      When bob attempts to create a buy order with the properties
+      """
+      {
+        "project_selector": {
+          "project_id": "C01-001"
+        },
+        "quantity": "10",
+        "bid_price": {
+          "denom": "regen",
+          "amount": "10"
+        }
+      }
+      """
+      Then expect no error
+
+    Scenario: project does not exist
+      When bob attempts to create a buy order with the properties
+      """
+      {
+        "project_selector": {
+          "project_id": "C01-002"
+        },
+        "quantity": "10",
+        "bid_price": {
+          "denom": "regen",
+          "amount": "10"
+        }
+      }
+      """
+      Then expect the error "orders[0]: project id C01-002: not found: invalid request"
+
+  Rule: The bid denom must be an allowed denom
+
+    Background:
+      Given a credit type
+      And a credit batch
+      And bob has the bank balance "1000regen"
+
+    Scenario: bid denom is allowed
+      Given an allowed denom with bank denom "regen"
+      When bob attempts to create a buy order with bid price "10regen"
+      Then expect no error
+
+    Scenario: bid denom is not allowed
+      Given an allowed denom with bank denom "atom"
+      When bob attempts to create a buy order with bid price "10regen"
+      Then expect the error "orders[0]: regen is not allowed to be used in buy orders: invalid request"
+
+  Rule: The expiration must be after the block time
+
+    Background:
+      Given a credit type
+      And a credit batch
+      And an allowed denom
+      And bob has the bank balance "1000regen"
+      And a block time with timestamp "2020-01-01"
+
+    Scenario: expiration is after block time
+      When bob attempts to create a buy order with expiration "2020-01-02"
+      Then expect no error
+
+    Scenario: expiration is equal to block time
+      When bob attempts to create a buy order with expiration "2020-01-01"
+      Then expect the error "orders[0]: expiration must be in the future: 2020-01-01 00:00:00 +0000 UTC: invalid request"
+
+    Scenario: expiration is before block time
+      When bob attempts to create a buy order with expiration "2019-12-31"
+      Then expect the error "orders[0]: expiration must be in the future: 2019-12-31 00:00:00 +0000 UTC: invalid request"
+
+  Rule: The number of decimal places in quantity must be less than or equal to the credit type precision
+
+    Background:
+      Given a credit type with precision "6"
+      And a credit batch
+      And an allowed denom
+      And bob has the bank balance "1000regen"
+
+    Scenario Outline: quantity decimal places less than or equal to precision
+      When bob attempts to create a buy order with quantity "<quantity>"
+      Then expect no error
+
+      Examples:
+        | description | quantity  |
+        | less than   | 9.12345   |
+        | equal to    | 9.123456  |
+
+    Scenario: quantity decimal places more than precision
+      When bob attempts to create a buy order with quantity "9.1234567"
+      Then expect the error "9.1234567 exceeds maximum decimal places: 6"
+
+  Rule: The buyer must have a bank balance greater than or equal to the total bid amount
+
+    Background:
+      Given a credit type
+      And a credit batch
+      And an allowed denom
+
+    Scenario Outline: buyer bank balance is greater than or equal to total bid amount
+      Given bob has the bank balance "<balance>"
+      When bob attempts to create a buy order with quantity "10" and bid price "10regen"
+      Then expect no error
+
+      Examples:
+        | description  | balance   |
+        | greater than | 150regen  |
+        | equal to     | 100regen  |
+
+    Scenario: buyer bank balance is less than total bid amount
+      Given bob has the bank balance "50regen"
+      When bob attempts to create a buy order with quantity "10" and bid price "10regen"
+      Then expect the error "orders[0]: quantity: 10, bid price: 10regen, total price: 100regen, bank balance: 50regen: insufficient funds"
+
+    Scenario: buyer bank balance is less than total bid amount (multiple buy orders)
+      Given bob has the bank balance "150regen"
+      When bob attempts to create two buy orders each with quantity "10" and bid price "10regen"
+      Then expect the error "orders[1]: quantity: 10, bid price: 10regen, total price: 100regen, bank balance: 50regen: insufficient funds"
+
+  Rule: The total bid amount is held in escrow
+
+    Background:
+      Given a credit type with precision "6"
+      And a credit batch
+      And an allowed denom
+      And bob has the bank balance "150regen"
+
+    Scenario: the total bid amount is escrowed
+      When bob attempts to create a buy order with quantity "10" and bid price "10regen"
+      Then expect bob bank balance "50regen"
+      And expect escrowed bank balance "100regen"
+
+    Scenario: the total bid amount is escrowed with a decimal quantity
+      When bob attempts to create a buy order with quantity "2.5" and bid price "10regen"
+      Then expect bob bank balance "125regen"
+      And expect escrowed bank balance "25regen"
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: The buy order is a taker order when sell orders are matched
+
+    Background:
+      Given a credit type
+      And a credit batch
+      And an allowed denom
+      And bob has the bank balance "1000regen"
+      And alice created a sell order with quantity "10" and ask price "10regen"
+
+    Scenario: the buy order is a maker order when the bid price is lower than the ask price
+      When bob attempts to create a buy order with quantity "10" and bid price "9regen"
+      Then expect buy order with id "1" and maker "true"
+
+    Scenario Outline: the buy order is a taker order when the bid price is greater than or equal to the ask price
+      When bob attempts to create a buy order with quantity "10" and bid price "<bid_price>"
+      Then expect buy order with id "1" and maker "false"
+
+      Examples:
+        | description  | bid_price |
+        | greater than | 11regen   |
+        | equal to     | 10regen   |
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: The buy order is stored in state
+
+    Background:
+      Given a credit type
+      And a credit batch
+      And an allowed denom
+      And bob has the bank balance "1000regen"
+
+    Scenario: the buy order is stored in state
+      When bob attempts to create a buy order with the properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "quantity": "10",
+        "bid_price": {
+          "denom": "regen",
+          "amount": "10"
+        },
+        "retirement_jurisdiction": "US-WA",
+        "expiration": "2030-01-01T00:00:00Z"
+      }
+      """
+      Then expect buy order with buyer bob and the properties
+      """
+      {
+        "id": 1,
+        "batch_key": 1,
+        "quantity": "10",
+        "market_id": 1,
+        "bid_amount": "10",
+        "retirement_jurisdiction": "US-WA",
+        "expiration": "2030-01-01T00:00:00Z",
+        "maker": true
+      }
+      """
+
+    Scenario: the buy order with a class selector is stored in state
+      When bob attempts to create a buy order with the properties
+      """
+      {
+        "class_selector": {
+          "class_id": "C01",
+          "project_location": "US",
+          "min_start_date": "2020-01-01T00:00:00Z",
+          "max_end_date": "2022-01-01T00:00:00Z"
+        },
+        "quantity": "10",
+        "bid_price": {
+          "denom": "regen",
+          "amount": "10"
+        },
+        "expiration": "2030-01-01T00:00:00Z"
+      }
+      """
+      Then expect buy order with buyer bob and the properties
+      """
+      {
+        "id": 1,
+        "class_key": 1,
+        "project_location": "US",
+        "min_start_date": "2020-01-01T00:00:00Z",
+        "max_end_date": "2022-01-01T00:00:00Z",
+        "quantity": "10",
+        "market_id": 1,
+        "bid_amount": "10",
+        "expiration": "2030-01-01T00:00:00Z",
+        "maker": true
+      }
+      """
+
+    Scenario: the buy order with a project selector is stored in state
+      When bob attempts to create a buy order with the properties
+      """
+      {
+        "project_selector": {
+          "project_id": "C01-001",
+          "max_end_date": "2022-01-01T00:00:00Z"
+        },
+        "quantity": "10",
+        "bid_price": {
+          "denom": "regen",
+          "amount": "10"
+        },
+        "expiration": "2030-01-01T00:00:00Z"
+      }
+      """
+      Then expect buy order with buyer bob and the properties
+      """
+      {
+        "id": 1,
+        "project_key": 1,
+        "max_end_date": "2022-01-01T00:00:00Z",
+        "quantity": "10",
+        "market_id": 1,
+        "bid_amount": "10",
+        "expiration": "2030-01-01T00:00:00Z",
+        "maker": true
+      }
+      """
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: The response includes the buy order ids
+
+    Background:
+      Given a credit type
+      And a credit batch
+      And an allowed denom
+      And bob has the bank balance "1000regen"
+
+    Scenario: the response includes the buy order ids
+      When bob attempts to create two buy orders each with quantity "10" and bid price "10regen"
+      Then expect the response
+      """
+      {
+        "buy_order_ids": [1, 2]
+      }
+      """
+
+    # no failing scenario - response should always be empty when message execution fails
+
+  Rule: Event is emitted
+
+    Background:
+      Given a credit type
+      And a credit batch
+      And an allowed denom
+      And bob has the bank balance "1000regen"
+
+    Scenario: EventBuyOrder is emitted
+      When bob attempts to create a buy order with quantity "10" and bid price "10regen"
+      Then expect event with properties
+      """
+      {
+        "buy_order_id": 1
+      }
+      """
@@ -0,0 +1,67 @@
+Feature: Msg/CancelBuyOrder
+
+  A buy order can be cancelled:
+    - when the buy order exists
+    - when the user is the owner of the buy order
+    - the escrowed funds are returned to the buyer
+    - the buy order is removed from state
+
+  Rule: The buy order must exist
+
+    Scenario: buy order exists
+      Given bob created a buy order with id "1"
+      When bob attempts to cancel the buy order with id "1"
+      Then expect no error
+
+    Scenario: buy order does not exist
+      When bob attempts to cancel the buy order with id "1"
+      Then expect the error "buy order with id 1: not found: invalid request"
+
+  Rule: The user must be the owner of the buy order
+
+    Scenario: user is the owner
+      Given bob created a buy order with id "1"
+      When bob attempts to cancel the buy order with id "1"
+      Then expect no error
+
+    Scenario: user is not the owner
+      Given bob created a buy order with id "1"
+      When alice attempts to cancel the buy order with id "1"
+      Then expect the error "buyer must be the owner of the buy order: unauthorized"
+
+  Rule: The escrowed funds are returned to the buyer
+
+    Scenario: the escrowed funds are returned to the buyer
+      Given bob created a buy order with id "1" and quantity "10"
+      When bob attempts to cancel the buy order with id "1"
+      Then expect bob bank balance "100regen"
+      And expect escrowed bank balance "0regen"
+
+    Scenario: the escrowed funds are returned to the buyer with a decimal quantity
+      Given bob created a buy order with id "1" and quantity "2.5"
+      When bob attempts to cancel the buy order with id "1"
+      Then expect bob bank balance "25regen"
+      And expect escrowed bank balance "0regen"
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: The buy order is removed from state
+
+    Scenario: the buy order is removed from state
+      Given bob created a buy order with id "1"
+      When bob attempts to cancel the buy order with id "1"
+      Then expect no buy order with id "1"
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: Event is emitted
+
+    Scenario: EventCancelBuyOrder is emitted
+      Given bob created a buy order with id "1"
+      When bob attempts to cancel the buy order with id "1"
+      Then expect event with properties
+      """
+      {
+        "buy_order_id": 1
+      }
+      """
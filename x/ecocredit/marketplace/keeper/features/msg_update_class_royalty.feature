@@ -0,0 +1,74 @@
+Feature: Msg/UpdateClassRoyalty
+
+  The royalty of a credit class can be updated:
+  - when the credit class exists
+  - when the admin is the admin of the credit class
+  - the royalty is set or removed
+  - an event is emitted
+
+  Background:
+    Given a credit class with id "C01" and admin alice
+
+  Rule: The credit class must exist
+
+    Scenario: the credit class exists
+      When alice attempts to update the royalty of class "C01" to "500" paid to bob
+      Then expect no error
+
+    Scenario: the credit class does not exist
+      When alice attempts to update the royalty of class "C02" to "500" paid to bob
+      Then expect the error "could not get credit class with id C02: not found: invalid request"
+
+  Rule: The admin must be the admin of the credit class
+
+    Scenario: the admin is the admin of the credit class
+      When alice attempts to update the royalty of class "C01" to "500" paid to bob
+      Then expect no error
+
+    Scenario: the admin is not the admin of the credit class
+      When bob attempts to update the royalty of class "C01" to "500" paid to bob
+      Then expect error contains "is not the admin of credit class C01: unauthorized"
+
+  Rule: The recipient must be allowed to receive funds
+
+    Scenario: the recipient is allowed to receive funds
+      When alice attempts to update the royalty of class "C01" to "500" paid to bob
+      Then expect no error
+
+    Scenario: the recipient is not allowed to receive funds
+      Given bob is not allowed to receive funds
+      When alice attempts to update the royalty of class "C01" to "500" paid to bob
+      Then expect error contains "is not allowed to receive funds: unauthorized"
+
+  Rule: The royalty is set or removed
+
+    Scenario: the royalty is set
+      When alice attempts to update the royalty of class "C01" to "500" paid to bob
+      Then expect class royalty of class "C01" is "500" paid to bob
+
+    Scenario: the royalty is updated
+      Given alice updated the royalty of class "C01" to "500" paid to bob
+      When alice attempts to update the royalty of class "C01" to "250" paid to alice
+      Then expect class royalty of class "C01" is "250" paid to alice
+
+    Scenario: the royalty is removed
+      Given alice updated the royalty of class "C01" to "500" paid to bob
+      When alice attempts to remove the royalty of class "C01"
+      Then expect no error
+      And expect no class royalty of class "C01"
+
+    Scenario: removing a royalty that does not exist
+      When alice attempts to remove the royalty of class "C01"
+      Then expect no error
+      And expect no class royalty of class "C01"
+
+  Rule: Event is emitted
+
+    Scenario: EventUpdateClassRoyalty is emitted
+      When alice attempts to update the royalty of class "C01" to "500" paid to bob
+      Then expect event with properties
+      """
+      {
+        "class_id": "C01"
+      }
+      """
@@ -0,0 +1,103 @@
+Feature: Msg/UpdateTradingFee
+
+  The trading fee can be updated:
+  - when the authority is a governance account address
+  - when the module account exists and is not the ecocredit module account
+  - the trading fee is updated
+
+  Rule: The authority must be governance account address
+
+    Scenario: The authority is a governance account address
+      When alice attempts to update the trading fee with properties
+      """
+      {
+        "authority":"regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "maker_fee_bps":100,
+        "taker_fee_bps":200,
+        "destination":"FEE_DESTINATION_BURN"
+      }
+      """
+      Then expect no error
+
+    Scenario: The authority is not a governance account address
+      When alice attempts to update the trading fee with properties
+      """
+      {
+        "authority":"regen1fua8speyxgempgy06gpfs0p4z32zznkqakm57s",
+        "maker_fee_bps":100,
+        "taker_fee_bps":200,
+        "destination":"FEE_DESTINATION_BURN"
+      }
+      """
+      Then expect error contains "expected gov account as only signer for proposal message"
+
+  Rule: The module account must exist and cannot be the ecocredit module account
+
+    Scenario: The module account exists
+      Given module account "fee_collector" exists
+      When alice attempts to update the trading fee with properties
+      """
+      {
+        "authority":"regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "maker_fee_bps":100,
+        "taker_fee_bps":200,
+        "destination":"FEE_DESTINATION_MODULE_ACCOUNT",
+        "module_account":"fee_collector"
+      }
+      """
+      Then expect no error
+
+    Scenario: The module account does not exist
+      When alice attempts to update the trading fee with properties
+      """
+      {
+        "authority":"regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "maker_fee_bps":100,
+        "taker_fee_bps":200,
+        "destination":"FEE_DESTINATION_MODULE_ACCOUNT",
+        "module_account":"foo"
+      }
+      """
+      Then expect the error "module account foo does not exist: invalid request"
+
+    Scenario: The module account is the ecocredit module account
+      When alice attempts to update the trading fee with properties
+      """
+      {
+        "authority":"regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "maker_fee_bps":100,
+        "taker_fee_bps":200,
+        "destination":"FEE_DESTINATION_MODULE_ACCOUNT",
+        "module_account":"ecocredit"
+      }
+      """
+      Then expect the error "trading fees cannot be sent to the ecocredit module account: invalid request"
+
+  Rule: The trading fee is updated
+
+    Scenario: The trading fee is updated
+      Given the trading fee with properties
+      """
+      {
+        "maker_fee_bps":100,
+        "taker_fee_bps":100,
+        "destination":"FEE_DESTINATION_BURN"
+      }
+      """
+      When alice attempts to update the trading fee with properties
+      """
+      {
+        "authority":"regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "maker_fee_bps":50,
+        "taker_fee_bps":250,
+        "destination":"FEE_DESTINATION_COMMUNITY_POOL"
+      }
+      """
+      Then expect trading fee with properties
+      """
+      {
+        "maker_fee_bps":50,
+        "taker_fee_bps":250,
+        "destination":"FEE_DESTINATION_COMMUNITY_POOL"
+      }
+      """
@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/mocks"
+)
+
+func TestHooks_BuyDirect(t *testing.T) {
+	t.Parallel()
+	s := setupProcessBuyOrders(t)
+	hooks := &mocks.Hooks{}
+	s.k.SetHooks(hooks)
+
+	sellOrderID := s.sell("10", 8, false)
+	bidPrice := sdk.NewInt64Coin(ask.Denom, 8)
+	_, err := s.k.BuyDirect(s.ctx, &types.MsgBuyDirect{
+		Buyer: s.buyer.String(),
+		Orders: []*types.MsgBuyDirect_Order{
+			{
+				SellOrderId:            sellOrderID,
+				Quantity:               "3",
+				BidPrice:               &bidPrice,
+				RetirementJurisdiction: "US-WA",
+				RetirementReason:       "offsetting electricity consumption",
+				RetirementBeneficiary:  "Regen Network",
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		fmt.Sprintf("transferred %s %s %s 0 3", batchDenom, s.seller, s.buyer),
+		fmt.Sprintf(`retired %s %s 3 1 US-WA "offsetting electricity consumption" "Regen Network"`, batchDenom, s.buyer),
+	}, hooks.Calls)
+}
+
+func TestHooks_BuyDirectError(t *testing.T) {
+	t.Parallel()
+	s := setupProcessBuyOrders(t)
+	s.k.SetHooks(&mocks.Hooks{Err: fmt.Errorf("hook failed")})
+
+	// an error returned by a hook fails the message
+	sellOrderID := s.sell("10", 8, true)
+	bidPrice := sdk.NewInt64Coin(ask.Denom, 8)
+	_, err := s.k.BuyDirect(s.ctx, &types.MsgBuyDirect{
+		Buyer: s.buyer.String(),
+		Orders: []*types.MsgBuyDirect_Order{
+			{
+				SellOrderId:       sellOrderID,
+				Quantity:          "3",
+				BidPrice:          &bidPrice,
+				DisableAutoRetire: true,
+			},
+		},
+	})
+	require.EqualError(t, err, "hook failed")
+}
+
+func TestHooks_ProcessBuyOrders(t *testing.T) {
+	t.Parallel()
+	s := setupProcessBuyOrders(t)
+	hooks := &mocks.Hooks{}
+	s.k.SetHooks(hooks)
+
+	s.sell("10", 8, true)
+	s.buy(s.buyer, "4", 10, true)
+
+	require.NoError(t, s.k.ProcessBuyOrders(s.ctx))
+	require.Equal(t, []string{
+		fmt.Sprintf("transferred %s %s %s 4 0", batchDenom, s.seller, s.buyer),
+	}, hooks.Calls)
+}
+
+func TestHooks_ProcessBuyOrdersError(t *testing.T) {
+	t.Parallel()
+	s := setupProcessBuyOrders(t)
+	s.k.SetHooks(&mocks.Hooks{Err: fmt.Errorf("hook failed")})
+
+	sellOrderID := s.sell("10", 8, false)
+	buyOrderID := s.buy(s.buyer, "10", 10, false)
+
+	// an error returned by a hook at the end of a block is logged and the
+	// buy order is filled
+	require.NoError(t, s.k.ProcessBuyOrders(s.ctx))
+
+	s.assertNoBuyOrder(buyOrderID)
+	s.assertNoSellOrder(sellOrderID)
+	s.assertBankBalance(s.seller.String(), 80)
+	s.assertBankBalance(ecocredit.ModuleName, 0)
+	require.Equal(t, "10", s.batchBalance(s.buyer).RetiredAmount)
+}
+
+func TestHooks_SettleAuctionsError(t *testing.T) {
+	t.Parallel()
+	s, balances := setupAuction(t)
+	seller, bidder := s.addrs[0], s.addrs[1]
+	balances[bidder.String()] = sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 2000))
+	hooks := &mocks.Hooks{Err: fmt.Errorf("hook failed")}
+	s.k.SetHooks(hooks)
+
+	id := s.createAuction(types.AuctionType_AUCTION_TYPE_ENGLISH, false)
+	require.NoError(t, s.bidAuction(bidder, id, 120))
+
+	// an error returned by a hook at the beginning of a block is logged and
+	// the auction is settled
+	s.setBlockTime(auctionStart.Add(10 * time.Hour))
+	require.NoError(t, s.k.SettleAuctions(s.ctx))
+
+	require.Equal(t, []string{
+		fmt.Sprintf("transferred %s %s %s 0 10", batchDenom, seller, bidder),
+	}, hooks.Calls)
+	_, err := s.marketStore.AuctionTable().Get(s.ctx, id)
+	require.Error(t, err)
+	require.Equal(t, sdk.NewInt64Coin(ask.Denom, 1200).String(), sdk.NewCoin(ask.Denom, balances[seller.String()].AmountOf(ask.Denom)).String())
+}
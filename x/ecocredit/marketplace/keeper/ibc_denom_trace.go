@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibctransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+)
+
+// ibcDenomPrefix is the prefix of IBC denoms (ex. ibc/{hash}).
+const ibcDenomPrefix = ibctransfertypes.DenomPrefix + "/"
+
+// isIBCDenom returns whether the denom is an IBC denom.
+func isIBCDenom(denom string) bool {
+	return strings.HasPrefix(denom, ibcDenomPrefix)
+}
+
+// getDenomTrace returns the denom trace of the IBC denom from the transfer
+// keeper. An error is returned if the denom trace does not exist, i.e. the
+// denom has never been received over IBC.
+func (k Keeper) getDenomTrace(ctx sdk.Context, denom string) (ibctransfertypes.DenomTrace, error) {
+	hash, err := ibctransfertypes.ParseHexHash(strings.TrimPrefix(denom, ibcDenomPrefix))
+	if err != nil {
+		return ibctransfertypes.DenomTrace{}, sdkerrors.ErrInvalidRequest.Wrapf("ibc denom %s: invalid hash: %s", denom, err)
+	}
+
+	trace, found := k.transferKeeper.GetDenomTrace(ctx, hash)
+	if !found {
+		return ibctransfertypes.DenomTrace{}, sdkerrors.ErrNotFound.Wrapf("denom trace for ibc denom %s", denom)
+	}
+
+	// the hash is decoded case-insensitively but bank denoms are case-sensitive
+	if trace.IBCDenom() != denom {
+		return ibctransfertypes.DenomTrace{}, sdkerrors.ErrInvalidRequest.Wrapf(
+			"ibc denom %s does not match the denom of its denom trace %s", denom, trace.IBCDenom(),
+		)
+	}
+
+	return trace, nil
+}
+
+// getOriginChainID returns the chain id of the chain the denom with the IBC
+// denom trace path originates from. The chain id can only be resolved when the
+// path consists of a single hop, in which case the origin chain is the
+// counterparty chain of the channel, otherwise an empty string is returned.
+func (k Keeper) getOriginChainID(ctx sdk.Context, path string) (string, error) {
+	identifiers := strings.Split(path, "/")
+	if len(identifiers) != 2 {
+		return "", nil
+	}
+
+	_, clientState, err := k.channelKeeper.GetChannelClientState(ctx, identifiers[0], identifiers[1])
+	if err != nil {
+		return "", err
+	}
+
+	// the chain id is not part of the client state interface but is provided
+	// by the client states of the light clients supported by the chain
+	if cs, ok := clientState.(interface{ GetChainID() string }); ok {
+		return cs.GetChainID(), nil
+	}
+
+	return "", nil
+}
@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+func (k Keeper) RegisterInvariants(ir sdk.InvariantRegistry) {
+	ir.RegisterRoute(ecocredit.ModuleName, "escrowed-credits", k.escrowedCreditsInvariant())
+}
+
+func (k Keeper) escrowedCreditsInvariant() sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg, broken := EscrowedCreditsInvariant(sdk.WrapSDKContext(ctx), k)
+		return sdk.FormatInvariant(ecocredit.ModuleName, "escrowed-credits", msg), broken
+	}
+}
+
+// escrowKey identifies the escrowed balance of an account for a credit batch.
+type escrowKey struct {
+	account  string
+	batchKey uint64
+}
+
+// EscrowedCreditsInvariant checks
+// - the escrowed balance of each account and credit batch matches the credits
+// held in the sell orders, auctions and swaps of the account
+func EscrowedCreditsInvariant(ctx context.Context, k Keeper) (msg string, broken bool) {
+	escrowed := make(map[escrowKey]math.Dec)
+	var keys []escrowKey
+
+	addEscrow := func(name string, id uint64, account []byte, batchKey uint64, quantity string) {
+		amount, err := math.NewNonNegativeDecFromString(quantity)
+		if err != nil {
+			broken = true
+			msg += fmt.Sprintf("\terror while parsing quantity of %s %d: %v\n", name, id, err)
+			return
+		}
+
+		key := escrowKey{account: string(account), batchKey: batchKey}
+		if total, ok := escrowed[key]; ok {
+			if amount, err = math.SafeAddBalance(total, amount); err != nil {
+				broken = true
+				msg += fmt.Sprintf("\terror adding quantity of %s %d: %v\n", name, id, err)
+				return
+			}
+		} else {
+			keys = append(keys, key)
+		}
+		escrowed[key] = amount
+	}
+
+	sellOrderIt, err := k.stateStore.SellOrderTable().List(ctx, api.SellOrderPrimaryKey{})
+	if err != nil {
+		return err.Error(), true
+	}
+	defer sellOrderIt.Close()
+
+	for sellOrderIt.Next() {
+		sellOrder, err := sellOrderIt.Value()
+		if err != nil {
+			return err.Error(), true
+		}
+		addEscrow("sell order", sellOrder.Id, sellOrder.Seller, sellOrder.BatchKey, sellOrder.Quantity)
+	}
+
+	auctionIt, err := k.stateStore.AuctionTable().List(ctx, api.AuctionPrimaryKey{})
+	if err != nil {
+		return err.Error(), true
+	}
+	defer auctionIt.Close()
+
+	for auctionIt.Next() {
+		auction, err := auctionIt.Value()
+		if err != nil {
+			return err.Error(), true
+		}
+		addEscrow("auction", auction.Id, auction.Seller, auction.BatchKey, auction.Quantity)
+	}
+
+	swapIt, err := k.stateStore.SwapTable().List(ctx, api.SwapPrimaryKey{})
+	if err != nil {
+		return err.Error(), true
+	}
+	defer swapIt.Close()
+
+	for swapIt.Next() {
+		swap, err := swapIt.Value()
+		if err != nil {
+			return err.Error(), true
+		}
+		addEscrow("swap", swap.Id, swap.Proposer, swap.OfferBatchKey, swap.OfferQuantity)
+	}
+
+	balanceIt, err := k.baseStore.BatchBalanceTable().List(ctx, baseapi.BatchBalancePrimaryKey{})
+	if err != nil {
+		return msg + err.Error(), true
+	}
+	defer balanceIt.Close()
+
+	checked := make(map[escrowKey]bool)
+	for balanceIt.Next() {
+		balance, err := balanceIt.Value()
+		if err != nil {
+			return err.Error(), true
+		}
+
+		key := escrowKey{account: string(balance.Address), batchKey: balance.BatchKey}
+		checked[key] = true
+
+		actual, err := math.NewNonNegativeDecFromString(balance.EscrowedAmount)
+		if err != nil {
+			broken = true
+			msg += fmt.Sprintf("\terror while parsing escrowed balance of %s for %d credit batch: %v\n",
+				sdk.AccAddress(balance.Address), balance.BatchKey, err)
+			continue
+		}
+
+		expected, ok := escrowed[key]
+		if !ok {
+			expected = math.NewDecFromInt64(0)
+		}
+		if expected.Cmp(actual) != math.EqualTo {
+			broken = true
+			msg += fmt.Sprintf("\tescrowed balance of %s is incorrect for %d credit batch, expected %v, got %v\n",
+				sdk.AccAddress(balance.Address), balance.BatchKey, expected, actual)
+		}
+	}
+
+	for _, key := range keys {
+		if !checked[key] {
+			broken = true
+			msg += fmt.Sprintf("\tescrowed balance of %s is not found for %d credit batch\n",
+				sdk.AccAddress(key.account), key.batchKey)
+		}
+	}
+
+	return msg, broken
+}
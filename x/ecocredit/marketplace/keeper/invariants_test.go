@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+)
+
+func TestEscrowedCreditsInvariant(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		msg        string
+		balances   []*baseapi.BatchBalance
+		sellOrders []*api.SellOrder
+		auctions   []*api.Auction
+		swaps      []*api.Swap
+		expBroken  bool
+	}{
+		{
+			msg: "valid sell orders, auctions and swaps",
+			balances: []*baseapi.BatchBalance{
+				{BatchKey: 1, TradableAmount: "10", EscrowedAmount: "17.5"},
+				{BatchKey: 2, TradableAmount: "10", EscrowedAmount: "3"},
+			},
+			sellOrders: []*api.SellOrder{
+				{BatchKey: 1, Quantity: "10"},
+				{BatchKey: 1, Quantity: "2.5"},
+			},
+			auctions: []*api.Auction{
+				{BatchKey: 1, Quantity: "5"},
+			},
+			swaps: []*api.Swap{
+				{OfferBatchKey: 2, OfferQuantity: "3", AskBatchKey: 1, AskQuantity: "100"},
+			},
+		},
+		{
+			msg: "valid no escrowed credits",
+			balances: []*baseapi.BatchBalance{
+				{BatchKey: 1, TradableAmount: "10", EscrowedAmount: "0"},
+			},
+		},
+		{
+			msg: "fail with escrowed balance without sell order",
+			balances: []*baseapi.BatchBalance{
+				{BatchKey: 1, TradableAmount: "10", EscrowedAmount: "1"},
+			},
+			expBroken: true,
+		},
+		{
+			msg: "fail with escrowed balance less than sell orders",
+			balances: []*baseapi.BatchBalance{
+				{BatchKey: 1, TradableAmount: "10", EscrowedAmount: "10"},
+			},
+			sellOrders: []*api.SellOrder{
+				{BatchKey: 1, Quantity: "10"},
+			},
+			auctions: []*api.Auction{
+				{BatchKey: 1, Quantity: "5"},
+			},
+			expBroken: true,
+		},
+		{
+			msg: "fail with escrowed balance not found",
+			swaps: []*api.Swap{
+				{OfferBatchKey: 1, OfferQuantity: "3", AskBatchKey: 2, AskQuantity: "3"},
+			},
+			expBroken: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.msg, func(t *testing.T) {
+			t.Parallel()
+			s := setupBase(t, 1)
+			owner := s.addrs[0]
+
+			for _, balance := range tc.balances {
+				balance.Address = owner
+				assert.NilError(t, s.baseStore.BatchBalanceTable().Insert(s.ctx, balance))
+			}
+			for _, sellOrder := range tc.sellOrders {
+				sellOrder.Seller = owner
+				assert.NilError(t, s.marketStore.SellOrderTable().Insert(s.ctx, sellOrder))
+			}
+			for _, auction := range tc.auctions {
+				auction.Seller = owner
+				assert.NilError(t, s.marketStore.AuctionTable().Insert(s.ctx, auction))
+			}
+			for _, swap := range tc.swaps {
+				swap.Proposer = owner
+				assert.NilError(t, s.marketStore.SwapTable().Insert(s.ctx, swap))
+			}
+
+			msg, broken := EscrowedCreditsInvariant(s.ctx, s.k)
+			assert.Equal(t, tc.expBroken, broken, msg)
+		})
+	}
+}
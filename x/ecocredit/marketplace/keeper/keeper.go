@@ -1,12 +1,15 @@
 package keeper
 
 import (
+	"github.com/tendermint/tendermint/libs/log"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	marketapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
 	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/orderbook"
 )
 
 var (
@@ -14,21 +17,77 @@ var (
 	_ types.QueryServer = Keeper{}
 )
 
+// DefaultSellOrderPruneLimit is the maximum number of expired sell orders
+// pruned in a single block.
+const DefaultSellOrderPruneLimit = 1000
+
+// DefaultPruneLimit is the maximum number of expired buy orders, offers, swaps
+// and forward contracts (each) pruned in a single block.
+const DefaultPruneLimit = 1000
+
+// DefaultAuctionSettleLimit is the maximum number of ended auctions settled in
+// a single block.
+const DefaultAuctionSettleLimit = 100
+
+// DefaultBuyOrderMatchLimit is the maximum number of matched buy orders and
+// sell orders processed in a single block.
+const DefaultBuyOrderMatchLimit = 1000
+
 type Keeper struct {
-	stateStore   marketapi.StateStore
-	baseStore    baseapi.StateStore
-	bankKeeper   ecocredit.BankKeeper
-	paramsKeeper ecocredit.ParamKeeper
-	authority    sdk.AccAddress
+	stateStore     marketapi.StateStore
+	baseStore      baseapi.StateStore
+	orderBook      orderbook.OrderBook
+	accountKeeper  ecocredit.AccountKeeper
+	bankKeeper     ecocredit.BankKeeper
+	distrKeeper    ecocredit.DistributionKeeper
+	transferKeeper ecocredit.TransferKeeper
+	channelKeeper  ecocredit.ChannelKeeper
+	paramsKeeper   ecocredit.ParamKeeper
+	authority      sdk.AccAddress
+
+	// sellOrderPruneLimit is the maximum number of expired sell orders pruned
+	// in a single block.
+	sellOrderPruneLimit int
+
+	// buyOrderMatchLimit is the maximum number of matched buy orders and sell
+	// orders processed in a single block.
+	buyOrderMatchLimit int
+
+	// pruneLimit is the maximum number of expired buy orders, offers, swaps and
+	// forward contracts (each) pruned in a single block.
+	pruneLimit int
+
+	// auctionSettleLimit is the maximum number of ended auctions settled in a
+	// single block.
+	auctionSettleLimit int
+
+	// hooks are called when credits are moved. If nil, no hooks are called.
+	hooks ecocredit.KeeperHooks
 }
 
-func NewKeeper(ss marketapi.StateStore, cs baseapi.StateStore, bk ecocredit.BankKeeper,
+func NewKeeper(ss marketapi.StateStore, cs baseapi.StateStore, ob orderbook.OrderBook, ak ecocredit.AccountKeeper,
+	bk ecocredit.BankKeeper, dk ecocredit.DistributionKeeper, tk ecocredit.TransferKeeper, chk ecocredit.ChannelKeeper,
 	params ecocredit.ParamKeeper, authority sdk.AccAddress) Keeper {
 	return Keeper{
-		baseStore:    cs,
-		stateStore:   ss,
-		bankKeeper:   bk,
-		paramsKeeper: params,
-		authority:    authority,
+		baseStore:      cs,
+		stateStore:     ss,
+		orderBook:      ob,
+		accountKeeper:  ak,
+		bankKeeper:     bk,
+		distrKeeper:    dk,
+		transferKeeper: tk,
+		channelKeeper:  chk,
+		paramsKeeper:   params,
+		authority:      authority,
+
+		sellOrderPruneLimit: DefaultSellOrderPruneLimit,
+		buyOrderMatchLimit:  DefaultBuyOrderMatchLimit,
+		auctionSettleLimit:  DefaultAuctionSettleLimit,
+		pruneLimit:          DefaultPruneLimit,
 	}
 }
+
+// logger returns the logger of the module.
+func logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+ecocredit.ModuleName)
+}
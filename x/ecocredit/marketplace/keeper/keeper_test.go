@@ -14,19 +14,20 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/orm/model/ormdb"
-	"github.com/cosmos/cosmos-sdk/orm/model/ormtable"
-	"github.com/cosmos/cosmos-sdk/orm/testing/ormtest"
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
 	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/types/ormstore"
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 	basetypes "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
 	"github.com/regen-network/regen-ledger/x/ecocredit/mocks"
+	"github.com/regen-network/regen-ledger/x/ecocredit/orderbook"
 	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
 )
 
@@ -36,49 +37,78 @@ const (
 )
 
 type baseSuite struct {
-	t            gocuke.TestingT
-	db           ormdb.ModuleDB
-	baseStore    baseapi.StateStore
-	marketStore  api.StateStore
-	ctx          context.Context
-	k            Keeper
-	ctrl         *gomock.Controller
-	addrs        []sdk.AccAddress
-	bankKeeper   *mocks.MockBankKeeper
-	paramsKeeper *mocks.MockParamKeeper
-	storeKey     *storetypes.KVStoreKey
-	sdkCtx       sdk.Context
+	t              gocuke.TestingT
+	db             ormdb.ModuleDB
+	baseStore      baseapi.StateStore
+	marketStore    api.StateStore
+	orderBook      orderbook.OrderBook
+	ctx            context.Context
+	k              Keeper
+	ctrl           *gomock.Controller
+	addrs          []sdk.AccAddress
+	accountKeeper  *mocks.MockAccountKeeper
+	bankKeeper     *mocks.MockBankKeeper
+	distrKeeper    *mocks.MockDistributionKeeper
+	transferKeeper *mocks.MockTransferKeeper
+	channelKeeper  *mocks.MockChannelKeeper
+	paramsKeeper   *mocks.MockParamKeeper
+	storeKey       *storetypes.KVStoreKey
+	memStoreKey    *storetypes.MemoryStoreKey
+	sdkCtx         sdk.Context
+
+	// blockedAddrs are the addresses that are not allowed to receive funds
+	blockedAddrs map[string]bool
 }
 
 func setupBase(t gocuke.TestingT, numAddresses int) *baseSuite {
 	// prepare database
 	s := &baseSuite{t: t}
-	var err error
-	s.db, err = ormdb.NewModuleDB(&ecocredit.ModuleSchema, ormdb.ModuleDBOptions{})
-	assert.NilError(t, err)
-	s.baseStore, err = baseapi.NewStateStore(s.db)
-	assert.NilError(t, err)
-	s.marketStore, err = api.NewStateStore(s.db)
-	assert.NilError(t, err)
-
+	// the state is backed by the multistore so that fills processed in a
+	// cache context are discarded when they fail
 	db := dbm.NewMemDB()
 	cms := store.NewCommitMultiStore(db)
 	s.storeKey = sdk.NewKVStoreKey("test")
+	s.memStoreKey = storetypes.NewMemoryStoreKey("test_mem")
 	cms.MountStoreWithDB(s.storeKey, storetypes.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(s.memStoreKey, storetypes.StoreTypeMemory, nil)
 	assert.NilError(t, cms.LoadLatestVersion())
-	ormCtx := ormtable.WrapContextDefault(ormtest.NewMemoryBackend())
-	s.sdkCtx = sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger()).WithContext(ormCtx)
+	s.sdkCtx = sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger())
 	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
 
+	var err error
+	s.db, err = ormstore.NewStoreKeyDB(&ecocredit.ModuleSchema, s.storeKey, ormdb.ModuleDBOptions{})
+	assert.NilError(t, err)
+	s.baseStore, err = baseapi.NewStateStore(s.db)
+	assert.NilError(t, err)
+	s.marketStore, err = api.NewStateStore(s.db)
+	assert.NilError(t, err)
+
 	// setup test keeper
 	s.ctrl = gomock.NewController(t)
 	assert.NilError(t, err)
+	s.accountKeeper = mocks.NewMockAccountKeeper(s.ctrl)
 	s.bankKeeper = mocks.NewMockBankKeeper(s.ctrl)
+	s.distrKeeper = mocks.NewMockDistributionKeeper(s.ctrl)
+	s.transferKeeper = mocks.NewMockTransferKeeper(s.ctrl)
+	s.channelKeeper = mocks.NewMockChannelKeeper(s.ctrl)
 	s.paramsKeeper = mocks.NewMockParamKeeper(s.ctrl)
 
+	s.blockedAddrs = make(map[string]bool)
+	s.bankKeeper.EXPECT().
+		BlockedAddr(gomock.Any()).
+		DoAndReturn(func(addr sdk.AccAddress) bool {
+			return s.blockedAddrs[addr.String()]
+		}).
+		AnyTimes()
+
 	authority, err := sdk.AccAddressFromBech32("regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68")
 	assert.NilError(s.t, err)
-	s.k = NewKeeper(s.marketStore, s.baseStore, s.bankKeeper, s.paramsKeeper, authority)
+	memDB, err := ormstore.NewStoreKeyDB(&orderbook.ModuleSchema, s.memStoreKey, ormdb.ModuleDBOptions{})
+	assert.NilError(t, err)
+	s.orderBook, err = orderbook.NewOrderBook(memDB, s.marketStore, s.baseStore)
+	assert.NilError(t, err)
+	s.k = NewKeeper(s.marketStore, s.baseStore, s.orderBook, s.accountKeeper, s.bankKeeper, s.distrKeeper, s.transferKeeper, s.channelKeeper,
+		s.paramsKeeper, authority)
 
 	// set test accounts
 	for i := 0; i < numAddresses; i++ {
@@ -124,6 +154,12 @@ func (s *baseSuite) testSellSetup(batchDenom, bankDenom, displayDenom, classID s
 		Metadata:         "",
 		CreditTypeAbbrev: creditType.Abbreviation,
 	}))
+	assert.NilError(s.t, s.baseStore.ProjectTable().Insert(s.ctx, &baseapi.Project{
+		Id:           classID + "-001",
+		Admin:        s.addrs[0],
+		ClassKey:     1,
+		Jurisdiction: "US-OR",
+	}))
 	assert.NilError(s.t, s.baseStore.BatchTable().Insert(s.ctx, &baseapi.Batch{
 		ProjectKey: 1,
 		Denom:      batchDenom,
@@ -156,3 +192,85 @@ func (s *baseSuite) testSellSetup(batchDenom, bankDenom, displayDenom, classID s
 		CancelledAmount: "0",
 	}))
 }
+
+// setBatchSuspended sets whether the credit batch with the given key is suspended.
+func (s *baseSuite) setBatchSuspended(batchKey uint64, suspended bool) {
+	batch, err := s.baseStore.BatchTable().Get(s.ctx, batchKey)
+	assert.NilError(s.t, err)
+	batch.Suspended = suspended
+	assert.NilError(s.t, s.baseStore.BatchTable().Update(s.ctx, batch))
+}
+
+// mockBankBalances sets up the bank keeper mock to track the bank balances of
+// accounts and module accounts in memory. The returned map is keyed by account
+// address and module accounts are keyed by module name.
+func (s *baseSuite) mockBankBalances() map[string]sdk.Coins {
+	balances := make(map[string]sdk.Coins)
+
+	s.bankKeeper.EXPECT().
+		GetBalance(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+			return sdk.NewCoin(denom, balances[addr.String()].AmountOf(denom))
+		}).
+		AnyTimes()
+
+	s.bankKeeper.EXPECT().
+		SendCoins(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ sdk.Context, from, to sdk.AccAddress, coins sdk.Coins) error {
+			return sendCoins(balances, from.String(), to.String(), coins)
+		}).
+		AnyTimes()
+
+	s.bankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ sdk.Context, from sdk.AccAddress, module string, coins sdk.Coins) error {
+			return sendCoins(balances, from.String(), module, coins)
+		}).
+		AnyTimes()
+
+	s.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ sdk.Context, module string, to sdk.AccAddress, coins sdk.Coins) error {
+			if s.blockedAddrs[to.String()] {
+				return sdkerrors.ErrUnauthorized.Wrapf("%s is not allowed to receive funds", to)
+			}
+			return sendCoins(balances, module, to.String(), coins)
+		}).
+		AnyTimes()
+
+	s.bankKeeper.EXPECT().
+		SendCoinsFromModuleToModule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ sdk.Context, from, to string, coins sdk.Coins) error {
+			return sendCoins(balances, from, to, coins)
+		}).
+		AnyTimes()
+
+	// burned coins are tracked as sent to "burn"
+	s.bankKeeper.EXPECT().
+		BurnCoins(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ sdk.Context, module string, coins sdk.Coins) error {
+			return sendCoins(balances, module, "burn", coins)
+		}).
+		AnyTimes()
+
+	return balances
+}
+
+// setTradingFee sets the trading fee burning the fees charged.
+func (s *baseSuite) setTradingFee(makerFeeBps, takerFeeBps uint32) {
+	assert.NilError(s.t, s.marketStore.TradingFeeTable().Save(s.ctx, &api.TradingFee{
+		MakerFeeBps: makerFeeBps,
+		TakerFeeBps: takerFeeBps,
+		Destination: api.FeeDestination_FEE_DESTINATION_BURN,
+	}))
+}
+
+func sendCoins(balances map[string]sdk.Coins, from, to string, coins sdk.Coins) error {
+	remaining, hasNeg := balances[from].SafeSub(coins...)
+	if hasNeg {
+		return sdkerrors.ErrInsufficientFunds.Wrapf("%s is smaller than %s", balances[from], coins)
+	}
+	balances[from] = remaining
+	balances[to] = balances[to].Add(coins...)
+	return nil
+}
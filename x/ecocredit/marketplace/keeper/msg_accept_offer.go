@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// AcceptOffer accepts an offer, transferring the credits from the sell order to
+// the buyer and the escrowed funds to the seller. The seller accepts an offer at
+// the bid amount and the buyer accepts a counter offer at the counter amount,
+// in which case the buyer pays the difference between the bid amount and the
+// counter amount.
+func (k Keeper) AcceptOffer(ctx context.Context, req *types.MsgAcceptOffer) (*types.MsgAcceptOfferResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	senderAcc, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	offer, err := k.stateStore.OfferTable().Get(ctx, req.OfferId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("offer with id %d: %s", req.OfferId, err.Error())
+	}
+
+	sellOrder, err := k.stateStore.SellOrderTable().Get(ctx, offer.SellOrderId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("sell order with id %d: %s", offer.SellOrderId, err.Error())
+	}
+
+	if err = checkOfferResponder(offer, sellOrder, senderAcc); err != nil {
+		return nil, err
+	}
+
+	// the seller may have enabled auto-retire since the offer was made
+	if offer.DisableAutoRetire && !sellOrder.DisableAutoRetire {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("cannot disable auto-retire for a sell order with auto-retire enabled")
+	}
+
+	market, err := k.stateStore.MarketTable().Get(ctx, offer.MarketId)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := offer.BidAmount
+	if offer.CounterAmount != "" {
+		amount = offer.CounterAmount
+	}
+	price, err := parseAmount(amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// the buyer pays the difference when accepting a counter offer
+	if err = k.updateOfferEscrow(ctx, offer, price, market.BankDenom); err != nil {
+		return nil, err
+	}
+
+	cost, err := getOfferCost(offer, price, market.BankDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	quantity, err := math.NewDecFromString(offer.Quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := k.baseStore.BatchTable().Get(ctx, sellOrder.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+	if err = utils.AssertBatchNotSuspended(batch); err != nil {
+		return nil, err
+	}
+
+	// the party accepting the offer is charged the taker fee and the buyer fee
+	// is paid from the trading fee held in escrow with the offer
+	opts := orderOptions{
+		autoRetire:   !offer.DisableAutoRetire,
+		batchDenom:   batch.Denom,
+		jurisdiction: offer.RetirementJurisdiction,
+		reason:       offer.RetirementReason,
+		beneficiary:  offer.RetirementBeneficiary,
+		fromEscrow:   true,
+		escrowedFee:  getTradingFee(cost.Amount, offer.EscrowedFeeBps),
+		buyerMaker:   senderAcc.Equals(sdk.AccAddress(sellOrder.Seller)),
+		sellerMaker:  senderAcc.Equals(sdk.AccAddress(offer.Buyer)),
+	}
+	// the offer is deleted before the credits are transferred so that the
+	// offer is not rejected with the other offers if the sell order is filled
+	if err = k.stateStore.OfferTable().Delete(ctx, offer); err != nil {
+		return nil, err
+	}
+
+	offerIndex := fmt.Sprintf("offer %d", offer.Id)
+	if err = k.transferCredits(ctx, offerIndex, sellOrder, offer.Buyer, quantity, opts); err != nil {
+		return nil, err
+	}
+
+	// the trade is recorded in the market of the offer, which is the market of
+	// one of the ask prices of the sell order
+	res, err := k.settleTrade(ctx, offer.Buyer, sellOrder.Seller, sellOrder.BatchKey, offer.MarketId, quantity,
+		amount, cost, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	pricePerCredit := sdk.NewCoin(market.BankDenom, price)
+	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventAcceptOffer{
+		OfferId:   offer.Id,
+		Price:     &pricePerCredit,
+		Royalty:   &res.royalty,
+		BuyerFee:  &res.buyerFee,
+		SellerFee: &res.sellerFee,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAcceptOfferResponse{}, nil
+}
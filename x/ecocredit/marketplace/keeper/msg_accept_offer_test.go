@@ -0,0 +1,297 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+func TestAcceptOffer_Seller(t *testing.T) {
+	t.Parallel()
+	s, balances, sellOrderID := setupOffer(t)
+	seller, buyer := s.addrs[0], s.addrs[1]
+
+	// a royalty of 10% is paid to the third test account
+	royaltyRecipient := s.addrs[2]
+	assert.NilError(t, s.marketStore.ClassRoyaltyTable().Insert(s.ctx, &api.ClassRoyalty{
+		ClassKey:   1,
+		RoyaltyBps: 1_000,
+		Recipient:  royaltyRecipient,
+	}))
+
+	id, err := s.makeOffer(sellOrderID, 80)
+	assert.NilError(t, err)
+
+	// the buyer cannot accept an offer awaiting a response from the seller
+	_, err = s.k.AcceptOffer(s.ctx, &types.MsgAcceptOffer{Sender: buyer.String(), OfferId: id})
+	assert.ErrorContains(t, err, "is not the seller of sell order 1")
+
+	_, err = s.k.AcceptOffer(s.ctx, &types.MsgAcceptOffer{Sender: seller.String(), OfferId: id})
+	assert.NilError(t, err)
+
+	_, err = s.marketStore.OfferTable().Get(s.ctx, id)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+	_, err = s.marketStore.SellOrderTable().Get(s.ctx, sellOrderID)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	// the escrowed funds are paid to the seller minus the royalty
+	assert.Equal(t, int64(1200), balances[buyer.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(720), balances[seller.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(80), balances[royaltyRecipient.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(0), balances[ecocredit.ModuleName].AmountOf(ask.Denom).Int64())
+
+	// the credits are retired to the buyer
+	buyerBal, err := s.baseStore.BatchBalanceTable().Get(s.ctx, buyer, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "10", buyerBal.RetiredAmount)
+
+	sellerBal, err := s.baseStore.BatchBalanceTable().Get(s.ctx, seller, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "0", sellerBal.EscrowedAmount)
+
+	// the trade is recorded at the bid price
+	trade, err := s.marketStore.TradeTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "80", trade.Price)
+	assert.Equal(t, "10", trade.Quantity)
+}
+
+func TestAcceptOffer_CounterOffer(t *testing.T) {
+	t.Parallel()
+	s, balances, sellOrderID := setupOffer(t)
+	seller, buyer := s.addrs[0], s.addrs[1]
+
+	id, err := s.makeOffer(sellOrderID, 80)
+	assert.NilError(t, err)
+	assert.NilError(t, s.counterOffer(seller, id, 90))
+
+	// the seller cannot accept their own counter offer
+	_, err = s.k.AcceptOffer(s.ctx, &types.MsgAcceptOffer{Sender: seller.String(), OfferId: id})
+	assert.ErrorContains(t, err, "offer 1 is awaiting a response from the buyer")
+
+	_, err = s.k.AcceptOffer(s.ctx, &types.MsgAcceptOffer{Sender: buyer.String(), OfferId: id})
+	assert.NilError(t, err)
+
+	// the buyer pays the counter price
+	assert.Equal(t, int64(1100), balances[buyer.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(900), balances[seller.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(0), balances[ecocredit.ModuleName].AmountOf(ask.Denom).Int64())
+
+	trade, err := s.marketStore.TradeTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "90", trade.Price)
+}
+
+func TestAcceptOffer_RetirementReason(t *testing.T) {
+	t.Parallel()
+	s, _, sellOrderID := setupOffer(t)
+	seller, buyer := s.addrs[0], s.addrs[1]
+
+	bidPrice := sdk.NewInt64Coin(ask.Denom, 80)
+	expiration := offerStart.Add(time.Hour)
+	res, err := s.k.MakeOffer(s.ctx, &types.MsgMakeOffer{
+		Buyer:                  buyer.String(),
+		SellOrderId:            sellOrderID,
+		Quantity:               "4",
+		BidPrice:               &bidPrice,
+		RetirementJurisdiction: "US-WA",
+		RetirementReason:       "offsetting emissions",
+		RetirementBeneficiary:  "regen",
+		Expiration:             &expiration,
+	})
+	assert.NilError(t, err)
+
+	offer, err := s.k.Offer(s.ctx, &types.QueryOfferRequest{OfferId: res.OfferId})
+	assert.NilError(t, err)
+	assert.Equal(t, "offsetting emissions", offer.Offer.RetirementReason)
+	assert.Equal(t, "regen", offer.Offer.RetirementBeneficiary)
+
+	_, err = s.k.AcceptOffer(s.ctx, &types.MsgAcceptOffer{Sender: seller.String(), OfferId: res.OfferId})
+	assert.NilError(t, err)
+
+	// the retirement is recorded with the reason and beneficiary of the offer
+	it, err := s.baseStore.RetirementTable().List(s.ctx, baseapi.RetirementOwnerIndexKey{}.WithOwner(buyer))
+	assert.NilError(t, err)
+	defer it.Close()
+	assert.Check(t, it.Next())
+	retirement, err := it.Value()
+	assert.NilError(t, err)
+	assert.Equal(t, "4", retirement.Amount)
+	assert.Equal(t, "US-WA", retirement.Jurisdiction)
+	assert.Equal(t, "offsetting emissions", retirement.Reason)
+	assert.Equal(t, "regen", retirement.Beneficiary)
+}
+
+func TestAcceptOffer_PartialFill(t *testing.T) {
+	t.Parallel()
+	s, _, sellOrderID := setupOffer(t)
+	seller := s.addrs[0]
+
+	bidPrice := sdk.NewInt64Coin(ask.Denom, 80)
+	expiration := offerStart.Add(time.Hour)
+	res, err := s.k.MakeOffer(s.ctx, &types.MsgMakeOffer{
+		Buyer:                  s.addrs[1].String(),
+		SellOrderId:            sellOrderID,
+		Quantity:               "4",
+		BidPrice:               &bidPrice,
+		RetirementJurisdiction: "US-WA",
+		Expiration:             &expiration,
+	})
+	assert.NilError(t, err)
+
+	_, err = s.k.AcceptOffer(s.ctx, &types.MsgAcceptOffer{Sender: seller.String(), OfferId: res.OfferId})
+	assert.NilError(t, err)
+
+	sellOrder, err := s.marketStore.SellOrderTable().Get(s.ctx, sellOrderID)
+	assert.NilError(t, err)
+	assert.Equal(t, "6", sellOrder.Quantity)
+}
+
+func TestAcceptOffer_SellOrderRemoved(t *testing.T) {
+	t.Parallel()
+	s, _, sellOrderID := setupOffer(t)
+	seller := s.addrs[0]
+
+	id, err := s.makeOffer(sellOrderID, 80)
+	assert.NilError(t, err)
+
+	// the offer is rejected when the sell order is cancelled
+	_, err = s.k.CancelSellOrder(s.ctx, &types.MsgCancelSellOrder{Seller: seller.String(), SellOrderId: sellOrderID})
+	assert.NilError(t, err)
+
+	_, err = s.k.AcceptOffer(s.ctx, &types.MsgAcceptOffer{Sender: seller.String(), OfferId: id})
+	assert.ErrorContains(t, err, "offer with id 1: not found")
+}
+
+func TestAcceptOffer_RejectsOtherOffers(t *testing.T) {
+	t.Parallel()
+	s, balances, sellOrderID := setupOffer(t)
+	seller, buyer, other := s.addrs[0], s.addrs[1], s.addrs[2]
+	balances[other.String()] = sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 1000))
+
+	id, err := s.makeOffer(sellOrderID, 80)
+	assert.NilError(t, err)
+
+	bidPrice := sdk.NewInt64Coin(ask.Denom, 70)
+	expiration := offerStart.Add(time.Hour)
+	res, err := s.k.MakeOffer(s.ctx, &types.MsgMakeOffer{
+		Buyer:       other.String(),
+		SellOrderId: sellOrderID,
+		Quantity:    "10",
+		BidPrice:    &bidPrice,
+		Expiration:  &expiration,
+	})
+	assert.NilError(t, err)
+
+	_, err = s.k.AcceptOffer(s.ctx, &types.MsgAcceptOffer{Sender: seller.String(), OfferId: id})
+	assert.NilError(t, err)
+
+	// the sell order is filled and the other offer is rejected
+	_, err = s.marketStore.OfferTable().Get(s.ctx, res.OfferId)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+	assert.Equal(t, int64(1000), balances[other.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(1200), balances[buyer.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(800), balances[seller.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(0), balances[ecocredit.ModuleName].AmountOf(ask.Denom).Int64())
+}
+
+func TestAcceptOffer_OfferMarket(t *testing.T) {
+	t.Parallel()
+	s, balances, sellOrderID := setupSellOrderAsks(t)
+	seller, buyer := s.addrs[0], s.addrs[1]
+
+	market, err := s.marketStore.MarketTable().GetByCreditTypeAbbrevBankDenom(s.ctx, creditType.Abbreviation, "uusdc")
+	assert.NilError(t, err)
+
+	// an offer in the market of the additional ask price
+	s.setBlockTime(offerStart)
+	bidPrice := sdk.NewInt64Coin("uusdc", 15)
+	expiration := offerStart.Add(time.Hour)
+	res, err := s.k.MakeOffer(s.ctx, &types.MsgMakeOffer{
+		Buyer:             buyer.String(),
+		SellOrderId:       sellOrderID,
+		Quantity:          "5",
+		BidPrice:          &bidPrice,
+		DisableAutoRetire: true,
+		Expiration:        &expiration,
+	})
+	assert.NilError(t, err)
+	id := res.OfferId
+	assert.Equal(t, int64(925), balances[buyer.String()].AmountOf("uusdc").Int64())
+	assert.Equal(t, int64(75), balances[ecocredit.ModuleName].AmountOf("uusdc").Int64())
+
+	_, err = s.k.AcceptOffer(s.ctx, &types.MsgAcceptOffer{Sender: seller.String(), OfferId: id})
+	assert.NilError(t, err)
+
+	// the escrowed bid is paid in the denom of the offer and the trade is
+	// recorded in the market of the offer
+	assert.Equal(t, int64(75), balances[seller.String()].AmountOf("uusdc").Int64())
+	assert.Equal(t, int64(0), balances[ecocredit.ModuleName].AmountOf("uusdc").Int64())
+
+	trade, err := s.marketStore.TradeTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, market.Id, trade.MarketId)
+	assert.Equal(t, "15", trade.Price)
+}
+
+func TestAcceptOffer_Suspended(t *testing.T) {
+	t.Parallel()
+	s, _, sellOrderID := setupOffer(t)
+
+	id, err := s.makeOffer(sellOrderID, 80)
+	assert.NilError(t, err)
+	s.setBatchSuspended(1, true)
+
+	_, err = s.k.AcceptOffer(s.ctx, &types.MsgAcceptOffer{Sender: s.addrs[0].String(), OfferId: id})
+	assert.ErrorContains(t, err, "credit batch "+batchDenom+" is suspended")
+}
+
+func TestAcceptOffer_TradingFees(t *testing.T) {
+	t.Parallel()
+	s, balances, sellOrderID := setupOffer(t)
+	seller, buyer := s.addrs[0], s.addrs[1]
+	s.setTradingFee(100, 200)
+
+	id, err := s.makeOffer(sellOrderID, 80)
+	assert.NilError(t, err)
+
+	_, err = s.k.AcceptOffer(s.ctx, &types.MsgAcceptOffer{Sender: seller.String(), OfferId: id})
+	assert.NilError(t, err)
+
+	// the seller accepting the offer pays the taker fee out of the escrowed
+	// bid and the buyer pays the maker fee in addition to the bid
+	assert.Equal(t, int64(1192), balances[buyer.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(784), balances[seller.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(24), balances["burn"].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(0), balances[ecocredit.ModuleName].AmountOf(ask.Denom).Int64())
+}
+
+func TestAcceptOffer_CounterOfferTradingFees(t *testing.T) {
+	t.Parallel()
+	s, balances, sellOrderID := setupOffer(t)
+	seller, buyer := s.addrs[0], s.addrs[1]
+	s.setTradingFee(100, 200)
+
+	id, err := s.makeOffer(sellOrderID, 80)
+	assert.NilError(t, err)
+	assert.NilError(t, s.counterOffer(seller, id, 90))
+
+	_, err = s.k.AcceptOffer(s.ctx, &types.MsgAcceptOffer{Sender: buyer.String(), OfferId: id})
+	assert.NilError(t, err)
+
+	// the buyer accepting the counter offer pays the taker fee and the seller
+	// pays the maker fee
+	assert.Equal(t, int64(1082), balances[buyer.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(891), balances[seller.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(27), balances["burn"].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(0), balances[ecocredit.ModuleName].AmountOf(ask.Denom).Int64())
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// AcceptSwap accepts a swap, atomically moving the requested credits from the
+// counterparty to the proposer, and the escrowed credits and coins from the
+// proposer to the counterparty. The credits are received in a tradable state.
+func (k Keeper) AcceptSwap(ctx context.Context, req *types.MsgAcceptSwap) (*types.MsgAcceptSwapResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	counterpartyAcc, err := sdk.AccAddressFromBech32(req.Counterparty)
+	if err != nil {
+		return nil, err
+	}
+
+	swap, err := k.stateStore.SwapTable().Get(ctx, req.SwapId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("swap with id %d: %s", req.SwapId, err.Error())
+	}
+
+	if !counterpartyAcc.Equals(sdk.AccAddress(swap.Counterparty)) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is not the counterparty of swap %d", req.Counterparty, swap.Id)
+	}
+
+	offerBatch, err := k.baseStore.BatchTable().Get(ctx, swap.OfferBatchKey)
+	if err != nil {
+		return nil, err
+	}
+
+	askBatch, err := k.baseStore.BatchTable().Get(ctx, swap.AskBatchKey)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, batch := range []*baseapi.Batch{offerBatch, askBatch} {
+		if err = utils.AssertBatchNotSuspended(batch); err != nil {
+			return nil, err
+		}
+	}
+
+	askQuantity, err := math.NewDecFromString(swap.AskQuantity)
+	if err != nil {
+		return nil, err
+	}
+
+	offerQuantity, err := math.NewDecFromString(swap.OfferQuantity)
+	if err != nil {
+		return nil, err
+	}
+
+	// the requested credits are escrowed and then transferred to the proposer
+	// so that the exchange follows the same path in both directions
+	if err = k.escrowCredits(ctx, "swap", counterpartyAcc, askBatch.Key, askQuantity); err != nil {
+		return nil, err
+	}
+	if err = k.transferEscrowedCredits(ctx, counterpartyAcc, askBatch.Key, swap.Proposer, askQuantity, orderOptions{
+		batchDenom: askBatch.Denom,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err = k.transferEscrowedCredits(ctx, swap.Proposer, offerBatch.Key, counterpartyAcc, offerQuantity, orderOptions{
+		batchDenom: offerBatch.Denom,
+	}); err != nil {
+		return nil, err
+	}
+
+	coins, err := getSwapCoins(swap)
+	if err != nil {
+		return nil, err
+	}
+
+	// the proposer is charged the maker fee and the counterparty the taker fee
+	// on the coins offered, the taker fee being deducted from the coins sent to
+	// the counterparty
+	tradingFee, err := k.stateStore.TradingFeeTable().Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	proposerFee, counterpartyFee := sdk.NewCoins(), sdk.NewCoins()
+	for _, coin := range coins {
+		buyerFee, sellerFee := getTradeFees(tradingFee, coin, true, false)
+		proposerFee = proposerFee.Add(buyerFee)
+		counterpartyFee = counterpartyFee.Add(sellerFee)
+	}
+
+	if proceeds := coins.Sub(counterpartyFee...); !proceeds.IsZero() {
+		if err = k.bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, ecocredit.ModuleName, counterpartyAcc, proceeds); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = k.chargeTradeFees(sdkCtx, swap.Proposer, proposerFee, counterpartyFee, tradingFee, true); err != nil {
+		return nil, err
+	}
+
+	if err = k.stateStore.SwapTable().Delete(ctx, swap); err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventAcceptSwap{
+		SwapId:          swap.Id,
+		ProposerFee:     proposerFee,
+		CounterpartyFee: counterpartyFee,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAcceptSwapResponse{}, nil
+}
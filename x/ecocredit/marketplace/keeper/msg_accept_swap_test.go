@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"testing"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/types/testutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+func TestAcceptSwap(t *testing.T) {
+	t.Parallel()
+	s, balances := setupSwap(t)
+	proposer, counterparty := s.addrs[0], s.addrs[1]
+
+	id, err := s.proposeSwap(sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 100)))
+	assert.NilError(t, err)
+
+	// only the counterparty can accept the swap
+	_, err = s.k.AcceptSwap(s.ctx, &types.MsgAcceptSwap{Counterparty: s.addrs[2].String(), SwapId: id})
+	assert.ErrorContains(t, err, "is not the counterparty of swap 1")
+
+	_, err = s.k.AcceptSwap(s.ctx, &types.MsgAcceptSwap{Counterparty: counterparty.String(), SwapId: id})
+	assert.NilError(t, err)
+
+	_, err = s.marketStore.SwapTable().Get(s.ctx, id)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	// the credits are exchanged in a tradable state
+	assertBal := func(addr sdk.AccAddress, batchKey uint64, tradable, escrowed string) {
+		bal, err := s.baseStore.BatchBalanceTable().Get(s.ctx, addr, batchKey)
+		assert.NilError(t, err)
+		assert.Equal(t, tradable, bal.TradableAmount)
+		assert.Equal(t, escrowed, bal.EscrowedAmount)
+	}
+	assertBal(proposer, 1, "90", "0")
+	assertBal(proposer, 2, "8", "0")
+	assertBal(counterparty, 1, "10", "0")
+	assertBal(counterparty, 2, "92", "0")
+
+	// the escrowed coins are sent to the counterparty
+	assert.Equal(t, int64(900), balances[proposer.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(100), balances[counterparty.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(0), balances[ecocredit.ModuleName].AmountOf(ask.Denom).Int64())
+
+	// the supply is unchanged
+	supply, err := s.baseStore.BatchSupplyTable().Get(s.ctx, 2)
+	assert.NilError(t, err)
+	assert.Equal(t, "100", supply.TradableAmount)
+}
+
+func TestAcceptSwap_InsufficientCredits(t *testing.T) {
+	t.Parallel()
+	s, _ := setupSwap(t)
+	counterparty := s.addrs[1]
+
+	id, err := s.proposeSwap(nil)
+	assert.NilError(t, err)
+
+	bal, err := s.baseStore.BatchBalanceTable().Get(s.ctx, counterparty, 2)
+	assert.NilError(t, err)
+	bal.TradableAmount = "7"
+	assert.NilError(t, s.baseStore.BatchBalanceTable().Update(s.ctx, bal))
+
+	_, err = s.k.AcceptSwap(s.ctx, &types.MsgAcceptSwap{Counterparty: counterparty.String(), SwapId: id})
+	assert.ErrorContains(t, err, "swap: credit quantity: 8, tradable balance: 7")
+}
+
+func TestAcceptSwap_Suspended(t *testing.T) {
+	t.Parallel()
+
+	// both the offered and the requested credit batch must not be suspended
+	for _, batchKey := range []uint64{1, 2} {
+		s, _ := setupSwap(t)
+		counterparty := s.addrs[1]
+
+		id, err := s.proposeSwap(nil)
+		assert.NilError(t, err)
+		s.setBatchSuspended(batchKey, true)
+
+		_, err = s.k.AcceptSwap(s.ctx, &types.MsgAcceptSwap{Counterparty: counterparty.String(), SwapId: id})
+		assert.ErrorContains(t, err, "is suspended")
+	}
+}
+
+func TestAcceptSwap_TradingFees(t *testing.T) {
+	t.Parallel()
+	s, balances := setupSwap(t)
+	proposer, counterparty := s.addrs[0], s.addrs[1]
+	s.setTradingFee(100, 200)
+
+	id, err := s.proposeSwap(sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 100)))
+	assert.NilError(t, err)
+
+	_, err = s.k.AcceptSwap(s.ctx, &types.MsgAcceptSwap{Counterparty: counterparty.String(), SwapId: id})
+	assert.NilError(t, err)
+
+	// the proposer pays the maker fee and the counterparty pays the taker fee
+	// out of the coins offered
+	assert.Equal(t, int64(899), balances[proposer.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(98), balances[counterparty.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(3), balances["burn"].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(0), balances[ecocredit.ModuleName].AmountOf(ask.Denom).Int64())
+
+	event := &types.EventAcceptSwap{
+		SwapId:          id,
+		ProposerFee:     sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 1)),
+		CounterpartyFee: sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 2)),
+	}
+	sdkEvent, found := testutil.GetEvent(event, s.sdkCtx.EventManager().Events())
+	assert.Check(t, found)
+	emitted, err := sdk.ParseTypedEvent(abci.Event(sdkEvent))
+	assert.NilError(t, err)
+	assert.Equal(t, event.String(), emitted.String())
+}
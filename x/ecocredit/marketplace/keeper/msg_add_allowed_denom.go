@@ -19,11 +19,26 @@ func (k Keeper) AddAllowedDenom(ctx context.Context, req *types.MsgAddAllowedDen
 		return nil, govtypes.ErrInvalidSigner.Wrapf("invalid authority: expected %s, got %s", k.authority, req.Authority)
 	}
 
-	if err := k.stateStore.AllowedDenomTable().Insert(ctx, &api.AllowedDenom{
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	allowedDenom := &api.AllowedDenom{
 		BankDenom:    req.BankDenom,
 		DisplayDenom: req.DisplayDenom,
 		Exponent:     req.Exponent,
-	}); err != nil {
+	}
+
+	// IBC denoms must have been received over IBC, which ensures the denom is
+	// not a mistyped hash that cannot be traded
+	if isIBCDenom(req.BankDenom) {
+		trace, err := k.getDenomTrace(sdkCtx, req.BankDenom)
+		if err != nil {
+			return nil, err
+		}
+		allowedDenom.IbcPath = trace.Path
+		allowedDenom.IbcBaseDenom = trace.BaseDenom
+	}
+
+	if err := k.stateStore.AllowedDenomTable().Insert(ctx, allowedDenom); err != nil {
 		if ormerrors.AlreadyExists.Is(err) {
 			return nil, sdkerrors.ErrConflict.Wrapf("bank denom %s already exists", req.BankDenom)
 		} else if ormerrors.UniqueKeyViolation.Is(err) {
@@ -33,7 +48,6 @@ func (k Keeper) AddAllowedDenom(ctx context.Context, req *types.MsgAddAllowedDen
 		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not add denom: %s", err.Error())
 	}
 
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventAllowDenom{
 		Denom: req.BankDenom,
 	}); err != nil {
@@ -4,8 +4,13 @@ import (
 	"encoding/json"
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/regen-network/gocuke"
 	"github.com/stretchr/testify/require"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
 
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
 	"github.com/regen-network/regen-ledger/types/testutil"
@@ -14,7 +19,8 @@ import (
 
 type addAllowedDenomSuite struct {
 	*baseSuite
-	err error
+	denomTraces map[string]ibctransfertypes.DenomTrace
+	err         error
 }
 
 func TestAddAllowedDenom(t *testing.T) {
@@ -23,6 +29,18 @@ func TestAddAllowedDenom(t *testing.T) {
 
 func (s *addAllowedDenomSuite) Before(t gocuke.TestingT) {
 	s.baseSuite = setupBase(t, 1)
+	s.denomTraces = make(map[string]ibctransfertypes.DenomTrace)
+	s.transferKeeper.EXPECT().GetDenomTrace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, hash tmbytes.HexBytes) (ibctransfertypes.DenomTrace, bool) {
+			trace, ok := s.denomTraces[hash.String()]
+			return trace, ok
+		},
+	).AnyTimes()
+}
+
+func (s *addAllowedDenomSuite) ADenomTraceWithPathAndBaseDenom(a, b string) {
+	trace := ibctransfertypes.DenomTrace{Path: a, BaseDenom: b}
+	s.denomTraces[trace.Hash().String()] = trace
 }
 
 func (s *addAllowedDenomSuite) AnAllowedDenomWithProperties(a gocuke.DocString) {
@@ -60,6 +78,20 @@ func (s *addAllowedDenomSuite) ExpectErrorContains(a string) {
 	require.ErrorContains(s.t, s.err, a)
 }
 
+func (s *addAllowedDenomSuite) ExpectAllowedDenomWithProperties(a gocuke.DocString) {
+	var expected types.AllowedDenom
+	err := json.Unmarshal([]byte(a.Content), &expected)
+	require.NoError(s.t, err)
+
+	allowedDenom, err := s.k.stateStore.AllowedDenomTable().Get(s.ctx, expected.BankDenom)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.DisplayDenom, allowedDenom.DisplayDenom)
+	require.Equal(s.t, expected.Exponent, allowedDenom.Exponent)
+	require.Equal(s.t, expected.IbcPath, allowedDenom.IbcPath)
+	require.Equal(s.t, expected.IbcBaseDenom, allowedDenom.IbcBaseDenom)
+}
+
 func (s *addAllowedDenomSuite) ExpectEventWithProperties(a gocuke.DocString) {
 	var event types.EventAllowDenom
 	err := json.Unmarshal([]byte(a.Content), &event)
@@ -0,0 +1,246 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// BidAuction places a bid on an auction and escrows the total bid amount, together
+// with the highest trading fee that can be charged on the total bid amount, in
+// the ecocredit module account. For an English auction, the escrowed funds of the
+// previous highest bidder are returned. For a Dutch auction, the first valid
+// bid wins the auction at the current price and closes the auction, which is
+// then settled at the beginning of the next block.
+func (k Keeper) BidAuction(ctx context.Context, req *types.MsgBidAuction) (*types.MsgBidAuctionResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime()
+
+	bidderAcc, err := sdk.AccAddressFromBech32(req.Bidder)
+	if err != nil {
+		return nil, err
+	}
+
+	auction, err := k.stateStore.AuctionTable().Get(ctx, req.AuctionId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("auction with id %d: %s", req.AuctionId, err.Error())
+	}
+
+	if bidderAcc.Equals(sdk.AccAddress(auction.Seller)) {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("bidder cannot be the seller of the auction")
+	}
+
+	if !blockTime.Before(auction.EndTime.AsTime()) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("auction with id %d has ended", auction.Id)
+	}
+
+	batch, err := k.baseStore.BatchTable().Get(ctx, auction.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+	if err = utils.AssertBatchNotSuspended(batch); err != nil {
+		return nil, err
+	}
+
+	if req.DisableAutoRetire && !auction.DisableAutoRetire {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("cannot disable auto-retire for an auction with auto-retire enabled")
+	}
+
+	market, err := k.stateStore.MarketTable().Get(ctx, auction.MarketId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("market id %d: %s", auction.MarketId, err.Error())
+	}
+	if req.BidPrice.Denom != market.BankDenom {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"bid price denom: %s, auction denom: %s", req.BidPrice.Denom, market.BankDenom,
+		)
+	}
+
+	// price is the amount per credit that the bidder pays if the bid wins
+	price := req.BidPrice.Amount
+	switch auction.AuctionType {
+	case api.AuctionType_AUCTION_TYPE_ENGLISH:
+		if len(auction.HighestBidder) == 0 {
+			startAmount, err := parseAmount(auction.StartAmount)
+			if err != nil {
+				return nil, err
+			}
+			if price.LT(startAmount) {
+				return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+					"start price: %s%s, bid price: %v, insufficient bid price", startAmount, market.BankDenom, req.BidPrice,
+				)
+			}
+		} else {
+			highestBid, err := parseAmount(auction.HighestBidAmount)
+			if err != nil {
+				return nil, err
+			}
+			if !price.GT(highestBid) {
+				return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+					"highest bid: %s%s, bid price: %v, bid price must be higher than the highest bid",
+					highestBid, market.BankDenom, req.BidPrice,
+				)
+			}
+		}
+	case api.AuctionType_AUCTION_TYPE_DUTCH:
+		currentAmount, err := getDutchAuctionAmount(auction, blockTime)
+		if err != nil {
+			return nil, err
+		}
+		if price.LT(currentAmount) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"current price: %s%s, bid price: %v, insufficient bid price", currentAmount, market.BankDenom, req.BidPrice,
+			)
+		}
+		price = currentAmount
+	default:
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid auction type: %s", auction.AuctionType)
+	}
+
+	quantity, err := math.NewDecFromString(auction.Quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	escrowedFeeBps, err := k.getEscrowFeeBps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// check address has the total bid amount (price * auction quantity) and the
+	// highest trading fee that can be charged on the total bid amount
+	bal := k.bankKeeper.GetBalance(sdkCtx, bidderAcc, market.BankDenom)
+	total, err := utils.GetTotalCost(price, quantity)
+	if err != nil {
+		return nil, err
+	}
+	coinTotal := sdk.Coin{Amount: getEscrowAmount(total, escrowedFeeBps), Denom: market.BankDenom}
+	if bal.IsLT(coinTotal) {
+		return nil, sdkerrors.ErrInsufficientFunds.Wrapf(
+			"quantity: %s, bid price: %s%s, total price: %v, bank balance: %v",
+			auction.Quantity, price, market.BankDenom, coinTotal, bal,
+		)
+	}
+
+	if err = k.bankKeeper.SendCoinsFromAccountToModule(sdkCtx, bidderAcc, ecocredit.ModuleName, sdk.NewCoins(coinTotal)); err != nil {
+		return nil, err
+	}
+
+	// return the escrowed funds of the previous highest bidder
+	if len(auction.HighestBidder) != 0 {
+		if err = k.unescrowAuctionBid(ctx, auction, market.BankDenom); err != nil {
+			return nil, err
+		}
+	}
+
+	auction.HighestBidder = bidderAcc
+	auction.HighestBidAmount = price.String()
+	auction.HighestBidDisableAutoRetire = req.DisableAutoRetire
+	auction.HighestBidRetirementJurisdiction = req.RetirementJurisdiction
+	auction.HighestBidRetirementReason = req.RetirementReason
+	auction.HighestBidRetirementBeneficiary = req.RetirementBeneficiary
+	auction.HighestBidEscrowedFeeBps = escrowedFeeBps
+
+	// the first valid bid on a dutch auction closes the auction
+	if auction.AuctionType == api.AuctionType_AUCTION_TYPE_DUTCH {
+		auction.EndTime = timestamppb.New(blockTime)
+	}
+
+	if err = k.stateStore.AuctionTable().Update(ctx, auction); err != nil {
+		return nil, err
+	}
+
+	bidPrice := sdk.NewCoin(market.BankDenom, price)
+	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventBidAuction{
+		AuctionId: auction.Id,
+		Bidder:    req.Bidder,
+		BidPrice:  &bidPrice,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBidAuctionResponse{}, nil
+}
+
+// unescrowAuctionBid sends the funds held in escrow for the highest bid of the
+// auction back to the highest bidder.
+func (k Keeper) unescrowAuctionBid(ctx context.Context, auction *api.Auction, bankDenom string) error {
+	cost, err := getAuctionCost(auction, bankDenom)
+	if err != nil {
+		return err
+	}
+	escrowed := sdk.NewCoin(bankDenom, getEscrowAmount(cost.Amount, auction.HighestBidEscrowedFeeBps))
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(
+		sdk.UnwrapSDKContext(ctx), ecocredit.ModuleName, auction.HighestBidder, sdk.NewCoins(escrowed),
+	)
+}
+
+// getAuctionCost returns the total cost of the highest bid of the auction, i.e.
+// the highest bid amount multiplied by the quantity of the auction.
+func getAuctionCost(auction *api.Auction, bankDenom string) (sdk.Coin, error) {
+	quantity, err := math.NewDecFromString(auction.Quantity)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	amount, err := parseAmount(auction.HighestBidAmount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	cost, err := utils.GetTotalCost(amount, quantity)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return sdk.NewCoin(bankDenom, cost), nil
+}
+
+// getDutchAuctionAmount returns the amount per credit of a Dutch auction at the
+// given time. The amount decreases linearly from the start amount at the start
+// time to the end amount at the end time, rounding up to the nearest integer.
+func getDutchAuctionAmount(auction *api.Auction, t time.Time) (sdkmath.Int, error) {
+	startAmount, err := parseAmount(auction.StartAmount)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	endAmount, err := parseAmount(auction.EndAmount)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	start, end := auction.StartTime.AsTime(), auction.EndTime.AsTime()
+	switch {
+	case !t.After(start):
+		return startAmount, nil
+	case !t.Before(end):
+		return endAmount, nil
+	}
+
+	elapsed, duration := sdkmath.NewInt(int64(t.Sub(start))), sdkmath.NewInt(int64(end.Sub(start)))
+	decrease := startAmount.Sub(endAmount).Mul(elapsed).Quo(duration)
+
+	return startAmount.Sub(decrease), nil
+}
+
+// parseAmount parses an integer amount encoded as a string.
+func parseAmount(amount string) (sdkmath.Int, error) {
+	i, ok := sdk.NewIntFromString(amount)
+	if !ok {
+		return sdkmath.Int{}, sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", amount, sdkmath.Int{})
+	}
+	return i, nil
+}
@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+func (s *baseSuite) bidAuction(bidder sdk.AccAddress, id uint64, amount int64) error {
+	bidPrice := sdk.NewInt64Coin(ask.Denom, amount)
+	_, err := s.k.BidAuction(s.ctx, &types.MsgBidAuction{
+		Bidder:                 bidder.String(),
+		AuctionId:              id,
+		BidPrice:               &bidPrice,
+		RetirementJurisdiction: "US-OR",
+	})
+	return err
+}
+
+func TestBidAuction_English(t *testing.T) {
+	t.Parallel()
+	s, balances := setupAuction(t)
+	bidder1, bidder2 := s.addrs[1], s.addrs[2]
+	balances[bidder1.String()] = sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 2000))
+	balances[bidder2.String()] = sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 2000))
+
+	id := s.createAuction(types.AuctionType_AUCTION_TYPE_ENGLISH, false)
+
+	// the seller cannot bid
+	err := s.bidAuction(s.addrs[0], id, 100)
+	assert.ErrorContains(t, err, "bidder cannot be the seller of the auction")
+
+	// the first bid must be at least the start price
+	err = s.bidAuction(bidder1, id, 99)
+	assert.ErrorContains(t, err, "start price: 100stake, bid price: 99stake, insufficient bid price")
+
+	// the bid is escrowed
+	assert.NilError(t, s.bidAuction(bidder1, id, 100))
+	assert.Equal(t, int64(1000), balances[bidder1.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(1000), balances[ecocredit.ModuleName].AmountOf(ask.Denom).Int64())
+
+	// a bid must be higher than the highest bid
+	err = s.bidAuction(bidder2, id, 100)
+	assert.ErrorContains(t, err, "highest bid: 100stake, bid price: 100stake, bid price must be higher than the highest bid")
+
+	// a bid exceeding the balance of the bidder fails
+	err = s.bidAuction(bidder2, id, 201)
+	assert.ErrorContains(t, err, "insufficient funds")
+
+	// the previous highest bid is returned when outbid
+	assert.NilError(t, s.bidAuction(bidder2, id, 150))
+	assert.Equal(t, int64(2000), balances[bidder1.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(500), balances[bidder2.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(1500), balances[ecocredit.ModuleName].AmountOf(ask.Denom).Int64())
+
+	auction, err := s.marketStore.AuctionTable().Get(s.ctx, id)
+	assert.NilError(t, err)
+	assert.Check(t, bidder2.Equals(sdk.AccAddress(auction.HighestBidder)))
+	assert.Equal(t, "150", auction.HighestBidAmount)
+	assert.Equal(t, "US-OR", auction.HighestBidRetirementJurisdiction)
+
+	// auto-retire cannot be disabled
+	bidPrice := sdk.NewInt64Coin(ask.Denom, 160)
+	_, err = s.k.BidAuction(s.ctx, &types.MsgBidAuction{
+		Bidder:            bidder1.String(),
+		AuctionId:         id,
+		BidPrice:          &bidPrice,
+		DisableAutoRetire: true,
+	})
+	assert.ErrorContains(t, err, "cannot disable auto-retire for an auction with auto-retire enabled")
+
+	// bids are not accepted after the end time
+	s.setBlockTime(auctionStart.Add(10 * time.Hour))
+	err = s.bidAuction(bidder1, id, 200)
+	assert.ErrorContains(t, err, "auction with id 1 has ended")
+}
+
+func TestBidAuction_Dutch(t *testing.T) {
+	t.Parallel()
+	s, balances := setupAuction(t)
+	bidder1, bidder2 := s.addrs[1], s.addrs[2]
+	balances[bidder1.String()] = sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 2000))
+	balances[bidder2.String()] = sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 2000))
+
+	id := s.createAuction(types.AuctionType_AUCTION_TYPE_DUTCH, false)
+
+	// the price decreases linearly from 100 to 50 over 10 hours
+	s.setBlockTime(auctionStart.Add(4 * time.Hour))
+
+	err := s.bidAuction(bidder1, id, 79)
+	assert.ErrorContains(t, err, "current price: 80stake, bid price: 79stake, insufficient bid price")
+
+	// the winning bidder pays the current price
+	assert.NilError(t, s.bidAuction(bidder1, id, 90))
+	assert.Equal(t, int64(1200), balances[bidder1.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(800), balances[ecocredit.ModuleName].AmountOf(ask.Denom).Int64())
+
+	auction, err := s.marketStore.AuctionTable().Get(s.ctx, id)
+	assert.NilError(t, err)
+	assert.Check(t, bidder1.Equals(sdk.AccAddress(auction.HighestBidder)))
+	assert.Equal(t, "80", auction.HighestBidAmount)
+	assert.Equal(t, auctionStart.Add(4*time.Hour), auction.EndTime.AsTime())
+
+	// the winning bid closes the auction
+	err = s.bidAuction(bidder2, id, 100)
+	assert.ErrorContains(t, err, "auction with id 1 has ended")
+}
+
+func TestBidAuction_NotFound(t *testing.T) {
+	t.Parallel()
+	s, _ := setupAuction(t)
+
+	err := s.bidAuction(s.addrs[1], 1, 100)
+	assert.ErrorContains(t, err, "auction with id 1: not found")
+}
+
+func TestGetDutchAuctionAmount(t *testing.T) {
+	t.Parallel()
+	s, _ := setupAuction(t)
+	id := s.createAuction(types.AuctionType_AUCTION_TYPE_DUTCH, false)
+	auction, err := s.marketStore.AuctionTable().Get(s.ctx, id)
+	assert.NilError(t, err)
+
+	testCases := []struct {
+		elapsed time.Duration
+		amount  int64
+	}{
+		{-time.Hour, 100},
+		{0, 100},
+		{time.Hour, 95},
+		{5 * time.Hour, 75},
+		// the decrease is rounded down, i.e. the price is rounded up
+		{5*time.Hour + time.Minute, 75},
+		{9*time.Hour + 59*time.Minute, 51},
+		{10 * time.Hour, 50},
+		{11 * time.Hour, 50},
+	}
+	for _, tc := range testCases {
+		amount, err := getDutchAuctionAmount(auction, auctionStart.Add(tc.elapsed))
+		assert.NilError(t, err)
+		assert.Equal(t, tc.amount, amount.Int64(), "elapsed: %s", tc.elapsed)
+	}
+}
+
+func TestBidAuction_Suspended(t *testing.T) {
+	t.Parallel()
+	s, balances := setupAuction(t)
+	bidder := s.addrs[1]
+	balances[bidder.String()] = sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 2000))
+
+	id := s.createAuction(types.AuctionType_AUCTION_TYPE_ENGLISH, false)
+	s.setBatchSuspended(1, true)
+
+	err := s.bidAuction(bidder, id, 100)
+	assert.ErrorContains(t, err, "credit batch "+batchDenom+" is suspended")
+	assert.Equal(t, int64(2000), balances[bidder.String()].AmountOf(ask.Denom).Int64())
+}
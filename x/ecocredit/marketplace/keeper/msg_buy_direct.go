@@ -4,7 +4,7 @@ import (
 	"context"
 	"fmt"
 
-	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -14,7 +14,9 @@ import (
 	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
 )
 
-// BuyDirect allows for the purchase of credits directly from sell orders.
+// BuyDirect allows for the purchase of credits directly from sell orders. The
+// buyer is charged the taker fee and the seller is charged the maker fee if the
+// sell order is a maker order and the taker fee otherwise.
 func (k Keeper) BuyDirect(ctx context.Context, req *types.MsgBuyDirect) (*types.MsgBuyDirectResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
@@ -23,6 +25,11 @@ func (k Keeper) BuyDirect(ctx context.Context, req *types.MsgBuyDirect) (*types.
 		return nil, err
 	}
 
+	tradingFee, err := k.stateStore.TradingFeeTable().Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	for i, order := range req.Orders {
 		// orderIndex is used for more granular error messages when
 		// an individual order in a list of orders fails to process
@@ -40,6 +47,13 @@ func (k Keeper) BuyDirect(ctx context.Context, req *types.MsgBuyDirect) (*types.
 			)
 		}
 
+		// check if the buyer is allowed to buy from a private sell order
+		if !isAllowedBuyer(sellOrder, buyerAcc) {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf(
+				"%s: buyer is not allowed to buy credits from sell order %d", orderIndex, sellOrder.Id,
+			)
+		}
+
 		// check if disable auto-retire is required
 		if order.DisableAutoRetire && !sellOrder.DisableAutoRetire {
 			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
@@ -52,6 +66,9 @@ func (k Keeper) BuyDirect(ctx context.Context, req *types.MsgBuyDirect) (*types.
 		if err != nil {
 			return nil, err
 		}
+		if err = utils.AssertBatchNotSuspended(batch); err != nil {
+			return nil, errors.Wrap(err, orderIndex)
+		}
 		ct, err := utils.GetCreditTypeFromBatchDenom(ctx, k.baseStore, batch.Denom)
 		if err != nil {
 			return nil, err
@@ -64,23 +81,24 @@ func (k Keeper) BuyDirect(ctx context.Context, req *types.MsgBuyDirect) (*types.
 			)
 		}
 
-		// check that bid price and ask price denoms match
-		market, err := k.stateStore.MarketTable().Get(ctx, sellOrder.MarketId)
+		// check that the sell order has an ask price in the bid price denom,
+		// i.e. the ask price or one of the additional ask prices
+		market, sellOrderAskAmount, err := k.getSellOrderAsk(ctx, sellOrder, order.BidPrice.Denom)
 		if err != nil {
-			return nil, sdkerrors.ErrInvalidRequest.Wrapf("market id %d: %s", sellOrder.MarketId, err.Error())
+			return nil, err
 		}
-		if order.BidPrice.Denom != market.BankDenom {
+		if market == nil {
+			primaryMarket, err := k.stateStore.MarketTable().Get(ctx, sellOrder.MarketId)
+			if err != nil {
+				return nil, err
+			}
 			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
 				"%s: bid price denom: %s, ask price denom: %s",
-				orderIndex, order.BidPrice.Denom, market.BankDenom,
+				orderIndex, order.BidPrice.Denom, primaryMarket.BankDenom,
 			)
 		}
 
 		// check that bid price >= sell price
-		sellOrderAskAmount, ok := sdk.NewIntFromString(sellOrder.AskAmount)
-		if !ok {
-			return nil, sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", sellOrder.AskAmount, sdkmath.Int{})
-		}
 		sellOrderPriceCoin := sdk.Coin{Denom: market.BankDenom, Amount: sellOrderAskAmount}
 		if sellOrderAskAmount.GT(order.BidPrice.Amount) {
 			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
@@ -89,31 +107,44 @@ func (k Keeper) BuyDirect(ctx context.Context, req *types.MsgBuyDirect) (*types.
 			)
 		}
 
-		// check address has the total cost (price per * order quantity)
-		bal := k.bankKeeper.GetBalance(sdkCtx, buyerAcc, order.BidPrice.Denom)
-		cost, err := getTotalCost(sellOrderAskAmount, creditOrderQty)
+		cost, err := utils.GetTotalCost(sellOrderAskAmount, creditOrderQty)
 		if err != nil {
 			return nil, err
 		}
-		coinCost := sdk.Coin{Amount: cost, Denom: market.BankDenom}
-		if bal.IsLT(coinCost) {
+
+		// the buyer is always the taker
+		buyerFee, _ := getTradeFees(tradingFee, sdk.NewCoin(market.BankDenom, cost), false, sellOrder.Maker)
+
+		// check address has the total cost (price per * order quantity + buyer fee)
+		bal := k.bankKeeper.GetBalance(sdkCtx, buyerAcc, order.BidPrice.Denom)
+		coinTotal := sdk.Coin{Amount: cost.Add(buyerFee.Amount), Denom: market.BankDenom}
+		if bal.IsLT(coinTotal) {
 			return nil, sdkerrors.ErrInsufficientFunds.Wrapf(
 				"%s: quantity: %s, ask price: %s%s, total price: %v, bank balance: %v",
-				orderIndex, order.Quantity, sellOrder.AskAmount, market.BankDenom, coinCost, bal,
+				orderIndex, order.Quantity, sellOrderAskAmount, market.BankDenom, coinTotal, bal,
 			)
 		}
 
-		// fill the order, updating balances and the sell order in state
-		if err = k.fillOrder(ctx, orderIndex, sellOrder, buyerAcc, creditOrderQty, coinCost, orderOptions{
-			autoRetire:   !order.DisableAutoRetire,
-			batchDenom:   batch.Denom,
-			jurisdiction: order.RetirementJurisdiction,
-		}); err != nil {
+		// fill the order, updating balances and the sell order in state and
+		// charging the trading fees
+		res, err := k.fillOrder(ctx, orderIndex, sellOrder, buyerAcc, creditOrderQty, sdk.NewCoin(market.BankDenom, cost),
+			market.Id, sellOrderAskAmount.String(), orderOptions{
+				autoRetire:   !order.DisableAutoRetire,
+				batchDenom:   batch.Denom,
+				jurisdiction: order.RetirementJurisdiction,
+				reason:       order.RetirementReason,
+				beneficiary:  order.RetirementBeneficiary,
+				sellerMaker:  sellOrder.Maker,
+			})
+		if err != nil {
 			return nil, err
 		}
 
 		if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventBuyDirect{
 			SellOrderId: sellOrder.Id,
+			BuyerFee:    &res.buyerFee,
+			SellerFee:   &res.sellerFee,
+			Royalty:     &res.royalty,
 		}); err != nil {
 			return nil, err
 		}
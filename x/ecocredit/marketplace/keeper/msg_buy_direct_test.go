@@ -10,6 +10,9 @@ import (
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/regen-network/gocuke"
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/golang/mock/gomock"
 
 	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,6 +21,7 @@ import (
 	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/types/math"
 	"github.com/regen-network/regen-ledger/types/testutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
 	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
 )
 
@@ -27,11 +31,18 @@ type buyDirectSuite struct {
 	aliceBankBalance  sdk.Coin
 	bob               sdk.AccAddress
 	bobBankBalance    sdk.Coin
+	carol             sdk.AccAddress
+	carolBankBalance  sdk.Coin
 	creditTypeAbbrev  string
 	classID           string
 	batchDenom        string
 	sellOrderID       uint64
 	disableAutoRetire bool
+	maker             bool
+	allowedBuyers     [][]byte
+	tradingFee        *api.TradingFee
+	tradingFees       map[string]sdk.Coins
+	classRoyalty      *api.ClassRoyalty
 	quantity          string
 	askPrice          sdk.Coin
 	bidPrice          sdk.Coin
@@ -44,9 +55,11 @@ func TestBuyDirect(t *testing.T) {
 }
 
 func (s *buyDirectSuite) Before(t gocuke.TestingT) {
-	s.baseSuite = setupBase(t, 2)
+	s.baseSuite = setupBase(t, 3)
 	s.alice = s.addrs[0]
 	s.bob = s.addrs[1]
+	s.carol = s.addrs[2]
+	s.carolBankBalance = sdk.NewCoin("regen", sdk.NewInt(0))
 	s.aliceBankBalance = sdk.Coin{
 		Denom:  "regen",
 		Amount: sdk.NewInt(100),
@@ -59,6 +72,8 @@ func (s *buyDirectSuite) Before(t gocuke.TestingT) {
 	s.classID = testClassID
 	s.batchDenom = testBatchDenom
 	s.sellOrderID = 1
+	s.tradingFee = &api.TradingFee{}
+	s.tradingFees = make(map[string]sdk.Coins)
 	s.quantity = "10"
 	s.askPrice = sdk.Coin{
 		Denom:  "regen",
@@ -159,6 +174,22 @@ func (s *buyDirectSuite) TheBatchSupply(a gocuke.DocString) {
 	require.NoError(s.t, err)
 }
 
+func (s *buyDirectSuite) TheTradingFee(a gocuke.DocString) {
+	tradingFee := &types.TradingFee{}
+	err := jsonpb.UnmarshalString(a.Content, tradingFee)
+	require.NoError(s.t, err)
+
+	s.tradingFee = &api.TradingFee{
+		MakerFeeBps:   tradingFee.MakerFeeBps,
+		TakerFeeBps:   tradingFee.TakerFeeBps,
+		Destination:   api.FeeDestination(tradingFee.Destination),
+		ModuleAccount: tradingFee.ModuleAccount,
+	}
+
+	err = s.marketStore.TradingFeeTable().Save(s.ctx, s.tradingFee)
+	require.NoError(s.t, err)
+}
+
 func (s *buyDirectSuite) AliceCreatedASellOrderWithId(a string) {
 	id, err := strconv.ParseUint(a, 10, 32)
 	require.NoError(s.t, err)
@@ -208,6 +239,12 @@ func (s *buyDirectSuite) AliceCreatedASellOrderWithQuantityAndAskAmount(a string
 	s.createSellOrders(1)
 }
 
+func (s *buyDirectSuite) AliceCreatedAMakerSellOrderWithQuantityAndAskAmount(a string, b string) {
+	s.maker = true
+
+	s.AliceCreatedASellOrderWithQuantityAndAskAmount(a, b)
+}
+
 func (s *buyDirectSuite) AliceCreatedASellOrderWithQuantityAndAskPrice(a string, b string) {
 	askPrice, err := sdk.ParseCoinNormalized(b)
 	require.NoError(s.t, err)
@@ -228,6 +265,18 @@ func (s *buyDirectSuite) AliceCreatedASellOrderWithQuantityAndDisableAutoRetire(
 	s.createSellOrders(1)
 }
 
+func (s *buyDirectSuite) AliceCreatedASellOrderAllowingOnlyBobToBuy() {
+	s.allowedBuyers = [][]byte{s.bob}
+
+	s.createSellOrders(1)
+}
+
+func (s *buyDirectSuite) AliceCreatedASellOrderAllowingOnlyCarolToBuy() {
+	s.allowedBuyers = [][]byte{s.carol}
+
+	s.createSellOrders(1)
+}
+
 func (s *buyDirectSuite) AliceCreatedTwoSellOrdersEachWithQuantityAndAskAmount(a string, b string) {
 	askAmount, ok := sdk.NewIntFromString(b)
 	require.True(s.t, ok)
@@ -238,6 +287,14 @@ func (s *buyDirectSuite) AliceCreatedTwoSellOrdersEachWithQuantityAndAskAmount(a
 	s.createSellOrders(2)
 }
 
+func (s *buyDirectSuite) TheCreditClassHasARoyaltyOfPaidToAlice(a string) {
+	s.setClassRoyalty(a, s.alice)
+}
+
+func (s *buyDirectSuite) TheCreditClassHasARoyaltyOfPaidToCarol(a string) {
+	s.setClassRoyalty(a, s.carol)
+}
+
 func (s *buyDirectSuite) AliceAttemptsToBuyCreditsWithSellOrderId(a string) {
 	id, err := strconv.ParseUint(a, 10, 32)
 	require.NoError(s.t, err)
@@ -466,6 +523,35 @@ func (s *buyDirectSuite) ExpectBobBankBalance(a string) {
 	require.Equal(s.t, bankBalance.Amount.String(), s.bobBankBalance.Amount.String())
 }
 
+func (s *buyDirectSuite) ExpectCarolBankBalance(a string) {
+	bankBalance, err := sdk.ParseCoinNormalized(a)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, bankBalance.Denom, s.carolBankBalance.Denom)
+	require.Equal(s.t, bankBalance.Amount.String(), s.carolBankBalance.Amount.String())
+}
+
+func (s *buyDirectSuite) ExpectBurnedTradingFees(a string) {
+	s.ExpectTradingFeesSentTo(a, "burn")
+}
+
+func (s *buyDirectSuite) ExpectTradingFeesSentTo(a string, b string) {
+	fees, err := sdk.ParseCoinsNormalized(a)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, fees.String(), s.tradingFees[b].String())
+}
+
+func (s *buyDirectSuite) ExpectATradeFromAliceToBobWithQuantityAndPrice(a string, b string) {
+	trade, err := s.marketStore.TradeTable().Get(s.ctx, 1)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, s.alice.Bytes(), trade.Seller)
+	require.Equal(s.t, s.bob.Bytes(), trade.Buyer)
+	require.Equal(s.t, a, trade.Quantity)
+	require.Equal(s.t, b, trade.Price)
+}
+
 func (s *buyDirectSuite) ExpectAliceBatchBalance(a gocuke.DocString) {
 	expected := &baseapi.BatchBalance{}
 	err := jsonpb.UnmarshalString(a.Content, expected)
@@ -521,8 +607,10 @@ func (s *buyDirectSuite) ExpectEventWithProperties(a gocuke.DocString) {
 	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
 	require.True(s.t, found)
 
-	err = testutil.MatchEvent(&event, sdkEvent)
+	// testutil.MatchEvent cannot compare non-zero sdk.Int fees
+	emitted, err := sdk.ParseTypedEvent(abci.Event(sdkEvent))
 	require.NoError(s.t, err)
+	require.Equal(s.t, event.String(), emitted.String())
 }
 
 // count is the number of sell orders created
@@ -538,14 +626,22 @@ func (s *buyDirectSuite) createSellOrders(count int) {
 		totalQuantity = t.String()
 	}
 
-	err := s.baseStore.ClassTable().Insert(s.ctx, &baseapi.Class{
+	classKey, err := s.baseStore.ClassTable().InsertReturningID(s.ctx, &baseapi.Class{
 		Id:               s.classID,
+		Admin:            s.alice,
 		CreditTypeAbbrev: s.creditTypeAbbrev,
 	})
 	require.NoError(s.t, err)
 
+	projectKey, err := s.baseStore.ProjectTable().InsertReturningID(s.ctx, &baseapi.Project{
+		Id:       s.classID + "-001",
+		ClassKey: classKey,
+	})
+	require.NoError(s.t, err)
+
 	batchKey, err := s.baseStore.BatchTable().InsertReturningID(s.ctx, &baseapi.Batch{
-		Denom: s.batchDenom,
+		ProjectKey: projectKey,
+		Denom:      s.batchDenom,
 	})
 	require.NoError(s.t, err)
 
@@ -575,6 +671,8 @@ func (s *buyDirectSuite) createSellOrders(count int) {
 		MarketId:          marketKey,
 		AskAmount:         s.askPrice.Amount.String(),
 		DisableAutoRetire: s.disableAutoRetire,
+		Maker:             s.maker,
+		AllowedBuyers:     s.allowedBuyers,
 	}
 
 	sellOrderID, err := s.marketStore.SellOrderTable().InsertReturningID(s.ctx, order)
@@ -590,9 +688,34 @@ func (s *buyDirectSuite) createSellOrders(count int) {
 
 func (s *buyDirectSuite) singleBuyOrderExpectCalls() {
 	askTotal := s.calculateAskTotal(s.quantity, s.askPrice.Amount.String())
-	sendCoin := sdk.NewCoin(s.askPrice.Denom, askTotal)
+
+	sellerFeeBps := s.tradingFee.TakerFeeBps
+	if s.maker {
+		sellerFeeBps = s.tradingFee.MakerFeeBps
+	}
+	sellerFee := getTradingFee(askTotal, sellerFeeBps)
+
+	proceeds := askTotal.Sub(sellerFee)
+
+	royalty := sdk.NewCoin(s.askPrice.Denom, sdk.ZeroInt())
+	if s.classRoyalty != nil && !s.alice.Equals(sdk.AccAddress(s.classRoyalty.Recipient)) {
+		royalty.Amount = getRoyalty(proceeds, s.classRoyalty.RoyaltyBps)
+	}
+
+	sendCoin := sdk.NewCoin(s.askPrice.Denom, proceeds.Sub(royalty.Amount))
 	sendCoins := sdk.NewCoins(sendCoin)
 
+	s.tradingFeeExpectCalls()
+
+	// the royalty is paid in a cache context
+	s.bankKeeper.EXPECT().
+		SendCoins(gomock.Any(), s.bob, s.carol, sdk.NewCoins(royalty)).
+		Do(func(sdk.Context, sdk.AccAddress, sdk.AccAddress, sdk.Coins) {
+			s.bobBankBalance = s.bobBankBalance.Sub(royalty)
+			s.carolBankBalance = s.carolBankBalance.Add(royalty)
+		}).
+		AnyTimes() // only expected when a royalty is paid
+
 	s.bankKeeper.EXPECT().
 		GetBalance(s.sdkCtx, s.bob, s.bidPrice.Denom).
 		Return(s.bobBankBalance).
@@ -631,6 +754,57 @@ func (s *buyDirectSuite) multipleBuyOrderExpectCalls() {
 		AnyTimes() // not expected on failed attempt
 }
 
+func (s *buyDirectSuite) setClassRoyalty(royaltyBps string, recipient sdk.AccAddress) {
+	bps, err := strconv.ParseUint(royaltyBps, 10, 32)
+	require.NoError(s.t, err)
+
+	class, err := s.baseStore.ClassTable().GetById(s.ctx, s.classID)
+	require.NoError(s.t, err)
+
+	s.classRoyalty = &api.ClassRoyalty{
+		ClassKey:   class.Key,
+		RoyaltyBps: uint32(bps),
+		Recipient:  recipient,
+	}
+
+	err = s.marketStore.ClassRoyaltyTable().Insert(s.ctx, s.classRoyalty)
+	require.NoError(s.t, err)
+}
+
+// tradingFeeExpectCalls tracks the trading fees paid by bob by destination
+func (s *buyDirectSuite) tradingFeeExpectCalls() {
+	payFee := func(recipient string, fees sdk.Coins) {
+		s.bobBankBalance = s.bobBankBalance.Sub(sdk.NewCoin(s.bobBankBalance.Denom, fees.AmountOf(s.bobBankBalance.Denom)))
+		s.tradingFees[recipient] = s.tradingFees[recipient].Add(fees...)
+	}
+
+	s.bankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(s.sdkCtx, s.bob, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ sdk.Context, _ sdk.AccAddress, module string, fees sdk.Coins) error {
+			if module != ecocredit.ModuleName {
+				payFee(module, fees)
+			}
+			return nil
+		}).
+		AnyTimes() // only expected when trading fees are charged
+
+	s.bankKeeper.EXPECT().
+		BurnCoins(s.sdkCtx, ecocredit.ModuleName, gomock.Any()).
+		DoAndReturn(func(_ sdk.Context, _ string, fees sdk.Coins) error {
+			payFee("burn", fees)
+			return nil
+		}).
+		AnyTimes() // only expected when trading fees are burned
+
+	s.distrKeeper.EXPECT().
+		FundCommunityPool(s.sdkCtx, gomock.Any(), s.bob).
+		DoAndReturn(func(_ sdk.Context, fees sdk.Coins, _ sdk.AccAddress) error {
+			payFee("community pool", fees)
+			return nil
+		}).
+		AnyTimes() // only expected when trading fees are sent to the community pool
+}
+
 func (s *buyDirectSuite) calculateAskTotal(quantity string, amount string) sdkmath.Int {
 	q, err := math.NewDecFromString(quantity)
 	require.NoError(s.t, err)
@@ -0,0 +1,202 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	marketApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// Buy creates new buy orders and escrows the total bid amount of each buy order,
+// together with the highest trading fee that can be charged on the total bid
+// amount, in the ecocredit module account until the buy order is filled,
+// cancelled, or expired.
+func (k Keeper) Buy(ctx context.Context, req *types.MsgBuyOrder) (*types.MsgBuyOrderResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	buyerAcc, err := sdk.AccAddressFromBech32(req.Buyer)
+	if err != nil {
+		return nil, err
+	}
+
+	escrowedFeeBps, err := k.getEscrowFeeBps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	buyOrderIDs := make([]uint64, len(req.Orders))
+
+	for i, order := range req.Orders {
+		// orderIndex is used for more granular error messages when
+		// an individual order in a list of orders fails to process
+		orderIndex := fmt.Sprintf("orders[%d]", i)
+
+		buyOrder := &marketApi.BuyOrder{
+			Buyer:                  buyerAcc,
+			Quantity:               order.Quantity,
+			BidAmount:              order.BidPrice.Amount.String(),
+			DisableAutoRetire:      order.DisableAutoRetire,
+			RetirementJurisdiction: order.RetirementJurisdiction,
+			RetirementReason:       order.RetirementReason,
+			RetirementBeneficiary:  order.RetirementBeneficiary,
+			Maker:                  true,
+		}
+
+		creditType, err := k.setBuyOrderSelector(ctx, buyOrder, order, orderIndex)
+		if err != nil {
+			return nil, err
+		}
+
+		allowed, err := isDenomAllowed(ctx, order.BidPrice.Denom, k.stateStore.AllowedDenomTable())
+		if err != nil {
+			return nil, err
+		}
+		if !allowed {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"%s: %s is not allowed to be used in buy orders", orderIndex, order.BidPrice.Denom,
+			)
+		}
+
+		marketID, err := k.getOrCreateMarketID(ctx, creditType.Abbreviation, order.BidPrice.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		// verify expiration is in the future
+		if order.Expiration != nil && !order.Expiration.UTC().After(sdkCtx.BlockTime()) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"%s: expiration must be in the future: %s", orderIndex, order.Expiration,
+			)
+		}
+
+		buyQty, err := math.NewPositiveFixedDecFromString(order.Quantity, creditType.Precision)
+		if err != nil {
+			return nil, err
+		}
+
+		// check address has the total bid amount (bid price * order quantity)
+		// and the highest trading fee that can be charged on the total bid amount
+		bal := k.bankKeeper.GetBalance(sdkCtx, buyerAcc, order.BidPrice.Denom)
+		total, err := utils.GetTotalCost(order.BidPrice.Amount, buyQty)
+		if err != nil {
+			return nil, err
+		}
+		coinTotal := sdk.Coin{Amount: getEscrowAmount(total, escrowedFeeBps), Denom: order.BidPrice.Denom}
+		if bal.IsLT(coinTotal) {
+			return nil, sdkerrors.ErrInsufficientFunds.Wrapf(
+				"%s: quantity: %s, bid price: %v, total price: %v, bank balance: %v",
+				orderIndex, order.Quantity, order.BidPrice, coinTotal, bal,
+			)
+		}
+
+		if err = k.bankKeeper.SendCoinsFromAccountToModule(sdkCtx, buyerAcc, ecocredit.ModuleName, sdk.NewCoins(coinTotal)); err != nil {
+			return nil, err
+		}
+
+		buyOrder.MarketId = marketID
+		buyOrder.EscrowedFeeBps = escrowedFeeBps
+		if order.Expiration != nil {
+			buyOrder.Expiration = timestamppb.New(*order.Expiration)
+		}
+
+		id, err := k.stateStore.BuyOrderTable().InsertReturningID(ctx, buyOrder)
+		if err != nil {
+			return nil, err
+		}
+
+		// a buy order is a taker order if it matches existing sell orders
+		matched, err := k.orderBook.OnInsertBuyOrder(ctx, buyOrder)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			buyOrder.Maker = false
+			if err = k.stateStore.BuyOrderTable().Update(ctx, buyOrder); err != nil {
+				return nil, err
+			}
+		}
+
+		buyOrderIDs[i] = id
+
+		if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventBuyOrder{
+			BuyOrderId: id,
+		}); err != nil {
+			return nil, err
+		}
+
+		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/marketplace/MsgBuyOrder order iteration")
+	}
+
+	return &types.MsgBuyOrderResponse{BuyOrderIds: buyOrderIDs}, nil
+}
+
+// setBuyOrderSelector sets the batch key, or the class or project selector, of
+// the buy order and returns the credit type of the credits the buy order bids on.
+func (k Keeper) setBuyOrderSelector(ctx context.Context, buyOrder *marketApi.BuyOrder, order *types.MsgBuyOrder_Order, orderIndex string) (*baseapi.CreditType, error) {
+	var classKey uint64
+
+	switch {
+	case order.ClassSelector != nil:
+		class, err := k.baseStore.ClassTable().GetById(ctx, order.ClassSelector.ClassId)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"%s: class id %s: %s", orderIndex, order.ClassSelector.ClassId, err.Error(),
+			)
+		}
+		classKey = class.Key
+
+		buyOrder.ClassKey = class.Key
+		buyOrder.ProjectLocation = order.ClassSelector.ProjectLocation
+		buyOrder.MinStartDate = protoTimestamp(order.ClassSelector.MinStartDate)
+		buyOrder.MaxEndDate = protoTimestamp(order.ClassSelector.MaxEndDate)
+	case order.ProjectSelector != nil:
+		project, err := k.baseStore.ProjectTable().GetById(ctx, order.ProjectSelector.ProjectId)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"%s: project id %s: %s", orderIndex, order.ProjectSelector.ProjectId, err.Error(),
+			)
+		}
+		classKey = project.ClassKey
+
+		buyOrder.ProjectKey = project.Key
+		buyOrder.MinStartDate = protoTimestamp(order.ProjectSelector.MinStartDate)
+		buyOrder.MaxEndDate = protoTimestamp(order.ProjectSelector.MaxEndDate)
+	default:
+		batch, err := k.baseStore.BatchTable().GetByDenom(ctx, order.BatchDenom)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"%s: batch denom %s: %s", orderIndex, order.BatchDenom, err.Error(),
+			)
+		}
+
+		buyOrder.BatchKey = batch.Key
+
+		return utils.GetCreditTypeFromBatchDenom(ctx, k.baseStore, batch.Denom)
+	}
+
+	class, err := k.baseStore.ClassTable().Get(ctx, classKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.baseStore.CreditTypeTable().Get(ctx, class.CreditTypeAbbrev)
+}
+
+// protoTimestamp converts an optional time to a protobuf timestamp.
+func protoTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
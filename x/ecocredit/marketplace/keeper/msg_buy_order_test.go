@@ -0,0 +1,349 @@
+//nolint:revive,stylecheck
+package keeper
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+type buyOrderSuite struct {
+	*baseSuite
+	alice            sdk.AccAddress
+	bob              sdk.AccAddress
+	bankBalances     map[string]sdk.Coins
+	creditTypeAbbrev string
+	classID          string
+	batchDenom       string
+	bidPrice         *sdk.Coin
+	quantity         string
+	expiration       *time.Time
+	res              *types.MsgBuyOrderResponse
+	err              error
+}
+
+func TestBuyOrder(t *testing.T) {
+	gocuke.NewRunner(t, &buyOrderSuite{}).Path("./features/msg_buy_order.feature").Run()
+}
+
+func (s *buyOrderSuite) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t, 2)
+	s.alice = s.addrs[0]
+	s.bob = s.addrs[1]
+	s.bankBalances = s.mockBankBalances()
+	s.creditTypeAbbrev = "C"
+	s.classID = testClassID
+	s.batchDenom = testBatchDenom
+	s.bidPrice = &sdk.Coin{
+		Denom:  "regen",
+		Amount: sdk.NewInt(10),
+	}
+	s.quantity = "10"
+
+	expiration, err := regentypes.ParseDate("expiration", "2030-01-01")
+	require.NoError(s.t, err)
+
+	s.expiration = &expiration
+}
+
+func (s *buyOrderSuite) ABlockTimeWithTimestamp(a string) {
+	blockTime, err := regentypes.ParseDate("block time", a)
+	require.NoError(s.t, err)
+
+	s.sdkCtx = s.sdkCtx.WithBlockTime(blockTime)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+}
+
+func (s *buyOrderSuite) ACreditType() {
+	err := s.baseStore.CreditTypeTable().Insert(s.ctx, &baseapi.CreditType{
+		Abbreviation: s.creditTypeAbbrev,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *buyOrderSuite) ACreditTypeWithPrecision(a string) {
+	precision, err := strconv.ParseUint(a, 10, 32)
+	require.NoError(s.t, err)
+
+	err = s.baseStore.CreditTypeTable().Insert(s.ctx, &baseapi.CreditType{
+		Abbreviation: s.creditTypeAbbrev,
+		Precision:    uint32(precision),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *buyOrderSuite) ACreditBatch() {
+	s.ACreditBatchWithBatchDenom(s.batchDenom)
+}
+
+func (s *buyOrderSuite) ACreditBatchWithBatchDenom(a string) {
+	classID := base.GetClassIDFromBatchDenom(a)
+	creditTypeAbbrev := base.GetCreditTypeAbbrevFromClassID(classID)
+
+	classKey, err := s.baseStore.ClassTable().InsertReturningID(s.ctx, &baseapi.Class{
+		Id:               classID,
+		CreditTypeAbbrev: creditTypeAbbrev,
+	})
+	require.NoError(s.t, err)
+
+	projectKey, err := s.baseStore.ProjectTable().InsertReturningID(s.ctx, &baseapi.Project{
+		Id:           base.GetProjectIDFromBatchDenom(a),
+		ClassKey:     classKey,
+		Jurisdiction: "US-OR",
+	})
+	require.NoError(s.t, err)
+
+	err = s.baseStore.BatchTable().Insert(s.ctx, &baseapi.Batch{
+		ProjectKey: projectKey,
+		Denom:      a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *buyOrderSuite) AnAllowedDenom() {
+	s.AnAllowedDenomWithBankDenom(s.bidPrice.Denom)
+}
+
+func (s *buyOrderSuite) AnAllowedDenomWithBankDenom(a string) {
+	err := s.marketStore.AllowedDenomTable().Insert(s.ctx, &api.AllowedDenom{
+		BankDenom: a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *buyOrderSuite) BobHasTheBankBalance(a string) {
+	coins, err := sdk.ParseCoinsNormalized(a)
+	require.NoError(s.t, err)
+
+	s.bankBalances[s.bob.String()] = coins
+}
+
+func (s *buyOrderSuite) AliceCreatedASellOrderWithQuantityAndAskPrice(a string, b string) {
+	askPrice, err := sdk.ParseCoinNormalized(b)
+	require.NoError(s.t, err)
+
+	batch, err := s.baseStore.BatchTable().GetByDenom(s.ctx, s.batchDenom)
+	require.NoError(s.t, err)
+
+	err = s.baseStore.BatchBalanceTable().Insert(s.ctx, &baseapi.BatchBalance{
+		BatchKey:       batch.Key,
+		Address:        s.alice,
+		TradableAmount: a,
+	})
+	require.NoError(s.t, err)
+
+	_, err = s.k.Sell(s.ctx, &types.MsgSell{
+		Seller: s.alice.String(),
+		Orders: []*types.MsgSell_Order{
+			{
+				BatchDenom: s.batchDenom,
+				Quantity:   a,
+				AskPrice:   &askPrice,
+			},
+		},
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *buyOrderSuite) BobAttemptsToCreateABuyOrderWithBatchDenom(a string) {
+	s.res, s.err = s.k.Buy(s.ctx, &types.MsgBuyOrder{
+		Buyer: s.bob.String(),
+		Orders: []*types.MsgBuyOrder_Order{
+			{
+				BatchDenom: a,
+				Quantity:   s.quantity,
+				BidPrice:   s.bidPrice,
+			},
+		},
+	})
+}
+
+func (s *buyOrderSuite) BobAttemptsToCreateABuyOrderWithBidPrice(a string) {
+	bidPrice, err := sdk.ParseCoinNormalized(a)
+	require.NoError(s.t, err)
+
+	s.res, s.err = s.k.Buy(s.ctx, &types.MsgBuyOrder{
+		Buyer: s.bob.String(),
+		Orders: []*types.MsgBuyOrder_Order{
+			{
+				BatchDenom: s.batchDenom,
+				Quantity:   s.quantity,
+				BidPrice:   &bidPrice,
+			},
+		},
+	})
+}
+
+func (s *buyOrderSuite) BobAttemptsToCreateABuyOrderWithExpiration(a string) {
+	expiration, err := regentypes.ParseDate("expiration", a)
+	require.NoError(s.t, err)
+
+	s.res, s.err = s.k.Buy(s.ctx, &types.MsgBuyOrder{
+		Buyer: s.bob.String(),
+		Orders: []*types.MsgBuyOrder_Order{
+			{
+				BatchDenom: s.batchDenom,
+				Quantity:   s.quantity,
+				BidPrice:   s.bidPrice,
+				Expiration: &expiration,
+			},
+		},
+	})
+}
+
+func (s *buyOrderSuite) BobAttemptsToCreateABuyOrderWithQuantity(a string) {
+	s.res, s.err = s.k.Buy(s.ctx, &types.MsgBuyOrder{
+		Buyer: s.bob.String(),
+		Orders: []*types.MsgBuyOrder_Order{
+			{
+				BatchDenom: s.batchDenom,
+				Quantity:   a,
+				BidPrice:   s.bidPrice,
+			},
+		},
+	})
+}
+
+func (s *buyOrderSuite) BobAttemptsToCreateABuyOrderWithQuantityAndBidPrice(a string, b string) {
+	bidPrice, err := sdk.ParseCoinNormalized(b)
+	require.NoError(s.t, err)
+
+	s.res, s.err = s.k.Buy(s.ctx, &types.MsgBuyOrder{
+		Buyer: s.bob.String(),
+		Orders: []*types.MsgBuyOrder_Order{
+			{
+				BatchDenom: s.batchDenom,
+				Quantity:   a,
+				BidPrice:   &bidPrice,
+			},
+		},
+	})
+}
+
+func (s *buyOrderSuite) BobAttemptsToCreateTwoBuyOrdersEachWithQuantityAndBidPrice(a string, b string) {
+	bidPrice, err := sdk.ParseCoinNormalized(b)
+	require.NoError(s.t, err)
+
+	s.res, s.err = s.k.Buy(s.ctx, &types.MsgBuyOrder{
+		Buyer: s.bob.String(),
+		Orders: []*types.MsgBuyOrder_Order{
+			{
+				BatchDenom: s.batchDenom,
+				Quantity:   a,
+				BidPrice:   &bidPrice,
+			},
+			{
+				BatchDenom: s.batchDenom,
+				Quantity:   a,
+				BidPrice:   &bidPrice,
+			},
+		},
+	})
+}
+
+func (s *buyOrderSuite) BobAttemptsToCreateABuyOrderWithTheProperties(a gocuke.DocString) {
+	order := &types.MsgBuyOrder_Order{}
+	err := jsonpb.UnmarshalString(a.Content, order)
+	require.NoError(s.t, err)
+
+	s.res, s.err = s.k.Buy(s.ctx, &types.MsgBuyOrder{
+		Buyer:  s.bob.String(),
+		Orders: []*types.MsgBuyOrder_Order{order},
+	})
+}
+
+func (s *buyOrderSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *buyOrderSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *buyOrderSuite) ExpectBobBankBalance(a string) {
+	expected, err := sdk.ParseCoinsNormalized(a)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.String(), s.bankBalances[s.bob.String()].String())
+}
+
+func (s *buyOrderSuite) ExpectEscrowedBankBalance(a string) {
+	expected, err := sdk.ParseCoinsNormalized(a)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.String(), s.bankBalances[ecocredit.ModuleName].String())
+}
+
+func (s *buyOrderSuite) ExpectBuyOrderWithIdAndMaker(a string, b string) {
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	maker, err := strconv.ParseBool(b)
+	require.NoError(s.t, err)
+
+	order, err := s.marketStore.BuyOrderTable().Get(s.ctx, id)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, maker, order.Maker)
+}
+
+func (s *buyOrderSuite) ExpectBuyOrderWithBuyerBobAndTheProperties(a gocuke.DocString) {
+	expected := &types.BuyOrder{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	order, err := s.marketStore.BuyOrderTable().Get(s.ctx, expected.Id)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.Id, order.Id)
+	require.Equal(s.t, s.bob.Bytes(), order.Buyer)
+	require.Equal(s.t, expected.BatchKey, order.BatchKey)
+	require.Equal(s.t, expected.ClassKey, order.ClassKey)
+	require.Equal(s.t, expected.ProjectKey, order.ProjectKey)
+	require.Equal(s.t, expected.ProjectLocation, order.ProjectLocation)
+	require.Equal(s.t, expected.MinStartDate.GetSeconds(), order.MinStartDate.GetSeconds())
+	require.Equal(s.t, expected.MaxEndDate.GetSeconds(), order.MaxEndDate.GetSeconds())
+	require.Equal(s.t, expected.Quantity, order.Quantity)
+	require.Equal(s.t, expected.MarketId, order.MarketId)
+	require.Equal(s.t, expected.BidAmount, order.BidAmount)
+	require.Equal(s.t, expected.DisableAutoRetire, order.DisableAutoRetire)
+	require.Equal(s.t, expected.RetirementJurisdiction, order.RetirementJurisdiction)
+	require.Equal(s.t, expected.Expiration.Seconds, order.Expiration.Seconds)
+	require.Equal(s.t, expected.Expiration.Nanos, order.Expiration.Nanos)
+	require.Equal(s.t, expected.Maker, order.Maker)
+}
+
+func (s *buyOrderSuite) ExpectTheResponse(a gocuke.DocString) {
+	res := &types.MsgBuyOrderResponse{}
+	err := jsonpb.UnmarshalString(a.Content, res)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, res, s.res)
+}
+
+func (s *buyOrderSuite) ExpectEventWithProperties(a gocuke.DocString) {
+	var event types.EventBuyOrder
+	err := json.Unmarshal([]byte(a.Content), &event)
+	require.NoError(s.t, err)
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
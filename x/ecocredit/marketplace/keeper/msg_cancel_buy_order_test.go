@@ -0,0 +1,175 @@
+//nolint:revive,stylecheck
+package keeper
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+type cancelBuyOrder struct {
+	*baseSuite
+	alice            sdk.AccAddress
+	bob              sdk.AccAddress
+	bankBalances     map[string]sdk.Coins
+	creditTypeAbbrev string
+	classID          string
+	batchDenom       string
+	buyOrderID       uint64
+	bidPrice         sdk.Coin
+	quantity         string
+	res              *types.MsgCancelBuyOrderResponse
+	err              error
+}
+
+func TestCancelBuyOrder(t *testing.T) {
+	gocuke.NewRunner(t, &cancelBuyOrder{}).Path("./features/msg_cancel_buy_order.feature").Run()
+}
+
+func (s *cancelBuyOrder) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t, 2)
+	s.alice = s.addrs[0]
+	s.bob = s.addrs[1]
+	s.bankBalances = s.mockBankBalances()
+	s.creditTypeAbbrev = "C"
+	s.classID = testClassID
+	s.batchDenom = testBatchDenom
+	s.bidPrice = sdk.NewInt64Coin("regen", 10)
+	s.quantity = "10"
+}
+
+func (s *cancelBuyOrder) BobCreatedABuyOrderWithId(a string) {
+	id, err := strconv.ParseUint(a, 10, 32)
+	require.NoError(s.t, err)
+
+	s.buyOrderID = id
+
+	s.buyOrderSetup()
+}
+
+func (s *cancelBuyOrder) BobCreatedABuyOrderWithIdAndQuantity(a string, b string) {
+	id, err := strconv.ParseUint(a, 10, 32)
+	require.NoError(s.t, err)
+
+	s.buyOrderID = id
+	s.quantity = b
+
+	s.buyOrderSetup()
+}
+
+func (s *cancelBuyOrder) AliceAttemptsToCancelTheBuyOrderWithId(a string) {
+	id, err := strconv.ParseUint(a, 10, 32)
+	require.NoError(s.t, err)
+
+	s.res, s.err = s.k.CancelBuyOrder(s.ctx, &types.MsgCancelBuyOrder{
+		Buyer:      s.alice.String(),
+		BuyOrderId: id,
+	})
+}
+
+func (s *cancelBuyOrder) BobAttemptsToCancelTheBuyOrderWithId(a string) {
+	id, err := strconv.ParseUint(a, 10, 32)
+	require.NoError(s.t, err)
+
+	s.res, s.err = s.k.CancelBuyOrder(s.ctx, &types.MsgCancelBuyOrder{
+		Buyer:      s.bob.String(),
+		BuyOrderId: id,
+	})
+}
+
+func (s *cancelBuyOrder) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *cancelBuyOrder) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *cancelBuyOrder) ExpectBobBankBalance(a string) {
+	expected, err := sdk.ParseCoinsNormalized(a)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.String(), s.bankBalances[s.bob.String()].String())
+}
+
+func (s *cancelBuyOrder) ExpectEscrowedBankBalance(a string) {
+	expected, err := sdk.ParseCoinsNormalized(a)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.String(), s.bankBalances[ecocredit.ModuleName].String())
+}
+
+func (s *cancelBuyOrder) ExpectNoBuyOrderWithId(a string) {
+	id, err := strconv.ParseUint(a, 10, 32)
+	require.NoError(s.t, err)
+
+	_, err = s.marketStore.BuyOrderTable().Get(s.ctx, id)
+	require.ErrorContains(s.t, err, ormerrors.NotFound.Error())
+}
+
+func (s *cancelBuyOrder) ExpectEventWithProperties(a gocuke.DocString) {
+	var event types.EventCancelBuyOrder
+	err := json.Unmarshal([]byte(a.Content), &event)
+	require.NoError(s.t, err)
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
+
+func (s *cancelBuyOrder) buyOrderSetup() {
+	err := s.baseStore.ClassTable().Insert(s.ctx, &baseapi.Class{
+		Id:               s.classID,
+		CreditTypeAbbrev: s.creditTypeAbbrev,
+	})
+	require.NoError(s.t, err)
+
+	batchKey, err := s.baseStore.BatchTable().InsertReturningID(s.ctx, &baseapi.Batch{
+		Denom: s.batchDenom,
+	})
+	require.NoError(s.t, err)
+
+	marketKey, err := s.marketStore.MarketTable().InsertReturningID(s.ctx, &api.Market{
+		CreditTypeAbbrev: s.creditTypeAbbrev,
+		BankDenom:        s.bidPrice.Denom,
+	})
+	require.NoError(s.t, err)
+
+	buyOrder := &api.BuyOrder{
+		Buyer:     s.bob,
+		BatchKey:  batchKey,
+		Quantity:  s.quantity,
+		MarketId:  marketKey,
+		BidAmount: s.bidPrice.Amount.String(),
+	}
+	buyOrderID, err := s.marketStore.BuyOrderTable().InsertReturningID(s.ctx, buyOrder)
+	require.NoError(s.t, err)
+	require.Equal(s.t, buyOrderID, s.buyOrderID)
+
+	_, err = s.orderBook.OnInsertBuyOrder(s.ctx, buyOrder)
+	require.NoError(s.t, err)
+
+	quantity, err := math.NewDecFromString(s.quantity)
+	require.NoError(s.t, err)
+
+	escrowed, err := utils.GetTotalCost(s.bidPrice.Amount, quantity)
+	require.NoError(s.t, err)
+
+	s.bankBalances[ecocredit.ModuleName] = sdk.NewCoins(sdk.NewCoin(s.bidPrice.Denom, escrowed))
+}
@@ -6,13 +6,12 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
 	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
 )
 
 // CancelSellOrder cancels a sell order and returns the escrowed credits to the seller.
 func (k Keeper) CancelSellOrder(ctx context.Context, req *types.MsgCancelSellOrder) (*types.MsgCancelSellOrderResponse, error) {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-
 	sellerAcc, err := sdk.AccAddressFromBech32(req.Seller)
 	if err != nil {
 		return nil, err
@@ -27,16 +26,37 @@ func (k Keeper) CancelSellOrder(ctx context.Context, req *types.MsgCancelSellOrd
 		return nil, sdkerrors.ErrUnauthorized.Wrapf("seller must be the owner of the sell order")
 	}
 
-	err = k.unescrowCredits(ctx, sellerAcc, sellOrder.BatchKey, sellOrder.Quantity)
+	return &types.MsgCancelSellOrderResponse{}, k.cancelSellOrder(ctx, sellOrder)
+}
+
+// cancelSellOrder returns the escrowed credits of the sell order to the seller,
+// rejects the open offers on the sell order and removes the sell order from
+// state.
+func (k Keeper) cancelSellOrder(ctx context.Context, sellOrder *api.SellOrder) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	err := k.unescrowCredits(ctx, sellOrder.Seller, sellOrder.BatchKey, sellOrder.Quantity)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventCancelSellOrder{
 		SellOrderId: sellOrder.Id,
 	}); err != nil {
-		return nil, err
+		return err
+	}
+
+	if err = k.stateStore.SellOrderTable().Delete(ctx, sellOrder); err != nil {
+		return err
+	}
+
+	if err = k.deleteSellOrderAsks(ctx, sellOrder.Id); err != nil {
+		return err
+	}
+
+	if err = k.rejectSellOrderOffers(ctx, sellOrder.Id); err != nil {
+		return err
 	}
 
-	return &types.MsgCancelSellOrderResponse{}, k.stateStore.SellOrderTable().Delete(ctx, sellOrder)
+	return k.orderBook.OnRemoveSellOrder(ctx, sellOrder.Id)
 }
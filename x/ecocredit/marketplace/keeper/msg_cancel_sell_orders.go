@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+// CancelSellOrders cancels the sell orders specified by id or, when cancelling
+// all sell orders, all sell orders of the seller, optionally filtered by credit
+// batch, and returns the escrowed credits to the seller.
+func (k Keeper) CancelSellOrders(ctx context.Context, req *types.MsgCancelSellOrders) (*types.MsgCancelSellOrdersResponse, error) {
+	sellerAcc, err := sdk.AccAddressFromBech32(req.Seller)
+	if err != nil {
+		return nil, err
+	}
+
+	var sellOrders []*api.SellOrder
+	if req.CancelAll {
+		sellOrders, err = k.getSellOrdersBySeller(ctx, sellerAcc, req.BatchDenom)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		for i, id := range req.SellOrderIds {
+			// orderIndex is used for more granular error messages when
+			// an individual order in a list of orders fails to process
+			orderIndex := fmt.Sprintf("sell_order_ids[%d]", i)
+
+			sellOrder, err := k.stateStore.SellOrderTable().Get(ctx, id)
+			if err != nil {
+				return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s: sell order with id %d: %s", orderIndex, id, err.Error())
+			}
+
+			if !sellerAcc.Equals(sdk.AccAddress(sellOrder.Seller)) {
+				return nil, sdkerrors.ErrUnauthorized.Wrapf("%s: seller must be the owner of the sell order", orderIndex)
+			}
+
+			sellOrders = append(sellOrders, sellOrder)
+		}
+	}
+
+	sellOrderIDs := make([]uint64, 0, len(sellOrders))
+	for _, sellOrder := range sellOrders {
+		if err = k.cancelSellOrder(ctx, sellOrder); err != nil {
+			return nil, err
+		}
+		sellOrderIDs = append(sellOrderIDs, sellOrder.Id)
+	}
+
+	return &types.MsgCancelSellOrdersResponse{SellOrderIds: sellOrderIDs}, nil
+}
+
+// getSellOrdersBySeller returns all sell orders of the seller, filtered by the
+// credit batch if batchDenom is not empty.
+func (k Keeper) getSellOrdersBySeller(ctx context.Context, seller sdk.AccAddress, batchDenom string) ([]*api.SellOrder, error) {
+	var batchKey uint64
+	if batchDenom != "" {
+		batch, err := k.baseStore.BatchTable().GetByDenom(ctx, batchDenom)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("batch denom %s: %s", batchDenom, err.Error())
+		}
+		batchKey = batch.Key
+	}
+
+	it, err := k.stateStore.SellOrderTable().List(ctx, api.SellOrderSellerIndexKey{}.WithSeller(seller))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var sellOrders []*api.SellOrder
+	for it.Next() {
+		sellOrder, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		if batchKey != 0 && sellOrder.BatchKey != batchKey {
+			continue
+		}
+
+		sellOrders = append(sellOrders, sellOrder)
+	}
+
+	return sellOrders, nil
+}
@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+const cancelBatchDenom = "C01-001-20200101-20200201-002"
+
+// setupCancelSellOrders sets up sell orders 1 and 2 of 10 credits each from
+// the first batch and sell order 3 of 10 credits from a second batch for the
+// first test account, and sell order 4 of 10 credits from the first batch for
+// the second test account.
+func setupCancelSellOrders(t *testing.T) *baseSuite {
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], classID, start, end, creditType)
+
+	assert.NilError(t, s.baseStore.BatchTable().Insert(s.ctx, &baseapi.Batch{
+		ProjectKey: 1,
+		Denom:      cancelBatchDenom,
+		StartDate:  start,
+		EndDate:    end,
+	}))
+	for _, balance := range []*baseapi.BatchBalance{
+		{BatchKey: 2, Address: s.addrs[0], TradableAmount: "100", EscrowedAmount: "0"},
+		{BatchKey: 1, Address: s.addrs[1], TradableAmount: "100", EscrowedAmount: "0"},
+	} {
+		assert.NilError(t, s.baseStore.BatchBalanceTable().Insert(s.ctx, balance))
+	}
+
+	sell := func(seller int, batchDenoms ...string) {
+		var orders []*types.MsgSell_Order
+		for _, denom := range batchDenoms {
+			orders = append(orders, &types.MsgSell_Order{BatchDenom: denom, Quantity: "10", AskPrice: &ask})
+		}
+		_, err := s.k.Sell(s.ctx, &types.MsgSell{Seller: s.addrs[seller].String(), Orders: orders})
+		assert.NilError(t, err)
+	}
+	sell(0, batchDenom, batchDenom, cancelBatchDenom)
+	sell(1, batchDenom)
+
+	return s
+}
+
+// assertSellOrdersCancelled checks that the sell orders were removed from
+// state and that the other sell orders still exist.
+func (s *baseSuite) assertSellOrdersCancelled(t *testing.T, cancelled ...uint64) {
+	isCancelled := make(map[uint64]bool)
+	for _, id := range cancelled {
+		isCancelled[id] = true
+	}
+	for id := uint64(1); id <= 4; id++ {
+		_, err := s.marketStore.SellOrderTable().Get(s.ctx, id)
+		if isCancelled[id] {
+			assert.ErrorIs(t, err, ormerrors.NotFound)
+		} else {
+			assert.NilError(t, err)
+		}
+	}
+}
+
+func TestCancelSellOrders_ByID(t *testing.T) {
+	t.Parallel()
+	s := setupCancelSellOrders(t)
+
+	res, err := s.k.CancelSellOrders(s.ctx, &types.MsgCancelSellOrders{
+		Seller:       s.addrs[0].String(),
+		SellOrderIds: []uint64{1, 3},
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []uint64{1, 3}, res.SellOrderIds)
+	s.assertSellOrdersCancelled(t, 1, 3)
+
+	// the escrowed credits are returned to the seller
+	bal, err := s.baseStore.BatchBalanceTable().Get(s.ctx, s.addrs[0], 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "90", bal.TradableAmount)
+	assert.Equal(t, "10", bal.EscrowedAmount)
+	bal, err = s.baseStore.BatchBalanceTable().Get(s.ctx, s.addrs[0], 2)
+	assert.NilError(t, err)
+	assert.Equal(t, "100", bal.TradableAmount)
+	assert.Equal(t, "0", bal.EscrowedAmount)
+}
+
+func TestCancelSellOrders_ByIDInvalid(t *testing.T) {
+	t.Parallel()
+	s := setupCancelSellOrders(t)
+
+	// no sell orders are cancelled if any sell order cannot be cancelled
+	_, err := s.k.CancelSellOrders(s.ctx, &types.MsgCancelSellOrders{
+		Seller:       s.addrs[0].String(),
+		SellOrderIds: []uint64{1, 5},
+	})
+	assert.ErrorContains(t, err, "sell_order_ids[1]: sell order with id 5: not found")
+
+	_, err = s.k.CancelSellOrders(s.ctx, &types.MsgCancelSellOrders{
+		Seller:       s.addrs[0].String(),
+		SellOrderIds: []uint64{1, 4},
+	})
+	assert.ErrorContains(t, err, "sell_order_ids[1]: seller must be the owner of the sell order")
+
+	s.assertSellOrdersCancelled(t)
+}
+
+func TestCancelSellOrders_All(t *testing.T) {
+	t.Parallel()
+	s := setupCancelSellOrders(t)
+
+	res, err := s.k.CancelSellOrders(s.ctx, &types.MsgCancelSellOrders{
+		Seller:    s.addrs[0].String(),
+		CancelAll: true,
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []uint64{1, 2, 3}, res.SellOrderIds)
+	s.assertSellOrdersCancelled(t, 1, 2, 3)
+
+	// cancelling all sell orders without any sell orders is a no-op
+	res, err = s.k.CancelSellOrders(s.ctx, &types.MsgCancelSellOrders{
+		Seller:    s.addrs[0].String(),
+		CancelAll: true,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(res.SellOrderIds))
+}
+
+func TestCancelSellOrders_AllByBatch(t *testing.T) {
+	t.Parallel()
+	s := setupCancelSellOrders(t)
+
+	res, err := s.k.CancelSellOrders(s.ctx, &types.MsgCancelSellOrders{
+		Seller:     s.addrs[0].String(),
+		CancelAll:  true,
+		BatchDenom: batchDenom,
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []uint64{1, 2}, res.SellOrderIds)
+	s.assertSellOrdersCancelled(t, 1, 2)
+
+	_, err = s.k.CancelSellOrders(s.ctx, &types.MsgCancelSellOrders{
+		Seller:     s.addrs[0].String(),
+		CancelAll:  true,
+		BatchDenom: "C01-001-20200101-20200201-003",
+	})
+	assert.ErrorContains(t, err, "batch denom C01-001-20200101-20200201-003: not found")
+}
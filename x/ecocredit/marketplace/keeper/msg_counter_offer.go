@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+// CounterOffer responds to an offer with a new price. The seller counters an
+// offer with a price higher than the bid price, after which the offer awaits a
+// response from the buyer. The buyer counters a counter offer with a new bid
+// price lower than the counter price, after which the escrowed funds are
+// updated and the offer awaits a response from the seller.
+func (k Keeper) CounterOffer(ctx context.Context, req *types.MsgCounterOffer) (*types.MsgCounterOfferResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	senderAcc, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	offer, err := k.stateStore.OfferTable().Get(ctx, req.OfferId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("offer with id %d: %s", req.OfferId, err.Error())
+	}
+
+	sellOrder, err := k.stateStore.SellOrderTable().Get(ctx, offer.SellOrderId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("sell order with id %d: %s", offer.SellOrderId, err.Error())
+	}
+
+	if err = checkOfferResponder(offer, sellOrder, senderAcc); err != nil {
+		return nil, err
+	}
+
+	market, err := k.stateStore.MarketTable().Get(ctx, offer.MarketId)
+	if err != nil {
+		return nil, err
+	}
+	if req.Price.Denom != market.BankDenom {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"price denom: %s, offer denom: %s", req.Price.Denom, market.BankDenom,
+		)
+	}
+
+	if offer.CounterAmount == "" {
+		bidAmount, err := parseAmount(offer.BidAmount)
+		if err != nil {
+			return nil, err
+		}
+		if !req.Price.Amount.GT(bidAmount) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"bid price: %s%s, price: %v, counter price must be higher than the bid price",
+				bidAmount, market.BankDenom, req.Price,
+			)
+		}
+		offer.CounterAmount = req.Price.Amount.String()
+	} else {
+		counterAmount, err := parseAmount(offer.CounterAmount)
+		if err != nil {
+			return nil, err
+		}
+		if !req.Price.Amount.LT(counterAmount) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"counter price: %s%s, price: %v, bid price must be lower than the counter price",
+				counterAmount, market.BankDenom, req.Price,
+			)
+		}
+		if err = k.updateOfferEscrow(ctx, offer, req.Price.Amount, market.BankDenom); err != nil {
+			return nil, err
+		}
+		offer.BidAmount = req.Price.Amount.String()
+		offer.CounterAmount = ""
+	}
+
+	if err = k.stateStore.OfferTable().Update(ctx, offer); err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventCounterOffer{
+		OfferId: offer.Id,
+		Sender:  req.Sender,
+		Price:   req.Price,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCounterOfferResponse{}, nil
+}
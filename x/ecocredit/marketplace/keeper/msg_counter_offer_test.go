@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+func TestCounterOffer(t *testing.T) {
+	t.Parallel()
+	s, balances, sellOrderID := setupOffer(t)
+	seller, buyer := s.addrs[0], s.addrs[1]
+
+	id, err := s.makeOffer(sellOrderID, 80)
+	assert.NilError(t, err)
+
+	// the buyer cannot counter an offer awaiting a response from the seller
+	assert.ErrorContains(t, s.counterOffer(buyer, id, 85), "is not the seller of sell order 1")
+
+	// the counter price must be higher than the bid price
+	assert.ErrorContains(t, s.counterOffer(seller, id, 80), "counter price must be higher than the bid price")
+
+	assert.NilError(t, s.counterOffer(seller, id, 95))
+	offer, err := s.marketStore.OfferTable().Get(s.ctx, id)
+	assert.NilError(t, err)
+	assert.Equal(t, "80", offer.BidAmount)
+	assert.Equal(t, "95", offer.CounterAmount)
+
+	// the seller cannot counter their own counter offer
+	assert.ErrorContains(t, s.counterOffer(seller, id, 90), "offer 1 is awaiting a response from the buyer")
+
+	// the new bid price must be lower than the counter price
+	assert.ErrorContains(t, s.counterOffer(buyer, id, 95), "bid price must be lower than the counter price")
+
+	// the buyer counters with a higher bid price and the escrow is updated
+	assert.NilError(t, s.counterOffer(buyer, id, 90))
+	offer, err = s.marketStore.OfferTable().Get(s.ctx, id)
+	assert.NilError(t, err)
+	assert.Equal(t, "90", offer.BidAmount)
+	assert.Equal(t, "", offer.CounterAmount)
+	assert.Equal(t, int64(1100), balances[buyer.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(900), balances[ecocredit.ModuleName].AmountOf(ask.Denom).Int64())
+
+	// the buyer counters with a lower bid price and the difference is returned
+	assert.NilError(t, s.counterOffer(seller, id, 95))
+	assert.NilError(t, s.counterOffer(buyer, id, 70))
+	assert.Equal(t, int64(1300), balances[buyer.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(700), balances[ecocredit.ModuleName].AmountOf(ask.Denom).Int64())
+}
+
+func TestCounterOffer_InvalidDenom(t *testing.T) {
+	t.Parallel()
+	s, _, sellOrderID := setupOffer(t)
+
+	id, err := s.makeOffer(sellOrderID, 80)
+	assert.NilError(t, err)
+
+	price := sdk.NewInt64Coin("uother", 90)
+	_, err = s.k.CounterOffer(s.ctx, &types.MsgCounterOffer{
+		Sender:  s.addrs[0].String(),
+		OfferId: id,
+		Price:   &price,
+	})
+	assert.ErrorContains(t, err, "price denom: uother, offer denom: stake")
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// CreateAuction creates a new English or Dutch auction and escrows the credits
+// being auctioned until the auction is settled.
+func (k Keeper) CreateAuction(ctx context.Context, req *types.MsgCreateAuction) (*types.MsgCreateAuctionResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	sellerAcc, err := sdk.AccAddressFromBech32(req.Seller)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := k.baseStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("batch denom %s: %s", req.BatchDenom, err.Error())
+	}
+	if err = utils.AssertBatchNotSuspended(batch); err != nil {
+		return nil, err
+	}
+
+	creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.baseStore, batch.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed, err := isDenomAllowed(ctx, req.StartPrice.Denom, k.stateStore.AllowedDenomTable())
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s is not allowed to be used in auctions", req.StartPrice.Denom)
+	}
+
+	marketID, err := k.getOrCreateMarketID(ctx, creditType.Abbreviation, req.StartPrice.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	// verify end time is in the future
+	if !req.EndTime.UTC().After(sdkCtx.BlockTime()) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("end time must be in the future: %s", req.EndTime)
+	}
+
+	quantity, err := math.NewPositiveFixedDecFromString(req.Quantity, creditType.Precision)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.escrowCredits(ctx, "auction", sellerAcc, batch.Key, quantity); err != nil {
+		return nil, err
+	}
+
+	auction := &api.Auction{
+		Seller:            sellerAcc,
+		BatchKey:          batch.Key,
+		Quantity:          req.Quantity,
+		MarketId:          marketID,
+		AuctionType:       api.AuctionType(req.AuctionType),
+		StartAmount:       req.StartPrice.Amount.String(),
+		StartTime:         timestamppb.New(sdkCtx.BlockTime()),
+		EndTime:           timestamppb.New(*req.EndTime),
+		DisableAutoRetire: req.DisableAutoRetire,
+	}
+	if req.AuctionType == types.AuctionType_AUCTION_TYPE_DUTCH {
+		auction.EndAmount = req.EndPrice.Amount.String()
+	}
+
+	id, err := k.stateStore.AuctionTable().InsertReturningID(ctx, auction)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventCreateAuction{
+		AuctionId: id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateAuctionResponse{AuctionId: id}, nil
+}
@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+var auctionStart = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// setupAuction sets up a batch and market and sets the block time to the start
+// time of the auctions created with createAuction.
+func setupAuction(t *testing.T) (*baseSuite, map[string]sdk.Coins) {
+	s := setupBase(t, 3)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], classID, start, end, creditType)
+	balances := s.mockBankBalances()
+	s.setBlockTime(auctionStart)
+	return s, balances
+}
+
+// createAuction creates an auction of 10 credits by the first test account
+// that starts at 100 and ends after 10 hours.
+func (s *baseSuite) createAuction(auctionType types.AuctionType, disableAutoRetire bool) uint64 {
+	startPrice := sdk.NewInt64Coin(ask.Denom, 100)
+	endTime := auctionStart.Add(10 * time.Hour)
+	msg := &types.MsgCreateAuction{
+		Seller:            s.addrs[0].String(),
+		BatchDenom:        batchDenom,
+		Quantity:          "10",
+		AuctionType:       auctionType,
+		StartPrice:        &startPrice,
+		EndTime:           &endTime,
+		DisableAutoRetire: disableAutoRetire,
+	}
+	if auctionType == types.AuctionType_AUCTION_TYPE_DUTCH {
+		endPrice := sdk.NewInt64Coin(ask.Denom, 50)
+		msg.EndPrice = &endPrice
+	}
+
+	res, err := s.k.CreateAuction(s.ctx, msg)
+	assert.NilError(s.t, err)
+
+	return res.AuctionId
+}
+
+func (s *baseSuite) setBlockTime(t time.Time) {
+	s.sdkCtx = s.sdkCtx.WithBlockTime(t)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+}
+
+func TestCreateAuction_Valid(t *testing.T) {
+	t.Parallel()
+	s, _ := setupAuction(t)
+
+	id := s.createAuction(types.AuctionType_AUCTION_TYPE_DUTCH, true)
+	assert.Equal(t, uint64(1), id)
+
+	auction, err := s.marketStore.AuctionTable().Get(s.ctx, id)
+	assert.NilError(t, err)
+	assert.Check(t, s.addrs[0].Equals(sdk.AccAddress(auction.Seller)))
+	assert.Equal(t, uint64(1), auction.BatchKey)
+	assert.Equal(t, uint64(1), auction.MarketId)
+	assert.Equal(t, "10", auction.Quantity)
+	assert.Equal(t, api.AuctionType_AUCTION_TYPE_DUTCH, auction.AuctionType)
+	assert.Equal(t, "100", auction.StartAmount)
+	assert.Equal(t, "50", auction.EndAmount)
+	assert.Equal(t, auctionStart, auction.StartTime.AsTime())
+	assert.Equal(t, auctionStart.Add(10*time.Hour), auction.EndTime.AsTime())
+	assert.Equal(t, true, auction.DisableAutoRetire)
+
+	// the auctioned credits are escrowed
+	bal, err := s.baseStore.BatchBalanceTable().Get(s.ctx, s.addrs[0], 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "90", bal.TradableAmount)
+	assert.Equal(t, "10", bal.EscrowedAmount)
+}
+
+func TestCreateAuction_Invalid(t *testing.T) {
+	t.Parallel()
+	s, _ := setupAuction(t)
+
+	startPrice := sdk.NewInt64Coin(ask.Denom, 100)
+	endTime := auctionStart.Add(time.Hour)
+	msg := func() *types.MsgCreateAuction {
+		return &types.MsgCreateAuction{
+			Seller:      s.addrs[0].String(),
+			BatchDenom:  batchDenom,
+			Quantity:    "10",
+			AuctionType: types.AuctionType_AUCTION_TYPE_ENGLISH,
+			StartPrice:  &startPrice,
+			EndTime:     &endTime,
+		}
+	}
+
+	// batch not found
+	m := msg()
+	m.BatchDenom = "C01-001-20200101-20200201-002"
+	_, err := s.k.CreateAuction(s.ctx, m)
+	assert.ErrorContains(t, err, "batch denom C01-001-20200101-20200201-002: not found")
+
+	// denom not allowed
+	m = msg()
+	notAllowed := sdk.NewInt64Coin("uother", 100)
+	m.StartPrice = &notAllowed
+	_, err = s.k.CreateAuction(s.ctx, m)
+	assert.ErrorContains(t, err, "uother is not allowed to be used in auctions")
+
+	// end time not in the future
+	m = msg()
+	m.EndTime = &auctionStart
+	_, err = s.k.CreateAuction(s.ctx, m)
+	assert.ErrorContains(t, err, "end time must be in the future")
+
+	// insufficient credits
+	m = msg()
+	m.Quantity = "101"
+	_, err = s.k.CreateAuction(s.ctx, m)
+	assert.ErrorContains(t, err, "auction: credit quantity: 101, tradable balance: 100")
+
+	// precision exceeded
+	m = msg()
+	m.Quantity = "1.0000001"
+	_, err = s.k.CreateAuction(s.ctx, m)
+	assert.ErrorContains(t, err, "exceeds maximum decimal places")
+}
+
+func TestCreateAuction_Suspended(t *testing.T) {
+	t.Parallel()
+	s, _ := setupAuction(t)
+	s.setBatchSuspended(1, true)
+
+	startPrice := sdk.NewInt64Coin(ask.Denom, 100)
+	endTime := auctionStart.Add(10 * time.Hour)
+	_, err := s.k.CreateAuction(s.ctx, &types.MsgCreateAuction{
+		Seller:      s.addrs[0].String(),
+		BatchDenom:  batchDenom,
+		Quantity:    "10",
+		AuctionType: types.AuctionType_AUCTION_TYPE_ENGLISH,
+		StartPrice:  &startPrice,
+		EndTime:     &endTime,
+	})
+	assert.ErrorContains(t, err, "credit batch "+batchDenom+" is suspended")
+}
@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// CreateForwardContract pre-purchases credits to be delivered by an issuer from
+// an open credit batch or from any credit batch of a project and escrows the
+// total cost in the ecocredit module account. The escrowed payment is released
+// to the issuer as credits are issued to the buyer (see the base keeper) and
+// the payment for any undelivered credits is returned to the buyer after the
+// deadline (see PruneForwardContracts).
+func (k Keeper) CreateForwardContract(ctx context.Context, req *types.MsgCreateForwardContract) (*types.MsgCreateForwardContractResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	buyerAcc, err := sdk.AccAddressFromBech32(req.Buyer)
+	if err != nil {
+		return nil, err
+	}
+
+	issuerAcc, err := sdk.AccAddressFromBech32(req.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := k.baseStore.ProjectTable().GetById(ctx, req.ProjectId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get project with id %s: %s", req.ProjectId, err.Error())
+	}
+
+	class, err := k.baseStore.ClassTable().Get(ctx, project.ClassKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var batchKey uint64
+	if req.BatchDenom != "" {
+		batch, err := k.baseStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", req.BatchDenom, err.Error())
+		}
+		if batch.ProjectKey != project.Key {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"batch denom %s does not belong to project %s", req.BatchDenom, req.ProjectId,
+			)
+		}
+		if err = utils.AssertBatchNotSuspended(batch); err != nil {
+			return nil, err
+		}
+		if !batch.Open {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("credits cannot be delivered from closed batch %s", req.BatchDenom)
+		}
+		if !issuerAcc.Equals(sdk.AccAddress(batch.Issuer)) {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is not the issuer of batch %s", req.Issuer, req.BatchDenom)
+		}
+		batchKey = batch.Key
+	} else {
+		isIssuer, err := k.baseStore.ClassIssuerTable().Has(ctx, class.Key, issuerAcc)
+		if err != nil {
+			return nil, err
+		}
+		if !isIssuer {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is not an issuer for class %s", req.Issuer, class.Id)
+		}
+	}
+
+	ct, err := k.baseStore.CreditTypeTable().Get(ctx, class.CreditTypeAbbrev)
+	if err != nil {
+		return nil, err
+	}
+	quantity, err := math.NewPositiveFixedDecFromString(req.Quantity, ct.Precision)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"decimal places exceeds precision: quantity: %s, credit type precision: %d", req.Quantity, ct.Precision,
+		)
+	}
+
+	allowed, err := isDenomAllowed(ctx, req.Price.Denom, k.stateStore.AllowedDenomTable())
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s is not allowed to be used in forward contracts", req.Price.Denom)
+	}
+
+	// verify deadline is in the future
+	if !req.Deadline.UTC().After(sdkCtx.BlockTime()) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("deadline must be in the future: %s", req.Deadline)
+	}
+
+	marketID, err := k.getOrCreateMarketID(ctx, ct.Abbreviation, req.Price.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	// check address has the total cost (price * quantity)
+	total, err := utils.GetTotalCost(req.Price.Amount, quantity)
+	if err != nil {
+		return nil, err
+	}
+	coinTotal := sdk.Coin{Amount: total, Denom: req.Price.Denom}
+	bal := k.bankKeeper.GetBalance(sdkCtx, buyerAcc, req.Price.Denom)
+	if bal.IsLT(coinTotal) {
+		return nil, sdkerrors.ErrInsufficientFunds.Wrapf(
+			"quantity: %s, price: %v, total price: %v, bank balance: %v",
+			req.Quantity, req.Price, coinTotal, bal,
+		)
+	}
+
+	if err = k.bankKeeper.SendCoinsFromAccountToModule(sdkCtx, buyerAcc, ecocredit.ModuleName, sdk.NewCoins(coinTotal)); err != nil {
+		return nil, err
+	}
+
+	id, err := k.stateStore.ForwardContractTable().InsertReturningID(ctx, &api.ForwardContract{
+		Buyer:             buyerAcc,
+		Issuer:            issuerAcc,
+		ProjectKey:        project.Key,
+		BatchKey:          batchKey,
+		Quantity:          quantity.String(),
+		DeliveredQuantity: "0",
+		MarketId:          marketID,
+		PriceAmount:       req.Price.Amount.String(),
+		Deadline:          timestamppb.New(*req.Deadline),
+		AcceptRetired:     req.AcceptRetired,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventCreateForwardContract{
+		ForwardContractId: id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateForwardContractResponse{ForwardContractId: id}, nil
+}
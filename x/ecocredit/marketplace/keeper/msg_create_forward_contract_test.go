@@ -0,0 +1,177 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+var forwardStart = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// setupForwardContract sets up an open batch issued by the second test account,
+// which is also an issuer of the credit class, and gives the first test account
+// a balance of 1000.
+func setupForwardContract(t *testing.T) (*baseSuite, map[string]sdk.Coins) {
+	s := setupBase(t, 3)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], classID, start, end, creditType)
+
+	batch, err := s.baseStore.BatchTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	batch.Issuer = s.addrs[1]
+	batch.Open = true
+	assert.NilError(t, s.baseStore.BatchTable().Update(s.ctx, batch))
+	assert.NilError(t, s.baseStore.ClassIssuerTable().Insert(s.ctx, &baseapi.ClassIssuer{
+		ClassKey: 1,
+		Issuer:   s.addrs[1],
+	}))
+
+	balances := s.mockBankBalances()
+	balances[s.addrs[0].String()] = sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 1000))
+	s.setBlockTime(forwardStart)
+
+	return s, balances
+}
+
+// createForwardContract creates a forward contract for the first test account
+// to buy 10 credits from the second test account at a price of 10 per credit
+// that must be delivered within 10 hours, optionally from the open batch.
+func (s *baseSuite) createForwardContract(batchDenom string) (uint64, error) {
+	deadline := forwardStart.Add(10 * time.Hour)
+	price := sdk.NewInt64Coin(ask.Denom, 10)
+	res, err := s.k.CreateForwardContract(s.ctx, &types.MsgCreateForwardContract{
+		Buyer:      s.addrs[0].String(),
+		Issuer:     s.addrs[1].String(),
+		ProjectId:  classID + "-001",
+		BatchDenom: batchDenom,
+		Quantity:   "10",
+		Price:      &price,
+		Deadline:   &deadline,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res.ForwardContractId, nil
+}
+
+func TestCreateForwardContract_Valid(t *testing.T) {
+	t.Parallel()
+	s, balances := setupForwardContract(t)
+
+	id, err := s.createForwardContract(batchDenom)
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(1), id)
+
+	contract, err := s.marketStore.ForwardContractTable().Get(s.ctx, id)
+	assert.NilError(t, err)
+	assert.Check(t, s.addrs[0].Equals(sdk.AccAddress(contract.Buyer)))
+	assert.Check(t, s.addrs[1].Equals(sdk.AccAddress(contract.Issuer)))
+	assert.Equal(t, uint64(1), contract.ProjectKey)
+	assert.Equal(t, uint64(1), contract.BatchKey)
+	assert.Equal(t, "10", contract.Quantity)
+	assert.Equal(t, "0", contract.DeliveredQuantity)
+	assert.Equal(t, uint64(1), contract.MarketId)
+	assert.Equal(t, "10", contract.PriceAmount)
+
+	// the total cost is escrowed
+	assert.Equal(t, int64(900), balances[s.addrs[0].String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(100), balances[ecocredit.ModuleName].AmountOf(ask.Denom).Int64())
+
+	// the batch denom is optional
+	id, err = s.createForwardContract("")
+	assert.NilError(t, err)
+	contract, err = s.marketStore.ForwardContractTable().Get(s.ctx, id)
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(0), contract.BatchKey)
+}
+
+func TestCreateForwardContract_Invalid(t *testing.T) {
+	t.Parallel()
+	s, _ := setupForwardContract(t)
+
+	deadline := forwardStart.Add(time.Hour)
+	msg := func() *types.MsgCreateForwardContract {
+		price := sdk.NewInt64Coin(ask.Denom, 10)
+		return &types.MsgCreateForwardContract{
+			Buyer:      s.addrs[0].String(),
+			Issuer:     s.addrs[1].String(),
+			ProjectId:  classID + "-001",
+			BatchDenom: batchDenom,
+			Quantity:   "10",
+			Price:      &price,
+			Deadline:   &deadline,
+		}
+	}
+
+	// project not found
+	m := msg()
+	m.ProjectId = classID + "-002"
+	_, err := s.k.CreateForwardContract(s.ctx, m)
+	assert.ErrorContains(t, err, "could not get project with id C01-002: not found")
+
+	// batch not found
+	m = msg()
+	m.BatchDenom = "C01-001-20200101-20200201-003"
+	_, err = s.k.CreateForwardContract(s.ctx, m)
+	assert.ErrorContains(t, err, "could not get batch with denom C01-001-20200101-20200201-003: not found")
+
+	// issuer is not the batch issuer
+	m = msg()
+	m.Issuer = s.addrs[2].String()
+	_, err = s.k.CreateForwardContract(s.ctx, m)
+	assert.ErrorContains(t, err, "is not the issuer of batch")
+
+	// issuer is not a class issuer
+	m = msg()
+	m.Issuer = s.addrs[2].String()
+	m.BatchDenom = ""
+	_, err = s.k.CreateForwardContract(s.ctx, m)
+	assert.ErrorContains(t, err, "is not an issuer for class C01")
+
+	// quantity exceeds precision
+	m = msg()
+	m.Quantity = "1.1234567"
+	_, err = s.k.CreateForwardContract(s.ctx, m)
+	assert.ErrorContains(t, err, "decimal places exceeds precision")
+
+	// price denom not allowed
+	m = msg()
+	m.Price = &sdk.Coin{Denom: "uother", Amount: sdk.NewInt(10)}
+	_, err = s.k.CreateForwardContract(s.ctx, m)
+	assert.ErrorContains(t, err, "uother is not allowed to be used in forward contracts")
+
+	// deadline not in the future
+	m = msg()
+	m.Deadline = &forwardStart
+	_, err = s.k.CreateForwardContract(s.ctx, m)
+	assert.ErrorContains(t, err, "deadline must be in the future")
+
+	// insufficient funds
+	m = msg()
+	m.Quantity = "101"
+	_, err = s.k.CreateForwardContract(s.ctx, m)
+	assert.ErrorContains(t, err, "insufficient funds")
+
+	// closed batch
+	batch, err := s.baseStore.BatchTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	batch.Open = false
+	assert.NilError(t, s.baseStore.BatchTable().Update(s.ctx, batch))
+	_, err = s.k.CreateForwardContract(s.ctx, msg())
+	assert.ErrorContains(t, err, "credits cannot be delivered from closed batch")
+}
+
+func TestCreateForwardContract_Suspended(t *testing.T) {
+	t.Parallel()
+	s, _ := setupForwardContract(t)
+	s.setBatchSuspended(1, true)
+
+	_, err := s.createForwardContract(batchDenom)
+	assert.ErrorContains(t, err, "credit batch "+batchDenom+" is suspended")
+}
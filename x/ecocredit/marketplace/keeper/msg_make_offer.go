@@ -0,0 +1,243 @@
+package keeper
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// MakeOffer makes an offer to buy credits from a sell order at a price below the
+// ask price of the sell order and escrows the total bid amount, together with
+// the highest trading fee that can be charged on the total bid amount, in the
+// ecocredit module account.
+func (k Keeper) MakeOffer(ctx context.Context, req *types.MsgMakeOffer) (*types.MsgMakeOfferResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	buyerAcc, err := sdk.AccAddressFromBech32(req.Buyer)
+	if err != nil {
+		return nil, err
+	}
+
+	sellOrder, err := k.stateStore.SellOrderTable().Get(ctx, req.SellOrderId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("sell order with id %d: %s", req.SellOrderId, err.Error())
+	}
+
+	if buyerAcc.Equals(sdk.AccAddress(sellOrder.Seller)) {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("buyer account cannot be the same as seller account")
+	}
+
+	if !isAllowedBuyer(sellOrder, buyerAcc) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("buyer is not allowed to buy credits from sell order %d", sellOrder.Id)
+	}
+
+	if req.DisableAutoRetire && !sellOrder.DisableAutoRetire {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("cannot disable auto-retire for a sell order with auto-retire enabled")
+	}
+
+	if !req.Expiration.UTC().After(sdkCtx.BlockTime()) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("expiration must be in the future: %s", req.Expiration)
+	}
+
+	// check decimal places does not exceed credit type precision
+	batch, err := k.baseStore.BatchTable().Get(ctx, sellOrder.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+	if err = utils.AssertBatchNotSuspended(batch); err != nil {
+		return nil, err
+	}
+	ct, err := utils.GetCreditTypeFromBatchDenom(ctx, k.baseStore, batch.Denom)
+	if err != nil {
+		return nil, err
+	}
+	quantity, err := math.NewPositiveFixedDecFromString(req.Quantity, ct.Precision)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"decimal places exceeds precision: quantity: %s, credit type precision: %d", req.Quantity, ct.Precision,
+		)
+	}
+
+	sellOrderQty, err := math.NewDecFromString(sellOrder.Quantity)
+	if err != nil {
+		return nil, err
+	}
+	if sellOrderQty.Cmp(quantity) == math.LessThan {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"requested quantity: %v, sell order quantity %s", quantity, sellOrder.Quantity,
+		)
+	}
+
+	// check that the sell order has an ask price in the bid price denom, i.e.
+	// the ask price or one of the additional ask prices
+	market, askAmount, err := k.getSellOrderAsk(ctx, sellOrder, req.BidPrice.Denom)
+	if err != nil {
+		return nil, err
+	}
+	if market == nil {
+		primaryMarket, err := k.stateStore.MarketTable().Get(ctx, sellOrder.MarketId)
+		if err != nil {
+			return nil, err
+		}
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"bid price denom: %s, ask price denom: %s", req.BidPrice.Denom, primaryMarket.BankDenom,
+		)
+	}
+
+	// check that bid price < ask price, otherwise the credits can be bought directly
+	if !req.BidPrice.Amount.LT(askAmount) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"ask price: %s%s, bid price: %v, bid price must be less than the ask price",
+			askAmount, market.BankDenom, req.BidPrice,
+		)
+	}
+
+	escrowedFeeBps, err := k.getEscrowFeeBps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// check address has the total bid amount (bid price * quantity) and the
+	// highest trading fee that can be charged on the total bid amount
+	total, err := utils.GetTotalCost(req.BidPrice.Amount, quantity)
+	if err != nil {
+		return nil, err
+	}
+	coinTotal := sdk.Coin{Amount: getEscrowAmount(total, escrowedFeeBps), Denom: market.BankDenom}
+	bal := k.bankKeeper.GetBalance(sdkCtx, buyerAcc, market.BankDenom)
+	if bal.IsLT(coinTotal) {
+		return nil, sdkerrors.ErrInsufficientFunds.Wrapf(
+			"quantity: %s, bid price: %v, total price: %v, bank balance: %v",
+			req.Quantity, req.BidPrice, coinTotal, bal,
+		)
+	}
+
+	if err = k.bankKeeper.SendCoinsFromAccountToModule(sdkCtx, buyerAcc, ecocredit.ModuleName, sdk.NewCoins(coinTotal)); err != nil {
+		return nil, err
+	}
+
+	id, err := k.stateStore.OfferTable().InsertReturningID(ctx, &api.Offer{
+		SellOrderId:            sellOrder.Id,
+		Buyer:                  buyerAcc,
+		Quantity:               quantity.String(),
+		MarketId:               market.Id,
+		BidAmount:              req.BidPrice.Amount.String(),
+		DisableAutoRetire:      req.DisableAutoRetire,
+		RetirementJurisdiction: req.RetirementJurisdiction,
+		RetirementReason:       req.RetirementReason,
+		RetirementBeneficiary:  req.RetirementBeneficiary,
+		Expiration:             timestamppb.New(*req.Expiration),
+		EscrowedFeeBps:         escrowedFeeBps,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventMakeOffer{
+		OfferId:     id,
+		SellOrderId: sellOrder.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMakeOfferResponse{OfferId: id}, nil
+}
+
+// getOfferCost returns the total cost of the offer at the given amount per
+// credit, i.e. the amount multiplied by the quantity of the offer.
+func getOfferCost(offer *api.Offer, amount sdkmath.Int, bankDenom string) (sdk.Coin, error) {
+	quantity, err := math.NewDecFromString(offer.Quantity)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	cost, err := utils.GetTotalCost(amount, quantity)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return sdk.NewCoin(bankDenom, cost), nil
+}
+
+// getOfferEscrow returns the funds held in escrow for the offer at the given
+// amount per credit, i.e. the total cost and the escrowed trading fee.
+func getOfferEscrow(offer *api.Offer, amount sdkmath.Int, bankDenom string) (sdk.Coin, error) {
+	cost, err := getOfferCost(offer, amount, bankDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return sdk.NewCoin(bankDenom, getEscrowAmount(cost.Amount, offer.EscrowedFeeBps)), nil
+}
+
+// updateOfferEscrow updates the funds held in escrow for the offer when the bid
+// amount of the offer changes to the new amount, i.e. the buyer sends the
+// difference to the ecocredit module account if the new amount is higher and
+// the difference is returned to the buyer if the new amount is lower.
+func (k Keeper) updateOfferEscrow(ctx context.Context, offer *api.Offer, newAmount sdkmath.Int, bankDenom string) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	bidAmount, err := parseAmount(offer.BidAmount)
+	if err != nil {
+		return err
+	}
+
+	escrowed, err := getOfferEscrow(offer, bidAmount, bankDenom)
+	if err != nil {
+		return err
+	}
+
+	cost, err := getOfferEscrow(offer, newAmount, bankDenom)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case cost.Amount.GT(escrowed.Amount):
+		diff := cost.Sub(escrowed)
+		bal := k.bankKeeper.GetBalance(sdkCtx, offer.Buyer, bankDenom)
+		if bal.IsLT(diff) {
+			return sdkerrors.ErrInsufficientFunds.Wrapf(
+				"total price: %v, escrowed: %v, bank balance: %v", cost, escrowed, bal,
+			)
+		}
+		return k.bankKeeper.SendCoinsFromAccountToModule(sdkCtx, offer.Buyer, ecocredit.ModuleName, sdk.NewCoins(diff))
+	case cost.Amount.LT(escrowed.Amount):
+		diff := escrowed.Sub(cost)
+		return k.bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, ecocredit.ModuleName, offer.Buyer, sdk.NewCoins(diff))
+	default:
+		return nil
+	}
+}
+
+// checkOfferResponder checks that the sender is the party the offer is awaiting
+// a response from, i.e. the seller of the sell order if the offer has no
+// counter amount and the buyer of the offer otherwise.
+func checkOfferResponder(offer *api.Offer, sellOrder *api.SellOrder, sender sdk.AccAddress) error {
+	if offer.CounterAmount == "" {
+		if !sender.Equals(sdk.AccAddress(sellOrder.Seller)) {
+			return sdkerrors.ErrUnauthorized.Wrapf(
+				"%s is not the seller of sell order %d", sender, sellOrder.Id,
+			)
+		}
+		return nil
+	}
+
+	if !sender.Equals(sdk.AccAddress(offer.Buyer)) {
+		return sdkerrors.ErrUnauthorized.Wrapf(
+			"offer %d is awaiting a response from the buyer, %s is not the buyer", offer.Id, sender,
+		)
+	}
+
+	return nil
+}
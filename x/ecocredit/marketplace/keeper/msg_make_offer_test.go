@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+var offerStart = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// setupOffer sets up a batch and market, creates a sell order of 10 credits at
+// 100 per credit by the first test account, and gives the second test account
+// a balance of 2000.
+func setupOffer(t *testing.T) (*baseSuite, map[string]sdk.Coins, uint64) {
+	s := setupBase(t, 3)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], classID, start, end, creditType)
+	balances := s.mockBankBalances()
+	balances[s.addrs[1].String()] = sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 2000))
+	s.setBlockTime(offerStart)
+
+	askPrice := sdk.NewInt64Coin(ask.Denom, 100)
+	res, err := s.k.Sell(s.ctx, &types.MsgSell{
+		Seller: s.addrs[0].String(),
+		Orders: []*types.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: "10", AskPrice: &askPrice},
+		},
+	})
+	assert.NilError(t, err)
+
+	return s, balances, res.SellOrderIds[0]
+}
+
+// makeOffer makes an offer of 10 credits on the sell order by the second test
+// account that expires after 10 hours.
+func (s *baseSuite) makeOffer(sellOrderID uint64, bidAmount int64) (uint64, error) {
+	bidPrice := sdk.NewInt64Coin(ask.Denom, bidAmount)
+	expiration := offerStart.Add(10 * time.Hour)
+	res, err := s.k.MakeOffer(s.ctx, &types.MsgMakeOffer{
+		Buyer:                  s.addrs[1].String(),
+		SellOrderId:            sellOrderID,
+		Quantity:               "10",
+		BidPrice:               &bidPrice,
+		RetirementJurisdiction: "US-WA",
+		Expiration:             &expiration,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res.OfferId, nil
+}
+
+func (s *baseSuite) counterOffer(sender sdk.AccAddress, offerID uint64, amount int64) error {
+	price := sdk.NewInt64Coin(ask.Denom, amount)
+	_, err := s.k.CounterOffer(s.ctx, &types.MsgCounterOffer{
+		Sender:  sender.String(),
+		OfferId: offerID,
+		Price:   &price,
+	})
+	return err
+}
+
+func TestMakeOffer_Valid(t *testing.T) {
+	t.Parallel()
+	s, balances, sellOrderID := setupOffer(t)
+
+	id, err := s.makeOffer(sellOrderID, 80)
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(1), id)
+
+	offer, err := s.marketStore.OfferTable().Get(s.ctx, id)
+	assert.NilError(t, err)
+	assert.Equal(t, sellOrderID, offer.SellOrderId)
+	assert.Check(t, s.addrs[1].Equals(sdk.AccAddress(offer.Buyer)))
+	assert.Equal(t, "10", offer.Quantity)
+	assert.Equal(t, uint64(1), offer.MarketId)
+	assert.Equal(t, "80", offer.BidAmount)
+	assert.Equal(t, "", offer.CounterAmount)
+	assert.Equal(t, offerStart.Add(10*time.Hour), offer.Expiration.AsTime())
+
+	// the total bid amount is escrowed
+	assert.Equal(t, int64(1200), balances[s.addrs[1].String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(800), balances[ecocredit.ModuleName].AmountOf(ask.Denom).Int64())
+}
+
+func TestMakeOffer_Invalid(t *testing.T) {
+	t.Parallel()
+	s, _, sellOrderID := setupOffer(t)
+
+	bidPrice := sdk.NewInt64Coin(ask.Denom, 80)
+	expiration := offerStart.Add(time.Hour)
+	msg := func() *types.MsgMakeOffer {
+		return &types.MsgMakeOffer{
+			Buyer:                  s.addrs[1].String(),
+			SellOrderId:            sellOrderID,
+			Quantity:               "10",
+			BidPrice:               &bidPrice,
+			RetirementJurisdiction: "US-WA",
+			Expiration:             &expiration,
+		}
+	}
+
+	// sell order not found
+	m := msg()
+	m.SellOrderId = 2
+	_, err := s.k.MakeOffer(s.ctx, m)
+	assert.ErrorContains(t, err, "sell order with id 2: not found")
+
+	// buyer is the seller
+	m = msg()
+	m.Buyer = s.addrs[0].String()
+	_, err = s.k.MakeOffer(s.ctx, m)
+	assert.ErrorContains(t, err, "buyer account cannot be the same as seller account")
+
+	// auto-retire cannot be disabled
+	m = msg()
+	m.DisableAutoRetire = true
+	_, err = s.k.MakeOffer(s.ctx, m)
+	assert.ErrorContains(t, err, "cannot disable auto-retire for a sell order with auto-retire enabled")
+
+	// expiration not in the future
+	m = msg()
+	m.Expiration = &offerStart
+	_, err = s.k.MakeOffer(s.ctx, m)
+	assert.ErrorContains(t, err, "expiration must be in the future")
+
+	// quantity greater than sell order quantity
+	m = msg()
+	m.Quantity = "11"
+	_, err = s.k.MakeOffer(s.ctx, m)
+	assert.ErrorContains(t, err, "requested quantity: 11, sell order quantity 10")
+
+	// bid price denom does not match
+	m = msg()
+	otherDenom := sdk.NewInt64Coin("uother", 80)
+	m.BidPrice = &otherDenom
+	_, err = s.k.MakeOffer(s.ctx, m)
+	assert.ErrorContains(t, err, "bid price denom: uother, ask price denom: stake")
+
+	// bid price not less than ask price
+	m = msg()
+	atAsk := sdk.NewInt64Coin(ask.Denom, 100)
+	m.BidPrice = &atAsk
+	_, err = s.k.MakeOffer(s.ctx, m)
+	assert.ErrorContains(t, err, "bid price must be less than the ask price")
+
+	// insufficient funds
+	m = msg()
+	m.Buyer = s.addrs[2].String()
+	_, err = s.k.MakeOffer(s.ctx, m)
+	assert.ErrorContains(t, err, "insufficient funds")
+}
+
+func TestMakeOffer_AllowedBuyers(t *testing.T) {
+	t.Parallel()
+	s, _, _ := setupOffer(t)
+
+	askPrice := sdk.NewInt64Coin(ask.Denom, 100)
+	res, err := s.k.Sell(s.ctx, &types.MsgSell{
+		Seller: s.addrs[0].String(),
+		Orders: []*types.MsgSell_Order{
+			{
+				BatchDenom:    batchDenom,
+				Quantity:      "10",
+				AskPrice:      &askPrice,
+				AllowedBuyers: []string{s.addrs[2].String()},
+			},
+		},
+	})
+	assert.NilError(t, err)
+
+	_, err = s.makeOffer(res.SellOrderIds[0], 80)
+	assert.ErrorContains(t, err, "buyer is not allowed to buy credits from sell order 2")
+}
+
+func TestMakeOffer_Suspended(t *testing.T) {
+	t.Parallel()
+	s, balances, sellOrderID := setupOffer(t)
+	s.setBatchSuspended(1, true)
+
+	_, err := s.makeOffer(sellOrderID, 80)
+	assert.ErrorContains(t, err, "credit batch "+batchDenom+" is suspended")
+	assert.Equal(t, int64(2000), balances[s.addrs[1].String()].AmountOf(ask.Denom).Int64())
+}
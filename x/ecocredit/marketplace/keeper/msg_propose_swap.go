@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// ProposeSwap proposes to exchange credits from one credit batch, optionally
+// together with coins, for credits from another credit batch held by the
+// counterparty. The offered credits and coins are escrowed until the swap is
+// accepted or expires.
+func (k Keeper) ProposeSwap(ctx context.Context, req *types.MsgProposeSwap) (*types.MsgProposeSwapResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	proposerAcc, err := sdk.AccAddressFromBech32(req.Proposer)
+	if err != nil {
+		return nil, err
+	}
+
+	counterpartyAcc, err := sdk.AccAddressFromBech32(req.Counterparty)
+	if err != nil {
+		return nil, err
+	}
+
+	offerBatch, err := k.baseStore.BatchTable().GetByDenom(ctx, req.OfferBatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("offer batch denom %s: %s", req.OfferBatchDenom, err.Error())
+	}
+
+	askBatch, err := k.baseStore.BatchTable().GetByDenom(ctx, req.AskBatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("ask batch denom %s: %s", req.AskBatchDenom, err.Error())
+	}
+
+	for _, batch := range []*baseapi.Batch{offerBatch, askBatch} {
+		if err = utils.AssertBatchNotSuspended(batch); err != nil {
+			return nil, err
+		}
+	}
+
+	offerQuantity, err := k.parseSwapQuantity(ctx, "offer", offerBatch.Denom, req.OfferQuantity)
+	if err != nil {
+		return nil, err
+	}
+
+	askQuantity, err := k.parseSwapQuantity(ctx, "ask", askBatch.Denom, req.AskQuantity)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, coin := range req.OfferCoins {
+		allowed, err := isDenomAllowed(ctx, coin.Denom, k.stateStore.AllowedDenomTable())
+		if err != nil {
+			return nil, err
+		}
+		if !allowed {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s is not allowed to be used in swaps", coin.Denom)
+		}
+	}
+
+	// verify expiration is in the future
+	if !req.Expiration.UTC().After(sdkCtx.BlockTime()) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("expiration must be in the future: %s", req.Expiration)
+	}
+
+	if err = k.escrowCredits(ctx, "swap", proposerAcc, offerBatch.Key, offerQuantity); err != nil {
+		return nil, err
+	}
+
+	if !req.OfferCoins.IsZero() {
+		if err = k.bankKeeper.SendCoinsFromAccountToModule(sdkCtx, proposerAcc, ecocredit.ModuleName, req.OfferCoins); err != nil {
+			return nil, err
+		}
+	}
+
+	id, err := k.stateStore.SwapTable().InsertReturningID(ctx, &api.Swap{
+		Proposer:      proposerAcc,
+		Counterparty:  counterpartyAcc,
+		OfferBatchKey: offerBatch.Key,
+		OfferQuantity: offerQuantity.String(),
+		OfferCoins:    regentypes.CoinsToProtoCoins(req.OfferCoins),
+		AskBatchKey:   askBatch.Key,
+		AskQuantity:   askQuantity.String(),
+		Expiration:    timestamppb.New(*req.Expiration),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventProposeSwap{
+		SwapId:       id,
+		Counterparty: req.Counterparty,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgProposeSwapResponse{SwapId: id}, nil
+}
+
+// parseSwapQuantity parses the quantity of credits of one side of a swap and
+// checks the decimal places do not exceed the credit type precision.
+func (k Keeper) parseSwapQuantity(ctx context.Context, side, batchDenom, quantity string) (math.Dec, error) {
+	ct, err := utils.GetCreditTypeFromBatchDenom(ctx, k.baseStore, batchDenom)
+	if err != nil {
+		return math.Dec{}, err
+	}
+
+	dec, err := math.NewPositiveFixedDecFromString(quantity, ct.Precision)
+	if err != nil {
+		return math.Dec{}, sdkerrors.ErrInvalidRequest.Wrapf(
+			"%s quantity: decimal places exceeds precision: quantity: %s, credit type precision: %d",
+			side, quantity, ct.Precision,
+		)
+	}
+
+	return dec, nil
+}
+
+// getSwapCoins returns the coins offered by the proposer of the swap.
+func getSwapCoins(swap *api.Swap) (sdk.Coins, error) {
+	coins, ok := regentypes.ProtoCoinsToCoins(swap.OfferCoins)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("could not convert offer coins of swap %d", swap.Id)
+	}
+
+	return sdk.NewCoins(coins...), nil
+}
@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+var (
+	swapStart      = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	swapBatchDenom = "C01-001-20200101-20200201-002"
+)
+
+// setupSwap sets up two batches where the first test account holds 100
+// tradable credits of the first batch and the second test account holds 100
+// tradable credits of the second batch, and gives the first test account a
+// balance of 1000.
+func setupSwap(t *testing.T) (*baseSuite, map[string]sdk.Coins) {
+	s := setupBase(t, 3)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], classID, start, end, creditType)
+	assert.NilError(t, s.baseStore.BatchTable().Insert(s.ctx, &baseapi.Batch{
+		ProjectKey: 1,
+		Denom:      swapBatchDenom,
+		StartDate:  start,
+		EndDate:    end,
+	}))
+	assert.NilError(t, s.baseStore.BatchBalanceTable().Insert(s.ctx, &baseapi.BatchBalance{
+		BatchKey:       2,
+		Address:        s.addrs[1],
+		TradableAmount: "100",
+		RetiredAmount:  "0",
+		EscrowedAmount: "0",
+	}))
+	assert.NilError(t, s.baseStore.BatchSupplyTable().Insert(s.ctx, &baseapi.BatchSupply{
+		BatchKey:        2,
+		TradableAmount:  "100",
+		RetiredAmount:   "0",
+		CancelledAmount: "0",
+	}))
+
+	balances := s.mockBankBalances()
+	balances[s.addrs[0].String()] = sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 1000))
+	s.setBlockTime(swapStart)
+
+	return s, balances
+}
+
+// proposeSwap proposes a swap of 10 credits of the first batch and the offer
+// coins by the first test account for 8 credits of the second batch held by
+// the second test account that expires after 10 hours.
+func (s *baseSuite) proposeSwap(offerCoins sdk.Coins) (uint64, error) {
+	expiration := swapStart.Add(10 * time.Hour)
+	res, err := s.k.ProposeSwap(s.ctx, &types.MsgProposeSwap{
+		Proposer:        s.addrs[0].String(),
+		Counterparty:    s.addrs[1].String(),
+		OfferBatchDenom: batchDenom,
+		OfferQuantity:   "10",
+		OfferCoins:      offerCoins,
+		AskBatchDenom:   swapBatchDenom,
+		AskQuantity:     "8",
+		Expiration:      &expiration,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res.SwapId, nil
+}
+
+func TestProposeSwap_Valid(t *testing.T) {
+	t.Parallel()
+	s, balances := setupSwap(t)
+
+	id, err := s.proposeSwap(sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 100)))
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(1), id)
+
+	swap, err := s.marketStore.SwapTable().Get(s.ctx, id)
+	assert.NilError(t, err)
+	assert.Check(t, s.addrs[0].Equals(sdk.AccAddress(swap.Proposer)))
+	assert.Check(t, s.addrs[1].Equals(sdk.AccAddress(swap.Counterparty)))
+	assert.Equal(t, uint64(1), swap.OfferBatchKey)
+	assert.Equal(t, "10", swap.OfferQuantity)
+	assert.Equal(t, uint64(2), swap.AskBatchKey)
+	assert.Equal(t, "8", swap.AskQuantity)
+	assert.Equal(t, 1, len(swap.OfferCoins))
+	assert.Equal(t, "100", swap.OfferCoins[0].Amount)
+
+	// the offered credits and coins are escrowed
+	bal, err := s.baseStore.BatchBalanceTable().Get(s.ctx, s.addrs[0], 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "90", bal.TradableAmount)
+	assert.Equal(t, "10", bal.EscrowedAmount)
+	assert.Equal(t, int64(900), balances[s.addrs[0].String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(100), balances[ecocredit.ModuleName].AmountOf(ask.Denom).Int64())
+}
+
+func TestProposeSwap_Invalid(t *testing.T) {
+	t.Parallel()
+	s, _ := setupSwap(t)
+
+	expiration := swapStart.Add(time.Hour)
+	msg := func() *types.MsgProposeSwap {
+		return &types.MsgProposeSwap{
+			Proposer:        s.addrs[0].String(),
+			Counterparty:    s.addrs[1].String(),
+			OfferBatchDenom: batchDenom,
+			OfferQuantity:   "10",
+			AskBatchDenom:   swapBatchDenom,
+			AskQuantity:     "8",
+			Expiration:      &expiration,
+		}
+	}
+
+	// offer batch not found
+	m := msg()
+	m.OfferBatchDenom = "C01-001-20200101-20200201-003"
+	_, err := s.k.ProposeSwap(s.ctx, m)
+	assert.ErrorContains(t, err, "offer batch denom C01-001-20200101-20200201-003: not found")
+
+	// ask batch not found
+	m = msg()
+	m.AskBatchDenom = "C01-001-20200101-20200201-003"
+	_, err = s.k.ProposeSwap(s.ctx, m)
+	assert.ErrorContains(t, err, "ask batch denom C01-001-20200101-20200201-003: not found")
+
+	// quantity exceeds precision
+	m = msg()
+	m.AskQuantity = "1.1234567"
+	_, err = s.k.ProposeSwap(s.ctx, m)
+	assert.ErrorContains(t, err, "ask quantity: decimal places exceeds precision")
+
+	// coin denom not allowed
+	m = msg()
+	m.OfferCoins = sdk.NewCoins(sdk.NewInt64Coin("uother", 100))
+	_, err = s.k.ProposeSwap(s.ctx, m)
+	assert.ErrorContains(t, err, "uother is not allowed to be used in swaps")
+
+	// expiration not in the future
+	m = msg()
+	m.Expiration = &swapStart
+	_, err = s.k.ProposeSwap(s.ctx, m)
+	assert.ErrorContains(t, err, "expiration must be in the future")
+
+	// insufficient credits
+	m = msg()
+	m.OfferQuantity = "101"
+	_, err = s.k.ProposeSwap(s.ctx, m)
+	assert.ErrorContains(t, err, "insufficient credit balance")
+
+	// insufficient funds
+	m = msg()
+	m.OfferCoins = sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 1001))
+	_, err = s.k.ProposeSwap(s.ctx, m)
+	assert.ErrorContains(t, err, "insufficient funds")
+}
+
+func TestProposeSwap_Suspended(t *testing.T) {
+	t.Parallel()
+
+	// both the offered and the requested credit batch must not be suspended
+	for _, batchKey := range []uint64{1, 2} {
+		s, _ := setupSwap(t)
+		s.setBatchSuspended(batchKey, true)
+
+		_, err := s.proposeSwap(nil)
+		assert.ErrorContains(t, err, "is suspended")
+	}
+}
@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+// RejectOffer rejects an offer and returns the escrowed funds to the buyer. An
+// offer can be rejected by the seller of the sell order or withdrawn by the
+// buyer at any time.
+func (k Keeper) RejectOffer(ctx context.Context, req *types.MsgRejectOffer) (*types.MsgRejectOfferResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	senderAcc, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	offer, err := k.stateStore.OfferTable().Get(ctx, req.OfferId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("offer with id %d: %s", req.OfferId, err.Error())
+	}
+
+	if !senderAcc.Equals(sdk.AccAddress(offer.Buyer)) {
+		sellOrder, err := k.stateStore.SellOrderTable().Get(ctx, offer.SellOrderId)
+		if err != nil || !senderAcc.Equals(sdk.AccAddress(sellOrder.Seller)) {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf(
+				"%s is not the buyer or the seller of offer %d", senderAcc, offer.Id,
+			)
+		}
+	}
+
+	if err = k.unescrowOffer(ctx, offer); err != nil {
+		return nil, err
+	}
+
+	if err = k.stateStore.OfferTable().Delete(ctx, offer); err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventRejectOffer{
+		OfferId: offer.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRejectOfferResponse{}, nil
+}
+
+// rejectSellOrderOffers returns the escrowed funds of the open offers on the
+// sell order to the buyers and deletes the offers. It is called whenever a sell
+// order is removed so that the funds are not held in escrow until the offers
+// expire.
+func (k Keeper) rejectSellOrderOffers(ctx context.Context, sellOrderID uint64) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	indexKey := api.OfferSellOrderIdIndexKey{}.WithSellOrderId(sellOrderID)
+
+	it, err := k.stateStore.OfferTable().List(ctx, indexKey)
+	if err != nil {
+		return err
+	}
+	for it.Next() {
+		offer, err := it.Value()
+		if err != nil {
+			it.Close()
+			return err
+		}
+		if err = k.unescrowOffer(ctx, offer); err != nil {
+			it.Close()
+			return err
+		}
+		if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventRejectOffer{
+			OfferId: offer.Id,
+		}); err != nil {
+			it.Close()
+			return err
+		}
+	}
+	it.Close()
+
+	return k.stateStore.OfferTable().DeleteBy(ctx, indexKey)
+}
+
+// unescrowOffer sends the funds held in escrow for the offer back to the buyer.
+func (k Keeper) unescrowOffer(ctx context.Context, offer *api.Offer) error {
+	market, err := k.stateStore.MarketTable().Get(ctx, offer.MarketId)
+	if err != nil {
+		return err
+	}
+
+	bidAmount, err := parseAmount(offer.BidAmount)
+	if err != nil {
+		return err
+	}
+
+	escrowed, err := getOfferEscrow(offer, bidAmount, market.BankDenom)
+	if err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(escrowed)
+	if coins.IsZero() {
+		return nil
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(
+		sdk.UnwrapSDKContext(ctx), ecocredit.ModuleName, offer.Buyer, coins,
+	)
+}
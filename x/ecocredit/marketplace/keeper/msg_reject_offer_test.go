@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/types/testutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+func TestRejectOffer(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name   string
+		sender int
+		err    string
+	}{
+		{name: "seller rejects the offer", sender: 0},
+		{name: "buyer withdraws the offer", sender: 1},
+		{name: "unauthorized", sender: 2, err: "is not the buyer or the seller of offer 1"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			s, balances, sellOrderID := setupOffer(t)
+
+			id, err := s.makeOffer(sellOrderID, 80)
+			assert.NilError(t, err)
+
+			_, err = s.k.RejectOffer(s.ctx, &types.MsgRejectOffer{Sender: s.addrs[tc.sender].String(), OfferId: id})
+			if tc.err != "" {
+				assert.ErrorContains(t, err, tc.err)
+				return
+			}
+			assert.NilError(t, err)
+
+			_, err = s.marketStore.OfferTable().Get(s.ctx, id)
+			assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+			// the escrowed funds are returned to the buyer
+			assert.Equal(t, int64(2000), balances[s.addrs[1].String()].AmountOf(ask.Denom).Int64())
+			assert.Equal(t, int64(0), balances[ecocredit.ModuleName].AmountOf(ask.Denom).Int64())
+		})
+	}
+}
+
+// assertOfferRejected checks that the offer was removed and the escrowed funds
+// of the offer made by the second test account were returned.
+func (s *baseSuite) assertOfferRejected(balances map[string]sdk.Coins, id uint64) {
+	_, err := s.marketStore.OfferTable().Get(s.ctx, id)
+	assert.ErrorContains(s.t, err, ormerrors.NotFound.Error())
+	assert.Equal(s.t, int64(2000), balances[s.addrs[1].String()].AmountOf(ask.Denom).Int64())
+
+	event := &types.EventRejectOffer{OfferId: id}
+	sdkEvent, found := testutil.GetEvent(event, s.sdkCtx.EventManager().Events())
+	assert.Check(s.t, found)
+	assert.NilError(s.t, testutil.MatchEvent(event, sdkEvent))
+}
+
+func TestRejectOffer_SellOrderCancelled(t *testing.T) {
+	t.Parallel()
+	s, balances, sellOrderID := setupOffer(t)
+	seller := s.addrs[0]
+
+	id, err := s.makeOffer(sellOrderID, 80)
+	assert.NilError(t, err)
+
+	_, err = s.k.CancelSellOrder(s.ctx, &types.MsgCancelSellOrder{Seller: seller.String(), SellOrderId: sellOrderID})
+	assert.NilError(t, err)
+
+	s.assertOfferRejected(balances, id)
+	assert.Equal(t, int64(0), balances[ecocredit.ModuleName].AmountOf(ask.Denom).Int64())
+}
+
+func TestRejectOffer_SellOrderFilled(t *testing.T) {
+	t.Parallel()
+	s, balances, sellOrderID := setupOffer(t)
+	buyer := s.addrs[2]
+	balances[buyer.String()] = sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 1000))
+
+	id, err := s.makeOffer(sellOrderID, 80)
+	assert.NilError(t, err)
+
+	bidPrice := sdk.NewInt64Coin(ask.Denom, 100)
+	_, err = s.k.BuyDirect(s.ctx, &types.MsgBuyDirect{
+		Buyer: buyer.String(),
+		Orders: []*types.MsgBuyDirect_Order{
+			{SellOrderId: sellOrderID, Quantity: "10", BidPrice: &bidPrice, RetirementJurisdiction: "US-WA"},
+		},
+	})
+	assert.NilError(t, err)
+
+	s.assertOfferRejected(balances, id)
+	assert.Equal(t, int64(0), balances[ecocredit.ModuleName].AmountOf(ask.Denom).Int64())
+}
+
+func TestRejectOffer_SellOrderExpired(t *testing.T) {
+	t.Parallel()
+	s, balances, _ := setupOffer(t)
+
+	askPrice := sdk.NewInt64Coin(ask.Denom, 100)
+	expiration := offerStart.Add(time.Hour)
+	res, err := s.k.Sell(s.ctx, &types.MsgSell{
+		Seller: s.addrs[0].String(),
+		Orders: []*types.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: "10", AskPrice: &askPrice, Expiration: &expiration},
+		},
+	})
+	assert.NilError(t, err)
+
+	id, err := s.makeOffer(res.SellOrderIds[0], 80)
+	assert.NilError(t, err)
+
+	s.setBlockTime(offerStart.Add(2 * time.Hour))
+	assert.NilError(t, s.k.PruneSellOrders(s.ctx))
+
+	s.assertOfferRejected(balances, id)
+	assert.Equal(t, int64(0), balances[ecocredit.ModuleName].AmountOf(ask.Denom).Int64())
+}
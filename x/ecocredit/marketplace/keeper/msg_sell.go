@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/errors"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
@@ -40,6 +41,10 @@ func (k Keeper) Sell(ctx context.Context, req *types.MsgSell) (*types.MsgSellRes
 			)
 		}
 
+		if err = utils.AssertBatchNotSuspended(batch); err != nil {
+			return nil, errors.Wrap(err, orderIndex)
+		}
+
 		creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.baseStore, batch.Denom)
 		if err != nil {
 			return nil, err
@@ -81,20 +86,51 @@ func (k Keeper) Sell(ctx context.Context, req *types.MsgSell) (*types.MsgSellRes
 			expiration = timestamppb.New(*order.Expiration)
 		}
 
-		id, err := k.stateStore.SellOrderTable().InsertReturningID(ctx, &marketApi.SellOrder{
+		var allowedBuyers [][]byte
+		for _, buyer := range order.AllowedBuyers {
+			buyerAcc, err := sdk.AccAddressFromBech32(buyer)
+			if err != nil {
+				return nil, err
+			}
+			allowedBuyers = append(allowedBuyers, buyerAcc)
+		}
+
+		sellOrder := &marketApi.SellOrder{
 			Seller:            sellerAcc,
 			BatchKey:          batch.Key,
 			Quantity:          order.Quantity,
 			MarketId:          marketID,
 			AskAmount:         order.AskPrice.Amount.String(),
+			SortableAskAmount: types.SortableAskAmount(order.AskPrice.Amount),
 			DisableAutoRetire: order.DisableAutoRetire,
 			Expiration:        expiration,
-			Maker:             true, // maker is always true for sell orders
-		})
+			Maker:             true,
+			AllowedBuyers:     allowedBuyers,
+		}
+		id, err := k.stateStore.SellOrderTable().InsertReturningID(ctx, sellOrder)
 		if err != nil {
 			return nil, err
 		}
 
+		if len(order.AdditionalAskPrices) != 0 {
+			sellOrder.Id = id
+			if err = k.setSellOrderAsks(ctx, orderIndex, sellOrder, creditType.Abbreviation, order.AdditionalAskPrices); err != nil {
+				return nil, err
+			}
+		}
+
+		// a sell order is a taker order if it matches existing buy orders
+		matched, err := k.orderBook.OnInsertSellOrder(ctx, sellOrder, batch)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			sellOrder.Maker = false
+			if err = k.stateStore.SellOrderTable().Update(ctx, sellOrder); err != nil {
+				return nil, err
+			}
+		}
+
 		sellOrderIDs[i] = id
 
 		if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventSell{
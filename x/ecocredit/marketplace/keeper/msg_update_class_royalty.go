@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+// UpdateClassRoyalty sets or removes the royalty paid whenever credits from the
+// credit class are sold in the marketplace. Only the class admin can update the
+// royalty and a royalty of zero removes the royalty.
+func (k Keeper) UpdateClassRoyalty(ctx context.Context, req *types.MsgUpdateClassRoyalty) (*types.MsgUpdateClassRoyaltyResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	reqAddr, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := k.baseStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"could not get credit class with id %s: %s", req.ClassId, err,
+		)
+	}
+
+	admin := sdk.AccAddress(class.Admin)
+	if !reqAddr.Equals(admin) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"%s is not the admin of credit class %s", req.Admin, req.ClassId,
+		)
+	}
+
+	if req.RoyaltyBps == 0 {
+		err = k.stateStore.ClassRoyaltyTable().Delete(ctx, &api.ClassRoyalty{ClassKey: class.Key})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+		if err != nil {
+			return nil, err
+		}
+
+		// the royalty is paid whenever credits are sold, including when buy
+		// orders are filled at the end of the block, so the recipient must
+		// be able to receive funds
+		if k.bankKeeper.BlockedAddr(recipient) {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf(
+				"%s is not allowed to receive funds", req.Recipient,
+			)
+		}
+
+		err = k.stateStore.ClassRoyaltyTable().Save(ctx, &api.ClassRoyalty{
+			ClassKey:   class.Key,
+			RoyaltyBps: req.RoyaltyBps,
+			Recipient:  recipient,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventUpdateClassRoyalty{
+		ClassId: req.ClassId,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateClassRoyaltyResponse{}, nil
+}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+type updateClassRoyaltySuite struct {
+	*baseSuite
+	alice sdk.AccAddress
+	bob   sdk.AccAddress
+	err   error
+}
+
+func TestUpdateClassRoyalty(t *testing.T) {
+	gocuke.NewRunner(t, &updateClassRoyaltySuite{}).Path("./features/msg_update_class_royalty.feature").Run()
+}
+
+func (s *updateClassRoyaltySuite) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t, 2)
+	s.alice = s.addrs[0]
+	s.bob = s.addrs[1]
+}
+
+func (s *updateClassRoyaltySuite) ACreditClassWithIdAndAdminAlice(a string) {
+	err := s.baseStore.ClassTable().Insert(s.ctx, &baseapi.Class{
+		Id:               a,
+		Admin:            s.alice,
+		CreditTypeAbbrev: "C",
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateClassRoyaltySuite) BobIsNotAllowedToReceiveFunds() {
+	s.blockedAddrs[s.bob.String()] = true
+}
+
+func (s *updateClassRoyaltySuite) AliceUpdatedTheRoyaltyOfClassToPaidToBob(a, b string) {
+	s.updateClassRoyalty(s.alice, a, b, s.bob)
+	require.NoError(s.t, s.err)
+}
+
+func (s *updateClassRoyaltySuite) AliceAttemptsToUpdateTheRoyaltyOfClassToPaidToBob(a, b string) {
+	s.updateClassRoyalty(s.alice, a, b, s.bob)
+}
+
+func (s *updateClassRoyaltySuite) AliceAttemptsToUpdateTheRoyaltyOfClassToPaidToAlice(a, b string) {
+	s.updateClassRoyalty(s.alice, a, b, s.alice)
+}
+
+func (s *updateClassRoyaltySuite) BobAttemptsToUpdateTheRoyaltyOfClassToPaidToBob(a, b string) {
+	s.updateClassRoyalty(s.bob, a, b, s.bob)
+}
+
+func (s *updateClassRoyaltySuite) AliceAttemptsToRemoveTheRoyaltyOfClass(a string) {
+	_, s.err = s.k.UpdateClassRoyalty(s.ctx, &types.MsgUpdateClassRoyalty{
+		Admin:   s.alice.String(),
+		ClassId: a,
+	})
+}
+
+func (s *updateClassRoyaltySuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *updateClassRoyaltySuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *updateClassRoyaltySuite) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *updateClassRoyaltySuite) ExpectClassRoyaltyOfClassIsPaidToBob(a, b string) {
+	s.expectClassRoyalty(a, b, s.bob)
+}
+
+func (s *updateClassRoyaltySuite) ExpectClassRoyaltyOfClassIsPaidToAlice(a, b string) {
+	s.expectClassRoyalty(a, b, s.alice)
+}
+
+func (s *updateClassRoyaltySuite) ExpectNoClassRoyaltyOfClass(a string) {
+	class, err := s.baseStore.ClassTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	_, err = s.marketStore.ClassRoyaltyTable().Get(s.ctx, class.Key)
+	require.ErrorIs(s.t, err, ormerrors.NotFound)
+}
+
+func (s *updateClassRoyaltySuite) ExpectEventWithProperties(a gocuke.DocString) {
+	var event types.EventUpdateClassRoyalty
+	err := json.Unmarshal([]byte(a.Content), &event)
+	require.NoError(s.t, err)
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
+
+func (s *updateClassRoyaltySuite) updateClassRoyalty(admin sdk.AccAddress, classID, royaltyBps string, recipient sdk.AccAddress) {
+	bps, err := strconv.ParseUint(royaltyBps, 10, 32)
+	require.NoError(s.t, err)
+
+	_, s.err = s.k.UpdateClassRoyalty(s.ctx, &types.MsgUpdateClassRoyalty{
+		Admin:      admin.String(),
+		ClassId:    classID,
+		RoyaltyBps: uint32(bps),
+		Recipient:  recipient.String(),
+	})
+}
+
+func (s *updateClassRoyaltySuite) expectClassRoyalty(classID, royaltyBps string, recipient sdk.AccAddress) {
+	bps, err := strconv.ParseUint(royaltyBps, 10, 32)
+	require.NoError(s.t, err)
+
+	class, err := s.baseStore.ClassTable().GetById(s.ctx, classID)
+	require.NoError(s.t, err)
+
+	royalty, err := s.marketStore.ClassRoyaltyTable().Get(s.ctx, class.Key)
+	require.NoError(s.t, err)
+	require.Equal(s.t, uint32(bps), royalty.RoyaltyBps)
+	require.Equal(s.t, recipient.Bytes(), royalty.Recipient)
+}
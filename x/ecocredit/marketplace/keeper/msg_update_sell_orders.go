@@ -86,6 +86,33 @@ func (k Keeper) applySellOrderUpdates(ctx context.Context, updateIndex string, o
 		}
 
 		order.AskAmount = update.NewAskPrice.Amount.String()
+		order.SortableAskAmount = types.SortableAskAmount(update.NewAskPrice.Amount)
+	}
+
+	if update.ClearAdditionalAskPrices || len(update.NewAdditionalAskPrices) != 0 {
+		if creditType == nil {
+			var err error
+			creditType, err = k.getCreditTypeFromBatchKey(ctx, order.BatchKey)
+			if err != nil {
+				return err
+			}
+		}
+		if err := k.setSellOrderAsks(ctx, updateIndex, order, creditType.Abbreviation, update.NewAdditionalAskPrices); err != nil {
+			return err
+		}
+	} else {
+		// the ask price cannot be updated to the denom of an additional ask price
+		// without also updating the additional ask prices
+		found, err := k.stateStore.SellOrderAskTable().Has(ctx, order.Id, order.MarketId)
+		if err != nil {
+			return err
+		}
+		if found {
+			return sdkerrors.ErrInvalidRequest.Wrapf(
+				"%s: new ask price denom %s is the denom of an additional ask price",
+				updateIndex, update.NewAskPrice.Denom,
+			)
+		}
 	}
 
 	if update.NewExpiration != nil {
@@ -144,7 +171,20 @@ func (k Keeper) applySellOrderUpdates(ctx context.Context, updateIndex string, o
 		}
 	}
 
-	order.Maker = true // maker is always true for sell orders
+	// the sell order is re-inserted into the order book because an update to
+	// the ask price or auto-retirement may change the buy orders it matches
+	if err := k.orderBook.OnRemoveSellOrder(ctx, order.Id); err != nil {
+		return err
+	}
+	batch, err := k.baseStore.BatchTable().Get(ctx, order.BatchKey)
+	if err != nil {
+		return err
+	}
+	matched, err := k.orderBook.OnInsertSellOrder(ctx, order, batch)
+	if err != nil {
+		return err
+	}
+	order.Maker = !matched
 
 	if err := k.stateStore.SellOrderTable().Update(ctx, order); err != nil {
 		return err
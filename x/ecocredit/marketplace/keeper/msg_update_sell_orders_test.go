@@ -432,14 +432,20 @@ func (s *updateSellOrdersSuite) sellOrderSetup(count int) {
 		totalQuantity = t.String()
 	}
 
-	err := s.baseStore.ClassTable().Insert(s.ctx, &baseapi.Class{
+	classKey, err := s.baseStore.ClassTable().InsertReturningID(s.ctx, &baseapi.Class{
 		Id:               s.classID,
 		CreditTypeAbbrev: s.creditTypeAbbrev,
 	})
 	require.NoError(s.t, err)
 
+	projectKey, err := s.baseStore.ProjectTable().InsertReturningID(s.ctx, &baseapi.Project{
+		ClassKey: classKey,
+	})
+	require.NoError(s.t, err)
+
 	batchKey, err := s.baseStore.BatchTable().InsertReturningID(s.ctx, &baseapi.Batch{
-		Denom: s.batchDenom,
+		ProjectKey: projectKey,
+		Denom:      s.batchDenom,
 	})
 	require.NoError(s.t, err)
 
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+// UpdateTradingFee is a governance method that updates the marketplace trading fee.
+func (k Keeper) UpdateTradingFee(ctx context.Context, req *types.MsgUpdateTradingFee) (*types.MsgUpdateTradingFeeResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("invalid authority: expected %s, got %s", k.authority, req.Authority)
+	}
+
+	if req.Destination == types.FeeDestination_FEE_DESTINATION_MODULE_ACCOUNT {
+		// the ecocredit module account holds credits and coins in escrow
+		if req.ModuleAccount == ecocredit.ModuleName {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"trading fees cannot be sent to the %s module account", ecocredit.ModuleName,
+			)
+		}
+
+		if k.accountKeeper.GetModuleAddress(req.ModuleAccount) == nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("module account %s does not exist", req.ModuleAccount)
+		}
+	}
+
+	if err := k.stateStore.TradingFeeTable().Save(ctx, &api.TradingFee{
+		MakerFeeBps:   req.MakerFeeBps,
+		TakerFeeBps:   req.TakerFeeBps,
+		Destination:   api.FeeDestination(req.Destination),
+		ModuleAccount: req.ModuleAccount,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateTradingFeeResponse{}, nil
+}
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/golang/mock/gomock"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+type updateTradingFeeSuite struct {
+	*baseSuite
+	moduleAccounts map[string]bool
+	err            error
+}
+
+func TestUpdateTradingFee(t *testing.T) {
+	gocuke.NewRunner(t, &updateTradingFeeSuite{}).Path("./features/msg_update_trading_fee.feature").Run()
+}
+
+func (s *updateTradingFeeSuite) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t, 1)
+	s.moduleAccounts = make(map[string]bool)
+
+	s.accountKeeper.EXPECT().
+		GetModuleAddress(gomock.Any()).
+		DoAndReturn(func(name string) sdk.AccAddress {
+			if !s.moduleAccounts[name] {
+				return nil
+			}
+			return authtypes.NewModuleAddress(name)
+		}).
+		AnyTimes() // only expected when the destination is a module account
+}
+
+func (s *updateTradingFeeSuite) ModuleAccountExists(a string) {
+	s.moduleAccounts[a] = true
+}
+
+func (s *updateTradingFeeSuite) TheTradingFeeWithProperties(a gocuke.DocString) {
+	var tradingFee types.TradingFee
+	err := jsonpb.UnmarshalString(a.Content, &tradingFee)
+	require.NoError(s.t, err)
+
+	err = s.marketStore.TradingFeeTable().Save(s.ctx, &api.TradingFee{
+		MakerFeeBps:   tradingFee.MakerFeeBps,
+		TakerFeeBps:   tradingFee.TakerFeeBps,
+		Destination:   api.FeeDestination(tradingFee.Destination),
+		ModuleAccount: tradingFee.ModuleAccount,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateTradingFeeSuite) AliceAttemptsToUpdateTheTradingFeeWithProperties(a gocuke.DocString) {
+	var msg types.MsgUpdateTradingFee
+	err := jsonpb.UnmarshalString(a.Content, &msg)
+	require.NoError(s.t, err)
+
+	_, s.err = s.k.UpdateTradingFee(s.ctx, &msg)
+}
+
+func (s *updateTradingFeeSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *updateTradingFeeSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *updateTradingFeeSuite) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *updateTradingFeeSuite) ExpectTradingFeeWithProperties(a gocuke.DocString) {
+	var expected types.TradingFee
+	err := jsonpb.UnmarshalString(a.Content, &expected)
+	require.NoError(s.t, err)
+
+	tradingFee, err := s.marketStore.TradingFeeTable().Get(s.ctx)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.MakerFeeBps, tradingFee.MakerFeeBps)
+	require.Equal(s.t, expected.TakerFeeBps, tradingFee.TakerFeeBps)
+	require.Equal(s.t, expected.Destination, types.FeeDestination(tradingFee.Destination))
+	require.Equal(s.t, expected.ModuleAccount, tradingFee.ModuleAccount)
+}
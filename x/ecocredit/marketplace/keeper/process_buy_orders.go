@@ -0,0 +1,241 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// ReloadOrderBook is a BeginBlock function that rebuilds the order book from
+// state the first time it is called after a node starts up.
+func (k Keeper) ReloadOrderBook(ctx context.Context) error {
+	return k.orderBook.Reload(ctx)
+}
+
+// ProcessBuyOrders is an EndBlock function that fills buy orders that have been
+// matched with sell orders in the order book by price/time priority. At most
+// buyOrderMatchLimit matches are processed per block and any remaining matches
+// are processed in the following blocks.
+func (k Keeper) ProcessBuyOrders(ctx context.Context) error {
+	return k.orderBook.ProcessBatch(ctx, k.buyOrderMatchLimit, k.tryFillBuyOrder)
+}
+
+// tryFillBuyOrder fills the buy order in a cache context. A fill that fails does
+// not fail the block. If the sell order cannot be filled, e.g. because it has no
+// ask price in the market of the buy order or because its credit batch has been
+// suspended, the sell order is cancelled and the buy order remains on the order
+// book. Otherwise the state changes of the fill are discarded, the error is
+// logged, and the buy order is cancelled and the escrowed funds are returned to
+// the buyer. The orders are removed from state rather than only removing the
+// match from the order book so that the outcome does not depend on the order
+// book held in memory, which is rebuilt from state when a node restarts.
+func (k Keeper) tryFillBuyOrder(ctx context.Context, buyOrder *api.BuyOrder, sellOrder *api.SellOrder) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if err := k.checkSellOrderFill(ctx, buyOrder, sellOrder); err != nil {
+		logger(sdkCtx).Error(
+			"failed to fill sell order",
+			"buy_order_id", buyOrder.Id,
+			"sell_order_id", sellOrder.Id,
+			"err", err,
+		)
+		return k.cancelSellOrder(ctx, sellOrder)
+	}
+
+	err := runInCacheContext(ctx, func(ctx context.Context) error {
+		return k.fillBuyOrder(ctx, buyOrder, sellOrder)
+	})
+	if err == nil {
+		return nil
+	}
+
+	logger(sdkCtx).Error(
+		"failed to fill buy order",
+		"buy_order_id", buyOrder.Id,
+		"sell_order_id", sellOrder.Id,
+		"err", err,
+	)
+
+	// the buy order is reloaded because the failed fill may have modified it
+	buyOrder, err = k.stateStore.BuyOrderTable().Get(ctx, buyOrder.Id)
+	if err != nil {
+		return err
+	}
+
+	if err = k.unescrowFunds(ctx, buyOrder); err != nil {
+		return err
+	}
+
+	if err = k.removeBuyOrder(ctx, buyOrder); err != nil {
+		return err
+	}
+
+	return sdkCtx.EventManager().EmitTypedEvent(&types.EventCancelBuyOrder{
+		BuyOrderId: buyOrder.Id,
+	})
+}
+
+// checkSellOrderFill checks that the sell order can be filled by the buy order,
+// i.e. the credit batch of the sell order is not suspended and the sell order
+// has an ask price in the market of the buy order.
+func (k Keeper) checkSellOrderFill(ctx context.Context, buyOrder *api.BuyOrder, sellOrder *api.SellOrder) error {
+	batch, err := k.baseStore.BatchTable().Get(ctx, sellOrder.BatchKey)
+	if err != nil {
+		return err
+	}
+	if err = utils.AssertBatchNotSuspended(batch); err != nil {
+		return err
+	}
+
+	market, err := k.stateStore.MarketTable().Get(ctx, buyOrder.MarketId)
+	if err != nil {
+		return err
+	}
+	askMarket, _, err := k.getSellOrderAsk(ctx, sellOrder, market.BankDenom)
+	if err != nil {
+		return err
+	}
+	if askMarket == nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf(
+			"sell order %d has no ask price in %s", sellOrder.Id, market.BankDenom,
+		)
+	}
+
+	return nil
+}
+
+// fillBuyOrder fills a buy order with as many credits as are available from the
+// matched sell order. Credits are always purchased at the ask price of the sell
+// order in the market of the buy order and the difference between the escrowed
+// bid amount and the cost is returned to the buyer. The order that was on the
+// market first is charged the maker fee and the buyer fee is paid from the
+// trading fee held in escrow with the buy order.
+func (k Keeper) fillBuyOrder(ctx context.Context, buyOrder *api.BuyOrder, sellOrder *api.SellOrder) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// orderIndex is used for more granular error messages
+	orderIndex := fmt.Sprintf("buy order %d", buyOrder.Id)
+
+	buyQty, err := math.NewDecFromString(buyOrder.Quantity)
+	if err != nil {
+		return err
+	}
+	sellQty, err := math.NewDecFromString(sellOrder.Quantity)
+	if err != nil {
+		return err
+	}
+	purchaseQty := buyQty
+	if sellQty.Cmp(buyQty) == math.LessThan {
+		purchaseQty = sellQty
+	}
+	remainingQty, err := buyQty.Sub(purchaseQty)
+	if err != nil {
+		return err
+	}
+
+	bidAmount, ok := sdk.NewIntFromString(buyOrder.BidAmount)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", buyOrder.BidAmount, sdk.Int{})
+	}
+
+	market, err := k.stateStore.MarketTable().Get(ctx, buyOrder.MarketId)
+	if err != nil {
+		return err
+	}
+
+	// the buy order is matched with the ask price of the sell order in the
+	// market of the buy order, i.e. the ask price or an additional ask price
+	askMarket, askAmount, err := k.getSellOrderAsk(ctx, sellOrder, market.BankDenom)
+	if err != nil {
+		return err
+	}
+	if askMarket == nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf(
+			"%s: sell order %d has no ask price in %s", orderIndex, sellOrder.Id, market.BankDenom,
+		)
+	}
+
+	batch, err := k.baseStore.BatchTable().Get(ctx, sellOrder.BatchKey)
+	if err != nil {
+		return err
+	}
+	if err = utils.AssertBatchNotSuspended(batch); err != nil {
+		return err
+	}
+
+	cost, err := utils.GetTotalCost(askAmount, purchaseQty)
+	if err != nil {
+		return err
+	}
+
+	// the escrowed amount released by this fill is calculated as the difference
+	// between the escrowed amount before and after the fill so that the sum of
+	// all releases always equals the amount escrowed when the order was created
+	escrowedBefore, err := utils.GetTotalCost(bidAmount, buyQty)
+	if err != nil {
+		return err
+	}
+	escrowedAfter, err := utils.GetTotalCost(bidAmount, remainingQty)
+	if err != nil {
+		return err
+	}
+	refund := escrowedBefore.Sub(escrowedAfter).Sub(cost)
+
+	// the trading fee escrowed for the credits purchased by this fill is
+	// calculated in the same way
+	escrowedFee := getTradingFee(escrowedBefore, buyOrder.EscrowedFeeBps).
+		Sub(getTradingFee(escrowedAfter, buyOrder.EscrowedFeeBps))
+
+	opts := orderOptions{
+		autoRetire:   !buyOrder.DisableAutoRetire,
+		batchDenom:   batch.Denom,
+		jurisdiction: buyOrder.RetirementJurisdiction,
+		reason:       buyOrder.RetirementReason,
+		beneficiary:  buyOrder.RetirementBeneficiary,
+		fromEscrow:   true,
+		escrowedFee:  escrowedFee,
+		buyerMaker:   buyOrder.Maker,
+		sellerMaker:  sellOrder.Maker,
+		isolateHooks: true,
+	}
+	res, err := k.fillOrder(ctx, orderIndex, sellOrder, buyOrder.Buyer, purchaseQty, sdk.NewCoin(market.BankDenom, cost),
+		market.Id, askAmount.String(), opts)
+	if err != nil {
+		return err
+	}
+
+	if refund.IsPositive() {
+		if err = k.bankKeeper.SendCoinsFromModuleToAccount(
+			sdkCtx, ecocredit.ModuleName, buyOrder.Buyer, sdk.NewCoins(sdk.NewCoin(market.BankDenom, refund)),
+		); err != nil {
+			return err
+		}
+	}
+
+	if remainingQty.IsZero() {
+		if err = k.removeBuyOrder(ctx, buyOrder); err != nil {
+			return err
+		}
+	} else {
+		buyOrder.Quantity = remainingQty.String()
+		if err = k.stateStore.BuyOrderTable().Update(ctx, buyOrder); err != nil {
+			return err
+		}
+	}
+
+	return sdkCtx.EventManager().EmitTypedEvent(&types.EventFillBuyOrder{
+		BuyOrderId:  buyOrder.Id,
+		SellOrderId: sellOrder.Id,
+		Royalty:     &res.royalty,
+		BuyerFee:    &res.buyerFee,
+		SellerFee:   &res.sellerFee,
+	})
+}
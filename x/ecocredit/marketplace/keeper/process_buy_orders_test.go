@@ -0,0 +1,738 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormdb"
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormstore"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/orderbook"
+)
+
+type processBuyOrdersSuite struct {
+	*baseSuite
+	seller       sdk.AccAddress
+	buyer        sdk.AccAddress
+	bankBalances map[string]sdk.Coins
+}
+
+func setupProcessBuyOrders(t *testing.T) *processBuyOrdersSuite {
+	s := &processBuyOrdersSuite{baseSuite: setupBase(t, 3)}
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], classID, start, end, creditType)
+	s.seller = s.addrs[0]
+	s.buyer = s.addrs[1]
+	s.bankBalances = s.mockBankBalances()
+	s.bankBalances[s.buyer.String()] = sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 1000))
+	s.bankBalances[s.addrs[2].String()] = sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 1000))
+	return s
+}
+
+func (s *processBuyOrdersSuite) sell(quantity string, askAmount int64, disableAutoRetire bool) uint64 {
+	askPrice := sdk.NewInt64Coin(ask.Denom, askAmount)
+	res, err := s.k.Sell(s.ctx, &types.MsgSell{
+		Seller: s.seller.String(),
+		Orders: []*types.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: quantity, AskPrice: &askPrice, DisableAutoRetire: disableAutoRetire},
+		},
+	})
+	require.NoError(s.t, err)
+	return res.SellOrderIds[0]
+}
+
+func (s *processBuyOrdersSuite) buy(buyer sdk.AccAddress, quantity string, bidAmount int64, disableAutoRetire bool) uint64 {
+	bidPrice := sdk.NewInt64Coin(ask.Denom, bidAmount)
+	return s.buyOrder(buyer, &types.MsgBuyOrder_Order{
+		BatchDenom:             batchDenom,
+		Quantity:               quantity,
+		BidPrice:               &bidPrice,
+		DisableAutoRetire:      disableAutoRetire,
+		RetirementJurisdiction: "US-WA",
+	})
+}
+
+func (s *processBuyOrdersSuite) buyOrder(buyer sdk.AccAddress, order *types.MsgBuyOrder_Order) uint64 {
+	res, err := s.k.Buy(s.ctx, &types.MsgBuyOrder{
+		Buyer:  buyer.String(),
+		Orders: []*types.MsgBuyOrder_Order{order},
+	})
+	require.NoError(s.t, err)
+	return res.BuyOrderIds[0]
+}
+
+func (s *processBuyOrdersSuite) assertBankBalance(addr string, amount int64) {
+	require.Equal(s.t, sdk.NewInt64Coin(ask.Denom, amount).String(), sdk.NewCoin(ask.Denom, s.bankBalances[addr].AmountOf(ask.Denom)).String())
+}
+
+func (s *processBuyOrdersSuite) assertBuyOrderQuantity(id uint64, quantity string) {
+	buyOrder, err := s.marketStore.BuyOrderTable().Get(s.ctx, id)
+	require.NoError(s.t, err)
+	require.Equal(s.t, quantity, buyOrder.Quantity)
+}
+
+func (s *processBuyOrdersSuite) assertNoBuyOrder(id uint64) {
+	_, err := s.marketStore.BuyOrderTable().Get(s.ctx, id)
+	require.ErrorContains(s.t, err, ormerrors.NotFound.Error())
+}
+
+func (s *processBuyOrdersSuite) assertSellOrderQuantity(id uint64, quantity string) {
+	sellOrder, err := s.marketStore.SellOrderTable().Get(s.ctx, id)
+	require.NoError(s.t, err)
+	require.Equal(s.t, quantity, sellOrder.Quantity)
+}
+
+func (s *processBuyOrdersSuite) assertNoSellOrder(id uint64) {
+	_, err := s.marketStore.SellOrderTable().Get(s.ctx, id)
+	require.ErrorContains(s.t, err, ormerrors.NotFound.Error())
+}
+
+func (s *processBuyOrdersSuite) batchBalance(addr sdk.AccAddress) *baseapi.BatchBalance {
+	balance, err := s.baseStore.BatchBalanceTable().Get(s.ctx, addr, 1)
+	require.NoError(s.t, err)
+	return balance
+}
+
+func TestProcessBuyOrders_FillAtAskPrice(t *testing.T) {
+	t.Parallel()
+	s := setupProcessBuyOrders(t)
+
+	sellOrderID := s.sell("10", 8, false)
+	buyOrderID := s.buy(s.buyer, "10", 10, false)
+
+	// the total bid amount is escrowed
+	s.assertBankBalance(s.buyer.String(), 900)
+	s.assertBankBalance(ecocredit.ModuleName, 100)
+
+	require.NoError(t, s.k.ProcessBuyOrders(s.ctx))
+
+	// credits are bought at the ask price and the difference is refunded
+	s.assertBankBalance(s.buyer.String(), 920)
+	s.assertBankBalance(s.seller.String(), 80)
+	s.assertBankBalance(ecocredit.ModuleName, 0)
+
+	s.assertNoBuyOrder(buyOrderID)
+	s.assertNoSellOrder(sellOrderID)
+
+	require.Equal(t, "10", s.batchBalance(s.buyer).RetiredAmount)
+	require.Equal(t, "0", s.batchBalance(s.seller).EscrowedAmount)
+
+	supply, err := s.baseStore.BatchSupplyTable().Get(s.ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, "90", supply.TradableAmount)
+	require.Equal(t, "110", supply.RetiredAmount)
+
+	// the trade is recorded at the ask price
+	trade, err := s.marketStore.TradeTable().Get(s.ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, s.buyer.Bytes(), trade.Buyer)
+	require.Equal(t, s.seller.Bytes(), trade.Seller)
+	require.Equal(t, "10", trade.Quantity)
+	require.Equal(t, "8", trade.Price)
+
+	royalty := sdk.NewInt64Coin(ask.Denom, 0)
+	noFee := sdk.NewInt64Coin(ask.Denom, 0)
+	event := &types.EventFillBuyOrder{
+		BuyOrderId:  buyOrderID,
+		SellOrderId: sellOrderID,
+		Royalty:     &royalty,
+		BuyerFee:    &noFee,
+		SellerFee:   &noFee,
+	}
+	sdkEvent, found := testutil.GetEvent(event, s.sdkCtx.EventManager().Events())
+	require.True(t, found)
+	require.NoError(t, testutil.MatchEvent(event, sdkEvent))
+
+	// processing again is a no-op
+	require.NoError(t, s.k.ProcessBuyOrders(s.ctx))
+	s.assertBankBalance(s.buyer.String(), 920)
+}
+
+func TestProcessBuyOrders_ClassRoyalty(t *testing.T) {
+	t.Parallel()
+	s := setupProcessBuyOrders(t)
+
+	recipient := s.addrs[2]
+	require.NoError(t, s.marketStore.ClassRoyaltyTable().Insert(s.ctx, &api.ClassRoyalty{
+		ClassKey:   1,
+		RoyaltyBps: 500,
+		Recipient:  recipient,
+	}))
+
+	sellOrderID := s.sell("10", 8, false)
+	buyOrderID := s.buy(s.buyer, "10", 10, false)
+
+	require.NoError(t, s.k.ProcessBuyOrders(s.ctx))
+
+	// the royalty is paid from escrow out of the amount paid to the seller
+	s.assertBankBalance(s.buyer.String(), 920)
+	s.assertBankBalance(s.seller.String(), 76)
+	s.assertBankBalance(recipient.String(), 1004)
+	s.assertBankBalance(ecocredit.ModuleName, 0)
+
+	royalty := sdk.NewInt64Coin(ask.Denom, 4)
+	noFee := sdk.NewInt64Coin(ask.Denom, 0)
+	event := &types.EventFillBuyOrder{
+		BuyOrderId:  buyOrderID,
+		SellOrderId: sellOrderID,
+		Royalty:     &royalty,
+		BuyerFee:    &noFee,
+		SellerFee:   &noFee,
+	}
+	sdkEvent, found := testutil.GetEvent(event, s.sdkCtx.EventManager().Events())
+	require.True(t, found)
+
+	// testutil.MatchEvent cannot compare non-zero sdk.Int royalties
+	emitted, err := sdk.ParseTypedEvent(abci.Event(sdkEvent))
+	require.NoError(t, err)
+	require.Equal(t, event.String(), emitted.String())
+}
+
+func TestProcessBuyOrders_BlockedRoyaltyRecipient(t *testing.T) {
+	t.Parallel()
+	s := setupProcessBuyOrders(t)
+
+	recipient := s.addrs[2]
+	require.NoError(t, s.marketStore.ClassRoyaltyTable().Insert(s.ctx, &api.ClassRoyalty{
+		ClassKey:   1,
+		RoyaltyBps: 500,
+		Recipient:  recipient,
+	}))
+	s.blockedAddrs[recipient.String()] = true
+
+	sellOrderID := s.sell("10", 8, false)
+	buyOrderID := s.buy(s.buyer, "10", 10, false)
+
+	require.NoError(t, s.k.ProcessBuyOrders(s.ctx))
+
+	// the royalty is skipped and the seller receives the full cost
+	s.assertBankBalance(s.buyer.String(), 920)
+	s.assertBankBalance(s.seller.String(), 80)
+	s.assertBankBalance(recipient.String(), 1000)
+	s.assertBankBalance(ecocredit.ModuleName, 0)
+
+	s.assertNoBuyOrder(buyOrderID)
+	s.assertNoSellOrder(sellOrderID)
+
+	royalty := sdk.NewInt64Coin(ask.Denom, 0)
+	noFee := sdk.NewInt64Coin(ask.Denom, 0)
+	event := &types.EventFillBuyOrder{
+		BuyOrderId:  buyOrderID,
+		SellOrderId: sellOrderID,
+		Royalty:     &royalty,
+		BuyerFee:    &noFee,
+		SellerFee:   &noFee,
+	}
+	sdkEvent, found := testutil.GetEvent(event, s.sdkCtx.EventManager().Events())
+	require.True(t, found)
+	require.NoError(t, testutil.MatchEvent(event, sdkEvent))
+}
+
+func TestProcessBuyOrders_TradingFees(t *testing.T) {
+	t.Parallel()
+	s := setupProcessBuyOrders(t)
+	s.bankBalances[s.buyer.String()] = sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 2000))
+	s.setTradingFee(100, 200)
+
+	sellOrderID := s.sell("10", 100, false)
+	buyOrderID := s.buy(s.buyer, "10", 100, false)
+
+	require.NoError(t, s.k.ProcessBuyOrders(s.ctx))
+
+	// the seller pays the maker fee out of the escrowed funds and the buyer
+	// that matched the sell order pays the taker fee
+	s.assertBankBalance(s.buyer.String(), 980)
+	s.assertBankBalance(s.seller.String(), 990)
+	s.assertBankBalance("burn", 30)
+	s.assertBankBalance(ecocredit.ModuleName, 0)
+
+	royalty := sdk.NewInt64Coin(ask.Denom, 0)
+	buyerFee := sdk.NewInt64Coin(ask.Denom, 20)
+	sellerFee := sdk.NewInt64Coin(ask.Denom, 10)
+	event := &types.EventFillBuyOrder{
+		BuyOrderId:  buyOrderID,
+		SellOrderId: sellOrderID,
+		Royalty:     &royalty,
+		BuyerFee:    &buyerFee,
+		SellerFee:   &sellerFee,
+	}
+	sdkEvent, found := testutil.GetEvent(event, s.sdkCtx.EventManager().Events())
+	require.True(t, found)
+	emitted, err := sdk.ParseTypedEvent(abci.Event(sdkEvent))
+	require.NoError(t, err)
+	require.Equal(t, event.String(), emitted.String())
+}
+
+func TestProcessBuyOrders_MakerBuyOrderFees(t *testing.T) {
+	t.Parallel()
+	s := setupProcessBuyOrders(t)
+	s.bankBalances[s.buyer.String()] = sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 2000))
+	s.setTradingFee(100, 200)
+
+	s.buy(s.buyer, "10", 100, false)
+	s.sell("10", 100, false)
+
+	require.NoError(t, s.k.ProcessBuyOrders(s.ctx))
+
+	// the buyer pays the maker fee and the seller that matched the buy order
+	// pays the taker fee
+	s.assertBankBalance(s.buyer.String(), 990)
+	s.assertBankBalance(s.seller.String(), 980)
+	s.assertBankBalance("burn", 30)
+	s.assertBankBalance(ecocredit.ModuleName, 0)
+}
+
+func TestProcessBuyOrders_PartialFill(t *testing.T) {
+	t.Parallel()
+	s := setupProcessBuyOrders(t)
+
+	sellOrderID := s.sell("4", 10, true)
+	buyOrderID := s.buy(s.buyer, "10", 10, true)
+
+	require.NoError(t, s.k.ProcessBuyOrders(s.ctx))
+
+	// the buy order remains with the unfilled quantity and escrow
+	s.assertBuyOrderQuantity(buyOrderID, "6")
+	s.assertNoSellOrder(sellOrderID)
+	s.assertBankBalance(s.seller.String(), 40)
+	s.assertBankBalance(ecocredit.ModuleName, 60)
+	require.Equal(t, "4", s.batchBalance(s.buyer).TradableAmount)
+
+	// a new sell order fills part of the remaining quantity
+	sellOrderID = s.sell("10", 10, true)
+	require.NoError(t, s.k.ProcessBuyOrders(s.ctx))
+
+	s.assertNoBuyOrder(buyOrderID)
+	s.assertSellOrderQuantity(sellOrderID, "4")
+	s.assertBankBalance(s.seller.String(), 100)
+	s.assertBankBalance(ecocredit.ModuleName, 0)
+	s.assertBankBalance(s.buyer.String(), 900)
+	require.Equal(t, "10", s.batchBalance(s.buyer).TradableAmount)
+}
+
+func TestProcessBuyOrders_LowestAskFirst(t *testing.T) {
+	t.Parallel()
+	s := setupProcessBuyOrders(t)
+
+	highAsk := s.sell("5", 9, false)
+	lowAsk := s.sell("5", 7, false)
+	buyOrderID := s.buy(s.buyer, "5", 10, false)
+
+	require.NoError(t, s.k.ProcessBuyOrders(s.ctx))
+
+	s.assertNoBuyOrder(buyOrderID)
+	s.assertNoSellOrder(lowAsk)
+	s.assertSellOrderQuantity(highAsk, "5")
+	s.assertBankBalance(s.seller.String(), 35)
+	s.assertBankBalance(s.buyer.String(), 965)
+}
+
+func TestProcessBuyOrders_HighestBidFirst(t *testing.T) {
+	t.Parallel()
+	s := setupProcessBuyOrders(t)
+
+	lowBid := s.buy(s.buyer, "5", 10, false)
+	highBid := s.buy(s.addrs[2], "5", 12, false)
+	sellOrderID := s.sell("5", 10, false)
+
+	require.NoError(t, s.k.ProcessBuyOrders(s.ctx))
+
+	s.assertNoSellOrder(sellOrderID)
+	s.assertNoBuyOrder(highBid)
+	s.assertBuyOrderQuantity(lowBid, "5")
+	s.assertBankBalance(s.addrs[2].String(), 950)
+	s.assertBankBalance(ecocredit.ModuleName, 50)
+}
+
+func TestProcessBuyOrders_TimePriority(t *testing.T) {
+	t.Parallel()
+	s := setupProcessBuyOrders(t)
+
+	first := s.buy(s.addrs[2], "5", 10, false)
+	second := s.buy(s.buyer, "5", 10, false)
+	sellOrderID := s.sell("5", 10, false)
+
+	require.NoError(t, s.k.ProcessBuyOrders(s.ctx))
+
+	s.assertNoSellOrder(sellOrderID)
+	s.assertNoBuyOrder(first)
+	s.assertBuyOrderQuantity(second, "5")
+}
+
+func TestProcessBuyOrders_FailedFill(t *testing.T) {
+	t.Parallel()
+	s := setupProcessBuyOrders(t)
+
+	lowAsk := s.sell("5", 8, false)
+	highAsk := s.sell("5", 9, false)
+	highBid := s.buy(s.buyer, "5", 10, false)
+	lowBid := s.buy(s.addrs[2], "5", 9, false)
+
+	// only the credits of one sell order are held in escrow so that the
+	// second fill fails after the sell order has been updated
+	balance := s.batchBalance(s.seller)
+	balance.EscrowedAmount = "5"
+	require.NoError(t, s.baseStore.BatchBalanceTable().Update(s.ctx, balance))
+
+	require.NoError(t, s.k.ProcessBuyOrders(s.ctx))
+
+	// the first fill succeeds
+	s.assertNoBuyOrder(highBid)
+	s.assertNoSellOrder(lowAsk)
+	s.assertBankBalance(s.seller.String(), 40)
+
+	// the failed fill is discarded and the buy order is cancelled
+	s.assertNoBuyOrder(lowBid)
+	s.assertSellOrderQuantity(highAsk, "5")
+	s.assertBankBalance(s.addrs[2].String(), 1000)
+	s.assertBankBalance(ecocredit.ModuleName, 0)
+	require.Equal(t, "0", s.batchBalance(s.seller).EscrowedAmount)
+
+	event := &types.EventCancelBuyOrder{BuyOrderId: lowBid}
+	sdkEvent, found := testutil.GetEvent(event, s.sdkCtx.EventManager().Events())
+	require.True(t, found)
+	require.NoError(t, testutil.MatchEvent(event, sdkEvent))
+}
+
+func TestProcessBuyOrders_Suspended(t *testing.T) {
+	t.Parallel()
+	s := setupProcessBuyOrders(t)
+
+	sellOrderID := s.sell("10", 8, false)
+	s.setBatchSuspended(1, true)
+	buyOrderID := s.buy(s.buyer, "10", 10, false)
+
+	// sell orders of a suspended credit batch are not matched and both orders
+	// remain on the market
+	require.NoError(t, s.k.ProcessBuyOrders(s.ctx))
+
+	s.assertBuyOrderQuantity(buyOrderID, "10")
+	s.assertSellOrderQuantity(sellOrderID, "10")
+	s.assertBankBalance(s.buyer.String(), 900)
+	s.assertBankBalance(ecocredit.ModuleName, 100)
+
+	// the sell orders are matched once the credit batch is unsuspended
+	s.setBatchSuspended(1, false)
+	batch, err := s.baseStore.BatchTable().Get(s.ctx, 1)
+	require.NoError(t, err)
+	require.NoError(t, s.k.AfterBatchUnsuspended(s.ctx, batch))
+	require.NoError(t, s.k.ProcessBuyOrders(s.ctx))
+
+	s.assertNoBuyOrder(buyOrderID)
+	s.assertBankBalance(s.buyer.String(), 920)
+	s.assertBankBalance(s.seller.String(), 80)
+	s.assertBankBalance(ecocredit.ModuleName, 0)
+}
+
+func TestProcessBuyOrders_SuspendedAfterMatch(t *testing.T) {
+	t.Parallel()
+	s := setupProcessBuyOrders(t)
+
+	sellOrderID := s.sell("10", 8, false)
+	buyOrderID := s.buy(s.buyer, "10", 10, false)
+
+	// suspending the credit batch removes the matches of its sell orders
+	s.setBatchSuspended(1, true)
+	batch, err := s.baseStore.BatchTable().Get(s.ctx, 1)
+	require.NoError(t, err)
+	require.NoError(t, s.k.AfterBatchSuspended(s.ctx, batch))
+	require.NoError(t, s.k.ProcessBuyOrders(s.ctx))
+
+	s.assertBuyOrderQuantity(buyOrderID, "10")
+	s.assertSellOrderQuantity(sellOrderID, "10")
+	s.assertBankBalance(s.buyer.String(), 900)
+	s.assertBankBalance(ecocredit.ModuleName, 100)
+	require.Equal(t, "10", s.batchBalance(s.seller).EscrowedAmount)
+}
+
+func TestProcessBuyOrders_SellOrderFailedFill(t *testing.T) {
+	t.Parallel()
+	s := setupProcessBuyOrders(t)
+
+	sellOrderID := s.sell("10", 8, false)
+	buyOrderID := s.buy(s.buyer, "10", 10, false)
+
+	// the credit batch is suspended without removing the matches
+	s.setBatchSuspended(1, true)
+
+	// a fill that fails because of the sell order cancels the sell order and
+	// the buy order remains on the market
+	require.NoError(t, s.k.ProcessBuyOrders(s.ctx))
+
+	s.assertBuyOrderQuantity(buyOrderID, "10")
+	_, err := s.marketStore.SellOrderTable().Get(s.ctx, sellOrderID)
+	require.ErrorContains(t, err, ormerrors.NotFound.Error())
+	s.assertBankBalance(s.buyer.String(), 900)
+	s.assertBankBalance(ecocredit.ModuleName, 100)
+	require.Equal(t, "0", s.batchBalance(s.seller).EscrowedAmount)
+
+	event := &types.EventCancelSellOrder{SellOrderId: sellOrderID}
+	sdkEvent, found := testutil.GetEvent(event, s.sdkCtx.EventManager().Events())
+	require.True(t, found)
+	require.NoError(t, testutil.MatchEvent(event, sdkEvent))
+}
+
+func TestProcessBuyOrders_MatchLimit(t *testing.T) {
+	t.Parallel()
+	s := setupProcessBuyOrders(t)
+	s.k.buyOrderMatchLimit = 1
+
+	first := s.buy(s.buyer, "5", 10, false)
+	second := s.buy(s.addrs[2], "5", 10, false)
+	s.sell("10", 10, false)
+
+	// one match is processed per block
+	require.NoError(t, s.k.ProcessBuyOrders(s.ctx))
+	s.assertNoBuyOrder(first)
+	s.assertBuyOrderQuantity(second, "5")
+
+	require.NoError(t, s.k.ProcessBuyOrders(s.ctx))
+	s.assertNoBuyOrder(second)
+}
+
+func TestProcessBuyOrders_NoMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                  string
+		askAmount             int64
+		sellDisableAutoRetire bool
+		buyDisableAutoRetire  bool
+		buyerIsSeller         bool
+	}{
+		{
+			name:      "ask price greater than bid price",
+			askAmount: 11,
+		},
+		{
+			name:                  "buyer disables auto-retire when seller does not",
+			askAmount:             10,
+			sellDisableAutoRetire: false,
+			buyDisableAutoRetire:  true,
+		},
+		{
+			name:          "buyer is the seller",
+			askAmount:     10,
+			buyerIsSeller: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			s := setupProcessBuyOrders(t)
+
+			buyer := s.buyer
+			if tc.buyerIsSeller {
+				buyer = s.seller
+				s.bankBalances[buyer.String()] = sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 1000))
+			}
+
+			sellOrderID := s.sell("5", tc.askAmount, tc.sellDisableAutoRetire)
+			buyOrderID := s.buy(buyer, "5", 10, tc.buyDisableAutoRetire)
+
+			buyOrder, err := s.marketStore.BuyOrderTable().Get(s.ctx, buyOrderID)
+			require.NoError(t, err)
+			require.True(t, buyOrder.Maker)
+
+			require.NoError(t, s.k.ProcessBuyOrders(s.ctx))
+
+			s.assertBuyOrderQuantity(buyOrderID, "5")
+			s.assertSellOrderQuantity(sellOrderID, "5")
+			s.assertBankBalance(ecocredit.ModuleName, 50)
+		})
+	}
+}
+
+func TestProcessBuyOrders_AllowedBuyers(t *testing.T) {
+	t.Parallel()
+	s := setupProcessBuyOrders(t)
+
+	askPrice := sdk.NewInt64Coin(ask.Denom, 10)
+	res, err := s.k.Sell(s.ctx, &types.MsgSell{
+		Seller: s.seller.String(),
+		Orders: []*types.MsgSell_Order{
+			{
+				BatchDenom:    batchDenom,
+				Quantity:      "5",
+				AskPrice:      &askPrice,
+				AllowedBuyers: []string{s.addrs[2].String()},
+			},
+		},
+	})
+	require.NoError(t, err)
+	sellOrderID := res.SellOrderIds[0]
+
+	// a buy order from a buyer that is not allowed is not matched
+	buyOrderID := s.buy(s.buyer, "5", 10, false)
+	require.NoError(t, s.k.ProcessBuyOrders(s.ctx))
+	s.assertBuyOrderQuantity(buyOrderID, "5")
+	s.assertSellOrderQuantity(sellOrderID, "5")
+
+	// a buy order from the allowed buyer is matched
+	allowedBuyOrderID := s.buy(s.addrs[2], "5", 10, false)
+	require.NoError(t, s.k.ProcessBuyOrders(s.ctx))
+	s.assertNoBuyOrder(allowedBuyOrderID)
+	s.assertNoSellOrder(sellOrderID)
+	s.assertBuyOrderQuantity(buyOrderID, "5")
+}
+
+func TestProcessBuyOrders_Reload(t *testing.T) {
+	t.Parallel()
+	s := setupProcessBuyOrders(t)
+
+	sellOrderID := s.sell("5", 10, false)
+	buyOrderID := s.buy(s.buyer, "5", 10, false)
+
+	bidPrice := sdk.NewInt64Coin(ask.Denom, 10)
+	classBuyOrderID := s.buyOrder(s.buyer, &types.MsgBuyOrder_Order{
+		ClassSelector:          &types.MsgBuyOrder_ClassSelector{ClassId: classID, ProjectLocation: "US"},
+		Quantity:               "5",
+		BidPrice:               &bidPrice,
+		RetirementJurisdiction: "US-WA",
+	})
+
+	// simulate a node restart with an empty order book
+	require.NoError(t, s.orderBook.OnRemoveBuyOrder(s.ctx, buyOrderID))
+	require.NoError(t, s.orderBook.OnRemoveBuyOrder(s.ctx, classBuyOrderID))
+	memDB, err := ormstore.NewStoreKeyDB(&orderbook.ModuleSchema, s.memStoreKey, ormdb.ModuleDBOptions{})
+	require.NoError(t, err)
+	s.orderBook, err = orderbook.NewOrderBook(memDB, s.marketStore, s.baseStore)
+	require.NoError(t, err)
+	s.k = NewKeeper(s.marketStore, s.baseStore, s.orderBook, s.accountKeeper, s.bankKeeper, s.distrKeeper, s.transferKeeper, s.channelKeeper,
+		s.paramsKeeper, s.k.authority)
+
+	require.NoError(t, s.k.ProcessBuyOrders(s.ctx))
+	s.assertBuyOrderQuantity(buyOrderID, "5")
+	s.assertBuyOrderQuantity(classBuyOrderID, "5")
+
+	require.NoError(t, s.k.ReloadOrderBook(s.ctx))
+	require.NoError(t, s.k.ProcessBuyOrders(s.ctx))
+
+	// the batch buy order has time priority over the class buy order
+	s.assertNoBuyOrder(buyOrderID)
+	s.assertNoSellOrder(sellOrderID)
+	s.assertBuyOrderQuantity(classBuyOrderID, "5")
+
+	// the class selector is reloaded and matches new sell orders
+	secondSellOrderID := s.sell("5", 10, false)
+	require.NoError(t, s.k.ProcessBuyOrders(s.ctx))
+
+	s.assertNoBuyOrder(classBuyOrderID)
+	s.assertNoSellOrder(secondSellOrderID)
+}
+
+func TestProcessBuyOrders_Selectors(t *testing.T) {
+	t.Parallel()
+
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	testCases := []struct {
+		name            string
+		classSelector   *types.MsgBuyOrder_ClassSelector
+		projectSelector *types.MsgBuyOrder_ProjectSelector
+		matched         bool
+	}{
+		{
+			name:          "class selector",
+			classSelector: &types.MsgBuyOrder_ClassSelector{ClassId: classID},
+			matched:       true,
+		},
+		{
+			name:          "class selector with country",
+			classSelector: &types.MsgBuyOrder_ClassSelector{ClassId: classID, ProjectLocation: "US"},
+			matched:       true,
+		},
+		{
+			name:          "class selector with region",
+			classSelector: &types.MsgBuyOrder_ClassSelector{ClassId: classID, ProjectLocation: "US-OR"},
+			matched:       true,
+		},
+		{
+			name:          "class selector with different region",
+			classSelector: &types.MsgBuyOrder_ClassSelector{ClassId: classID, ProjectLocation: "US-WA"},
+		},
+		{
+			name: "class selector within date range",
+			classSelector: &types.MsgBuyOrder_ClassSelector{
+				ClassId: classID, MinStartDate: &past, MaxEndDate: &future,
+			},
+			matched: true,
+		},
+		{
+			name:          "class selector with min start date after batch start date",
+			classSelector: &types.MsgBuyOrder_ClassSelector{ClassId: classID, MinStartDate: &future},
+		},
+		{
+			name:            "project selector",
+			projectSelector: &types.MsgBuyOrder_ProjectSelector{ProjectId: classID + "-001"},
+			matched:         true,
+		},
+		{
+			name:            "project selector with max end date before batch end date",
+			projectSelector: &types.MsgBuyOrder_ProjectSelector{ProjectId: classID + "-001", MaxEndDate: &past},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		newBuyOrder := func() *types.MsgBuyOrder_Order {
+			bidPrice := sdk.NewInt64Coin(ask.Denom, 10)
+			return &types.MsgBuyOrder_Order{
+				ClassSelector:          tc.classSelector,
+				ProjectSelector:        tc.projectSelector,
+				Quantity:               "5",
+				BidPrice:               &bidPrice,
+				RetirementJurisdiction: "US-WA",
+			}
+		}
+
+		assertFilled := func(s *processBuyOrdersSuite, buyOrderID, sellOrderID uint64) {
+			require.NoError(s.t, s.k.ProcessBuyOrders(s.ctx))
+			if tc.matched {
+				s.assertNoBuyOrder(buyOrderID)
+				s.assertNoSellOrder(sellOrderID)
+				s.assertBankBalance(s.seller.String(), 50)
+			} else {
+				s.assertBuyOrderQuantity(buyOrderID, "5")
+				s.assertSellOrderQuantity(sellOrderID, "5")
+			}
+		}
+
+		t.Run(tc.name+" (buy order after sell order)", func(t *testing.T) {
+			t.Parallel()
+			s := setupProcessBuyOrders(t)
+
+			sellOrderID := s.sell("5", 10, false)
+			buyOrderID := s.buyOrder(s.buyer, newBuyOrder())
+
+			buyOrder, err := s.marketStore.BuyOrderTable().Get(s.ctx, buyOrderID)
+			require.NoError(t, err)
+			require.Equal(t, !tc.matched, buyOrder.Maker)
+
+			assertFilled(s, buyOrderID, sellOrderID)
+		})
+
+		t.Run(tc.name+" (sell order after buy order)", func(t *testing.T) {
+			t.Parallel()
+			s := setupProcessBuyOrders(t)
+
+			buyOrderID := s.buyOrder(s.buyer, newBuyOrder())
+			sellOrderID := s.sell("5", 10, false)
+
+			assertFilled(s, buyOrderID, sellOrderID)
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// PruneBuyOrders is a BeginBlock function that returns escrowed funds to the buyers and deletes buy orders
+// that have expired. At most pruneLimit buy orders are pruned per block and any remaining expired buy orders
+// are pruned in the following blocks, starting from the buy order prune cursor.
+func (k Keeper) PruneBuyOrders(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	cursor, err := k.stateStore.BuyOrderPruneCursorTable().Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// buy orders cannot be created with an expiration that is not in the future,
+	// so all buy orders with an expiration before the cursor have already been pruned.
+	min, blockTime, ok := pruneRange(cursor.Expiration, sdkCtx.BlockTime())
+	if !ok {
+		return nil
+	}
+	fromKey, toKey := api.BuyOrderExpirationIndexKey{}.WithExpiration(min), api.BuyOrderExpirationIndexKey{}.WithExpiration(blockTime)
+
+	var it api.BuyOrderIterator
+	if min.AsTime().Equal(blockTime.AsTime()) {
+		// the start and end of a range cannot be equal, so we list the buy orders expiring at the block time
+		it, err = k.stateStore.BuyOrderTable().List(ctx, toKey)
+	} else {
+		it, err = k.stateStore.BuyOrderTable().ListRange(ctx, fromKey, toKey)
+	}
+	if err != nil {
+		return err
+	}
+
+	// collect the expired buy orders before deleting them so that the buy order
+	// table is not modified while iterating
+	var expired []*api.BuyOrder
+	for len(expired) < k.pruneLimit && it.Next() {
+		buyOrder, err := it.Value()
+		if err != nil {
+			it.Close()
+			return err
+		}
+		expired = append(expired, buyOrder)
+	}
+	it.Close()
+
+	for _, buyOrder := range expired {
+		if err = k.unescrowFunds(ctx, buyOrder); err != nil {
+			return err
+		}
+		if err = k.removeBuyOrder(ctx, buyOrder); err != nil {
+			return err
+		}
+	}
+
+	if len(expired) == 0 {
+		return nil
+	}
+
+	return k.stateStore.BuyOrderPruneCursorTable().Save(ctx, &api.BuyOrderPruneCursor{
+		Expiration: expired[len(expired)-1].Expiration,
+	})
+}
+
+// unescrowFunds sends the funds held in escrow for the remaining quantity of the buy order back to the buyer.
+func (k Keeper) unescrowFunds(ctx context.Context, buyOrder *api.BuyOrder) error {
+	market, err := k.stateStore.MarketTable().Get(ctx, buyOrder.MarketId)
+	if err != nil {
+		return err
+	}
+
+	quantity, err := math.NewDecFromString(buyOrder.Quantity)
+	if err != nil {
+		return err
+	}
+
+	bidAmount, ok := sdk.NewIntFromString(buyOrder.BidAmount)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", buyOrder.BidAmount, sdk.Int{})
+	}
+
+	escrowed, err := utils.GetTotalCost(bidAmount, quantity)
+	if err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(market.BankDenom, getEscrowAmount(escrowed, buyOrder.EscrowedFeeBps)))
+	if coins.IsZero() {
+		return nil
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(
+		sdk.UnwrapSDKContext(ctx), ecocredit.ModuleName, buyOrder.Buyer, coins,
+	)
+}
+
+// removeBuyOrder deletes the buy order from state and from the order book.
+func (k Keeper) removeBuyOrder(ctx context.Context, buyOrder *api.BuyOrder) error {
+	if err := k.stateStore.BuyOrderTable().Delete(ctx, buyOrder); err != nil {
+		return err
+	}
+
+	return k.orderBook.OnRemoveBuyOrder(ctx, buyOrder.Id)
+}
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	regentypes "github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+func TestBuy_Prune(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], classID, start, end, creditType)
+	balances := s.mockBankBalances()
+
+	buyer := s.addrs[1]
+	balances[buyer.String()] = sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 1000))
+
+	blockTime, err := regentypes.ParseDate("block time", "2020-01-01")
+	assert.NilError(t, err)
+	expired, err := regentypes.ParseDate("expiration", "2019-12-30")
+	assert.NilError(t, err)
+	notExpired, err := regentypes.ParseDate("expiration", "2022-01-01")
+	assert.NilError(t, err)
+
+	res, err := s.k.Buy(s.ctx, &types.MsgBuyOrder{
+		Buyer: buyer.String(),
+		Orders: []*types.MsgBuyOrder_Order{
+			{BatchDenom: batchDenom, Quantity: "10", BidPrice: &ask, Expiration: &expired},
+			{BatchDenom: batchDenom, Quantity: "10", BidPrice: &ask, Expiration: &notExpired},
+			{BatchDenom: batchDenom, Quantity: "10", BidPrice: &ask, Expiration: nil},
+		},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 3, len(res.BuyOrderIds))
+	assert.Equal(t, int64(700), balances[buyer.String()].AmountOf(ask.Denom).Int64())
+
+	// setup block time so the orders expire
+	s.sdkCtx = s.sdkCtx.WithBlockTime(blockTime)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+
+	// prune the orders
+	err = s.k.PruneBuyOrders(s.ctx)
+	assert.NilError(t, err)
+
+	// the escrowed funds of the expired buy order are returned to the buyer
+	assert.Equal(t, int64(800), balances[buyer.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(200), balances[ecocredit.ModuleName].AmountOf(ask.Denom).Int64())
+
+	_, err = s.marketStore.BuyOrderTable().Get(s.ctx, res.BuyOrderIds[0])
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	_, err = s.marketStore.BuyOrderTable().Get(s.ctx, res.BuyOrderIds[1])
+	assert.NilError(t, err)
+
+	_, err = s.marketStore.BuyOrderTable().Get(s.ctx, res.BuyOrderIds[2])
+	assert.NilError(t, err)
+}
+
+func TestBuy_PruneLimit(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], classID, start, end, creditType)
+	balances := s.mockBankBalances()
+	s.k.pruneLimit = 1
+
+	buyer := s.addrs[1]
+	balances[buyer.String()] = sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 1000))
+
+	expiration, err := regentypes.ParseDate("expiration", "2019-12-30")
+	assert.NilError(t, err)
+
+	res, err := s.k.Buy(s.ctx, &types.MsgBuyOrder{
+		Buyer: buyer.String(),
+		Orders: []*types.MsgBuyOrder_Order{
+			{BatchDenom: batchDenom, Quantity: "10", BidPrice: &ask, Expiration: &expiration},
+			{BatchDenom: batchDenom, Quantity: "10", BidPrice: &ask, Expiration: &expiration},
+		},
+	})
+	assert.NilError(t, err)
+
+	// only the first expired buy order is pruned
+	s.sdkCtx = s.sdkCtx.WithBlockTime(expiration)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+	assert.NilError(t, s.k.PruneBuyOrders(s.ctx))
+
+	_, err = s.marketStore.BuyOrderTable().Get(s.ctx, res.BuyOrderIds[0])
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+	_, err = s.marketStore.BuyOrderTable().Get(s.ctx, res.BuyOrderIds[1])
+	assert.NilError(t, err)
+
+	cursor, err := s.marketStore.BuyOrderPruneCursorTable().Get(s.ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, expiration, cursor.Expiration.AsTime())
+
+	// the remaining buy order is pruned in the next block, starting from the cursor
+	s.sdkCtx = s.sdkCtx.WithBlockTime(expiration.AddDate(0, 0, 1))
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+	assert.NilError(t, s.k.PruneBuyOrders(s.ctx))
+
+	_, err = s.marketStore.BuyOrderTable().Get(s.ctx, res.BuyOrderIds[1])
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	assert.Equal(t, int64(1000), balances[buyer.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(0), balances[ecocredit.ModuleName].AmountOf(ask.Denom).Int64())
+}
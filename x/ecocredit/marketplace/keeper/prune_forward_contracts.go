@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// PruneForwardContracts is a BeginBlock function that returns the escrowed payment for undelivered
+// credits to the buyers and deletes forward contracts that have reached their deadline. At most
+// pruneLimit forward contracts are pruned per block and any remaining forward contracts that have
+// reached their deadline are pruned in the following blocks, starting from the forward contract
+// prune cursor.
+func (k Keeper) PruneForwardContracts(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	cursor, err := k.stateStore.ForwardContractPruneCursorTable().Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// forward contracts cannot be created with a deadline that is not in the future,
+	// so all forward contracts with a deadline before the cursor have already been pruned.
+	min, blockTime, ok := pruneRange(cursor.Deadline, sdkCtx.BlockTime())
+	if !ok {
+		return nil
+	}
+	fromKey, toKey := api.ForwardContractDeadlineIndexKey{}.WithDeadline(min), api.ForwardContractDeadlineIndexKey{}.WithDeadline(blockTime)
+
+	var it api.ForwardContractIterator
+	if min.AsTime().Equal(blockTime.AsTime()) {
+		// the start and end of a range cannot be equal, so we list the forward contracts with a deadline at the block time
+		it, err = k.stateStore.ForwardContractTable().List(ctx, toKey)
+	} else {
+		it, err = k.stateStore.ForwardContractTable().ListRange(ctx, fromKey, toKey)
+	}
+	if err != nil {
+		return err
+	}
+
+	// collect the forward contracts before deleting them so that the forward
+	// contract table is not modified while iterating
+	var expired []*api.ForwardContract
+	for len(expired) < k.pruneLimit && it.Next() {
+		contract, err := it.Value()
+		if err != nil {
+			it.Close()
+			return err
+		}
+		expired = append(expired, contract)
+	}
+	it.Close()
+
+	for _, contract := range expired {
+		refund, err := k.getForwardContractEscrow(ctx, contract)
+		if err != nil {
+			return err
+		}
+		if refund.IsPositive() {
+			if err = k.bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, ecocredit.ModuleName, contract.Buyer, sdk.NewCoins(refund)); err != nil {
+				return err
+			}
+		}
+		if err = k.stateStore.ForwardContractTable().Delete(ctx, contract); err != nil {
+			return err
+		}
+	}
+
+	if len(expired) == 0 {
+		return nil
+	}
+
+	return k.stateStore.ForwardContractPruneCursorTable().Save(ctx, &api.ForwardContractPruneCursor{
+		Deadline: expired[len(expired)-1].Deadline,
+	})
+}
+
+// getForwardContractEscrow returns the payment held in escrow for the credits
+// of the forward contract that have not been delivered, i.e. the total cost of
+// the contract minus the total cost of the credits delivered.
+func (k Keeper) getForwardContractEscrow(ctx context.Context, contract *api.ForwardContract) (sdk.Coin, error) {
+	market, err := k.stateStore.MarketTable().Get(ctx, contract.MarketId)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	priceAmount, err := parseAmount(contract.PriceAmount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	quantity, err := math.NewDecFromString(contract.Quantity)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	delivered, err := math.NewDecFromString(contract.DeliveredQuantity)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	total, err := utils.GetTotalCost(priceAmount, quantity)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	paid, err := utils.GetTotalCost(priceAmount, delivered)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return sdk.NewCoin(market.BankDenom, total.Sub(paid)), nil
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+func TestPruneForwardContracts(t *testing.T) {
+	t.Parallel()
+	s, balances := setupForwardContract(t)
+	buyer := s.addrs[0]
+
+	id, err := s.createForwardContract(batchDenom)
+	assert.NilError(t, err)
+
+	// simulate the delivery of 2.5 credits and the release of their payment
+	contract, err := s.marketStore.ForwardContractTable().Get(s.ctx, id)
+	assert.NilError(t, err)
+	contract.DeliveredQuantity = "2.5"
+	assert.NilError(t, s.marketStore.ForwardContractTable().Update(s.ctx, contract))
+	payment := sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 25))
+	assert.NilError(t, sendCoins(balances, ecocredit.ModuleName, s.addrs[1].String(), payment))
+
+	// forward contracts are not pruned before the deadline
+	s.setBlockTime(forwardStart.Add(9 * time.Hour))
+	assert.NilError(t, s.k.PruneForwardContracts(s.ctx))
+	_, err = s.marketStore.ForwardContractTable().Get(s.ctx, id)
+	assert.NilError(t, err)
+
+	s.setBlockTime(forwardStart.Add(10 * time.Hour))
+	assert.NilError(t, s.k.PruneForwardContracts(s.ctx))
+	_, err = s.marketStore.ForwardContractTable().Get(s.ctx, id)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	// the payment for the undelivered credits is returned to the buyer
+	assert.Equal(t, int64(975), balances[buyer.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(25), balances[s.addrs[1].String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(0), balances[ecocredit.ModuleName].AmountOf(ask.Denom).Int64())
+}
+
+func TestPruneForwardContracts_Limit(t *testing.T) {
+	t.Parallel()
+	s, balances := setupForwardContract(t)
+	s.k.pruneLimit = 1
+
+	first, err := s.createForwardContract(batchDenom)
+	assert.NilError(t, err)
+	second, err := s.createForwardContract(batchDenom)
+	assert.NilError(t, err)
+
+	// only the first forward contract is pruned
+	deadline := forwardStart.Add(10 * time.Hour)
+	s.setBlockTime(deadline)
+	assert.NilError(t, s.k.PruneForwardContracts(s.ctx))
+	_, err = s.marketStore.ForwardContractTable().Get(s.ctx, first)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+	_, err = s.marketStore.ForwardContractTable().Get(s.ctx, second)
+	assert.NilError(t, err)
+
+	cursor, err := s.marketStore.ForwardContractPruneCursorTable().Get(s.ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, deadline, cursor.Deadline.AsTime())
+
+	// the remaining forward contract is pruned in the next block, starting from the cursor
+	s.setBlockTime(deadline.Add(time.Hour))
+	assert.NilError(t, s.k.PruneForwardContracts(s.ctx))
+	_, err = s.marketStore.ForwardContractTable().Get(s.ctx, second)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	assert.Equal(t, int64(1000), balances[s.addrs[0].String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(0), balances[ecocredit.ModuleName].AmountOf(ask.Denom).Int64())
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+)
+
+// PruneOffers is a BeginBlock function that returns escrowed funds to the buyers and deletes offers
+// that have expired. At most pruneLimit offers are pruned per block and any remaining expired offers
+// are pruned in the following blocks, starting from the offer prune cursor.
+func (k Keeper) PruneOffers(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	cursor, err := k.stateStore.OfferPruneCursorTable().Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// offers cannot be made with an expiration that is not in the future, so all
+	// offers with an expiration before the cursor have already been pruned.
+	min, blockTime, ok := pruneRange(cursor.Expiration, sdkCtx.BlockTime())
+	if !ok {
+		return nil
+	}
+	fromKey, toKey := api.OfferExpirationIndexKey{}.WithExpiration(min), api.OfferExpirationIndexKey{}.WithExpiration(blockTime)
+
+	var it api.OfferIterator
+	if min.AsTime().Equal(blockTime.AsTime()) {
+		// the start and end of a range cannot be equal, so we list the offers expiring at the block time
+		it, err = k.stateStore.OfferTable().List(ctx, toKey)
+	} else {
+		it, err = k.stateStore.OfferTable().ListRange(ctx, fromKey, toKey)
+	}
+	if err != nil {
+		return err
+	}
+
+	// collect the expired offers before deleting them so that the offer table
+	// is not modified while iterating
+	var expired []*api.Offer
+	for len(expired) < k.pruneLimit && it.Next() {
+		offer, err := it.Value()
+		if err != nil {
+			it.Close()
+			return err
+		}
+		expired = append(expired, offer)
+	}
+	it.Close()
+
+	for _, offer := range expired {
+		if err = k.unescrowOffer(ctx, offer); err != nil {
+			return err
+		}
+		if err = k.stateStore.OfferTable().Delete(ctx, offer); err != nil {
+			return err
+		}
+	}
+
+	if len(expired) == 0 {
+		return nil
+	}
+
+	return k.stateStore.OfferPruneCursorTable().Save(ctx, &api.OfferPruneCursor{
+		Expiration: expired[len(expired)-1].Expiration,
+	})
+}
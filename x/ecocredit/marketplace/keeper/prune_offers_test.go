@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+func TestPruneOffers(t *testing.T) {
+	t.Parallel()
+	s, balances, sellOrderID := setupOffer(t)
+	buyer := s.addrs[1]
+
+	id, err := s.makeOffer(sellOrderID, 80)
+	assert.NilError(t, err)
+
+	// offers are not pruned before the expiration
+	s.setBlockTime(offerStart.Add(9 * time.Hour))
+	assert.NilError(t, s.k.PruneOffers(s.ctx))
+	_, err = s.marketStore.OfferTable().Get(s.ctx, id)
+	assert.NilError(t, err)
+
+	s.setBlockTime(offerStart.Add(10 * time.Hour))
+	assert.NilError(t, s.k.PruneOffers(s.ctx))
+	_, err = s.marketStore.OfferTable().Get(s.ctx, id)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	// the escrowed funds are returned to the buyer
+	assert.Equal(t, int64(2000), balances[buyer.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(0), balances[ecocredit.ModuleName].AmountOf(ask.Denom).Int64())
+
+	// the sell order is not affected
+	sellOrder, err := s.marketStore.SellOrderTable().Get(s.ctx, sellOrderID)
+	assert.NilError(t, err)
+	assert.Equal(t, "10", sellOrder.Quantity)
+}
+
+func TestPruneOffers_Limit(t *testing.T) {
+	t.Parallel()
+	s, balances, sellOrderID := setupOffer(t)
+	s.k.pruneLimit = 1
+
+	first, err := s.makeOffer(sellOrderID, 80)
+	assert.NilError(t, err)
+	second, err := s.makeOffer(sellOrderID, 80)
+	assert.NilError(t, err)
+
+	// only the first expired offer is pruned
+	expiration := offerStart.Add(10 * time.Hour)
+	s.setBlockTime(expiration)
+	assert.NilError(t, s.k.PruneOffers(s.ctx))
+	_, err = s.marketStore.OfferTable().Get(s.ctx, first)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+	_, err = s.marketStore.OfferTable().Get(s.ctx, second)
+	assert.NilError(t, err)
+
+	cursor, err := s.marketStore.OfferPruneCursorTable().Get(s.ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, expiration, cursor.Expiration.AsTime())
+
+	// the remaining offer is pruned in the next block, starting from the cursor
+	s.setBlockTime(expiration.Add(time.Hour))
+	assert.NilError(t, s.k.PruneOffers(s.ctx))
+	_, err = s.marketStore.OfferTable().Get(s.ctx, second)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	assert.Equal(t, int64(2000), balances[s.addrs[1].String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(0), balances[ecocredit.ModuleName].AmountOf(ask.Denom).Int64())
+}
@@ -2,44 +2,90 @@ package keeper
 
 import (
 	"context"
-	"time"
-
-	"google.golang.org/protobuf/types/known/timestamppb"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
 	"github.com/regen-network/regen-ledger/types/math"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
 )
 
 // PruneSellOrders is a BeginBlock function that moves escrowed credits back into their tradable balance and deletes orders
-// that have expired.
+// that have expired. At most sellOrderPruneLimit orders are pruned per block and any remaining expired orders are pruned
+// in the following blocks, starting from the sell order prune cursor.
 func (k Keeper) PruneSellOrders(ctx context.Context) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	// we set the min to 1 ns because nil expirations are encoded as the 0 value timestamp,
-	// and we DO NOT want those to be deleted/unescrowed.
-	// https://github.com/cosmos/cosmos-sdk/issues/11980
-	min, blockTime := timestamppb.New(time.Unix(0, 1)), timestamppb.New(sdkCtx.BlockTime())
+	cursor, err := k.stateStore.SellOrderPruneCursorTable().Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// sell orders cannot be created or updated with an expiration that is not in the future,
+	// so all sell orders with an expiration before the cursor have already been pruned. The
+	// pruned sell orders are deleted, so resuming at the expiration of the cursor does not
+	// list the sell orders with the same expiration that have already been pruned.
+	min, blockTime, ok := pruneRange(cursor.Expiration, sdkCtx.BlockTime())
+	if !ok {
+		return nil
+	}
 	fromKey, toKey := api.SellOrderExpirationIndexKey{}.WithExpiration(min), api.SellOrderExpirationIndexKey{}.WithExpiration(blockTime)
 
-	it, err := k.stateStore.SellOrderTable().ListRange(ctx, fromKey, toKey)
+	var it api.SellOrderIterator
+	if min.AsTime().Equal(blockTime.AsTime()) {
+		// the start and end of a range cannot be equal, so we list the sell orders expiring at the block time
+		it, err = k.stateStore.SellOrderTable().List(ctx, toKey)
+	} else {
+		it, err = k.stateStore.SellOrderTable().ListRange(ctx, fromKey, toKey)
+	}
 	if err != nil {
 		return err
 	}
-	for it.Next() {
+
+	// collect the expired sell orders before deleting them so that the sell
+	// order table is not modified while iterating
+	var expired []*api.SellOrder
+	for len(expired) < k.sellOrderPruneLimit && it.Next() {
 		sellOrder, err := it.Value()
 		if err != nil {
+			it.Close()
 			return err
 		}
+		expired = append(expired, sellOrder)
+	}
+	it.Close()
+
+	for _, sellOrder := range expired {
 		if err = k.unescrowCredits(ctx, sellOrder.Seller, sellOrder.BatchKey, sellOrder.Quantity); err != nil {
 			return err
 		}
+		if err = k.orderBook.OnRemoveSellOrder(ctx, sellOrder.Id); err != nil {
+			return err
+		}
+		if err = k.deleteSellOrderAsks(ctx, sellOrder.Id); err != nil {
+			return err
+		}
+		if err = k.rejectSellOrderOffers(ctx, sellOrder.Id); err != nil {
+			return err
+		}
+		if err = k.stateStore.SellOrderTable().Delete(ctx, sellOrder); err != nil {
+			return err
+		}
+		if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventSellOrderExpired{
+			SellOrderId: sellOrder.Id,
+		}); err != nil {
+			return err
+		}
+	}
 
+	if len(expired) == 0 {
+		return nil
 	}
-	it.Close()
 
-	return k.stateStore.SellOrderTable().DeleteRange(ctx, fromKey, toKey)
+	last := expired[len(expired)-1]
+	return k.stateStore.SellOrderPruneCursorTable().Save(ctx, &api.SellOrderPruneCursor{
+		Expiration: last.Expiration,
+	})
 }
 
 // unescrowCredits moves `amount` of credits from the sellerAddr's escrowed balance, into their tradable balance.
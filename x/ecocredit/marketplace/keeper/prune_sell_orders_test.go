@@ -1,13 +1,20 @@
 package keeper
 
 import (
+	"strconv"
 	"testing"
+	"time"
 
+	"github.com/regen-network/gocuke"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gotest.tools/v3/assert"
 
+	"github.com/gogo/protobuf/proto"
+
 	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
 	regentypes "github.com/regen-network/regen-ledger/types"
 	"github.com/regen-network/regen-ledger/types/math"
 	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
@@ -100,3 +107,95 @@ func TestPrune_NilExpiration(t *testing.T) {
 	_, err = s.marketStore.SellOrderTable().Get(s.ctx, shouldNotExistOrder)
 	assert.ErrorIs(t, err, ormerrors.NotFound)
 }
+
+var midnight = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// setupExpiredSellOrders inserts n sell orders of 1 credit each from the first
+// test account that all expire at midnight and sets the block time to midnight.
+func setupExpiredSellOrders(t gocuke.TestingT, n int) *baseSuite {
+	s := setupBase(t, 1)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+
+	bal, err := s.baseStore.BatchBalanceTable().Get(s.ctx, s.addrs[0], 1)
+	assert.NilError(t, err)
+	bal.EscrowedAmount = strconv.Itoa(n)
+	assert.NilError(t, s.baseStore.BatchBalanceTable().Update(s.ctx, bal))
+
+	for i := 0; i < n; i++ {
+		assert.NilError(t, s.marketStore.SellOrderTable().Insert(s.ctx, &api.SellOrder{
+			Seller:     s.addrs[0],
+			BatchKey:   1,
+			Quantity:   "1",
+			MarketId:   1,
+			AskAmount:  "100",
+			Expiration: timestamppb.New(midnight),
+		}))
+	}
+
+	s.setBlockTime(midnight)
+
+	return s
+}
+
+func TestPrune_Limit(t *testing.T) {
+	t.Parallel()
+	s := setupExpiredSellOrders(t, 2500)
+	s.k.sellOrderPruneLimit = 1000
+
+	for _, remaining := range []int{1500, 500, 0} {
+		s.sdkCtx = s.sdkCtx.WithEventManager(sdk.NewEventManager())
+		s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+
+		assert.NilError(t, s.k.PruneSellOrders(s.ctx))
+
+		it, err := s.marketStore.SellOrderTable().List(s.ctx, api.SellOrderPrimaryKey{})
+		assert.NilError(t, err)
+		count := 0
+		for it.Next() {
+			count++
+		}
+		it.Close()
+		assert.Equal(t, remaining, count)
+
+		bal, err := s.baseStore.BatchBalanceTable().Get(s.ctx, s.addrs[0], 1)
+		assert.NilError(t, err)
+		assert.Equal(t, strconv.Itoa(remaining), bal.EscrowedAmount)
+		assert.Equal(t, strconv.Itoa(2600-remaining), bal.TradableAmount)
+	}
+
+	// the cursor points to the last sell order pruned
+	cursor, err := s.marketStore.SellOrderPruneCursorTable().Get(s.ctx)
+	assert.NilError(t, err)
+	assert.Check(t, cursor.Expiration.AsTime().Equal(midnight))
+
+	// an event is emitted for each sell order pruned
+	events := s.sdkCtx.EventManager().Events()
+	assert.Equal(t, 500, len(events))
+	assert.Equal(t, proto.MessageName(&types.EventSellOrderExpired{}), events[0].Type)
+
+	// sell orders created after pruning are pruned when they expire
+	expiration := midnight.Add(time.Hour)
+	res, err := s.k.Sell(s.ctx, &types.MsgSell{
+		Seller: s.addrs[0].String(),
+		Orders: []*types.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: "10", AskPrice: &ask, Expiration: &expiration},
+		},
+	})
+	assert.NilError(t, err)
+
+	s.setBlockTime(expiration)
+	assert.NilError(t, s.k.PruneSellOrders(s.ctx))
+
+	_, err = s.marketStore.SellOrderTable().Get(s.ctx, res.SellOrderIds[0])
+	assert.ErrorIs(t, err, ormerrors.NotFound)
+}
+
+func BenchmarkPruneSellOrders(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		s := setupExpiredSellOrders(b, DefaultSellOrderPruneLimit)
+		b.StartTimer()
+
+		assert.NilError(b, s.k.PruneSellOrders(s.ctx))
+	}
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// PruneSwaps is a BeginBlock function that returns escrowed credits and coins to the proposers and
+// deletes swaps that have expired. At most pruneLimit swaps are pruned per block and any remaining
+// expired swaps are pruned in the following blocks, starting from the swap prune cursor.
+func (k Keeper) PruneSwaps(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	cursor, err := k.stateStore.SwapPruneCursorTable().Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// swaps cannot be proposed with an expiration that is not in the future, so
+	// all swaps with an expiration before the cursor have already been pruned.
+	min, blockTime, ok := pruneRange(cursor.Expiration, sdkCtx.BlockTime())
+	if !ok {
+		return nil
+	}
+	fromKey, toKey := api.SwapExpirationIndexKey{}.WithExpiration(min), api.SwapExpirationIndexKey{}.WithExpiration(blockTime)
+
+	var it api.SwapIterator
+	if min.AsTime().Equal(blockTime.AsTime()) {
+		// the start and end of a range cannot be equal, so we list the swaps expiring at the block time
+		it, err = k.stateStore.SwapTable().List(ctx, toKey)
+	} else {
+		it, err = k.stateStore.SwapTable().ListRange(ctx, fromKey, toKey)
+	}
+	if err != nil {
+		return err
+	}
+
+	// collect the expired swaps before deleting them so that the swap table is
+	// not modified while iterating
+	var expired []*api.Swap
+	for len(expired) < k.pruneLimit && it.Next() {
+		swap, err := it.Value()
+		if err != nil {
+			it.Close()
+			return err
+		}
+		expired = append(expired, swap)
+	}
+	it.Close()
+
+	for _, swap := range expired {
+		if err = k.unescrowCredits(ctx, swap.Proposer, swap.OfferBatchKey, swap.OfferQuantity); err != nil {
+			return err
+		}
+		coins, err := getSwapCoins(swap)
+		if err != nil {
+			return err
+		}
+		if !coins.IsZero() {
+			if err = k.bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, ecocredit.ModuleName, swap.Proposer, coins); err != nil {
+				return err
+			}
+		}
+		if err = k.stateStore.SwapTable().Delete(ctx, swap); err != nil {
+			return err
+		}
+	}
+
+	if len(expired) == 0 {
+		return nil
+	}
+
+	return k.stateStore.SwapPruneCursorTable().Save(ctx, &api.SwapPruneCursor{
+		Expiration: expired[len(expired)-1].Expiration,
+	})
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+func TestPruneSwaps(t *testing.T) {
+	t.Parallel()
+	s, balances := setupSwap(t)
+	proposer := s.addrs[0]
+
+	id, err := s.proposeSwap(sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 100)))
+	assert.NilError(t, err)
+
+	// swaps are not pruned before the expiration
+	s.setBlockTime(swapStart.Add(9 * time.Hour))
+	assert.NilError(t, s.k.PruneSwaps(s.ctx))
+	_, err = s.marketStore.SwapTable().Get(s.ctx, id)
+	assert.NilError(t, err)
+
+	s.setBlockTime(swapStart.Add(10 * time.Hour))
+	assert.NilError(t, s.k.PruneSwaps(s.ctx))
+	_, err = s.marketStore.SwapTable().Get(s.ctx, id)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	// the escrowed credits and coins are returned to the proposer
+	bal, err := s.baseStore.BatchBalanceTable().Get(s.ctx, proposer, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "100", bal.TradableAmount)
+	assert.Equal(t, "0", bal.EscrowedAmount)
+	assert.Equal(t, int64(1000), balances[proposer.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(0), balances[ecocredit.ModuleName].AmountOf(ask.Denom).Int64())
+}
+
+func TestPruneSwaps_Limit(t *testing.T) {
+	t.Parallel()
+	s, _ := setupSwap(t)
+	s.k.pruneLimit = 1
+
+	first, err := s.proposeSwap(nil)
+	assert.NilError(t, err)
+	second, err := s.proposeSwap(nil)
+	assert.NilError(t, err)
+
+	// only the first expired swap is pruned
+	expiration := swapStart.Add(10 * time.Hour)
+	s.setBlockTime(expiration)
+	assert.NilError(t, s.k.PruneSwaps(s.ctx))
+	_, err = s.marketStore.SwapTable().Get(s.ctx, first)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+	_, err = s.marketStore.SwapTable().Get(s.ctx, second)
+	assert.NilError(t, err)
+
+	cursor, err := s.marketStore.SwapPruneCursorTable().Get(s.ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, expiration, cursor.Expiration.AsTime())
+
+	// the remaining swap is pruned in the next block, starting from the cursor
+	s.setBlockTime(expiration.Add(time.Hour))
+	assert.NilError(t, s.k.PruneSwaps(s.ctx))
+	_, err = s.marketStore.SwapTable().Get(s.ctx, second)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	bal, err := s.baseStore.BatchBalanceTable().Get(s.ctx, s.addrs[0], 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "100", bal.TradableAmount)
+	assert.Equal(t, "0", bal.EscrowedAmount)
+}
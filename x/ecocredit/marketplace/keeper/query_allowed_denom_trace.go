@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+// AllowedDenomTrace queries the IBC denom trace of an allowed denom.
+func (k Keeper) AllowedDenomTrace(ctx context.Context, req *types.QueryAllowedDenomTraceRequest) (*types.QueryAllowedDenomTraceResponse, error) {
+	allowedDenom, err := k.stateStore.AllowedDenomTable().Get(ctx, req.BankDenom)
+	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			return nil, sdkerrors.ErrNotFound.Wrapf("allowed denom %s", req.BankDenom)
+		}
+		return nil, err
+	}
+
+	if !isIBCDenom(allowedDenom.BankDenom) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("allowed denom %s is not an ibc denom", req.BankDenom)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// denoms allowed before denom traces were recorded do not have a path
+	path, baseDenom := allowedDenom.IbcPath, allowedDenom.IbcBaseDenom
+	if path == "" {
+		trace, err := k.getDenomTrace(sdkCtx, allowedDenom.BankDenom)
+		if err != nil {
+			return nil, err
+		}
+		path, baseDenom = trace.Path, trace.BaseDenom
+	}
+
+	chainID, err := k.getOriginChainID(sdkCtx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAllowedDenomTraceResponse{
+		BankDenom:     allowedDenom.BankDenom,
+		Path:          path,
+		BaseDenom:     baseDenom,
+		OriginChainId: chainID,
+	}, nil
+}
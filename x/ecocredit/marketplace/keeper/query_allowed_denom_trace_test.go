@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"gotest.tools/v3/assert"
+
+	ibctransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v5/modules/light-clients/07-tendermint/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+func TestQueryAllowedDenomTrace(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 0)
+
+	trace := ibctransfertypes.DenomTrace{Path: "transfer/channel-0", BaseDenom: "uatom"}
+	multiHopTrace := ibctransfertypes.DenomTrace{Path: "transfer/channel-1/transfer/channel-2", BaseDenom: "uatom"}
+	for _, allowedDenom := range []*api.AllowedDenom{
+		{BankDenom: "uregen", DisplayDenom: "regen", Exponent: 6},
+		{BankDenom: trace.IBCDenom(), DisplayDenom: "atom", Exponent: 6, IbcPath: trace.Path, IbcBaseDenom: trace.BaseDenom},
+		{BankDenom: multiHopTrace.IBCDenom(), DisplayDenom: "atom2", Exponent: 6},
+	} {
+		assert.NilError(t, s.marketStore.AllowedDenomTable().Insert(s.ctx, allowedDenom))
+	}
+
+	s.channelKeeper.EXPECT().GetChannelClientState(gomock.Any(), "transfer", "channel-0").
+		Return("07-tendermint-0", &ibctmtypes.ClientState{ChainId: "cosmoshub-4"}, nil)
+
+	res, err := s.k.AllowedDenomTrace(s.ctx, &types.QueryAllowedDenomTraceRequest{BankDenom: trace.IBCDenom()})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, &types.QueryAllowedDenomTraceResponse{
+		BankDenom:     trace.IBCDenom(),
+		Path:          "transfer/channel-0",
+		BaseDenom:     "uatom",
+		OriginChainId: "cosmoshub-4",
+	}, res)
+
+	// the denom trace of a denom without a recorded path is retrieved from the
+	// transfer keeper and the origin chain is not resolved for multiple hops
+	s.transferKeeper.EXPECT().GetDenomTrace(gomock.Any(), multiHopTrace.Hash()).Return(multiHopTrace, true)
+
+	res, err = s.k.AllowedDenomTrace(s.ctx, &types.QueryAllowedDenomTraceRequest{BankDenom: multiHopTrace.IBCDenom()})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, &types.QueryAllowedDenomTraceResponse{
+		BankDenom: multiHopTrace.IBCDenom(),
+		Path:      "transfer/channel-1/transfer/channel-2",
+		BaseDenom: "uatom",
+	}, res)
+
+	_, err = s.k.AllowedDenomTrace(s.ctx, &types.QueryAllowedDenomTraceRequest{BankDenom: "uregen"})
+	assert.ErrorContains(t, err, "allowed denom uregen is not an ibc denom")
+
+	_, err = s.k.AllowedDenomTrace(s.ctx, &types.QueryAllowedDenomTraceRequest{BankDenom: "uosmo"})
+	assert.ErrorContains(t, err, "allowed denom uosmo: not found")
+}
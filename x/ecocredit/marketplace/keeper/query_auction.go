@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+// Auction queries an auction by its unique identifier.
+func (k Keeper) Auction(ctx context.Context, req *types.QueryAuctionRequest) (*types.QueryAuctionResponse, error) {
+	auction, err := k.stateStore.AuctionTable().Get(ctx, req.AuctionId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get auction with id %d: %s", req.AuctionId, err.Error())
+	}
+
+	info, err := k.getAuctionInfo(ctx, auction)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAuctionResponse{Auction: info}, nil
+}
+
+// getAuctionInfo converts an auction to the human-readable auction information
+// including the current amount at the current block time.
+func (k Keeper) getAuctionInfo(ctx context.Context, auction *api.Auction) (*types.AuctionInfo, error) {
+	batch, err := k.baseStore.BatchTable().Get(ctx, auction.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+
+	market, err := k.stateStore.MarketTable().Get(ctx, auction.MarketId)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &types.AuctionInfo{
+		Id:                auction.Id,
+		Seller:            sdk.AccAddress(auction.Seller).String(),
+		BatchDenom:        batch.Denom,
+		Quantity:          auction.Quantity,
+		AuctionType:       types.AuctionType(auction.AuctionType),
+		BankDenom:         market.BankDenom,
+		StartAmount:       auction.StartAmount,
+		EndAmount:         auction.EndAmount,
+		StartTime:         regentypes.ProtobufToGogoTimestamp(auction.StartTime),
+		EndTime:           regentypes.ProtobufToGogoTimestamp(auction.EndTime),
+		DisableAutoRetire: auction.DisableAutoRetire,
+		HighestBidAmount:  auction.HighestBidAmount,
+	}
+
+	if len(auction.HighestBidder) != 0 {
+		info.HighestBidder = sdk.AccAddress(auction.HighestBidder).String()
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	if !blockTime.Before(auction.EndTime.AsTime()) {
+		return info, nil
+	}
+
+	switch auction.AuctionType {
+	case api.AuctionType_AUCTION_TYPE_ENGLISH:
+		if len(auction.HighestBidder) == 0 {
+			info.CurrentAmount = auction.StartAmount
+		}
+	case api.AuctionType_AUCTION_TYPE_DUTCH:
+		currentAmount, err := getDutchAuctionAmount(auction, blockTime)
+		if err != nil {
+			return nil, err
+		}
+		info.CurrentAmount = currentAmount.String()
+	}
+
+	return info, nil
+}
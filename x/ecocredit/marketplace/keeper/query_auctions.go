@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+// Auctions queries all auctions in state with optional pagination.
+func (k Keeper) Auctions(ctx context.Context, req *types.QueryAuctionsRequest) (*types.QueryAuctionsResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.AuctionTable().List(ctx, api.AuctionIdIndexKey{}, ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	auctions := make([]*types.AuctionInfo, 0)
+	for it.Next() {
+		auction, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := k.getAuctionInfo(ctx, auction)
+		if err != nil {
+			return nil, err
+		}
+
+		auctions = append(auctions, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAuctionsResponse{Auctions: auctions, Pagination: pr}, nil
+}
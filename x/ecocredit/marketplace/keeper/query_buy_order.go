@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+// BuyOrder queries a buy order by its unique identifier.
+func (k Keeper) BuyOrder(ctx context.Context, req *types.QueryBuyOrderRequest) (*types.QueryBuyOrderResponse, error) {
+	order, err := k.stateStore.BuyOrderTable().Get(ctx, req.BuyOrderId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get buy order with id %d: %s", req.BuyOrderId, err.Error())
+	}
+
+	info, err := k.getBuyOrderInfo(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBuyOrderResponse{BuyOrder: info}, nil
+}
+
+// getBuyOrderInfo converts a buy order to the human-readable buy order information.
+func (k Keeper) getBuyOrderInfo(ctx context.Context, order *api.BuyOrder) (*types.BuyOrderInfo, error) {
+	market, err := k.stateStore.MarketTable().Get(ctx, order.MarketId)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &types.BuyOrderInfo{
+		Id:                     order.Id,
+		Buyer:                  sdk.AccAddress(order.Buyer).String(),
+		Quantity:               order.Quantity,
+		BidDenom:               market.BankDenom,
+		BidAmount:              order.BidAmount,
+		DisableAutoRetire:      order.DisableAutoRetire,
+		RetirementJurisdiction: order.RetirementJurisdiction,
+		RetirementReason:       order.RetirementReason,
+		RetirementBeneficiary:  order.RetirementBeneficiary,
+		Expiration:             regentypes.ProtobufToGogoTimestamp(order.Expiration),
+		ProjectLocation:        order.ProjectLocation,
+		MinStartDate:           regentypes.ProtobufToGogoTimestamp(order.MinStartDate),
+		MaxEndDate:             regentypes.ProtobufToGogoTimestamp(order.MaxEndDate),
+	}
+
+	switch {
+	case order.ClassKey != 0:
+		class, err := k.baseStore.ClassTable().Get(ctx, order.ClassKey)
+		if err != nil {
+			return nil, err
+		}
+		info.ClassId = class.Id
+	case order.ProjectKey != 0:
+		project, err := k.baseStore.ProjectTable().Get(ctx, order.ProjectKey)
+		if err != nil {
+			return nil, err
+		}
+		info.ProjectId = project.Id
+	default:
+		batch, err := k.baseStore.BatchTable().Get(ctx, order.BatchKey)
+		if err != nil {
+			return nil, err
+		}
+		info.BatchDenom = batch.Denom
+	}
+
+	return info, nil
+}
@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+func TestQuery_BuyOrder(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 1)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], classID, start, end, creditType)
+
+	order := insertBuyOrder(t, s, s.addrs[0], 1)
+
+	res, err := s.k.BuyOrder(s.ctx, &types.QueryBuyOrderRequest{BuyOrderId: order.Id})
+	assert.NilError(t, err)
+	assertBuyOrderEqual(s.ctx, t, s.k, res.BuyOrder, order)
+
+	// invalid order id should fail
+	_, err = s.k.BuyOrder(s.ctx, &types.QueryBuyOrderRequest{BuyOrderId: 404})
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+}
+
+func TestQuery_BuyOrder_Selectors(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 1)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], classID, start, end, creditType)
+
+	classOrder := &api.BuyOrder{
+		Buyer:           s.addrs[0],
+		ClassKey:        1,
+		ProjectLocation: "US-OR",
+		MinStartDate:    start,
+		Quantity:        "10",
+		MarketId:        1,
+		BidAmount:       "10",
+	}
+	assert.NilError(t, s.marketStore.BuyOrderTable().Insert(s.ctx, classOrder))
+
+	res, err := s.k.BuyOrder(s.ctx, &types.QueryBuyOrderRequest{BuyOrderId: classOrder.Id})
+	assert.NilError(t, err)
+	assert.Equal(t, "", res.BuyOrder.BatchDenom)
+	assert.Equal(t, classID, res.BuyOrder.ClassId)
+	assert.Equal(t, "US-OR", res.BuyOrder.ProjectLocation)
+	assert.DeepEqual(t, regentypes.ProtobufToGogoTimestamp(start), res.BuyOrder.MinStartDate)
+	assert.Assert(t, res.BuyOrder.MaxEndDate == nil)
+
+	projectOrder := &api.BuyOrder{
+		Buyer:      s.addrs[0],
+		ProjectKey: 1,
+		MaxEndDate: end,
+		Quantity:   "10",
+		MarketId:   1,
+		BidAmount:  "10",
+	}
+	assert.NilError(t, s.marketStore.BuyOrderTable().Insert(s.ctx, projectOrder))
+
+	res, err = s.k.BuyOrder(s.ctx, &types.QueryBuyOrderRequest{BuyOrderId: projectOrder.Id})
+	assert.NilError(t, err)
+	assert.Equal(t, "", res.BuyOrder.BatchDenom)
+	assert.Equal(t, classID+"-001", res.BuyOrder.ProjectId)
+	assert.DeepEqual(t, regentypes.ProtobufToGogoTimestamp(end), res.BuyOrder.MaxEndDate)
+}
+
+func TestQuery_BuyOrders(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], classID, start, end, creditType)
+
+	order1 := insertBuyOrder(t, s, s.addrs[0], 1)
+	insertBuyOrder(t, s, s.addrs[1], 1)
+
+	res, err := s.k.BuyOrders(s.ctx, &types.QueryBuyOrdersRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.BuyOrders))
+	assertBuyOrderEqual(s.ctx, t, s.k, res.BuyOrders[0], order1)
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+}
+
+func TestQuery_BuyOrdersByBuyer(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 3)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], classID, start, end, creditType)
+
+	otherAddr := s.addrs[1]
+	noOrdersAddr := s.addrs[2]
+
+	insertBuyOrder(t, s, s.addrs[0], 1)
+	order2 := insertBuyOrder(t, s, otherAddr, 1)
+
+	res, err := s.k.BuyOrdersByBuyer(s.ctx, &types.QueryBuyOrdersByBuyerRequest{
+		Buyer:      otherAddr.String(),
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.BuyOrders))
+	assertBuyOrderEqual(s.ctx, t, s.k, res.BuyOrders[0], order2)
+	assert.Equal(t, uint64(1), res.Pagination.Total)
+
+	// addr with no buy orders should just return empty slice
+	res, err = s.k.BuyOrdersByBuyer(s.ctx, &types.QueryBuyOrdersByBuyerRequest{
+		Buyer:      noOrdersAddr.String(),
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(res.BuyOrders))
+	assert.Equal(t, uint64(0), res.Pagination.Total)
+
+	// bad address should fail
+	_, err = s.k.BuyOrdersByBuyer(s.ctx, &types.QueryBuyOrdersByBuyerRequest{
+		Buyer: "foobar1vlk23jrkl",
+	})
+	assert.ErrorContains(t, err, "decoding bech32 failed")
+}
+
+func insertBuyOrder(t *testing.T, s *baseSuite, addr sdk.AccAddress, batchKey uint64) *api.BuyOrder {
+	buyOrder := &api.BuyOrder{
+		Buyer:                  addr,
+		BatchKey:               batchKey,
+		Quantity:               "10",
+		MarketId:               1,
+		BidAmount:              "10",
+		RetirementJurisdiction: "US-WA",
+		Expiration:             timestamppb.Now(),
+		Maker:                  true,
+	}
+	assert.NilError(t, s.marketStore.BuyOrderTable().Insert(s.ctx, buyOrder))
+
+	return buyOrder
+}
+
+func assertBuyOrderEqual(ctx context.Context, t *testing.T, k Keeper, received *types.BuyOrderInfo, order *api.BuyOrder) {
+	batch, err := k.baseStore.BatchTable().Get(ctx, order.BatchKey)
+	assert.NilError(t, err)
+
+	market, err := k.stateStore.MarketTable().Get(ctx, order.MarketId)
+	assert.NilError(t, err)
+
+	info := types.BuyOrderInfo{
+		Id:                     order.Id,
+		Buyer:                  sdk.AccAddress(order.Buyer).String(),
+		BatchDenom:             batch.Denom,
+		Quantity:               order.Quantity,
+		BidDenom:               market.BankDenom,
+		BidAmount:              order.BidAmount,
+		DisableAutoRetire:      order.DisableAutoRetire,
+		RetirementJurisdiction: order.RetirementJurisdiction,
+		Expiration:             regentypes.ProtobufToGogoTimestamp(order.Expiration),
+	}
+
+	assert.DeepEqual(t, info, *received)
+}
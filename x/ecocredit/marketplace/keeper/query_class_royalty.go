@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+// ClassRoyalty queries the royalty of a credit class.
+func (k Keeper) ClassRoyalty(ctx context.Context, req *types.QueryClassRoyaltyRequest) (*types.QueryClassRoyaltyResponse, error) {
+	class, err := k.baseStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get class with id %s: %s", req.ClassId, err.Error())
+	}
+
+	royalty, err := k.stateStore.ClassRoyaltyTable().Get(ctx, class.Key)
+	if err != nil {
+		if ormerrors.NotFound.Is(err) {
+			return &types.QueryClassRoyaltyResponse{}, nil
+		}
+		return nil, err
+	}
+
+	return &types.QueryClassRoyaltyResponse{
+		RoyaltyBps: royalty.RoyaltyBps,
+		Recipient:  sdk.AccAddress(royalty.Recipient).String(),
+	}, nil
+}
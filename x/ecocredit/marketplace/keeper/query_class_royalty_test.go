@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+func TestQuery_ClassRoyalty(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 1)
+
+	classKey, err := s.baseStore.ClassTable().InsertReturningID(s.ctx, &baseapi.Class{
+		Id:               "C01",
+		Admin:            s.addrs[0],
+		CreditTypeAbbrev: "C",
+	})
+	require.NoError(t, err)
+
+	// query royalty of a credit class without a royalty
+	res, err := s.k.ClassRoyalty(s.ctx, &types.QueryClassRoyaltyRequest{ClassId: "C01"})
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), res.RoyaltyBps)
+	require.Equal(t, "", res.Recipient)
+
+	err = s.marketStore.ClassRoyaltyTable().Insert(s.ctx, &api.ClassRoyalty{
+		ClassKey:   classKey,
+		RoyaltyBps: 500,
+		Recipient:  s.addrs[0],
+	})
+	require.NoError(t, err)
+
+	// query royalty of a credit class
+	res, err = s.k.ClassRoyalty(s.ctx, &types.QueryClassRoyaltyRequest{ClassId: "C01"})
+	require.NoError(t, err)
+	require.Equal(t, uint32(500), res.RoyaltyBps)
+	require.Equal(t, sdk.AccAddress(s.addrs[0]).String(), res.Recipient)
+
+	// query royalty of a credit class that does not exist
+	_, err = s.k.ClassRoyalty(s.ctx, &types.QueryClassRoyaltyRequest{ClassId: "C02"})
+	require.ErrorContains(t, err, "could not get class with id C02: not found")
+}
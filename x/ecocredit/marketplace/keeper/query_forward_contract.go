@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+// ForwardContract queries a forward contract by its unique identifier.
+func (k Keeper) ForwardContract(ctx context.Context, req *types.QueryForwardContractRequest) (*types.QueryForwardContractResponse, error) {
+	contract, err := k.stateStore.ForwardContractTable().Get(ctx, req.ForwardContractId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get forward contract with id %d: %s", req.ForwardContractId, err.Error())
+	}
+
+	info, err := k.getForwardContractInfo(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryForwardContractResponse{ForwardContract: info}, nil
+}
+
+// getForwardContractInfo converts a forward contract to the human-readable
+// forward contract information.
+func (k Keeper) getForwardContractInfo(ctx context.Context, contract *api.ForwardContract) (*types.ForwardContractInfo, error) {
+	project, err := k.baseStore.ProjectTable().Get(ctx, contract.ProjectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var batchDenom string
+	if contract.BatchKey != 0 {
+		batch, err := k.baseStore.BatchTable().Get(ctx, contract.BatchKey)
+		if err != nil {
+			return nil, err
+		}
+		batchDenom = batch.Denom
+	}
+
+	market, err := k.stateStore.MarketTable().Get(ctx, contract.MarketId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ForwardContractInfo{
+		Id:                contract.Id,
+		Buyer:             sdk.AccAddress(contract.Buyer).String(),
+		Issuer:            sdk.AccAddress(contract.Issuer).String(),
+		ProjectId:         project.Id,
+		BatchDenom:        batchDenom,
+		Quantity:          contract.Quantity,
+		DeliveredQuantity: contract.DeliveredQuantity,
+		BankDenom:         market.BankDenom,
+		PriceAmount:       contract.PriceAmount,
+		Deadline:          regentypes.ProtobufToGogoTimestamp(contract.Deadline),
+		AcceptRetired:     contract.AcceptRetired,
+	}, nil
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+// ForwardContractsByProject queries all forward contracts for credits from the given project with
+// optional pagination.
+func (k Keeper) ForwardContractsByProject(ctx context.Context, req *types.QueryForwardContractsByProjectRequest) (*types.QueryForwardContractsByProjectResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := k.baseStore.ProjectTable().GetById(ctx, req.ProjectId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get project with id %s: %s", req.ProjectId, err.Error())
+	}
+
+	it, err := k.stateStore.ForwardContractTable().List(
+		ctx, api.ForwardContractProjectKeyIndexKey{}.WithProjectKey(project.Key), ormlist.Paginate(pg),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	contracts := make([]*types.ForwardContractInfo, 0)
+	for it.Next() {
+		contract, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := k.getForwardContractInfo(ctx, contract)
+		if err != nil {
+			return nil, err
+		}
+
+		contracts = append(contracts, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryForwardContractsByProjectResponse{ForwardContracts: contracts, Pagination: pr}, nil
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// LastPrice queries the price of the most recent trade of credits from a credit batch in a bank denom.
+func (k Keeper) LastPrice(ctx context.Context, req *types.QueryLastPriceRequest) (*types.QueryLastPriceResponse, error) {
+	batch, market, err := k.getBatchMarket(ctx, req.BatchDenom, req.BankDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.TradeTable().List(
+		ctx,
+		api.TradeBatchKeyMarketIdTimestampIndexKey{}.WithBatchKeyMarketId(batch.Key, market.Id),
+		ormlist.Reverse(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	if !it.Next() {
+		return nil, sdkerrors.ErrNotFound.Wrapf("no trades of %s in %s", req.BatchDenom, req.BankDenom)
+	}
+
+	trade, err := it.Value()
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryLastPriceResponse{
+		Price:     trade.Price,
+		Quantity:  trade.Quantity,
+		Timestamp: regentypes.ProtobufToGogoTimestamp(trade.Timestamp),
+	}, nil
+}
+
+// getBatchMarket gets the credit batch with the batch denom and the market of
+// the credit type of the credit batch and the bank denom.
+func (k Keeper) getBatchMarket(ctx context.Context, batchDenom, bankDenom string) (*baseapi.Batch, *api.Market, error) {
+	batch, err := k.baseStore.BatchTable().GetByDenom(ctx, batchDenom)
+	if err != nil {
+		return nil, nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", batchDenom, err.Error())
+	}
+
+	creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.baseStore, batchDenom)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	market, err := k.stateStore.MarketTable().GetByCreditTypeAbbrevBankDenom(ctx, creditType.Abbreviation, bankDenom)
+	if err != nil {
+		return nil, nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"could not get market with credit type %s and bank denom %s: %s", creditType.Abbreviation, bankDenom, err.Error(),
+		)
+	}
+
+	return batch, market, nil
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+func TestQuery_LastPrice(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+
+	// query last price with no trades
+	_, err := s.k.LastPrice(s.ctx, &types.QueryLastPriceRequest{BatchDenom: batchDenom, BankDenom: ask.Denom})
+	require.ErrorContains(t, err, "no trades of "+batchDenom+" in "+ask.Denom)
+
+	ts := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.insertTrade(1, "10", "100", ts.Add(time.Hour))
+	s.insertTrade(1, "5", "110", ts)
+
+	// query last price returns the most recent trade
+	res, err := s.k.LastPrice(s.ctx, &types.QueryLastPriceRequest{BatchDenom: batchDenom, BankDenom: ask.Denom})
+	require.NoError(t, err)
+	require.Equal(t, "100", res.Price)
+	require.Equal(t, "10", res.Quantity)
+	require.Equal(t, ts.Add(time.Hour).Unix(), res.Timestamp.Seconds)
+
+	// query last price with unknown market
+	_, err = s.k.LastPrice(s.ctx, &types.QueryLastPriceRequest{BatchDenom: batchDenom, BankDenom: "foo"})
+	require.ErrorContains(t, err, "could not get market with credit type C and bank denom foo")
+}
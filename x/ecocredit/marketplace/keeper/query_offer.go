@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+// Offer queries an offer by its unique identifier.
+func (k Keeper) Offer(ctx context.Context, req *types.QueryOfferRequest) (*types.QueryOfferResponse, error) {
+	offer, err := k.stateStore.OfferTable().Get(ctx, req.OfferId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get offer with id %d: %s", req.OfferId, err.Error())
+	}
+
+	info, err := k.getOfferInfo(ctx, offer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryOfferResponse{Offer: info}, nil
+}
+
+// getOfferInfo converts an offer to the human-readable offer information.
+func (k Keeper) getOfferInfo(ctx context.Context, offer *api.Offer) (*types.OfferInfo, error) {
+	market, err := k.stateStore.MarketTable().Get(ctx, offer.MarketId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.OfferInfo{
+		Id:                     offer.Id,
+		SellOrderId:            offer.SellOrderId,
+		Buyer:                  sdk.AccAddress(offer.Buyer).String(),
+		Quantity:               offer.Quantity,
+		BankDenom:              market.BankDenom,
+		BidAmount:              offer.BidAmount,
+		CounterAmount:          offer.CounterAmount,
+		DisableAutoRetire:      offer.DisableAutoRetire,
+		RetirementJurisdiction: offer.RetirementJurisdiction,
+		RetirementReason:       offer.RetirementReason,
+		RetirementBeneficiary:  offer.RetirementBeneficiary,
+		Expiration:             regentypes.ProtobufToGogoTimestamp(offer.Expiration),
+	}, nil
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+// OffersBySellOrder queries all offers made on the given sell order with optional pagination.
+func (k Keeper) OffersBySellOrder(ctx context.Context, req *types.QueryOffersBySellOrderRequest) (*types.QueryOffersBySellOrderResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.OfferTable().List(ctx, api.OfferSellOrderIdIndexKey{}.WithSellOrderId(req.SellOrderId), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	offers := make([]*types.OfferInfo, 0)
+	for it.Next() {
+		offer, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := k.getOfferInfo(ctx, offer)
+		if err != nil {
+			return nil, err
+		}
+
+		offers = append(offers, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryOffersBySellOrderResponse{Offers: offers, Pagination: pr}, nil
+}
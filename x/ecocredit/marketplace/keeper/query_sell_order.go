@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
 	regentypes "github.com/regen-network/regen-ledger/types"
 	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
 )
@@ -28,16 +29,38 @@ func (k Keeper) SellOrder(ctx context.Context, req *types.QuerySellOrderRequest)
 		return nil, err
 	}
 
+	additionalAskPrices, err := k.getAdditionalAskPrices(ctx, order.Id)
+	if err != nil {
+		return nil, err
+	}
+
 	info := types.SellOrderInfo{
-		Id:                order.Id,
-		Seller:            seller.String(),
-		BatchDenom:        batch.Denom,
-		Quantity:          order.Quantity,
-		AskDenom:          market.BankDenom,
-		AskAmount:         order.AskAmount,
-		DisableAutoRetire: order.DisableAutoRetire,
-		Expiration:        regentypes.ProtobufToGogoTimestamp(order.Expiration),
+		Id:                  order.Id,
+		Seller:              seller.String(),
+		BatchDenom:          batch.Denom,
+		Quantity:            order.Quantity,
+		AskDenom:            market.BankDenom,
+		AskAmount:           order.AskAmount,
+		DisableAutoRetire:   order.DisableAutoRetire,
+		Expiration:          regentypes.ProtobufToGogoTimestamp(order.Expiration),
+		AllowedBuyers:       allowedBuyers(order),
+		AdditionalAskPrices: additionalAskPrices,
 	}
 
 	return &types.QuerySellOrderResponse{SellOrder: &info}, nil
 }
+
+// allowedBuyers returns the bech32 addresses of the allowed buyers of the sell
+// order or nil if any account can buy credits from the sell order.
+func allowedBuyers(order *api.SellOrder) []string {
+	if len(order.AllowedBuyers) == 0 {
+		return nil
+	}
+
+	buyers := make([]string, len(order.AllowedBuyers))
+	for i, buyer := range order.AllowedBuyers {
+		buyers[i] = sdk.AccAddress(buyer).String()
+	}
+
+	return buyers
+}
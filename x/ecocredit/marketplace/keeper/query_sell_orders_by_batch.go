@@ -45,15 +45,22 @@ func (k Keeper) SellOrdersByBatch(ctx context.Context, req *types.QuerySellOrder
 			return nil, err
 		}
 
+		additionalAskPrices, err := k.getAdditionalAskPrices(ctx, order.Id)
+		if err != nil {
+			return nil, err
+		}
+
 		info := types.SellOrderInfo{
-			Id:                order.Id,
-			Seller:            seller.String(),
-			BatchDenom:        batch.Denom,
-			Quantity:          order.Quantity,
-			AskDenom:          market.BankDenom,
-			AskAmount:         order.AskAmount,
-			DisableAutoRetire: order.DisableAutoRetire,
-			Expiration:        regentypes.ProtobufToGogoTimestamp(order.Expiration),
+			Id:                  order.Id,
+			Seller:              seller.String(),
+			BatchDenom:          batch.Denom,
+			Quantity:            order.Quantity,
+			AskDenom:            market.BankDenom,
+			AskAmount:           order.AskAmount,
+			DisableAutoRetire:   order.DisableAutoRetire,
+			Expiration:          regentypes.ProtobufToGogoTimestamp(order.Expiration),
+			AllowedBuyers:       allowedBuyers(order),
+			AdditionalAskPrices: additionalAskPrices,
 		}
 
 		orders = append(orders, &info)
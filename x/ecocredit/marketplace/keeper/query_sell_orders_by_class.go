@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+// SellOrdersByClass queries all sell orders for credits from a credit class with optional filtering, sorting and
+// pagination.
+func (k Keeper) SellOrdersByClass(ctx context.Context, req *types.QuerySellOrdersByClassRequest) (*types.QuerySellOrdersByClassResponse, error) {
+	class, err := k.baseStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get class with id %s: %s", req.ClassId, err.Error())
+	}
+
+	filter, err := k.parseSellOrderFilter(ctx, req.Filter, class.CreditTypeAbbrev, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// we put a "-" after the class id to avoid including credit batches of other credit classes
+	orders, pageRes, err := k.listSellOrders(ctx, filter, k.batchDenomPrefixMatcher(ctx, class.Id+"-"), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	infos, err := k.getSellOrderInfos(ctx, orders)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySellOrdersByClassResponse{SellOrders: infos, Pagination: pageRes}, nil
+}
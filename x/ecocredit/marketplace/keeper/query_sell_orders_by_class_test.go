@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+// setupSellOrderQueries sets up the following sell orders:
+//
+//	id  project  market  ask amount
+//	1   C01-001  1       30
+//	2   C01-001  1       10 (expired)
+//	3   C01-002  1       20
+//	4   C01-002  2       5
+//	5   C02-001  1       15
+func setupSellOrderQueries(t *testing.T) *baseSuite {
+	s := setupBase(t, 1)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], classID, start, end, creditType)
+
+	require.NoError(t, s.baseStore.ClassTable().Insert(s.ctx, &baseapi.Class{
+		Id:               "C02",
+		Admin:            s.addrs[0],
+		CreditTypeAbbrev: creditType.Abbreviation,
+	}))
+	for _, project := range []*baseapi.Project{
+		{Id: "C01-002", Admin: s.addrs[0], ClassKey: 1, Jurisdiction: "US-OR"},
+		{Id: "C02-001", Admin: s.addrs[0], ClassKey: 2, Jurisdiction: "US-OR"},
+	} {
+		require.NoError(t, s.baseStore.ProjectTable().Insert(s.ctx, project))
+	}
+	for _, batch := range []*baseapi.Batch{
+		{ProjectKey: 2, Denom: "C01-002-20200101-20200201-001", StartDate: start, EndDate: end},
+		{ProjectKey: 3, Denom: "C02-001-20200101-20200201-001", StartDate: start, EndDate: end},
+	} {
+		require.NoError(t, s.baseStore.BatchTable().Insert(s.ctx, batch))
+	}
+	require.NoError(t, s.marketStore.MarketTable().Insert(s.ctx, &api.Market{
+		CreditTypeAbbrev: creditType.Abbreviation,
+		BankDenom:        "uusdc",
+	}))
+
+	blockTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.setBlockTime(blockTime)
+
+	for _, order := range []*api.SellOrder{
+		{BatchKey: 1, MarketId: 1, AskAmount: "30"},
+		{BatchKey: 1, MarketId: 1, AskAmount: "10", Expiration: timestamppb.New(blockTime.Add(-time.Hour))},
+		{BatchKey: 2, MarketId: 1, AskAmount: "20"},
+		{BatchKey: 2, MarketId: 2, AskAmount: "5"},
+		{BatchKey: 3, MarketId: 1, AskAmount: "15", Expiration: timestamppb.New(blockTime.Add(time.Hour))},
+	} {
+		askAmount, ok := sdkmath.NewIntFromString(order.AskAmount)
+		require.True(t, ok)
+		order.Seller = s.addrs[0]
+		order.Quantity = "10"
+		order.SortableAskAmount = types.SortableAskAmount(askAmount)
+		require.NoError(t, s.marketStore.SellOrderTable().Insert(s.ctx, order))
+	}
+
+	return s
+}
+
+func sellOrderIDs(orders []*types.SellOrderInfo) []uint64 {
+	ids := make([]uint64, 0, len(orders))
+	for _, order := range orders {
+		ids = append(ids, order.Id)
+	}
+	return ids
+}
+
+func TestQuery_SellOrdersByClass(t *testing.T) {
+	t.Parallel()
+	s := setupSellOrderQueries(t)
+
+	sellOrdersByClass := func(filter *types.SellOrderFilter, pagination *query.PageRequest) []uint64 {
+		res, err := s.k.SellOrdersByClass(s.ctx, &types.QuerySellOrdersByClassRequest{
+			ClassId:    classID,
+			Filter:     filter,
+			Pagination: pagination,
+		})
+		require.NoError(t, err)
+		return sellOrderIDs(res.SellOrders)
+	}
+
+	require.Equal(t, []uint64{1, 2, 3, 4}, sellOrdersByClass(nil, nil))
+
+	// sell orders are grouped by market when sorted by ask price
+	require.Equal(t, []uint64{2, 3, 1, 4}, sellOrdersByClass(&types.SellOrderFilter{
+		Sort: types.SellOrderSort_SELL_ORDER_SORT_ASK_PRICE_ASC,
+	}, nil))
+
+	require.Equal(t, []uint64{2, 3, 1}, sellOrdersByClass(&types.SellOrderFilter{
+		AskDenom: ask.Denom,
+		Sort:     types.SellOrderSort_SELL_ORDER_SORT_ASK_PRICE_ASC,
+	}, nil))
+
+	require.Equal(t, []uint64{3, 1}, sellOrdersByClass(&types.SellOrderFilter{
+		AskDenom:       ask.Denom,
+		Sort:           types.SellOrderSort_SELL_ORDER_SORT_ASK_PRICE_ASC,
+		ExcludeExpired: true,
+	}, nil))
+
+	require.Equal(t, []uint64{3}, sellOrdersByClass(&types.SellOrderFilter{
+		AskDenom:     ask.Denom,
+		MinAskAmount: "15",
+		MaxAskAmount: "25",
+	}, nil))
+
+	// an ask denom without a market matches no sell orders
+	require.Equal(t, []uint64{}, sellOrdersByClass(&types.SellOrderFilter{AskDenom: "uother"}, nil))
+
+	// pagination is applied after filtering and sorting
+	res, err := s.k.SellOrdersByClass(s.ctx, &types.QuerySellOrdersByClassRequest{
+		ClassId: classID,
+		Filter: &types.SellOrderFilter{
+			AskDenom: ask.Denom,
+			Sort:     types.SellOrderSort_SELL_ORDER_SORT_ASK_PRICE_DESC,
+		},
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3}, sellOrderIDs(res.SellOrders))
+	require.Equal(t, uint64(3), res.Pagination.Total)
+	require.Equal(t, "C01-002-20200101-20200201-001", res.SellOrders[0].BatchDenom)
+	require.Equal(t, ask.Denom, res.SellOrders[0].AskDenom)
+
+	// an offset beyond the number of sell orders returns no sell orders
+	require.Equal(t, []uint64{}, sellOrdersByClass(nil, &query.PageRequest{Offset: 10}))
+
+	_, err = s.k.SellOrdersByClass(s.ctx, &types.QuerySellOrdersByClassRequest{
+		ClassId: classID,
+		Filter:  &types.SellOrderFilter{MinAskAmount: "15"},
+	})
+	require.ErrorContains(t, err, "ask denom is required when filtering by ask amount")
+
+	// key based pagination continues from the next key of the previous page
+	filter := &types.SellOrderFilter{AskDenom: ask.Denom, Sort: types.SellOrderSort_SELL_ORDER_SORT_ASK_PRICE_ASC}
+	res, err = s.k.SellOrdersByClass(s.ctx, &types.QuerySellOrdersByClassRequest{
+		ClassId:    classID,
+		Filter:     filter,
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 3}, sellOrderIDs(res.SellOrders))
+	require.NotEmpty(t, res.Pagination.NextKey)
+	require.Equal(t, []uint64{1}, sellOrdersByClass(filter, &query.PageRequest{Key: res.Pagination.NextKey}))
+
+	_, err = s.k.SellOrdersByClass(s.ctx, &types.QuerySellOrdersByClassRequest{ClassId: "C03"})
+	require.ErrorContains(t, err, "could not get class with id C03: not found")
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+// SellOrdersByMarket queries all sell orders in a market with optional filtering, sorting and pagination.
+func (k Keeper) SellOrdersByMarket(ctx context.Context, req *types.QuerySellOrdersByMarketRequest) (*types.QuerySellOrdersByMarketResponse, error) {
+	market, err := k.stateStore.MarketTable().Get(ctx, req.MarketId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get market with id %d: %s", req.MarketId, err.Error())
+	}
+
+	filter, err := k.parseSellOrderFilter(ctx, req.Filter, market.CreditTypeAbbrev, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// an ask denom of another market matches no sell orders
+	if filter.marketID != 0 && filter.marketID != market.Id {
+		filter.noMarket = true
+	}
+	filter.marketID = market.Id
+
+	orders, pageRes, err := k.listSellOrders(ctx, filter, nil, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	infos, err := k.getSellOrderInfos(ctx, orders)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySellOrdersByMarketResponse{SellOrders: infos, Pagination: pageRes}, nil
+}
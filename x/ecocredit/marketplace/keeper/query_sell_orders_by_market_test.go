@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+func TestQuery_SellOrdersByMarket(t *testing.T) {
+	t.Parallel()
+	s := setupSellOrderQueries(t)
+
+	sellOrdersByMarket := func(marketID uint64, filter *types.SellOrderFilter) []uint64 {
+		res, err := s.k.SellOrdersByMarket(s.ctx, &types.QuerySellOrdersByMarketRequest{
+			MarketId: marketID,
+			Filter:   filter,
+		})
+		require.NoError(t, err)
+		return sellOrderIDs(res.SellOrders)
+	}
+
+	require.Equal(t, []uint64{1, 2, 3, 5}, sellOrdersByMarket(1, nil))
+	require.Equal(t, []uint64{4}, sellOrdersByMarket(2, nil))
+
+	require.Equal(t, []uint64{2, 5, 3, 1}, sellOrdersByMarket(1, &types.SellOrderFilter{
+		Sort: types.SellOrderSort_SELL_ORDER_SORT_ASK_PRICE_ASC,
+	}))
+
+	require.Equal(t, []uint64{1, 3, 5}, sellOrdersByMarket(1, &types.SellOrderFilter{
+		Sort:           types.SellOrderSort_SELL_ORDER_SORT_ASK_PRICE_DESC,
+		ExcludeExpired: true,
+	}))
+
+	// the ask denom is not required to filter by ask amount
+	require.Equal(t, []uint64{5, 3}, sellOrdersByMarket(1, &types.SellOrderFilter{
+		MinAskAmount: "15",
+		MaxAskAmount: "25",
+		Sort:         types.SellOrderSort_SELL_ORDER_SORT_ASK_PRICE_ASC,
+	}))
+
+	// the ask amount range is inclusive and may be open on either side
+	require.Equal(t, []uint64{3, 1}, sellOrdersByMarket(1, &types.SellOrderFilter{MinAskAmount: "20"}))
+	require.Equal(t, []uint64{2, 5}, sellOrdersByMarket(1, &types.SellOrderFilter{MaxAskAmount: "15"}))
+	require.Equal(t, []uint64{3}, sellOrdersByMarket(1, &types.SellOrderFilter{MinAskAmount: "20", MaxAskAmount: "20"}))
+
+	// an ask denom of another market matches no sell orders
+	require.Equal(t, []uint64{}, sellOrdersByMarket(1, &types.SellOrderFilter{AskDenom: "uusdc"}))
+
+	_, err := s.k.SellOrdersByMarket(s.ctx, &types.QuerySellOrdersByMarketRequest{
+		MarketId: 1,
+		Filter:   &types.SellOrderFilter{MinAskAmount: "25", MaxAskAmount: "15"},
+	})
+	require.ErrorContains(t, err, "min ask amount 25 cannot be greater than max ask amount 15")
+
+	_, err = s.k.SellOrdersByMarket(s.ctx, &types.QuerySellOrdersByMarketRequest{
+		MarketId: 1,
+		Filter:   &types.SellOrderFilter{MinAskAmount: "-1"},
+	})
+	require.ErrorContains(t, err, "min ask amount: -1 is not a non-negative integer")
+
+	_, err = s.k.SellOrdersByMarket(s.ctx, &types.QuerySellOrdersByMarketRequest{MarketId: 3})
+	require.ErrorContains(t, err, "could not get market with id 3: not found")
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+// SellOrdersByProject queries all sell orders for credits from a project with optional filtering, sorting and
+// pagination.
+func (k Keeper) SellOrdersByProject(ctx context.Context, req *types.QuerySellOrdersByProjectRequest) (*types.QuerySellOrdersByProjectResponse, error) {
+	project, err := k.baseStore.ProjectTable().GetById(ctx, req.ProjectId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get project with id %s: %s", req.ProjectId, err.Error())
+	}
+
+	class, err := k.baseStore.ClassTable().Get(ctx, project.ClassKey)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := k.parseSellOrderFilter(ctx, req.Filter, class.CreditTypeAbbrev, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// we put a "-" after the project id to avoid including credit batches of other projects
+	orders, pageRes, err := k.listSellOrders(ctx, filter, k.batchDenomPrefixMatcher(ctx, project.Id+"-"), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	infos, err := k.getSellOrderInfos(ctx, orders)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySellOrdersByProjectResponse{SellOrders: infos, Pagination: pageRes}, nil
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+func TestQuery_SellOrdersByProject(t *testing.T) {
+	t.Parallel()
+	s := setupSellOrderQueries(t)
+
+	sellOrdersByProject := func(projectID string, filter *types.SellOrderFilter) []uint64 {
+		res, err := s.k.SellOrdersByProject(s.ctx, &types.QuerySellOrdersByProjectRequest{
+			ProjectId: projectID,
+			Filter:    filter,
+		})
+		require.NoError(t, err)
+		return sellOrderIDs(res.SellOrders)
+	}
+
+	require.Equal(t, []uint64{1, 2}, sellOrdersByProject("C01-001", nil))
+	require.Equal(t, []uint64{3, 4}, sellOrdersByProject("C01-002", nil))
+	require.Equal(t, []uint64{5}, sellOrdersByProject("C02-001", nil))
+
+	require.Equal(t, []uint64{1}, sellOrdersByProject("C01-001", &types.SellOrderFilter{ExcludeExpired: true}))
+
+	require.Equal(t, []uint64{4}, sellOrdersByProject("C01-002", &types.SellOrderFilter{
+		AskDenom:     "uusdc",
+		MaxAskAmount: "10",
+	}))
+
+	_, err := s.k.SellOrdersByProject(s.ctx, &types.QuerySellOrdersByProjectRequest{ProjectId: "C01-003"})
+	require.ErrorContains(t, err, "could not get project with id C01-003: not found")
+}
@@ -48,15 +48,22 @@ func (k Keeper) SellOrdersBySeller(ctx context.Context, req *types.QuerySellOrde
 			return nil, err
 		}
 
+		additionalAskPrices, err := k.getAdditionalAskPrices(ctx, order.Id)
+		if err != nil {
+			return nil, err
+		}
+
 		info := types.SellOrderInfo{
-			Id:                order.Id,
-			Seller:            sellerString,
-			BatchDenom:        batch.Denom,
-			Quantity:          order.Quantity,
-			AskDenom:          market.BankDenom,
-			AskAmount:         order.AskAmount,
-			DisableAutoRetire: order.DisableAutoRetire,
-			Expiration:        regentypes.ProtobufToGogoTimestamp(order.Expiration),
+			Id:                  order.Id,
+			Seller:              sellerString,
+			BatchDenom:          batch.Denom,
+			Quantity:            order.Quantity,
+			AskDenom:            market.BankDenom,
+			AskAmount:           order.AskAmount,
+			DisableAutoRetire:   order.DisableAutoRetire,
+			Expiration:          regentypes.ProtobufToGogoTimestamp(order.Expiration),
+			AllowedBuyers:       allowedBuyers(order),
+			AdditionalAskPrices: additionalAskPrices,
 		}
 
 		orders = append(orders, &info)
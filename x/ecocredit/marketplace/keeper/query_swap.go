@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+// Swap queries a swap by its unique identifier.
+func (k Keeper) Swap(ctx context.Context, req *types.QuerySwapRequest) (*types.QuerySwapResponse, error) {
+	swap, err := k.stateStore.SwapTable().Get(ctx, req.SwapId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get swap with id %d: %s", req.SwapId, err.Error())
+	}
+
+	info, err := k.getSwapInfo(ctx, swap)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySwapResponse{Swap: info}, nil
+}
+
+// getSwapInfo converts a swap to the human-readable swap information.
+func (k Keeper) getSwapInfo(ctx context.Context, swap *api.Swap) (*types.SwapInfo, error) {
+	offerBatch, err := k.baseStore.BatchTable().Get(ctx, swap.OfferBatchKey)
+	if err != nil {
+		return nil, err
+	}
+
+	askBatch, err := k.baseStore.BatchTable().Get(ctx, swap.AskBatchKey)
+	if err != nil {
+		return nil, err
+	}
+
+	coins, err := getSwapCoins(swap)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SwapInfo{
+		Id:              swap.Id,
+		Proposer:        sdk.AccAddress(swap.Proposer).String(),
+		Counterparty:    sdk.AccAddress(swap.Counterparty).String(),
+		OfferBatchDenom: offerBatch.Denom,
+		OfferQuantity:   swap.OfferQuantity,
+		OfferCoins:      coins,
+		AskBatchDenom:   askBatch.Denom,
+		AskQuantity:     swap.AskQuantity,
+		Expiration:      regentypes.ProtobufToGogoTimestamp(swap.Expiration),
+	}, nil
+}
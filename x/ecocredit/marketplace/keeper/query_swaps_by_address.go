@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+// SwapsByAddress queries all swaps proposed by the given address, or proposed to the given address if
+// counterparty is set, with optional pagination.
+func (k Keeper) SwapsByAddress(ctx context.Context, req *types.QuerySwapsByAddressRequest) (*types.QuerySwapsByAddressResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	var index api.SwapIndexKey = api.SwapProposerIndexKey{}.WithProposer(addr)
+	if req.Counterparty {
+		index = api.SwapCounterpartyIndexKey{}.WithCounterparty(addr)
+	}
+
+	it, err := k.stateStore.SwapTable().List(ctx, index, ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	swaps := make([]*types.SwapInfo, 0)
+	for it.Next() {
+		swap, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := k.getSwapInfo(ctx, swap)
+		if err != nil {
+			return nil, err
+		}
+
+		swaps = append(swaps, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySwapsByAddressResponse{Swaps: swaps, Pagination: pr}, nil
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+// TradesByBatch queries all trades of credits from a credit batch with optional pagination.
+func (k Keeper) TradesByBatch(ctx context.Context, req *types.QueryTradesByBatchRequest) (*types.QueryTradesByBatchResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := k.baseStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", req.BatchDenom, err.Error())
+	}
+
+	it, err := k.stateStore.TradeTable().List(ctx, api.TradeBatchKeyMarketIdTimestampIndexKey{}.WithBatchKey(batch.Key), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	trades := make([]*types.TradeInfo, 0, 10)
+	for it.Next() {
+		trade, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := k.getTradeInfo(ctx, trade)
+		if err != nil {
+			return nil, err
+		}
+
+		trades = append(trades, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTradesByBatchResponse{Trades: trades, Pagination: pr}, nil
+}
+
+// getTradeInfo converts a trade to the human-readable trade information.
+func (k Keeper) getTradeInfo(ctx context.Context, trade *api.Trade) (*types.TradeInfo, error) {
+	batch, err := k.baseStore.BatchTable().Get(ctx, trade.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+
+	market, err := k.stateStore.MarketTable().Get(ctx, trade.MarketId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.TradeInfo{
+		Id:         trade.Id,
+		Buyer:      sdk.AccAddress(trade.Buyer).String(),
+		Seller:     sdk.AccAddress(trade.Seller).String(),
+		BatchDenom: batch.Denom,
+		Quantity:   trade.Quantity,
+		BankDenom:  market.BankDenom,
+		Price:      trade.Price,
+		Timestamp:  regentypes.ProtobufToGogoTimestamp(trade.Timestamp),
+	}, nil
+}
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+func TestQuery_TradesByBatch(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+
+	// make another batch
+	otherDenom := "C01-001-20200101-20220101-002"
+	assert := require.New(t)
+	assert.NoError(s.baseStore.BatchTable().Insert(s.ctx, &baseapi.Batch{
+		ProjectKey: 1,
+		Denom:      otherDenom,
+		StartDate:  start,
+		EndDate:    end,
+	}))
+
+	ts := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.insertTrade(1, "10", "100", ts)
+	s.insertTrade(1, "5", "110", ts.Add(time.Hour))
+	s.insertTrade(2, "1", "120", ts)
+
+	// query trades by batch
+	res, err := s.k.TradesByBatch(s.ctx, &types.QueryTradesByBatchRequest{
+		BatchDenom: batchDenom,
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	assert.NoError(err)
+	assert.Len(res.Trades, 2)
+	assert.Equal(uint64(2), res.Pagination.Total)
+
+	assert.Equal(uint64(1), res.Trades[0].Id)
+	assert.Equal(s.addrs[1].String(), res.Trades[0].Buyer)
+	assert.Equal(s.addrs[0].String(), res.Trades[0].Seller)
+	assert.Equal(batchDenom, res.Trades[0].BatchDenom)
+	assert.Equal("10", res.Trades[0].Quantity)
+	assert.Equal(ask.Denom, res.Trades[0].BankDenom)
+	assert.Equal("100", res.Trades[0].Price)
+	assert.Equal(ts.Unix(), res.Trades[0].Timestamp.Seconds)
+	assert.Equal(uint64(2), res.Trades[1].Id)
+
+	// query trades by batch with pagination
+	res, err = s.k.TradesByBatch(s.ctx, &types.QueryTradesByBatchRequest{
+		BatchDenom: batchDenom,
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	assert.NoError(err)
+	assert.Len(res.Trades, 1)
+
+	// query trades by batch with unknown batch denom
+	_, err = s.k.TradesByBatch(s.ctx, &types.QueryTradesByBatchRequest{BatchDenom: "C02-001-20200101-20220101-001"})
+	assert.ErrorContains(err, "could not get batch with denom C02-001-20200101-20220101-001")
+}
+
+// insertTrade inserts a trade of credits from the credit batch in the first
+// market with the first address as seller and the second address as buyer.
+func (s *baseSuite) insertTrade(batchKey uint64, quantity, price string, ts time.Time) {
+	require.NoError(s.t, s.marketStore.TradeTable().Insert(s.ctx, &api.Trade{
+		Buyer:     s.addrs[1],
+		Seller:    s.addrs[0],
+		BatchKey:  batchKey,
+		MarketId:  1,
+		Quantity:  quantity,
+		Price:     price,
+		Timestamp: timestamppb.New(ts),
+	}))
+}
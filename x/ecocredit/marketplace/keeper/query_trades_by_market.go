@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+// TradesByMarket queries all trades in a market with optional pagination.
+func (k Keeper) TradesByMarket(ctx context.Context, req *types.QueryTradesByMarketRequest) (*types.QueryTradesByMarketResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	market, err := k.stateStore.MarketTable().GetByCreditTypeAbbrevBankDenom(ctx, req.CreditTypeAbbrev, req.BankDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"could not get market with credit type %s and bank denom %s: %s", req.CreditTypeAbbrev, req.BankDenom, err.Error(),
+		)
+	}
+
+	it, err := k.stateStore.TradeTable().List(ctx, api.TradeMarketIdTimestampIndexKey{}.WithMarketId(market.Id), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	trades := make([]*types.TradeInfo, 0, 10)
+	for it.Next() {
+		trade, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := k.getTradeInfo(ctx, trade)
+		if err != nil {
+			return nil, err
+		}
+
+		trades = append(trades, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTradesByMarketResponse{Trades: trades, Pagination: pr}, nil
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+func TestQuery_TradesByMarket(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+
+	// make another market
+	require.NoError(t, s.marketStore.MarketTable().Insert(s.ctx, &api.Market{
+		CreditTypeAbbrev: creditType.Abbreviation,
+		BankDenom:        "uatom",
+	}))
+
+	ts := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.insertTrade(1, "10", "100", ts.Add(time.Hour))
+	s.insertTrade(1, "5", "110", ts)
+	require.NoError(t, s.marketStore.TradeTable().Insert(s.ctx, &api.Trade{
+		Buyer:    s.addrs[1],
+		Seller:   s.addrs[0],
+		BatchKey: 1,
+		MarketId: 2,
+		Quantity: "1",
+		Price:    "120",
+	}))
+
+	// query trades by market (ordered by timestamp)
+	res, err := s.k.TradesByMarket(s.ctx, &types.QueryTradesByMarketRequest{
+		CreditTypeAbbrev: creditType.Abbreviation,
+		BankDenom:        ask.Denom,
+		Pagination:       &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Trades, 2)
+	require.Equal(t, uint64(2), res.Pagination.Total)
+	require.Equal(t, uint64(2), res.Trades[0].Id)
+	require.Equal(t, uint64(1), res.Trades[1].Id)
+	require.Equal(t, ask.Denom, res.Trades[0].BankDenom)
+
+	// query trades by market with unknown market
+	_, err = s.k.TradesByMarket(s.ctx, &types.QueryTradesByMarketRequest{
+		CreditTypeAbbrev: creditType.Abbreviation,
+		BankDenom:        "foo",
+	})
+	require.ErrorContains(t, err, "could not get market with credit type C and bank denom foo")
+}
@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	"context"
+
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+// TradingFee queries the marketplace trading fee.
+func (k Keeper) TradingFee(ctx context.Context, _ *types.QueryTradingFeeRequest) (*types.QueryTradingFeeResponse, error) {
+	tradingFee, err := k.stateStore.TradingFeeTable().Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTradingFeeResponse{
+		MakerFeeBps:   tradingFee.MakerFeeBps,
+		TakerFeeBps:   tradingFee.TakerFeeBps,
+		Destination:   types.FeeDestination(tradingFee.Destination),
+		ModuleAccount: tradingFee.ModuleAccount,
+	}, nil
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+func TestQuery_TradingFee(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 0)
+
+	// query trading fee when no trading fee has been set
+	res, err := s.k.TradingFee(s.ctx, &types.QueryTradingFeeRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), res.MakerFeeBps)
+	require.Equal(t, uint32(0), res.TakerFeeBps)
+	require.Equal(t, types.FeeDestination_FEE_DESTINATION_UNSPECIFIED, res.Destination)
+
+	err = s.marketStore.TradingFeeTable().Save(s.ctx, &api.TradingFee{
+		MakerFeeBps:   100,
+		TakerFeeBps:   200,
+		Destination:   api.FeeDestination_FEE_DESTINATION_MODULE_ACCOUNT,
+		ModuleAccount: "fee_collector",
+	})
+	require.NoError(t, err)
+
+	// query trading fee
+	res, err = s.k.TradingFee(s.ctx, &types.QueryTradingFeeRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint32(100), res.MakerFeeBps)
+	require.Equal(t, uint32(200), res.TakerFeeBps)
+	require.Equal(t, types.FeeDestination_FEE_DESTINATION_MODULE_ACCOUNT, res.Destination)
+	require.Equal(t, "fee_collector", res.ModuleAccount)
+}
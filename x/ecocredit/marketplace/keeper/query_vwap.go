@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/math"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+// VWAP queries the volume-weighted average price of trades of credits from a
+// credit batch in a bank denom within an optional time range.
+func (k Keeper) VWAP(ctx context.Context, req *types.QueryVWAPRequest) (*types.QueryVWAPResponse, error) {
+	if req.StartTime != nil && req.EndTime != nil && req.StartTime.Compare(req.EndTime) > 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("start time cannot be after end time")
+	}
+
+	batch, market, err := k.getBatchMarket(ctx, req.BatchDenom, req.BankDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	indexKey := api.TradeBatchKeyMarketIdTimestampIndexKey{}
+	from := indexKey.WithBatchKeyMarketId(batch.Key, market.Id)
+	to := indexKey.WithBatchKeyMarketId(batch.Key, market.Id)
+	if req.StartTime != nil {
+		from = indexKey.WithBatchKeyMarketIdTimestamp(batch.Key, market.Id, regentypes.GogoToProtobufTimestamp(req.StartTime))
+	}
+	if req.EndTime != nil {
+		to = indexKey.WithBatchKeyMarketIdTimestamp(batch.Key, market.Id, regentypes.GogoToProtobufTimestamp(req.EndTime))
+	}
+
+	// range iteration requires the start and end keys to differ so trades at a
+	// single point in time are listed by prefix
+	var it api.TradeIterator
+	if (req.StartTime == nil && req.EndTime == nil) ||
+		(req.StartTime != nil && req.EndTime != nil && req.StartTime.Compare(req.EndTime) == 0) {
+		it, err = k.stateStore.TradeTable().List(ctx, from)
+	} else {
+		it, err = k.stateStore.TradeTable().ListRange(ctx, from, to)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	volume := math.NewDecFromInt64(0)
+	value := math.NewDecFromInt64(0)
+	var tradeCount uint64
+	for it.Next() {
+		trade, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		quantity, err := math.NewDecFromString(trade.Quantity)
+		if err != nil {
+			return nil, err
+		}
+
+		price, err := math.NewDecFromString(trade.Price)
+		if err != nil {
+			return nil, err
+		}
+
+		tradeValue, err := price.Mul(quantity)
+		if err != nil {
+			return nil, err
+		}
+
+		if value, err = value.Add(tradeValue); err != nil {
+			return nil, err
+		}
+
+		if volume, err = volume.Add(quantity); err != nil {
+			return nil, err
+		}
+
+		tradeCount++
+	}
+
+	res := &types.QueryVWAPResponse{
+		Volume:     volume.String(),
+		TradeCount: tradeCount,
+	}
+
+	if volume.IsPositive() {
+		vwap, err := value.Quo(volume)
+		if err != nil {
+			return nil, err
+		}
+		vwap, _ = vwap.Reduce()
+		res.Vwap = vwap.String()
+	}
+
+	return res, nil
+}
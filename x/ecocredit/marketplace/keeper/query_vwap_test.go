@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+func TestQuery_VWAP(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+
+	// query vwap with no trades
+	res, err := s.k.VWAP(s.ctx, &types.QueryVWAPRequest{BatchDenom: batchDenom, BankDenom: ask.Denom})
+	require.NoError(t, err)
+	require.Equal(t, "", res.Vwap)
+	require.Equal(t, "0", res.Volume)
+	require.Equal(t, uint64(0), res.TradeCount)
+
+	ts := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.insertTrade(1, "10", "100", ts)
+	s.insertTrade(1, "5", "130", ts.Add(time.Hour))
+	s.insertTrade(1, "1.5", "200", ts.Add(2*time.Hour))
+
+	// query vwap of all trades: (1000 + 650 + 300) / 16.5
+	res, err = s.k.VWAP(s.ctx, &types.QueryVWAPRequest{BatchDenom: batchDenom, BankDenom: ask.Denom})
+	require.NoError(t, err)
+	require.Equal(t, "118.1818181818181818181818181818182", res.Vwap)
+	require.Equal(t, "16.5", res.Volume)
+	require.Equal(t, uint64(3), res.TradeCount)
+
+	// query vwap with start time (inclusive): (650 + 300) / 6.5
+	res, err = s.k.VWAP(s.ctx, &types.QueryVWAPRequest{
+		BatchDenom: batchDenom,
+		BankDenom:  ask.Denom,
+		StartTime:  &gogotypes.Timestamp{Seconds: ts.Add(time.Hour).Unix()},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "146.1538461538461538461538461538462", res.Vwap)
+	require.Equal(t, uint64(2), res.TradeCount)
+
+	// query vwap with end time (inclusive): (1000 + 650) / 15
+	res, err = s.k.VWAP(s.ctx, &types.QueryVWAPRequest{
+		BatchDenom: batchDenom,
+		BankDenom:  ask.Denom,
+		EndTime:    &gogotypes.Timestamp{Seconds: ts.Add(time.Hour).Unix()},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "110", res.Vwap)
+	require.Equal(t, "15", res.Volume)
+	require.Equal(t, uint64(2), res.TradeCount)
+
+	// query vwap with start time and end time
+	res, err = s.k.VWAP(s.ctx, &types.QueryVWAPRequest{
+		BatchDenom: batchDenom,
+		BankDenom:  ask.Denom,
+		StartTime:  &gogotypes.Timestamp{Seconds: ts.Add(time.Hour).Unix()},
+		EndTime:    &gogotypes.Timestamp{Seconds: ts.Add(time.Hour).Unix()},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "130", res.Vwap)
+	require.Equal(t, uint64(1), res.TradeCount)
+
+	// query vwap with start time after end time
+	_, err = s.k.VWAP(s.ctx, &types.QueryVWAPRequest{
+		BatchDenom: batchDenom,
+		BankDenom:  ask.Denom,
+		StartTime:  &gogotypes.Timestamp{Seconds: ts.Add(time.Hour).Unix()},
+		EndTime:    &gogotypes.Timestamp{Seconds: ts.Unix()},
+	})
+	require.ErrorContains(t, err, "start time cannot be after end time")
+}
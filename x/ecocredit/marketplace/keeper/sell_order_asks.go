@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+)
+
+// setSellOrderAsks replaces the additional ask prices of the sell order with
+// the given prices, creating the markets for the prices if they do not exist.
+func (k Keeper) setSellOrderAsks(ctx context.Context, orderIndex string, sellOrder *api.SellOrder, creditTypeAbbrev string, prices []sdk.Coin) error {
+	if err := k.deleteSellOrderAsks(ctx, sellOrder.Id); err != nil {
+		return err
+	}
+
+	for _, price := range prices {
+		allowed, err := isDenomAllowed(ctx, price.Denom, k.stateStore.AllowedDenomTable())
+		if err != nil {
+			return err
+		}
+		if !allowed {
+			return sdkerrors.ErrInvalidRequest.Wrapf(
+				"%s: %s is not allowed to be used in sell orders", orderIndex, price.Denom,
+			)
+		}
+
+		marketID, err := k.getOrCreateMarketID(ctx, creditTypeAbbrev, price.Denom)
+		if err != nil {
+			return err
+		}
+
+		if marketID == sellOrder.MarketId {
+			return sdkerrors.ErrInvalidRequest.Wrapf(
+				"%s: additional ask price denom %s cannot be the same as the ask price denom", orderIndex, price.Denom,
+			)
+		}
+
+		if err = k.stateStore.SellOrderAskTable().Insert(ctx, &api.SellOrderAsk{
+			SellOrderId: sellOrder.Id,
+			MarketId:    marketID,
+			AskAmount:   price.Amount.String(),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteSellOrderAsks deletes the additional ask prices of the sell order.
+func (k Keeper) deleteSellOrderAsks(ctx context.Context, sellOrderID uint64) error {
+	return k.stateStore.SellOrderAskTable().DeleteBy(ctx, api.SellOrderAskPrimaryKey{}.WithSellOrderId(sellOrderID))
+}
+
+// getSellOrderAsk returns the market and the ask amount of the sell order in
+// the given bank denom, which is either the ask price of the sell order or one
+// of its additional ask prices. The returned market is nil if the sell order
+// has no ask price in the bank denom.
+func (k Keeper) getSellOrderAsk(ctx context.Context, sellOrder *api.SellOrder, bankDenom string) (*api.Market, sdkmath.Int, error) {
+	market, err := k.stateStore.MarketTable().Get(ctx, sellOrder.MarketId)
+	if err != nil {
+		return nil, sdkmath.Int{}, sdkerrors.ErrInvalidRequest.Wrapf("market id %d: %s", sellOrder.MarketId, err.Error())
+	}
+
+	askAmount := sellOrder.AskAmount
+	if market.BankDenom != bankDenom {
+		market, err = k.stateStore.MarketTable().GetByCreditTypeAbbrevBankDenom(ctx, market.CreditTypeAbbrev, bankDenom)
+		if err != nil {
+			if ormerrors.IsNotFound(err) {
+				return nil, sdkmath.Int{}, nil
+			}
+			return nil, sdkmath.Int{}, err
+		}
+		ask, err := k.stateStore.SellOrderAskTable().Get(ctx, sellOrder.Id, market.Id)
+		if err != nil {
+			if ormerrors.IsNotFound(err) {
+				return nil, sdkmath.Int{}, nil
+			}
+			return nil, sdkmath.Int{}, err
+		}
+		askAmount = ask.AskAmount
+	}
+
+	amount, err := parseAmount(askAmount)
+	if err != nil {
+		return nil, sdkmath.Int{}, err
+	}
+
+	return market, amount, nil
+}
+
+// getAdditionalAskPrices returns the additional ask prices of the sell order.
+func (k Keeper) getAdditionalAskPrices(ctx context.Context, sellOrderID uint64) (sdk.Coins, error) {
+	it, err := k.stateStore.SellOrderAskTable().List(ctx, api.SellOrderAskPrimaryKey{}.WithSellOrderId(sellOrderID))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var prices []sdk.Coin
+	for it.Next() {
+		ask, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		market, err := k.stateStore.MarketTable().Get(ctx, ask.MarketId)
+		if err != nil {
+			return nil, err
+		}
+
+		amount, err := parseAmount(ask.AskAmount)
+		if err != nil {
+			return nil, err
+		}
+
+		prices = append(prices, sdk.NewCoin(market.BankDenom, amount))
+	}
+
+	if len(prices) == 0 {
+		return nil, nil
+	}
+
+	return sdk.NewCoins(prices...), nil
+}
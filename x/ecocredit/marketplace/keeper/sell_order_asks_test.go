@@ -0,0 +1,275 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+// setupSellOrderAsks sets up a sell order of 10 credits from the first test
+// account with an ask price of 10 in the ask denom and an additional ask price
+// of 20 in a second allowed denom, and gives the second test account a balance
+// of 1000 in both denoms.
+func setupSellOrderAsks(t *testing.T) (*baseSuite, map[string]sdk.Coins, uint64) {
+	s := setupBase(t, 3)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], classID, start, end, creditType)
+	assert.NilError(t, s.marketStore.AllowedDenomTable().Insert(s.ctx, &api.AllowedDenom{
+		BankDenom:    "uusdc",
+		DisplayDenom: "usdc",
+		Exponent:     6,
+	}))
+
+	balances := s.mockBankBalances()
+	balances[s.addrs[1].String()] = sdk.NewCoins(
+		sdk.NewInt64Coin(ask.Denom, 1000),
+		sdk.NewInt64Coin("uusdc", 1000),
+	)
+
+	askPrice := sdk.NewInt64Coin(ask.Denom, 10)
+	res, err := s.k.Sell(s.ctx, &types.MsgSell{
+		Seller: s.addrs[0].String(),
+		Orders: []*types.MsgSell_Order{
+			{
+				BatchDenom:          batchDenom,
+				Quantity:            "10",
+				AskPrice:            &askPrice,
+				DisableAutoRetire:   true,
+				AdditionalAskPrices: []sdk.Coin{sdk.NewInt64Coin("uusdc", 20)},
+			},
+		},
+	})
+	assert.NilError(t, err)
+
+	return s, balances, res.SellOrderIds[0]
+}
+
+func TestSellOrderAsks_Sell(t *testing.T) {
+	t.Parallel()
+	s, _, id := setupSellOrderAsks(t)
+
+	market, err := s.marketStore.MarketTable().GetByCreditTypeAbbrevBankDenom(s.ctx, creditType.Abbreviation, "uusdc")
+	assert.NilError(t, err)
+
+	sellOrderAsk, err := s.marketStore.SellOrderAskTable().Get(s.ctx, id, market.Id)
+	assert.NilError(t, err)
+	assert.Equal(t, "20", sellOrderAsk.AskAmount)
+
+	res, err := s.k.SellOrder(s.ctx, &types.QuerySellOrderRequest{SellOrderId: id})
+	assert.NilError(t, err)
+	assert.Equal(t, ask.Denom, res.SellOrder.AskDenom)
+	assert.Equal(t, "10", res.SellOrder.AskAmount)
+	assert.DeepEqual(t, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 20)), res.SellOrder.AdditionalAskPrices)
+
+	// additional ask price denom must be allowed
+	askPrice := sdk.NewInt64Coin(ask.Denom, 10)
+	_, err = s.k.Sell(s.ctx, &types.MsgSell{
+		Seller: s.addrs[0].String(),
+		Orders: []*types.MsgSell_Order{
+			{
+				BatchDenom:          batchDenom,
+				Quantity:            "10",
+				AskPrice:            &askPrice,
+				AdditionalAskPrices: []sdk.Coin{sdk.NewInt64Coin("uother", 20)},
+			},
+		},
+	})
+	assert.ErrorContains(t, err, "orders[0]: uother is not allowed to be used in sell orders")
+}
+
+func TestSellOrderAsks_BuyDirect(t *testing.T) {
+	t.Parallel()
+	s, balances, id := setupSellOrderAsks(t)
+
+	buy := func(bidPrice sdk.Coin) error {
+		_, err := s.k.BuyDirect(s.ctx, &types.MsgBuyDirect{
+			Buyer: s.addrs[1].String(),
+			Orders: []*types.MsgBuyDirect_Order{
+				{
+					SellOrderId:       id,
+					Quantity:          "5",
+					BidPrice:          &bidPrice,
+					DisableAutoRetire: true,
+				},
+			},
+		})
+		return err
+	}
+
+	// the sell order has no ask price in the bid price denom
+	err := buy(sdk.NewInt64Coin("uother", 20))
+	assert.ErrorContains(t, err, "bid price denom: uother, ask price denom: "+ask.Denom)
+
+	// the bid price is less than the additional ask price
+	err = buy(sdk.NewInt64Coin("uusdc", 10))
+	assert.ErrorContains(t, err, "insufficient bid price")
+
+	// pay in the additional ask price denom
+	assert.NilError(t, buy(sdk.NewInt64Coin("uusdc", 20)))
+	assert.Equal(t, int64(900), balances[s.addrs[1].String()].AmountOf("uusdc").Int64())
+	assert.Equal(t, int64(100), balances[s.addrs[0].String()].AmountOf("uusdc").Int64())
+
+	market, err := s.marketStore.MarketTable().GetByCreditTypeAbbrevBankDenom(s.ctx, creditType.Abbreviation, "uusdc")
+	assert.NilError(t, err)
+	trade, err := s.marketStore.TradeTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, market.Id, trade.MarketId)
+	assert.Equal(t, "20", trade.Price)
+
+	// pay in the ask price denom, filling the sell order
+	assert.NilError(t, buy(sdk.NewInt64Coin(ask.Denom, 10)))
+	assert.Equal(t, int64(950), balances[s.addrs[1].String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(0), balances[ecocredit.ModuleName].AmountOf(ask.Denom).Int64())
+
+	// the additional ask prices are deleted with the sell order
+	_, err = s.marketStore.SellOrderAskTable().Get(s.ctx, id, market.Id)
+	assert.ErrorIs(t, err, ormerrors.NotFound)
+}
+
+func TestSellOrderAsks_MakeOffer(t *testing.T) {
+	t.Parallel()
+	s, balances, id := setupSellOrderAsks(t)
+	s.setBlockTime(offerStart)
+
+	makeOffer := func(bidPrice sdk.Coin) error {
+		expiration := offerStart.Add(time.Hour)
+		_, err := s.k.MakeOffer(s.ctx, &types.MsgMakeOffer{
+			Buyer:             s.addrs[1].String(),
+			SellOrderId:       id,
+			Quantity:          "5",
+			BidPrice:          &bidPrice,
+			DisableAutoRetire: true,
+			Expiration:        &expiration,
+		})
+		return err
+	}
+
+	// the sell order has no ask price in the bid price denom
+	err := makeOffer(sdk.NewInt64Coin("uother", 5))
+	assert.ErrorContains(t, err, "bid price denom: uother, ask price denom: "+ask.Denom)
+
+	// the bid price must be less than the additional ask price
+	err = makeOffer(sdk.NewInt64Coin("uusdc", 20))
+	assert.ErrorContains(t, err, "ask price: 20uusdc, bid price: 20uusdc, bid price must be less than the ask price")
+
+	// the bid price is compared with the additional ask price rather than the
+	// ask price
+	assert.NilError(t, makeOffer(sdk.NewInt64Coin("uusdc", 15)))
+	assert.Equal(t, int64(925), balances[s.addrs[1].String()].AmountOf("uusdc").Int64())
+
+	market, err := s.marketStore.MarketTable().GetByCreditTypeAbbrevBankDenom(s.ctx, creditType.Abbreviation, "uusdc")
+	assert.NilError(t, err)
+	offer, err := s.marketStore.OfferTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, market.Id, offer.MarketId)
+}
+
+func TestSellOrderAsks_ProcessBuyOrders(t *testing.T) {
+	t.Parallel()
+	s, balances, id := setupSellOrderAsks(t)
+
+	// a buy order below the additional ask price is not matched
+	bidPrice := sdk.NewInt64Coin("uusdc", 15)
+	_, err := s.k.Buy(s.ctx, &types.MsgBuyOrder{
+		Buyer: s.addrs[1].String(),
+		Orders: []*types.MsgBuyOrder_Order{
+			{BatchDenom: batchDenom, Quantity: "5", BidPrice: &bidPrice, DisableAutoRetire: true},
+		},
+	})
+	assert.NilError(t, err)
+	assert.NilError(t, s.k.ProcessBuyOrders(s.ctx))
+	assert.Equal(t, int64(75), balances[ecocredit.ModuleName].AmountOf("uusdc").Int64())
+
+	// a buy order in the additional ask price denom is filled at the
+	// additional ask price and the difference is returned to the buyer
+	bidPrice = sdk.NewInt64Coin("uusdc", 25)
+	res, err := s.k.Buy(s.ctx, &types.MsgBuyOrder{
+		Buyer: s.addrs[1].String(),
+		Orders: []*types.MsgBuyOrder_Order{
+			{BatchDenom: batchDenom, Quantity: "5", BidPrice: &bidPrice, DisableAutoRetire: true},
+		},
+	})
+	assert.NilError(t, err)
+	assert.NilError(t, s.k.ProcessBuyOrders(s.ctx))
+
+	_, err = s.marketStore.BuyOrderTable().Get(s.ctx, res.BuyOrderIds[0])
+	assert.ErrorIs(t, err, ormerrors.NotFound)
+	assert.Equal(t, int64(825), balances[s.addrs[1].String()].AmountOf("uusdc").Int64())
+	assert.Equal(t, int64(100), balances[s.addrs[0].String()].AmountOf("uusdc").Int64())
+
+	sellOrder, err := s.marketStore.SellOrderTable().Get(s.ctx, id)
+	assert.NilError(t, err)
+	assert.Equal(t, "5", sellOrder.Quantity)
+
+	market, err := s.marketStore.MarketTable().GetByCreditTypeAbbrevBankDenom(s.ctx, creditType.Abbreviation, "uusdc")
+	assert.NilError(t, err)
+	trade, err := s.marketStore.TradeTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, market.Id, trade.MarketId)
+	assert.Equal(t, "20", trade.Price)
+}
+
+func TestSellOrderAsks_UpdateSellOrders(t *testing.T) {
+	t.Parallel()
+	s, _, id := setupSellOrderAsks(t)
+
+	update := func(u *types.MsgUpdateSellOrders_Update) error {
+		u.SellOrderId = id
+		u.NewQuantity = "10"
+		if u.NewAskPrice == nil {
+			askPrice := sdk.NewInt64Coin(ask.Denom, 10)
+			u.NewAskPrice = &askPrice
+		}
+		_, err := s.k.UpdateSellOrders(s.ctx, &types.MsgUpdateSellOrders{
+			Seller:  s.addrs[0].String(),
+			Updates: []*types.MsgUpdateSellOrders_Update{u},
+		})
+		return err
+	}
+	additionalAskPrices := func() sdk.Coins {
+		prices, err := s.k.getAdditionalAskPrices(s.ctx, id)
+		assert.NilError(t, err)
+		return prices
+	}
+
+	// the new ask price denom cannot be an additional ask price denom
+	usdcPrice := sdk.NewInt64Coin("uusdc", 10)
+	err := update(&types.MsgUpdateSellOrders_Update{NewAskPrice: &usdcPrice})
+	assert.ErrorContains(t, err, "updates[0]: new ask price denom uusdc is the denom of an additional ask price")
+
+	// the additional ask prices are kept when not updated
+	assert.NilError(t, update(&types.MsgUpdateSellOrders_Update{}))
+	assert.DeepEqual(t, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 20)), additionalAskPrices())
+
+	// replace the additional ask prices
+	assert.NilError(t, update(&types.MsgUpdateSellOrders_Update{
+		NewAdditionalAskPrices: []sdk.Coin{sdk.NewInt64Coin("uusdc", 30)},
+	}))
+	assert.DeepEqual(t, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 30)), additionalAskPrices())
+
+	// clear the additional ask prices
+	assert.NilError(t, update(&types.MsgUpdateSellOrders_Update{ClearAdditionalAskPrices: true}))
+	assert.Check(t, additionalAskPrices() == nil)
+}
+
+func TestSellOrderAsks_CancelSellOrder(t *testing.T) {
+	t.Parallel()
+	s, _, id := setupSellOrderAsks(t)
+
+	_, err := s.k.CancelSellOrder(s.ctx, &types.MsgCancelSellOrder{
+		Seller:      s.addrs[0].String(),
+		SellOrderId: id,
+	})
+	assert.NilError(t, err)
+
+	prices, err := s.k.getAdditionalAskPrices(s.ctx, id)
+	assert.NilError(t, err)
+	assert.Check(t, prices == nil)
+}
@@ -0,0 +1,265 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+// sellOrderFilter is a parsed types.SellOrderFilter.
+type sellOrderFilter struct {
+	// marketID is the market of the ask denom, zero if the ask denom is not set
+	marketID uint64
+	// noMarket is true if the ask denom is set but there is no market for the
+	// ask denom, in which case no sell orders match the filter
+	noMarket       bool
+	minAskAmount   *sdkmath.Int
+	maxAskAmount   *sdkmath.Int
+	sort           types.SellOrderSort
+	excludeExpired bool
+	blockTime      time.Time
+}
+
+// parseSellOrderFilter parses and validates the filter. The credit type is used
+// to look up the market of the ask denom and requireAskDenom determines whether
+// the ask denom must be set when filtering by ask amount, i.e. whether the sell
+// orders being filtered may belong to multiple markets.
+func (k Keeper) parseSellOrderFilter(ctx context.Context, filter *types.SellOrderFilter, creditTypeAbbrev string, requireAskDenom bool) (*sellOrderFilter, error) {
+	f := &sellOrderFilter{blockTime: sdk.UnwrapSDKContext(ctx).BlockTime()}
+	if filter == nil {
+		return f, nil
+	}
+
+	f.sort = filter.Sort
+	f.excludeExpired = filter.ExcludeExpired
+
+	if filter.MinAskAmount != "" {
+		amount, ok := sdkmath.NewIntFromString(filter.MinAskAmount)
+		if !ok || amount.IsNegative() {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("min ask amount: %s is not a non-negative integer", filter.MinAskAmount)
+		}
+		f.minAskAmount = &amount
+	}
+
+	if filter.MaxAskAmount != "" {
+		amount, ok := sdkmath.NewIntFromString(filter.MaxAskAmount)
+		if !ok || amount.IsNegative() {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("max ask amount: %s is not a non-negative integer", filter.MaxAskAmount)
+		}
+		f.maxAskAmount = &amount
+	}
+
+	if f.minAskAmount != nil && f.maxAskAmount != nil && f.minAskAmount.GT(*f.maxAskAmount) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"min ask amount %s cannot be greater than max ask amount %s", f.minAskAmount, f.maxAskAmount,
+		)
+	}
+
+	if filter.AskDenom == "" {
+		if requireAskDenom && (f.minAskAmount != nil || f.maxAskAmount != nil) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap("ask denom is required when filtering by ask amount")
+		}
+		return f, nil
+	}
+
+	market, err := k.stateStore.MarketTable().GetByCreditTypeAbbrevBankDenom(ctx, creditTypeAbbrev, filter.AskDenom)
+	if err != nil {
+		if !ormerrors.IsNotFound(err) {
+			return nil, err
+		}
+		f.noMarket = true
+		return f, nil
+	}
+	f.marketID = market.Id
+
+	return f, nil
+}
+
+// listSellOrders returns the sell orders matching the filter and the match
+// function with ORM pagination. When sorting by ask price or filtering by ask
+// amount, the sell orders are listed with the market id and sortable ask amount
+// index, otherwise they are listed in the order of their ids. The match
+// function selects the sell orders in addition to the filter, e.g. the sell
+// orders of a credit class.
+func (k Keeper) listSellOrders(ctx context.Context, f *sellOrderFilter, match func(*api.SellOrder) (bool, error),
+	pagination *query.PageRequest) ([]*api.SellOrder, *query.PageResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(pagination)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if f.noMarket {
+		return []*api.SellOrder{}, &query.PageResponse{}, nil
+	}
+
+	// the filter function of the iterator cannot return an error, so the
+	// first error of the match function is returned after iterating
+	var matchErr error
+	opts := []ormlist.Option{
+		ormlist.Paginate(pg),
+		ormlist.Filter(func(msg proto.Message) bool {
+			order, ok := msg.(*api.SellOrder)
+			if !ok || matchErr != nil || f.isExpired(order) {
+				return false
+			}
+			if match == nil {
+				return true
+			}
+			matched, err := match(order)
+			if err != nil {
+				matchErr = err
+				return false
+			}
+			return matched
+		}),
+	}
+
+	var it api.SellOrderIterator
+	switch {
+	case f.sort != types.SellOrderSort_SELL_ORDER_SORT_UNSPECIFIED || f.minAskAmount != nil || f.maxAskAmount != nil:
+		if f.sort == types.SellOrderSort_SELL_ORDER_SORT_ASK_PRICE_DESC {
+			opts = append(opts, ormlist.Reverse())
+		}
+		it, err = k.listSellOrdersByAskAmount(ctx, f, opts...)
+	case f.marketID != 0:
+		it, err = k.stateStore.SellOrderTable().List(ctx, api.SellOrderMarketIdIndexKey{}.WithMarketId(f.marketID), opts...)
+	default:
+		it, err = k.stateStore.SellOrderTable().List(ctx, api.SellOrderPrimaryKey{}, opts...)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	defer it.Close()
+
+	orders := make([]*api.SellOrder, 0, 10)
+	for it.Next() {
+		order, err := it.Value()
+		if err != nil {
+			return nil, nil, err
+		}
+		orders = append(orders, order)
+	}
+	if matchErr != nil {
+		return nil, nil, matchErr
+	}
+
+	pageRes, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return orders, pageRes, nil
+}
+
+// listSellOrdersByAskAmount lists the sell orders with the market id and
+// sortable ask amount index, within the range of the min and max ask amounts
+// of the filter when the market of the filter is set.
+func (k Keeper) listSellOrdersByAskAmount(ctx context.Context, f *sellOrderFilter, opts ...ormlist.Option) (api.SellOrderIterator, error) {
+	key := api.SellOrderMarketIdSortableAskAmountIndexKey{}
+	if f.marketID == 0 {
+		return k.stateStore.SellOrderTable().List(ctx, key, opts...)
+	}
+
+	if f.minAskAmount == nil && f.maxAskAmount == nil {
+		return k.stateStore.SellOrderTable().List(ctx, key.WithMarketId(f.marketID), opts...)
+	}
+
+	// a range with a prefix key of the market id as its start or end includes
+	// all ask amounts in the market below or above the other end of the range
+	fromKey, toKey := key.WithMarketId(f.marketID), key.WithMarketId(f.marketID)
+	if f.minAskAmount != nil {
+		fromKey = key.WithMarketIdSortableAskAmount(f.marketID, types.SortableAskAmount(*f.minAskAmount))
+	}
+	if f.maxAskAmount != nil {
+		toKey = key.WithMarketIdSortableAskAmount(f.marketID, types.SortableAskAmount(*f.maxAskAmount))
+	}
+	if f.minAskAmount != nil && f.maxAskAmount != nil && f.minAskAmount.Equal(*f.maxAskAmount) {
+		// the start and end of a range cannot be equal, so we list the sell orders with the ask amount
+		return k.stateStore.SellOrderTable().List(ctx, toKey, opts...)
+	}
+
+	return k.stateStore.SellOrderTable().ListRange(ctx, fromKey, toKey, opts...)
+}
+
+// isExpired returns whether the sell order is expired and expired sell orders
+// are excluded by the filter.
+func (f *sellOrderFilter) isExpired(order *api.SellOrder) bool {
+	// nil expirations may be decoded as the 0 value timestamp
+	return f.excludeExpired && order.Expiration != nil && order.Expiration.AsTime().After(time.Unix(0, 0)) &&
+		!order.Expiration.AsTime().After(f.blockTime)
+}
+
+// batchDenomPrefixMatcher returns a match function for listSellOrders that
+// selects the sell orders of the credit batches with a denom starting with the
+// prefix.
+func (k Keeper) batchDenomPrefixMatcher(ctx context.Context, prefix string) func(*api.SellOrder) (bool, error) {
+	matches := make(map[uint64]bool)
+	return func(order *api.SellOrder) (bool, error) {
+		matched, ok := matches[order.BatchKey]
+		if !ok {
+			batch, err := k.baseStore.BatchTable().Get(ctx, order.BatchKey)
+			if err != nil {
+				return false, err
+			}
+			matched = strings.HasPrefix(batch.Denom, prefix)
+			matches[order.BatchKey] = matched
+		}
+		return matched, nil
+	}
+}
+
+// getSellOrderInfos returns the sell order information of the sell orders.
+func (k Keeper) getSellOrderInfos(ctx context.Context, orders []*api.SellOrder) ([]*types.SellOrderInfo, error) {
+	batchDenoms := make(map[uint64]string)
+	infos := make([]*types.SellOrderInfo, 0, len(orders))
+	for _, order := range orders {
+		batchDenom, ok := batchDenoms[order.BatchKey]
+		if !ok {
+			batch, err := k.baseStore.BatchTable().Get(ctx, order.BatchKey)
+			if err != nil {
+				return nil, err
+			}
+			batchDenom = batch.Denom
+			batchDenoms[order.BatchKey] = batchDenom
+		}
+
+		market, err := k.stateStore.MarketTable().Get(ctx, order.MarketId)
+		if err != nil {
+			return nil, err
+		}
+
+		additionalAskPrices, err := k.getAdditionalAskPrices(ctx, order.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		infos = append(infos, &types.SellOrderInfo{
+			Id:                  order.Id,
+			Seller:              sdk.AccAddress(order.Seller).String(),
+			BatchDenom:          batchDenom,
+			Quantity:            order.Quantity,
+			AskDenom:            market.BankDenom,
+			AskAmount:           order.AskAmount,
+			DisableAutoRetire:   order.DisableAutoRetire,
+			Expiration:          regentypes.ProtobufToGogoTimestamp(order.Expiration),
+			AllowedBuyers:       allowedBuyers(order),
+			AdditionalAskPrices: additionalAskPrices,
+		})
+	}
+
+	return infos, nil
+}
@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// SettleAuctions is a BeginBlock function that settles and deletes auctions
+// that have ended. If an auction has a highest bidder, the escrowed credits are
+// transferred to the highest bidder and the escrowed bid is sent to the seller,
+// otherwise the escrowed credits are moved back into the seller's tradable
+// balance. Each auction is settled in a cache context and an auction that
+// cannot be settled (e.g. the credit batch has been suspended) is cancelled
+// instead, returning the escrowed credits to the seller and the escrowed bid
+// to the highest bidder, so that a single auction cannot halt the chain. At
+// most auctionSettleLimit auctions are settled per block and any remaining
+// ended auctions are settled in the following blocks, starting from the
+// auction settle cursor.
+func (k Keeper) SettleAuctions(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	cursor, err := k.stateStore.AuctionSettleCursorTable().Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// auctions cannot be created with an end time that is not in the future and
+	// the end time of an auction is never moved before the current block time,
+	// so all auctions with an end time before the cursor have already been settled.
+	min, blockTime, ok := pruneRange(cursor.EndTime, sdkCtx.BlockTime())
+	if !ok {
+		return nil
+	}
+	fromKey, toKey := api.AuctionEndTimeIndexKey{}.WithEndTime(min), api.AuctionEndTimeIndexKey{}.WithEndTime(blockTime)
+
+	var it api.AuctionIterator
+	if min.AsTime().Equal(blockTime.AsTime()) {
+		// the start and end of a range cannot be equal, so we list the auctions ending at the block time
+		it, err = k.stateStore.AuctionTable().List(ctx, toKey)
+	} else {
+		it, err = k.stateStore.AuctionTable().ListRange(ctx, fromKey, toKey)
+	}
+	if err != nil {
+		return err
+	}
+
+	// auctions are collected before settlement because settlement writes to
+	// the tables being iterated over
+	var auctions []*api.Auction
+	for len(auctions) < k.auctionSettleLimit && it.Next() {
+		auction, err := it.Value()
+		if err != nil {
+			it.Close()
+			return err
+		}
+		auctions = append(auctions, auction)
+	}
+	it.Close()
+
+	for _, auction := range auctions {
+		err = runInCacheContext(ctx, func(ctx context.Context) error {
+			return k.settleAuction(ctx, auction)
+		})
+		if err != nil {
+			logger(sdkCtx).Error(
+				"failed to settle auction",
+				"auction_id", auction.Id,
+				"err", err,
+			)
+			if err = k.cancelAuction(ctx, auction); err != nil {
+				return err
+			}
+		}
+		if err = k.stateStore.AuctionTable().Delete(ctx, auction); err != nil {
+			return err
+		}
+	}
+
+	if len(auctions) == 0 {
+		return nil
+	}
+
+	return k.stateStore.AuctionSettleCursorTable().Save(ctx, &api.AuctionSettleCursor{
+		EndTime: auctions[len(auctions)-1].EndTime,
+	})
+}
+
+// settleAuction moves credits and coins according to the outcome of the auction.
+func (k Keeper) settleAuction(ctx context.Context, auction *api.Auction) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	market, err := k.stateStore.MarketTable().Get(ctx, auction.MarketId)
+	if err != nil {
+		return err
+	}
+
+	event := &types.EventSettleAuction{AuctionId: auction.Id}
+
+	if len(auction.HighestBidder) == 0 {
+		if err = k.unescrowCredits(ctx, auction.Seller, auction.BatchKey, auction.Quantity); err != nil {
+			return err
+		}
+		return sdkCtx.EventManager().EmitTypedEvent(event)
+	}
+
+	batch, err := k.baseStore.BatchTable().Get(ctx, auction.BatchKey)
+	if err != nil {
+		return err
+	}
+	if err = utils.AssertBatchNotSuspended(batch); err != nil {
+		return err
+	}
+
+	quantity, err := math.NewDecFromString(auction.Quantity)
+	if err != nil {
+		return err
+	}
+
+	cost, err := getAuctionCost(auction, market.BankDenom)
+	if err != nil {
+		return err
+	}
+
+	if err = k.transferEscrowedCredits(ctx, auction.Seller, auction.BatchKey, auction.HighestBidder, quantity, orderOptions{
+		autoRetire:   !auction.HighestBidDisableAutoRetire,
+		batchDenom:   batch.Denom,
+		jurisdiction: auction.HighestBidRetirementJurisdiction,
+		reason:       auction.HighestBidRetirementReason,
+		beneficiary:  auction.HighestBidRetirementBeneficiary,
+		isolateHooks: true,
+	}); err != nil {
+		return err
+	}
+
+	// the seller is charged the maker fee and the bidder the taker fee, which
+	// is paid from the trading fee held in escrow with the highest bid
+	res, err := k.settleTrade(ctx, auction.HighestBidder, auction.Seller, auction.BatchKey, auction.MarketId,
+		quantity, auction.HighestBidAmount, cost, orderOptions{
+			fromEscrow:  true,
+			escrowedFee: getTradingFee(cost.Amount, auction.HighestBidEscrowedFeeBps),
+			sellerMaker: true,
+		})
+	if err != nil {
+		return err
+	}
+
+	event.Winner = sdk.AccAddress(auction.HighestBidder).String()
+	event.Royalty = &res.royalty
+	event.BuyerFee = &res.buyerFee
+	event.SellerFee = &res.sellerFee
+
+	return sdkCtx.EventManager().EmitTypedEvent(event)
+}
+
+// cancelAuction returns the escrowed credits of the auction to the seller and
+// the escrowed bid (if any) to the highest bidder.
+func (k Keeper) cancelAuction(ctx context.Context, auction *api.Auction) error {
+	if err := k.unescrowCredits(ctx, auction.Seller, auction.BatchKey, auction.Quantity); err != nil {
+		return err
+	}
+
+	if len(auction.HighestBidder) != 0 {
+		market, err := k.stateStore.MarketTable().Get(ctx, auction.MarketId)
+		if err != nil {
+			return err
+		}
+		if err = k.unescrowAuctionBid(ctx, auction, market.BankDenom); err != nil {
+			return err
+		}
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventSettleAuction{
+		AuctionId: auction.Id,
+	})
+}
@@ -0,0 +1,262 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+func TestSettleAuctions(t *testing.T) {
+	t.Parallel()
+	s, balances := setupAuction(t)
+	seller, bidder := s.addrs[0], s.addrs[1]
+	balances[bidder.String()] = sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 2000))
+
+	// a royalty of 10% is paid to the third test account
+	royaltyRecipient := s.addrs[2]
+	assert.NilError(t, s.marketStore.ClassRoyaltyTable().Insert(s.ctx, &api.ClassRoyalty{
+		ClassKey:   1,
+		RoyaltyBps: 1_000,
+		Recipient:  royaltyRecipient,
+	}))
+
+	won := s.createAuction(types.AuctionType_AUCTION_TYPE_ENGLISH, false)
+	unsold := s.createAuction(types.AuctionType_AUCTION_TYPE_ENGLISH, false)
+	assert.NilError(t, s.bidAuction(bidder, won, 120))
+
+	// auctions are not settled before the end time
+	s.setBlockTime(auctionStart.Add(9 * time.Hour))
+	assert.NilError(t, s.k.SettleAuctions(s.ctx))
+	_, err := s.marketStore.AuctionTable().Get(s.ctx, won)
+	assert.NilError(t, err)
+
+	s.setBlockTime(auctionStart.Add(10 * time.Hour))
+	assert.NilError(t, s.k.SettleAuctions(s.ctx))
+
+	for _, id := range []uint64{won, unsold} {
+		_, err = s.marketStore.AuctionTable().Get(s.ctx, id)
+		assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+	}
+
+	// the credits of the unsold auction are returned to the seller and the
+	// credits of the won auction are retired to the bidder
+	sellerBal, err := s.baseStore.BatchBalanceTable().Get(s.ctx, seller, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "90", sellerBal.TradableAmount)
+	assert.Equal(t, "0", sellerBal.EscrowedAmount)
+
+	bidderBal, err := s.baseStore.BatchBalanceTable().Get(s.ctx, bidder, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "0", bidderBal.TradableAmount)
+	assert.Equal(t, "10", bidderBal.RetiredAmount)
+
+	supply, err := s.baseStore.BatchSupplyTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "90", supply.TradableAmount)
+	assert.Equal(t, "110", supply.RetiredAmount)
+
+	// the escrowed bid is paid to the seller minus the royalty
+	assert.Equal(t, int64(800), balances[bidder.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(1080), balances[seller.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(120), balances[royaltyRecipient.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(0), balances[ecocredit.ModuleName].AmountOf(ask.Denom).Int64())
+
+	// the trade is recorded at the winning bid
+	trade, err := s.marketStore.TradeTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	assert.Check(t, bidder.Equals(sdk.AccAddress(trade.Buyer)))
+	assert.Check(t, seller.Equals(sdk.AccAddress(trade.Seller)))
+	assert.Equal(t, "10", trade.Quantity)
+	assert.Equal(t, "120", trade.Price)
+}
+
+func TestSettleAuctions_RetirementReason(t *testing.T) {
+	t.Parallel()
+	s, balances := setupAuction(t)
+	bidder := s.addrs[1]
+	balances[bidder.String()] = sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 2000))
+
+	id := s.createAuction(types.AuctionType_AUCTION_TYPE_ENGLISH, false)
+	bidPrice := sdk.NewInt64Coin(ask.Denom, 120)
+	_, err := s.k.BidAuction(s.ctx, &types.MsgBidAuction{
+		Bidder:                 bidder.String(),
+		AuctionId:              id,
+		BidPrice:               &bidPrice,
+		RetirementJurisdiction: "US-OR",
+		RetirementReason:       "offsetting emissions",
+		RetirementBeneficiary:  "regen",
+	})
+	assert.NilError(t, err)
+
+	s.setBlockTime(auctionStart.Add(10 * time.Hour))
+	assert.NilError(t, s.k.SettleAuctions(s.ctx))
+
+	// the retirement is recorded with the reason and beneficiary of the bid
+	it, err := s.baseStore.RetirementTable().List(s.ctx, baseapi.RetirementOwnerIndexKey{}.WithOwner(bidder))
+	assert.NilError(t, err)
+	defer it.Close()
+	assert.Check(t, it.Next())
+	retirement, err := it.Value()
+	assert.NilError(t, err)
+	assert.Equal(t, "10", retirement.Amount)
+	assert.Equal(t, "US-OR", retirement.Jurisdiction)
+	assert.Equal(t, "offsetting emissions", retirement.Reason)
+	assert.Equal(t, "regen", retirement.Beneficiary)
+}
+
+func TestSettleAuctions_DutchTradable(t *testing.T) {
+	t.Parallel()
+	s, balances := setupAuction(t)
+	seller, bidder := s.addrs[0], s.addrs[1]
+	balances[bidder.String()] = sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 2000))
+
+	id := s.createAuction(types.AuctionType_AUCTION_TYPE_DUTCH, true)
+
+	s.setBlockTime(auctionStart.Add(5 * time.Hour))
+	bidPrice := sdk.NewInt64Coin(ask.Denom, 100)
+	_, err := s.k.BidAuction(s.ctx, &types.MsgBidAuction{
+		Bidder:            bidder.String(),
+		AuctionId:         id,
+		BidPrice:          &bidPrice,
+		DisableAutoRetire: true,
+	})
+	assert.NilError(t, err)
+
+	// the auction is settled in the next block
+	s.setBlockTime(auctionStart.Add(5*time.Hour + 5*time.Second))
+	assert.NilError(t, s.k.SettleAuctions(s.ctx))
+
+	_, err = s.marketStore.AuctionTable().Get(s.ctx, id)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	bidderBal, err := s.baseStore.BatchBalanceTable().Get(s.ctx, bidder, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "10", bidderBal.TradableAmount)
+	assert.Equal(t, "0", bidderBal.RetiredAmount)
+
+	assert.Equal(t, int64(1250), balances[bidder.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(750), balances[seller.String()].AmountOf(ask.Denom).Int64())
+}
+
+func TestSettleAuctions_Suspended(t *testing.T) {
+	t.Parallel()
+	s, balances := setupAuction(t)
+	seller, bidder := s.addrs[0], s.addrs[1]
+	balances[bidder.String()] = sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 2000))
+
+	id := s.createAuction(types.AuctionType_AUCTION_TYPE_ENGLISH, false)
+	assert.NilError(t, s.bidAuction(bidder, id, 120))
+	s.setBatchSuspended(1, true)
+
+	// the auction cannot be settled and is cancelled without failing the block
+	s.setBlockTime(auctionStart.Add(10 * time.Hour))
+	assert.NilError(t, s.k.SettleAuctions(s.ctx))
+
+	_, err := s.marketStore.AuctionTable().Get(s.ctx, id)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	// the escrowed credits are returned to the seller and the escrowed bid to
+	// the bidder
+	sellerBal, err := s.baseStore.BatchBalanceTable().Get(s.ctx, seller, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "100", sellerBal.TradableAmount)
+	assert.Equal(t, "0", sellerBal.EscrowedAmount)
+
+	_, err = s.baseStore.BatchBalanceTable().Get(s.ctx, bidder, 1)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	assert.Equal(t, int64(2000), balances[bidder.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(0), balances[seller.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(0), balances[ecocredit.ModuleName].AmountOf(ask.Denom).Int64())
+}
+
+func TestSettleAuctions_TradingFees(t *testing.T) {
+	t.Parallel()
+	s, balances := setupAuction(t)
+	seller, bidder := s.addrs[0], s.addrs[1]
+	balances[bidder.String()] = sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 2000))
+	s.setTradingFee(100, 200)
+
+	id := s.createAuction(types.AuctionType_AUCTION_TYPE_ENGLISH, false)
+	assert.NilError(t, s.bidAuction(bidder, id, 120))
+
+	s.setBlockTime(auctionStart.Add(10 * time.Hour))
+	assert.NilError(t, s.k.SettleAuctions(s.ctx))
+
+	// the seller pays the maker fee out of the escrowed bid and the bidder
+	// pays the taker fee in addition to the bid
+	assert.Equal(t, int64(776), balances[bidder.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(1188), balances[seller.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(36), balances["burn"].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(0), balances[ecocredit.ModuleName].AmountOf(ask.Denom).Int64())
+}
+
+func TestSettleAuctions_EscrowedTradingFee(t *testing.T) {
+	t.Parallel()
+	s, balances := setupAuction(t)
+	seller, bidder := s.addrs[0], s.addrs[1]
+	s.setTradingFee(300, 200)
+
+	// the bidder escrows the bid and the highest trading fee
+	balances[bidder.String()] = sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 1236))
+
+	id := s.createAuction(types.AuctionType_AUCTION_TYPE_ENGLISH, false)
+	assert.NilError(t, s.bidAuction(bidder, id, 120))
+	assert.Equal(t, int64(0), balances[bidder.String()].AmountOf(ask.Denom).Int64())
+
+	s.setBlockTime(auctionStart.Add(10 * time.Hour))
+	assert.NilError(t, s.k.SettleAuctions(s.ctx))
+
+	// the bidder pays the taker fee out of the escrowed trading fee and the
+	// remainder is returned to the bidder
+	assert.Equal(t, int64(12), balances[bidder.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(1164), balances[seller.String()].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(60), balances["burn"].AmountOf(ask.Denom).Int64())
+	assert.Equal(t, int64(0), balances[ecocredit.ModuleName].AmountOf(ask.Denom).Int64())
+}
+
+func TestSettleAuctions_Limit(t *testing.T) {
+	t.Parallel()
+	s, _ := setupAuction(t)
+	s.k.auctionSettleLimit = 2
+
+	ids := []uint64{
+		s.createAuction(types.AuctionType_AUCTION_TYPE_ENGLISH, false),
+		s.createAuction(types.AuctionType_AUCTION_TYPE_ENGLISH, false),
+		s.createAuction(types.AuctionType_AUCTION_TYPE_ENGLISH, false),
+	}
+
+	// only the first two ended auctions are settled
+	endTime := auctionStart.Add(10 * time.Hour)
+	s.setBlockTime(endTime)
+	assert.NilError(t, s.k.SettleAuctions(s.ctx))
+	for _, id := range ids[:2] {
+		_, err := s.marketStore.AuctionTable().Get(s.ctx, id)
+		assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+	}
+	_, err := s.marketStore.AuctionTable().Get(s.ctx, ids[2])
+	assert.NilError(t, err)
+
+	cursor, err := s.marketStore.AuctionSettleCursorTable().Get(s.ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, endTime, cursor.EndTime.AsTime())
+
+	// the remaining auction is settled in the next block, starting from the cursor
+	s.setBlockTime(endTime.Add(time.Hour))
+	assert.NilError(t, s.k.SettleAuctions(s.ctx))
+	_, err = s.marketStore.AuctionTable().Get(s.ctx, ids[2])
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	sellerBal, err := s.baseStore.BatchBalanceTable().Get(s.ctx, s.addrs[0], 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "0", sellerBal.EscrowedAmount)
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+func TestSuspendedBatch_SellAndBuyDirect(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], classID, start, end, creditType)
+
+	res, err := s.k.Sell(s.ctx, &types.MsgSell{
+		Seller: s.addrs[0].String(),
+		Orders: []*types.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: "10", AskPrice: &ask, DisableAutoRetire: true},
+		},
+	})
+	assert.NilError(t, err)
+
+	batch, err := s.baseStore.BatchTable().GetByDenom(s.ctx, batchDenom)
+	assert.NilError(t, err)
+	batch.Suspended = true
+	assert.NilError(t, s.baseStore.BatchTable().Update(s.ctx, batch))
+
+	_, err = s.k.Sell(s.ctx, &types.MsgSell{
+		Seller: s.addrs[0].String(),
+		Orders: []*types.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: "10", AskPrice: &ask},
+		},
+	})
+	assert.Error(t, err, "orders[0]: credit batch "+batchDenom+" is suspended: invalid request")
+
+	bidPrice := sdk.NewInt64Coin(ask.Denom, ask.Amount.Int64())
+	_, err = s.k.BuyDirect(s.ctx, &types.MsgBuyDirect{
+		Buyer: s.addrs[1].String(),
+		Orders: []*types.MsgBuyDirect_Order{
+			{SellOrderId: res.SellOrderIds[0], Quantity: "5", BidPrice: &bidPrice, DisableAutoRetire: true},
+		},
+	})
+	assert.Error(t, err, "orders[0]: credit batch "+batchDenom+" is suspended: invalid request")
+}
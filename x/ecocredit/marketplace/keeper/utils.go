@@ -2,15 +2,22 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	basekeeper "github.com/regen-network/regen-ledger/x/ecocredit/base/keeper"
 	basetypes "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
 	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
 )
 
@@ -19,10 +26,88 @@ func isDenomAllowed(ctx context.Context, bankDenom string, table api.AllowedDeno
 	return table.Has(ctx, bankDenom)
 }
 
+// isAllowedBuyer checks if the buyer is allowed to buy credits from the sell
+// order, i.e. the sell order has no allowed buyers or the buyer is one of them.
+func isAllowedBuyer(sellOrder *api.SellOrder, buyer sdk.AccAddress) bool {
+	if len(sellOrder.AllowedBuyers) == 0 {
+		return true
+	}
+
+	for _, allowed := range sellOrder.AllowedBuyers {
+		if buyer.Equals(sdk.AccAddress(allowed)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// runInCacheContext runs fn in a cache context and only writes the state changes
+// and events of fn if fn does not return an error.
+func runInCacheContext(ctx context.Context, fn func(ctx context.Context) error) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, write := sdkCtx.CacheContext()
+	if err := fn(sdk.WrapSDKContext(cacheCtx)); err != nil {
+		return err
+	}
+
+	write()
+	sdkCtx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return nil
+}
+
+// pruneRange returns the range of timestamps to be processed by a BeginBlock
+// function that iterates over a time index up to the block time, starting from
+// the timestamp stored in its cursor. The start of the range is 1 ns when the
+// cursor is not set because nil timestamps are encoded as the 0 value timestamp
+// and must not be processed (https://github.com/cosmos/cosmos-sdk/issues/11980).
+// If the cursor is after the block time, ok is false and there is nothing to
+// process.
+func pruneRange(cursor *timestamppb.Timestamp, blockTime time.Time) (from, to *timestamppb.Timestamp, ok bool) {
+	from, to = timestamppb.New(time.Unix(0, 1)), timestamppb.New(blockTime)
+	if cursor != nil {
+		from = cursor
+	}
+	return from, to, !from.AsTime().After(blockTime)
+}
+
 type orderOptions struct {
 	autoRetire   bool
 	batchDenom   string
 	jurisdiction string
+
+	// reason and beneficiary are recorded with the retirement of the credits
+	// when the credits are auto-retired.
+	reason      string
+	beneficiary string
+
+	// fromEscrow indicates that the cost is paid from the coins held in escrow
+	// by the ecocredit module account rather than the buyer's account.
+	fromEscrow bool
+
+	// escrowedFee is the trading fee held in escrow for the buyer when the cost
+	// is paid from escrow. The buyer fee is paid from the escrowed fee and the
+	// remainder is returned to the buyer.
+	escrowedFee sdkmath.Int
+
+	// buyerMaker and sellerMaker indicate that the order of the buyer or the
+	// seller was on the market before the trade, in which case the maker fee is
+	// charged instead of the taker fee.
+	buyerMaker  bool
+	sellerMaker bool
+
+	// isolateHooks indicates that the credits are moved at the beginning or end
+	// of a block, in which case an error returned by the ecocredit hooks is
+	// logged rather than returned and the state changes of the hooks discarded.
+	isolateHooks bool
+}
+
+// settlement is the royalty and the trading fees paid when a trade is settled.
+type settlement struct {
+	royalty   sdk.Coin
+	buyerFee  sdk.Coin
+	sellerFee sdk.Coin
 }
 
 // fillOrder moves credits and coins according to the order. It will:
@@ -30,10 +115,122 @@ type orderOptions struct {
 // - remove the purchaseQty from the seller's escrowed balance.
 // - add credits to the buyer's tradable/retired address (based on the DisableAutoRetire field).
 // - update the supply accordingly.
-// - send the coins specified in the bid to the seller.
+// - send the cost minus the seller fee and the royalty to the seller (from the buyer or from escrow).
+// - pay the credit class royalty (if any) and the trading fees.
+// - record the trade at the ask price of the sell order in the given market.
 func (k Keeper) fillOrder(ctx context.Context, orderIndex string, sellOrder *api.SellOrder, buyerAcc sdk.AccAddress, purchaseQty math.Dec,
-	cost sdk.Coin, opts orderOptions) error {
+	cost sdk.Coin, marketID uint64, askAmount string, opts orderOptions) (settlement, error) {
+	if err := k.transferCredits(ctx, orderIndex, sellOrder, buyerAcc, purchaseQty, opts); err != nil {
+		return settlement{}, err
+	}
+
+	return k.settleTrade(ctx, buyerAcc, sellOrder.Seller, sellOrder.BatchKey, marketID, purchaseQty,
+		askAmount, cost, opts)
+}
+
+// settleTrade sends the cost of purchased credits to the seller and records the
+// trade at the given price per credit. The seller fee is deducted from the cost
+// and the credit class royalty (if any) is paid out of the remaining amount
+// before it is sent to the seller, whereas the buyer fee is paid by the buyer
+// in addition to the cost, or from the escrowed fee if the cost is paid from
+// escrow. If the royalty cannot be paid (e.g. the recipient is
+// not allowed to receive funds), the royalty is skipped and the seller receives
+// the full amount so that a royalty recipient cannot prevent trades from being
+// settled.
+func (k Keeper) settleTrade(ctx context.Context, buyerAcc, seller sdk.AccAddress, batchKey, marketID uint64, quantity math.Dec,
+	price string, cost sdk.Coin, opts orderOptions) (settlement, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	tradingFee, err := k.stateStore.TradingFeeTable().Get(ctx)
+	if err != nil {
+		return settlement{}, err
+	}
+
+	res := settlement{royalty: sdk.NewCoin(cost.Denom, sdk.ZeroInt())}
+	res.buyerFee, res.sellerFee = getTradeFees(tradingFee, cost, opts.buyerMaker, opts.sellerMaker)
+	proceeds := cost.Sub(res.sellerFee)
+
+	// the buyer fee cannot exceed the escrowed fee, which is only possible if
+	// the trading fee was raised after the fee was escrowed
+	if opts.fromEscrow && res.buyerFee.Amount.GT(opts.escrowedFee) {
+		res.buyerFee.Amount = opts.escrowedFee
+	}
+
+	classRoyalty, err := k.getClassRoyalty(ctx, batchKey)
+	if err != nil {
+		return res, err
+	}
+
+	// the royalty is not paid if the seller is the recipient of the royalty
+	if classRoyalty != nil && !sdk.AccAddress(classRoyalty.Recipient).Equals(seller) {
+		res.royalty.Amount = getRoyalty(proceeds.Amount, classRoyalty.RoyaltyBps)
+	}
+
+	if res.royalty.IsPositive() {
+		err = runInCacheContext(ctx, func(ctx context.Context) error {
+			return k.sendCoins(ctx, buyerAcc, classRoyalty.Recipient, res.royalty, opts.fromEscrow)
+		})
+		if err != nil {
+			logger(sdkCtx).Error(
+				"failed to pay class royalty",
+				"recipient", sdk.AccAddress(classRoyalty.Recipient).String(),
+				"err", err,
+			)
+			res.royalty.Amount = sdk.ZeroInt()
+		}
+	}
+
+	if err = k.sendCoins(ctx, buyerAcc, seller, proceeds.Sub(res.royalty), opts.fromEscrow); err != nil {
+		return res, err
+	}
+
+	fees := sdk.NewCoins(res.buyerFee.Add(res.sellerFee))
+	if err = k.chargeTradingFee(sdkCtx, buyerAcc, fees, tradingFee, opts.fromEscrow); err != nil {
+		return res, err
+	}
+
+	// the remainder of the escrowed fee is returned to the buyer
+	if opts.fromEscrow {
+		remainder := sdk.NewCoins(sdk.NewCoin(cost.Denom, opts.escrowedFee.Sub(res.buyerFee.Amount)))
+		if !remainder.IsZero() {
+			if err = k.bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, ecocredit.ModuleName, buyerAcc, remainder); err != nil {
+				return res, err
+			}
+		}
+	}
+
+	if err = k.stateStore.TradeTable().Insert(ctx, &api.Trade{
+		Buyer:     buyerAcc,
+		Seller:    seller,
+		BatchKey:  batchKey,
+		MarketId:  marketID,
+		Quantity:  quantity.String(),
+		Price:     price,
+		Timestamp: timestamppb.New(sdkCtx.BlockTime()),
+	}); err != nil {
+		return res, err
+	}
+
+	return res, nil
+}
+
+// sendCoins sends coins to the recipient from the buyer or from the coins held
+// in escrow by the ecocredit module account.
+func (k Keeper) sendCoins(ctx context.Context, buyerAcc, recipient sdk.AccAddress, coin sdk.Coin, fromEscrow bool) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if fromEscrow {
+		return k.bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, ecocredit.ModuleName, recipient, sdk.NewCoins(coin))
+	}
+
+	return k.bankKeeper.SendCoins(sdkCtx, buyerAcc, recipient, sdk.NewCoins(coin))
+}
+
+// transferCredits updates the sell order and moves the purchased credits from
+// the seller's escrowed balance to the buyer's tradable or retired balance. If
+// the sell order is filled, the sell order is removed and the open offers on
+// the sell order are rejected.
+func (k Keeper) transferCredits(ctx context.Context, orderIndex string, sellOrder *api.SellOrder, buyerAcc sdk.AccAddress,
+	purchaseQty math.Dec, opts orderOptions) error {
 	sellOrderQty, err := math.NewDecFromString(sellOrder.Quantity)
 	if err != nil {
 		return err
@@ -49,6 +246,15 @@ func (k Keeper) fillOrder(ctx context.Context, orderIndex string, sellOrder *api
 		if err := k.stateStore.SellOrderTable().Delete(ctx, sellOrder); err != nil {
 			return err
 		}
+		if err := k.deleteSellOrderAsks(ctx, sellOrder.Id); err != nil {
+			return err
+		}
+		if err := k.rejectSellOrderOffers(ctx, sellOrder.Id); err != nil {
+			return err
+		}
+		if err := k.orderBook.OnRemoveSellOrder(ctx, sellOrder.Id); err != nil {
+			return err
+		}
 	case math.GreaterThan:
 		newSellOrderQty, err := sellOrderQty.Sub(purchaseQty)
 		if err != nil {
@@ -60,8 +266,17 @@ func (k Keeper) fillOrder(ctx context.Context, orderIndex string, sellOrder *api
 		}
 	}
 
+	return k.transferEscrowedCredits(ctx, sellOrder.Seller, sellOrder.BatchKey, buyerAcc, purchaseQty, opts)
+}
+
+// transferEscrowedCredits moves credits from the seller's escrowed balance to
+// the buyer's tradable or retired balance, updating the supply accordingly.
+func (k Keeper) transferEscrowedCredits(ctx context.Context, seller sdk.AccAddress, batchKey uint64, buyerAcc sdk.AccAddress,
+	purchaseQty math.Dec, opts orderOptions) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
 	// remove the credits from the seller's escrowed balance
-	sellerBal, err := k.baseStore.BatchBalanceTable().Get(ctx, sellOrder.Seller, sellOrder.BatchKey)
+	sellerBal, err := k.baseStore.BatchBalanceTable().Get(ctx, seller, batchKey)
 	if err != nil {
 		return err
 	}
@@ -79,16 +294,17 @@ func (k Keeper) fillOrder(ctx context.Context, orderIndex string, sellOrder *api
 	}
 
 	// update the buyers balance and the batch supply
-	supply, err := k.baseStore.BatchSupplyTable().Get(ctx, sellOrder.BatchKey)
+	supply, err := k.baseStore.BatchSupplyTable().Get(ctx, batchKey)
 	if err != nil {
 		return err
 	}
-	buyerBal, err := utils.GetBalance(ctx, k.baseStore.BatchBalanceTable(), buyerAcc, sellOrder.BatchKey)
+	buyerBal, err := utils.GetBalance(ctx, k.baseStore.BatchBalanceTable(), buyerAcc, batchKey)
 	if err != nil {
 		return err
 	}
 	// if auto retire is disabled, we move the credits into the buyer's tradable balance.
 	// supply is not updated because supply does not distinguish between tradable and escrowed credits.
+	var retirementID uint64
 	if !opts.autoRetire {
 		tradableBalance, err := math.NewDecFromString(buyerBal.TradableAmount)
 		if err != nil {
@@ -100,7 +316,7 @@ func (k Keeper) fillOrder(ctx context.Context, orderIndex string, sellOrder *api
 		}
 		buyerBal.TradableAmount = tradableBalance.String()
 		if err = sdkCtx.EventManager().EmitTypedEvent(&basetypes.EventTransfer{
-			Sender:         sdk.AccAddress(sellOrder.Seller).String(),
+			Sender:         seller.String(),
 			Recipient:      buyerAcc.String(),
 			BatchDenom:     opts.batchDenom,
 			TradableAmount: purchaseQty.String(),
@@ -141,6 +357,17 @@ func (k Keeper) fillOrder(ctx context.Context, orderIndex string, sellOrder *api
 		if err = k.baseStore.BatchSupplyTable().Update(ctx, supply); err != nil {
 			return err
 		}
+		retirementID, err = basekeeper.SaveRetirement(ctx, k.baseStore.RetirementTable(), &baseapi.Retirement{
+			Owner:        buyerAcc,
+			BatchKey:     batchKey,
+			Amount:       purchaseQty.String(),
+			Jurisdiction: opts.jurisdiction,
+			Reason:       opts.reason,
+			Beneficiary:  opts.beneficiary,
+		})
+		if err != nil {
+			return err
+		}
 		if err = sdkCtx.EventManager().EmitTypedEvent(&basetypes.EventRetire{
 			Owner:        buyerAcc.String(),
 			BatchDenom:   opts.batchDenom,
@@ -154,19 +381,144 @@ func (k Keeper) fillOrder(ctx context.Context, orderIndex string, sellOrder *api
 		return err
 	}
 
-	return k.bankKeeper.SendCoins(sdkCtx, buyerAcc, sellOrder.Seller, sdk.NewCoins(cost))
+	callHooks := func(ctx context.Context) error {
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+		zero := math.NewDecFromInt64(0)
+		if !opts.autoRetire {
+			return k.hooks.AfterCreditsTransferred(sdkCtx, opts.batchDenom, seller, buyerAcc, purchaseQty, zero)
+		}
+		if err := k.hooks.AfterCreditsTransferred(sdkCtx, opts.batchDenom, seller, buyerAcc, zero, purchaseQty); err != nil {
+			return err
+		}
+		return k.hooks.AfterCreditsRetired(sdkCtx, opts.batchDenom, buyerAcc, purchaseQty, retirementID,
+			opts.jurisdiction, opts.reason, opts.beneficiary)
+	}
+	if !opts.isolateHooks {
+		return callHooks(ctx)
+	}
+
+	if err = runInCacheContext(ctx, callHooks); err != nil {
+		logger(sdkCtx).Error("ecocredit hook failed", "batch_denom", opts.batchDenom, "err", err)
+	}
+	return nil
 }
 
-// getTotalCost calculates the cost of the order by multiplying the price per credit, and the amount of credits
-// desired in the order.
-func getTotalCost(pricePerCredit sdkmath.Int, amtCredits math.Dec) (sdkmath.Int, error) {
-	unitPrice, err := math.NewPositiveFixedDecFromString(pricePerCredit.String(), amtCredits.NumDecimalPlaces())
+// getClassRoyalty returns the royalty of the credit class of the credit batch
+// or nil if the credit class does not have a royalty.
+func (k Keeper) getClassRoyalty(ctx context.Context, batchKey uint64) (*api.ClassRoyalty, error) {
+	batch, err := k.baseStore.BatchTable().Get(ctx, batchKey)
 	if err != nil {
-		return sdkmath.Int{}, err
+		return nil, err
 	}
-	cost, err := amtCredits.Mul(unitPrice)
+
+	project, err := k.baseStore.ProjectTable().Get(ctx, batch.ProjectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	royalty, err := k.stateStore.ClassRoyaltyTable().Get(ctx, project.ClassKey)
 	if err != nil {
-		return sdkmath.Int{}, err
+		if ormerrors.NotFound.Is(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return royalty, nil
+}
+
+// getRoyalty calculates the royalty for the proceeds given the royalty in
+// basis points, rounding down to the nearest integer.
+func getRoyalty(proceeds sdkmath.Int, royaltyBps uint32) sdkmath.Int {
+	return proceeds.MulRaw(int64(royaltyBps)).QuoRaw(types.MaxFeeBps)
+}
+
+// getTradingFee calculates the trading fee for the cost given the fee in basis
+// points, rounding down to the nearest integer.
+func getTradingFee(cost sdkmath.Int, feeBps uint32) sdkmath.Int {
+	return cost.MulRaw(int64(feeBps)).QuoRaw(types.MaxFeeBps)
+}
+
+// getTradeFees returns the trading fees charged to the buyer and the seller for
+// the cost. The maker fee is charged to a party whose order was on the market
+// before the trade and the taker fee otherwise.
+func getTradeFees(tradingFee *api.TradingFee, cost sdk.Coin, buyerMaker, sellerMaker bool) (sdk.Coin, sdk.Coin) {
+	feeBps := func(maker bool) uint32 {
+		if maker {
+			return tradingFee.MakerFeeBps
+		}
+		return tradingFee.TakerFeeBps
+	}
+	buyerFee := sdk.NewCoin(cost.Denom, getTradingFee(cost.Amount, feeBps(buyerMaker)))
+	sellerFee := sdk.NewCoin(cost.Denom, getTradingFee(cost.Amount, feeBps(sellerMaker)))
+	return buyerFee, sellerFee
+}
+
+// getEscrowFeeBps returns the trading fee in basis points that is held in escrow
+// with a bid, i.e. the higher of the maker and taker fees, such that the buyer
+// fee can be paid from escrow whether the buyer is the maker or the taker.
+func (k Keeper) getEscrowFeeBps(ctx context.Context) (uint32, error) {
+	tradingFee, err := k.stateStore.TradingFeeTable().Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if tradingFee.MakerFeeBps > tradingFee.TakerFeeBps {
+		return tradingFee.MakerFeeBps, nil
+	}
+	return tradingFee.TakerFeeBps, nil
+}
+
+// getEscrowAmount returns the amount held in escrow for a bid with the given
+// cost, i.e. the cost and the trading fee at the escrowed fee.
+func getEscrowAmount(cost sdkmath.Int, escrowedFeeBps uint32) sdkmath.Int {
+	return cost.Add(getTradingFee(cost, escrowedFeeBps))
+}
+
+// chargeTradeFees charges the buyer fee to the buyer and the seller fee out of
+// the cost of the trade, i.e. from the buyer's account or from the coins held
+// in escrow by the ecocredit module account.
+func (k Keeper) chargeTradeFees(ctx sdk.Context, buyerAcc sdk.AccAddress, buyerFee, sellerFee sdk.Coins,
+	tradingFee *api.TradingFee, fromEscrow bool) error {
+	if !fromEscrow {
+		return k.chargeTradingFee(ctx, buyerAcc, buyerFee.Add(sellerFee...), tradingFee, false)
+	}
+
+	if err := k.chargeTradingFee(ctx, buyerAcc, buyerFee, tradingFee, false); err != nil {
+		return err
+	}
+
+	return k.chargeTradingFee(ctx, nil, sellerFee, tradingFee, true)
+}
+
+// chargeTradingFee sends the trading fee from the payer, or from the coins held
+// in escrow by the ecocredit module account, to the destination set in the
+// trading fee, i.e. the fee is burned, sent to the community pool, or sent to a
+// module account.
+func (k Keeper) chargeTradingFee(ctx sdk.Context, payer sdk.AccAddress, fee sdk.Coins, tradingFee *api.TradingFee,
+	fromEscrow bool) error {
+	if fee.IsZero() {
+		return nil
+	}
+
+	switch tradingFee.Destination {
+	case api.FeeDestination_FEE_DESTINATION_BURN:
+		if !fromEscrow {
+			if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, ecocredit.ModuleName, fee); err != nil {
+				return err
+			}
+		}
+		return k.bankKeeper.BurnCoins(ctx, ecocredit.ModuleName, fee)
+	case api.FeeDestination_FEE_DESTINATION_COMMUNITY_POOL:
+		if fromEscrow {
+			payer = k.accountKeeper.GetModuleAddress(ecocredit.ModuleName)
+		}
+		return k.distrKeeper.FundCommunityPool(ctx, fee, payer)
+	case api.FeeDestination_FEE_DESTINATION_MODULE_ACCOUNT:
+		if fromEscrow {
+			return k.bankKeeper.SendCoinsFromModuleToModule(ctx, ecocredit.ModuleName, tradingFee.ModuleAccount, fee)
+		}
+		return k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, tradingFee.ModuleAccount, fee)
+	default:
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid trading fee destination: %s", tradingFee.Destination)
 	}
-	return cost.SdkIntTrim(), nil
 }
@@ -0,0 +1,116 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	basetypes "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/simulation/utils"
+)
+
+const OpWeightMsgBuyOrder = "op_weight_msg_buy_order" //nolint:gosec
+
+const WeightBuyOrder = 100
+
+var TypeMsgBuyOrder = types.MsgBuyOrder{}.Route()
+
+// SimulateMsgBuyOrder generates a Marketplace/MsgBuyOrder with random values.
+func SimulateMsgBuyOrder(ak ecocredit.AccountKeeper, bk ecocredit.BankKeeper,
+	qryClient basetypes.QueryServer, mktQryClient types.QueryServer) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, sdkCtx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		buyer, _ := simtypes.RandomAcc(r, accs)
+		buyerAddr := buyer.Address.String()
+
+		ctx := sdk.WrapSDKContext(sdkCtx)
+		result, err := mktQryClient.SellOrders(ctx, &types.QuerySellOrdersRequest{})
+		if err != nil {
+			return simtypes.NoOpMsg(ecocredit.ModuleName, TypeMsgBuyOrder, err.Error()), nil, err
+		}
+
+		sellOrders := result.SellOrders
+		if len(sellOrders) == 0 {
+			return simtypes.NoOpMsg(ecocredit.ModuleName, TypeMsgBuyOrder, "no sell orders"), nil, nil
+		}
+
+		// bid on the credit batch of a random sell order
+		sellOrder := sellOrders[r.Intn(len(sellOrders))]
+		if sellOrder.Seller == buyerAddr {
+			return simtypes.NoOpMsg(ecocredit.ModuleName, TypeMsgBuyOrder, "buyer is the seller"), nil, nil
+		}
+
+		askAmount, ok := sdk.NewIntFromString(sellOrder.AskAmount)
+		if !ok {
+			return simtypes.NoOpMsg(ecocredit.ModuleName, TypeMsgBuyOrder, "could not convert to int"), nil, nil
+		}
+
+		quantity, err := math.NewDecFromString(sellOrder.Quantity)
+		if err != nil {
+			return simtypes.NoOpMsg(ecocredit.ModuleName, TypeMsgBuyOrder, err.Error()), nil, nil
+		}
+
+		// the bid price may be lower than the ask price in which case the buy order
+		// remains in the order book until it is matched with another sell order
+		bidAmount := askAmount.Add(sdk.NewInt(int64(simtypes.RandIntBetween(r, 0, 100)))).Sub(sdk.NewInt(50))
+		if !bidAmount.IsPositive() {
+			bidAmount = askAmount
+		}
+		bidPrice := sdk.NewCoin(sellOrder.AskDenom, bidAmount)
+
+		spendable, account, op, err := utils.GetAccountAndSpendableCoins(sdkCtx, bk, accs, buyerAddr, TypeMsgBuyOrder)
+		if spendable == nil {
+			return op, nil, err
+		}
+
+		total, err := math.NewDecFromString(bidAmount.String())
+		if err != nil {
+			return simtypes.NoOpMsg(ecocredit.ModuleName, TypeMsgBuyOrder, err.Error()), nil, nil
+		}
+		total, err = total.Mul(quantity)
+		if err != nil {
+			return simtypes.NoOpMsg(ecocredit.ModuleName, TypeMsgBuyOrder, err.Error()), nil, nil
+		}
+		if spendable.AmountOf(bidPrice.Denom).LT(total.SdkIntTrim()) {
+			return simtypes.NoOpMsg(ecocredit.ModuleName, TypeMsgBuyOrder, "insufficient funds"), nil, nil
+		}
+
+		msg := &types.MsgBuyOrder{
+			Buyer: buyerAddr,
+			Orders: []*types.MsgBuyOrder_Order{
+				{
+					BatchDenom:             sellOrder.BatchDenom,
+					Quantity:               sellOrder.Quantity,
+					BidPrice:               &bidPrice,
+					DisableAutoRetire:      sellOrder.DisableAutoRetire,
+					RetirementJurisdiction: "AQ",
+				},
+			},
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simapp.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         sdkCtx,
+			SimAccount:      *account,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      ecocredit.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return utils.GenAndDeliverTxWithRandFees(r, txCtx)
+	}
+}
@@ -0,0 +1,73 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	basetypes "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/simulation/utils"
+)
+
+const OpWeightMsgCancelBuyOrder = "op_weight_msg_cancel_buy_order" //nolint:gosec
+
+const WeightCancelBuyOrder = 100
+
+var TypeMsgCancelBuyOrder = types.MsgCancelBuyOrder{}.Route()
+
+// SimulateMsgCancelBuyOrder generates a Marketplace/MsgCancelBuyOrder with random values.
+func SimulateMsgCancelBuyOrder(ak ecocredit.AccountKeeper, bk ecocredit.BankKeeper,
+	qryClient basetypes.QueryServer, mktQryClient types.QueryServer) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, sdkCtx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		buyer, _ := simtypes.RandomAcc(r, accs)
+		buyerAddr := buyer.Address.String()
+
+		ctx := sdk.WrapSDKContext(sdkCtx)
+		result, err := mktQryClient.BuyOrdersByBuyer(ctx, &types.QueryBuyOrdersByBuyerRequest{Buyer: buyerAddr})
+		if err != nil {
+			return simtypes.NoOpMsg(ecocredit.ModuleName, TypeMsgCancelBuyOrder, err.Error()), nil, err
+		}
+
+		orders := result.BuyOrders
+		if len(orders) == 0 {
+			return simtypes.NoOpMsg(ecocredit.ModuleName, TypeMsgCancelBuyOrder, "no buy orders present"), nil, nil
+		}
+
+		// select random order
+		order := orders[r.Intn(len(orders))]
+		msg := &types.MsgCancelBuyOrder{
+			Buyer:      buyerAddr,
+			BuyOrderId: order.Id,
+		}
+
+		spendable, account, op, err := utils.GetAccountAndSpendableCoins(sdkCtx, bk, accs, buyerAddr, TypeMsgCancelBuyOrder)
+		if spendable == nil {
+			return op, nil, err
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simapp.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         sdkCtx,
+			SimAccount:      *account,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      ecocredit.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return utils.GenAndDeliverTxWithRandFees(r, txCtx)
+	}
+}
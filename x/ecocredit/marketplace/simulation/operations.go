@@ -26,6 +26,8 @@ func WeightedOperations(
 		weightMsgCancelSellOrder    int
 		weightMsgAddAllowedDenom    int
 		weightMsgRemoveAllowedDenom int
+		weightMsgBuyOrder           int
+		weightMsgCancelBuyOrder     int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgBuy, &weightMsgBuyDirect, nil,
@@ -64,6 +66,18 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgBuyOrder, &weightMsgBuyOrder, nil,
+		func(_ *rand.Rand) {
+			weightMsgBuyOrder = WeightBuyOrder
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCancelBuyOrder, &weightMsgCancelBuyOrder, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelBuyOrder = WeightCancelBuyOrder
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgBuyDirect,
@@ -89,5 +103,13 @@ func WeightedOperations(
 			weightMsgRemoveAllowedDenom,
 			SimulateMsgRemoveAllowedDenom(ak, bk, mktQryClient, govk, authority),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgBuyOrder,
+			SimulateMsgBuyOrder(ak, bk, qryClient, mktQryClient),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCancelBuyOrder,
+			SimulateMsgCancelBuyOrder(ak, bk, qryClient, mktQryClient),
+		),
 	}
 }
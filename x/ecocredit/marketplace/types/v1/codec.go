@@ -20,6 +20,20 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateSellOrders{}, "regen.marketplace/MsgUpdateSellOrders", nil)
 	cdc.RegisterConcrete(&MsgRemoveAllowedDenom{}, "regen.marketplace/MsgRemoveAllowedDenom", nil)
 	cdc.RegisterConcrete(&MsgAddAllowedDenom{}, "regen.marketplace/MsgAddAllowedDenom", nil)
+	cdc.RegisterConcrete(&MsgBuyOrder{}, "regen.marketplace/MsgBuyOrder", nil)
+	cdc.RegisterConcrete(&MsgCancelBuyOrder{}, "regen.marketplace/MsgCancelBuyOrder", nil)
+	cdc.RegisterConcrete(&MsgUpdateTradingFee{}, "regen.marketplace/MsgUpdateTradingFee", nil)
+	cdc.RegisterConcrete(&MsgUpdateClassRoyalty{}, "regen.marketplace/MsgUpdateClassRoyalty", nil)
+	cdc.RegisterConcrete(&MsgCreateAuction{}, "regen.marketplace/MsgCreateAuction", nil)
+	cdc.RegisterConcrete(&MsgBidAuction{}, "regen.marketplace/MsgBidAuction", nil)
+	cdc.RegisterConcrete(&MsgMakeOffer{}, "regen.marketplace/MsgMakeOffer", nil)
+	cdc.RegisterConcrete(&MsgAcceptOffer{}, "regen.marketplace/MsgAcceptOffer", nil)
+	cdc.RegisterConcrete(&MsgRejectOffer{}, "regen.marketplace/MsgRejectOffer", nil)
+	cdc.RegisterConcrete(&MsgCounterOffer{}, "regen.marketplace/MsgCounterOffer", nil)
+	cdc.RegisterConcrete(&MsgProposeSwap{}, "regen.marketplace/MsgProposeSwap", nil)
+	cdc.RegisterConcrete(&MsgAcceptSwap{}, "regen.marketplace/MsgAcceptSwap", nil)
+	cdc.RegisterConcrete(&MsgCreateForwardContract{}, "regen.marketplace/MsgCreateForwardContract", nil)
+	cdc.RegisterConcrete(&MsgCancelSellOrders{}, "regen.marketplace/MsgCancelSellOrders", nil)
 }
 
 var (
@@ -5,6 +5,9 @@ package v1
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -73,6 +76,18 @@ type EventBuyDirect struct {
 	// sell_order_id is the unique identifier of the sell order that credits were
 	// purchased from.
 	SellOrderId uint64 `protobuf:"varint,1,opt,name=sell_order_id,json=sellOrderId,proto3" json:"sell_order_id,omitempty"`
+	// buyer_fee is the trading fee charged to the buyer.
+	//
+	// Since Revision 1
+	BuyerFee *types.Coin `protobuf:"bytes,2,opt,name=buyer_fee,json=buyerFee,proto3" json:"buyer_fee,omitempty"`
+	// seller_fee is the trading fee charged to the seller.
+	//
+	// Since Revision 1
+	SellerFee *types.Coin `protobuf:"bytes,3,opt,name=seller_fee,json=sellerFee,proto3" json:"seller_fee,omitempty"`
+	// royalty is the royalty paid to the recipient of the credit class royalty.
+	//
+	// Since Revision 1
+	Royalty *types.Coin `protobuf:"bytes,4,opt,name=royalty,proto3" json:"royalty,omitempty"`
 }
 
 func (m *EventBuyDirect) Reset()         { *m = EventBuyDirect{} }
@@ -115,6 +130,27 @@ func (m *EventBuyDirect) GetSellOrderId() uint64 {
 	return 0
 }
 
+func (m *EventBuyDirect) GetBuyerFee() *types.Coin {
+	if m != nil {
+		return m.BuyerFee
+	}
+	return nil
+}
+
+func (m *EventBuyDirect) GetSellerFee() *types.Coin {
+	if m != nil {
+		return m.SellerFee
+	}
+	return nil
+}
+
+func (m *EventBuyDirect) GetRoyalty() *types.Coin {
+	if m != nil {
+		return m.Royalty
+	}
+	return nil
+}
+
 // EventUpdateSellOrder is an event emitted when a sell order is updated.
 type EventUpdateSellOrder struct {
 	//  sell_order_id is the unique identifier of the sell order that was updated.
@@ -306,338 +342,4200 @@ func (m *EventRemoveAllowedDenom) GetDenom() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*EventSell)(nil), "regen.ecocredit.marketplace.v1.EventSell")
-	proto.RegisterType((*EventBuyDirect)(nil), "regen.ecocredit.marketplace.v1.EventBuyDirect")
-	proto.RegisterType((*EventUpdateSellOrder)(nil), "regen.ecocredit.marketplace.v1.EventUpdateSellOrder")
-	proto.RegisterType((*EventCancelSellOrder)(nil), "regen.ecocredit.marketplace.v1.EventCancelSellOrder")
-	proto.RegisterType((*EventAllowDenom)(nil), "regen.ecocredit.marketplace.v1.EventAllowDenom")
-	proto.RegisterType((*EventRemoveAllowedDenom)(nil), "regen.ecocredit.marketplace.v1.EventRemoveAllowedDenom")
+// EventBuyOrder is an event emitted when a buy order is created.
+//
+// Since Revision 1
+type EventBuyOrder struct {
+	// buy_order_id is the unique identifier of the buy order that was created.
+	BuyOrderId uint64 `protobuf:"varint,1,opt,name=buy_order_id,json=buyOrderId,proto3" json:"buy_order_id,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("regen/ecocredit/marketplace/v1/events.proto", fileDescriptor_68b71b54d42cf1d9)
+func (m *EventBuyOrder) Reset()         { *m = EventBuyOrder{} }
+func (m *EventBuyOrder) String() string { return proto.CompactTextString(m) }
+func (*EventBuyOrder) ProtoMessage()    {}
+func (*EventBuyOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{6}
 }
-
-var fileDescriptor_68b71b54d42cf1d9 = []byte{
-	// 282 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0x3f, 0x4b, 0xc3, 0x40,
-	0x1c, 0x86, 0x13, 0x50, 0xa1, 0x27, 0x2a, 0x84, 0x82, 0x4e, 0x87, 0x64, 0x51, 0x10, 0x73, 0x04,
-	0x9d, 0xdc, 0xac, 0x55, 0x70, 0x12, 0x52, 0x5c, 0x5c, 0x6a, 0x7a, 0xf7, 0x12, 0x43, 0x2f, 0xb9,
-	0x70, 0xb9, 0x5e, 0xed, 0xb7, 0xf0, 0x63, 0x39, 0x76, 0x74, 0x94, 0xe4, 0x8b, 0x48, 0xce, 0x3f,
-	0xb8, 0x28, 0xed, 0x76, 0xef, 0x7b, 0xcf, 0xb3, 0xfc, 0x5e, 0x72, 0xa2, 0x91, 0xa1, 0x64, 0xe0,
-	0x8a, 0x6b, 0x88, 0xdc, 0xb0, 0x22, 0xd5, 0x53, 0x98, 0x4a, 0xa6, 0x1c, 0xcc, 0xc6, 0x0c, 0x16,
-	0xa5, 0xa9, 0xa3, 0x4a, 0x2b, 0xa3, 0x02, 0xea, 0xe0, 0xe8, 0x07, 0x8e, 0x7e, 0xc1, 0x91, 0x8d,
-	0x43, 0x46, 0x7a, 0xd7, 0x1d, 0x3f, 0x82, 0x94, 0x41, 0x48, 0x76, 0x6a, 0x48, 0x39, 0x56, 0x5a,
-	0x40, 0x8f, 0x73, 0x71, 0xe0, 0x1f, 0xfa, 0xc7, 0x1b, 0xc9, 0x76, 0x57, 0xde, 0x75, 0xdd, 0xad,
-	0x08, 0xcf, 0xc9, 0xae, 0x13, 0x06, 0xb3, 0xc5, 0x30, 0xd7, 0xe0, 0x66, 0x25, 0xeb, 0x82, 0xf4,
-	0x9d, 0x75, 0x5f, 0x89, 0xd4, 0x60, 0xf4, 0xfd, 0xb3, 0x96, 0x7b, 0x95, 0x96, 0x1c, 0x72, 0x3d,
-	0xf7, 0x88, 0xec, 0x39, 0xf7, 0x52, 0x4a, 0x35, 0x1f, 0xa2, 0x54, 0x45, 0xd0, 0x27, 0x9b, 0xa2,
-	0x7b, 0x38, 0xbc, 0x97, 0x7c, 0x86, 0x90, 0x91, 0x7d, 0x07, 0x26, 0x28, 0x94, 0x85, 0xc3, 0x21,
-	0xfe, 0x11, 0x06, 0x8f, 0xaf, 0x0d, 0xf5, 0x97, 0x0d, 0xf5, 0xdf, 0x1b, 0xea, 0xbf, 0xb4, 0xd4,
-	0x5b, 0xb6, 0xd4, 0x7b, 0x6b, 0xa9, 0xf7, 0x70, 0x93, 0xe5, 0xe6, 0x69, 0x36, 0x89, 0xb8, 0x2a,
-	0x98, 0xbb, 0xfe, 0x69, 0x09, 0x33, 0x57, 0x7a, 0xfa, 0x95, 0x24, 0x44, 0x06, 0xcd, 0x9e, 0xff,
-	0x58, 0xd0, 0x2c, 0x2a, 0xd4, 0xcc, 0xc6, 0x93, 0x2d, 0xb7, 0xe0, 0xd9, 0x47, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x33, 0xb9, 0xd9, 0xe6, 0xf0, 0x01, 0x00, 0x00,
+func (m *EventBuyOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBuyOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBuyOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBuyOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBuyOrder.Merge(m, src)
+}
+func (m *EventBuyOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBuyOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBuyOrder.DiscardUnknown(m)
 }
 
-func (m *EventSell) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventBuyOrder proto.InternalMessageInfo
+
+func (m *EventBuyOrder) GetBuyOrderId() uint64 {
+	if m != nil {
+		return m.BuyOrderId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventSell) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventCancelBuyOrder is an event emitted when a buy order is cancelled.
+//
+// Since Revision 1
+type EventCancelBuyOrder struct {
+	// buy_order_id is the unique identifier of the buy order that was
+	// cancelled.
+	BuyOrderId uint64 `protobuf:"varint,1,opt,name=buy_order_id,json=buyOrderId,proto3" json:"buy_order_id,omitempty"`
 }
 
-func (m *EventSell) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SellOrderId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SellOrderId))
-		i--
-		dAtA[i] = 0x8
+func (m *EventCancelBuyOrder) Reset()         { *m = EventCancelBuyOrder{} }
+func (m *EventCancelBuyOrder) String() string { return proto.CompactTextString(m) }
+func (*EventCancelBuyOrder) ProtoMessage()    {}
+func (*EventCancelBuyOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{7}
+}
+func (m *EventCancelBuyOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCancelBuyOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCancelBuyOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventCancelBuyOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancelBuyOrder.Merge(m, src)
+}
+func (m *EventCancelBuyOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCancelBuyOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancelBuyOrder.DiscardUnknown(m)
 }
 
-func (m *EventBuyDirect) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventCancelBuyOrder proto.InternalMessageInfo
+
+func (m *EventCancelBuyOrder) GetBuyOrderId() uint64 {
+	if m != nil {
+		return m.BuyOrderId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventBuyDirect) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventFillBuyOrder is an event emitted when a buy order is matched with a
+// sell order in the order book and credits are purchased from the sell order.
+//
+// Since Revision 1
+type EventFillBuyOrder struct {
+	// buy_order_id is the unique identifier of the buy order that was filled.
+	BuyOrderId uint64 `protobuf:"varint,1,opt,name=buy_order_id,json=buyOrderId,proto3" json:"buy_order_id,omitempty"`
+	// sell_order_id is the unique identifier of the sell order that credits
+	// were purchased from.
+	SellOrderId uint64 `protobuf:"varint,2,opt,name=sell_order_id,json=sellOrderId,proto3" json:"sell_order_id,omitempty"`
+	// royalty is the royalty paid to the recipient of the credit class royalty.
+	Royalty *types.Coin `protobuf:"bytes,3,opt,name=royalty,proto3" json:"royalty,omitempty"`
+	// buyer_fee is the trading fee charged to the buyer.
+	BuyerFee *types.Coin `protobuf:"bytes,4,opt,name=buyer_fee,json=buyerFee,proto3" json:"buyer_fee,omitempty"`
+	// seller_fee is the trading fee charged to the seller.
+	SellerFee *types.Coin `protobuf:"bytes,5,opt,name=seller_fee,json=sellerFee,proto3" json:"seller_fee,omitempty"`
 }
 
-func (m *EventBuyDirect) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SellOrderId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SellOrderId))
-		i--
-		dAtA[i] = 0x8
+func (m *EventFillBuyOrder) Reset()         { *m = EventFillBuyOrder{} }
+func (m *EventFillBuyOrder) String() string { return proto.CompactTextString(m) }
+func (*EventFillBuyOrder) ProtoMessage()    {}
+func (*EventFillBuyOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{8}
+}
+func (m *EventFillBuyOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFillBuyOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFillBuyOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventFillBuyOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFillBuyOrder.Merge(m, src)
+}
+func (m *EventFillBuyOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFillBuyOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFillBuyOrder.DiscardUnknown(m)
 }
 
-func (m *EventUpdateSellOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventFillBuyOrder proto.InternalMessageInfo
+
+func (m *EventFillBuyOrder) GetBuyOrderId() uint64 {
+	if m != nil {
+		return m.BuyOrderId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventUpdateSellOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventFillBuyOrder) GetSellOrderId() uint64 {
+	if m != nil {
+		return m.SellOrderId
+	}
+	return 0
 }
 
-func (m *EventUpdateSellOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SellOrderId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SellOrderId))
-		i--
-		dAtA[i] = 0x8
+func (m *EventFillBuyOrder) GetRoyalty() *types.Coin {
+	if m != nil {
+		return m.Royalty
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *EventCancelSellOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventFillBuyOrder) GetBuyerFee() *types.Coin {
+	if m != nil {
+		return m.BuyerFee
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventCancelSellOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventFillBuyOrder) GetSellerFee() *types.Coin {
+	if m != nil {
+		return m.SellerFee
+	}
+	return nil
 }
 
-func (m *EventCancelSellOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SellOrderId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SellOrderId))
-		i--
-		dAtA[i] = 0x8
+// EventUpdateClassRoyalty is an event emitted when the royalty of a credit
+// class is updated.
+//
+// Since Revision 1
+type EventUpdateClassRoyalty struct {
+	// class_id is the unique identifier of the credit class.
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+}
+
+func (m *EventUpdateClassRoyalty) Reset()         { *m = EventUpdateClassRoyalty{} }
+func (m *EventUpdateClassRoyalty) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateClassRoyalty) ProtoMessage()    {}
+func (*EventUpdateClassRoyalty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{9}
+}
+func (m *EventUpdateClassRoyalty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateClassRoyalty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateClassRoyalty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventUpdateClassRoyalty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateClassRoyalty.Merge(m, src)
+}
+func (m *EventUpdateClassRoyalty) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateClassRoyalty) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateClassRoyalty.DiscardUnknown(m)
 }
 
-func (m *EventAllowDenom) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventUpdateClassRoyalty proto.InternalMessageInfo
+
+func (m *EventUpdateClassRoyalty) GetClassId() string {
+	if m != nil {
+		return m.ClassId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventAllowDenom) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventCreateAuction is an event emitted when an auction is created.
+//
+// Since Revision 1
+type EventCreateAuction struct {
+	// auction_id is the unique identifier of the auction that was created.
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
 }
 
-func (m *EventAllowDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventCreateAuction) Reset()         { *m = EventCreateAuction{} }
+func (m *EventCreateAuction) String() string { return proto.CompactTextString(m) }
+func (*EventCreateAuction) ProtoMessage()    {}
+func (*EventCreateAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{10}
+}
+func (m *EventCreateAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCreateAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCreateAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventCreateAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCreateAuction.Merge(m, src)
+}
+func (m *EventCreateAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCreateAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCreateAuction.DiscardUnknown(m)
 }
 
-func (m *EventRemoveAllowedDenom) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventCreateAuction proto.InternalMessageInfo
+
+func (m *EventCreateAuction) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventRemoveAllowedDenom) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventBidAuction is an event emitted when a bid is placed on an auction.
+//
+// Since Revision 1
+type EventBidAuction struct {
+	// auction_id is the unique identifier of the auction.
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// bidder is the address of the account that placed the bid.
+	Bidder string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// bid_price is the price per credit of the bid. For a Dutch auction, this
+	// is the price of the auction at the time of the bid.
+	BidPrice *types.Coin `protobuf:"bytes,3,opt,name=bid_price,json=bidPrice,proto3" json:"bid_price,omitempty"`
 }
 
-func (m *EventRemoveAllowedDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventBidAuction) Reset()         { *m = EventBidAuction{} }
+func (m *EventBidAuction) String() string { return proto.CompactTextString(m) }
+func (*EventBidAuction) ProtoMessage()    {}
+func (*EventBidAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{11}
+}
+func (m *EventBidAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBidAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBidAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventBidAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBidAuction.Merge(m, src)
+}
+func (m *EventBidAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBidAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBidAuction.DiscardUnknown(m)
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_EventBidAuction proto.InternalMessageInfo
+
+func (m *EventBidAuction) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *EventSell) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *EventBidAuction) GetBidder() string {
+	if m != nil {
+		return m.Bidder
 	}
-	var l int
-	_ = l
-	if m.SellOrderId != 0 {
-		n += 1 + sovEvents(uint64(m.SellOrderId))
+	return ""
+}
+
+func (m *EventBidAuction) GetBidPrice() *types.Coin {
+	if m != nil {
+		return m.BidPrice
 	}
-	return n
+	return nil
 }
 
-func (m *EventBuyDirect) Size() (n int) {
-	if m == nil {
-		return 0
+// EventSettleAuction is an event emitted when an auction is settled.
+//
+// Since Revision 1
+type EventSettleAuction struct {
+	// auction_id is the unique identifier of the auction that was settled.
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// winner is the address of the account that won the auction. Empty if there
+	// were no bids and the credits were returned to the seller.
+	Winner string `protobuf:"bytes,2,opt,name=winner,proto3" json:"winner,omitempty"`
+	// royalty is the royalty paid to the recipient of the credit class royalty.
+	Royalty *types.Coin `protobuf:"bytes,3,opt,name=royalty,proto3" json:"royalty,omitempty"`
+	// buyer_fee is the trading fee charged to the buyer.
+	BuyerFee *types.Coin `protobuf:"bytes,4,opt,name=buyer_fee,json=buyerFee,proto3" json:"buyer_fee,omitempty"`
+	// seller_fee is the trading fee charged to the seller.
+	SellerFee *types.Coin `protobuf:"bytes,5,opt,name=seller_fee,json=sellerFee,proto3" json:"seller_fee,omitempty"`
+}
+
+func (m *EventSettleAuction) Reset()         { *m = EventSettleAuction{} }
+func (m *EventSettleAuction) String() string { return proto.CompactTextString(m) }
+func (*EventSettleAuction) ProtoMessage()    {}
+func (*EventSettleAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{12}
+}
+func (m *EventSettleAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSettleAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSettleAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.SellOrderId != 0 {
-		n += 1 + sovEvents(uint64(m.SellOrderId))
+}
+func (m *EventSettleAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSettleAuction.Merge(m, src)
+}
+func (m *EventSettleAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSettleAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSettleAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSettleAuction proto.InternalMessageInfo
+
+func (m *EventSettleAuction) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
 	}
-	return n
+	return 0
 }
 
-func (m *EventUpdateSellOrder) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSettleAuction) GetWinner() string {
+	if m != nil {
+		return m.Winner
 	}
-	var l int
-	_ = l
-	if m.SellOrderId != 0 {
-		n += 1 + sovEvents(uint64(m.SellOrderId))
+	return ""
+}
+
+func (m *EventSettleAuction) GetRoyalty() *types.Coin {
+	if m != nil {
+		return m.Royalty
 	}
-	return n
+	return nil
 }
 
-func (m *EventCancelSellOrder) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSettleAuction) GetBuyerFee() *types.Coin {
+	if m != nil {
+		return m.BuyerFee
 	}
-	var l int
-	_ = l
-	if m.SellOrderId != 0 {
-		n += 1 + sovEvents(uint64(m.SellOrderId))
+	return nil
+}
+
+func (m *EventSettleAuction) GetSellerFee() *types.Coin {
+	if m != nil {
+		return m.SellerFee
 	}
-	return n
+	return nil
 }
 
-func (m *EventAllowDenom) Size() (n int) {
-	if m == nil {
-		return 0
+// EventMakeOffer is an event emitted when an offer is made.
+//
+// Since Revision 1
+type EventMakeOffer struct {
+	// offer_id is the unique identifier of the offer that was made.
+	OfferId uint64 `protobuf:"varint,1,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+	// sell_order_id is the ID of the sell order the offer was made on.
+	SellOrderId uint64 `protobuf:"varint,2,opt,name=sell_order_id,json=sellOrderId,proto3" json:"sell_order_id,omitempty"`
+}
+
+func (m *EventMakeOffer) Reset()         { *m = EventMakeOffer{} }
+func (m *EventMakeOffer) String() string { return proto.CompactTextString(m) }
+func (*EventMakeOffer) ProtoMessage()    {}
+func (*EventMakeOffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{13}
+}
+func (m *EventMakeOffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMakeOffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMakeOffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+}
+func (m *EventMakeOffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMakeOffer.Merge(m, src)
+}
+func (m *EventMakeOffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMakeOffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMakeOffer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMakeOffer proto.InternalMessageInfo
+
+func (m *EventMakeOffer) GetOfferId() uint64 {
+	if m != nil {
+		return m.OfferId
 	}
-	return n
+	return 0
 }
 
-func (m *EventRemoveAllowedDenom) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMakeOffer) GetSellOrderId() uint64 {
+	if m != nil {
+		return m.SellOrderId
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return 0
+}
+
+// EventAcceptOffer is an event emitted when an offer is accepted.
+//
+// Since Revision 1
+type EventAcceptOffer struct {
+	// offer_id is the unique identifier of the offer that was accepted.
+	OfferId uint64 `protobuf:"varint,1,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+	// price is the price per credit at which the credits were bought.
+	Price *types.Coin `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	// royalty is the royalty paid to the recipient of the credit class royalty.
+	Royalty *types.Coin `protobuf:"bytes,3,opt,name=royalty,proto3" json:"royalty,omitempty"`
+	// buyer_fee is the trading fee charged to the buyer.
+	BuyerFee *types.Coin `protobuf:"bytes,4,opt,name=buyer_fee,json=buyerFee,proto3" json:"buyer_fee,omitempty"`
+	// seller_fee is the trading fee charged to the seller.
+	SellerFee *types.Coin `protobuf:"bytes,5,opt,name=seller_fee,json=sellerFee,proto3" json:"seller_fee,omitempty"`
+}
+
+func (m *EventAcceptOffer) Reset()         { *m = EventAcceptOffer{} }
+func (m *EventAcceptOffer) String() string { return proto.CompactTextString(m) }
+func (*EventAcceptOffer) ProtoMessage()    {}
+func (*EventAcceptOffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{14}
+}
+func (m *EventAcceptOffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAcceptOffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAcceptOffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *EventAcceptOffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAcceptOffer.Merge(m, src)
+}
+func (m *EventAcceptOffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAcceptOffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAcceptOffer.DiscardUnknown(m)
 }
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+var xxx_messageInfo_EventAcceptOffer proto.InternalMessageInfo
+
+func (m *EventAcceptOffer) GetOfferId() uint64 {
+	if m != nil {
+		return m.OfferId
+	}
+	return 0
 }
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *EventAcceptOffer) GetPrice() *types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return nil
 }
-func (m *EventSell) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+
+func (m *EventAcceptOffer) GetRoyalty() *types.Coin {
+	if m != nil {
+		return m.Royalty
+	}
+	return nil
+}
+
+func (m *EventAcceptOffer) GetBuyerFee() *types.Coin {
+	if m != nil {
+		return m.BuyerFee
+	}
+	return nil
+}
+
+func (m *EventAcceptOffer) GetSellerFee() *types.Coin {
+	if m != nil {
+		return m.SellerFee
+	}
+	return nil
+}
+
+// EventRejectOffer is an event emitted when an offer is rejected.
+//
+// Since Revision 1
+type EventRejectOffer struct {
+	// offer_id is the unique identifier of the offer that was rejected.
+	OfferId uint64 `protobuf:"varint,1,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+}
+
+func (m *EventRejectOffer) Reset()         { *m = EventRejectOffer{} }
+func (m *EventRejectOffer) String() string { return proto.CompactTextString(m) }
+func (*EventRejectOffer) ProtoMessage()    {}
+func (*EventRejectOffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{15}
+}
+func (m *EventRejectOffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRejectOffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRejectOffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRejectOffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRejectOffer.Merge(m, src)
+}
+func (m *EventRejectOffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRejectOffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRejectOffer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRejectOffer proto.InternalMessageInfo
+
+func (m *EventRejectOffer) GetOfferId() uint64 {
+	if m != nil {
+		return m.OfferId
+	}
+	return 0
+}
+
+// EventCounterOffer is an event emitted when a counter offer is made.
+//
+// Since Revision 1
+type EventCounterOffer struct {
+	// offer_id is the unique identifier of the offer.
+	OfferId uint64 `protobuf:"varint,1,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+	// sender is the address of the account that made the counter offer.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// price is the price per credit of the counter offer.
+	Price *types.Coin `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (m *EventCounterOffer) Reset()         { *m = EventCounterOffer{} }
+func (m *EventCounterOffer) String() string { return proto.CompactTextString(m) }
+func (*EventCounterOffer) ProtoMessage()    {}
+func (*EventCounterOffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{16}
+}
+func (m *EventCounterOffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCounterOffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCounterOffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCounterOffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCounterOffer.Merge(m, src)
+}
+func (m *EventCounterOffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCounterOffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCounterOffer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCounterOffer proto.InternalMessageInfo
+
+func (m *EventCounterOffer) GetOfferId() uint64 {
+	if m != nil {
+		return m.OfferId
+	}
+	return 0
+}
+
+func (m *EventCounterOffer) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventCounterOffer) GetPrice() *types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return nil
+}
+
+// EventProposeSwap is an event emitted when a swap is proposed.
+//
+// Since Revision 1
+type EventProposeSwap struct {
+	// swap_id is the unique identifier of the swap that was proposed.
+	SwapId uint64 `protobuf:"varint,1,opt,name=swap_id,json=swapId,proto3" json:"swap_id,omitempty"`
+	// counterparty is the address of the counterparty of the swap.
+	Counterparty string `protobuf:"bytes,2,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+}
+
+func (m *EventProposeSwap) Reset()         { *m = EventProposeSwap{} }
+func (m *EventProposeSwap) String() string { return proto.CompactTextString(m) }
+func (*EventProposeSwap) ProtoMessage()    {}
+func (*EventProposeSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{17}
+}
+func (m *EventProposeSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventProposeSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventProposeSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventProposeSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventProposeSwap.Merge(m, src)
+}
+func (m *EventProposeSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventProposeSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventProposeSwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventProposeSwap proto.InternalMessageInfo
+
+func (m *EventProposeSwap) GetSwapId() uint64 {
+	if m != nil {
+		return m.SwapId
+	}
+	return 0
+}
+
+func (m *EventProposeSwap) GetCounterparty() string {
+	if m != nil {
+		return m.Counterparty
+	}
+	return ""
+}
+
+// EventAcceptSwap is an event emitted when a swap is accepted.
+//
+// Since Revision 1
+type EventAcceptSwap struct {
+	// swap_id is the unique identifier of the swap that was accepted.
+	SwapId uint64 `protobuf:"varint,1,opt,name=swap_id,json=swapId,proto3" json:"swap_id,omitempty"`
+	// proposer_fee is the trading fee charged to the proposer on the coins
+	// offered in the swap.
+	ProposerFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=proposer_fee,json=proposerFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"proposer_fee"`
+	// counterparty_fee is the trading fee charged to the counterparty on the
+	// coins offered in the swap.
+	CounterpartyFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=counterparty_fee,json=counterpartyFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"counterparty_fee"`
+}
+
+func (m *EventAcceptSwap) Reset()         { *m = EventAcceptSwap{} }
+func (m *EventAcceptSwap) String() string { return proto.CompactTextString(m) }
+func (*EventAcceptSwap) ProtoMessage()    {}
+func (*EventAcceptSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{18}
+}
+func (m *EventAcceptSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAcceptSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAcceptSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAcceptSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAcceptSwap.Merge(m, src)
+}
+func (m *EventAcceptSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAcceptSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAcceptSwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAcceptSwap proto.InternalMessageInfo
+
+func (m *EventAcceptSwap) GetSwapId() uint64 {
+	if m != nil {
+		return m.SwapId
+	}
+	return 0
+}
+
+func (m *EventAcceptSwap) GetProposerFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ProposerFee
+	}
+	return nil
+}
+
+func (m *EventAcceptSwap) GetCounterpartyFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CounterpartyFee
+	}
+	return nil
+}
+
+// EventCreateForwardContract is an event emitted when a forward contract is
+// created.
+//
+// Since Revision 1
+type EventCreateForwardContract struct {
+	// forward_contract_id is the unique identifier of the forward contract that
+	// was created.
+	ForwardContractId uint64 `protobuf:"varint,1,opt,name=forward_contract_id,json=forwardContractId,proto3" json:"forward_contract_id,omitempty"`
+}
+
+func (m *EventCreateForwardContract) Reset()         { *m = EventCreateForwardContract{} }
+func (m *EventCreateForwardContract) String() string { return proto.CompactTextString(m) }
+func (*EventCreateForwardContract) ProtoMessage()    {}
+func (*EventCreateForwardContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{19}
+}
+func (m *EventCreateForwardContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCreateForwardContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCreateForwardContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCreateForwardContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCreateForwardContract.Merge(m, src)
+}
+func (m *EventCreateForwardContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCreateForwardContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCreateForwardContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCreateForwardContract proto.InternalMessageInfo
+
+func (m *EventCreateForwardContract) GetForwardContractId() uint64 {
+	if m != nil {
+		return m.ForwardContractId
+	}
+	return 0
+}
+
+// EventDeliverForwardContract is an event emitted when credits are delivered
+// for a forward contract and the escrowed payment is released to the issuer.
+//
+// Since Revision 1
+type EventDeliverForwardContract struct {
+	// forward_contract_id is the unique identifier of the forward contract.
+	ForwardContractId uint64 `protobuf:"varint,1,opt,name=forward_contract_id,json=forwardContractId,proto3" json:"forward_contract_id,omitempty"`
+	// batch_denom is the denom of the credit batch the credits were delivered
+	// from.
+	BatchDenom string `protobuf:"bytes,2,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// quantity is the quantity of credits delivered.
+	Quantity string `protobuf:"bytes,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// payment is the payment released to the issuer for the credits delivered.
+	Payment *types.Coin `protobuf:"bytes,4,opt,name=payment,proto3" json:"payment,omitempty"`
+}
+
+func (m *EventDeliverForwardContract) Reset()         { *m = EventDeliverForwardContract{} }
+func (m *EventDeliverForwardContract) String() string { return proto.CompactTextString(m) }
+func (*EventDeliverForwardContract) ProtoMessage()    {}
+func (*EventDeliverForwardContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{20}
+}
+func (m *EventDeliverForwardContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDeliverForwardContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDeliverForwardContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDeliverForwardContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDeliverForwardContract.Merge(m, src)
+}
+func (m *EventDeliverForwardContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDeliverForwardContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDeliverForwardContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDeliverForwardContract proto.InternalMessageInfo
+
+func (m *EventDeliverForwardContract) GetForwardContractId() uint64 {
+	if m != nil {
+		return m.ForwardContractId
+	}
+	return 0
+}
+
+func (m *EventDeliverForwardContract) GetBatchDenom() string {
+	if m != nil {
+		return m.BatchDenom
+	}
+	return ""
+}
+
+func (m *EventDeliverForwardContract) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
+	}
+	return ""
+}
+
+func (m *EventDeliverForwardContract) GetPayment() *types.Coin {
+	if m != nil {
+		return m.Payment
+	}
+	return nil
+}
+
+// EventSellOrderExpired is an event emitted when a sell order is removed from
+// state because it has expired and the escrowed credits are returned to the
+// tradable balance of the seller.
+//
+// Since Revision 1
+type EventSellOrderExpired struct {
+	// sell_order_id is the unique identifier of the sell order that expired.
+	SellOrderId uint64 `protobuf:"varint,1,opt,name=sell_order_id,json=sellOrderId,proto3" json:"sell_order_id,omitempty"`
+}
+
+func (m *EventSellOrderExpired) Reset()         { *m = EventSellOrderExpired{} }
+func (m *EventSellOrderExpired) String() string { return proto.CompactTextString(m) }
+func (*EventSellOrderExpired) ProtoMessage()    {}
+func (*EventSellOrderExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{21}
+}
+func (m *EventSellOrderExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSellOrderExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSellOrderExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSellOrderExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSellOrderExpired.Merge(m, src)
+}
+func (m *EventSellOrderExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSellOrderExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSellOrderExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSellOrderExpired proto.InternalMessageInfo
+
+func (m *EventSellOrderExpired) GetSellOrderId() uint64 {
+	if m != nil {
+		return m.SellOrderId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EventSell)(nil), "regen.ecocredit.marketplace.v1.EventSell")
+	proto.RegisterType((*EventBuyDirect)(nil), "regen.ecocredit.marketplace.v1.EventBuyDirect")
+	proto.RegisterType((*EventUpdateSellOrder)(nil), "regen.ecocredit.marketplace.v1.EventUpdateSellOrder")
+	proto.RegisterType((*EventCancelSellOrder)(nil), "regen.ecocredit.marketplace.v1.EventCancelSellOrder")
+	proto.RegisterType((*EventAllowDenom)(nil), "regen.ecocredit.marketplace.v1.EventAllowDenom")
+	proto.RegisterType((*EventRemoveAllowedDenom)(nil), "regen.ecocredit.marketplace.v1.EventRemoveAllowedDenom")
+	proto.RegisterType((*EventBuyOrder)(nil), "regen.ecocredit.marketplace.v1.EventBuyOrder")
+	proto.RegisterType((*EventCancelBuyOrder)(nil), "regen.ecocredit.marketplace.v1.EventCancelBuyOrder")
+	proto.RegisterType((*EventFillBuyOrder)(nil), "regen.ecocredit.marketplace.v1.EventFillBuyOrder")
+	proto.RegisterType((*EventUpdateClassRoyalty)(nil), "regen.ecocredit.marketplace.v1.EventUpdateClassRoyalty")
+	proto.RegisterType((*EventCreateAuction)(nil), "regen.ecocredit.marketplace.v1.EventCreateAuction")
+	proto.RegisterType((*EventBidAuction)(nil), "regen.ecocredit.marketplace.v1.EventBidAuction")
+	proto.RegisterType((*EventSettleAuction)(nil), "regen.ecocredit.marketplace.v1.EventSettleAuction")
+	proto.RegisterType((*EventMakeOffer)(nil), "regen.ecocredit.marketplace.v1.EventMakeOffer")
+	proto.RegisterType((*EventAcceptOffer)(nil), "regen.ecocredit.marketplace.v1.EventAcceptOffer")
+	proto.RegisterType((*EventRejectOffer)(nil), "regen.ecocredit.marketplace.v1.EventRejectOffer")
+	proto.RegisterType((*EventCounterOffer)(nil), "regen.ecocredit.marketplace.v1.EventCounterOffer")
+	proto.RegisterType((*EventProposeSwap)(nil), "regen.ecocredit.marketplace.v1.EventProposeSwap")
+	proto.RegisterType((*EventAcceptSwap)(nil), "regen.ecocredit.marketplace.v1.EventAcceptSwap")
+	proto.RegisterType((*EventCreateForwardContract)(nil), "regen.ecocredit.marketplace.v1.EventCreateForwardContract")
+	proto.RegisterType((*EventDeliverForwardContract)(nil), "regen.ecocredit.marketplace.v1.EventDeliverForwardContract")
+	proto.RegisterType((*EventSellOrderExpired)(nil), "regen.ecocredit.marketplace.v1.EventSellOrderExpired")
+}
+
+func init() {
+	proto.RegisterFile("regen/ecocredit/marketplace/v1/events.proto", fileDescriptor_68b71b54d42cf1d9)
+}
+
+var fileDescriptor_68b71b54d42cf1d9 = []byte{
+	// 862 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcd, 0x6e, 0x23, 0x45,
+	0x10, 0xce, 0x38, 0xbf, 0xae, 0x64, 0xc9, 0xee, 0x6c, 0xd8, 0x64, 0x83, 0x70, 0xa2, 0xb9, 0x10,
+	0x09, 0x65, 0x86, 0x10, 0x04, 0x08, 0x4e, 0x1b, 0x67, 0x23, 0x45, 0x02, 0x65, 0x35, 0x11, 0x17,
+	0x2e, 0xa6, 0xa7, 0xbb, 0xec, 0x6d, 0x3c, 0x9e, 0x1e, 0x7a, 0xda, 0xf6, 0xce, 0x8d, 0x0b, 0x77,
+	0xc4, 0x63, 0xf0, 0x12, 0x5c, 0xf7, 0xb8, 0x47, 0x24, 0x24, 0x40, 0xc9, 0x1b, 0xf0, 0x04, 0xa8,
+	0x7f, 0x1c, 0x4f, 0x58, 0x11, 0x4f, 0x60, 0x2f, 0x39, 0xb9, 0xab, 0x5c, 0x5f, 0xd7, 0x37, 0x5f,
+	0x55, 0x77, 0x17, 0xbc, 0x2f, 0xb1, 0x87, 0x59, 0x84, 0x54, 0x50, 0x89, 0x8c, 0xab, 0x68, 0x40,
+	0x64, 0x1f, 0x55, 0x9e, 0x12, 0x8a, 0xd1, 0xe8, 0x20, 0xc2, 0x11, 0x66, 0xaa, 0x08, 0x73, 0x29,
+	0x94, 0xf0, 0x5b, 0x26, 0x38, 0xbc, 0x0a, 0x0e, 0x2b, 0xc1, 0xe1, 0xe8, 0x60, 0xbb, 0x45, 0x45,
+	0x31, 0x10, 0x45, 0x94, 0x90, 0x42, 0x83, 0x13, 0x54, 0xe4, 0x20, 0xa2, 0x82, 0x67, 0x16, 0xbf,
+	0xbd, 0xd1, 0x13, 0x3d, 0x61, 0x96, 0x91, 0x5e, 0x59, 0x6f, 0x10, 0x41, 0xf3, 0xa9, 0xce, 0x72,
+	0x8e, 0x69, 0xea, 0x07, 0x70, 0xaf, 0xc0, 0x34, 0xed, 0x08, 0xc9, 0x50, 0x76, 0x38, 0xdb, 0xf2,
+	0x76, 0xbd, 0xbd, 0x85, 0x78, 0x55, 0x3b, 0xcf, 0xb4, 0xef, 0x94, 0x05, 0xbf, 0x79, 0xf0, 0x96,
+	0x41, 0x1c, 0x0d, 0xcb, 0x63, 0x2e, 0x91, 0xaa, 0x3a, 0x30, 0xff, 0x63, 0x68, 0x26, 0xc3, 0x12,
+	0x65, 0xa7, 0x8b, 0xb8, 0xd5, 0xd8, 0xf5, 0xf6, 0x56, 0x3f, 0x7c, 0x1c, 0x5a, 0xc6, 0xa1, 0x66,
+	0x1c, 0x3a, 0xc6, 0x61, 0x5b, 0xf0, 0x2c, 0x5e, 0x31, 0xb1, 0x27, 0x88, 0xfe, 0xa7, 0x00, 0x7a,
+	0x1b, 0x07, 0x9c, 0x9f, 0x05, 0x6c, 0xda, 0x60, 0x8d, 0x3c, 0x84, 0x65, 0x29, 0x4a, 0x92, 0xaa,
+	0x72, 0x6b, 0x61, 0x16, 0x6c, 0x12, 0x19, 0x7c, 0x06, 0x1b, 0xe6, 0xe3, 0xbe, 0xca, 0x19, 0x51,
+	0x78, 0x3e, 0xf9, 0x80, 0x5a, 0xca, 0x4c, 0xb0, 0x6d, 0x92, 0x51, 0x4c, 0x6f, 0x87, 0x7d, 0x0f,
+	0xd6, 0x0d, 0xf6, 0x49, 0x9a, 0x8a, 0xf1, 0x31, 0x66, 0x62, 0xe0, 0x6f, 0xc0, 0x22, 0xd3, 0x0b,
+	0x13, 0xde, 0x8c, 0xad, 0x11, 0x44, 0xb0, 0x69, 0x02, 0x63, 0x1c, 0x88, 0x11, 0x9a, 0x70, 0x64,
+	0x37, 0x01, 0x0e, 0xe0, 0xde, 0xa4, 0x5c, 0x96, 0xce, 0x2e, 0xac, 0x25, 0xc3, 0xf2, 0x9f, 0x6c,
+	0x20, 0x71, 0xff, 0x9f, 0xb2, 0xe0, 0x13, 0x78, 0x58, 0xf9, 0x90, 0x5b, 0x00, 0xbf, 0x6f, 0xc0,
+	0x03, 0x83, 0x3c, 0xe1, 0xe9, 0x2d, 0x70, 0xaf, 0x2b, 0xd4, 0x78, 0xbd, 0x81, 0x2a, 0xe5, 0x9c,
+	0xaf, 0x5b, 0xce, 0xeb, 0x5d, 0xb7, 0xf0, 0x5f, 0xbb, 0x6e, 0xb1, 0x7e, 0xd7, 0x05, 0x1f, 0xc1,
+	0x66, 0xa5, 0x81, 0xda, 0x29, 0x29, 0x8a, 0xd8, 0x91, 0x79, 0x0c, 0x2b, 0x54, 0xdb, 0x13, 0x0d,
+	0x9a, 0xf1, 0xb2, 0xb1, 0x4f, 0x59, 0x70, 0x08, 0xbe, 0x55, 0x5c, 0x22, 0x51, 0xf8, 0x64, 0x48,
+	0x15, 0x17, 0x99, 0xff, 0x2e, 0x00, 0xb1, 0xcb, 0xa9, 0x6c, 0x4d, 0xe7, 0xd1, 0x6a, 0x7b, 0xae,
+	0x69, 0x8e, 0x38, 0xab, 0x07, 0xf1, 0x1f, 0xc1, 0x52, 0xc2, 0x19, 0x43, 0x69, 0x14, 0x6e, 0xc6,
+	0xce, 0x32, 0x3a, 0x71, 0xd6, 0xc9, 0x25, 0xa7, 0x35, 0x0e, 0xd9, 0x4a, 0xc2, 0xd9, 0x33, 0x1d,
+	0x1a, 0xfc, 0xe5, 0x39, 0xe2, 0xe7, 0xa8, 0x54, 0x8a, 0xf5, 0x59, 0x8c, 0x79, 0x96, 0x4d, 0x59,
+	0x58, 0xeb, 0xae, 0x94, 0xf8, 0xcc, 0x5d, 0x80, 0x5f, 0x92, 0x3e, 0x9e, 0x75, 0xbb, 0x28, 0x75,
+	0x65, 0x85, 0x5e, 0x4c, 0xbf, 0x76, 0xd9, 0xd8, 0xf5, 0x5a, 0x3b, 0xf8, 0xa1, 0x01, 0xf7, 0xed,
+	0xe9, 0xa7, 0x14, 0x73, 0x35, 0x73, 0xcf, 0x08, 0x16, 0x6d, 0xa5, 0x66, 0xde, 0xa3, 0x36, 0xee,
+	0xae, 0x08, 0xbb, 0xef, 0x64, 0x88, 0xf1, 0x5b, 0xa4, 0x33, 0x65, 0x08, 0xc6, 0xee, 0xb2, 0x69,
+	0x8b, 0x61, 0xa6, 0x50, 0xce, 0x94, 0xed, 0x11, 0x2c, 0x15, 0x98, 0x55, 0x9a, 0xdf, 0x5a, 0x53,
+	0x39, 0xe7, 0xeb, 0xc9, 0x19, 0x9c, 0x39, 0x9e, 0xcf, 0xa4, 0xc8, 0x45, 0x81, 0xe7, 0x63, 0x92,
+	0xfb, 0x9b, 0xb0, 0x5c, 0x8c, 0x49, 0x3e, 0x4d, 0xbb, 0xa4, 0x4d, 0xd3, 0x00, 0x6b, 0xd4, 0x12,
+	0xcc, 0x89, 0x54, 0xa5, 0xcb, 0x7d, 0xcd, 0x17, 0xfc, 0xd4, 0x80, 0xf5, 0x4a, 0x03, 0xdc, 0xbc,
+	0x61, 0x06, 0x6b, 0xb9, 0x4d, 0x3c, 0x79, 0x4c, 0xe7, 0x6f, 0x64, 0x7d, 0xf4, 0xc1, 0xcb, 0xdf,
+	0x77, 0xe6, 0x7e, 0xfe, 0x63, 0x67, 0xaf, 0xc7, 0xd5, 0xf3, 0x61, 0x12, 0x52, 0x31, 0x88, 0xdc,
+	0xac, 0x60, 0x7f, 0xf6, 0x0b, 0xd6, 0x8f, 0x54, 0x99, 0x63, 0x61, 0x00, 0x45, 0xbc, 0x3a, 0x49,
+	0xa0, 0xeb, 0x39, 0x82, 0xfb, 0x55, 0xb2, 0xee, 0x1d, 0x7e, 0xe3, 0x39, 0xd7, 0xab, 0x49, 0x74,
+	0x37, 0x7c, 0x01, 0xdb, 0x95, 0x3b, 0xf1, 0x44, 0xc8, 0x31, 0x91, 0xac, 0x2d, 0x32, 0x25, 0x09,
+	0x55, 0x7e, 0x08, 0x0f, 0xbb, 0xd6, 0xd5, 0xa1, 0xce, 0x37, 0x95, 0xea, 0x41, 0xf7, 0x7a, 0xf4,
+	0x29, 0x0b, 0x7e, 0xf1, 0xe0, 0x1d, 0xb3, 0xdd, 0x31, 0xa6, 0x7c, 0x84, 0xf2, 0x7f, 0xee, 0xe7,
+	0xef, 0xc0, 0x6a, 0x42, 0x14, 0x7d, 0xde, 0xb1, 0x4f, 0xae, 0xad, 0x2a, 0x18, 0x97, 0x7d, 0x8d,
+	0xb7, 0x61, 0xe5, 0xbb, 0x21, 0xc9, 0x14, 0x77, 0x87, 0xae, 0x19, 0x5f, 0xd9, 0xfa, 0x3c, 0xe6,
+	0xa4, 0x1c, 0x60, 0xa6, 0x6a, 0x8c, 0x26, 0x2e, 0x32, 0xf8, 0x1c, 0xde, 0xbe, 0x9a, 0xd4, 0xcc,
+	0xcd, 0xf1, 0xf4, 0x45, 0xce, 0x25, 0xb2, 0x3a, 0xf3, 0xc5, 0xd1, 0x37, 0x2f, 0x2f, 0x5a, 0xde,
+	0xab, 0x8b, 0x96, 0xf7, 0xe7, 0x45, 0xcb, 0xfb, 0xf1, 0xb2, 0x35, 0xf7, 0xea, 0xb2, 0x35, 0xf7,
+	0xeb, 0x65, 0x6b, 0xee, 0xeb, 0x93, 0x4a, 0x85, 0xcc, 0x84, 0xb9, 0x9f, 0xa1, 0x1a, 0x0b, 0xd9,
+	0x77, 0x56, 0x8a, 0xac, 0x87, 0x32, 0x7a, 0xf1, 0x2f, 0x53, 0xaa, 0x29, 0xa1, 0x1e, 0x37, 0x97,
+	0xcc, 0x3c, 0x79, 0xf8, 0xf7, 0x00, 0x08, 0x36, 0xce, 0xa9, 0xd4, 0x0a, 0x00, 0x00,
+}
+
+func (m *EventSell) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSell) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSell) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SellOrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SellOrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBuyDirect) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBuyDirect) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBuyDirect) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Royalty != nil {
+		{
+			size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SellerFee != nil {
+		{
+			size, err := m.SellerFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BuyerFee != nil {
+		{
+			size, err := m.BuyerFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SellOrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SellOrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateSellOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateSellOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateSellOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SellOrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SellOrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCancelSellOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelSellOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelSellOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SellOrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SellOrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAllowDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAllowDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAllowDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRemoveAllowedDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRemoveAllowedDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRemoveAllowedDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBuyOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBuyOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBuyOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BuyOrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BuyOrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCancelBuyOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelBuyOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelBuyOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BuyOrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BuyOrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFillBuyOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFillBuyOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFillBuyOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SellerFee != nil {
+		{
+			size, err := m.SellerFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BuyerFee != nil {
+		{
+			size, err := m.BuyerFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Royalty != nil {
+		{
+			size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SellOrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SellOrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BuyOrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BuyOrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateClassRoyalty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateClassRoyalty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateClassRoyalty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCreateAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCreateAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCreateAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBidAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBidAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBidAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BidPrice != nil {
+		{
+			size, err := m.BidPrice.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSettleAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSettleAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSettleAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SellerFee != nil {
+		{
+			size, err := m.SellerFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BuyerFee != nil {
+		{
+			size, err := m.BuyerFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Royalty != nil {
+		{
+			size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Winner) > 0 {
+		i -= len(m.Winner)
+		copy(dAtA[i:], m.Winner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Winner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMakeOffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMakeOffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMakeOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SellOrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SellOrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OfferId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OfferId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAcceptOffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAcceptOffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAcceptOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SellerFee != nil {
+		{
+			size, err := m.SellerFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BuyerFee != nil {
+		{
+			size, err := m.BuyerFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Royalty != nil {
+		{
+			size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Price != nil {
+		{
+			size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OfferId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OfferId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRejectOffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRejectOffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRejectOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OfferId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OfferId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCounterOffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCounterOffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCounterOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Price != nil {
+		{
+			size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OfferId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OfferId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventProposeSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventProposeSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventProposeSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Counterparty) > 0 {
+		i -= len(m.Counterparty)
+		copy(dAtA[i:], m.Counterparty)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Counterparty)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SwapId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SwapId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAcceptSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAcceptSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAcceptSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CounterpartyFee) > 0 {
+		for iNdEx := len(m.CounterpartyFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CounterpartyFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ProposerFee) > 0 {
+		for iNdEx := len(m.ProposerFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposerFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.SwapId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SwapId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCreateForwardContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCreateForwardContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCreateForwardContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ForwardContractId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ForwardContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDeliverForwardContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDeliverForwardContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDeliverForwardContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Payment != nil {
+		{
+			size, err := m.Payment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BatchDenom) > 0 {
+		i -= len(m.BatchDenom)
+		copy(dAtA[i:], m.BatchDenom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BatchDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ForwardContractId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ForwardContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSellOrderExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSellOrderExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSellOrderExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SellOrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SellOrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventSell) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SellOrderId != 0 {
+		n += 1 + sovEvents(uint64(m.SellOrderId))
+	}
+	return n
+}
+
+func (m *EventBuyDirect) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SellOrderId != 0 {
+		n += 1 + sovEvents(uint64(m.SellOrderId))
+	}
+	if m.BuyerFee != nil {
+		l = m.BuyerFee.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.SellerFee != nil {
+		l = m.SellerFee.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Royalty != nil {
+		l = m.Royalty.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventUpdateSellOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SellOrderId != 0 {
+		n += 1 + sovEvents(uint64(m.SellOrderId))
+	}
+	return n
+}
+
+func (m *EventCancelSellOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SellOrderId != 0 {
+		n += 1 + sovEvents(uint64(m.SellOrderId))
+	}
+	return n
+}
+
+func (m *EventAllowDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRemoveAllowedDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventBuyOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BuyOrderId != 0 {
+		n += 1 + sovEvents(uint64(m.BuyOrderId))
+	}
+	return n
+}
+
+func (m *EventCancelBuyOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BuyOrderId != 0 {
+		n += 1 + sovEvents(uint64(m.BuyOrderId))
+	}
+	return n
+}
+
+func (m *EventFillBuyOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BuyOrderId != 0 {
+		n += 1 + sovEvents(uint64(m.BuyOrderId))
+	}
+	if m.SellOrderId != 0 {
+		n += 1 + sovEvents(uint64(m.SellOrderId))
+	}
+	if m.Royalty != nil {
+		l = m.Royalty.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.BuyerFee != nil {
+		l = m.BuyerFee.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.SellerFee != nil {
+		l = m.SellerFee.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventUpdateClassRoyalty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCreateAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovEvents(uint64(m.AuctionId))
+	}
+	return n
+}
+
+func (m *EventBidAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovEvents(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.BidPrice != nil {
+		l = m.BidPrice.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSettleAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovEvents(uint64(m.AuctionId))
+	}
+	l = len(m.Winner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Royalty != nil {
+		l = m.Royalty.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.BuyerFee != nil {
+		l = m.BuyerFee.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.SellerFee != nil {
+		l = m.SellerFee.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMakeOffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OfferId != 0 {
+		n += 1 + sovEvents(uint64(m.OfferId))
+	}
+	if m.SellOrderId != 0 {
+		n += 1 + sovEvents(uint64(m.SellOrderId))
+	}
+	return n
+}
+
+func (m *EventAcceptOffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OfferId != 0 {
+		n += 1 + sovEvents(uint64(m.OfferId))
+	}
+	if m.Price != nil {
+		l = m.Price.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Royalty != nil {
+		l = m.Royalty.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.BuyerFee != nil {
+		l = m.BuyerFee.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.SellerFee != nil {
+		l = m.SellerFee.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRejectOffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OfferId != 0 {
+		n += 1 + sovEvents(uint64(m.OfferId))
+	}
+	return n
+}
+
+func (m *EventCounterOffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OfferId != 0 {
+		n += 1 + sovEvents(uint64(m.OfferId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Price != nil {
+		l = m.Price.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventProposeSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SwapId != 0 {
+		n += 1 + sovEvents(uint64(m.SwapId))
+	}
+	l = len(m.Counterparty)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAcceptSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SwapId != 0 {
+		n += 1 + sovEvents(uint64(m.SwapId))
+	}
+	if len(m.ProposerFee) > 0 {
+		for _, e := range m.ProposerFee {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.CounterpartyFee) > 0 {
+		for _, e := range m.CounterpartyFee {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventCreateForwardContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ForwardContractId != 0 {
+		n += 1 + sovEvents(uint64(m.ForwardContractId))
+	}
+	return n
+}
+
+func (m *EventDeliverForwardContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ForwardContractId != 0 {
+		n += 1 + sovEvents(uint64(m.ForwardContractId))
+	}
+	l = len(m.BatchDenom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Payment != nil {
+		l = m.Payment.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSellOrderExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SellOrderId != 0 {
+		n += 1 + sovEvents(uint64(m.SellOrderId))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventSell) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSell: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSell: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellOrderId", wireType)
+			}
+			m.SellOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SellOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBuyDirect) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBuyDirect: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBuyDirect: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellOrderId", wireType)
+			}
+			m.SellOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SellOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyerFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BuyerFee == nil {
+				m.BuyerFee = &types.Coin{}
+			}
+			if err := m.BuyerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellerFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SellerFee == nil {
+				m.SellerFee = &types.Coin{}
+			}
+			if err := m.SellerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Royalty == nil {
+				m.Royalty = &types.Coin{}
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateSellOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateSellOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateSellOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellOrderId", wireType)
+			}
+			m.SellOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SellOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCancelSellOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCancelSellOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCancelSellOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellOrderId", wireType)
+			}
+			m.SellOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SellOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAllowDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAllowDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAllowDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRemoveAllowedDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRemoveAllowedDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRemoveAllowedDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBuyOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBuyOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBuyOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyOrderId", wireType)
+			}
+			m.BuyOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BuyOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCancelBuyOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCancelBuyOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCancelBuyOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyOrderId", wireType)
+			}
+			m.BuyOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BuyOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFillBuyOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFillBuyOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFillBuyOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyOrderId", wireType)
+			}
+			m.BuyOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BuyOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellOrderId", wireType)
+			}
+			m.SellOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SellOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Royalty == nil {
+				m.Royalty = &types.Coin{}
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyerFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BuyerFee == nil {
+				m.BuyerFee = &types.Coin{}
+			}
+			if err := m.BuyerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellerFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SellerFee == nil {
+				m.SellerFee = &types.Coin{}
+			}
+			if err := m.SellerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateClassRoyalty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateClassRoyalty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateClassRoyalty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCreateAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreateAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreateAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBidAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBidAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBidAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BidPrice == nil {
+				m.BidPrice = &types.Coin{}
+			}
+			if err := m.BidPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSettleAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSettleAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSettleAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Winner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Winner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Royalty == nil {
+				m.Royalty = &types.Coin{}
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyerFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BuyerFee == nil {
+				m.BuyerFee = &types.Coin{}
+			}
+			if err := m.BuyerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellerFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SellerFee == nil {
+				m.SellerFee = &types.Coin{}
+			}
+			if err := m.SellerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMakeOffer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMakeOffer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMakeOffer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferId", wireType)
+			}
+			m.OfferId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OfferId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellOrderId", wireType)
+			}
+			m.SellOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SellOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAcceptOffer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAcceptOffer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAcceptOffer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferId", wireType)
+			}
+			m.OfferId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OfferId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Price == nil {
+				m.Price = &types.Coin{}
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Royalty == nil {
+				m.Royalty = &types.Coin{}
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyerFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BuyerFee == nil {
+				m.BuyerFee = &types.Coin{}
+			}
+			if err := m.BuyerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellerFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SellerFee == nil {
+				m.SellerFee = &types.Coin{}
+			}
+			if err := m.SellerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRejectOffer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRejectOffer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRejectOffer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferId", wireType)
+			}
+			m.OfferId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OfferId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCounterOffer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCounterOffer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCounterOffer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferId", wireType)
+			}
+			m.OfferId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OfferId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventSell: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSell: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SellOrderId", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.SellOrderId = 0
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -647,11 +4545,28 @@ func (m *EventSell) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SellOrderId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Price == nil {
+				m.Price = &types.Coin{}
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -673,7 +4588,7 @@ func (m *EventSell) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBuyDirect) Unmarshal(dAtA []byte) error {
+func (m *EventProposeSwap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -696,17 +4611,17 @@ func (m *EventBuyDirect) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBuyDirect: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventProposeSwap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBuyDirect: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventProposeSwap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SellOrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapId", wireType)
 			}
-			m.SellOrderId = 0
+			m.SwapId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -716,11 +4631,43 @@ func (m *EventBuyDirect) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SellOrderId |= uint64(b&0x7F) << shift
+				m.SwapId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Counterparty = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -742,7 +4689,7 @@ func (m *EventBuyDirect) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateSellOrder) Unmarshal(dAtA []byte) error {
+func (m *EventAcceptSwap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -765,17 +4712,17 @@ func (m *EventUpdateSellOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateSellOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAcceptSwap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateSellOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAcceptSwap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SellOrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapId", wireType)
 			}
-			m.SellOrderId = 0
+			m.SwapId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -785,11 +4732,79 @@ func (m *EventUpdateSellOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SellOrderId |= uint64(b&0x7F) << shift
+				m.SwapId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerFee = append(m.ProposerFee, types.Coin{})
+			if err := m.ProposerFee[len(m.ProposerFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyFee = append(m.CounterpartyFee, types.Coin{})
+			if err := m.CounterpartyFee[len(m.CounterpartyFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -811,7 +4826,7 @@ func (m *EventUpdateSellOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCancelSellOrder) Unmarshal(dAtA []byte) error {
+func (m *EventCreateForwardContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -834,17 +4849,17 @@ func (m *EventCancelSellOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCancelSellOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCreateForwardContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCancelSellOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCreateForwardContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SellOrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardContractId", wireType)
 			}
-			m.SellOrderId = 0
+			m.ForwardContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -854,7 +4869,7 @@ func (m *EventCancelSellOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SellOrderId |= uint64(b&0x7F) << shift
+				m.ForwardContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -880,7 +4895,7 @@ func (m *EventCancelSellOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAllowDenom) Unmarshal(dAtA []byte) error {
+func (m *EventDeliverForwardContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -903,15 +4918,34 @@ func (m *EventAllowDenom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAllowDenom: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDeliverForwardContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAllowDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDeliverForwardContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardContractId", wireType)
+			}
+			m.ForwardContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwardContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -939,7 +4973,75 @@ func (m *EventAllowDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.BatchDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Payment == nil {
+				m.Payment = &types.Coin{}
+			}
+			if err := m.Payment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -962,7 +5064,7 @@ func (m *EventAllowDenom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRemoveAllowedDenom) Unmarshal(dAtA []byte) error {
+func (m *EventSellOrderExpired) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -985,17 +5087,17 @@ func (m *EventRemoveAllowedDenom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRemoveAllowedDenom: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSellOrderExpired: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRemoveAllowedDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSellOrderExpired: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellOrderId", wireType)
 			}
-			var stringLen uint64
+			m.SellOrderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1005,24 +5107,11 @@ func (m *EventRemoveAllowedDenom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SellOrderId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
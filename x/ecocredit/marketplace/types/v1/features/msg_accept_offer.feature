@@ -0,0 +1,40 @@
+Feature: MsgAcceptOffer
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "sender": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "offer_id": 1
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if sender is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "sender cannot be empty: invalid request"
+
+  Scenario: an error is returned if sender is not a bech32 address
+    Given the message
+    """
+    {
+      "sender": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "sender is not a valid address: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if offer id is empty
+    Given the message
+    """
+    {
+      "sender": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+    }
+    """
+    When the message is validated
+    Then expect the error "offer id cannot be empty: invalid request"
@@ -0,0 +1,40 @@
+Feature: MsgAcceptSwap
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "counterparty": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "swap_id": 1
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if counterparty is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "counterparty cannot be empty: invalid request"
+
+  Scenario: an error is returned if counterparty is not a bech32 address
+    Given the message
+    """
+    {
+      "counterparty": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "counterparty is not a valid address: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if swap id is empty
+    Given the message
+    """
+    {
+      "counterparty": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+    }
+    """
+    When the message is validated
+    Then expect the error "swap id cannot be empty: invalid request"
@@ -52,6 +52,17 @@ Feature: MsgAddAllowedDenom
     When the message is validated
     Then expect the error "bank denom: invalid denom: 1: parse error: invalid request"
 
+  Scenario: an error is returned if bank denom is not valid ibc denom
+    Given the message
+    """
+    {
+      "authority": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "bank_denom": "ibc/XYZ"
+    }
+    """
+    When the message is validated
+    Then expect the error "bank denom: invalid denom trace hash XYZ: encoding/hex: invalid byte: U+0058 'X': parse error: invalid request"
+
   Scenario: an error is returned if bank display denom is empty
     Given the message
     """
@@ -0,0 +1,492 @@
+Feature: MsgBuyOrder
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "US-WA"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with disable auto retire
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "disable_auto_retire": true
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with expiration
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "US-WA",
+          "expiration": "2030-01-01T00:00:00Z"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with multiple orders
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "US-WA"
+        },
+        {
+          "batch_denom": "C01-001-20200101-20210101-002",
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "US-WA"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with class selector
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "class_selector": {
+            "class_id": "C01",
+            "project_location": "US-OR",
+            "min_start_date": "2020-01-01T00:00:00Z",
+            "max_end_date": "2030-01-01T00:00:00Z"
+          },
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "US-WA"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with project selector
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "project_selector": {
+            "project_id": "C01-001",
+            "min_start_date": "2020-01-01T00:00:00Z"
+          },
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "US-WA"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if buyer is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "buyer cannot be empty: invalid request"
+
+  Scenario: an error is returned if buyer is not a valid bech32 address
+    Given the message
+    """
+    {
+      "buyer": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "buyer is not a valid address: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if orders is empty
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+    }
+    """
+    When the message is validated
+    Then expect the error "orders cannot be empty: invalid request"
+
+  Scenario: an error is returned if order batch denom is empty
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {}
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: batch denom: empty string is not allowed: parse error: invalid request"
+
+  Scenario: an error is returned if order batch denom is not formatted
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "foo"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: batch denom: expected format <project-id>-<start_date>-<end_date>-<batch_sequence>: parse error: invalid request"
+
+  Scenario: an error is returned if batch denom and class selector are both set
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "class_selector": {
+            "class_id": "C01"
+          }
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: only one of batch denom, class selector, or project selector can be set: invalid request"
+
+  Scenario: an error is returned if class selector and project selector are both set
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "class_selector": {
+            "class_id": "C01"
+          },
+          "project_selector": {
+            "project_id": "C01-001"
+          }
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: only one of batch denom, class selector, or project selector can be set: invalid request"
+
+  Scenario: an error is returned if class selector class id is not formatted
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "class_selector": {
+            "class_id": "foo"
+          }
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: class selector: class id: expected format <credit-type-abbrev><class-sequence>: parse error: invalid request"
+
+  Scenario: an error is returned if class selector project location is not formatted
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "class_selector": {
+            "class_id": "C01",
+            "project_location": "foo"
+          }
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: class selector: project location: expected format <country-code>[-<region-code>[ <postal-code>]]: parse error: invalid request"
+
+  Scenario: an error is returned if class selector min start date is not before max end date
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "class_selector": {
+            "class_id": "C01",
+            "min_start_date": "2030-01-01T00:00:00Z",
+            "max_end_date": "2020-01-01T00:00:00Z"
+          }
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: class selector: min start date must be before max end date: invalid request"
+
+  Scenario: an error is returned if project selector project id is not formatted
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "project_selector": {
+            "project_id": "foo"
+          }
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: project selector: project id: expected format <class-id>-<project-sequence>: parse error: invalid request"
+
+  Scenario: an error is returned if project selector min start date is not before max end date
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "project_selector": {
+            "project_id": "C01-001",
+            "min_start_date": "2030-01-01T00:00:00Z",
+            "max_end_date": "2030-01-01T00:00:00Z"
+          }
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: project selector: min start date must be before max end date: invalid request"
+
+  Scenario: an error is returned if order quantity is empty
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: quantity cannot be empty: invalid request"
+
+  Scenario: an error is returned if order quantity is not a positive decimal
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "-100"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: quantity must be a positive decimal: invalid request"
+
+  Scenario: an error is returned if bid price is empty
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: bid price: cannot be empty: invalid request"
+
+  Scenario: an error is returned if bid price denom is empty
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "bid_price": {}
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: bid price: denom cannot be empty: invalid request"
+
+  Scenario: an error is returned if bid price denom is not formatted
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "bid_price": {
+            "denom": "foo#bar"
+          }
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: bid price: invalid denom: foo#bar: invalid request"
+
+  Scenario: an error is returned if bid price amount is empty
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen"
+          }
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: bid price: amount cannot be empty: invalid request"
+
+  Scenario: an error is returned if bid price amount is not a positive integer
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "-100"
+          }
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: bid price: amount must be a positive integer: invalid request"
+
+  Scenario: an error is returned if auto-retire is enabled and retirement jurisdiction is empty
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          }
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: retirement jurisdiction: empty string is not allowed: parse error: invalid request"
+
+  Scenario: an error is returned if auto-retire is enabled and retirement jurisdiction is not formatted
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "foo"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: retirement jurisdiction: expected format <country-code>[-<region-code>[ <postal-code>]]: parse error: invalid request"
@@ -0,0 +1,121 @@
+Feature: MsgCancelSellOrders
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "sell_order_ids": [1, 2]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message cancelling all sell orders
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "cancel_all": true
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message cancelling all sell orders of a credit batch
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "cancel_all": true,
+      "batch_denom": "C01-001-20200101-20210101-001"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if seller is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "seller cannot be empty: invalid request"
+
+  Scenario: an error is returned if seller is not a valid bech32 address
+    Given the message
+    """
+    {
+      "seller": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "seller is not a valid address: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if sell order ids are set when cancelling all sell orders
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "sell_order_ids": [1],
+      "cancel_all": true
+    }
+    """
+    When the message is validated
+    Then expect the error "sell order ids must be empty when cancelling all sell orders: invalid request"
+
+  Scenario: an error is returned if batch denom is not formatted
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "cancel_all": true,
+      "batch_denom": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "batch denom: expected format <project-id>-<start_date>-<end_date>-<batch_sequence>: parse error: invalid request"
+
+  Scenario: an error is returned if sell order ids are empty
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+    }
+    """
+    When the message is validated
+    Then expect the error "sell order ids cannot be empty: invalid request"
+
+  Scenario: an error is returned if batch denom is set when not cancelling all sell orders
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "sell_order_ids": [1],
+      "batch_denom": "C01-001-20200101-20210101-001"
+    }
+    """
+    When the message is validated
+    Then expect the error "batch denom can only be set when cancelling all sell orders: invalid request"
+
+  Scenario: an error is returned if a sell order id is empty
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "sell_order_ids": [1, 0]
+    }
+    """
+    When the message is validated
+    Then expect the error "sell_order_ids[1]: sell order id cannot be empty: invalid request"
+
+  Scenario: an error is returned if a sell order id is duplicated
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "sell_order_ids": [1, 2, 1]
+    }
+    """
+    When the message is validated
+    Then expect the error "sell_order_ids[2]: duplicate sell order id 1: invalid request"
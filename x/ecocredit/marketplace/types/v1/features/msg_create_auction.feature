@@ -0,0 +1,233 @@
+Feature: MsgCreateAuction
+
+  Scenario: a valid english auction
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "auction_type": "AUCTION_TYPE_ENGLISH",
+      "start_price": {
+        "denom": "regen",
+        "amount": "100"
+      },
+      "end_time": "2030-01-01T00:00:00Z"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid dutch auction
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "auction_type": "AUCTION_TYPE_DUTCH",
+      "start_price": {
+        "denom": "regen",
+        "amount": "100"
+      },
+      "end_price": {
+        "denom": "regen",
+        "amount": "50"
+      },
+      "end_time": "2030-01-01T00:00:00Z"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if seller is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "seller cannot be empty: invalid request"
+
+  Scenario: an error is returned if seller is not a bech32 address
+    Given the message
+    """
+    {
+      "seller": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "seller is not a valid address: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if batch denom is empty
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+    }
+    """
+    When the message is validated
+    Then expect the error "batch denom: empty string is not allowed: parse error: invalid request"
+
+  Scenario: an error is returned if quantity is empty
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001"
+    }
+    """
+    When the message is validated
+    Then expect the error "quantity cannot be empty: invalid request"
+
+  Scenario: an error is returned if quantity is not a positive decimal
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "-100"
+    }
+    """
+    When the message is validated
+    Then expect the error "quantity must be a positive decimal: invalid request"
+
+  Scenario: an error is returned if start price is empty
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100"
+    }
+    """
+    When the message is validated
+    Then expect the error "start price: cannot be empty: invalid request"
+
+  Scenario: an error is returned if start price amount is not positive
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "start_price": {
+        "denom": "regen",
+        "amount": "0"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "start price: amount must be a positive integer: invalid request"
+
+  Scenario: an error is returned if auction type is unspecified
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "start_price": {
+        "denom": "regen",
+        "amount": "100"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "invalid auction type: AUCTION_TYPE_UNSPECIFIED: invalid request"
+
+  Scenario: an error is returned if end price is set for an english auction
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "auction_type": "AUCTION_TYPE_ENGLISH",
+      "start_price": {
+        "denom": "regen",
+        "amount": "100"
+      },
+      "end_price": {
+        "denom": "regen",
+        "amount": "50"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "end price must be empty for an english auction: invalid request"
+
+  Scenario: an error is returned if end price is empty for a dutch auction
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "auction_type": "AUCTION_TYPE_DUTCH",
+      "start_price": {
+        "denom": "regen",
+        "amount": "100"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "end price: cannot be empty: invalid request"
+
+  Scenario: an error is returned if end price denom does not match start price denom
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "auction_type": "AUCTION_TYPE_DUTCH",
+      "start_price": {
+        "denom": "regen",
+        "amount": "100"
+      },
+      "end_price": {
+        "denom": "atom",
+        "amount": "50"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "end price denom atom does not match start price denom regen: invalid request"
+
+  Scenario: an error is returned if end price is not less than start price
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "auction_type": "AUCTION_TYPE_DUTCH",
+      "start_price": {
+        "denom": "regen",
+        "amount": "100"
+      },
+      "end_price": {
+        "denom": "regen",
+        "amount": "100"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "end price must be less than start price for a dutch auction: invalid request"
+
+  Scenario: an error is returned if end time is empty
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "auction_type": "AUCTION_TYPE_ENGLISH",
+      "start_price": {
+        "denom": "regen",
+        "amount": "100"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "end time cannot be empty: invalid request"
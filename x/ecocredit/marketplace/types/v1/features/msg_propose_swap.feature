@@ -0,0 +1,177 @@
+Feature: MsgProposeSwap
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "proposer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "counterparty": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "offer_batch_denom": "C01-001-20200101-20210101-001",
+      "offer_quantity": "100",
+      "ask_batch_denom": "C01-001-20200101-20210101-002",
+      "ask_quantity": "90",
+      "expiration": "2030-01-01T00:00:00Z"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with offer coins
+    Given the message
+    """
+    {
+      "proposer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "counterparty": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "offer_batch_denom": "C01-001-20200101-20210101-001",
+      "offer_quantity": "100",
+      "offer_coins": [
+        {
+          "denom": "regen",
+          "amount": "100"
+        }
+      ],
+      "ask_batch_denom": "C01-001-20200101-20210101-002",
+      "ask_quantity": "100",
+      "expiration": "2030-01-01T00:00:00Z"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if proposer is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "proposer cannot be empty: invalid request"
+
+  Scenario: an error is returned if proposer is not a bech32 address
+    Given the message
+    """
+    {
+      "proposer": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "proposer is not a valid address: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if counterparty is empty
+    Given the message
+    """
+    {
+      "proposer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+    }
+    """
+    When the message is validated
+    Then expect the error "counterparty cannot be empty: invalid request"
+
+  Scenario: an error is returned if counterparty is not a bech32 address
+    Given the message
+    """
+    {
+      "proposer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "counterparty": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "counterparty is not a valid address: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if counterparty is the proposer
+    Given the message
+    """
+    {
+      "proposer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "counterparty": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+    }
+    """
+    When the message is validated
+    Then expect the error "proposer and counterparty cannot be the same account: invalid request"
+
+  Scenario: an error is returned if offer batch denom is not formatted
+    Given the message
+    """
+    {
+      "proposer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "counterparty": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "offer_batch_denom": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "offer batch denom: expected format <project-id>-<start_date>-<end_date>-<batch_sequence>: parse error: invalid request"
+
+  Scenario: an error is returned if offer quantity is not a positive decimal
+    Given the message
+    """
+    {
+      "proposer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "counterparty": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "offer_batch_denom": "C01-001-20200101-20210101-001",
+      "offer_quantity": "-100"
+    }
+    """
+    When the message is validated
+    Then expect the error "offer quantity must be a positive decimal: invalid request"
+
+  Scenario: an error is returned if offer coins are not valid
+    Given the message
+    """
+    {
+      "proposer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "counterparty": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "offer_batch_denom": "C01-001-20200101-20210101-001",
+      "offer_quantity": "100",
+      "offer_coins": [
+        {
+          "denom": "regen",
+          "amount": "0"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "offer coins: coin 0regen amount is not positive: invalid request"
+
+  Scenario: an error is returned if ask batch denom is the offer batch denom
+    Given the message
+    """
+    {
+      "proposer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "counterparty": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "offer_batch_denom": "C01-001-20200101-20210101-001",
+      "offer_quantity": "100",
+      "ask_batch_denom": "C01-001-20200101-20210101-001"
+    }
+    """
+    When the message is validated
+    Then expect the error "ask batch denom cannot be the same as offer batch denom: invalid request"
+
+  Scenario: an error is returned if ask quantity is not a positive decimal
+    Given the message
+    """
+    {
+      "proposer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "counterparty": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "offer_batch_denom": "C01-001-20200101-20210101-001",
+      "offer_quantity": "100",
+      "ask_batch_denom": "C01-001-20200101-20210101-002",
+      "ask_quantity": "0"
+    }
+    """
+    When the message is validated
+    Then expect the error "ask quantity must be a positive decimal: invalid request"
+
+  Scenario: an error is returned if expiration is empty
+    Given the message
+    """
+    {
+      "proposer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "counterparty": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "offer_batch_denom": "C01-001-20200101-20210101-001",
+      "offer_quantity": "100",
+      "ask_batch_denom": "C01-001-20200101-20210101-002",
+      "ask_quantity": "90"
+    }
+    """
+    When the message is validated
+    Then expect the error "expiration cannot be empty: invalid request"
@@ -267,3 +267,204 @@ Feature: MsgSell
     """
     When the message is validated
     Then expect the error "orders[0]: ask price: amount must be a positive integer: invalid request"
+
+  Scenario: a valid message with allowed buyers
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "allowed_buyers": [
+            "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+          ]
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if an allowed buyer is not a bech32 address
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "allowed_buyers": [
+            "foo"
+          ]
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: allowed buyers[0]: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if an allowed buyer is the seller
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "allowed_buyers": [
+            "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+          ]
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: allowed buyers[0]: seller cannot be an allowed buyer: invalid request"
+
+  Scenario: an error is returned if an allowed buyer is duplicated
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "allowed_buyers": [
+            "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+            "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+          ]
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: allowed buyers[1]: duplicate address regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6: invalid request"
+
+  Scenario: a valid message with additional ask prices
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "additional_ask_prices": [
+            {
+              "denom": "uusdc",
+              "amount": "200"
+            }
+          ]
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if an additional ask price amount is not a positive integer
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "additional_ask_prices": [
+            {
+              "denom": "uusdc",
+              "amount": "0"
+            }
+          ]
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: additional ask prices[0]: amount must be a positive integer: invalid request"
+
+  Scenario: an error is returned if an additional ask price denom is the ask price denom
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "additional_ask_prices": [
+            {
+              "denom": "regen",
+              "amount": "200"
+            }
+          ]
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: additional ask prices[0]: denom cannot be the same as the ask price denom: invalid request"
+
+  Scenario: an error is returned if an additional ask price denom is duplicated
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "additional_ask_prices": [
+            {
+              "denom": "uusdc",
+              "amount": "200"
+            },
+            {
+              "denom": "uusdc",
+              "amount": "300"
+            }
+          ]
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: additional ask prices[1]: duplicate denom uusdc: invalid request"
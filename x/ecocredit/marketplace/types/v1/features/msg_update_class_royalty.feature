@@ -0,0 +1,114 @@
+Feature: MsgUpdateClassRoyalty
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "admin": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "class_id": "C01",
+      "royalty_bps": 500,
+      "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message removing the royalty
+    Given the message
+    """
+    {
+      "admin": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "class_id": "C01"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if admin is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "admin: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if admin is not a bech32 address
+    Given the message
+    """
+    {
+      "admin": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "admin: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if class id is empty
+    Given the message
+    """
+    {
+      "admin": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+    }
+    """
+    When the message is validated
+    Then expect the error "class id: empty string is not allowed: parse error: invalid request"
+
+  Scenario: an error is returned if class id is not formatted
+    Given the message
+    """
+    {
+      "admin": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "class_id": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "class id: expected format <credit-type-abbrev><class-sequence>: parse error: invalid request"
+
+  Scenario: an error is returned if royalty exceeds the maximum
+    Given the message
+    """
+    {
+      "admin": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "class_id": "C01",
+      "royalty_bps": 5001,
+      "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "royalty cannot exceed 5000 basis points: invalid request"
+
+  Scenario: an error is returned if recipient is set and royalty is zero
+    Given the message
+    """
+    {
+      "admin": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "class_id": "C01",
+      "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "recipient must be empty when royalty is zero: invalid request"
+
+  Scenario: an error is returned if recipient is empty and royalty is not zero
+    Given the message
+    """
+    {
+      "admin": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "class_id": "C01",
+      "royalty_bps": 500
+    }
+    """
+    When the message is validated
+    Then expect the error "recipient: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if recipient is not a bech32 address
+    Given the message
+    """
+    {
+      "admin": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "class_id": "C01",
+      "royalty_bps": 500,
+      "recipient": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "recipient: decoding bech32 failed: invalid bech32 string length 3: invalid address"
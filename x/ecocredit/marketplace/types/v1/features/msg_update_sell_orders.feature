@@ -252,3 +252,82 @@ Feature: MsgUpdateSellOrders
     """
     When the message is validated
     Then expect the error "updates[0]: new ask price: amount must be a positive integer: invalid request"
+
+  Scenario: a valid message with new additional ask prices
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "updates": [
+        {
+          "sell_order_id": 1,
+          "new_quantity": "100",
+          "new_ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "new_additional_ask_prices": [
+            {
+              "denom": "uusdc",
+              "amount": "200"
+            }
+          ]
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if new additional ask prices are set when clearing additional ask prices
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "updates": [
+        {
+          "sell_order_id": 1,
+          "new_quantity": "100",
+          "new_ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "new_additional_ask_prices": [
+            {
+              "denom": "uusdc",
+              "amount": "200"
+            }
+          ],
+          "clear_additional_ask_prices": true
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "updates[0]: new additional ask prices cannot be set when clearing additional ask prices: invalid request"
+
+  Scenario: an error is returned if a new additional ask price denom is the new ask price denom
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "updates": [
+        {
+          "sell_order_id": 1,
+          "new_quantity": "100",
+          "new_ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "new_additional_ask_prices": [
+            {
+              "denom": "regen",
+              "amount": "200"
+            }
+          ]
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "updates[0]: new additional ask prices[0]: denom cannot be the same as the ask price denom: invalid request"
@@ -0,0 +1,114 @@
+Feature: MsgUpdateTradingFee
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "authority": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "maker_fee_bps": 10,
+      "taker_fee_bps": 20,
+      "destination": "FEE_DESTINATION_COMMUNITY_POOL"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with zero fees
+    Given the message
+    """
+    {
+      "authority": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "destination": "FEE_DESTINATION_BURN"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with module account destination
+    Given the message
+    """
+    {
+      "authority": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "maker_fee_bps": 10,
+      "taker_fee_bps": 20,
+      "destination": "FEE_DESTINATION_MODULE_ACCOUNT",
+      "module_account": "treasury"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if authority is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "invalid authority address: empty address string is not allowed"
+
+  Scenario: an error is returned if authority is not a valid bech32 address
+    Given the message
+    """
+    {
+      "authority": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "invalid authority address: decoding bech32 failed: invalid bech32 string length 3"
+
+  Scenario: an error is returned if maker fee exceeds the maximum
+    Given the message
+    """
+    {
+      "authority": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "maker_fee_bps": 10001
+    }
+    """
+    When the message is validated
+    Then expect the error "maker fee cannot exceed 10000 basis points: invalid request"
+
+  Scenario: an error is returned if taker fee exceeds the maximum
+    Given the message
+    """
+    {
+      "authority": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "taker_fee_bps": 10001
+    }
+    """
+    When the message is validated
+    Then expect the error "taker fee cannot exceed 10000 basis points: invalid request"
+
+  Scenario: an error is returned if destination is unspecified
+    Given the message
+    """
+    {
+      "authority": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "maker_fee_bps": 10,
+      "taker_fee_bps": 20
+    }
+    """
+    When the message is validated
+    Then expect the error "destination must be specified: invalid request"
+
+  Scenario: an error is returned if module account is empty with module account destination
+    Given the message
+    """
+    {
+      "authority": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "destination": "FEE_DESTINATION_MODULE_ACCOUNT"
+    }
+    """
+    When the message is validated
+    Then expect the error "module account cannot be empty: invalid request"
+
+  Scenario: an error is returned if module account is set with another destination
+    Given the message
+    """
+    {
+      "authority": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "destination": "FEE_DESTINATION_BURN",
+      "module_account": "treasury"
+    }
+    """
+    When the message is validated
+    Then expect the error "module account can only be set with destination FEE_DESTINATION_MODULE_ACCOUNT: invalid request"
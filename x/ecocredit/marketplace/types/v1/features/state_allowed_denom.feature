@@ -62,3 +62,70 @@ Feature: AllowedDenom
     """
     When the allowed denom is validated
     Then expect the error "exponent must be one of [0 1 2 3 6 9 12 15 18 21 24]: parse error"
+
+  Scenario: a valid allowed ibc denom with denom trace
+    Given the allowed denom
+    """
+    {
+      "bank_denom": "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+      "display_denom": "atom",
+      "exponent": 6,
+      "ibc_path": "transfer/channel-0",
+      "ibc_base_denom": "uatom"
+    }
+    """
+    When the allowed denom is validated
+    Then expect no error
+
+  Scenario: an error is returned if bank denom is not a valid ibc denom
+    Given the allowed denom
+    """
+    {
+      "bank_denom": "ibc/27394FB092D2ECCD",
+      "display_denom": "atom",
+      "exponent": 6
+    }
+    """
+    When the allowed denom is validated
+    Then expect the error "bank denom: invalid denom trace hash 27394FB092D2ECCD: expected size to be 32 bytes, got 8 bytes: parse error"
+
+  Scenario: an error is returned if ibc denom trace does not match bank denom
+    Given the allowed denom
+    """
+    {
+      "bank_denom": "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+      "display_denom": "atom",
+      "exponent": 6,
+      "ibc_path": "transfer/channel-1",
+      "ibc_base_denom": "uatom"
+    }
+    """
+    When the allowed denom is validated
+    Then expect the error "ibc denom trace does not match bank denom ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2: parse error"
+
+  Scenario: an error is returned if ibc base denom is set without ibc path
+    Given the allowed denom
+    """
+    {
+      "bank_denom": "uatom",
+      "display_denom": "atom",
+      "exponent": 6,
+      "ibc_base_denom": "uatom"
+    }
+    """
+    When the allowed denom is validated
+    Then expect the error "ibc denom trace does not match bank denom uatom: parse error"
+
+  Scenario: an error is returned if ibc path is not valid
+    Given the allowed denom
+    """
+    {
+      "bank_denom": "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+      "display_denom": "atom",
+      "exponent": 6,
+      "ibc_path": "transfer",
+      "ibc_base_denom": "uatom"
+    }
+    """
+    When the allowed denom is validated
+    Then expect the error "ibc denom trace: trace info must come in pairs of port and channel identifiers '{portID}/{channelID}', got the identifiers: [transfer]: parse error"
@@ -0,0 +1,229 @@
+Feature: Auction
+
+  Scenario: a valid english auction
+    Given the auction
+    """
+    {
+      "id": 1,
+      "seller": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "quantity": "100",
+      "market_id": 1,
+      "auction_type": "AUCTION_TYPE_ENGLISH",
+      "start_amount": "100",
+      "start_time": "2020-01-01T00:00:00Z",
+      "end_time": "2020-02-01T00:00:00Z"
+    }
+    """
+    When the auction is validated
+    Then expect no error
+
+  Scenario: a valid dutch auction with a highest bidder
+    Given the auction
+    """
+    {
+      "id": 1,
+      "seller": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "quantity": "100",
+      "market_id": 1,
+      "auction_type": "AUCTION_TYPE_DUTCH",
+      "start_amount": "100",
+      "end_amount": "50",
+      "start_time": "2020-01-01T00:00:00Z",
+      "end_time": "2020-02-01T00:00:00Z",
+      "highest_bidder": "cT0uUyCnS+Uu5A1PvWaJgRFuUgo=",
+      "highest_bid_amount": "75"
+    }
+    """
+    When the auction is validated
+    Then expect no error
+
+  Scenario: an error is returned if id is empty
+    Given the auction
+    """
+    {}
+    """
+    When the auction is validated
+    Then expect the error "id cannot be zero: parse error"
+
+  Scenario: an error is returned if seller is empty
+    Given the auction
+    """
+    {
+      "id": 1
+    }
+    """
+    When the auction is validated
+    Then expect the error "seller: empty address string is not allowed: parse error"
+
+  Scenario: an error is returned if batch key is empty
+    Given the auction
+    """
+    {
+      "id": 1,
+      "seller": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y="
+    }
+    """
+    When the auction is validated
+    Then expect the error "batch key cannot be zero: parse error"
+
+  Scenario: an error is returned if quantity is not positive
+    Given the auction
+    """
+    {
+      "id": 1,
+      "seller": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "quantity": "0"
+    }
+    """
+    When the auction is validated
+    Then expect the error "quantity: expected a positive decimal, got 0: invalid decimal string: parse error"
+
+  Scenario: an error is returned if market id is empty
+    Given the auction
+    """
+    {
+      "id": 1,
+      "seller": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "quantity": "100"
+    }
+    """
+    When the auction is validated
+    Then expect the error "market id cannot be zero: parse error"
+
+  Scenario: an error is returned if auction type is unspecified
+    Given the auction
+    """
+    {
+      "id": 1,
+      "seller": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "quantity": "100",
+      "market_id": 1
+    }
+    """
+    When the auction is validated
+    Then expect the error "invalid auction type: AUCTION_TYPE_UNSPECIFIED: parse error"
+
+  Scenario: an error is returned if start amount is empty
+    Given the auction
+    """
+    {
+      "id": 1,
+      "seller": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "quantity": "100",
+      "market_id": 1,
+      "auction_type": "AUCTION_TYPE_ENGLISH"
+    }
+    """
+    When the auction is validated
+    Then expect the error "start amount must be a positive integer: parse error"
+
+  Scenario: an error is returned if end amount of a dutch auction is not less than start amount
+    Given the auction
+    """
+    {
+      "id": 1,
+      "seller": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "quantity": "100",
+      "market_id": 1,
+      "auction_type": "AUCTION_TYPE_DUTCH",
+      "start_amount": "100",
+      "end_amount": "100"
+    }
+    """
+    When the auction is validated
+    Then expect the error "end amount must be a positive integer less than start amount: parse error"
+
+  Scenario: an error is returned if end amount of an english auction is set
+    Given the auction
+    """
+    {
+      "id": 1,
+      "seller": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "quantity": "100",
+      "market_id": 1,
+      "auction_type": "AUCTION_TYPE_ENGLISH",
+      "start_amount": "100",
+      "end_amount": "50"
+    }
+    """
+    When the auction is validated
+    Then expect the error "end amount must be empty for an english auction: parse error"
+
+  Scenario: an error is returned if start time is empty
+    Given the auction
+    """
+    {
+      "id": 1,
+      "seller": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "quantity": "100",
+      "market_id": 1,
+      "auction_type": "AUCTION_TYPE_ENGLISH",
+      "start_amount": "100"
+    }
+    """
+    When the auction is validated
+    Then expect the error "start time cannot be empty: parse error"
+
+  Scenario: an error is returned if end time is empty
+    Given the auction
+    """
+    {
+      "id": 1,
+      "seller": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "quantity": "100",
+      "market_id": 1,
+      "auction_type": "AUCTION_TYPE_ENGLISH",
+      "start_amount": "100",
+      "start_time": "2020-01-01T00:00:00Z"
+    }
+    """
+    When the auction is validated
+    Then expect the error "end time cannot be empty: parse error"
+
+  Scenario: an error is returned if highest bid amount is empty
+    Given the auction
+    """
+    {
+      "id": 1,
+      "seller": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "quantity": "100",
+      "market_id": 1,
+      "auction_type": "AUCTION_TYPE_ENGLISH",
+      "start_amount": "100",
+      "start_time": "2020-01-01T00:00:00Z",
+      "end_time": "2020-02-01T00:00:00Z",
+      "highest_bidder": "cT0uUyCnS+Uu5A1PvWaJgRFuUgo="
+    }
+    """
+    When the auction is validated
+    Then expect the error "highest bid amount must be a positive integer: parse error"
+
+  Scenario: an error is returned if highest bid amount is set without a highest bidder
+    Given the auction
+    """
+    {
+      "id": 1,
+      "seller": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "quantity": "100",
+      "market_id": 1,
+      "auction_type": "AUCTION_TYPE_ENGLISH",
+      "start_amount": "100",
+      "start_time": "2020-01-01T00:00:00Z",
+      "end_time": "2020-02-01T00:00:00Z",
+      "highest_bid_amount": "100"
+    }
+    """
+    When the auction is validated
+    Then expect the error "highest bid amount must be empty if there is no highest bidder: parse error"
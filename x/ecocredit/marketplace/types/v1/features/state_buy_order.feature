@@ -0,0 +1,249 @@
+Feature: BuyOrder
+
+  Scenario: a valid buy order
+    Given the buy order
+    """
+    {
+      "id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "quantity": "100",
+      "market_id": 1,
+      "bid_amount": "100",
+      "retirement_jurisdiction": "US-WA"
+    }
+    """
+    When the buy order is validated
+    Then expect no error
+
+  Scenario: a valid buy order with disable auto retire
+    Given the buy order
+    """
+    {
+      "id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "quantity": "100",
+      "market_id": 1,
+      "bid_amount": "100",
+      "disable_auto_retire": true
+    }
+    """
+    When the buy order is validated
+    Then expect no error
+
+  Scenario: a valid buy order with expiration
+    Given the buy order
+    """
+    {
+      "id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "quantity": "100",
+      "market_id": 1,
+      "bid_amount": "100",
+      "retirement_jurisdiction": "US-WA",
+      "expiration": "2020-01-01T00:00:00Z"
+    }
+    """
+    When the buy order is validated
+    Then expect no error
+
+  Scenario: a valid buy order with class key
+    Given the buy order
+    """
+    {
+      "id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "class_key": 1,
+      "project_location": "US-OR",
+      "min_start_date": "2020-01-01T00:00:00Z",
+      "quantity": "100",
+      "market_id": 1,
+      "bid_amount": "100",
+      "retirement_jurisdiction": "US-WA"
+    }
+    """
+    When the buy order is validated
+    Then expect no error
+
+  Scenario: a valid buy order with project key
+    Given the buy order
+    """
+    {
+      "id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "project_key": 1,
+      "max_end_date": "2030-01-01T00:00:00Z",
+      "quantity": "100",
+      "market_id": 1,
+      "bid_amount": "100",
+      "retirement_jurisdiction": "US-WA"
+    }
+    """
+    When the buy order is validated
+    Then expect no error
+
+  Scenario: an error is returned if id is empty
+    Given the buy order
+    """
+    {}
+    """
+    When the buy order is validated
+    Then expect the error "id cannot be zero: parse error"
+
+  Scenario: an error is returned if buyer is empty
+    Given the buy order
+    """
+    {
+      "id": 1
+    }
+    """
+    When the buy order is validated
+    Then expect the error "buyer: empty address string is not allowed: parse error"
+
+  Scenario: an error is returned if batch key is empty
+    Given the buy order
+    """
+    {
+      "id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y="
+    }
+    """
+    When the buy order is validated
+    Then expect the error "batch key cannot be zero: parse error"
+
+  Scenario: an error is returned if more than one of batch key, class key, or project key is set
+    Given the buy order
+    """
+    {
+      "id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "class_key": 1,
+      "project_key": 1
+    }
+    """
+    When the buy order is validated
+    Then expect the error "only one of batch key, class key, or project key can be set: parse error"
+
+  Scenario: an error is returned if project location is set without class key
+    Given the buy order
+    """
+    {
+      "id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "project_key": 1,
+      "project_location": "US-OR"
+    }
+    """
+    When the buy order is validated
+    Then expect the error "project location can only be set with class key: parse error"
+
+  Scenario: an error is returned if project location is not formatted
+    Given the buy order
+    """
+    {
+      "id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "class_key": 1,
+      "project_location": "foo"
+    }
+    """
+    When the buy order is validated
+    Then expect the error "project location: expected format <country-code>[-<region-code>[ <postal-code>]]: parse error"
+
+  Scenario: an error is returned if dates are set with batch key
+    Given the buy order
+    """
+    {
+      "id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "min_start_date": "2020-01-01T00:00:00Z"
+    }
+    """
+    When the buy order is validated
+    Then expect the error "min start date and max end date cannot be set with batch key: parse error"
+
+  Scenario: an error is returned if quantity is empty
+    Given the buy order
+    """
+    {
+      "id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1
+    }
+    """
+    When the buy order is validated
+    Then expect the error "quantity cannot be empty: parse error"
+
+  Scenario: an error is returned if quantity is not a positive decimal
+    Given the buy order
+    """
+    {
+      "id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "quantity": "-100"
+    }
+    """
+    When the buy order is validated
+    Then expect the error "quantity: expected a non-negative decimal, got -100: invalid decimal string: parse error"
+
+  Scenario: an error is returned if market id is empty
+    Given the buy order
+    """
+    {
+      "id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "quantity": "100"
+    }
+    """
+    When the buy order is validated
+    Then expect the error "market id cannot be zero: parse error"
+
+  Scenario: an error is returned if bid amount is empty
+    Given the buy order
+    """
+    {
+      "id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "quantity": "100",
+      "market_id": 1
+    }
+    """
+    When the buy order is validated
+    Then expect the error "bid amount cannot be empty: parse error"
+
+  Scenario: an error is returned if bid amount is a negative decimal
+    Given the buy order
+    """
+    {
+      "id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "quantity": "100",
+      "market_id": 1,
+      "bid_amount": "-100"
+    }
+    """
+    When the buy order is validated
+    Then expect the error "bid amount: expected a non-negative decimal, got -100: invalid decimal string: parse error"
+
+  Scenario: an error is returned if auto-retire is enabled and retirement jurisdiction is not formatted
+    Given the buy order
+    """
+    {
+      "id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "quantity": "100",
+      "market_id": 1,
+      "bid_amount": "100",
+      "retirement_jurisdiction": "foo"
+    }
+    """
+    When the buy order is validated
+    Then expect the error "retirement jurisdiction: expected format <country-code>[-<region-code>[ <postal-code>]]: parse error"
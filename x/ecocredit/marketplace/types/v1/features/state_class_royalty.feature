@@ -0,0 +1,53 @@
+Feature: ClassRoyalty
+
+  Scenario: a valid class royalty
+    Given the class royalty
+    """
+    {
+      "class_key": 1,
+      "royalty_bps": 500,
+      "recipient": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y="
+    }
+    """
+    When the class royalty is validated
+    Then expect no error
+
+  Scenario: an error is returned if class key is empty
+    Given the class royalty
+    """
+    {}
+    """
+    When the class royalty is validated
+    Then expect the error "class key cannot be zero: parse error"
+
+  Scenario: an error is returned if royalty is zero
+    Given the class royalty
+    """
+    {
+      "class_key": 1
+    }
+    """
+    When the class royalty is validated
+    Then expect the error "royalty cannot be zero: parse error"
+
+  Scenario: an error is returned if royalty exceeds the maximum
+    Given the class royalty
+    """
+    {
+      "class_key": 1,
+      "royalty_bps": 5001
+    }
+    """
+    When the class royalty is validated
+    Then expect the error "royalty cannot exceed 5000 basis points: parse error"
+
+  Scenario: an error is returned if recipient is empty
+    Given the class royalty
+    """
+    {
+      "class_key": 1,
+      "royalty_bps": 500
+    }
+    """
+    When the class royalty is validated
+    Then expect the error "recipient: empty address string is not allowed: parse error"
@@ -0,0 +1,125 @@
+Feature: ForwardContract
+
+  Scenario: a valid forward contract
+    Given the forward contract
+    """
+    {
+      "id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "issuer": "cT0uUyCnS+Uu5A1PvWaJgRFuUgo=",
+      "project_key": 1,
+      "batch_key": 1,
+      "quantity": "100",
+      "delivered_quantity": "10",
+      "market_id": 1,
+      "price_amount": "100",
+      "deadline": "2020-02-01T00:00:00Z"
+    }
+    """
+    When the forward contract is validated
+    Then expect no error
+
+  Scenario: an error is returned if id is empty
+    Given the forward contract
+    """
+    {}
+    """
+    When the forward contract is validated
+    Then expect the error "id cannot be zero: parse error"
+
+  Scenario: an error is returned if buyer is empty
+    Given the forward contract
+    """
+    {
+      "id": 1
+    }
+    """
+    When the forward contract is validated
+    Then expect the error "buyer: empty address string is not allowed: parse error"
+
+  Scenario: an error is returned if issuer is empty
+    Given the forward contract
+    """
+    {
+      "id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y="
+    }
+    """
+    When the forward contract is validated
+    Then expect the error "issuer: empty address string is not allowed: parse error"
+
+  Scenario: an error is returned if project key is empty
+    Given the forward contract
+    """
+    {
+      "id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "issuer": "cT0uUyCnS+Uu5A1PvWaJgRFuUgo="
+    }
+    """
+    When the forward contract is validated
+    Then expect the error "project key cannot be zero: parse error"
+
+  Scenario: an error is returned if delivered quantity is not less than quantity
+    Given the forward contract
+    """
+    {
+      "id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "issuer": "cT0uUyCnS+Uu5A1PvWaJgRFuUgo=",
+      "project_key": 1,
+      "quantity": "100",
+      "delivered_quantity": "100"
+    }
+    """
+    When the forward contract is validated
+    Then expect the error "delivered quantity must be less than quantity: parse error"
+
+  Scenario: an error is returned if market id is empty
+    Given the forward contract
+    """
+    {
+      "id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "issuer": "cT0uUyCnS+Uu5A1PvWaJgRFuUgo=",
+      "project_key": 1,
+      "quantity": "100",
+      "delivered_quantity": "0"
+    }
+    """
+    When the forward contract is validated
+    Then expect the error "market id cannot be zero: parse error"
+
+  Scenario: an error is returned if price amount is not positive
+    Given the forward contract
+    """
+    {
+      "id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "issuer": "cT0uUyCnS+Uu5A1PvWaJgRFuUgo=",
+      "project_key": 1,
+      "quantity": "100",
+      "delivered_quantity": "0",
+      "market_id": 1,
+      "price_amount": "0"
+    }
+    """
+    When the forward contract is validated
+    Then expect the error "price amount must be a positive integer: parse error"
+
+  Scenario: an error is returned if deadline is empty
+    Given the forward contract
+    """
+    {
+      "id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "issuer": "cT0uUyCnS+Uu5A1PvWaJgRFuUgo=",
+      "project_key": 1,
+      "quantity": "100",
+      "delivered_quantity": "0",
+      "market_id": 1,
+      "price_amount": "100"
+    }
+    """
+    When the forward contract is validated
+    Then expect the error "deadline cannot be empty: parse error"
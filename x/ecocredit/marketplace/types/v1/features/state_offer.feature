@@ -0,0 +1,134 @@
+Feature: Offer
+
+  Scenario: a valid offer
+    Given the offer
+    """
+    {
+      "id": 1,
+      "sell_order_id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "quantity": "100",
+      "market_id": 1,
+      "bid_amount": "100",
+      "expiration": "2020-02-01T00:00:00Z"
+    }
+    """
+    When the offer is validated
+    Then expect no error
+
+  Scenario: a valid offer with a counter amount
+    Given the offer
+    """
+    {
+      "id": 1,
+      "sell_order_id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "quantity": "100",
+      "market_id": 1,
+      "bid_amount": "100",
+      "counter_amount": "120",
+      "expiration": "2020-02-01T00:00:00Z"
+    }
+    """
+    When the offer is validated
+    Then expect no error
+
+  Scenario: an error is returned if id is empty
+    Given the offer
+    """
+    {}
+    """
+    When the offer is validated
+    Then expect the error "id cannot be zero: parse error"
+
+  Scenario: an error is returned if sell order id is empty
+    Given the offer
+    """
+    {
+      "id": 1
+    }
+    """
+    When the offer is validated
+    Then expect the error "sell order id cannot be zero: parse error"
+
+  Scenario: an error is returned if buyer is empty
+    Given the offer
+    """
+    {
+      "id": 1,
+      "sell_order_id": 1
+    }
+    """
+    When the offer is validated
+    Then expect the error "buyer: empty address string is not allowed: parse error"
+
+  Scenario: an error is returned if quantity is empty
+    Given the offer
+    """
+    {
+      "id": 1,
+      "sell_order_id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y="
+    }
+    """
+    When the offer is validated
+    Then expect the error "quantity: expected a positive decimal, got : invalid decimal string: parse error"
+
+  Scenario: an error is returned if market id is empty
+    Given the offer
+    """
+    {
+      "id": 1,
+      "sell_order_id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "quantity": "100"
+    }
+    """
+    When the offer is validated
+    Then expect the error "market id cannot be zero: parse error"
+
+  Scenario: an error is returned if bid amount is not positive
+    Given the offer
+    """
+    {
+      "id": 1,
+      "sell_order_id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "quantity": "100",
+      "market_id": 1,
+      "bid_amount": "0"
+    }
+    """
+    When the offer is validated
+    Then expect the error "bid amount must be a positive integer: parse error"
+
+  Scenario: an error is returned if counter amount is not greater than bid amount
+    Given the offer
+    """
+    {
+      "id": 1,
+      "sell_order_id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "quantity": "100",
+      "market_id": 1,
+      "bid_amount": "100",
+      "counter_amount": "100"
+    }
+    """
+    When the offer is validated
+    Then expect the error "counter amount must be an integer greater than bid amount: parse error"
+
+  Scenario: an error is returned if expiration is empty
+    Given the offer
+    """
+    {
+      "id": 1,
+      "sell_order_id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "quantity": "100",
+      "market_id": 1,
+      "bid_amount": "100"
+    }
+    """
+    When the offer is validated
+    Then expect the error "expiration cannot be empty: parse error"
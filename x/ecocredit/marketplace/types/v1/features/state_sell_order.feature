@@ -9,7 +9,8 @@ Feature: SellOrder
       "batch_key": 1,
       "quantity": "100",
       "market_id": 1,
-      "ask_amount": "100"
+      "ask_amount": "100",
+      "sortable_ask_amount": "000000000000000000000000000000000000000000000000000000000000000000000000000100"
     }
     """
     When the sell order is validated
@@ -25,6 +26,7 @@ Feature: SellOrder
       "quantity": "100",
       "market_id": 1,
       "ask_amount": "100",
+      "sortable_ask_amount": "000000000000000000000000000000000000000000000000000000000000000000000000000100",
       "expiration": "2020-01-01T00:00:00Z"
     }
     """
@@ -126,3 +128,57 @@ Feature: SellOrder
     """
     When the sell order is validated
     Then expect the error "ask amount: expected a non-negative decimal, got -100: invalid decimal string: parse error"
+
+  Scenario: an error is returned if sortable ask amount does not match ask amount
+    Given the sell order
+    """
+    {
+      "id": 1,
+      "seller": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "quantity": "100",
+      "market_id": 1,
+      "ask_amount": "100",
+      "sortable_ask_amount": "100"
+    }
+    """
+    When the sell order is validated
+    Then expect the error "sortable ask amount does not match ask amount 100: parse error"
+
+  Scenario: a valid sell order with allowed buyers
+    Given the sell order
+    """
+    {
+      "id": 1,
+      "seller": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "quantity": "100",
+      "market_id": 1,
+      "ask_amount": "100",
+      "sortable_ask_amount": "000000000000000000000000000000000000000000000000000000000000000000000000000100",
+      "allowed_buyers": [
+        "cT0uUyCnS+Uu5A1PvWaJgRFuUgo="
+      ]
+    }
+    """
+    When the sell order is validated
+    Then expect no error
+
+  Scenario: an error is returned if an allowed buyer is empty
+    Given the sell order
+    """
+    {
+      "id": 1,
+      "seller": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "quantity": "100",
+      "market_id": 1,
+      "ask_amount": "100",
+      "sortable_ask_amount": "000000000000000000000000000000000000000000000000000000000000000000000000000100",
+      "allowed_buyers": [
+        ""
+      ]
+    }
+    """
+    When the sell order is validated
+    Then expect the error "allowed buyers[0]: empty address string is not allowed: parse error"
@@ -0,0 +1,43 @@
+Feature: SellOrderAsk
+
+  Scenario: a valid sell order ask
+    Given the sell order ask
+    """
+    {
+      "sell_order_id": 1,
+      "market_id": 2,
+      "ask_amount": "100"
+    }
+    """
+    When the sell order ask is validated
+    Then expect no error
+
+  Scenario: an error is returned if sell order id is empty
+    Given the sell order ask
+    """
+    {}
+    """
+    When the sell order ask is validated
+    Then expect the error "sell order id cannot be zero: parse error"
+
+  Scenario: an error is returned if market id is empty
+    Given the sell order ask
+    """
+    {
+      "sell_order_id": 1
+    }
+    """
+    When the sell order ask is validated
+    Then expect the error "market id cannot be zero: parse error"
+
+  Scenario: an error is returned if ask amount is not a positive integer
+    Given the sell order ask
+    """
+    {
+      "sell_order_id": 1,
+      "market_id": 2,
+      "ask_amount": "-100"
+    }
+    """
+    When the sell order ask is validated
+    Then expect the error "ask amount must be a positive integer: parse error"
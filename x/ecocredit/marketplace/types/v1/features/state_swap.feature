@@ -0,0 +1,116 @@
+Feature: Swap
+
+  Scenario: a valid swap
+    Given the swap
+    """
+    {
+      "id": 1,
+      "proposer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "counterparty": "cT0uUyCnS+Uu5A1PvWaJgRFuUgo=",
+      "offer_batch_key": 1,
+      "offer_quantity": "100",
+      "offer_coins": [
+        {
+          "denom": "regen",
+          "amount": "100"
+        }
+      ],
+      "ask_batch_key": 2,
+      "ask_quantity": "90",
+      "expiration": "2020-02-01T00:00:00Z"
+    }
+    """
+    When the swap is validated
+    Then expect no error
+
+  Scenario: an error is returned if id is empty
+    Given the swap
+    """
+    {}
+    """
+    When the swap is validated
+    Then expect the error "id cannot be zero: parse error"
+
+  Scenario: an error is returned if proposer is empty
+    Given the swap
+    """
+    {
+      "id": 1
+    }
+    """
+    When the swap is validated
+    Then expect the error "proposer: empty address string is not allowed: parse error"
+
+  Scenario: an error is returned if counterparty is empty
+    Given the swap
+    """
+    {
+      "id": 1,
+      "proposer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y="
+    }
+    """
+    When the swap is validated
+    Then expect the error "counterparty: empty address string is not allowed: parse error"
+
+  Scenario: an error is returned if offer batch key is empty
+    Given the swap
+    """
+    {
+      "id": 1,
+      "proposer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "counterparty": "cT0uUyCnS+Uu5A1PvWaJgRFuUgo="
+    }
+    """
+    When the swap is validated
+    Then expect the error "offer batch key cannot be zero: parse error"
+
+  Scenario: an error is returned if offer coins are not valid
+    Given the swap
+    """
+    {
+      "id": 1,
+      "proposer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "counterparty": "cT0uUyCnS+Uu5A1PvWaJgRFuUgo=",
+      "offer_batch_key": 1,
+      "offer_quantity": "100",
+      "offer_coins": [
+        {
+          "denom": "regen",
+          "amount": "-1"
+        }
+      ]
+    }
+    """
+    When the swap is validated
+    Then expect the error "offer coins[0]: negative coin amount: -1: parse error"
+
+  Scenario: an error is returned if ask batch key is the offer batch key
+    Given the swap
+    """
+    {
+      "id": 1,
+      "proposer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "counterparty": "cT0uUyCnS+Uu5A1PvWaJgRFuUgo=",
+      "offer_batch_key": 1,
+      "offer_quantity": "100",
+      "ask_batch_key": 1
+    }
+    """
+    When the swap is validated
+    Then expect the error "ask batch key cannot be the same as offer batch key: parse error"
+
+  Scenario: an error is returned if expiration is empty
+    Given the swap
+    """
+    {
+      "id": 1,
+      "proposer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "counterparty": "cT0uUyCnS+Uu5A1PvWaJgRFuUgo=",
+      "offer_batch_key": 1,
+      "offer_quantity": "100",
+      "ask_batch_key": 2,
+      "ask_quantity": "90"
+    }
+    """
+    When the swap is validated
+    Then expect the error "expiration cannot be empty: parse error"
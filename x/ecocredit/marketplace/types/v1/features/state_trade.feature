@@ -0,0 +1,148 @@
+Feature: Trade
+
+  Scenario: a valid trade
+    Given the trade
+    """
+    {
+      "id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "seller": "cT0uUyCnS+Uu5A1PvWaJgRFuUgo=",
+      "batch_key": 1,
+      "market_id": 1,
+      "quantity": "10.5",
+      "price": "100",
+      "timestamp": "2020-01-01T00:00:00Z"
+    }
+    """
+    When the trade is validated
+    Then expect no error
+
+  Scenario: an error is returned if id is empty
+    Given the trade
+    """
+    {}
+    """
+    When the trade is validated
+    Then expect the error "id cannot be zero: parse error"
+
+  Scenario: an error is returned if buyer is empty
+    Given the trade
+    """
+    {
+      "id": 1
+    }
+    """
+    When the trade is validated
+    Then expect the error "buyer: empty address string is not allowed: parse error"
+
+  Scenario: an error is returned if seller is empty
+    Given the trade
+    """
+    {
+      "id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y="
+    }
+    """
+    When the trade is validated
+    Then expect the error "seller: empty address string is not allowed: parse error"
+
+  Scenario: an error is returned if batch key is empty
+    Given the trade
+    """
+    {
+      "id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "seller": "cT0uUyCnS+Uu5A1PvWaJgRFuUgo="
+    }
+    """
+    When the trade is validated
+    Then expect the error "batch key cannot be zero: parse error"
+
+  Scenario: an error is returned if market id is empty
+    Given the trade
+    """
+    {
+      "id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "seller": "cT0uUyCnS+Uu5A1PvWaJgRFuUgo=",
+      "batch_key": 1
+    }
+    """
+    When the trade is validated
+    Then expect the error "market id cannot be zero: parse error"
+
+  Scenario: an error is returned if quantity is empty
+    Given the trade
+    """
+    {
+      "id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "seller": "cT0uUyCnS+Uu5A1PvWaJgRFuUgo=",
+      "batch_key": 1,
+      "market_id": 1
+    }
+    """
+    When the trade is validated
+    Then expect the error "quantity cannot be empty: parse error"
+
+  Scenario: an error is returned if quantity is not positive
+    Given the trade
+    """
+    {
+      "id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "seller": "cT0uUyCnS+Uu5A1PvWaJgRFuUgo=",
+      "batch_key": 1,
+      "market_id": 1,
+      "quantity": "0"
+    }
+    """
+    When the trade is validated
+    Then expect the error "quantity: expected a positive decimal, got 0: invalid decimal string: parse error"
+
+  Scenario: an error is returned if price is empty
+    Given the trade
+    """
+    {
+      "id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "seller": "cT0uUyCnS+Uu5A1PvWaJgRFuUgo=",
+      "batch_key": 1,
+      "market_id": 1,
+      "quantity": "10.5"
+    }
+    """
+    When the trade is validated
+    Then expect the error "price cannot be empty: parse error"
+
+  Scenario: an error is returned if price is negative
+    Given the trade
+    """
+    {
+      "id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "seller": "cT0uUyCnS+Uu5A1PvWaJgRFuUgo=",
+      "batch_key": 1,
+      "market_id": 1,
+      "quantity": "10.5",
+      "price": "-100"
+    }
+    """
+    When the trade is validated
+    Then expect the error "price: expected a non-negative decimal, got -100: invalid decimal string: parse error"
+
+  Scenario: an error is returned if timestamp is empty
+    Given the trade
+    """
+    {
+      "id": 1,
+      "buyer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "seller": "cT0uUyCnS+Uu5A1PvWaJgRFuUgo=",
+      "batch_key": 1,
+      "market_id": 1,
+      "quantity": "10.5",
+      "price": "100"
+    }
+    """
+    When the trade is validated
+    Then expect the error "timestamp cannot be empty: parse error"
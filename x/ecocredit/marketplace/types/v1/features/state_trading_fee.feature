@@ -0,0 +1,86 @@
+Feature: TradingFee
+
+  Scenario: a valid trading fee
+    Given the trading fee
+    """
+    {
+      "maker_fee_bps": 10,
+      "taker_fee_bps": 20,
+      "destination": "FEE_DESTINATION_BURN"
+    }
+    """
+    When the trading fee is validated
+    Then expect no error
+
+  Scenario: a valid trading fee with module account destination
+    Given the trading fee
+    """
+    {
+      "maker_fee_bps": 10,
+      "taker_fee_bps": 20,
+      "destination": "FEE_DESTINATION_MODULE_ACCOUNT",
+      "module_account": "treasury"
+    }
+    """
+    When the trading fee is validated
+    Then expect no error
+
+  Scenario: a valid trading fee with no fees set
+    Given the trading fee
+    """
+    {}
+    """
+    When the trading fee is validated
+    Then expect no error
+
+  Scenario: an error is returned if maker fee exceeds the maximum
+    Given the trading fee
+    """
+    {
+      "maker_fee_bps": 10001
+    }
+    """
+    When the trading fee is validated
+    Then expect the error "maker fee cannot exceed 10000 basis points: parse error"
+
+  Scenario: an error is returned if taker fee exceeds the maximum
+    Given the trading fee
+    """
+    {
+      "taker_fee_bps": 10001
+    }
+    """
+    When the trading fee is validated
+    Then expect the error "taker fee cannot exceed 10000 basis points: parse error"
+
+  Scenario: an error is returned if destination is unspecified
+    Given the trading fee
+    """
+    {
+      "maker_fee_bps": 10,
+      "taker_fee_bps": 20
+    }
+    """
+    When the trading fee is validated
+    Then expect the error "destination must be specified: parse error"
+
+  Scenario: an error is returned if module account is empty with module account destination
+    Given the trading fee
+    """
+    {
+      "destination": "FEE_DESTINATION_MODULE_ACCOUNT"
+    }
+    """
+    When the trading fee is validated
+    Then expect the error "module account cannot be empty: parse error"
+
+  Scenario: an error is returned if module account is set with another destination
+    Given the trading fee
+    """
+    {
+      "destination": "FEE_DESTINATION_COMMUNITY_POOL",
+      "module_account": "treasury"
+    }
+    """
+    When the trading fee is validated
+    Then expect the error "module account can only be set with destination FEE_DESTINATION_MODULE_ACCOUNT: parse error"
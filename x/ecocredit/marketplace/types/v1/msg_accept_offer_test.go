@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgAcceptOfferSuite struct {
+	t   gocuke.TestingT
+	msg *MsgAcceptOffer
+	err error
+}
+
+func TestMsgAcceptOffer(t *testing.T) {
+	gocuke.NewRunner(t, &msgAcceptOfferSuite{}).Path("./features/msg_accept_offer.feature").Run()
+}
+
+func (s *msgAcceptOfferSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgAcceptOfferSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgAcceptOffer{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgAcceptOfferSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgAcceptOfferSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgAcceptOfferSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -0,0 +1,43 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var _ legacytx.LegacyMsg = &MsgAcceptSwap{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgAcceptSwap) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgAcceptSwap) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgAcceptSwap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgAcceptSwap) ValidateBasic() error {
+	if len(m.Counterparty) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("counterparty cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Counterparty); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("counterparty is not a valid address: %s", err)
+	}
+
+	if m.SwapId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("swap id cannot be empty")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgAcceptSwap.
+func (m *MsgAcceptSwap) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Counterparty)
+	return []sdk.AccAddress{addr}
+}
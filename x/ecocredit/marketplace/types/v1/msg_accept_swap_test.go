@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgAcceptSwapSuite struct {
+	t   gocuke.TestingT
+	msg *MsgAcceptSwap
+	err error
+}
+
+func TestMsgAcceptSwap(t *testing.T) {
+	gocuke.NewRunner(t, &msgAcceptSwapSuite{}).Path("./features/msg_accept_swap.feature").Run()
+}
+
+func (s *msgAcceptSwapSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgAcceptSwapSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgAcceptSwap{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgAcceptSwapSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgAcceptSwapSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgAcceptSwapSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
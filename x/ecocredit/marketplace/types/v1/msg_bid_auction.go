@@ -0,0 +1,64 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+var _ legacytx.LegacyMsg = &MsgBidAuction{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgBidAuction) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgBidAuction) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgBidAuction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgBidAuction) ValidateBasic() error {
+	if len(m.Bidder) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("bidder cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Bidder); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("bidder is not a valid address: %s", err)
+	}
+
+	if m.AuctionId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("auction id cannot be empty")
+	}
+
+	if err := validatePrice("bid price", m.BidPrice); err != nil {
+		return err
+	}
+
+	if !m.DisableAutoRetire {
+		if err := base.ValidateJurisdiction(m.RetirementJurisdiction); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("retirement jurisdiction: %s", err)
+		}
+	}
+
+	if len(m.RetirementReason) > base.MaxNoteLength {
+		return ecocredit.ErrMaxLimit.Wrapf("retirement reason: max length %d", base.MaxNoteLength)
+	}
+
+	if len(m.RetirementBeneficiary) > base.MaxNoteLength {
+		return ecocredit.ErrMaxLimit.Wrapf("retirement beneficiary: max length %d", base.MaxNoteLength)
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgBidAuction.
+func (m *MsgBidAuction) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Bidder)
+	return []sdk.AccAddress{addr}
+}
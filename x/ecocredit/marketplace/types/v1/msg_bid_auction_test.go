@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgBidAuctionSuite struct {
+	t   gocuke.TestingT
+	msg *MsgBidAuction
+	err error
+}
+
+func TestMsgBidAuction(t *testing.T) {
+	gocuke.NewRunner(t, &msgBidAuctionSuite{}).Path("./features/msg_bid_auction.feature").Run()
+}
+
+func (s *msgBidAuctionSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgBidAuctionSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgBidAuction{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgBidAuctionSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgBidAuctionSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgBidAuctionSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 
 	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
 	"github.com/regen-network/regen-ledger/x/ecocredit/base"
 )
 
@@ -72,6 +73,14 @@ func (m MsgBuyDirect) ValidateBasic() error {
 				return sdkerrors.ErrInvalidRequest.Wrapf("%s: retirement jurisdiction: %s", orderIndex, err)
 			}
 		}
+
+		if len(order.RetirementReason) > base.MaxNoteLength {
+			return ecocredit.ErrMaxLimit.Wrapf("%s: retirement reason: max length %d", orderIndex, base.MaxNoteLength)
+		}
+
+		if len(order.RetirementBeneficiary) > base.MaxNoteLength {
+			return ecocredit.ErrMaxLimit.Wrapf("%s: retirement beneficiary: max length %d", orderIndex, base.MaxNoteLength)
+		}
 	}
 
 	return nil
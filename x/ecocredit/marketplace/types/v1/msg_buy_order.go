@@ -0,0 +1,158 @@
+package v1
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+var _ legacytx.LegacyMsg = &MsgBuyOrder{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgBuyOrder) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgBuyOrder) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgBuyOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgBuyOrder) ValidateBasic() error {
+	if len(m.Buyer) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("buyer cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Buyer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("buyer is not a valid address: %s", err)
+	}
+
+	if len(m.Orders) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("orders cannot be empty")
+	}
+
+	for i, order := range m.Orders {
+		// orderIndex is used for more granular error messages when
+		// an individual order in a list of orders fails to process
+		orderIndex := fmt.Sprintf("orders[%d]", i)
+
+		if err := order.validateSelector(orderIndex); err != nil {
+			return err
+		}
+
+		if len(order.Quantity) == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: quantity cannot be empty", orderIndex)
+		}
+
+		if _, err := math.NewPositiveDecFromString(order.Quantity); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: quantity must be a positive decimal", orderIndex)
+		}
+
+		// sdk.Coin.Validate panics if coin is nil
+		if order.BidPrice == nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: bid price: cannot be empty", orderIndex)
+		}
+
+		// sdk.Coin.Validate provides inadequate error if coin denom is empty
+		if len(order.BidPrice.Denom) == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: bid price: denom cannot be empty", orderIndex)
+		}
+
+		if err := sdk.ValidateDenom(order.BidPrice.Denom); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: bid price: %s", orderIndex, err)
+		}
+
+		// sdk.Coin.Validate panics if coin amount is nil
+		if order.BidPrice.Amount.IsNil() {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: bid price: amount cannot be empty", orderIndex)
+		}
+
+		// sdk.Coin.Validate provides inadequate error if coin amount is not a positive integer
+		if !order.BidPrice.Amount.IsPositive() {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: bid price: amount must be a positive integer", orderIndex)
+		}
+
+		if !order.DisableAutoRetire {
+			if err := base.ValidateJurisdiction(order.RetirementJurisdiction); err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("%s: retirement jurisdiction: %s", orderIndex, err)
+			}
+		}
+
+		if len(order.RetirementReason) > base.MaxNoteLength {
+			return ecocredit.ErrMaxLimit.Wrapf("%s: retirement reason: max length %d", orderIndex, base.MaxNoteLength)
+		}
+
+		if len(order.RetirementBeneficiary) > base.MaxNoteLength {
+			return ecocredit.ErrMaxLimit.Wrapf("%s: retirement beneficiary: max length %d", orderIndex, base.MaxNoteLength)
+		}
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgBuyOrder.
+func (m *MsgBuyOrder) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Buyer)
+	return []sdk.AccAddress{addr}
+}
+
+// validateSelector validates that exactly one of batch denom, class selector, or
+// project selector is set and that the selector criteria are valid.
+func (o *MsgBuyOrder_Order) validateSelector(orderIndex string) error {
+	if o.ClassSelector == nil && o.ProjectSelector == nil {
+		if err := base.ValidateBatchDenom(o.BatchDenom); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: batch denom: %s", orderIndex, err)
+		}
+		return nil
+	}
+
+	if len(o.BatchDenom) != 0 || (o.ClassSelector != nil && o.ProjectSelector != nil) {
+		return sdkerrors.ErrInvalidRequest.Wrapf(
+			"%s: only one of batch denom, class selector, or project selector can be set", orderIndex,
+		)
+	}
+
+	if o.ClassSelector != nil {
+		if err := base.ValidateClassID(o.ClassSelector.ClassId); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: class selector: class id: %s", orderIndex, err)
+		}
+
+		if len(o.ClassSelector.ProjectLocation) != 0 {
+			if err := base.ValidateJurisdiction(o.ClassSelector.ProjectLocation); err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("%s: class selector: project location: %s", orderIndex, err)
+			}
+		}
+
+		if err := validateDateRange(o.ClassSelector.MinStartDate, o.ClassSelector.MaxEndDate); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: class selector: %s", orderIndex, err)
+		}
+
+		return nil
+	}
+
+	if err := base.ValidateProjectID(o.ProjectSelector.ProjectId); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("%s: project selector: project id: %s", orderIndex, err)
+	}
+
+	if err := validateDateRange(o.ProjectSelector.MinStartDate, o.ProjectSelector.MaxEndDate); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("%s: project selector: %s", orderIndex, err)
+	}
+
+	return nil
+}
+
+func validateDateRange(minStartDate, maxEndDate *time.Time) error {
+	if minStartDate != nil && maxEndDate != nil && !minStartDate.Before(*maxEndDate) {
+		return fmt.Errorf("min start date must be before max end date")
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgBuyOrderSuite struct {
+	t   gocuke.TestingT
+	msg *MsgBuyOrder
+	err error
+}
+
+func TestMsgBuyOrder(t *testing.T) {
+	gocuke.NewRunner(t, &msgBuyOrderSuite{}).Path("./features/msg_buy_order.feature").Run()
+}
+
+func (s *msgBuyOrderSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgBuyOrderSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgBuyOrder{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgBuyOrderSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgBuyOrderSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgBuyOrderSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
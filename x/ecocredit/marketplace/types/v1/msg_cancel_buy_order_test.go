@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgCancelBuyOrderSuite struct {
+	t   gocuke.TestingT
+	msg *MsgCancelBuyOrder
+	err error
+}
+
+func TestMsgCancelBuyOrder(t *testing.T) {
+	gocuke.NewRunner(t, &msgCancelBuyOrderSuite{}).Path("./features/msg_cancel_buy_order.feature").Run()
+}
+
+func (s *msgCancelBuyOrderSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgCancelBuyOrderSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgCancelBuyOrder{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgCancelBuyOrderSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgCancelBuyOrderSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgCancelBuyOrderSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -0,0 +1,75 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+var _ legacytx.LegacyMsg = &MsgCancelSellOrders{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgCancelSellOrders) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgCancelSellOrders) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCancelSellOrders) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgCancelSellOrders) ValidateBasic() error {
+	if len(m.Seller) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("seller cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Seller); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("seller is not a valid address: %s", err)
+	}
+
+	if m.CancelAll {
+		if len(m.SellOrderIds) != 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("sell order ids must be empty when cancelling all sell orders")
+		}
+
+		if len(m.BatchDenom) != 0 {
+			if err := base.ValidateBatchDenom(m.BatchDenom); err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("batch denom: %s", err)
+			}
+		}
+
+		return nil
+	}
+
+	if len(m.SellOrderIds) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("sell order ids cannot be empty")
+	}
+
+	if len(m.BatchDenom) != 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("batch denom can only be set when cancelling all sell orders")
+	}
+
+	seen := make(map[uint64]bool, len(m.SellOrderIds))
+	for i, id := range m.SellOrderIds {
+		if id == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrapf("sell_order_ids[%d]: sell order id cannot be empty", i)
+		}
+
+		if seen[id] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("sell_order_ids[%d]: duplicate sell order id %d", i, id)
+		}
+		seen[id] = true
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgCancelSellOrders.
+func (m *MsgCancelSellOrders) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Seller)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgCancelSellOrdersSuite struct {
+	t   gocuke.TestingT
+	msg *MsgCancelSellOrders
+	err error
+}
+
+func TestMsgCancelSellOrders(t *testing.T) {
+	gocuke.NewRunner(t, &msgCancelSellOrdersSuite{}).Path("./features/msg_cancel_sell_orders.feature").Run()
+}
+
+func (s *msgCancelSellOrdersSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgCancelSellOrdersSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgCancelSellOrders{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgCancelSellOrdersSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgCancelSellOrdersSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgCancelSellOrdersSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -0,0 +1,43 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var _ legacytx.LegacyMsg = &MsgCounterOffer{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgCounterOffer) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgCounterOffer) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCounterOffer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgCounterOffer) ValidateBasic() error {
+	if len(m.Sender) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("sender cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("sender is not a valid address: %s", err)
+	}
+
+	if m.OfferId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("offer id cannot be empty")
+	}
+
+	return validatePrice("price", m.Price)
+}
+
+// GetSigners returns the expected signers for MsgCounterOffer.
+func (m *MsgCounterOffer) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgCounterOfferSuite struct {
+	t   gocuke.TestingT
+	msg *MsgCounterOffer
+	err error
+}
+
+func TestMsgCounterOffer(t *testing.T) {
+	gocuke.NewRunner(t, &msgCounterOfferSuite{}).Path("./features/msg_counter_offer.feature").Run()
+}
+
+func (s *msgCounterOfferSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgCounterOfferSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgCounterOffer{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgCounterOfferSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgCounterOfferSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgCounterOfferSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
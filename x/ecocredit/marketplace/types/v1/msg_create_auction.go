@@ -0,0 +1,114 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+var _ legacytx.LegacyMsg = &MsgCreateAuction{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgCreateAuction) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgCreateAuction) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCreateAuction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgCreateAuction) ValidateBasic() error {
+	if len(m.Seller) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("seller cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Seller); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("seller is not a valid address: %s", err)
+	}
+
+	if err := base.ValidateBatchDenom(m.BatchDenom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("batch denom: %s", err)
+	}
+
+	if len(m.Quantity) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("quantity cannot be empty")
+	}
+
+	if _, err := math.NewPositiveDecFromString(m.Quantity); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("quantity must be a positive decimal")
+	}
+
+	if err := validatePrice("start price", m.StartPrice); err != nil {
+		return err
+	}
+
+	switch m.AuctionType {
+	case AuctionType_AUCTION_TYPE_ENGLISH:
+		if m.EndPrice != nil {
+			return sdkerrors.ErrInvalidRequest.Wrap("end price must be empty for an english auction")
+		}
+	case AuctionType_AUCTION_TYPE_DUTCH:
+		if err := validatePrice("end price", m.EndPrice); err != nil {
+			return err
+		}
+
+		if m.EndPrice.Denom != m.StartPrice.Denom {
+			return sdkerrors.ErrInvalidRequest.Wrapf(
+				"end price denom %s does not match start price denom %s", m.EndPrice.Denom, m.StartPrice.Denom,
+			)
+		}
+
+		if !m.EndPrice.Amount.LT(m.StartPrice.Amount) {
+			return sdkerrors.ErrInvalidRequest.Wrap("end price must be less than start price for a dutch auction")
+		}
+	default:
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid auction type: %s", m.AuctionType)
+	}
+
+	if m.EndTime == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("end time cannot be empty")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgCreateAuction.
+func (m *MsgCreateAuction) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Seller)
+	return []sdk.AccAddress{addr}
+}
+
+// validatePrice performs basic validation of a price per credit.
+func validatePrice(name string, price *sdk.Coin) error {
+	// sdk.Coin.Validate panics if coin is nil
+	if price == nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("%s: cannot be empty", name)
+	}
+
+	// sdk.Coin.Validate provides inadequate error if coin denom is empty
+	if len(price.Denom) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("%s: denom cannot be empty", name)
+	}
+
+	if err := sdk.ValidateDenom(price.Denom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("%s: %s", name, err)
+	}
+
+	// sdk.Coin.Validate panics if coin amount is nil
+	if price.Amount.IsNil() {
+		return sdkerrors.ErrInvalidRequest.Wrapf("%s: amount cannot be empty", name)
+	}
+
+	// sdk.Coin.Validate provides inadequate error if coin amount is not a positive integer
+	if !price.Amount.IsPositive() {
+		return sdkerrors.ErrInvalidRequest.Wrapf("%s: amount must be a positive integer", name)
+	}
+
+	return nil
+}
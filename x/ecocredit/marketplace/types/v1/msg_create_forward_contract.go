@@ -0,0 +1,86 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+var _ legacytx.LegacyMsg = &MsgCreateForwardContract{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgCreateForwardContract) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgCreateForwardContract) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCreateForwardContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgCreateForwardContract) ValidateBasic() error {
+	if len(m.Buyer) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("buyer cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Buyer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("buyer is not a valid address: %s", err)
+	}
+
+	if len(m.Issuer) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("issuer cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Issuer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("issuer is not a valid address: %s", err)
+	}
+
+	if m.Buyer == m.Issuer {
+		return sdkerrors.ErrInvalidRequest.Wrap("buyer and issuer cannot be the same account")
+	}
+
+	if err := base.ValidateProjectID(m.ProjectId); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("project id: %s", err)
+	}
+
+	if len(m.BatchDenom) != 0 {
+		if err := base.ValidateBatchDenom(m.BatchDenom); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("batch denom: %s", err)
+		}
+
+		if base.GetProjectIDFromBatchDenom(m.BatchDenom) != m.ProjectId {
+			return sdkerrors.ErrInvalidRequest.Wrapf(
+				"batch denom %s does not belong to project %s", m.BatchDenom, m.ProjectId,
+			)
+		}
+	}
+
+	if len(m.Quantity) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("quantity cannot be empty")
+	}
+
+	if _, err := math.NewPositiveDecFromString(m.Quantity); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("quantity must be a positive decimal")
+	}
+
+	if err := validatePrice("price", m.Price); err != nil {
+		return err
+	}
+
+	if m.Deadline == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("deadline cannot be empty")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgCreateForwardContract.
+func (m *MsgCreateForwardContract) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Buyer)
+	return []sdk.AccAddress{addr}
+}
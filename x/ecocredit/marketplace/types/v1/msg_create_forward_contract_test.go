@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgCreateForwardContractSuite struct {
+	t   gocuke.TestingT
+	msg *MsgCreateForwardContract
+	err error
+}
+
+func TestMsgCreateForwardContract(t *testing.T) {
+	gocuke.NewRunner(t, &msgCreateForwardContractSuite{}).Path("./features/msg_create_forward_contract.feature").Run()
+}
+
+func (s *msgCreateForwardContractSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgCreateForwardContractSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgCreateForwardContract{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgCreateForwardContractSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgCreateForwardContractSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgCreateForwardContractSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -0,0 +1,77 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+var _ legacytx.LegacyMsg = &MsgMakeOffer{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgMakeOffer) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgMakeOffer) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgMakeOffer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgMakeOffer) ValidateBasic() error {
+	if len(m.Buyer) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("buyer cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Buyer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("buyer is not a valid address: %s", err)
+	}
+
+	if m.SellOrderId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("sell order id cannot be empty")
+	}
+
+	if len(m.Quantity) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("quantity cannot be empty")
+	}
+
+	if _, err := math.NewPositiveDecFromString(m.Quantity); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("quantity must be a positive decimal")
+	}
+
+	if err := validatePrice("bid price", m.BidPrice); err != nil {
+		return err
+	}
+
+	if !m.DisableAutoRetire {
+		if err := base.ValidateJurisdiction(m.RetirementJurisdiction); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("retirement jurisdiction: %s", err)
+		}
+	}
+
+	if len(m.RetirementReason) > base.MaxNoteLength {
+		return ecocredit.ErrMaxLimit.Wrapf("retirement reason: max length %d", base.MaxNoteLength)
+	}
+
+	if len(m.RetirementBeneficiary) > base.MaxNoteLength {
+		return ecocredit.ErrMaxLimit.Wrapf("retirement beneficiary: max length %d", base.MaxNoteLength)
+	}
+
+	if m.Expiration == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("expiration cannot be empty")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgMakeOffer.
+func (m *MsgMakeOffer) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Buyer)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,82 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+var _ legacytx.LegacyMsg = &MsgProposeSwap{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgProposeSwap) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgProposeSwap) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgProposeSwap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgProposeSwap) ValidateBasic() error {
+	if len(m.Proposer) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("proposer cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Proposer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("proposer is not a valid address: %s", err)
+	}
+
+	if len(m.Counterparty) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("counterparty cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Counterparty); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("counterparty is not a valid address: %s", err)
+	}
+
+	if m.Proposer == m.Counterparty {
+		return sdkerrors.ErrInvalidRequest.Wrap("proposer and counterparty cannot be the same account")
+	}
+
+	if err := base.ValidateBatchDenom(m.OfferBatchDenom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("offer batch denom: %s", err)
+	}
+
+	if _, err := math.NewPositiveDecFromString(m.OfferQuantity); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("offer quantity must be a positive decimal")
+	}
+
+	if err := m.OfferCoins.Validate(); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("offer coins: %s", err)
+	}
+
+	if err := base.ValidateBatchDenom(m.AskBatchDenom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("ask batch denom: %s", err)
+	}
+
+	if m.AskBatchDenom == m.OfferBatchDenom {
+		return sdkerrors.ErrInvalidRequest.Wrap("ask batch denom cannot be the same as offer batch denom")
+	}
+
+	if _, err := math.NewPositiveDecFromString(m.AskQuantity); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("ask quantity must be a positive decimal")
+	}
+
+	if m.Expiration == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("expiration cannot be empty")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgProposeSwap.
+func (m *MsgProposeSwap) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Proposer)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgProposeSwapSuite struct {
+	t   gocuke.TestingT
+	msg *MsgProposeSwap
+	err error
+}
+
+func TestMsgProposeSwap(t *testing.T) {
+	gocuke.NewRunner(t, &msgProposeSwapSuite{}).Path("./features/msg_propose_swap.feature").Run()
+}
+
+func (s *msgProposeSwapSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgProposeSwapSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgProposeSwap{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgProposeSwapSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgProposeSwapSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgProposeSwapSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -0,0 +1,43 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var _ legacytx.LegacyMsg = &MsgRejectOffer{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgRejectOffer) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgRejectOffer) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRejectOffer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgRejectOffer) ValidateBasic() error {
+	if len(m.Sender) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("sender cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("sender is not a valid address: %s", err)
+	}
+
+	if m.OfferId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("offer id cannot be empty")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgRejectOffer.
+func (m *MsgRejectOffer) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgRejectOfferSuite struct {
+	t   gocuke.TestingT
+	msg *MsgRejectOffer
+	err error
+}
+
+func TestMsgRejectOffer(t *testing.T) {
+	gocuke.NewRunner(t, &msgRejectOfferSuite{}).Path("./features/msg_reject_offer.feature").Run()
+}
+
+func (s *msgRejectOfferSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgRejectOfferSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgRejectOffer{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgRejectOfferSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgRejectOfferSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgRejectOfferSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
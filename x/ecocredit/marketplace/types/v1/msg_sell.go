@@ -78,6 +78,53 @@ func (m *MsgSell) ValidateBasic() error {
 		if !order.AskPrice.Amount.IsPositive() {
 			return sdkerrors.ErrInvalidRequest.Wrapf("%s: ask price: amount must be a positive integer", orderIndex)
 		}
+
+		allowedBuyers := make(map[string]bool, len(order.AllowedBuyers))
+		for j, buyer := range order.AllowedBuyers {
+			if _, err := sdk.AccAddressFromBech32(buyer); err != nil {
+				return sdkerrors.ErrInvalidAddress.Wrapf("%s: allowed buyers[%d]: %s", orderIndex, j, err)
+			}
+
+			if buyer == m.Seller {
+				return sdkerrors.ErrInvalidRequest.Wrapf("%s: allowed buyers[%d]: seller cannot be an allowed buyer", orderIndex, j)
+			}
+
+			if allowedBuyers[buyer] {
+				return sdkerrors.ErrInvalidRequest.Wrapf("%s: allowed buyers[%d]: duplicate address %s", orderIndex, j, buyer)
+			}
+			allowedBuyers[buyer] = true
+		}
+
+		name := fmt.Sprintf("%s: additional ask prices", orderIndex)
+		if err := validateAdditionalAskPrices(name, order.AskPrice.Denom, order.AdditionalAskPrices); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateAdditionalAskPrices validates the additional ask prices of a sell
+// order, i.e. each price is valid and has a unique denom other than the denom
+// of the ask price.
+func validateAdditionalAskPrices(name, askDenom string, prices []sdk.Coin) error {
+	denoms := make(map[string]bool, len(prices))
+	for i := range prices {
+		price := prices[i]
+		priceName := fmt.Sprintf("%s[%d]", name, i)
+
+		if err := validatePrice(priceName, &price); err != nil {
+			return err
+		}
+
+		if price.Denom == askDenom {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: denom cannot be the same as the ask price denom", priceName)
+		}
+
+		if denoms[price.Denom] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: duplicate denom %s", priceName, price.Denom)
+		}
+		denoms[price.Denom] = true
 	}
 
 	return nil
@@ -0,0 +1,56 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+var _ legacytx.LegacyMsg = &MsgUpdateClassRoyalty{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgUpdateClassRoyalty) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgUpdateClassRoyalty) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateClassRoyalty) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgUpdateClassRoyalty) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("admin: %s", err)
+	}
+
+	if err := base.ValidateClassID(m.ClassId); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("class id: %s", err)
+	}
+
+	if m.RoyaltyBps > MaxRoyaltyBps {
+		return sdkerrors.ErrInvalidRequest.Wrapf("royalty cannot exceed %d basis points", MaxRoyaltyBps)
+	}
+
+	if m.RoyaltyBps == 0 {
+		if len(m.Recipient) != 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("recipient must be empty when royalty is zero")
+		}
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("recipient: %s", err)
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgUpdateClassRoyalty.
+func (m MsgUpdateClassRoyalty) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Admin)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgUpdateClassRoyaltySuite struct {
+	t   gocuke.TestingT
+	msg *MsgUpdateClassRoyalty
+	err error
+}
+
+func TestMsgUpdateClassRoyaltySuite(t *testing.T) {
+	gocuke.NewRunner(t, &msgUpdateClassRoyaltySuite{}).Path("./features/msg_update_class_royalty.feature").Run()
+}
+
+func (s *msgUpdateClassRoyaltySuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgUpdateClassRoyaltySuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgUpdateClassRoyalty{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgUpdateClassRoyaltySuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgUpdateClassRoyaltySuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgUpdateClassRoyaltySuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -75,6 +75,17 @@ func (m *MsgUpdateSellOrders) ValidateBasic() error {
 		if !update.NewAskPrice.Amount.IsPositive() {
 			return sdkerrors.ErrInvalidRequest.Wrapf("%s: new ask price: amount must be a positive integer", updateIndex)
 		}
+
+		if update.ClearAdditionalAskPrices && len(update.NewAdditionalAskPrices) != 0 {
+			return sdkerrors.ErrInvalidRequest.Wrapf(
+				"%s: new additional ask prices cannot be set when clearing additional ask prices", updateIndex,
+			)
+		}
+
+		name := fmt.Sprintf("%s: new additional ask prices", updateIndex)
+		if err := validateAdditionalAskPrices(name, update.NewAskPrice.Denom, update.NewAdditionalAskPrices); err != nil {
+			return err
+		}
 	}
 
 	return nil
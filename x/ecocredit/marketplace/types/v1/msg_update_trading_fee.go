@@ -0,0 +1,63 @@
+package v1
+
+import (
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var _ legacytx.LegacyMsg = &MsgUpdateTradingFee{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgUpdateTradingFee) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgUpdateTradingFee) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateTradingFee) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgUpdateTradingFee) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errors.Wrapf(err, "invalid authority address")
+	}
+
+	if m.MakerFeeBps > MaxFeeBps {
+		return sdkerrors.ErrInvalidRequest.Wrapf("maker fee cannot exceed %d basis points", MaxFeeBps)
+	}
+
+	if m.TakerFeeBps > MaxFeeBps {
+		return sdkerrors.ErrInvalidRequest.Wrapf("taker fee cannot exceed %d basis points", MaxFeeBps)
+	}
+
+	if m.Destination == FeeDestination_FEE_DESTINATION_UNSPECIFIED {
+		return sdkerrors.ErrInvalidRequest.Wrap("destination must be specified")
+	}
+
+	if _, ok := FeeDestination_name[int32(m.Destination)]; !ok {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid destination: %d", m.Destination)
+	}
+
+	if m.Destination == FeeDestination_FEE_DESTINATION_MODULE_ACCOUNT {
+		if m.ModuleAccount == "" {
+			return sdkerrors.ErrInvalidRequest.Wrap("module account cannot be empty")
+		}
+	} else if m.ModuleAccount != "" {
+		return sdkerrors.ErrInvalidRequest.Wrapf(
+			"module account can only be set with destination %s", FeeDestination_FEE_DESTINATION_MODULE_ACCOUNT,
+		)
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgUpdateTradingFee.
+func (m MsgUpdateTradingFee) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgUpdateTradingFeeSuite struct {
+	t   gocuke.TestingT
+	msg *MsgUpdateTradingFee
+	err error
+}
+
+func TestMsgUpdateTradingFeeSuite(t *testing.T) {
+	gocuke.NewRunner(t, &msgUpdateTradingFeeSuite{}).Path("./features/msg_update_trading_fee.feature").Run()
+}
+
+func (s *msgUpdateTradingFeeSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgUpdateTradingFeeSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgUpdateTradingFee{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgUpdateTradingFeeSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgUpdateTradingFeeSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgUpdateTradingFeeSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -6,6 +6,7 @@ package v1
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/api/cosmos/orm/v1"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	proto "github.com/gogo/protobuf/proto"
 	types "github.com/gogo/protobuf/types"
 	io "io"
@@ -24,6 +25,87 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// FeeDestination is the destination of the trading fees charged when credits
+// are purchased in the marketplace.
+//
+// Since Revision 1
+type FeeDestination int32
+
+const (
+	// FEE_DESTINATION_UNSPECIFIED is the default value and is not a valid
+	// destination.
+	FeeDestination_FEE_DESTINATION_UNSPECIFIED FeeDestination = 0
+	// FEE_DESTINATION_BURN burns the trading fees.
+	FeeDestination_FEE_DESTINATION_BURN FeeDestination = 1
+	// FEE_DESTINATION_COMMUNITY_POOL sends the trading fees to the community
+	// pool.
+	FeeDestination_FEE_DESTINATION_COMMUNITY_POOL FeeDestination = 2
+	// FEE_DESTINATION_MODULE_ACCOUNT sends the trading fees to a module account.
+	FeeDestination_FEE_DESTINATION_MODULE_ACCOUNT FeeDestination = 3
+)
+
+var FeeDestination_name = map[int32]string{
+	0: "FEE_DESTINATION_UNSPECIFIED",
+	1: "FEE_DESTINATION_BURN",
+	2: "FEE_DESTINATION_COMMUNITY_POOL",
+	3: "FEE_DESTINATION_MODULE_ACCOUNT",
+}
+
+var FeeDestination_value = map[string]int32{
+	"FEE_DESTINATION_UNSPECIFIED":    0,
+	"FEE_DESTINATION_BURN":           1,
+	"FEE_DESTINATION_COMMUNITY_POOL": 2,
+	"FEE_DESTINATION_MODULE_ACCOUNT": 3,
+}
+
+func (x FeeDestination) String() string {
+	return proto.EnumName(FeeDestination_name, int32(x))
+}
+
+func (FeeDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{0}
+}
+
+// AuctionType is the type of an auction.
+//
+// Since Revision 1
+type AuctionType int32
+
+const (
+	// AUCTION_TYPE_UNSPECIFIED is the default value and is not a valid auction
+	// type.
+	AuctionType_AUCTION_TYPE_UNSPECIFIED AuctionType = 0
+	// AUCTION_TYPE_ENGLISH is an ascending price auction. Each bid must be at
+	// least the start price and higher than the current highest bid. The highest
+	// bidder wins the auction when the end time is reached.
+	AuctionType_AUCTION_TYPE_ENGLISH AuctionType = 1
+	// AUCTION_TYPE_DUTCH is a descending price auction. The price decreases
+	// linearly from the start price to the end price over the duration of the
+	// auction and the first bid at or above the current price wins the auction
+	// at the current price.
+	AuctionType_AUCTION_TYPE_DUTCH AuctionType = 2
+)
+
+var AuctionType_name = map[int32]string{
+	0: "AUCTION_TYPE_UNSPECIFIED",
+	1: "AUCTION_TYPE_ENGLISH",
+	2: "AUCTION_TYPE_DUTCH",
+}
+
+var AuctionType_value = map[string]int32{
+	"AUCTION_TYPE_UNSPECIFIED": 0,
+	"AUCTION_TYPE_ENGLISH":     1,
+	"AUCTION_TYPE_DUTCH":       2,
+}
+
+func (x AuctionType) String() string {
+	return proto.EnumName(AuctionType_name, int32(x))
+}
+
+func (AuctionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{1}
+}
+
 // SellOrder represents the information for a sell order.
 type SellOrder struct {
 	// id is the unique ID of sell order.
@@ -53,6 +135,21 @@ type SellOrder struct {
 	// maker indicates that this is a maker order, meaning that when it hit
 	// the order book, there were no matching buy orders.
 	Maker bool `protobuf:"varint,10,opt,name=maker,proto3" json:"maker,omitempty"`
+	// allowed_buyers is an optional list of the addresses of the accounts that
+	// are allowed to buy credits from the sell order. An allowed buyer may be a
+	// group policy address, in which case credits can only be bought through a
+	// proposal executed by the group policy. If empty, any account can buy
+	// credits from the sell order.
+	//
+	// Since Revision 1
+	AllowedBuyers [][]byte `protobuf:"bytes,11,rep,name=allowed_buyers,json=allowedBuyers,proto3" json:"allowed_buyers,omitempty"`
+	// sortable_ask_amount is the ask_amount encoded as a zero-padded decimal
+	// string of fixed length so that sell orders can be sorted and filtered by
+	// ask amount using the market_id and sortable_ask_amount index. It is set
+	// by the module whenever ask_amount is set.
+	//
+	// Since Revision 1
+	SortableAskAmount string `protobuf:"bytes,12,opt,name=sortable_ask_amount,json=sortableAskAmount,proto3" json:"sortable_ask_amount,omitempty"`
 }
 
 func (m *SellOrder) Reset()         { *m = SellOrder{} }
@@ -151,6 +248,257 @@ func (m *SellOrder) GetMaker() bool {
 	return false
 }
 
+func (m *SellOrder) GetAllowedBuyers() [][]byte {
+	if m != nil {
+		return m.AllowedBuyers
+	}
+	return nil
+}
+
+func (m *SellOrder) GetSortableAskAmount() string {
+	if m != nil {
+		return m.SortableAskAmount
+	}
+	return ""
+}
+
+// BuyOrder represents the information for a buy order. The bid amount
+// multiplied by the quantity is held in escrow by the ecocredit module account
+// until the buy order is filled, cancelled, or expired.
+//
+// Since Revision 1
+type BuyOrder struct {
+	// id is the unique ID of buy order.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// buyer is the address of the account that is buying credits.
+	Buyer []byte `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// batch_key is the table row identifier of the credit batch used internally
+	// for efficient lookups. This links a buy order to the credit batch that the
+	// buyer is bidding on. Exactly one of batch_key, class_key, or project_key is
+	// set.
+	BatchKey uint64 `protobuf:"varint,3,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// quantity is the decimal quantity of credits remaining to be bought.
+	Quantity string `protobuf:"bytes,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// market_id is the market in which this buy order exists and specifies
+	// the bank_denom that bid_amount corresponds to forming the bid_price.
+	MarketId uint64 `protobuf:"varint,5,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// bid_amount is the integer amount (encoded as a string) that the buyer is
+	// willing to pay for each credit unit. Each credit unit will be bought for
+	// at most the bid_amount. The bid_amount corresponds to the Market.denom to
+	// form the bid price.
+	BidAmount string `protobuf:"bytes,6,opt,name=bid_amount,json=bidAmount,proto3" json:"bid_amount,omitempty"`
+	// disable_auto_retire disables auto-retirement of credits which allows the
+	// buyer to receive credits in a tradable state. A buy order with
+	// disable_auto_retire set to true will only be matched with sell orders that
+	// also have disable_auto_retire set to true.
+	DisableAutoRetire bool `protobuf:"varint,7,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
+	// retirement_jurisdiction is the optional retirement jurisdiction for the
+	// credits which will be used only if disable_auto_retire is false.
+	RetirementJurisdiction string `protobuf:"bytes,8,opt,name=retirement_jurisdiction,json=retirementJurisdiction,proto3" json:"retirement_jurisdiction,omitempty"`
+	// expiration is an optional timestamp when the buy order expires. When the
+	// expiration time is reached, the buy order is removed from state and the
+	// remaining escrowed funds are returned to the buyer.
+	Expiration *types.Timestamp `protobuf:"bytes,9,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// maker indicates that this is a maker order, meaning that when it hit
+	// the order book, there were no matching sell orders.
+	Maker bool `protobuf:"varint,10,opt,name=maker,proto3" json:"maker,omitempty"`
+	// class_key is the table row identifier of the credit class used internally
+	// for efficient lookups. If set, the buy order can be filled with credits
+	// from any credit batch within the credit class that meets the criteria.
+	ClassKey uint64 `protobuf:"varint,11,opt,name=class_key,json=classKey,proto3" json:"class_key,omitempty"`
+	// project_key is the table row identifier of the project used internally
+	// for efficient lookups. If set, the buy order can be filled with credits
+	// from any credit batch within the project that meets the criteria.
+	ProjectKey uint64 `protobuf:"varint,12,opt,name=project_key,json=projectKey,proto3" json:"project_key,omitempty"`
+	// project_location is an optional jurisdiction that the project of the
+	// credit batch must be located in. Only used with class_key.
+	ProjectLocation string `protobuf:"bytes,13,opt,name=project_location,json=projectLocation,proto3" json:"project_location,omitempty"`
+	// min_start_date is an optional minimum start date of the credit batch.
+	// Only used with class_key or project_key.
+	MinStartDate *types.Timestamp `protobuf:"bytes,14,opt,name=min_start_date,json=minStartDate,proto3" json:"min_start_date,omitempty"`
+	// max_end_date is an optional maximum end date of the credit batch. Only
+	// used with class_key or project_key.
+	MaxEndDate *types.Timestamp `protobuf:"bytes,15,opt,name=max_end_date,json=maxEndDate,proto3" json:"max_end_date,omitempty"`
+	// retirement_reason is any arbitrary string that specifies the reason for
+	// retiring the credits which will be used only if disable_auto_retire is
+	// false.
+	//
+	// Since Revision 1
+	RetirementReason string `protobuf:"bytes,16,opt,name=retirement_reason,json=retirementReason,proto3" json:"retirement_reason,omitempty"`
+	// retirement_beneficiary is any arbitrary string that specifies the person
+	// or organization on whose behalf the credits are retired which will be
+	// used only if disable_auto_retire is false.
+	//
+	// Since Revision 1
+	RetirementBeneficiary string `protobuf:"bytes,17,opt,name=retirement_beneficiary,json=retirementBeneficiary,proto3" json:"retirement_beneficiary,omitempty"`
+	// escrowed_fee_bps is the trading fee in basis points that is held in escrow
+	// in addition to the total bid amount, i.e. the higher of the maker and taker
+	// fees when the buy order was created. The buyer fee of each fill is paid
+	// from the escrowed fee and the remainder is returned to the buyer.
+	//
+	// Since Revision 1
+	EscrowedFeeBps uint32 `protobuf:"varint,18,opt,name=escrowed_fee_bps,json=escrowedFeeBps,proto3" json:"escrowed_fee_bps,omitempty"`
+}
+
+func (m *BuyOrder) Reset()         { *m = BuyOrder{} }
+func (m *BuyOrder) String() string { return proto.CompactTextString(m) }
+func (*BuyOrder) ProtoMessage()    {}
+func (*BuyOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{1}
+}
+func (m *BuyOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BuyOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BuyOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BuyOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuyOrder.Merge(m, src)
+}
+func (m *BuyOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *BuyOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuyOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuyOrder proto.InternalMessageInfo
+
+func (m *BuyOrder) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *BuyOrder) GetBuyer() []byte {
+	if m != nil {
+		return m.Buyer
+	}
+	return nil
+}
+
+func (m *BuyOrder) GetBatchKey() uint64 {
+	if m != nil {
+		return m.BatchKey
+	}
+	return 0
+}
+
+func (m *BuyOrder) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
+	}
+	return ""
+}
+
+func (m *BuyOrder) GetMarketId() uint64 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *BuyOrder) GetBidAmount() string {
+	if m != nil {
+		return m.BidAmount
+	}
+	return ""
+}
+
+func (m *BuyOrder) GetDisableAutoRetire() bool {
+	if m != nil {
+		return m.DisableAutoRetire
+	}
+	return false
+}
+
+func (m *BuyOrder) GetRetirementJurisdiction() string {
+	if m != nil {
+		return m.RetirementJurisdiction
+	}
+	return ""
+}
+
+func (m *BuyOrder) GetExpiration() *types.Timestamp {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func (m *BuyOrder) GetMaker() bool {
+	if m != nil {
+		return m.Maker
+	}
+	return false
+}
+
+func (m *BuyOrder) GetClassKey() uint64 {
+	if m != nil {
+		return m.ClassKey
+	}
+	return 0
+}
+
+func (m *BuyOrder) GetProjectKey() uint64 {
+	if m != nil {
+		return m.ProjectKey
+	}
+	return 0
+}
+
+func (m *BuyOrder) GetProjectLocation() string {
+	if m != nil {
+		return m.ProjectLocation
+	}
+	return ""
+}
+
+func (m *BuyOrder) GetMinStartDate() *types.Timestamp {
+	if m != nil {
+		return m.MinStartDate
+	}
+	return nil
+}
+
+func (m *BuyOrder) GetMaxEndDate() *types.Timestamp {
+	if m != nil {
+		return m.MaxEndDate
+	}
+	return nil
+}
+
+func (m *BuyOrder) GetRetirementReason() string {
+	if m != nil {
+		return m.RetirementReason
+	}
+	return ""
+}
+
+func (m *BuyOrder) GetRetirementBeneficiary() string {
+	if m != nil {
+		return m.RetirementBeneficiary
+	}
+	return ""
+}
+
+func (m *BuyOrder) GetEscrowedFeeBps() uint32 {
+	if m != nil {
+		return m.EscrowedFeeBps
+	}
+	return 0
+}
+
 // AllowedDenom represents the information for an allowed ask/bid denom.
 type AllowedDenom struct {
 	// denom is the bank denom to allow (ex. ibc/GLKHDSG423SGS)
@@ -162,13 +510,25 @@ type AllowedDenom struct {
 	// exponent is the exponent that relates the denom to the display_denom and is
 	// informational
 	Exponent uint32 `protobuf:"varint,3,opt,name=exponent,proto3" json:"exponent,omitempty"`
+	// ibc_path is the IBC denom trace path (ex. transfer/channel-0) of an IBC
+	// denom, recorded when the denom is allowed. It is empty for denoms that are
+	// not IBC denoms.
+	//
+	// Since Revision 1
+	IbcPath string `protobuf:"bytes,4,opt,name=ibc_path,json=ibcPath,proto3" json:"ibc_path,omitempty"`
+	// ibc_base_denom is the IBC denom trace base denom (ex. uatom) of an IBC
+	// denom, recorded when the denom is allowed. It is empty for denoms that are
+	// not IBC denoms.
+	//
+	// Since Revision 1
+	IbcBaseDenom string `protobuf:"bytes,5,opt,name=ibc_base_denom,json=ibcBaseDenom,proto3" json:"ibc_base_denom,omitempty"`
 }
 
 func (m *AllowedDenom) Reset()         { *m = AllowedDenom{} }
 func (m *AllowedDenom) String() string { return proto.CompactTextString(m) }
 func (*AllowedDenom) ProtoMessage()    {}
 func (*AllowedDenom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_718b9cb8f10a9f3c, []int{1}
+	return fileDescriptor_718b9cb8f10a9f3c, []int{2}
 }
 func (m *AllowedDenom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -218,6 +578,20 @@ func (m *AllowedDenom) GetExponent() uint32 {
 	return 0
 }
 
+func (m *AllowedDenom) GetIbcPath() string {
+	if m != nil {
+		return m.IbcPath
+	}
+	return ""
+}
+
+func (m *AllowedDenom) GetIbcBaseDenom() string {
+	if m != nil {
+		return m.IbcBaseDenom
+	}
+	return ""
+}
+
 // Market describes a distinctly processed market between a credit type and
 // allowed bank denom. Each market has its own precision in the order book
 // and is processed independently of other markets. Governance must enable
@@ -266,7 +640,7 @@ func (m *Market) Reset()         { *m = Market{} }
 func (m *Market) String() string { return proto.CompactTextString(m) }
 func (*Market) ProtoMessage()    {}
 func (*Market) Descriptor() ([]byte, []int) {
-	return fileDescriptor_718b9cb8f10a9f3c, []int{2}
+	return fileDescriptor_718b9cb8f10a9f3c, []int{3}
 }
 func (m *Market) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -323,371 +697,6601 @@ func (m *Market) GetPrecisionModifier() uint32 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*SellOrder)(nil), "regen.ecocredit.marketplace.v1.SellOrder")
-	proto.RegisterType((*AllowedDenom)(nil), "regen.ecocredit.marketplace.v1.AllowedDenom")
-	proto.RegisterType((*Market)(nil), "regen.ecocredit.marketplace.v1.Market")
+// TradingFee is the marketplace trading fee. Trading fees are a percentage of
+// the total cost of a purchase expressed in basis points (1/100th of a
+// percent) and are charged on direct purchases, filled buy orders, settled
+// auctions, accepted offers and accepted swaps. A party is charged the maker
+// fee if its order was on the market before the trade (a maker sell or buy
+// order, an auction, an offer or a counter offer that is accepted, or a swap
+// proposal) and the taker fee otherwise. The buyer fee is paid in addition to
+// the cost and the seller fee is deducted from the cost paid to the seller.
+// If not set, trading fees are not charged. This table is controlled via
+// governance.
+//
+// Since Revision 1
+type TradingFee struct {
+	// maker_fee_bps is the fee in basis points charged to the seller of a maker
+	// sell order.
+	MakerFeeBps uint32 `protobuf:"varint,1,opt,name=maker_fee_bps,json=makerFeeBps,proto3" json:"maker_fee_bps,omitempty"`
+	// taker_fee_bps is the fee in basis points charged to the buyer and to the
+	// seller of a taker sell order.
+	TakerFeeBps uint32 `protobuf:"varint,2,opt,name=taker_fee_bps,json=takerFeeBps,proto3" json:"taker_fee_bps,omitempty"`
+	// destination is the destination of the trading fees.
+	Destination FeeDestination `protobuf:"varint,3,opt,name=destination,proto3,enum=regen.ecocredit.marketplace.v1.FeeDestination" json:"destination,omitempty"`
+	// module_account is the name of the module account that receives the trading
+	// fees. Only set when destination is FEE_DESTINATION_MODULE_ACCOUNT.
+	ModuleAccount string `protobuf:"bytes,4,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("regen/ecocredit/marketplace/v1/state.proto", fileDescriptor_718b9cb8f10a9f3c)
+func (m *TradingFee) Reset()         { *m = TradingFee{} }
+func (m *TradingFee) String() string { return proto.CompactTextString(m) }
+func (*TradingFee) ProtoMessage()    {}
+func (*TradingFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{4}
+}
+func (m *TradingFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TradingFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TradingFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TradingFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradingFee.Merge(m, src)
+}
+func (m *TradingFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *TradingFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradingFee.DiscardUnknown(m)
 }
 
-var fileDescriptor_718b9cb8f10a9f3c = []byte{
-	// 592 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0xcf, 0x6b, 0xd4, 0x4e,
-	0x14, 0xef, 0xec, 0xb6, 0xfb, 0xdd, 0xcc, 0x77, 0xb7, 0x6c, 0xa7, 0xa2, 0xc3, 0x4a, 0xe3, 0xd2,
-	0x22, 0x2c, 0xda, 0x26, 0x54, 0xe9, 0x65, 0x3d, 0xad, 0x88, 0x20, 0x52, 0x84, 0xd8, 0x93, 0x97,
-	0x38, 0xc9, 0xbc, 0x6e, 0x87, 0xfc, 0x98, 0x38, 0x99, 0x6c, 0x9b, 0x7f, 0x42, 0xbc, 0x0b, 0xfe,
-	0x3d, 0x82, 0x97, 0x82, 0x17, 0x8f, 0xd2, 0x1e, 0xbc, 0xfb, 0x17, 0x48, 0x26, 0xe9, 0xba, 0x6c,
-	0xf1, 0xf8, 0xf9, 0xf1, 0xf2, 0xde, 0xfb, 0xe4, 0x0d, 0x7e, 0xa4, 0x60, 0x06, 0xa9, 0x0b, 0xa1,
-	0x0c, 0x15, 0x70, 0xa1, 0xdd, 0x84, 0xa9, 0x08, 0x74, 0x16, 0xb3, 0x10, 0xdc, 0xf9, 0xa1, 0x9b,
-	0x6b, 0xa6, 0xc1, 0xc9, 0x94, 0xd4, 0x92, 0xd8, 0xc6, 0xeb, 0x2c, 0xbc, 0xce, 0x92, 0xd7, 0x99,
-	0x1f, 0x0e, 0xef, 0x85, 0x32, 0x4f, 0x64, 0xee, 0x4a, 0x95, 0x54, 0xa5, 0x52, 0x25, 0x75, 0xe1,
-	0xf0, 0xc1, 0x4c, 0xca, 0x59, 0x0c, 0xae, 0x41, 0x41, 0x71, 0xea, 0x6a, 0x91, 0x40, 0xae, 0x59,
-	0x92, 0xd5, 0x86, 0xdd, 0x5f, 0x2d, 0x6c, 0xbd, 0x85, 0x38, 0x7e, 0xa3, 0x38, 0x28, 0xb2, 0x89,
-	0x5b, 0x82, 0x53, 0x34, 0x42, 0xe3, 0x75, 0xaf, 0x25, 0x38, 0xb9, 0x8b, 0x3b, 0x39, 0xc4, 0x31,
-	0x28, 0xda, 0x1a, 0xa1, 0x71, 0xcf, 0x6b, 0x10, 0xb9, 0x8f, 0xad, 0x80, 0xe9, 0xf0, 0xcc, 0x8f,
-	0xa0, 0xa4, 0x6d, 0x63, 0xef, 0x1a, 0xe2, 0x35, 0x94, 0x64, 0x88, 0xbb, 0x1f, 0x0a, 0x96, 0x6a,
-	0xa1, 0x4b, 0xba, 0x3e, 0x42, 0x63, 0xcb, 0x5b, 0xe0, 0xaa, 0xb0, 0x1e, 0xdd, 0x17, 0x9c, 0x6e,
-	0xd4, 0x85, 0x35, 0xf1, 0x8a, 0x93, 0x1d, 0x8c, 0x59, 0x1e, 0xf9, 0x2c, 0x91, 0x45, 0xaa, 0x69,
-	0xc7, 0x94, 0x5a, 0x2c, 0x8f, 0xa6, 0x86, 0x20, 0x0e, 0xde, 0xe6, 0x22, 0x67, 0x41, 0x0c, 0x3e,
-	0x2b, 0xb4, 0xf4, 0x15, 0x68, 0xa1, 0x80, 0xfe, 0x37, 0x42, 0xe3, 0xae, 0xb7, 0xd5, 0x48, 0xd3,
-	0x42, 0x4b, 0xcf, 0x08, 0x64, 0x82, 0x31, 0x5c, 0x64, 0x42, 0x31, 0x2d, 0x64, 0x4a, 0xad, 0x11,
-	0x1a, 0xff, 0xff, 0x64, 0xe8, 0xd4, 0x81, 0x38, 0x37, 0x81, 0x38, 0x27, 0x37, 0x81, 0x78, 0x4b,
-	0x6e, 0x72, 0x07, 0x6f, 0x24, 0x2c, 0x02, 0x45, 0xb1, 0xf9, 0x7a, 0x0d, 0x26, 0xcf, 0x7e, 0x7f,
-	0xf9, 0xfe, 0xb1, 0x7d, 0x84, 0x3b, 0x55, 0x4c, 0x03, 0x44, 0xfa, 0x4b, 0x31, 0x0c, 0x10, 0xc1,
-	0x37, 0x69, 0x0d, 0x5a, 0x64, 0x73, 0xb9, 0xf9, 0xa0, 0x4d, 0xd1, 0xee, 0x67, 0x84, 0x7b, 0xd3,
-	0x38, 0x96, 0xe7, 0xc0, 0x5f, 0x40, 0x2a, 0x93, 0x6a, 0xdd, 0x80, 0xa5, 0x91, 0xcf, 0x2b, 0x64,
-	0x42, 0xb7, 0x3c, 0xab, 0x62, 0x6a, 0x79, 0x0f, 0xf7, 0xb9, 0xc8, 0xb3, 0x98, 0x95, 0x8d, 0xa3,
-	0x65, 0x1c, 0xbd, 0x86, 0xac, 0x4d, 0x43, 0xdc, 0x85, 0x8b, 0x4c, 0xa6, 0x90, 0x6a, 0xf3, 0x1f,
-	0xfa, 0xde, 0x02, 0x4f, 0x1e, 0x9b, 0x69, 0x1f, 0xe2, 0xde, 0x72, 0x1f, 0xb2, 0xbd, 0xf2, 0xd9,
-	0x01, 0xa2, 0x88, 0xb6, 0x77, 0xbf, 0x21, 0xdc, 0x39, 0x36, 0x3f, 0xe2, 0xd6, 0x11, 0xec, 0x63,
-	0x52, 0x5f, 0x9d, 0xaf, 0xcb, 0x0c, 0x7c, 0x16, 0x04, 0x0a, 0xe6, 0xcd, 0x34, 0x83, 0x5a, 0x39,
-	0x29, 0x33, 0x98, 0x1a, 0x7e, 0x65, 0xab, 0xf6, 0xea, 0x56, 0x07, 0x98, 0x64, 0x0a, 0x42, 0x91,
-	0x0b, 0x99, 0xfa, 0x89, 0xe4, 0xe2, 0x54, 0x80, 0x32, 0x67, 0xd2, 0xf7, 0xb6, 0x16, 0xca, 0x71,
-	0x23, 0x4c, 0x8e, 0xcc, 0x0e, 0xee, 0x22, 0xf1, 0x3d, 0xbc, 0x73, 0x7b, 0x96, 0xfd, 0xbf, 0x0d,
-	0xcd, 0x36, 0xeb, 0xcf, 0xdf, 0x7f, 0xbd, 0xb2, 0xd1, 0xe5, 0x95, 0x8d, 0x7e, 0x5e, 0xd9, 0xe8,
-	0xd3, 0xb5, 0xbd, 0x76, 0x79, 0x6d, 0xaf, 0xfd, 0xb8, 0xb6, 0xd7, 0xde, 0xbd, 0x9c, 0x09, 0x7d,
-	0x56, 0x04, 0x4e, 0x28, 0x13, 0xd7, 0x3c, 0xaa, 0x83, 0x14, 0xf4, 0xb9, 0x54, 0x51, 0x83, 0x62,
-	0xe0, 0x33, 0x50, 0xee, 0xc5, 0x3f, 0xde, 0x65, 0xd5, 0x35, 0x77, 0xe7, 0x87, 0x41, 0xc7, 0x1c,
-	0xd0, 0xd3, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x27, 0x53, 0x7e, 0x67, 0xc6, 0x03, 0x00, 0x00,
+var xxx_messageInfo_TradingFee proto.InternalMessageInfo
+
+func (m *TradingFee) GetMakerFeeBps() uint32 {
+	if m != nil {
+		return m.MakerFeeBps
+	}
+	return 0
 }
 
-func (m *SellOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TradingFee) GetTakerFeeBps() uint32 {
+	if m != nil {
+		return m.TakerFeeBps
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *SellOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TradingFee) GetDestination() FeeDestination {
+	if m != nil {
+		return m.Destination
+	}
+	return FeeDestination_FEE_DESTINATION_UNSPECIFIED
 }
 
-func (m *SellOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Maker {
-		i--
-		if m.Maker {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *TradingFee) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
+	}
+	return ""
+}
+
+// ClassRoyalty is a royalty set by the admin of a credit class that is paid to
+// the recipient whenever credits from the batches of the credit class are sold
+// in the marketplace. The royalty is a percentage of the amount paid to the
+// seller (after trading fees) expressed in basis points (1/100th of a
+// percent).
+//
+// Since Revision 1
+type ClassRoyalty struct {
+	// class_key is the table row identifier of the credit class used internally
+	// for efficient lookups. This links a class royalty to a credit class.
+	ClassKey uint64 `protobuf:"varint,1,opt,name=class_key,json=classKey,proto3" json:"class_key,omitempty"`
+	// royalty_bps is the royalty in basis points paid on each sale of credits
+	// from the credit class.
+	RoyaltyBps uint32 `protobuf:"varint,2,opt,name=royalty_bps,json=royaltyBps,proto3" json:"royalty_bps,omitempty"`
+	// recipient is the address of the account that receives the royalty.
+	Recipient []byte `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *ClassRoyalty) Reset()         { *m = ClassRoyalty{} }
+func (m *ClassRoyalty) String() string { return proto.CompactTextString(m) }
+func (*ClassRoyalty) ProtoMessage()    {}
+func (*ClassRoyalty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{5}
+}
+func (m *ClassRoyalty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClassRoyalty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClassRoyalty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x50
+		return b[:n], nil
 	}
-	if m.Expiration != nil {
-		{
-			size, err := m.Expiration.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintState(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
+}
+func (m *ClassRoyalty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClassRoyalty.Merge(m, src)
+}
+func (m *ClassRoyalty) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClassRoyalty) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClassRoyalty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClassRoyalty proto.InternalMessageInfo
+
+func (m *ClassRoyalty) GetClassKey() uint64 {
+	if m != nil {
+		return m.ClassKey
 	}
-	if m.DisableAutoRetire {
-		i--
-		if m.DisableAutoRetire {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
+	return 0
+}
+
+func (m *ClassRoyalty) GetRoyaltyBps() uint32 {
+	if m != nil {
+		return m.RoyaltyBps
 	}
-	if len(m.AskAmount) > 0 {
-		i -= len(m.AskAmount)
-		copy(dAtA[i:], m.AskAmount)
-		i = encodeVarintState(dAtA, i, uint64(len(m.AskAmount)))
-		i--
-		dAtA[i] = 0x32
+	return 0
+}
+
+func (m *ClassRoyalty) GetRecipient() []byte {
+	if m != nil {
+		return m.Recipient
 	}
-	if m.MarketId != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x28
+	return nil
+}
+
+// Trade is a record of credits purchased in the marketplace, either from a sell
+// order (directly or by a buy order being matched with the sell order) or by
+// winning an auction.
+//
+// Since Revision 1
+type Trade struct {
+	// id is the unique identifier of the trade.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// buyer is the address of the account that purchased the credits.
+	Buyer []byte `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// seller is the address of the account that sold the credits.
+	Seller []byte `protobuf:"bytes,3,opt,name=seller,proto3" json:"seller,omitempty"`
+	// batch_key is the table row identifier of the credit batch used internally
+	// for efficient lookups. This links a trade to the credit batch traded.
+	BatchKey uint64 `protobuf:"varint,4,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// market_id is the market in which the trade was executed.
+	MarketId uint64 `protobuf:"varint,5,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// quantity is the quantity of credits purchased.
+	Quantity string `protobuf:"bytes,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// price is the price paid per credit in the bank denom of the market, i.e.
+	// the ask amount of the sell order or the winning bid amount of the auction.
+	Price string `protobuf:"bytes,7,opt,name=price,proto3" json:"price,omitempty"`
+	// timestamp is the block time at which the trade was executed.
+	Timestamp *types.Timestamp `protobuf:"bytes,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *Trade) Reset()         { *m = Trade{} }
+func (m *Trade) String() string { return proto.CompactTextString(m) }
+func (*Trade) ProtoMessage()    {}
+func (*Trade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{6}
+}
+func (m *Trade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Trade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Trade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Quantity) > 0 {
-		i -= len(m.Quantity)
-		copy(dAtA[i:], m.Quantity)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Quantity)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *Trade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Trade.Merge(m, src)
+}
+func (m *Trade) XXX_Size() int {
+	return m.Size()
+}
+func (m *Trade) XXX_DiscardUnknown() {
+	xxx_messageInfo_Trade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Trade proto.InternalMessageInfo
+
+func (m *Trade) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if m.BatchKey != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
-		i--
-		dAtA[i] = 0x18
+	return 0
+}
+
+func (m *Trade) GetBuyer() []byte {
+	if m != nil {
+		return m.Buyer
 	}
-	if len(m.Seller) > 0 {
-		i -= len(m.Seller)
-		copy(dAtA[i:], m.Seller)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Seller)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *Trade) GetSeller() []byte {
+	if m != nil {
+		return m.Seller
 	}
-	if m.Id != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *Trade) GetBatchKey() uint64 {
+	if m != nil {
+		return m.BatchKey
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *AllowedDenom) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Trade) GetMarketId() uint64 {
+	if m != nil {
+		return m.MarketId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *AllowedDenom) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Trade) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
+	}
+	return ""
 }
 
-func (m *AllowedDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Exponent != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.Exponent))
-		i--
-		dAtA[i] = 0x18
+func (m *Trade) GetPrice() string {
+	if m != nil {
+		return m.Price
 	}
-	if len(m.DisplayDenom) > 0 {
-		i -= len(m.DisplayDenom)
-		copy(dAtA[i:], m.DisplayDenom)
-		i = encodeVarintState(dAtA, i, uint64(len(m.DisplayDenom)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *Trade) GetTimestamp() *types.Timestamp {
+	if m != nil {
+		return m.Timestamp
 	}
-	if len(m.BankDenom) > 0 {
-		i -= len(m.BankDenom)
-		copy(dAtA[i:], m.BankDenom)
-		i = encodeVarintState(dAtA, i, uint64(len(m.BankDenom)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+// Auction represents the information for an auction of credits. The credits
+// being auctioned are held in the escrowed balance of the seller and the
+// highest bid is held in escrow by the ecocredit module account until the
+// auction is settled at the beginning of the first block after the end time.
+//
+// Since Revision 1
+type Auction struct {
+	// id is the unique ID of the auction.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// seller is the address of the account that is selling credits.
+	Seller []byte `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// batch_key is the table row identifier of the credit batch used internally
+	// for efficient lookups. This links an auction to a credit batch.
+	BatchKey uint64 `protobuf:"varint,3,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// quantity is the decimal quantity of credits being auctioned.
+	Quantity string `protobuf:"bytes,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// market_id is the market in which this auction exists and specifies the
+	// bank_denom that the start_amount, end_amount, and bid amounts correspond
+	// to.
+	MarketId uint64 `protobuf:"varint,5,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// auction_type is the type of the auction.
+	AuctionType AuctionType `protobuf:"varint,6,opt,name=auction_type,json=auctionType,proto3,enum=regen.ecocredit.marketplace.v1.AuctionType" json:"auction_type,omitempty"`
+	// start_amount is the integer amount (encoded as a string) per credit at
+	// which the auction starts. For an English auction, this is the minimum bid
+	// amount. For a Dutch auction, this is the initial price.
+	StartAmount string `protobuf:"bytes,7,opt,name=start_amount,json=startAmount,proto3" json:"start_amount,omitempty"`
+	// end_amount is the integer amount (encoded as a string) per credit at which
+	// a Dutch auction ends, i.e. the lowest price the seller will accept. Only
+	// set for Dutch auctions.
+	EndAmount string `protobuf:"bytes,8,opt,name=end_amount,json=endAmount,proto3" json:"end_amount,omitempty"`
+	// start_time is the block time at which the auction was created.
+	StartTime *types.Timestamp `protobuf:"bytes,9,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time is the time at which the auction ends. The end time of a Dutch
+	// auction is set to the block time of the winning bid.
+	EndTime *types.Timestamp `protobuf:"bytes,10,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// disable_auto_retire allows the winning bidder to disable auto-retirement
+	// of the credits.
+	DisableAutoRetire bool `protobuf:"varint,11,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
+	// highest_bidder is the address of the account that placed the highest bid.
+	// Empty if there are no bids.
+	HighestBidder []byte `protobuf:"bytes,12,opt,name=highest_bidder,json=highestBidder,proto3" json:"highest_bidder,omitempty"`
+	// highest_bid_amount is the integer amount (encoded as a string) per credit
+	// of the highest bid. For a Dutch auction, this is the price of the auction
+	// at the time of the winning bid.
+	HighestBidAmount string `protobuf:"bytes,13,opt,name=highest_bid_amount,json=highestBidAmount,proto3" json:"highest_bid_amount,omitempty"`
+	// highest_bid_disable_auto_retire indicates that the highest bidder disabled
+	// auto-retirement of the credits.
+	HighestBidDisableAutoRetire bool `protobuf:"varint,14,opt,name=highest_bid_disable_auto_retire,json=highestBidDisableAutoRetire,proto3" json:"highest_bid_disable_auto_retire,omitempty"`
+	// highest_bid_retirement_jurisdiction is the retirement jurisdiction of the
+	// highest bidder which will be used only if auto-retirement is not disabled.
+	HighestBidRetirementJurisdiction string `protobuf:"bytes,15,opt,name=highest_bid_retirement_jurisdiction,json=highestBidRetirementJurisdiction,proto3" json:"highest_bid_retirement_jurisdiction,omitempty"`
+	// highest_bid_retirement_reason is the retirement reason of the highest
+	// bidder which will be used only if auto-retirement is not disabled.
+	HighestBidRetirementReason string `protobuf:"bytes,16,opt,name=highest_bid_retirement_reason,json=highestBidRetirementReason,proto3" json:"highest_bid_retirement_reason,omitempty"`
+	// highest_bid_retirement_beneficiary is the retirement beneficiary of the
+	// highest bidder which will be used only if auto-retirement is not disabled.
+	HighestBidRetirementBeneficiary string `protobuf:"bytes,17,opt,name=highest_bid_retirement_beneficiary,json=highestBidRetirementBeneficiary,proto3" json:"highest_bid_retirement_beneficiary,omitempty"`
+	// highest_bid_escrowed_fee_bps is the trading fee in basis points that is
+	// held in escrow in addition to the highest bid, i.e. the higher of the maker
+	// and taker fees when the highest bid was placed. The buyer fee is paid from
+	// the escrowed fee and the remainder is returned to the highest bidder.
+	HighestBidEscrowedFeeBps uint32 `protobuf:"varint,18,opt,name=highest_bid_escrowed_fee_bps,json=highestBidEscrowedFeeBps,proto3" json:"highest_bid_escrowed_fee_bps,omitempty"`
+}
+
+func (m *Auction) Reset()         { *m = Auction{} }
+func (m *Auction) String() string { return proto.CompactTextString(m) }
+func (*Auction) ProtoMessage()    {}
+func (*Auction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{7}
+}
+func (m *Auction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Auction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Auction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *Auction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Auction.Merge(m, src)
+}
+func (m *Auction) XXX_Size() int {
+	return m.Size()
+}
+func (m *Auction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Auction.DiscardUnknown(m)
 }
 
-func (m *Market) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Auction proto.InternalMessageInfo
+
+func (m *Auction) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Market) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Auction) GetSeller() []byte {
+	if m != nil {
+		return m.Seller
+	}
+	return nil
 }
 
-func (m *Market) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PrecisionModifier != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.PrecisionModifier))
-		i--
-		dAtA[i] = 0x20
+func (m *Auction) GetBatchKey() uint64 {
+	if m != nil {
+		return m.BatchKey
 	}
-	if len(m.BankDenom) > 0 {
-		i -= len(m.BankDenom)
-		copy(dAtA[i:], m.BankDenom)
-		i = encodeVarintState(dAtA, i, uint64(len(m.BankDenom)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *Auction) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
 	}
-	if len(m.CreditTypeAbbrev) > 0 {
-		i -= len(m.CreditTypeAbbrev)
-		copy(dAtA[i:], m.CreditTypeAbbrev)
-		i = encodeVarintState(dAtA, i, uint64(len(m.CreditTypeAbbrev)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *Auction) GetMarketId() uint64 {
+	if m != nil {
+		return m.MarketId
 	}
-	if m.Id != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *Auction) GetAuctionType() AuctionType {
+	if m != nil {
+		return m.AuctionType
 	}
-	return len(dAtA) - i, nil
+	return AuctionType_AUCTION_TYPE_UNSPECIFIED
 }
 
-func encodeVarintState(dAtA []byte, offset int, v uint64) int {
-	offset -= sovState(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Auction) GetStartAmount() string {
+	if m != nil {
+		return m.StartAmount
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *SellOrder) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *Auction) GetEndAmount() string {
+	if m != nil {
+		return m.EndAmount
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovState(uint64(m.Id))
+	return ""
+}
+
+func (m *Auction) GetStartTime() *types.Timestamp {
+	if m != nil {
+		return m.StartTime
 	}
-	l = len(m.Seller)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	return nil
+}
+
+func (m *Auction) GetEndTime() *types.Timestamp {
+	if m != nil {
+		return m.EndTime
 	}
-	if m.BatchKey != 0 {
-		n += 1 + sovState(uint64(m.BatchKey))
+	return nil
+}
+
+func (m *Auction) GetDisableAutoRetire() bool {
+	if m != nil {
+		return m.DisableAutoRetire
 	}
-	l = len(m.Quantity)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	return false
+}
+
+func (m *Auction) GetHighestBidder() []byte {
+	if m != nil {
+		return m.HighestBidder
 	}
-	if m.MarketId != 0 {
-		n += 1 + sovState(uint64(m.MarketId))
+	return nil
+}
+
+func (m *Auction) GetHighestBidAmount() string {
+	if m != nil {
+		return m.HighestBidAmount
 	}
-	l = len(m.AskAmount)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	return ""
+}
+
+func (m *Auction) GetHighestBidDisableAutoRetire() bool {
+	if m != nil {
+		return m.HighestBidDisableAutoRetire
 	}
-	if m.DisableAutoRetire {
-		n += 2
+	return false
+}
+
+func (m *Auction) GetHighestBidRetirementJurisdiction() string {
+	if m != nil {
+		return m.HighestBidRetirementJurisdiction
 	}
-	if m.Expiration != nil {
-		l = m.Expiration.Size()
-		n += 1 + l + sovState(uint64(l))
+	return ""
+}
+
+func (m *Auction) GetHighestBidRetirementReason() string {
+	if m != nil {
+		return m.HighestBidRetirementReason
 	}
-	if m.Maker {
-		n += 2
+	return ""
+}
+
+func (m *Auction) GetHighestBidRetirementBeneficiary() string {
+	if m != nil {
+		return m.HighestBidRetirementBeneficiary
 	}
-	return n
+	return ""
 }
 
-func (m *AllowedDenom) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Auction) GetHighestBidEscrowedFeeBps() uint32 {
+	if m != nil {
+		return m.HighestBidEscrowedFeeBps
 	}
-	var l int
-	_ = l
-	l = len(m.BankDenom)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	return 0
+}
+
+// Offer represents a negotiated offer to buy credits from a sell order at a
+// price below the ask price of the sell order. The total bid amount is held in
+// escrow by the ecocredit module account until the offer is accepted,
+// rejected, or expires. Open offers are rejected when the sell order is
+// cancelled, filled, or expires.
+//
+// Since Revision 1
+type Offer struct {
+	// id is the unique ID of the offer.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sell_order_id is the ID of the sell order the offer was made on.
+	SellOrderId uint64 `protobuf:"varint,2,opt,name=sell_order_id,json=sellOrderId,proto3" json:"sell_order_id,omitempty"`
+	// buyer is the address of the account that made the offer.
+	Buyer []byte `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// quantity is the decimal quantity of credits the buyer is offering to buy.
+	Quantity string `protobuf:"bytes,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// market_id is the market of the sell order and specifies the bank_denom
+	// that bid_amount and counter_amount correspond to.
+	MarketId uint64 `protobuf:"varint,5,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// bid_amount is the integer amount (encoded as a string) per credit that the
+	// buyer is offering to pay. The total bid amount is held in escrow.
+	BidAmount string `protobuf:"bytes,6,opt,name=bid_amount,json=bidAmount,proto3" json:"bid_amount,omitempty"`
+	// counter_amount is the integer amount (encoded as a string) per credit of
+	// a counter offer made by the seller. If set, the offer is awaiting a
+	// response from the buyer, otherwise the offer is awaiting a response from
+	// the seller.
+	CounterAmount string `protobuf:"bytes,7,opt,name=counter_amount,json=counterAmount,proto3" json:"counter_amount,omitempty"`
+	// disable_auto_retire disables auto-retirement of credits which allows the
+	// buyer to receive credits in a tradable state.
+	DisableAutoRetire bool `protobuf:"varint,8,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
+	// retirement_jurisdiction is the optional retirement jurisdiction for the
+	// credits which will be used only if disable_auto_retire is false.
+	RetirementJurisdiction string `protobuf:"bytes,9,opt,name=retirement_jurisdiction,json=retirementJurisdiction,proto3" json:"retirement_jurisdiction,omitempty"`
+	// expiration is the timestamp when the offer expires. When the expiration
+	// time is reached, the offer is removed from state and the escrowed funds
+	// are returned to the buyer.
+	Expiration *types.Timestamp `protobuf:"bytes,10,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// retirement_reason is any arbitrary string that specifies the reason for
+	// retiring the credits which will be used only if disable_auto_retire is
+	// false.
+	RetirementReason string `protobuf:"bytes,11,opt,name=retirement_reason,json=retirementReason,proto3" json:"retirement_reason,omitempty"`
+	// retirement_beneficiary is any arbitrary string that specifies the person
+	// or organization on whose behalf the credits are retired which will be
+	// used only if disable_auto_retire is false.
+	RetirementBeneficiary string `protobuf:"bytes,12,opt,name=retirement_beneficiary,json=retirementBeneficiary,proto3" json:"retirement_beneficiary,omitempty"`
+	// escrowed_fee_bps is the trading fee in basis points that is held in escrow
+	// in addition to the total bid amount, i.e. the higher of the maker and taker
+	// fees when the offer was made. The buyer fee is paid from the escrowed fee
+	// and the remainder is returned to the buyer.
+	EscrowedFeeBps uint32 `protobuf:"varint,13,opt,name=escrowed_fee_bps,json=escrowedFeeBps,proto3" json:"escrowed_fee_bps,omitempty"`
+}
+
+func (m *Offer) Reset()         { *m = Offer{} }
+func (m *Offer) String() string { return proto.CompactTextString(m) }
+func (*Offer) ProtoMessage()    {}
+func (*Offer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{8}
+}
+func (m *Offer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Offer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Offer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.DisplayDenom)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+}
+func (m *Offer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Offer.Merge(m, src)
+}
+func (m *Offer) XXX_Size() int {
+	return m.Size()
+}
+func (m *Offer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Offer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Offer proto.InternalMessageInfo
+
+func (m *Offer) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if m.Exponent != 0 {
-		n += 1 + sovState(uint64(m.Exponent))
+	return 0
+}
+
+func (m *Offer) GetSellOrderId() uint64 {
+	if m != nil {
+		return m.SellOrderId
 	}
-	return n
+	return 0
 }
 
-func (m *Market) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Offer) GetBuyer() []byte {
+	if m != nil {
+		return m.Buyer
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovState(uint64(m.Id))
+	return nil
+}
+
+func (m *Offer) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
 	}
-	l = len(m.CreditTypeAbbrev)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	return ""
+}
+
+func (m *Offer) GetMarketId() uint64 {
+	if m != nil {
+		return m.MarketId
 	}
-	l = len(m.BankDenom)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	return 0
+}
+
+func (m *Offer) GetBidAmount() string {
+	if m != nil {
+		return m.BidAmount
 	}
-	if m.PrecisionModifier != 0 {
-		n += 1 + sovState(uint64(m.PrecisionModifier))
+	return ""
+}
+
+func (m *Offer) GetCounterAmount() string {
+	if m != nil {
+		return m.CounterAmount
 	}
-	return n
+	return ""
 }
 
-func sovState(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *Offer) GetDisableAutoRetire() bool {
+	if m != nil {
+		return m.DisableAutoRetire
+	}
+	return false
 }
-func sozState(x uint64) (n int) {
-	return sovState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *Offer) GetRetirementJurisdiction() string {
+	if m != nil {
+		return m.RetirementJurisdiction
+	}
+	return ""
 }
-func (m *SellOrder) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowState
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+
+func (m *Offer) GetExpiration() *types.Timestamp {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func (m *Offer) GetRetirementReason() string {
+	if m != nil {
+		return m.RetirementReason
+	}
+	return ""
+}
+
+func (m *Offer) GetRetirementBeneficiary() string {
+	if m != nil {
+		return m.RetirementBeneficiary
+	}
+	return ""
+}
+
+func (m *Offer) GetEscrowedFeeBps() uint32 {
+	if m != nil {
+		return m.EscrowedFeeBps
+	}
+	return 0
+}
+
+// Swap represents a proposed exchange of credits from one credit batch,
+// optionally together with coins, for credits from another credit batch
+// between two parties. The credits and coins offered by the proposer are held
+// in escrow until the swap is accepted by the counterparty or expires.
+//
+// Since Revision 1
+type Swap struct {
+	// id is the unique ID of the swap.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// proposer is the address of the account that proposed the swap.
+	Proposer []byte `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// counterparty is the address of the only account that can accept the swap.
+	Counterparty []byte `protobuf:"bytes,3,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+	// offer_batch_key is the table row identifier of the credit batch offered
+	// by the proposer.
+	OfferBatchKey uint64 `protobuf:"varint,4,opt,name=offer_batch_key,json=offerBatchKey,proto3" json:"offer_batch_key,omitempty"`
+	// offer_quantity is the decimal quantity of credits offered by the
+	// proposer.
+	OfferQuantity string `protobuf:"bytes,5,opt,name=offer_quantity,json=offerQuantity,proto3" json:"offer_quantity,omitempty"`
+	// offer_coins are the optional coins offered by the proposer in addition to
+	// the credits.
+	OfferCoins []*types1.Coin `protobuf:"bytes,6,rep,name=offer_coins,json=offerCoins,proto3" json:"offer_coins,omitempty"`
+	// ask_batch_key is the table row identifier of the credit batch requested
+	// from the counterparty.
+	AskBatchKey uint64 `protobuf:"varint,7,opt,name=ask_batch_key,json=askBatchKey,proto3" json:"ask_batch_key,omitempty"`
+	// ask_quantity is the decimal quantity of credits requested from the
+	// counterparty.
+	AskQuantity string `protobuf:"bytes,8,opt,name=ask_quantity,json=askQuantity,proto3" json:"ask_quantity,omitempty"`
+	// expiration is the timestamp when the swap expires. When the expiration
+	// time is reached, the swap is removed from state and the escrowed credits
+	// and coins are returned to the proposer.
+	Expiration *types.Timestamp `protobuf:"bytes,9,opt,name=expiration,proto3" json:"expiration,omitempty"`
+}
+
+func (m *Swap) Reset()         { *m = Swap{} }
+func (m *Swap) String() string { return proto.CompactTextString(m) }
+func (*Swap) ProtoMessage()    {}
+func (*Swap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{9}
+}
+func (m *Swap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Swap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Swap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Swap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Swap.Merge(m, src)
+}
+func (m *Swap) XXX_Size() int {
+	return m.Size()
+}
+func (m *Swap) XXX_DiscardUnknown() {
+	xxx_messageInfo_Swap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Swap proto.InternalMessageInfo
+
+func (m *Swap) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Swap) GetProposer() []byte {
+	if m != nil {
+		return m.Proposer
+	}
+	return nil
+}
+
+func (m *Swap) GetCounterparty() []byte {
+	if m != nil {
+		return m.Counterparty
+	}
+	return nil
+}
+
+func (m *Swap) GetOfferBatchKey() uint64 {
+	if m != nil {
+		return m.OfferBatchKey
+	}
+	return 0
+}
+
+func (m *Swap) GetOfferQuantity() string {
+	if m != nil {
+		return m.OfferQuantity
+	}
+	return ""
+}
+
+func (m *Swap) GetOfferCoins() []*types1.Coin {
+	if m != nil {
+		return m.OfferCoins
+	}
+	return nil
+}
+
+func (m *Swap) GetAskBatchKey() uint64 {
+	if m != nil {
+		return m.AskBatchKey
+	}
+	return 0
+}
+
+func (m *Swap) GetAskQuantity() string {
+	if m != nil {
+		return m.AskQuantity
+	}
+	return ""
+}
+
+func (m *Swap) GetExpiration() *types.Timestamp {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+// ForwardContract represents a pre-purchase of credits to be delivered by an
+// issuer from an open credit batch or from the next credit batch issued for a
+// project. The payment for the credits is held in escrow and released to the
+// issuer per unit as credits are minted or issued to the buyer. Any payment
+// remaining in escrow when the deadline is reached is returned to the buyer.
+//
+// Since Revision 1
+type ForwardContract struct {
+	// id is the unique ID of the forward contract.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// buyer is the address of the account that pre-purchased the credits.
+	Buyer []byte `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// issuer is the address of the account expected to deliver the credits.
+	Issuer []byte `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// project_key is the table row identifier of the project from which the
+	// credits will be delivered.
+	ProjectKey uint64 `protobuf:"varint,4,opt,name=project_key,json=projectKey,proto3" json:"project_key,omitempty"`
+	// batch_key is the table row identifier of the open credit batch from which
+	// the credits will be delivered. If empty, credits can be delivered from
+	// any credit batch of the project, including the next credit batch of the
+	// project.
+	BatchKey uint64 `protobuf:"varint,5,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// quantity is the decimal quantity of credits pre-purchased.
+	Quantity string `protobuf:"bytes,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// delivered_quantity is the decimal quantity of credits delivered so far.
+	DeliveredQuantity string `protobuf:"bytes,7,opt,name=delivered_quantity,json=deliveredQuantity,proto3" json:"delivered_quantity,omitempty"`
+	// market_id is the market in which the forward contract was created.
+	MarketId uint64 `protobuf:"varint,8,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// price_amount is the integer price per credit.
+	PriceAmount string `protobuf:"bytes,9,opt,name=price_amount,json=priceAmount,proto3" json:"price_amount,omitempty"`
+	// deadline is the timestamp by which the credits must be delivered. When
+	// the deadline is reached, the forward contract is removed from state and
+	// the payment for any undelivered credits is returned to the buyer.
+	Deadline *types.Timestamp `protobuf:"bytes,10,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// accept_retired allows credits retired upon issuance to the buyer to be
+	// delivered towards the forward contract. If false, only tradable credits
+	// are delivered.
+	AcceptRetired bool `protobuf:"varint,11,opt,name=accept_retired,json=acceptRetired,proto3" json:"accept_retired,omitempty"`
+}
+
+func (m *ForwardContract) Reset()         { *m = ForwardContract{} }
+func (m *ForwardContract) String() string { return proto.CompactTextString(m) }
+func (*ForwardContract) ProtoMessage()    {}
+func (*ForwardContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{10}
+}
+func (m *ForwardContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForwardContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardContract.Merge(m, src)
+}
+func (m *ForwardContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardContract proto.InternalMessageInfo
+
+func (m *ForwardContract) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ForwardContract) GetBuyer() []byte {
+	if m != nil {
+		return m.Buyer
+	}
+	return nil
+}
+
+func (m *ForwardContract) GetIssuer() []byte {
+	if m != nil {
+		return m.Issuer
+	}
+	return nil
+}
+
+func (m *ForwardContract) GetProjectKey() uint64 {
+	if m != nil {
+		return m.ProjectKey
+	}
+	return 0
+}
+
+func (m *ForwardContract) GetBatchKey() uint64 {
+	if m != nil {
+		return m.BatchKey
+	}
+	return 0
+}
+
+func (m *ForwardContract) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
+	}
+	return ""
+}
+
+func (m *ForwardContract) GetDeliveredQuantity() string {
+	if m != nil {
+		return m.DeliveredQuantity
+	}
+	return ""
+}
+
+func (m *ForwardContract) GetMarketId() uint64 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *ForwardContract) GetPriceAmount() string {
+	if m != nil {
+		return m.PriceAmount
+	}
+	return ""
+}
+
+func (m *ForwardContract) GetDeadline() *types.Timestamp {
+	if m != nil {
+		return m.Deadline
+	}
+	return nil
+}
+
+func (m *ForwardContract) GetAcceptRetired() bool {
+	if m != nil {
+		return m.AcceptRetired
+	}
+	return false
+}
+
+// SellOrderAsk represents an additional ask price of a sell order in a market
+// other than the market of the sell order, allowing the credits of a single
+// sell order to be bought with different denoms.
+//
+// Since Revision 1
+type SellOrderAsk struct {
+	// sell_order_id is the ID of the sell order.
+	SellOrderId uint64 `protobuf:"varint,1,opt,name=sell_order_id,json=sellOrderId,proto3" json:"sell_order_id,omitempty"`
+	// market_id is the market of the additional ask price, which specifies the
+	// bank_denom that ask_amount corresponds to.
+	MarketId uint64 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// ask_amount is the integer amount (encoded as a string) that the seller is
+	// asking for each credit unit of the batch in the bank_denom of the market.
+	AskAmount string `protobuf:"bytes,3,opt,name=ask_amount,json=askAmount,proto3" json:"ask_amount,omitempty"`
+}
+
+func (m *SellOrderAsk) Reset()         { *m = SellOrderAsk{} }
+func (m *SellOrderAsk) String() string { return proto.CompactTextString(m) }
+func (*SellOrderAsk) ProtoMessage()    {}
+func (*SellOrderAsk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{11}
+}
+func (m *SellOrderAsk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SellOrderAsk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SellOrderAsk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SellOrderAsk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SellOrderAsk.Merge(m, src)
+}
+func (m *SellOrderAsk) XXX_Size() int {
+	return m.Size()
+}
+func (m *SellOrderAsk) XXX_DiscardUnknown() {
+	xxx_messageInfo_SellOrderAsk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SellOrderAsk proto.InternalMessageInfo
+
+func (m *SellOrderAsk) GetSellOrderId() uint64 {
+	if m != nil {
+		return m.SellOrderId
+	}
+	return 0
+}
+
+func (m *SellOrderAsk) GetMarketId() uint64 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *SellOrderAsk) GetAskAmount() string {
+	if m != nil {
+		return m.AskAmount
+	}
+	return ""
+}
+
+// SellOrderPruneCursor stores the position in the sell order expiration index
+// up to which expired sell orders have been pruned. Expired sell orders are
+// pruned in the BeginBlocker with a limit on the number of sell orders pruned
+// per block, and the cursor allows pruning to resume in the next block without
+// iterating over the sell orders that have already been pruned.
+//
+// Since Revision 1
+type SellOrderPruneCursor struct {
+	// expiration is the expiration of the last sell order pruned.
+	Expiration *types.Timestamp `protobuf:"bytes,1,opt,name=expiration,proto3" json:"expiration,omitempty"`
+}
+
+func (m *SellOrderPruneCursor) Reset()         { *m = SellOrderPruneCursor{} }
+func (m *SellOrderPruneCursor) String() string { return proto.CompactTextString(m) }
+func (*SellOrderPruneCursor) ProtoMessage()    {}
+func (*SellOrderPruneCursor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{12}
+}
+func (m *SellOrderPruneCursor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SellOrderPruneCursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SellOrderPruneCursor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SellOrderPruneCursor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SellOrderPruneCursor.Merge(m, src)
+}
+func (m *SellOrderPruneCursor) XXX_Size() int {
+	return m.Size()
+}
+func (m *SellOrderPruneCursor) XXX_DiscardUnknown() {
+	xxx_messageInfo_SellOrderPruneCursor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SellOrderPruneCursor proto.InternalMessageInfo
+
+func (m *SellOrderPruneCursor) GetExpiration() *types.Timestamp {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+// AuctionSettleCursor stores the position in the auction end time index up to
+// which ended auctions have been settled. Ended auctions are settled in the
+// BeginBlocker with a limit on the number of auctions settled per block, and
+// the cursor allows settlement to resume in the next block without iterating
+// over the end times of the auctions that have already been settled.
+//
+// Since Revision 1
+type AuctionSettleCursor struct {
+	// end_time is the end time of the last auction settled.
+	EndTime *types.Timestamp `protobuf:"bytes,1,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (m *AuctionSettleCursor) Reset()         { *m = AuctionSettleCursor{} }
+func (m *AuctionSettleCursor) String() string { return proto.CompactTextString(m) }
+func (*AuctionSettleCursor) ProtoMessage()    {}
+func (*AuctionSettleCursor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{13}
+}
+func (m *AuctionSettleCursor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuctionSettleCursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuctionSettleCursor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuctionSettleCursor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuctionSettleCursor.Merge(m, src)
+}
+func (m *AuctionSettleCursor) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuctionSettleCursor) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuctionSettleCursor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuctionSettleCursor proto.InternalMessageInfo
+
+func (m *AuctionSettleCursor) GetEndTime() *types.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+// OfferPruneCursor stores the position in the offer expiration index up to
+// which expired offers have been pruned. Expired offers are pruned in the
+// BeginBlocker with a limit on the number of offers pruned per block, and the
+// cursor allows pruning to resume in the next block without iterating over the
+// offers that have already been pruned.
+//
+// Since Revision 1
+type OfferPruneCursor struct {
+	// expiration is the expiration of the last offer pruned.
+	Expiration *types.Timestamp `protobuf:"bytes,1,opt,name=expiration,proto3" json:"expiration,omitempty"`
+}
+
+func (m *OfferPruneCursor) Reset()         { *m = OfferPruneCursor{} }
+func (m *OfferPruneCursor) String() string { return proto.CompactTextString(m) }
+func (*OfferPruneCursor) ProtoMessage()    {}
+func (*OfferPruneCursor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{14}
+}
+func (m *OfferPruneCursor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OfferPruneCursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OfferPruneCursor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OfferPruneCursor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OfferPruneCursor.Merge(m, src)
+}
+func (m *OfferPruneCursor) XXX_Size() int {
+	return m.Size()
+}
+func (m *OfferPruneCursor) XXX_DiscardUnknown() {
+	xxx_messageInfo_OfferPruneCursor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OfferPruneCursor proto.InternalMessageInfo
+
+func (m *OfferPruneCursor) GetExpiration() *types.Timestamp {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+// SwapPruneCursor stores the position in the swap expiration index up to which
+// expired swaps have been pruned. Expired swaps are pruned in the BeginBlocker
+// with a limit on the number of swaps pruned per block, and the cursor allows
+// pruning to resume in the next block without iterating over the swaps that
+// have already been pruned.
+//
+// Since Revision 1
+type SwapPruneCursor struct {
+	// expiration is the expiration of the last swap pruned.
+	Expiration *types.Timestamp `protobuf:"bytes,1,opt,name=expiration,proto3" json:"expiration,omitempty"`
+}
+
+func (m *SwapPruneCursor) Reset()         { *m = SwapPruneCursor{} }
+func (m *SwapPruneCursor) String() string { return proto.CompactTextString(m) }
+func (*SwapPruneCursor) ProtoMessage()    {}
+func (*SwapPruneCursor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{15}
+}
+func (m *SwapPruneCursor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapPruneCursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapPruneCursor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapPruneCursor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapPruneCursor.Merge(m, src)
+}
+func (m *SwapPruneCursor) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapPruneCursor) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapPruneCursor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapPruneCursor proto.InternalMessageInfo
+
+func (m *SwapPruneCursor) GetExpiration() *types.Timestamp {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+// ForwardContractPruneCursor stores the position in the forward contract
+// deadline index up to which forward contracts that have reached their deadline
+// have been pruned. Forward contracts are pruned in the BeginBlocker with a
+// limit on the number of forward contracts pruned per block, and the cursor
+// allows pruning to resume in the next block without iterating over the
+// forward contracts that have already been pruned.
+//
+// Since Revision 1
+type ForwardContractPruneCursor struct {
+	// deadline is the deadline of the last forward contract pruned.
+	Deadline *types.Timestamp `protobuf:"bytes,1,opt,name=deadline,proto3" json:"deadline,omitempty"`
+}
+
+func (m *ForwardContractPruneCursor) Reset()         { *m = ForwardContractPruneCursor{} }
+func (m *ForwardContractPruneCursor) String() string { return proto.CompactTextString(m) }
+func (*ForwardContractPruneCursor) ProtoMessage()    {}
+func (*ForwardContractPruneCursor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{16}
+}
+func (m *ForwardContractPruneCursor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardContractPruneCursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardContractPruneCursor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForwardContractPruneCursor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardContractPruneCursor.Merge(m, src)
+}
+func (m *ForwardContractPruneCursor) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardContractPruneCursor) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardContractPruneCursor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardContractPruneCursor proto.InternalMessageInfo
+
+func (m *ForwardContractPruneCursor) GetDeadline() *types.Timestamp {
+	if m != nil {
+		return m.Deadline
+	}
+	return nil
+}
+
+// BuyOrderPruneCursor stores the position in the buy order expiration index up
+// to which expired buy orders have been pruned. Expired buy orders are pruned
+// in the BeginBlocker with a limit on the number of buy orders pruned per
+// block, and the cursor allows pruning to resume in the next block without
+// iterating over the buy orders that have already been pruned.
+//
+// Since Revision 1
+type BuyOrderPruneCursor struct {
+	// expiration is the expiration of the last buy order pruned.
+	Expiration *types.Timestamp `protobuf:"bytes,1,opt,name=expiration,proto3" json:"expiration,omitempty"`
+}
+
+func (m *BuyOrderPruneCursor) Reset()         { *m = BuyOrderPruneCursor{} }
+func (m *BuyOrderPruneCursor) String() string { return proto.CompactTextString(m) }
+func (*BuyOrderPruneCursor) ProtoMessage()    {}
+func (*BuyOrderPruneCursor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{17}
+}
+func (m *BuyOrderPruneCursor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BuyOrderPruneCursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BuyOrderPruneCursor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BuyOrderPruneCursor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuyOrderPruneCursor.Merge(m, src)
+}
+func (m *BuyOrderPruneCursor) XXX_Size() int {
+	return m.Size()
+}
+func (m *BuyOrderPruneCursor) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuyOrderPruneCursor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuyOrderPruneCursor proto.InternalMessageInfo
+
+func (m *BuyOrderPruneCursor) GetExpiration() *types.Timestamp {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("regen.ecocredit.marketplace.v1.FeeDestination", FeeDestination_name, FeeDestination_value)
+	proto.RegisterEnum("regen.ecocredit.marketplace.v1.AuctionType", AuctionType_name, AuctionType_value)
+	proto.RegisterType((*SellOrder)(nil), "regen.ecocredit.marketplace.v1.SellOrder")
+	proto.RegisterType((*BuyOrder)(nil), "regen.ecocredit.marketplace.v1.BuyOrder")
+	proto.RegisterType((*AllowedDenom)(nil), "regen.ecocredit.marketplace.v1.AllowedDenom")
+	proto.RegisterType((*Market)(nil), "regen.ecocredit.marketplace.v1.Market")
+	proto.RegisterType((*TradingFee)(nil), "regen.ecocredit.marketplace.v1.TradingFee")
+	proto.RegisterType((*ClassRoyalty)(nil), "regen.ecocredit.marketplace.v1.ClassRoyalty")
+	proto.RegisterType((*Trade)(nil), "regen.ecocredit.marketplace.v1.Trade")
+	proto.RegisterType((*Auction)(nil), "regen.ecocredit.marketplace.v1.Auction")
+	proto.RegisterType((*Offer)(nil), "regen.ecocredit.marketplace.v1.Offer")
+	proto.RegisterType((*Swap)(nil), "regen.ecocredit.marketplace.v1.Swap")
+	proto.RegisterType((*ForwardContract)(nil), "regen.ecocredit.marketplace.v1.ForwardContract")
+	proto.RegisterType((*SellOrderAsk)(nil), "regen.ecocredit.marketplace.v1.SellOrderAsk")
+	proto.RegisterType((*SellOrderPruneCursor)(nil), "regen.ecocredit.marketplace.v1.SellOrderPruneCursor")
+	proto.RegisterType((*AuctionSettleCursor)(nil), "regen.ecocredit.marketplace.v1.AuctionSettleCursor")
+	proto.RegisterType((*OfferPruneCursor)(nil), "regen.ecocredit.marketplace.v1.OfferPruneCursor")
+	proto.RegisterType((*SwapPruneCursor)(nil), "regen.ecocredit.marketplace.v1.SwapPruneCursor")
+	proto.RegisterType((*ForwardContractPruneCursor)(nil), "regen.ecocredit.marketplace.v1.ForwardContractPruneCursor")
+	proto.RegisterType((*BuyOrderPruneCursor)(nil), "regen.ecocredit.marketplace.v1.BuyOrderPruneCursor")
+}
+
+func init() {
+	proto.RegisterFile("regen/ecocredit/marketplace/v1/state.proto", fileDescriptor_718b9cb8f10a9f3c)
+}
+
+var fileDescriptor_718b9cb8f10a9f3c = []byte{
+	// 2041 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x0f, 0x25, 0xcb, 0x96, 0x9e, 0x28, 0x99, 0x9e, 0x78, 0x13, 0xc6, 0x89, 0xbf, 0x98, 0xba,
+	0x70, 0xf3, 0x21, 0x21, 0xd9, 0xa6, 0xdd, 0x15, 0x8a, 0xc5, 0xca, 0xb2, 0xdc, 0x75, 0x37, 0xfe,
+	0x58, 0xda, 0x6e, 0xbb, 0xdd, 0xb6, 0xec, 0x88, 0x1c, 0xdb, 0x5c, 0x49, 0x24, 0x3b, 0x1c, 0x25,
+	0xd6, 0xb1, 0x40, 0xcf, 0x45, 0x81, 0xbd, 0xf7, 0xd8, 0x73, 0x81, 0xfe, 0x09, 0xbd, 0x2c, 0xd0,
+	0xcb, 0x02, 0x3d, 0xb4, 0x87, 0x1e, 0x8a, 0xe4, 0x1f, 0x28, 0x7a, 0xec, 0xa9, 0x98, 0x19, 0x8a,
+	0xa4, 0x3e, 0xfc, 0x15, 0x24, 0xe8, 0x4d, 0xf3, 0xde, 0x9b, 0xa7, 0xf7, 0xfd, 0x7b, 0x43, 0x78,
+	0x40, 0xc9, 0x09, 0xf1, 0xaa, 0xc4, 0xf6, 0x6d, 0x4a, 0x1c, 0x97, 0x55, 0xbb, 0x98, 0xb6, 0x09,
+	0x0b, 0x3a, 0xd8, 0x26, 0xd5, 0x17, 0x4f, 0xaa, 0x21, 0xc3, 0x8c, 0x54, 0x02, 0xea, 0x33, 0x1f,
+	0x2d, 0x09, 0xd9, 0x4a, 0x2c, 0x5b, 0x49, 0xc9, 0x56, 0x5e, 0x3c, 0x59, 0x58, 0xb2, 0xfd, 0xb0,
+	0xeb, 0x87, 0xd5, 0x16, 0x0e, 0xf9, 0xdd, 0x16, 0x61, 0xf8, 0x49, 0xd5, 0xf6, 0x5d, 0x4f, 0xde,
+	0x5f, 0xb8, 0x1d, 0xf1, 0x7d, 0xda, 0xe5, 0xaa, 0x7d, 0xda, 0x8d, 0x18, 0xcb, 0x27, 0xbe, 0x7f,
+	0xd2, 0x21, 0x55, 0x71, 0x6a, 0xf5, 0x8e, 0xab, 0xcc, 0xed, 0x92, 0x90, 0xe1, 0x6e, 0x20, 0x05,
+	0x8c, 0x7f, 0x67, 0xa1, 0x70, 0x40, 0x3a, 0x9d, 0x3d, 0xea, 0x10, 0x8a, 0xca, 0x90, 0x71, 0x1d,
+	0x5d, 0x59, 0x51, 0xd6, 0xa7, 0xcc, 0x8c, 0xeb, 0xa0, 0x5b, 0x30, 0x1d, 0x92, 0x4e, 0x87, 0x50,
+	0x3d, 0xb3, 0xa2, 0xac, 0xab, 0x66, 0x74, 0x42, 0x77, 0xa1, 0xd0, 0xc2, 0xcc, 0x3e, 0xb5, 0xda,
+	0xa4, 0xaf, 0x67, 0x85, 0x78, 0x5e, 0x10, 0x3e, 0x25, 0x7d, 0xb4, 0x00, 0xf9, 0x5f, 0xf7, 0xb0,
+	0xc7, 0x5c, 0xd6, 0xd7, 0xa7, 0x56, 0x94, 0xf5, 0x82, 0x19, 0x9f, 0xf9, 0x45, 0xe9, 0x9a, 0xe5,
+	0x3a, 0x7a, 0x4e, 0x5e, 0x94, 0x84, 0x6d, 0x07, 0x2d, 0x02, 0xe0, 0xb0, 0x6d, 0xe1, 0xae, 0xdf,
+	0xf3, 0x98, 0x3e, 0x2d, 0xae, 0x16, 0x70, 0xd8, 0xae, 0x0b, 0x02, 0xaa, 0xc0, 0x4d, 0xc7, 0x0d,
+	0x71, 0xab, 0x43, 0x2c, 0xdc, 0x63, 0xbe, 0x45, 0x09, 0x73, 0x29, 0xd1, 0x67, 0x56, 0x94, 0xf5,
+	0xbc, 0x39, 0x17, 0xb1, 0xea, 0x3d, 0xe6, 0x9b, 0x82, 0x81, 0x6a, 0x00, 0xe4, 0x2c, 0x70, 0x29,
+	0x66, 0xae, 0xef, 0xe9, 0x85, 0x15, 0x65, 0xbd, 0xf8, 0x74, 0xa1, 0x22, 0x03, 0x52, 0x19, 0x04,
+	0xa4, 0x72, 0x38, 0x08, 0x88, 0x99, 0x92, 0x46, 0xf3, 0x90, 0xeb, 0xe2, 0x36, 0xa1, 0x3a, 0x08,
+	0xed, 0xf2, 0x80, 0xd6, 0xa0, 0x8c, 0x3b, 0x1d, 0xff, 0x25, 0x71, 0xac, 0x56, 0xaf, 0x4f, 0x68,
+	0xa8, 0x17, 0x57, 0xb2, 0xeb, 0xaa, 0x59, 0x8a, 0xa8, 0x1b, 0x82, 0xc8, 0x0d, 0x0d, 0x7d, 0xca,
+	0xa4, 0xa5, 0x89, 0x43, 0xaa, 0x70, 0x68, 0x6e, 0xc0, 0xaa, 0x0f, 0x1c, 0xab, 0x75, 0xff, 0xf3,
+	0x87, 0xbf, 0xfd, 0x2e, 0x7b, 0x02, 0xd3, 0x3c, 0xfa, 0x9a, 0x82, 0x4a, 0xa9, 0xe8, 0x6a, 0x0a,
+	0x82, 0x41, 0x12, 0xb4, 0x0c, 0x2a, 0xa7, 0x7d, 0xd2, 0xb2, 0x5c, 0x34, 0x8e, 0xa7, 0x36, 0x85,
+	0x56, 0x61, 0x31, 0x3e, 0x3e, 0x9a, 0x60, 0x83, 0x96, 0xd3, 0x15, 0xe3, 0xb7, 0xd3, 0x90, 0xdf,
+	0xe8, 0xf5, 0x27, 0x67, 0x7c, 0x1e, 0x72, 0xc2, 0xb5, 0x28, 0xe1, 0xf2, 0xf0, 0xee, 0xf2, 0xdd,
+	0x72, 0x9d, 0x91, 0x7c, 0xb7, 0x5c, 0xe7, 0x0d, 0xf3, 0xfd, 0x7d, 0xb8, 0x2d, 0x45, 0xba, 0xc4,
+	0x63, 0xd6, 0x97, 0x3d, 0xea, 0x86, 0x8e, 0x6b, 0x8b, 0xe4, 0xe7, 0x85, 0xee, 0x5b, 0x09, 0xfb,
+	0x47, 0x29, 0xee, 0x3b, 0x28, 0x94, 0xbb, 0x50, 0xb0, 0x3b, 0x38, 0x0c, 0x45, 0xbc, 0x8a, 0xd2,
+	0x6d, 0x41, 0xe0, 0xf1, 0x5a, 0x86, 0x62, 0x40, 0xfd, 0x2f, 0x89, 0xcd, 0x04, 0x5b, 0x15, 0x6c,
+	0x88, 0x48, 0x5c, 0xe0, 0x3b, 0xa0, 0x0d, 0x04, 0x3a, 0xbe, 0x2d, 0xad, 0x2a, 0x09, 0x0f, 0x66,
+	0x23, 0xfa, 0xf3, 0x88, 0x8c, 0x3e, 0x86, 0x72, 0xd7, 0xf5, 0xac, 0x90, 0x61, 0xca, 0x2c, 0x07,
+	0x33, 0xa2, 0x97, 0x2f, 0x35, 0x5f, 0xed, 0xba, 0xde, 0x01, 0xbf, 0xb0, 0x89, 0x19, 0x41, 0x3f,
+	0x00, 0xb5, 0x8b, 0xcf, 0x2c, 0xe2, 0x39, 0xf2, 0xfe, 0xec, 0xe5, 0xee, 0x77, 0xf1, 0x59, 0xd3,
+	0x73, 0xc4, 0xed, 0x87, 0x30, 0x97, 0x8a, 0x39, 0x25, 0x38, 0xf4, 0x3d, 0x5d, 0x13, 0xb6, 0x6a,
+	0x09, 0xc3, 0x14, 0x74, 0xf4, 0x0c, 0x52, 0x19, 0xb0, 0x5a, 0xc4, 0x23, 0xc7, 0xae, 0xed, 0x62,
+	0xda, 0xd7, 0xe7, 0xc4, 0x8d, 0xf7, 0x12, 0xee, 0x46, 0xc2, 0x44, 0xeb, 0xa0, 0x91, 0xd0, 0xa6,
+	0xa2, 0xed, 0x8e, 0x09, 0xb1, 0x5a, 0x41, 0xa8, 0xa3, 0x15, 0x65, 0xbd, 0x64, 0x96, 0x07, 0xf4,
+	0x2d, 0x42, 0x36, 0x82, 0xb0, 0xf6, 0x50, 0x34, 0xd2, 0x5a, 0xdc, 0x48, 0x85, 0xa8, 0x98, 0x35,
+	0x65, 0xa4, 0x71, 0x32, 0x7a, 0xc6, 0xf8, 0xa7, 0x02, 0x6a, 0x5d, 0xf6, 0xed, 0x26, 0xf1, 0xfc,
+	0xae, 0x28, 0x47, 0xec, 0xb5, 0x2d, 0x87, 0x9f, 0x74, 0x25, 0x2a, 0x47, 0xec, 0xb5, 0x25, 0xfb,
+	0x3e, 0x94, 0x1c, 0x37, 0x0c, 0x3a, 0xb8, 0x1f, 0x49, 0x64, 0x84, 0x84, 0x1a, 0x11, 0xa5, 0xd0,
+	0x02, 0xe4, 0xc9, 0x59, 0xe0, 0x7b, 0xc4, 0x63, 0xa2, 0x4f, 0x4a, 0x66, 0x7c, 0x46, 0x77, 0x20,
+	0xef, 0xb6, 0x6c, 0x2b, 0xc0, 0xec, 0x34, 0xea, 0x93, 0x19, 0xb7, 0x65, 0xef, 0x63, 0x76, 0x8a,
+	0xbe, 0x05, 0x65, 0xce, 0xe2, 0xe3, 0x3d, 0x52, 0x9e, 0x93, 0xca, 0xdd, 0x96, 0xbd, 0x81, 0x43,
+	0x22, 0x94, 0xc7, 0xee, 0xa9, 0x69, 0x43, 0xd1, 0xcd, 0x11, 0xbb, 0x34, 0x45, 0x57, 0xf4, 0xac,
+	0xf1, 0x57, 0x05, 0xa6, 0x77, 0x44, 0xa7, 0x8d, 0xf5, 0xf8, 0x23, 0x40, 0x12, 0x66, 0x2c, 0xd6,
+	0x0f, 0x88, 0x85, 0x5b, 0x2d, 0x4a, 0x5e, 0x44, 0xee, 0x68, 0x92, 0x73, 0xd8, 0x0f, 0x48, 0x5d,
+	0xd0, 0x47, 0xc2, 0x92, 0x1d, 0x0d, 0xcb, 0x63, 0x40, 0x01, 0x25, 0xb6, 0x1b, 0xba, 0xbe, 0x67,
+	0x75, 0x7d, 0xc7, 0x3d, 0x76, 0x09, 0x15, 0xfe, 0x95, 0xcc, 0xb9, 0x98, 0xb3, 0x13, 0x31, 0x6a,
+	0xcf, 0x84, 0x0f, 0xd5, 0x38, 0x45, 0xf7, 0x61, 0x71, 0xdc, 0x96, 0x47, 0xc9, 0x1f, 0x0a, 0x6f,
+	0xa6, 0x8c, 0xbf, 0x2b, 0x00, 0x87, 0x14, 0x3b, 0xae, 0x77, 0xb2, 0x45, 0x08, 0x32, 0xa0, 0x24,
+	0x1a, 0x2d, 0xae, 0x07, 0x45, 0xfc, 0x5f, 0x51, 0x10, 0x65, 0x31, 0x70, 0x19, 0x36, 0x24, 0x93,
+	0x91, 0x32, 0x2c, 0x25, 0xb3, 0x0f, 0x45, 0x87, 0x84, 0xcc, 0xf5, 0x64, 0x93, 0x71, 0xe7, 0xca,
+	0x4f, 0x2b, 0x95, 0x8b, 0xd1, 0xb8, 0xb2, 0x45, 0xc8, 0x66, 0x72, 0xcb, 0x4c, 0xab, 0xe0, 0x10,
+	0xd1, 0xf5, 0x9d, 0x1e, 0x9f, 0x59, 0xb6, 0x2d, 0xe6, 0x9a, 0x4c, 0x75, 0x49, 0x52, 0xeb, 0x92,
+	0x58, 0xcb, 0xff, 0x97, 0x87, 0x21, 0x93, 0xcf, 0x19, 0xbf, 0x51, 0x40, 0x6d, 0xf0, 0xd1, 0x60,
+	0xfa, 0x7d, 0xdc, 0x91, 0x23, 0x33, 0x99, 0x1d, 0xca, 0xf8, 0xec, 0xa0, 0x52, 0x2e, 0xe5, 0x12,
+	0x44, 0x24, 0xee, 0xd1, 0x3d, 0x28, 0xf0, 0x98, 0x07, 0xee, 0xa0, 0x02, 0x55, 0x33, 0x21, 0xd4,
+	0xde, 0x13, 0xd1, 0x9f, 0x85, 0x62, 0xfa, 0x3f, 0xa6, 0x8d, 0xaf, 0x33, 0x90, 0xe3, 0xd1, 0x25,
+	0x57, 0x84, 0x83, 0x64, 0x2d, 0xc8, 0x9e, 0xbf, 0x16, 0x4c, 0x8d, 0xc0, 0xc4, 0x85, 0x50, 0x90,
+	0xc6, 0x90, 0xe9, 0x11, 0x0c, 0x99, 0x87, 0x5c, 0x40, 0x5d, 0x5b, 0x4e, 0xfe, 0x82, 0x29, 0x0f,
+	0xe8, 0x03, 0x28, 0xc4, 0xbb, 0x8c, 0x9e, 0xbf, 0x74, 0x68, 0x25, 0xc2, 0xb5, 0x2f, 0x44, 0x10,
+	0x8e, 0xe2, 0x12, 0x5c, 0x85, 0xc5, 0xd8, 0xea, 0x47, 0x09, 0x7c, 0xc6, 0x17, 0x34, 0x05, 0xdd,
+	0x86, 0x9b, 0x93, 0x18, 0x19, 0x8e, 0xbf, 0xc9, 0x31, 0xab, 0xcf, 0x18, 0x7f, 0x9a, 0x81, 0x99,
+	0x7a, 0x4f, 0xe2, 0xca, 0xff, 0x77, 0x9b, 0xda, 0x05, 0x15, 0x4b, 0x43, 0x44, 0x6b, 0x89, 0xb0,
+	0x96, 0x9f, 0x3e, 0xbc, 0xac, 0xb8, 0x23, 0xe3, 0xf9, 0x00, 0x30, 0x8b, 0x38, 0x39, 0xa0, 0x55,
+	0x50, 0x25, 0xcc, 0x44, 0x78, 0x2d, 0xb3, 0x51, 0x14, 0xb4, 0x08, 0xb1, 0x17, 0x01, 0x38, 0x8e,
+	0x44, 0x02, 0x12, 0x74, 0x0b, 0xc4, 0x1b, 0x00, 0xfa, 0x87, 0x00, 0x52, 0x03, 0x0f, 0xd9, 0x15,
+	0x70, 0xb6, 0x20, 0xa4, 0xf9, 0x19, 0x3d, 0x83, 0x3c, 0xd7, 0x2c, 0x2e, 0xc2, 0xa5, 0x17, 0x67,
+	0x88, 0xe7, 0x88, 0x6b, 0xe7, 0xac, 0x10, 0xc5, 0xf3, 0x56, 0x88, 0x35, 0x28, 0x9f, 0xba, 0x27,
+	0xa7, 0x24, 0x64, 0x56, 0xcb, 0x75, 0x1c, 0x42, 0x05, 0x3a, 0xab, 0x66, 0x29, 0xa2, 0x6e, 0x08,
+	0x22, 0x1f, 0xa0, 0x29, 0xb1, 0x81, 0xbf, 0x12, 0xa2, 0xb5, 0x44, 0x34, 0x72, 0x7b, 0x13, 0x96,
+	0xd3, 0xd2, 0x93, 0x0c, 0x2a, 0x0b, 0x83, 0xee, 0x26, 0x57, 0x37, 0xc7, 0x4c, 0xdb, 0x81, 0xfb,
+	0x69, 0x2d, 0xe7, 0x6d, 0x3a, 0xb3, 0xc2, 0x88, 0x95, 0x44, 0x93, 0x39, 0x79, 0xe7, 0xa9, 0xc3,
+	0xe2, 0x39, 0xea, 0x86, 0x40, 0x7c, 0x61, 0x92, 0xa2, 0x08, 0xce, 0x3f, 0x05, 0xe3, 0x1c, 0x15,
+	0xe3, 0xd0, 0xbe, 0x3c, 0x49, 0x4f, 0x1a, 0xe4, 0x3f, 0x82, 0x7b, 0x69, 0x65, 0xe7, 0x00, 0xbe,
+	0x9e, 0xa8, 0x69, 0x0e, 0x43, 0xff, 0x87, 0xa2, 0xa9, 0xdf, 0xbf, 0xca, 0x0e, 0xad, 0x26, 0xb5,
+	0xa4, 0x65, 0xf5, 0xbc, 0xf1, 0x97, 0x29, 0xc8, 0xed, 0x1d, 0x1f, 0x4f, 0x58, 0x86, 0x0d, 0x28,
+	0xf1, 0x5b, 0x96, 0x4f, 0x1d, 0x42, 0x79, 0x8f, 0x65, 0x04, 0xab, 0x18, 0x0e, 0x1e, 0x4c, 0xdb,
+	0xa9, 0x09, 0x99, 0x4d, 0x4f, 0xc8, 0x77, 0xb5, 0x13, 0xaf, 0x41, 0x59, 0x00, 0x08, 0xa1, 0xc3,
+	0x6d, 0x58, 0x8a, 0xa8, 0x17, 0xaf, 0xce, 0xf9, 0x37, 0x58, 0x9d, 0x0b, 0xd7, 0x58, 0x9d, 0xe1,
+	0x5a, 0xab, 0xf3, 0xc4, 0xdd, 0xb1, 0x78, 0xed, 0xdd, 0x51, 0xbd, 0xee, 0xee, 0x58, 0x9a, 0xb8,
+	0x3b, 0x7e, 0x24, 0x0a, 0xe8, 0x83, 0xb8, 0x80, 0xe6, 0x46, 0x72, 0x9f, 0x5e, 0x27, 0xc7, 0xde,
+	0x61, 0x7a, 0xc1, 0xf8, 0x63, 0x16, 0xa6, 0x0e, 0x5e, 0xe2, 0x60, 0xac, 0x88, 0x16, 0x20, 0x1f,
+	0x50, 0x3f, 0xf0, 0xc3, 0x78, 0xee, 0xc7, 0x67, 0x64, 0x80, 0x1a, 0x25, 0x2e, 0xc0, 0x94, 0xf5,
+	0xa3, 0x1a, 0x1a, 0xa2, 0xa1, 0x6f, 0xc3, 0xac, 0xcf, 0xab, 0xd3, 0x1a, 0x85, 0xd6, 0x92, 0x20,
+	0x6f, 0x0c, 0x80, 0x62, 0x0d, 0xca, 0x52, 0x2e, 0x2e, 0x3c, 0xb9, 0x43, 0x4a, 0xb1, 0xcf, 0x22,
+	0x22, 0xaa, 0x41, 0x51, 0x8a, 0xf1, 0xcf, 0x07, 0xa1, 0x3e, 0xbd, 0x92, 0x5d, 0x2f, 0x3e, 0xbd,
+	0x53, 0x91, 0x1f, 0x10, 0x2a, 0x7c, 0x03, 0xad, 0x44, 0x1f, 0x18, 0x2a, 0x0d, 0xdf, 0xf5, 0x4c,
+	0x10, 0xd2, 0xfc, 0xa7, 0x58, 0xa9, 0xf8, 0x5b, 0x32, 0x31, 0x64, 0x46, 0xf6, 0x03, 0x0e, 0xdb,
+	0xb1, 0x19, 0xab, 0xa0, 0x72, 0x99, 0xd8, 0x08, 0x89, 0x02, 0x5c, 0x24, 0x65, 0xc2, 0x1b, 0xbf,
+	0xb7, 0x6a, 0x1f, 0x8b, 0x34, 0xd5, 0xe2, 0x34, 0xa9, 0x49, 0x74, 0x35, 0x05, 0x69, 0xc3, 0xf1,
+	0x9c, 0x90, 0x28, 0x30, 0xfe, 0x9c, 0x85, 0xd9, 0x2d, 0x9f, 0xbe, 0xc4, 0xd4, 0x69, 0xf8, 0x1e,
+	0xa3, 0xd8, 0x66, 0x57, 0x5f, 0x7b, 0xdc, 0x30, 0xec, 0x25, 0x6b, 0x8f, 0x3c, 0x8d, 0x3e, 0xe8,
+	0xa6, 0xc6, 0x1e, 0x74, 0x43, 0x00, 0x9f, 0xbb, 0x00, 0xe0, 0x47, 0x57, 0x9f, 0xc7, 0x80, 0x1c,
+	0xd2, 0x71, 0x5f, 0x10, 0x4a, 0x9c, 0x24, 0xa4, 0xb2, 0xe5, 0xe7, 0x62, 0xce, 0x67, 0x13, 0x27,
+	0x4b, 0x7e, 0x64, 0xb2, 0xac, 0x82, 0x2a, 0x36, 0xa7, 0xc1, 0xe0, 0x90, 0x8d, 0x5d, 0x14, 0xb4,
+	0x68, 0x6c, 0x7c, 0x0f, 0xf2, 0x0e, 0xc1, 0x4e, 0xc7, 0xf5, 0xae, 0x82, 0xb2, 0xb1, 0xac, 0xf8,
+	0x2e, 0x62, 0xdb, 0x24, 0x60, 0xd1, 0xa0, 0x71, 0x22, 0x84, 0x2d, 0x49, 0xaa, 0x1c, 0x32, 0x4e,
+	0xad, 0x26, 0x72, 0xf7, 0xdd, 0x49, 0xcf, 0xb3, 0xd9, 0xa1, 0x10, 0xca, 0x21, 0x3d, 0x50, 0xaf,
+	0x65, 0xf5, 0xa2, 0xf1, 0x95, 0x02, 0x6a, 0xfc, 0x9d, 0xaa, 0x1e, 0xb6, 0xc7, 0x67, 0xb3, 0x32,
+	0x3e, 0x9b, 0x87, 0xe2, 0x91, 0xb9, 0xf0, 0x6b, 0x53, 0x76, 0xe4, 0x6b, 0x53, 0xed, 0xbe, 0x30,
+	0x76, 0x11, 0xee, 0xc0, 0xed, 0xa1, 0xff, 0x49, 0x36, 0x44, 0x5d, 0x35, 0x7e, 0x0e, 0xf3, 0xb1,
+	0x51, 0xfb, 0xb4, 0xe7, 0x91, 0x46, 0x8f, 0x86, 0x3e, 0x1d, 0xa9, 0x70, 0xe5, 0x5a, 0x15, 0x3e,
+	0x78, 0x1a, 0x94, 0x8c, 0x1f, 0xc3, 0xcd, 0x68, 0x1b, 0x3b, 0x20, 0x8c, 0x75, 0x06, 0xca, 0xd3,
+	0xbb, 0x90, 0x72, 0xe5, 0x5d, 0x28, 0xd6, 0x5b, 0x36, 0x7e, 0x0a, 0x9a, 0xc0, 0xbb, 0xb7, 0x6d,
+	0xf1, 0xac, 0xf1, 0x13, 0x98, 0xe5, 0x33, 0xf0, 0x6d, 0x2b, 0xd6, 0x8c, 0x5f, 0xc2, 0xc2, 0x48,
+	0xcf, 0xa6, 0xff, 0x23, 0x5d, 0xb7, 0xca, 0xd5, 0xeb, 0x36, 0xd6, 0x3f, 0x67, 0x7c, 0x01, 0x37,
+	0x07, 0x9f, 0xc4, 0xde, 0xb6, 0xf1, 0xe8, 0xc1, 0x57, 0x0a, 0x94, 0x87, 0xdf, 0x8c, 0x68, 0x19,
+	0xee, 0x6e, 0x35, 0x9b, 0xd6, 0x66, 0xf3, 0xe0, 0x70, 0x7b, 0xb7, 0x7e, 0xb8, 0xbd, 0xb7, 0x6b,
+	0x1d, 0xed, 0x1e, 0xec, 0x37, 0x1b, 0xdb, 0x5b, 0xdb, 0xcd, 0x4d, 0xed, 0x06, 0xd2, 0x61, 0x7e,
+	0x54, 0x60, 0xe3, 0xc8, 0xdc, 0xd5, 0x14, 0x64, 0xc0, 0xd2, 0x28, 0xa7, 0xb1, 0xb7, 0xb3, 0x73,
+	0xb4, 0xbb, 0x7d, 0xf8, 0xb9, 0xb5, 0xbf, 0xb7, 0xf7, 0x5c, 0xcb, 0x4c, 0x92, 0xd9, 0xd9, 0xdb,
+	0x3c, 0x7a, 0xde, 0xb4, 0xea, 0x8d, 0xc6, 0xde, 0xd1, 0xee, 0xa1, 0x96, 0x7d, 0xf0, 0x0b, 0x28,
+	0xa6, 0x76, 0x7d, 0x74, 0x0f, 0xf4, 0xfa, 0x51, 0x43, 0x88, 0x1e, 0x7e, 0xbe, 0xdf, 0x1c, 0x37,
+	0x67, 0x88, 0xdb, 0xdc, 0xfd, 0xe1, 0xf3, 0xed, 0x83, 0x4f, 0x34, 0x05, 0xdd, 0x02, 0x34, 0xc4,
+	0xd9, 0x3c, 0x3a, 0x6c, 0x7c, 0xa2, 0x65, 0x36, 0x7e, 0xf5, 0xf5, 0xab, 0x25, 0xe5, 0x9b, 0x57,
+	0x4b, 0xca, 0xbf, 0x5e, 0x2d, 0x29, 0xbf, 0x7f, 0xbd, 0x74, 0xe3, 0x9b, 0xd7, 0x4b, 0x37, 0xfe,
+	0xf1, 0x7a, 0xe9, 0xc6, 0xcf, 0xb6, 0x4e, 0x5c, 0x76, 0xda, 0x6b, 0x55, 0x6c, 0xbf, 0x5b, 0x15,
+	0x8f, 0x91, 0xc7, 0x1e, 0x61, 0x2f, 0x7d, 0xda, 0x8e, 0x4e, 0x1d, 0xe2, 0x9c, 0x10, 0x5a, 0x3d,
+	0x3b, 0xe7, 0xd3, 0x39, 0x7f, 0xcc, 0x84, 0xfc, 0x23, 0xf8, 0xb4, 0x48, 0xc0, 0xfb, 0xff, 0x1b,
+	0x00, 0x88, 0xba, 0x8a, 0xe7, 0x69, 0x17, 0x00, 0x00,
+}
+
+func (m *SellOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SellOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SellOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SortableAskAmount) > 0 {
+		i -= len(m.SortableAskAmount)
+		copy(dAtA[i:], m.SortableAskAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.SortableAskAmount)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.AllowedBuyers) > 0 {
+		for iNdEx := len(m.AllowedBuyers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedBuyers[iNdEx])
+			copy(dAtA[i:], m.AllowedBuyers[iNdEx])
+			i = encodeVarintState(dAtA, i, uint64(len(m.AllowedBuyers[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.Maker {
+		i--
+		if m.Maker {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Expiration != nil {
+		{
+			size, err := m.Expiration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.DisableAutoRetire {
+		i--
+		if m.DisableAutoRetire {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.AskAmount) > 0 {
+		i -= len(m.AskAmount)
+		copy(dAtA[i:], m.AskAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.AskAmount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BatchKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BuyOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BuyOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuyOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EscrowedFeeBps != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.EscrowedFeeBps))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.RetirementBeneficiary) > 0 {
+		i -= len(m.RetirementBeneficiary)
+		copy(dAtA[i:], m.RetirementBeneficiary)
+		i = encodeVarintState(dAtA, i, uint64(len(m.RetirementBeneficiary)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.RetirementReason) > 0 {
+		i -= len(m.RetirementReason)
+		copy(dAtA[i:], m.RetirementReason)
+		i = encodeVarintState(dAtA, i, uint64(len(m.RetirementReason)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.MaxEndDate != nil {
+		{
+			size, err := m.MaxEndDate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.MinStartDate != nil {
+		{
+			size, err := m.MinStartDate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.ProjectLocation) > 0 {
+		i -= len(m.ProjectLocation)
+		copy(dAtA[i:], m.ProjectLocation)
+		i = encodeVarintState(dAtA, i, uint64(len(m.ProjectLocation)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.ProjectKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ProjectKey))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.ClassKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Maker {
+		i--
+		if m.Maker {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Expiration != nil {
+		{
+			size, err := m.Expiration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.RetirementJurisdiction) > 0 {
+		i -= len(m.RetirementJurisdiction)
+		copy(dAtA[i:], m.RetirementJurisdiction)
+		i = encodeVarintState(dAtA, i, uint64(len(m.RetirementJurisdiction)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.DisableAutoRetire {
+		i--
+		if m.DisableAutoRetire {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.BidAmount) > 0 {
+		i -= len(m.BidAmount)
+		copy(dAtA[i:], m.BidAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.BidAmount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BatchKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllowedDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IbcBaseDenom) > 0 {
+		i -= len(m.IbcBaseDenom)
+		copy(dAtA[i:], m.IbcBaseDenom)
+		i = encodeVarintState(dAtA, i, uint64(len(m.IbcBaseDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.IbcPath) > 0 {
+		i -= len(m.IbcPath)
+		copy(dAtA[i:], m.IbcPath)
+		i = encodeVarintState(dAtA, i, uint64(len(m.IbcPath)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Exponent != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Exponent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DisplayDenom) > 0 {
+		i -= len(m.DisplayDenom)
+		copy(dAtA[i:], m.DisplayDenom)
+		i = encodeVarintState(dAtA, i, uint64(len(m.DisplayDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BankDenom) > 0 {
+		i -= len(m.BankDenom)
+		copy(dAtA[i:], m.BankDenom)
+		i = encodeVarintState(dAtA, i, uint64(len(m.BankDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Market) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Market) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Market) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PrecisionModifier != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.PrecisionModifier))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BankDenom) > 0 {
+		i -= len(m.BankDenom)
+		copy(dAtA[i:], m.BankDenom)
+		i = encodeVarintState(dAtA, i, uint64(len(m.BankDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CreditTypeAbbrev) > 0 {
+		i -= len(m.CreditTypeAbbrev)
+		copy(dAtA[i:], m.CreditTypeAbbrev)
+		i = encodeVarintState(dAtA, i, uint64(len(m.CreditTypeAbbrev)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TradingFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TradingFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TradingFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Destination != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TakerFeeBps != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.TakerFeeBps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MakerFeeBps != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MakerFeeBps))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClassRoyalty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassRoyalty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassRoyalty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RoyaltyBps != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.RoyaltyBps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClassKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Trade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Trade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Trade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != nil {
+		{
+			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BatchKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Auction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Auction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Auction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HighestBidEscrowedFeeBps != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.HighestBidEscrowedFeeBps))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.HighestBidRetirementBeneficiary) > 0 {
+		i -= len(m.HighestBidRetirementBeneficiary)
+		copy(dAtA[i:], m.HighestBidRetirementBeneficiary)
+		i = encodeVarintState(dAtA, i, uint64(len(m.HighestBidRetirementBeneficiary)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.HighestBidRetirementReason) > 0 {
+		i -= len(m.HighestBidRetirementReason)
+		copy(dAtA[i:], m.HighestBidRetirementReason)
+		i = encodeVarintState(dAtA, i, uint64(len(m.HighestBidRetirementReason)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.HighestBidRetirementJurisdiction) > 0 {
+		i -= len(m.HighestBidRetirementJurisdiction)
+		copy(dAtA[i:], m.HighestBidRetirementJurisdiction)
+		i = encodeVarintState(dAtA, i, uint64(len(m.HighestBidRetirementJurisdiction)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.HighestBidDisableAutoRetire {
+		i--
+		if m.HighestBidDisableAutoRetire {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.HighestBidAmount) > 0 {
+		i -= len(m.HighestBidAmount)
+		copy(dAtA[i:], m.HighestBidAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.HighestBidAmount)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.HighestBidder) > 0 {
+		i -= len(m.HighestBidder)
+		copy(dAtA[i:], m.HighestBidder)
+		i = encodeVarintState(dAtA, i, uint64(len(m.HighestBidder)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.DisableAutoRetire {
+		i--
+		if m.DisableAutoRetire {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.EndTime != nil {
+		{
+			size, err := m.EndTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.StartTime != nil {
+		{
+			size, err := m.StartTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.EndAmount) > 0 {
+		i -= len(m.EndAmount)
+		copy(dAtA[i:], m.EndAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.EndAmount)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.StartAmount) > 0 {
+		i -= len(m.StartAmount)
+		copy(dAtA[i:], m.StartAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.StartAmount)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.AuctionType != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.AuctionType))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BatchKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Offer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Offer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Offer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EscrowedFeeBps != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.EscrowedFeeBps))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.RetirementBeneficiary) > 0 {
+		i -= len(m.RetirementBeneficiary)
+		copy(dAtA[i:], m.RetirementBeneficiary)
+		i = encodeVarintState(dAtA, i, uint64(len(m.RetirementBeneficiary)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.RetirementReason) > 0 {
+		i -= len(m.RetirementReason)
+		copy(dAtA[i:], m.RetirementReason)
+		i = encodeVarintState(dAtA, i, uint64(len(m.RetirementReason)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Expiration != nil {
+		{
+			size, err := m.Expiration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.RetirementJurisdiction) > 0 {
+		i -= len(m.RetirementJurisdiction)
+		copy(dAtA[i:], m.RetirementJurisdiction)
+		i = encodeVarintState(dAtA, i, uint64(len(m.RetirementJurisdiction)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.DisableAutoRetire {
+		i--
+		if m.DisableAutoRetire {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.CounterAmount) > 0 {
+		i -= len(m.CounterAmount)
+		copy(dAtA[i:], m.CounterAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.CounterAmount)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.BidAmount) > 0 {
+		i -= len(m.BidAmount)
+		copy(dAtA[i:], m.BidAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.BidAmount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SellOrderId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.SellOrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Swap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Swap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Swap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		{
+			size, err := m.Expiration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.AskQuantity) > 0 {
+		i -= len(m.AskQuantity)
+		copy(dAtA[i:], m.AskQuantity)
+		i = encodeVarintState(dAtA, i, uint64(len(m.AskQuantity)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.AskBatchKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.AskBatchKey))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.OfferCoins) > 0 {
+		for iNdEx := len(m.OfferCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OfferCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.OfferQuantity) > 0 {
+		i -= len(m.OfferQuantity)
+		copy(dAtA[i:], m.OfferQuantity)
+		i = encodeVarintState(dAtA, i, uint64(len(m.OfferQuantity)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.OfferBatchKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.OfferBatchKey))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Counterparty) > 0 {
+		i -= len(m.Counterparty)
+		copy(dAtA[i:], m.Counterparty)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Counterparty)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForwardContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AcceptRetired {
+		i--
+		if m.AcceptRetired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Deadline != nil {
+		{
+			size, err := m.Deadline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.PriceAmount) > 0 {
+		i -= len(m.PriceAmount)
+		copy(dAtA[i:], m.PriceAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.PriceAmount)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.DeliveredQuantity) > 0 {
+		i -= len(m.DeliveredQuantity)
+		copy(dAtA[i:], m.DeliveredQuantity)
+		i = encodeVarintState(dAtA, i, uint64(len(m.DeliveredQuantity)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BatchKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ProjectKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ProjectKey))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SellOrderAsk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SellOrderAsk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SellOrderAsk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AskAmount) > 0 {
+		i -= len(m.AskAmount)
+		copy(dAtA[i:], m.AskAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.AskAmount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SellOrderId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.SellOrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SellOrderPruneCursor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SellOrderPruneCursor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SellOrderPruneCursor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		{
+			size, err := m.Expiration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuctionSettleCursor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuctionSettleCursor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuctionSettleCursor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != nil {
+		{
+			size, err := m.EndTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OfferPruneCursor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OfferPruneCursor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OfferPruneCursor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		{
+			size, err := m.Expiration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapPruneCursor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapPruneCursor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapPruneCursor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		{
+			size, err := m.Expiration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForwardContractPruneCursor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardContractPruneCursor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardContractPruneCursor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deadline != nil {
+		{
+			size, err := m.Deadline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BuyOrderPruneCursor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BuyOrderPruneCursor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuyOrderPruneCursor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		{
+			size, err := m.Expiration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintState(dAtA []byte, offset int, v uint64) int {
+	offset -= sovState(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SellOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.BatchKey != 0 {
+		n += 1 + sovState(uint64(m.BatchKey))
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovState(uint64(m.MarketId))
+	}
+	l = len(m.AskAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.DisableAutoRetire {
+		n += 2
+	}
+	if m.Expiration != nil {
+		l = m.Expiration.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Maker {
+		n += 2
+	}
+	if len(m.AllowedBuyers) > 0 {
+		for _, b := range m.AllowedBuyers {
+			l = len(b)
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	l = len(m.SortableAskAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *BuyOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.BatchKey != 0 {
+		n += 1 + sovState(uint64(m.BatchKey))
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovState(uint64(m.MarketId))
+	}
+	l = len(m.BidAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.DisableAutoRetire {
+		n += 2
+	}
+	l = len(m.RetirementJurisdiction)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = m.Expiration.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Maker {
+		n += 2
+	}
+	if m.ClassKey != 0 {
+		n += 1 + sovState(uint64(m.ClassKey))
+	}
+	if m.ProjectKey != 0 {
+		n += 1 + sovState(uint64(m.ProjectKey))
+	}
+	l = len(m.ProjectLocation)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.MinStartDate != nil {
+		l = m.MinStartDate.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.MaxEndDate != nil {
+		l = m.MaxEndDate.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.RetirementReason)
+	if l > 0 {
+		n += 2 + l + sovState(uint64(l))
+	}
+	l = len(m.RetirementBeneficiary)
+	if l > 0 {
+		n += 2 + l + sovState(uint64(l))
+	}
+	if m.EscrowedFeeBps != 0 {
+		n += 2 + sovState(uint64(m.EscrowedFeeBps))
+	}
+	return n
+}
+
+func (m *AllowedDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BankDenom)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.DisplayDenom)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Exponent != 0 {
+		n += 1 + sovState(uint64(m.Exponent))
+	}
+	l = len(m.IbcPath)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.IbcBaseDenom)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *Market) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.CreditTypeAbbrev)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.BankDenom)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.PrecisionModifier != 0 {
+		n += 1 + sovState(uint64(m.PrecisionModifier))
+	}
+	return n
+}
+
+func (m *TradingFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MakerFeeBps != 0 {
+		n += 1 + sovState(uint64(m.MakerFeeBps))
+	}
+	if m.TakerFeeBps != 0 {
+		n += 1 + sovState(uint64(m.TakerFeeBps))
+	}
+	if m.Destination != 0 {
+		n += 1 + sovState(uint64(m.Destination))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *ClassRoyalty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClassKey != 0 {
+		n += 1 + sovState(uint64(m.ClassKey))
+	}
+	if m.RoyaltyBps != 0 {
+		n += 1 + sovState(uint64(m.RoyaltyBps))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *Trade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.BatchKey != 0 {
+		n += 1 + sovState(uint64(m.BatchKey))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovState(uint64(m.MarketId))
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *Auction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.BatchKey != 0 {
+		n += 1 + sovState(uint64(m.BatchKey))
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovState(uint64(m.MarketId))
+	}
+	if m.AuctionType != 0 {
+		n += 1 + sovState(uint64(m.AuctionType))
+	}
+	l = len(m.StartAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.EndAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.StartTime != nil {
+		l = m.StartTime.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = m.EndTime.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.DisableAutoRetire {
+		n += 2
+	}
+	l = len(m.HighestBidder)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.HighestBidAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.HighestBidDisableAutoRetire {
+		n += 2
+	}
+	l = len(m.HighestBidRetirementJurisdiction)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.HighestBidRetirementReason)
+	if l > 0 {
+		n += 2 + l + sovState(uint64(l))
+	}
+	l = len(m.HighestBidRetirementBeneficiary)
+	if l > 0 {
+		n += 2 + l + sovState(uint64(l))
+	}
+	if m.HighestBidEscrowedFeeBps != 0 {
+		n += 2 + sovState(uint64(m.HighestBidEscrowedFeeBps))
+	}
+	return n
+}
+
+func (m *Offer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	if m.SellOrderId != 0 {
+		n += 1 + sovState(uint64(m.SellOrderId))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovState(uint64(m.MarketId))
+	}
+	l = len(m.BidAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.CounterAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.DisableAutoRetire {
+		n += 2
+	}
+	l = len(m.RetirementJurisdiction)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = m.Expiration.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.RetirementReason)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.RetirementBeneficiary)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.EscrowedFeeBps != 0 {
+		n += 1 + sovState(uint64(m.EscrowedFeeBps))
+	}
+	return n
+}
+
+func (m *Swap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Counterparty)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.OfferBatchKey != 0 {
+		n += 1 + sovState(uint64(m.OfferBatchKey))
+	}
+	l = len(m.OfferQuantity)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if len(m.OfferCoins) > 0 {
+		for _, e := range m.OfferCoins {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	if m.AskBatchKey != 0 {
+		n += 1 + sovState(uint64(m.AskBatchKey))
+	}
+	l = len(m.AskQuantity)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = m.Expiration.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *ForwardContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.ProjectKey != 0 {
+		n += 1 + sovState(uint64(m.ProjectKey))
+	}
+	if m.BatchKey != 0 {
+		n += 1 + sovState(uint64(m.BatchKey))
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.DeliveredQuantity)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovState(uint64(m.MarketId))
+	}
+	l = len(m.PriceAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Deadline != nil {
+		l = m.Deadline.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.AcceptRetired {
+		n += 2
+	}
+	return n
+}
+
+func (m *SellOrderAsk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SellOrderId != 0 {
+		n += 1 + sovState(uint64(m.SellOrderId))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovState(uint64(m.MarketId))
+	}
+	l = len(m.AskAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *SellOrderPruneCursor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		l = m.Expiration.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *AuctionSettleCursor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EndTime != nil {
+		l = m.EndTime.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *OfferPruneCursor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		l = m.Expiration.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *SwapPruneCursor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		l = m.Expiration.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *ForwardContractPruneCursor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Deadline != nil {
+		l = m.Deadline.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *BuyOrderPruneCursor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		l = m.Expiration.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func sovState(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozState(x uint64) (n int) {
+	return sovState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SellOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SellOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SellOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = append(m.Seller[:0], dAtA[iNdEx:postIndex]...)
+			if m.Seller == nil {
+				m.Seller = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+			}
+			m.BatchKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AskAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableAutoRetire = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = &types.Timestamp{}
+			}
+			if err := m.Expiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maker", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Maker = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedBuyers", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedBuyers = append(m.AllowedBuyers, make([]byte, postIndex-iNdEx))
+			copy(m.AllowedBuyers[len(m.AllowedBuyers)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SortableAskAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SortableAskAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BuyOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BuyOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BuyOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = append(m.Buyer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Buyer == nil {
+				m.Buyer = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+			}
+			m.BatchKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableAutoRetire = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementJurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetirementJurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = &types.Timestamp{}
+			}
+			if err := m.Expiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maker", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Maker = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
+			}
+			m.ClassKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClassKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
+			}
+			m.ProjectKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProjectKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectLocation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectLocation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStartDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MinStartDate == nil {
+				m.MinStartDate = &types.Timestamp{}
+			}
+			if err := m.MinStartDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEndDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxEndDate == nil {
+				m.MaxEndDate = &types.Timestamp{}
+			}
+			if err := m.MaxEndDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetirementReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementBeneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetirementBeneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowedFeeBps", wireType)
+			}
+			m.EscrowedFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EscrowedFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowedDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BankDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			}
+			m.Exponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcBaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcBaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Market) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Market: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Market: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BankDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrecisionModifier", wireType)
+			}
+			m.PrecisionModifier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrecisionModifier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TradingFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TradingFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TradingFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeBps", wireType)
+			}
+			m.MakerFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MakerFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeBps", wireType)
+			}
+			m.TakerFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TakerFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			m.Destination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Destination |= FeeDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClassRoyalty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassRoyalty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassRoyalty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
+			}
+			m.ClassKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClassKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyBps", wireType)
+			}
+			m.RoyaltyBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoyaltyBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = append(m.Recipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.Recipient == nil {
+				m.Recipient = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Trade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Trade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Trade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = append(m.Buyer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Buyer == nil {
+				m.Buyer = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = append(m.Seller[:0], dAtA[iNdEx:postIndex]...)
+			if m.Seller == nil {
+				m.Seller = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+			}
+			m.BatchKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &types.Timestamp{}
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Auction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Auction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Auction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = append(m.Seller[:0], dAtA[iNdEx:postIndex]...)
+			if m.Seller == nil {
+				m.Seller = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+			}
+			m.BatchKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionType", wireType)
+			}
+			m.AuctionType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionType |= AuctionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = &types.Timestamp{}
+			}
+			if err := m.StartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = &types.Timestamp{}
+			}
+			if err := m.EndTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableAutoRetire = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestBidder", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HighestBidder = append(m.HighestBidder[:0], dAtA[iNdEx:postIndex]...)
+			if m.HighestBidder == nil {
+				m.HighestBidder = []byte{}
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestBidAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HighestBidAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestBidDisableAutoRetire", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HighestBidDisableAutoRetire = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestBidRetirementJurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HighestBidRetirementJurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestBidRetirementReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HighestBidRetirementReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestBidRetirementBeneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HighestBidRetirementBeneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestBidEscrowedFeeBps", wireType)
+			}
+			m.HighestBidEscrowedFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HighestBidEscrowedFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Offer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Offer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Offer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellOrderId", wireType)
+			}
+			m.SellOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SellOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = append(m.Buyer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Buyer == nil {
+				m.Buyer = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableAutoRetire = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementJurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetirementJurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = &types.Timestamp{}
+			}
+			if err := m.Expiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetirementReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementBeneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetirementBeneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowedFeeBps", wireType)
+			}
+			m.EscrowedFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EscrowedFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Swap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Swap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Swap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = append(m.Proposer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proposer == nil {
+				m.Proposer = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Counterparty = append(m.Counterparty[:0], dAtA[iNdEx:postIndex]...)
+			if m.Counterparty == nil {
+				m.Counterparty = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferBatchKey", wireType)
+			}
+			m.OfferBatchKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OfferBatchKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OfferQuantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OfferCoins = append(m.OfferCoins, &types1.Coin{})
+			if err := m.OfferCoins[len(m.OfferCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskBatchKey", wireType)
+			}
+			m.AskBatchKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AskBatchKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AskQuantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = &types.Timestamp{}
+			}
+			if err := m.Expiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForwardContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForwardContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForwardContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = append(m.Buyer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Buyer == nil {
+				m.Buyer = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = append(m.Issuer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Issuer == nil {
+				m.Issuer = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
+			}
+			m.ProjectKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProjectKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+			}
+			m.BatchKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveredQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SellOrder: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SellOrder: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeliveredQuantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			m.Id = 0
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -697,16 +7301,16 @@ func (m *SellOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.MarketId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceAmount", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -716,31 +7320,29 @@ func (m *SellOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Seller = append(m.Seller[:0], dAtA[iNdEx:postIndex]...)
-			if m.Seller == nil {
-				m.Seller = []byte{}
-			}
+			m.PriceAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
 			}
-			m.BatchKey = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -750,16 +7352,33 @@ func (m *SellOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchKey |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthState
 			}
-			var stringLen uint64
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deadline == nil {
+				m.Deadline = &types.Timestamp{}
+			}
+			if err := m.Deadline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptRetired", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -769,25 +7388,82 @@ func (m *SellOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
+			m.AcceptRetired = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthState
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Quantity = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SellOrderAsk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SellOrderAsk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SellOrderAsk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellOrderId", wireType)
+			}
+			m.SellOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SellOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
@@ -806,7 +7482,7 @@ func (m *SellOrder) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 6:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AskAmount", wireType)
 			}
@@ -838,11 +7514,61 @@ func (m *SellOrder) Unmarshal(dAtA []byte) error {
 			}
 			m.AskAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SellOrderPruneCursor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SellOrderPruneCursor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SellOrderPruneCursor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -852,15 +7578,81 @@ func (m *SellOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DisableAutoRetire = bool(v != 0)
-		case 9:
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = &types.Timestamp{}
+			}
+			if err := m.Expiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuctionSettleCursor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuctionSettleCursor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuctionSettleCursor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -887,33 +7679,13 @@ func (m *SellOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Expiration == nil {
-				m.Expiration = &types.Timestamp{}
+			if m.EndTime == nil {
+				m.EndTime = &types.Timestamp{}
 			}
-			if err := m.Expiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EndTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Maker", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Maker = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -935,7 +7707,7 @@ func (m *SellOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllowedDenom) Unmarshal(dAtA []byte) error {
+func (m *OfferPruneCursor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -958,17 +7730,17 @@ func (m *AllowedDenom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllowedDenom: wiretype end group for non-group")
+			return fmt.Errorf("proto: OfferPruneCursor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllowedDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OfferPruneCursor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -978,29 +7750,83 @@ func (m *AllowedDenom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BankDenom = string(dAtA[iNdEx:postIndex])
+			if m.Expiration == nil {
+				m.Expiration = &types.Timestamp{}
+			}
+			if err := m.Expiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapPruneCursor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapPruneCursor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapPruneCursor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisplayDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1010,43 +7836,28 @@ func (m *AllowedDenom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DisplayDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			if m.Expiration == nil {
+				m.Expiration = &types.Timestamp{}
 			}
-			m.Exponent = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Exponent |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Expiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -1068,7 +7879,7 @@ func (m *AllowedDenom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Market) Unmarshal(dAtA []byte) error {
+func (m *ForwardContractPruneCursor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1091,36 +7902,17 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Market: wiretype end group for non-group")
+			return fmt.Errorf("proto: ForwardContractPruneCursor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Market: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ForwardContractPruneCursor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1130,29 +7922,83 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
+			if m.Deadline == nil {
+				m.Deadline = &types.Timestamp{}
+			}
+			if err := m.Deadline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BuyOrderPruneCursor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BuyOrderPruneCursor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BuyOrderPruneCursor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1162,43 +8008,28 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BankDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrecisionModifier", wireType)
+			if m.Expiration == nil {
+				m.Expiration = &types.Timestamp{}
 			}
-			m.PrecisionModifier = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PrecisionModifier |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Expiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package v1
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
 
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 	"github.com/regen-network/regen-ledger/x/ecocredit/base"
@@ -17,6 +18,10 @@ func (m *AllowedDenom) Validate() error {
 		return ecocredit.ErrParseFailure.Wrapf("bank denom: %s", err)
 	}
 
+	if err := ibctransfertypes.ValidateIBCDenom(m.BankDenom); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("bank denom: %s", err)
+	}
+
 	if m.DisplayDenom == "" {
 		return ecocredit.ErrParseFailure.Wrap("display denom cannot be empty")
 	}
@@ -29,5 +34,16 @@ func (m *AllowedDenom) Validate() error {
 		return err // returns parse error
 	}
 
+	if m.IbcPath != "" || m.IbcBaseDenom != "" {
+		trace := ibctransfertypes.DenomTrace{Path: m.IbcPath, BaseDenom: m.IbcBaseDenom}
+		if err := trace.Validate(); err != nil {
+			return ecocredit.ErrParseFailure.Wrapf("ibc denom trace: %s", err)
+		}
+
+		if m.IbcPath == "" || trace.IBCDenom() != m.BankDenom {
+			return ecocredit.ErrParseFailure.Wrapf("ibc denom trace does not match bank denom %s", m.BankDenom)
+		}
+	}
+
 	return nil
 }
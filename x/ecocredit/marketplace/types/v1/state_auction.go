@@ -0,0 +1,72 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// Validate performs basic validation of the Auction state type
+func (m *Auction) Validate() error {
+	if m.Id == 0 {
+		return ecocredit.ErrParseFailure.Wrapf("id cannot be zero")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(sdk.AccAddress(m.Seller).String()); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("seller: %s", err)
+	}
+
+	if m.BatchKey == 0 {
+		return ecocredit.ErrParseFailure.Wrapf("batch key cannot be zero")
+	}
+
+	if _, err := math.NewPositiveDecFromString(m.Quantity); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("quantity: %s", err)
+	}
+
+	if m.MarketId == 0 {
+		return ecocredit.ErrParseFailure.Wrapf("market id cannot be zero")
+	}
+
+	if _, ok := AuctionType_name[int32(m.AuctionType)]; !ok || m.AuctionType == AuctionType_AUCTION_TYPE_UNSPECIFIED {
+		return ecocredit.ErrParseFailure.Wrapf("invalid auction type: %s", m.AuctionType)
+	}
+
+	startAmount, ok := sdk.NewIntFromString(m.StartAmount)
+	if !ok || !startAmount.IsPositive() {
+		return ecocredit.ErrParseFailure.Wrapf("start amount must be a positive integer")
+	}
+
+	if m.AuctionType == AuctionType_AUCTION_TYPE_DUTCH {
+		endAmount, ok := sdk.NewIntFromString(m.EndAmount)
+		if !ok || !endAmount.IsPositive() || !endAmount.LT(startAmount) {
+			return ecocredit.ErrParseFailure.Wrapf("end amount must be a positive integer less than start amount")
+		}
+	} else if m.EndAmount != "" {
+		return ecocredit.ErrParseFailure.Wrapf("end amount must be empty for an english auction")
+	}
+
+	if m.StartTime == nil {
+		return ecocredit.ErrParseFailure.Wrapf("start time cannot be empty")
+	}
+
+	if m.EndTime == nil {
+		return ecocredit.ErrParseFailure.Wrapf("end time cannot be empty")
+	}
+
+	if len(m.HighestBidder) != 0 {
+		if _, err := sdk.AccAddressFromBech32(sdk.AccAddress(m.HighestBidder).String()); err != nil {
+			return ecocredit.ErrParseFailure.Wrapf("highest bidder: %s", err)
+		}
+
+		bidAmount, ok := sdk.NewIntFromString(m.HighestBidAmount)
+		if !ok || !bidAmount.IsPositive() {
+			return ecocredit.ErrParseFailure.Wrapf("highest bid amount must be a positive integer")
+		}
+	} else if m.HighestBidAmount != "" {
+		return ecocredit.ErrParseFailure.Wrapf("highest bid amount must be empty if there is no highest bidder")
+	}
+
+	return nil
+}
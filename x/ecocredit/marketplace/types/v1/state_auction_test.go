@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type auction struct {
+	t       gocuke.TestingT
+	auction *Auction
+	err     error
+}
+
+func TestAuction(t *testing.T) {
+	gocuke.NewRunner(t, &auction{}).Path("./features/state_auction.feature").Run()
+}
+
+func (s *auction) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *auction) TheAuction(a gocuke.DocString) {
+	s.auction = &Auction{}
+	err := jsonpb.UnmarshalString(a.Content, s.auction)
+	require.NoError(s.t, err)
+}
+
+func (s *auction) TheAuctionIsValidated() {
+	s.err = s.auction.Validate()
+}
+
+func (s *auction) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *auction) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -0,0 +1,78 @@
+package v1
+
+import (
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+// Validate performs basic validation of the BuyOrder state type
+func (m *BuyOrder) Validate() error {
+	if m.Id == 0 {
+		return ecocredit.ErrParseFailure.Wrapf("id cannot be zero")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(sdk.AccAddress(m.Buyer).String()); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("buyer: %s", err)
+	}
+
+	switch {
+	case m.BatchKey == 0 && m.ClassKey == 0 && m.ProjectKey == 0:
+		return ecocredit.ErrParseFailure.Wrapf("batch key cannot be zero")
+	case (m.BatchKey != 0 && m.ClassKey != 0) || (m.BatchKey != 0 && m.ProjectKey != 0) || (m.ClassKey != 0 && m.ProjectKey != 0):
+		return ecocredit.ErrParseFailure.Wrapf("only one of batch key, class key, or project key can be set")
+	}
+
+	if m.ProjectLocation != "" {
+		if m.ClassKey == 0 {
+			return ecocredit.ErrParseFailure.Wrapf("project location can only be set with class key")
+		}
+
+		if err := base.ValidateJurisdiction(m.ProjectLocation); err != nil {
+			return errors.Wrap(err, "project location") // returns parse error
+		}
+	}
+
+	if m.BatchKey != 0 && (m.MinStartDate != nil || m.MaxEndDate != nil) {
+		return ecocredit.ErrParseFailure.Wrapf("min start date and max end date cannot be set with batch key")
+	}
+
+	if m.Quantity == "" {
+		return ecocredit.ErrParseFailure.Wrapf("quantity cannot be empty")
+	}
+
+	if _, err := math.NewNonNegativeDecFromString(m.Quantity); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("quantity: %s", err)
+	}
+
+	if m.MarketId == 0 {
+		return ecocredit.ErrParseFailure.Wrapf("market id cannot be zero")
+	}
+
+	if m.BidAmount == "" {
+		return ecocredit.ErrParseFailure.Wrapf("bid amount cannot be empty")
+	}
+
+	if _, err := math.NewNonNegativeDecFromString(m.BidAmount); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("bid amount: %s", err)
+	}
+
+	if !m.DisableAutoRetire {
+		if err := base.ValidateJurisdiction(m.RetirementJurisdiction); err != nil {
+			return errors.Wrap(err, "retirement jurisdiction") // returns parse error
+		}
+	}
+
+	if len(m.RetirementReason) > base.MaxNoteLength {
+		return ecocredit.ErrParseFailure.Wrapf("retirement reason: max length %d", base.MaxNoteLength)
+	}
+
+	if len(m.RetirementBeneficiary) > base.MaxNoteLength {
+		return ecocredit.ErrParseFailure.Wrapf("retirement beneficiary: max length %d", base.MaxNoteLength)
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type buyOrder struct {
+	t        gocuke.TestingT
+	buyOrder *BuyOrder
+	err      error
+}
+
+func TestBuyOrder(t *testing.T) {
+	gocuke.NewRunner(t, &buyOrder{}).Path("./features/state_buy_order.feature").Run()
+}
+
+func (s *buyOrder) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *buyOrder) TheBuyOrder(a gocuke.DocString) {
+	s.buyOrder = &BuyOrder{}
+	err := jsonpb.UnmarshalString(a.Content, s.buyOrder)
+	require.NoError(s.t, err)
+}
+
+func (s *buyOrder) TheBuyOrderIsValidated() {
+	s.err = s.buyOrder.Validate()
+}
+
+func (s *buyOrder) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *buyOrder) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -0,0 +1,31 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// MaxRoyaltyBps is the maximum class royalty in basis points (50%).
+const MaxRoyaltyBps = 5_000
+
+// Validate performs basic validation of the ClassRoyalty state type
+func (m *ClassRoyalty) Validate() error {
+	if m.ClassKey == 0 {
+		return ecocredit.ErrParseFailure.Wrapf("class key cannot be zero")
+	}
+
+	if m.RoyaltyBps == 0 {
+		return ecocredit.ErrParseFailure.Wrapf("royalty cannot be zero")
+	}
+
+	if m.RoyaltyBps > MaxRoyaltyBps {
+		return ecocredit.ErrParseFailure.Wrapf("royalty cannot exceed %d basis points", MaxRoyaltyBps)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(sdk.AccAddress(m.Recipient).String()); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("recipient: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type classRoyalty struct {
+	t            gocuke.TestingT
+	classRoyalty *ClassRoyalty
+	err          error
+}
+
+func TestClassRoyalty(t *testing.T) {
+	gocuke.NewRunner(t, &classRoyalty{}).Path("./features/state_class_royalty.feature").Run()
+}
+
+func (s *classRoyalty) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *classRoyalty) TheClassRoyalty(a gocuke.DocString) {
+	s.classRoyalty = &ClassRoyalty{}
+	err := jsonpb.UnmarshalString(a.Content, s.classRoyalty)
+	require.NoError(s.t, err)
+}
+
+func (s *classRoyalty) TheClassRoyaltyIsValidated() {
+	s.err = s.classRoyalty.Validate()
+}
+
+func (s *classRoyalty) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *classRoyalty) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -0,0 +1,56 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// Validate performs basic validation of the ForwardContract state type
+func (m *ForwardContract) Validate() error {
+	if m.Id == 0 {
+		return ecocredit.ErrParseFailure.Wrapf("id cannot be zero")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(sdk.AccAddress(m.Buyer).String()); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("buyer: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(sdk.AccAddress(m.Issuer).String()); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("issuer: %s", err)
+	}
+
+	if m.ProjectKey == 0 {
+		return ecocredit.ErrParseFailure.Wrapf("project key cannot be zero")
+	}
+
+	quantity, err := math.NewPositiveDecFromString(m.Quantity)
+	if err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("quantity: %s", err)
+	}
+
+	delivered, err := math.NewNonNegativeDecFromString(m.DeliveredQuantity)
+	if err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("delivered quantity: %s", err)
+	}
+
+	if delivered.Cmp(quantity) != -1 {
+		return ecocredit.ErrParseFailure.Wrapf("delivered quantity must be less than quantity")
+	}
+
+	if m.MarketId == 0 {
+		return ecocredit.ErrParseFailure.Wrapf("market id cannot be zero")
+	}
+
+	priceAmount, ok := sdk.NewIntFromString(m.PriceAmount)
+	if !ok || !priceAmount.IsPositive() {
+		return ecocredit.ErrParseFailure.Wrapf("price amount must be a positive integer")
+	}
+
+	if m.Deadline == nil {
+		return ecocredit.ErrParseFailure.Wrapf("deadline cannot be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type forwardContract struct {
+	t               gocuke.TestingT
+	forwardContract *ForwardContract
+	err             error
+}
+
+func TestForwardContract(t *testing.T) {
+	gocuke.NewRunner(t, &forwardContract{}).Path("./features/state_forward_contract.feature").Run()
+}
+
+func (s *forwardContract) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *forwardContract) TheForwardContract(a gocuke.DocString) {
+	s.forwardContract = &ForwardContract{}
+	err := jsonpb.UnmarshalString(a.Content, s.forwardContract)
+	require.NoError(s.t, err)
+}
+
+func (s *forwardContract) TheForwardContractIsValidated() {
+	s.err = s.forwardContract.Validate()
+}
+
+func (s *forwardContract) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *forwardContract) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
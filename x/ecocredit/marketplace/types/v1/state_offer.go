@@ -0,0 +1,49 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// Validate performs basic validation of the Offer state type
+func (m *Offer) Validate() error {
+	if m.Id == 0 {
+		return ecocredit.ErrParseFailure.Wrapf("id cannot be zero")
+	}
+
+	if m.SellOrderId == 0 {
+		return ecocredit.ErrParseFailure.Wrapf("sell order id cannot be zero")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(sdk.AccAddress(m.Buyer).String()); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("buyer: %s", err)
+	}
+
+	if _, err := math.NewPositiveDecFromString(m.Quantity); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("quantity: %s", err)
+	}
+
+	if m.MarketId == 0 {
+		return ecocredit.ErrParseFailure.Wrapf("market id cannot be zero")
+	}
+
+	bidAmount, ok := sdk.NewIntFromString(m.BidAmount)
+	if !ok || !bidAmount.IsPositive() {
+		return ecocredit.ErrParseFailure.Wrapf("bid amount must be a positive integer")
+	}
+
+	if m.CounterAmount != "" {
+		counterAmount, ok := sdk.NewIntFromString(m.CounterAmount)
+		if !ok || !counterAmount.GT(bidAmount) {
+			return ecocredit.ErrParseFailure.Wrapf("counter amount must be an integer greater than bid amount")
+		}
+	}
+
+	if m.Expiration == nil {
+		return ecocredit.ErrParseFailure.Wrapf("expiration cannot be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type offer struct {
+	t     gocuke.TestingT
+	offer *Offer
+	err   error
+}
+
+func TestOffer(t *testing.T) {
+	gocuke.NewRunner(t, &offer{}).Path("./features/state_offer.feature").Run()
+}
+
+func (s *offer) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *offer) TheOffer(a gocuke.DocString) {
+	s.offer = &Offer{}
+	err := jsonpb.UnmarshalString(a.Content, s.offer)
+	require.NoError(s.t, err)
+}
+
+func (s *offer) TheOfferIsValidated() {
+	s.err = s.offer.Validate()
+}
+
+func (s *offer) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *offer) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
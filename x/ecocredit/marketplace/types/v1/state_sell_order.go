@@ -1,11 +1,30 @@
 package v1
 
 import (
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/regen-network/regen-ledger/types/math"
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 )
 
+// sortableAskAmountLength is the number of decimal digits of the largest
+// integer amount, i.e. 2^256 - 1.
+const sortableAskAmountLength = 78
+
+// SortableAskAmount encodes the ask amount as a zero-padded decimal string of
+// fixed length so that the lexicographic order of the encoded ask amounts is
+// the numeric order of the ask amounts.
+func SortableAskAmount(askAmount sdkmath.Int) string {
+	s := askAmount.String()
+	if len(s) >= sortableAskAmountLength {
+		return s
+	}
+	return strings.Repeat("0", sortableAskAmountLength-len(s)) + s
+}
+
 // Validate performs basic validation of the SellOrder state type
 func (m *SellOrder) Validate() error {
 	if m.Id == 0 {
@@ -40,5 +59,16 @@ func (m *SellOrder) Validate() error {
 		return ecocredit.ErrParseFailure.Wrapf("ask amount: %s", err)
 	}
 
+	askAmount, ok := sdkmath.NewIntFromString(m.AskAmount)
+	if !ok || m.SortableAskAmount != SortableAskAmount(askAmount) {
+		return ecocredit.ErrParseFailure.Wrapf("sortable ask amount does not match ask amount %s", m.AskAmount)
+	}
+
+	for i, buyer := range m.AllowedBuyers {
+		if _, err := sdk.AccAddressFromBech32(sdk.AccAddress(buyer).String()); err != nil {
+			return ecocredit.ErrParseFailure.Wrapf("allowed buyers[%d]: %s", i, err)
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,25 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// Validate performs basic validation of the SellOrderAsk state type
+func (m *SellOrderAsk) Validate() error {
+	if m.SellOrderId == 0 {
+		return ecocredit.ErrParseFailure.Wrapf("sell order id cannot be zero")
+	}
+
+	if m.MarketId == 0 {
+		return ecocredit.ErrParseFailure.Wrapf("market id cannot be zero")
+	}
+
+	askAmount, ok := sdk.NewIntFromString(m.AskAmount)
+	if !ok || !askAmount.IsPositive() {
+		return ecocredit.ErrParseFailure.Wrapf("ask amount must be a positive integer")
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type sellOrderAsk struct {
+	t            gocuke.TestingT
+	sellOrderAsk *SellOrderAsk
+	err          error
+}
+
+func TestSellOrderAsk(t *testing.T) {
+	gocuke.NewRunner(t, &sellOrderAsk{}).Path("./features/state_sell_order_ask.feature").Run()
+}
+
+func (s *sellOrderAsk) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *sellOrderAsk) TheSellOrderAsk(a gocuke.DocString) {
+	s.sellOrderAsk = &SellOrderAsk{}
+	err := jsonpb.UnmarshalString(a.Content, s.sellOrderAsk)
+	require.NoError(s.t, err)
+}
+
+func (s *sellOrderAsk) TheSellOrderAskIsValidated() {
+	s.err = s.sellOrderAsk.Validate()
+}
+
+func (s *sellOrderAsk) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *sellOrderAsk) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
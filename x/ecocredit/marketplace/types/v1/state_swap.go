@@ -0,0 +1,58 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// Validate performs basic validation of the Swap state type
+func (m *Swap) Validate() error {
+	if m.Id == 0 {
+		return ecocredit.ErrParseFailure.Wrapf("id cannot be zero")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(sdk.AccAddress(m.Proposer).String()); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("proposer: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(sdk.AccAddress(m.Counterparty).String()); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("counterparty: %s", err)
+	}
+
+	if m.OfferBatchKey == 0 {
+		return ecocredit.ErrParseFailure.Wrapf("offer batch key cannot be zero")
+	}
+
+	if _, err := math.NewPositiveDecFromString(m.OfferQuantity); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("offer quantity: %s", err)
+	}
+
+	for i, coin := range m.OfferCoins {
+		if coin == nil {
+			return ecocredit.ErrParseFailure.Wrapf("offer coins[%d]: cannot be empty", i)
+		}
+		if err := coin.Validate(); err != nil {
+			return ecocredit.ErrParseFailure.Wrapf("offer coins[%d]: %s", i, err)
+		}
+	}
+
+	if m.AskBatchKey == 0 {
+		return ecocredit.ErrParseFailure.Wrapf("ask batch key cannot be zero")
+	}
+
+	if m.AskBatchKey == m.OfferBatchKey {
+		return ecocredit.ErrParseFailure.Wrapf("ask batch key cannot be the same as offer batch key")
+	}
+
+	if _, err := math.NewPositiveDecFromString(m.AskQuantity); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("ask quantity: %s", err)
+	}
+
+	if m.Expiration == nil {
+		return ecocredit.ErrParseFailure.Wrapf("expiration cannot be empty")
+	}
+
+	return nil
+}
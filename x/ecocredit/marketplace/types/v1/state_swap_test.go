@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type swap struct {
+	t    gocuke.TestingT
+	swap *Swap
+	err  error
+}
+
+func TestSwap(t *testing.T) {
+	gocuke.NewRunner(t, &swap{}).Path("./features/state_swap.feature").Run()
+}
+
+func (s *swap) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *swap) TheSwap(a gocuke.DocString) {
+	s.swap = &Swap{}
+	err := jsonpb.UnmarshalString(a.Content, s.swap)
+	require.NoError(s.t, err)
+}
+
+func (s *swap) TheSwapIsValidated() {
+	s.err = s.swap.Validate()
+}
+
+func (s *swap) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *swap) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
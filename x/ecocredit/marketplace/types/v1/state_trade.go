@@ -0,0 +1,53 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// Validate performs basic validation of the Trade state type
+func (m *Trade) Validate() error {
+	if m.Id == 0 {
+		return ecocredit.ErrParseFailure.Wrapf("id cannot be zero")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(sdk.AccAddress(m.Buyer).String()); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("buyer: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(sdk.AccAddress(m.Seller).String()); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("seller: %s", err)
+	}
+
+	if m.BatchKey == 0 {
+		return ecocredit.ErrParseFailure.Wrapf("batch key cannot be zero")
+	}
+
+	if m.MarketId == 0 {
+		return ecocredit.ErrParseFailure.Wrapf("market id cannot be zero")
+	}
+
+	if m.Quantity == "" {
+		return ecocredit.ErrParseFailure.Wrapf("quantity cannot be empty")
+	}
+
+	if _, err := math.NewPositiveDecFromString(m.Quantity); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("quantity: %s", err)
+	}
+
+	if m.Price == "" {
+		return ecocredit.ErrParseFailure.Wrapf("price cannot be empty")
+	}
+
+	if _, err := math.NewNonNegativeDecFromString(m.Price); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("price: %s", err)
+	}
+
+	if m.Timestamp == nil {
+		return ecocredit.ErrParseFailure.Wrapf("timestamp cannot be empty")
+	}
+
+	return nil
+}
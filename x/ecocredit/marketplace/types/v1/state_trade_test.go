@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type trade struct {
+	t     gocuke.TestingT
+	trade *Trade
+	err   error
+}
+
+func TestTrade(t *testing.T) {
+	gocuke.NewRunner(t, &trade{}).Path("./features/state_trade.feature").Run()
+}
+
+func (s *trade) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *trade) TheTrade(a gocuke.DocString) {
+	s.trade = &Trade{}
+	err := jsonpb.UnmarshalString(a.Content, s.trade)
+	require.NoError(s.t, err)
+}
+
+func (s *trade) TheTradeIsValidated() {
+	s.err = s.trade.Validate()
+}
+
+func (s *trade) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *trade) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -0,0 +1,43 @@
+package v1
+
+import (
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// MaxFeeBps is the maximum trading fee in basis points (100%).
+const MaxFeeBps = 10_000
+
+// Validate performs basic validation of the TradingFee state type
+func (m *TradingFee) Validate() error {
+	if m.MakerFeeBps > MaxFeeBps {
+		return ecocredit.ErrParseFailure.Wrapf("maker fee cannot exceed %d basis points", MaxFeeBps)
+	}
+
+	if m.TakerFeeBps > MaxFeeBps {
+		return ecocredit.ErrParseFailure.Wrapf("taker fee cannot exceed %d basis points", MaxFeeBps)
+	}
+
+	if m.Destination == FeeDestination_FEE_DESTINATION_UNSPECIFIED {
+		// no trading fee has been set (the default singleton value)
+		if m.MakerFeeBps == 0 && m.TakerFeeBps == 0 && m.ModuleAccount == "" {
+			return nil
+		}
+		return ecocredit.ErrParseFailure.Wrap("destination must be specified")
+	}
+
+	if _, ok := FeeDestination_name[int32(m.Destination)]; !ok {
+		return ecocredit.ErrParseFailure.Wrapf("invalid destination: %d", m.Destination)
+	}
+
+	if m.Destination == FeeDestination_FEE_DESTINATION_MODULE_ACCOUNT {
+		if m.ModuleAccount == "" {
+			return ecocredit.ErrParseFailure.Wrap("module account cannot be empty")
+		}
+	} else if m.ModuleAccount != "" {
+		return ecocredit.ErrParseFailure.Wrapf(
+			"module account can only be set with destination %s", FeeDestination_FEE_DESTINATION_MODULE_ACCOUNT,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type tradingFee struct {
+	t          gocuke.TestingT
+	tradingFee *TradingFee
+	err        error
+}
+
+func TestTradingFee(t *testing.T) {
+	gocuke.NewRunner(t, &tradingFee{}).Path("./features/state_trading_fee.feature").Run()
+}
+
+func (s *tradingFee) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *tradingFee) TheTradingFee(a gocuke.DocString) {
+	s.tradingFee = &TradingFee{}
+	err := jsonpb.UnmarshalString(a.Content, s.tradingFee)
+	require.NoError(s.t, err)
+}
+
+func (s *tradingFee) TheTradingFeeIsValidated() {
+	s.err = s.tradingFee.Validate()
+}
+
+func (s *tradingFee) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *tradingFee) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -0,0 +1,103 @@
+package v4
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	marketapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	markettypes "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+)
+
+// MigrateState performs in-place store migrations from ConsensusVersion 3 to 4.
+func MigrateState(sdkCtx sdk.Context, baseStore baseapi.StateStore, marketStore marketapi.StateStore) error {
+	if err := migrateSellOrders(sdkCtx, marketStore); err != nil {
+		return err
+	}
+
+	return migrateProjects(sdkCtx, baseStore)
+}
+
+// migrateSellOrders sets the sortable ask amount of existing sell orders and
+// populates the market id and the market id and sortable ask amount indexes.
+func migrateSellOrders(sdkCtx sdk.Context, marketStore marketapi.StateStore) error {
+	it, err := marketStore.SellOrderTable().List(sdkCtx, marketapi.SellOrderPrimaryKey{})
+	if err != nil {
+		return err
+	}
+
+	var sellOrders []*marketapi.SellOrder
+	for it.Next() {
+		sellOrder, err := it.Value()
+		if err != nil {
+			it.Close()
+			return err
+		}
+		sellOrders = append(sellOrders, sellOrder)
+	}
+	it.Close()
+
+	// An index entry is only written when the indexed value of a sell order
+	// changes, so each sell order is updated with a market id of zero and then
+	// with its market id and sortable ask amount.
+	for _, sellOrder := range sellOrders {
+		marketID := sellOrder.MarketId
+		askAmount, ok := sdkmath.NewIntFromString(sellOrder.AskAmount)
+		if !ok {
+			return sdkerrors.ErrInvalidType.Wrapf("sell order %d: invalid ask amount %s", sellOrder.Id, sellOrder.AskAmount)
+		}
+
+		sellOrder.MarketId = 0
+		if err := marketStore.SellOrderTable().Update(sdkCtx, sellOrder); err != nil {
+			return err
+		}
+
+		sellOrder.MarketId = marketID
+		sellOrder.SortableAskAmount = markettypes.SortableAskAmount(askAmount)
+		if err := marketStore.SellOrderTable().Update(sdkCtx, sellOrder); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// migrateProjects populates the status index of existing projects.
+func migrateProjects(sdkCtx sdk.Context, baseStore baseapi.StateStore) error {
+	it, err := baseStore.ProjectTable().List(sdkCtx, baseapi.ProjectPrimaryKey{})
+	if err != nil {
+		return err
+	}
+
+	var projects []*baseapi.Project
+	for it.Next() {
+		project, err := it.Value()
+		if err != nil {
+			it.Close()
+			return err
+		}
+		projects = append(projects, project)
+	}
+	it.Close()
+
+	// An index entry is only written when the indexed value of a project
+	// changes, so each project is updated with a different status and then
+	// with its status.
+	for _, project := range projects {
+		status := project.Status
+
+		project.Status = status + 1
+		if err := baseStore.ProjectTable().Update(sdkCtx, project); err != nil {
+			return err
+		}
+
+		project.Status = status
+		if err := baseStore.ProjectTable().Update(sdkCtx, project); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package v4_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormdb"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormtable"
+	"github.com/cosmos/cosmos-sdk/orm/testing/ormtest"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	marketapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	v4 "github.com/regen-network/regen-ledger/x/ecocredit/migrations/v4"
+)
+
+func TestMigrations(t *testing.T) {
+	backend := ormtest.NewMemoryBackend()
+	ormCtx := ormtable.WrapContextDefault(backend)
+	sdkCtx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger()).WithContext(ormCtx)
+
+	db, err := ormdb.NewModuleDB(&ecocredit.ModuleSchema, ormdb.ModuleDBOptions{})
+	require.NoError(t, err)
+	baseStore, err := baseapi.NewStateStore(db)
+	require.NoError(t, err)
+	marketStore, err := marketapi.NewStateStore(db)
+	require.NoError(t, err)
+
+	sellOrders := []*marketapi.SellOrder{
+		{Seller: sdk.AccAddress("seller"), BatchKey: 1, Quantity: "10", MarketId: 1, AskAmount: "100"},
+		{Seller: sdk.AccAddress("seller"), BatchKey: 1, Quantity: "10", MarketId: 2, AskAmount: "200"},
+		{Seller: sdk.AccAddress("seller"), BatchKey: 2, Quantity: "10", MarketId: 1, AskAmount: "300"},
+	}
+	for _, sellOrder := range sellOrders {
+		require.NoError(t, marketStore.SellOrderTable().Insert(sdkCtx, sellOrder))
+	}
+
+	// remove the market id and the market id and sortable ask amount index
+	// entries to set up the state before the indexes were added
+	for _, fields := range []string{"market_id", "market_id,sortable_ask_amount"} {
+		index, ok := db.GetTable(&marketapi.SellOrder{}).GetIndex(fields).(interface {
+			EncodeKVFromMessage(message protoreflect.Message) (k, v []byte, err error)
+		})
+		require.True(t, ok)
+		for _, sellOrder := range sellOrders {
+			k, _, err := index.EncodeKVFromMessage(sellOrder.ProtoReflect())
+			require.NoError(t, err)
+			require.NoError(t, backend.IndexStore().Delete(k))
+		}
+	}
+	require.Equal(t, []uint64(nil), listByMarket(t, sdkCtx, marketStore, 1))
+	require.Equal(t, []uint64(nil), listByAskAmount(t, sdkCtx, marketStore, 1))
+
+	projects := []*baseapi.Project{
+		{Id: "C01-001", ClassKey: 1},
+		{Id: "C01-002", ClassKey: 1, Status: baseapi.ProjectStatus_PROJECT_STATUS_VERIFIED},
+		{Id: "C01-003", ClassKey: 1, Status: baseapi.ProjectStatus_PROJECT_STATUS_COMPLETED},
+	}
+	for _, project := range projects {
+		require.NoError(t, baseStore.ProjectTable().Insert(sdkCtx, project))
+	}
+
+	// remove the status index entries to set up the state before the index
+	// was added
+	statusIndex, ok := db.GetTable(&baseapi.Project{}).GetIndex("status").(interface {
+		EncodeKVFromMessage(message protoreflect.Message) (k, v []byte, err error)
+	})
+	require.True(t, ok)
+	for _, project := range projects {
+		k, _, err := statusIndex.EncodeKVFromMessage(project.ProtoReflect())
+		require.NoError(t, err)
+		require.NoError(t, backend.IndexStore().Delete(k))
+	}
+	require.Equal(t, []string(nil), listByStatus(t, sdkCtx, db, baseapi.ProjectStatus_PROJECT_STATUS_VERIFIED))
+
+	require.NoError(t, v4.MigrateState(sdkCtx, baseStore, marketStore))
+
+	require.Equal(t, []string{"C01-001"}, listByStatus(t, sdkCtx, db, baseapi.ProjectStatus_PROJECT_STATUS_UNSPECIFIED))
+	require.Equal(t, []string{"C01-002"}, listByStatus(t, sdkCtx, db, baseapi.ProjectStatus_PROJECT_STATUS_VERIFIED))
+	require.Equal(t, []string{"C01-003"}, listByStatus(t, sdkCtx, db, baseapi.ProjectStatus_PROJECT_STATUS_COMPLETED))
+	require.Equal(t, []string(nil), listByStatus(t, sdkCtx, db, baseapi.ProjectStatus_PROJECT_STATUS_PROPOSED))
+
+	require.Equal(t, []uint64{1, 3}, listByMarket(t, sdkCtx, marketStore, 1))
+	require.Equal(t, []uint64{2}, listByMarket(t, sdkCtx, marketStore, 2))
+	require.Equal(t, []uint64(nil), listByMarket(t, sdkCtx, marketStore, 0))
+
+	require.Equal(t, []uint64{1, 3}, listByAskAmount(t, sdkCtx, marketStore, 1))
+	require.Equal(t, []uint64{2}, listByAskAmount(t, sdkCtx, marketStore, 2))
+	require.Equal(t, []uint64(nil), listByAskAmount(t, sdkCtx, marketStore, 0))
+
+	// the sell orders are unchanged
+	for _, expected := range sellOrders {
+		sellOrder, err := marketStore.SellOrderTable().Get(sdkCtx, expected.Id)
+		require.NoError(t, err)
+		require.Equal(t, expected.MarketId, sellOrder.MarketId)
+		require.Equal(t, expected.AskAmount, sellOrder.AskAmount)
+		require.Equal(t, expected.AskAmount, strings.TrimLeft(sellOrder.SortableAskAmount, "0"))
+	}
+
+	// the projects are unchanged
+	for _, expected := range projects {
+		project, err := baseStore.ProjectTable().GetById(sdkCtx, expected.Id)
+		require.NoError(t, err)
+		require.Equal(t, expected.Status, project.Status)
+	}
+}
+
+func listByMarket(t *testing.T, ctx sdk.Context, marketStore marketapi.StateStore, marketID uint64) []uint64 {
+	it, err := marketStore.SellOrderTable().List(ctx, marketapi.SellOrderMarketIdIndexKey{}.WithMarketId(marketID))
+	require.NoError(t, err)
+	defer it.Close()
+
+	var ids []uint64
+	for it.Next() {
+		sellOrder, err := it.Value()
+		require.NoError(t, err)
+		ids = append(ids, sellOrder.Id)
+	}
+	return ids
+}
+
+func listByAskAmount(t *testing.T, ctx sdk.Context, marketStore marketapi.StateStore, marketID uint64) []uint64 {
+	key := marketapi.SellOrderMarketIdSortableAskAmountIndexKey{}.WithMarketId(marketID)
+	it, err := marketStore.SellOrderTable().List(ctx, key)
+	require.NoError(t, err)
+	defer it.Close()
+
+	var ids []uint64
+	for it.Next() {
+		sellOrder, err := it.Value()
+		require.NoError(t, err)
+		ids = append(ids, sellOrder.Id)
+	}
+	return ids
+}
+
+// listByStatus lists the projects with the status index of the project table
+// because the generated index key cannot be used to list enum values.
+func listByStatus(t *testing.T, ctx sdk.Context, db ormdb.ModuleDB, status baseapi.ProjectStatus) []string {
+	ormIt, err := db.GetTable(&baseapi.Project{}).GetIndex("status").List(ctx, []interface{}{protoreflect.EnumNumber(status)})
+	require.NoError(t, err)
+	it := baseapi.ProjectIterator{Iterator: ormIt}
+	defer it.Close()
+
+	var ids []string
+	for it.Next() {
+		project, err := it.Value()
+		require.NoError(t, err)
+		ids = append(ids, project.Id)
+	}
+	return ids
+}
@@ -0,0 +1,46 @@
+package mocks
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// Hooks records the calls of the ecocredit hooks and returns Err if set.
+type Hooks struct {
+	Calls []string
+	Err   error
+}
+
+var _ ecocredit.EcocreditHooks = &Hooks{}
+
+func (h *Hooks) AfterCreditsIssued(_ sdk.Context, batchDenom string, recipient sdk.AccAddress, tradable, retired math.Dec) error {
+	h.Calls = append(h.Calls, fmt.Sprintf("issued %s %s %s %s", batchDenom, recipient, tradable, retired))
+	return h.Err
+}
+
+func (h *Hooks) AfterCreditsTransferred(_ sdk.Context, batchDenom string, sender, recipient sdk.AccAddress, tradable, retired math.Dec) error {
+	h.Calls = append(h.Calls, fmt.Sprintf("transferred %s %s %s %s %s", batchDenom, sender, recipient, tradable, retired))
+	return h.Err
+}
+
+func (h *Hooks) AfterCreditsRetired(_ sdk.Context, batchDenom string, owner sdk.AccAddress, amount math.Dec, retirementID uint64,
+	jurisdiction, reason, beneficiary string) error {
+	h.Calls = append(h.Calls, fmt.Sprintf(
+		"retired %s %s %s %d %s %q %q", batchDenom, owner, amount, retirementID, jurisdiction, reason, beneficiary,
+	))
+	return h.Err
+}
+
+func (h *Hooks) AfterCreditsCancelled(_ sdk.Context, batchDenom string, owner sdk.AccAddress, amount math.Dec) error {
+	h.Calls = append(h.Calls, fmt.Sprintf("cancelled %s %s %s", batchDenom, owner, amount))
+	return h.Err
+}
+
+func (h *Hooks) AfterBatchSealed(_ sdk.Context, batchDenom string) error {
+	h.Calls = append(h.Calls, fmt.Sprintf("sealed %s", batchDenom))
+	return h.Err
+}
@@ -10,13 +10,34 @@ import (
 	"github.com/regen-network/regen-ledger/x/ecocredit/server"
 )
 
-// BeginBlocker checks if there are any expired sell orders and removes them from state.
+// BeginBlocker loads the order book if the node has just started, checks if
+// there are any expired sell orders, buy orders, offers, swaps, or forward
+// contracts and removes them from state, and settles auctions that have ended.
 func BeginBlocker(ctx sdk.Context, k server.Keeper) error {
 	defer telemetry.ModuleMeasureSince(ecocredit.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
+	if err := k.ReloadOrderBook(ctx); err != nil {
+		return err
+	}
+
 	if err := k.PruneOrders(ctx); err != nil {
 		return err
 	}
 
+	if err := k.SettleAuctions(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// EndBlocker fills buy orders that have been matched with sell orders.
+func EndBlocker(ctx sdk.Context, k server.Keeper) error {
+	defer telemetry.ModuleMeasureSince(ecocredit.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	if err := k.ProcessOrders(ctx); err != nil {
+		return err
+	}
+
 	return nil
 }
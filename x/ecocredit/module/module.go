@@ -42,30 +42,45 @@ var (
 )
 
 const (
-	ConsensusVersion = 3 // ConsensusVersion is the module consensus version
+	ConsensusVersion = 4 // ConsensusVersion is the module consensus version
 )
 
 // Module implements the AppModule interface.
 type Module struct {
 	key           storetypes.StoreKey
+	memKey        storetypes.StoreKey
 	authority     sdk.AccAddress
 	Keeper        server.Keeper
 	accountKeeper ecocredit.AccountKeeper
 	bankKeeper    ecocredit.BankKeeper
 	govKeeper     ecocredit.GovKeeper
+	distrKeeper   ecocredit.DistributionKeeper
+
+	// transferKeeper and channelKeeper are used to validate and resolve the
+	// IBC denom traces of allowed denoms
+	transferKeeper ecocredit.TransferKeeper
+	channelKeeper  ecocredit.ChannelKeeper
 
 	// legacySubspace is used solely for migration of x/ecocredit managed parameters
 	legacySubspace paramtypes.Subspace
+
+	// hooks are called when credits are issued, moved or when a credit batch is
+	// sealed. If nil, no hooks are called.
+	hooks ecocredit.EcocreditHooks
 }
 
 // NewModule returns a new Module.
 func NewModule(
 	storeKey storetypes.StoreKey,
+	memStoreKey storetypes.StoreKey,
 	authority sdk.AccAddress,
 	accountKeeper ecocredit.AccountKeeper,
 	bankKeeper ecocredit.BankKeeper,
 	legacySubspace paramtypes.Subspace,
 	govKeeper ecocredit.GovKeeper,
+	distrKeeper ecocredit.DistributionKeeper,
+	transferKeeper ecocredit.TransferKeeper,
+	channelKeeper ecocredit.ChannelKeeper,
 ) *Module {
 
 	// legacySubspace is used solely for migration of x/ecocredit managed parameters
@@ -75,12 +90,50 @@ func NewModule(
 
 	return &Module{
 		key:            storeKey,
+		memKey:         memStoreKey,
 		legacySubspace: legacySubspace,
 		bankKeeper:     bankKeeper,
 		accountKeeper:  accountKeeper,
 		authority:      authority,
 		govKeeper:      govKeeper,
+		distrKeeper:    distrKeeper,
+		transferKeeper: transferKeeper,
+		channelKeeper:  channelKeeper,
+	}
+}
+
+// SetHooks sets the ecocredit hooks called when credits are issued, moved or
+// when a credit batch is sealed. SetHooks must be called before the services of
+// the module are registered and before the credit keeper is used.
+func (m *Module) SetHooks(hooks ecocredit.EcocreditHooks) *Module {
+	if m.hooks != nil {
+		panic("cannot set ecocredit hooks twice")
+	}
+	if m.Keeper != nil {
+		panic("cannot set ecocredit hooks after the keeper has been created")
+	}
+	m.hooks = hooks
+	return m
+}
+
+// CreditKeeper returns the keeper other modules use to hold, move and retire
+// credits. The hooks of the module must be set before CreditKeeper is called.
+func (m *Module) CreditKeeper() ecocredit.CreditKeeper {
+	return m.keeper().CreditKeeper()
+}
+
+// keeper returns the keeper of the module and creates the keeper if it has not
+// been created yet.
+func (m *Module) keeper() server.Keeper {
+	if m.Keeper == nil {
+		svr := server.NewServer(m.key, m.memKey, m.legacySubspace, m.accountKeeper, m.bankKeeper, m.distrKeeper,
+			m.transferKeeper, m.channelKeeper, m.authority)
+		if m.hooks != nil {
+			svr.SetHooks(m.hooks)
+		}
+		m.Keeper = svr
 	}
+	return m.Keeper
 }
 
 /* -------------------- AppModule -------------------- */
@@ -120,22 +173,26 @@ func (m Module) RegisterInterfaces(registry types.InterfaceRegistry) {
 
 // RegisterServices implements AppModule/RegisterServices.
 func (m *Module) RegisterServices(cfg module.Configurator) {
-	svr := server.NewServer(m.key, m.legacySubspace, m.accountKeeper, m.bankKeeper, m.authority)
-	basetypes.RegisterMsgServer(cfg.MsgServer(), svr.BaseKeeper)
-	basetypes.RegisterQueryServer(cfg.QueryServer(), svr.BaseKeeper)
+	svr := m.keeper()
+	baseMsgServer, basketMsgServer, marketMsgServer := svr.MsgServers()
+	baseQueryServer, basketQueryServer, marketQueryServer := svr.QueryServers()
+
+	basetypes.RegisterMsgServer(cfg.MsgServer(), baseMsgServer)
+	basetypes.RegisterQueryServer(cfg.QueryServer(), baseQueryServer)
 
-	baskettypes.RegisterMsgServer(cfg.MsgServer(), svr.BasketKeeper)
-	baskettypes.RegisterQueryServer(cfg.QueryServer(), svr.BasketKeeper)
+	baskettypes.RegisterMsgServer(cfg.MsgServer(), basketMsgServer)
+	baskettypes.RegisterQueryServer(cfg.QueryServer(), basketQueryServer)
 
-	markettypes.RegisterMsgServer(cfg.MsgServer(), svr.MarketplaceKeeper)
-	markettypes.RegisterQueryServer(cfg.QueryServer(), svr.MarketplaceKeeper)
+	markettypes.RegisterMsgServer(cfg.MsgServer(), marketMsgServer)
+	markettypes.RegisterQueryServer(cfg.QueryServer(), marketQueryServer)
 
 	migrator := server.NewMigrator(svr, m.legacySubspace)
 	if err := cfg.RegisterMigration(ecocredit.ModuleName, 2, migrator.Migrate2to3); err != nil {
 		panic(err)
 	}
-
-	m.Keeper = svr
+	if err := cfg.RegisterMigration(ecocredit.ModuleName, 3, migrator.Migrate3to4); err != nil {
+		panic(err)
+	}
 }
 
 // RegisterGRPCGatewayRoutes implements AppModule/RegisterGRPCGatewayRoutes.
@@ -263,6 +320,15 @@ func (m Module) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 	}
 }
 
+// EndBlock fills buy orders that have been matched with sell orders in the order book.
+func (m Module) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	err := EndBlocker(ctx, m.Keeper)
+	if err != nil {
+		panic(err)
+	}
+	return []abci.ValidatorUpdate{}
+}
+
 // LegacyQuerierHandler implements AppModule/LegacyQuerierHandler.
 func (m Module) LegacyQuerierHandler(_ *codec.LegacyAmino) sdk.Querier { return nil }
 
@@ -1,40 +1,65 @@
 package orderbook
 
 import (
+	"bytes"
 	"context"
+	"math"
+	"strings"
 
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdkmath "cosmossdk.io/math"
+
+	ormapi "github.com/cosmos/cosmos-sdk/api/cosmos/orm/v1alpha1"
 	"github.com/cosmos/cosmos-sdk/orm/model/ormdb"
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	marketplacev1 "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
 	orderbookv1alpha1 "github.com/regen-network/regen-ledger/api/regen/ecocredit/orderbook/v1alpha1"
 	ecocreditv1 "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 )
 
-// TODO: revisit when BuyOrder is reintroduced for marketplace order-book https://github.com/regen-network/regen-ledger/issues/505
+const (
+	// MemStoreKey is the key of the memory store used to hold the order book.
+	MemStoreKey = "mem_ecocredit"
+
+	// ORMPrefix is the prefix of the order book tables. It is distinct from the
+	// ecocredit prefix so that both databases can share a single backend.
+	ORMPrefix byte = 0x8
+)
+
+// ModuleSchema is the schema of the in-memory order book tables.
+var ModuleSchema = ormapi.ModuleSchemaDescriptor{
+	SchemaFile: []*ormapi.ModuleSchemaDescriptor_FileEntry{
+		{
+			Id:            1,
+			ProtoFileName: orderbookv1alpha1.File_regen_ecocredit_orderbook_v1alpha1_memory_proto.Path(),
+			StorageType:   ormapi.StorageType_STORAGE_TYPE_MEMORY,
+		},
+	},
+	Prefix: []byte{ORMPrefix},
+}
 
 type orderbook struct {
 	memStore         orderbookv1alpha1.MemoryStore
 	marketplaceStore marketplacev1.StateStore
 	ecocreditStore   ecocreditv1.StateStore
+
+	// loaded is set once the memory store has been reloaded from state.
+	loaded bool
 }
 
-// NewOrderBook creates a new OrderBook instance.
-func NewOrderBook(db ormdb.ModuleDB) (OrderBook, error) {
+// NewOrderBook creates a new OrderBook instance. The memory tables are created
+// from db whereas buy orders, sell orders and credit batches are read from the
+// provided marketplace and ecocredit state stores.
+func NewOrderBook(db ormdb.ModuleDB, marketplaceStore marketplacev1.StateStore,
+	ecocreditStore ecocreditv1.StateStore) (OrderBook, error) {
 	memStore, err := orderbookv1alpha1.NewMemoryStore(db)
 	if err != nil {
 		return nil, err
 	}
 
-	marketplaceStore, err := marketplacev1.NewStateStore(db)
-	if err != nil {
-		return nil, err
-	}
-
-	ecocreditStore, err := ecocreditv1.NewStateStore(db)
-	if err != nil {
-		return nil, err
-	}
-
 	return &orderbook{
 		memStore:         memStore,
 		marketplaceStore: marketplaceStore,
@@ -42,34 +67,504 @@ func NewOrderBook(db ormdb.ModuleDB) (OrderBook, error) {
 	}, nil
 }
 
+// FillFunc fills a matched buy order and sell order. It is responsible for
+// removing the orders from the order book if either order is completely filled.
+type FillFunc func(ctx context.Context, buyOrder *marketplacev1.BuyOrder, sellOrder *marketplacev1.SellOrder) error
+
 type OrderBook interface {
 	// OnInsertBuyOrder gets called whenever a buy order is inserted into the marketplace state.
-	// OnInsertBuyOrder(ctx context.Context, buyOrder *marketplacev1.BuyOrder) error
+	// It returns true if the buy order was matched with at least one sell order. Sell orders of
+	// suspended credit batches are not matched.
+	OnInsertBuyOrder(ctx context.Context, buyOrder *marketplacev1.BuyOrder) (bool, error)
+
+	// OnInsertSellOrder gets called whenever a sell order is inserted into the marketplace state
+	// or the credit batch of the sell order is unsuspended. It returns true if the sell order was
+	// matched with at least one buy order. Sell orders of suspended credit batches are not matched.
+	OnInsertSellOrder(ctx context.Context, sellOrder *marketplacev1.SellOrder, batch *ecocreditv1.Batch) (bool, error)
+
+	// OnRemoveBuyOrder gets called whenever a buy order is removed from the marketplace state.
+	OnRemoveBuyOrder(ctx context.Context, buyOrderID uint64) error
 
-	// OnInsertSellOrder gets called whenever a sell order is inserted into the marketplace state.
-	OnInsertSellOrder(ctx context.Context, sellOrder *marketplacev1.SellOrder, batch *ecocreditv1.Batch) error
+	// OnRemoveSellOrder gets called whenever a sell order is removed from the marketplace state
+	// or the credit batch of the sell order is suspended.
+	OnRemoveSellOrder(ctx context.Context, sellOrderID uint64) error
 
 	// ProcessBatch called in end blocker, can happen every block or at some epoch.
-	ProcessBatch(ctx context.Context) error
+	// Matches are processed by price/time priority, i.e. the highest bids are
+	// filled first with the lowest asks. At most limit matches are processed and
+	// the remaining matches are processed in the next call.
+	ProcessBatch(ctx context.Context, limit int, fill FillFunc) error
 
-	// Reload gets call on end blocker only when a node starts up.
+	// Reload gets called in begin blocker and rebuilds the memory store from
+	// state only the first time it is called after a node starts up.
 	Reload(ctx context.Context) error
 }
 
-/*
-func (o orderbook) OnInsertBuyOrder(ctx context.Context, buyOrder *marketplacev1.BuyOrder) error {
-	return nil
+func (o *orderbook) OnInsertBuyOrder(ctx context.Context, buyOrder *marketplacev1.BuyOrder) (bool, error) {
+	batchKeys, err := o.insertSelector(ctx, buyOrder)
+	if err != nil {
+		return false, err
+	}
+
+	// sell orders are collected before any matches are written so that we
+	// never write to a store while iterating over it
+	var sellOrders []*marketplacev1.SellOrder
+	for _, batchKey := range batchKeys {
+		// sell orders of suspended credit batches are not matched until the
+		// credit batch is unsuspended
+		batch, err := o.ecocreditStore.BatchTable().Get(ctx, batchKey)
+		if err != nil {
+			return false, err
+		}
+		if batch.Suspended {
+			continue
+		}
+
+		it, err := o.marketplaceStore.SellOrderTable().List(ctx, marketplacev1.SellOrderBatchKeyIndexKey{}.WithBatchKey(batchKey))
+		if err != nil {
+			return false, err
+		}
+
+		for it.Next() {
+			sellOrder, err := it.Value()
+			if err != nil {
+				it.Close()
+				return false, err
+			}
+			sellOrders = append(sellOrders, sellOrder)
+		}
+		it.Close()
+	}
+
+	matched := false
+	for _, sellOrder := range sellOrders {
+		ok, err := o.match(ctx, buyOrder, sellOrder)
+		if err != nil {
+			return false, err
+		}
+		matched = matched || ok
+	}
+
+	return matched, nil
 }
-*/
 
-func (o orderbook) OnInsertSellOrder(ctx context.Context, sellOrder *marketplacev1.SellOrder, batch *ecocreditv1.Batch) error {
-	return nil
+// insertSelector saves the selector of the buy order and returns the keys of
+// the credit batches currently selected by the buy order.
+func (o *orderbook) insertSelector(ctx context.Context, buyOrder *marketplacev1.BuyOrder) ([]uint64, error) {
+	switch {
+	case buyOrder.ClassKey != 0:
+		err := o.memStore.BuyOrderClassSelectorTable().Save(ctx, &orderbookv1alpha1.BuyOrderClassSelector{
+			BuyOrderId:      buyOrder.Id,
+			ClassId:         buyOrder.ClassKey,
+			ProjectLocation: buyOrder.ProjectLocation,
+			MinStartDate:    buyOrder.MinStartDate,
+			MaxEndDate:      buyOrder.MaxEndDate,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		it, err := o.ecocreditStore.ProjectTable().List(ctx, ecocreditv1.ProjectClassKeyIdIndexKey{}.WithClassKey(buyOrder.ClassKey))
+		if err != nil {
+			return nil, err
+		}
+
+		var projectKeys []uint64
+		for it.Next() {
+			project, err := it.Value()
+			if err != nil {
+				it.Close()
+				return nil, err
+			}
+			if matchesLocation(buyOrder.ProjectLocation, project.Jurisdiction) {
+				projectKeys = append(projectKeys, project.Key)
+			}
+		}
+		it.Close()
+
+		var batchKeys []uint64
+		for _, projectKey := range projectKeys {
+			keys, err := o.projectBatchKeys(ctx, projectKey, buyOrder.MinStartDate, buyOrder.MaxEndDate)
+			if err != nil {
+				return nil, err
+			}
+			batchKeys = append(batchKeys, keys...)
+		}
+
+		return batchKeys, nil
+	case buyOrder.ProjectKey != 0:
+		err := o.memStore.BuyOrderProjectSelectorTable().Save(ctx, &orderbookv1alpha1.BuyOrderProjectSelector{
+			BuyOrderId:   buyOrder.Id,
+			ProjectId:    buyOrder.ProjectKey,
+			MinStartDate: buyOrder.MinStartDate,
+			MaxEndDate:   buyOrder.MaxEndDate,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return o.projectBatchKeys(ctx, buyOrder.ProjectKey, buyOrder.MinStartDate, buyOrder.MaxEndDate)
+	default:
+		err := o.memStore.BuyOrderBatchSelectorTable().Save(ctx, &orderbookv1alpha1.BuyOrderBatchSelector{
+			BuyOrderId: buyOrder.Id,
+			BatchId:    buyOrder.BatchKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return []uint64{buyOrder.BatchKey}, nil
+	}
+}
+
+// projectBatchKeys returns the keys of the credit batches of the project that
+// are within the date range.
+func (o *orderbook) projectBatchKeys(ctx context.Context, projectKey uint64, minStartDate, maxEndDate *timestamppb.Timestamp) ([]uint64, error) {
+	it, err := o.ecocreditStore.BatchTable().List(ctx, ecocreditv1.BatchProjectKeyIndexKey{}.WithProjectKey(projectKey))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var batchKeys []uint64
+	for it.Next() {
+		batch, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		if matchesDateRange(batch, minStartDate, maxEndDate) {
+			batchKeys = append(batchKeys, batch.Key)
+		}
+	}
+
+	return batchKeys, nil
+}
+
+func (o *orderbook) OnInsertSellOrder(ctx context.Context, sellOrder *marketplacev1.SellOrder, batch *ecocreditv1.Batch) (bool, error) {
+	if batch.Suspended {
+		return false, nil
+	}
+
+	buyOrderIDs, err := o.selectingBuyOrderIDs(ctx, batch)
+	if err != nil {
+		return false, err
+	}
+
+	matched := false
+	for _, id := range buyOrderIDs {
+		buyOrder, err := o.marketplaceStore.BuyOrderTable().Get(ctx, id)
+		if err != nil {
+			return false, err
+		}
+
+		ok, err := o.match(ctx, buyOrder, sellOrder)
+		if err != nil {
+			return false, err
+		}
+		matched = matched || ok
+	}
+
+	return matched, nil
+}
+
+// selectingBuyOrderIDs returns the ids of the buy orders with a batch, project,
+// or class selector that selects the credit batch.
+func (o *orderbook) selectingBuyOrderIDs(ctx context.Context, batch *ecocreditv1.Batch) ([]uint64, error) {
+	var buyOrderIDs []uint64
+
+	batchIt, err := o.memStore.BuyOrderBatchSelectorTable().List(ctx, orderbookv1alpha1.BuyOrderBatchSelectorBatchIdIndexKey{}.WithBatchId(batch.Key))
+	if err != nil {
+		return nil, err
+	}
+	for batchIt.Next() {
+		selector, err := batchIt.Value()
+		if err != nil {
+			batchIt.Close()
+			return nil, err
+		}
+		buyOrderIDs = append(buyOrderIDs, selector.BuyOrderId)
+	}
+	batchIt.Close()
+
+	projectIt, err := o.memStore.BuyOrderProjectSelectorTable().List(ctx, orderbookv1alpha1.BuyOrderProjectSelectorProjectIdIndexKey{}.WithProjectId(batch.ProjectKey))
+	if err != nil {
+		return nil, err
+	}
+	for projectIt.Next() {
+		selector, err := projectIt.Value()
+		if err != nil {
+			projectIt.Close()
+			return nil, err
+		}
+		if matchesDateRange(batch, selector.MinStartDate, selector.MaxEndDate) {
+			buyOrderIDs = append(buyOrderIDs, selector.BuyOrderId)
+		}
+	}
+	projectIt.Close()
+
+	project, err := o.ecocreditStore.ProjectTable().Get(ctx, batch.ProjectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	classIt, err := o.memStore.BuyOrderClassSelectorTable().List(ctx, orderbookv1alpha1.BuyOrderClassSelectorClassIdIndexKey{}.WithClassId(project.ClassKey))
+	if err != nil {
+		return nil, err
+	}
+	defer classIt.Close()
+
+	for classIt.Next() {
+		selector, err := classIt.Value()
+		if err != nil {
+			return nil, err
+		}
+		if matchesLocation(selector.ProjectLocation, project.Jurisdiction) &&
+			matchesDateRange(batch, selector.MinStartDate, selector.MaxEndDate) {
+			buyOrderIDs = append(buyOrderIDs, selector.BuyOrderId)
+		}
+	}
+
+	return buyOrderIDs, nil
+}
+
+func (o *orderbook) OnRemoveBuyOrder(ctx context.Context, buyOrderID uint64) error {
+	err := o.memStore.BuyOrderSellOrderMatchTable().DeleteBy(ctx,
+		orderbookv1alpha1.BuyOrderSellOrderMatchBuyOrderIdSellOrderIdIndexKey{}.WithBuyOrderId(buyOrderID),
+	)
+	if err != nil {
+		return err
+	}
+
+	err = o.memStore.BuyOrderClassSelectorTable().DeleteBy(ctx,
+		orderbookv1alpha1.BuyOrderClassSelectorBuyOrderIdClassIdIndexKey{}.WithBuyOrderId(buyOrderID),
+	)
+	if err != nil {
+		return err
+	}
+
+	err = o.memStore.BuyOrderProjectSelectorTable().DeleteBy(ctx,
+		orderbookv1alpha1.BuyOrderProjectSelectorBuyOrderIdProjectIdIndexKey{}.WithBuyOrderId(buyOrderID),
+	)
+	if err != nil {
+		return err
+	}
+
+	return o.memStore.BuyOrderBatchSelectorTable().DeleteBy(ctx,
+		orderbookv1alpha1.BuyOrderBatchSelectorBuyOrderIdBatchIdIndexKey{}.WithBuyOrderId(buyOrderID),
+	)
 }
 
-func (o orderbook) ProcessBatch(ctx context.Context) error {
+func (o *orderbook) OnRemoveSellOrder(ctx context.Context, sellOrderID uint64) error {
+	return o.memStore.BuyOrderSellOrderMatchTable().DeleteBy(ctx,
+		orderbookv1alpha1.BuyOrderSellOrderMatchSellOrderIdIndexKey{}.WithSellOrderId(sellOrderID),
+	)
+}
+
+func (o *orderbook) ProcessBatch(ctx context.Context, limit int, fill FillFunc) error {
+	it, err := o.memStore.BuyOrderSellOrderMatchTable().List(ctx,
+		orderbookv1alpha1.BuyOrderSellOrderMatchMarketIdBidPriceComplementBuyOrderIdAskPriceSellOrderIdIndexKey{},
+	)
+	if err != nil {
+		return err
+	}
+
+	// matches are collected first because filling orders removes matches
+	var matches []*orderbookv1alpha1.BuyOrderSellOrderMatch
+	for len(matches) < limit && it.Next() {
+		match, err := it.Value()
+		if err != nil {
+			it.Close()
+			return err
+		}
+		matches = append(matches, match)
+	}
+	it.Close()
+
+	for _, match := range matches {
+		// either order may have already been completely filled by a previous match
+		buyOrder, err := o.marketplaceStore.BuyOrderTable().Get(ctx, match.BuyOrderId)
+		if err != nil {
+			if ormerrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+
+		sellOrder, err := o.marketplaceStore.SellOrderTable().Get(ctx, match.SellOrderId)
+		if err != nil {
+			if ormerrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+
+		if err = fill(ctx, buyOrder, sellOrder); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-func (o orderbook) Reload(ctx context.Context) error {
+func (o *orderbook) Reload(ctx context.Context) error {
+	if o.loaded {
+		return nil
+	}
+
+	it, err := o.marketplaceStore.BuyOrderTable().List(ctx, marketplacev1.BuyOrderPrimaryKey{})
+	if err != nil {
+		return err
+	}
+
+	var buyOrders []*marketplacev1.BuyOrder
+	for it.Next() {
+		buyOrder, err := it.Value()
+		if err != nil {
+			it.Close()
+			return err
+		}
+		buyOrders = append(buyOrders, buyOrder)
+	}
+	it.Close()
+
+	for _, buyOrder := range buyOrders {
+		if _, err := o.OnInsertBuyOrder(ctx, buyOrder); err != nil {
+			return err
+		}
+	}
+
+	o.loaded = true
+
 	return nil
 }
+
+// match inserts a BuyOrderSellOrderMatch if the buy order and the sell order
+// can be matched and returns whether a match was inserted.
+func (o *orderbook) match(ctx context.Context, buyOrder *marketplacev1.BuyOrder, sellOrder *marketplacev1.SellOrder) (bool, error) {
+	if bytes.Equal(buyOrder.Buyer, sellOrder.Seller) {
+		return false, nil
+	}
+
+	// private sell orders can only be matched with buy orders of allowed buyers
+	if !isAllowedBuyer(sellOrder, buyOrder.Buyer) {
+		return false, nil
+	}
+
+	// buyers can only disable auto-retire if the seller allows it
+	if buyOrder.DisableAutoRetire && !sellOrder.DisableAutoRetire {
+		return false, nil
+	}
+
+	bidAmount, ok := sdkmath.NewIntFromString(buyOrder.BidAmount)
+	if !ok {
+		return false, sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", buyOrder.BidAmount, sdkmath.Int{})
+	}
+	// the sell order is matched at its ask price in the market of the buy order
+	askAmountStr, found, err := o.askAmount(ctx, sellOrder, buyOrder.MarketId)
+	if err != nil {
+		return false, err
+	}
+	if !found {
+		return false, nil
+	}
+	askAmount, ok := sdkmath.NewIntFromString(askAmountStr)
+	if !ok {
+		return false, sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", askAmountStr, sdkmath.Int{})
+	}
+	if askAmount.GT(bidAmount) {
+		return false, nil
+	}
+
+	market, err := o.marketplaceStore.MarketTable().Get(ctx, buyOrder.MarketId)
+	if err != nil {
+		return false, err
+	}
+
+	err = o.memStore.BuyOrderSellOrderMatchTable().Save(ctx, &orderbookv1alpha1.BuyOrderSellOrderMatch{
+		MarketId:           market.Id,
+		BuyOrderId:         buyOrder.Id,
+		SellOrderId:        sellOrder.Id,
+		BidPriceComplement: ^encodePrice(bidAmount, market.PrecisionModifier),
+		AskPrice:           encodePrice(askAmount, market.PrecisionModifier),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// askAmount returns the ask amount of the sell order in the market, which is
+// either the ask amount of the sell order or one of its additional ask prices,
+// and whether the sell order has an ask price in the market.
+func (o *orderbook) askAmount(ctx context.Context, sellOrder *marketplacev1.SellOrder, marketID uint64) (string, bool, error) {
+	if sellOrder.MarketId == marketID {
+		return sellOrder.AskAmount, true, nil
+	}
+
+	ask, err := o.marketplaceStore.SellOrderAskTable().Get(ctx, sellOrder.Id, marketID)
+	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+
+	return ask.AskAmount, true, nil
+}
+
+// isAllowedBuyer returns true if the sell order has no allowed buyers or if the
+// buyer is one of the allowed buyers of the sell order.
+func isAllowedBuyer(sellOrder *marketplacev1.SellOrder, buyer []byte) bool {
+	if len(sellOrder.AllowedBuyers) == 0 {
+		return true
+	}
+
+	for _, allowed := range sellOrder.AllowedBuyers {
+		if bytes.Equal(allowed, buyer) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesLocation returns true if the jurisdiction is within the location. An
+// empty location matches any jurisdiction, a country code matches all regions
+// of the country, and a region matches all postal codes of the region.
+func matchesLocation(location, jurisdiction string) bool {
+	if location == "" || location == jurisdiction {
+		return true
+	}
+	return strings.HasPrefix(jurisdiction, location+"-") || strings.HasPrefix(jurisdiction, location+" ")
+}
+
+// matchesDateRange returns true if the credit batch starts on or after the min
+// start date and ends on or before the max end date. Unset dates are ignored.
+func matchesDateRange(batch *ecocreditv1.Batch, minStartDate, maxEndDate *timestamppb.Timestamp) bool {
+	if minStartDate != nil && batch.StartDate.AsTime().Before(minStartDate.AsTime()) {
+		return false
+	}
+	if maxEndDate != nil && batch.EndDate.AsTime().After(maxEndDate.AsTime()) {
+		return false
+	}
+	return true
+}
+
+// encodePrice converts an integer amount to the uint32 value used for sorting
+// in the order book, dividing it by 10^precisionModifier using round half away
+// from zero rounding. Amounts that exceed the range of uint32 are capped at
+// math.MaxUint32 which only affects the order in which matches are processed
+// and not the settlement price.
+func encodePrice(amount sdkmath.Int, precisionModifier uint32) uint32 {
+	if precisionModifier > 0 {
+		divisor := sdkmath.NewIntWithDecimal(1, int(precisionModifier))
+		amount = amount.Add(divisor.QuoRaw(2)).Quo(divisor)
+	}
+
+	if !amount.IsUint64() || amount.Uint64() > math.MaxUint32 {
+		return math.MaxUint32
+	}
+
+	return uint32(amount.Uint64())
+}
@@ -0,0 +1,101 @@
+package orderbook
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdkmath "cosmossdk.io/math"
+
+	ecocreditv1 "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+)
+
+func TestEncodePrice(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name              string
+		amount            sdkmath.Int
+		precisionModifier uint32
+		expected          uint32
+	}{
+		{"zero", sdkmath.NewInt(0), 0, 0},
+		{"no precision modifier", sdkmath.NewInt(123456), 0, 123456},
+		{"round down", sdkmath.NewInt(123449), 2, 1234},
+		{"round half away from zero", sdkmath.NewInt(123450), 2, 1235},
+		{"max uint32", sdkmath.NewInt(math.MaxUint32), 0, math.MaxUint32},
+		{"exceeds max uint32", sdkmath.NewInt(math.MaxUint32 + 1), 0, math.MaxUint32},
+		{"exceeds max uint64", sdkmath.NewIntWithDecimal(1, 30), 3, math.MaxUint32},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.expected, encodePrice(tc.amount, tc.precisionModifier))
+		})
+	}
+}
+
+func TestMatchesLocation(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		location     string
+		jurisdiction string
+		expected     bool
+	}{
+		{"empty location", "", "US-OR", true},
+		{"same jurisdiction", "US-OR", "US-OR", true},
+		{"country matches region", "US", "US-OR", true},
+		{"country matches postal code", "US", "US-OR 97201", true},
+		{"region matches postal code", "US-OR", "US-OR 97201", true},
+		{"different country", "US", "CD-MN", false},
+		{"different region", "US-OR", "US-WA", false},
+		{"region prefix of another region", "US-O", "US-OR", false},
+		{"region does not match country", "US-OR", "US", false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.expected, matchesLocation(tc.location, tc.jurisdiction))
+		})
+	}
+}
+
+func TestMatchesDateRange(t *testing.T) {
+	t.Parallel()
+
+	date := func(year int) *timestamppb.Timestamp {
+		return timestamppb.New(time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC))
+	}
+	batch := &ecocreditv1.Batch{StartDate: date(2020), EndDate: date(2021)}
+
+	testCases := []struct {
+		name         string
+		minStartDate *timestamppb.Timestamp
+		maxEndDate   *timestamppb.Timestamp
+		expected     bool
+	}{
+		{"no date range", nil, nil, true},
+		{"start date equal to min start date", date(2020), nil, true},
+		{"start date before min start date", date(2021), nil, false},
+		{"end date equal to max end date", nil, date(2021), true},
+		{"end date after max end date", nil, date(2020), false},
+		{"within date range", date(2019), date(2022), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.expected, matchesDateRange(batch, tc.minStartDate, tc.maxEndDate))
+		})
+	}
+}
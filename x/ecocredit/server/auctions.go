@@ -0,0 +1,10 @@
+package server
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SettleAuctions settles auctions that have ended and removes them from state.
+func (s serverImpl) SettleAuctions(ctx sdk.Context) error {
+	return s.MarketplaceKeeper.SettleAuctions(sdk.WrapSDKContext(ctx))
+}
@@ -11,6 +11,7 @@ import (
 	basketapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
 	marketapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
 	basetypes "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
 	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket/types/v1"
 	markettypes "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
@@ -21,9 +22,14 @@ var _ Keeper = serverImpl{}
 // Keeper defines a set of methods the ecocredit module exposes.
 type Keeper interface {
 	PruneOrders(ctx sdk.Context) error
+	SettleAuctions(ctx sdk.Context) error
+	ReloadOrderBook(ctx sdk.Context) error
+	ProcessOrders(ctx sdk.Context) error
 	RegisterInvariants(sdk.InvariantRegistry)
 	InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) ([]types.ValidatorUpdate, error)
 	ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) (json.RawMessage, error)
+	MsgServers() (basetypes.MsgServer, baskettypes.MsgServer, markettypes.MsgServer)
 	QueryServers() (basetypes.QueryServer, baskettypes.QueryServer, markettypes.QueryServer)
+	CreditKeeper() ecocredit.CreditKeeper
 	GetStateStores() (api.StateStore, basketapi.StateStore, marketapi.StateStore)
 }
@@ -4,7 +4,21 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// PruneOrders checks if there are any expired sell or buy orders and removes them from state.
+// PruneOrders checks if there are any expired sell orders, buy orders, offers, swaps, or forward contracts
+// and removes them from state.
 func (s serverImpl) PruneOrders(ctx sdk.Context) error {
-	return s.MarketplaceKeeper.PruneSellOrders(sdk.WrapSDKContext(ctx))
+	goCtx := sdk.WrapSDKContext(ctx)
+	if err := s.MarketplaceKeeper.PruneSellOrders(goCtx); err != nil {
+		return err
+	}
+	if err := s.MarketplaceKeeper.PruneBuyOrders(goCtx); err != nil {
+		return err
+	}
+	if err := s.MarketplaceKeeper.PruneOffers(goCtx); err != nil {
+		return err
+	}
+	if err := s.MarketplaceKeeper.PruneSwaps(goCtx); err != nil {
+		return err
+	}
+	return s.MarketplaceKeeper.PruneForwardContracts(goCtx)
 }
@@ -10,7 +10,9 @@ import (
 // RegisterInvariants registers the ecocredit module invariants.
 func (s serverImpl) RegisterInvariants(ir sdk.InvariantRegistry) {
 	ir.RegisterRoute(ecocredit.ModuleName, "batch-supply", s.batchSupplyInvariant())
+	ir.RegisterRoute(ecocredit.ModuleName, "retired-supply", s.retiredSupplyInvariant())
 	s.BasketKeeper.RegisterInvariants(ir)
+	s.MarketplaceKeeper.RegisterInvariants(ir)
 }
 
 func (s serverImpl) batchSupplyInvariant() sdk.Invariant {
@@ -25,3 +27,10 @@ func (s serverImpl) batchSupplyInvariant() sdk.Invariant {
 		return sdk.FormatInvariant(ecocredit.ModuleName, "batch-supply", msg), broken
 	}
 }
+
+func (s serverImpl) retiredSupplyInvariant() sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg, broken := basekeeper.RetiredSupplyInvariant(sdk.WrapSDKContext(ctx), s.BaseKeeper)
+		return sdk.FormatInvariant(ecocredit.ModuleName, "retired-supply", msg), broken
+	}
+}
@@ -73,7 +73,7 @@ func setupBase(t *testing.T) *baseSuite {
 	marketStore, err := marketapi.NewStateStore(s.db)
 	assert.NilError(t, err)
 
-	s.k = basekeeper.NewKeeper(s.stateStore, s.bankKeeper, moduleAddress, basketStore, marketStore, authorityAddress)
+	s.k = basekeeper.NewKeeper(s.db, s.stateStore, mocks.NewMockAccountKeeper(s.ctrl), s.bankKeeper, moduleAddress, basketStore, marketStore, authorityAddress)
 
 	return s
 }
@@ -316,3 +316,98 @@ func initSupply(ctx context.Context, t *testing.T, ss baseapi.StateStore, supply
 		require.NoError(t, err)
 	}
 }
+
+func TestRetiredSupplyInvariant(t *testing.T) {
+	acc1 := sdk.AccAddress([]byte("account1"))
+	acc2 := sdk.AccAddress([]byte("account2"))
+
+	testCases := []struct {
+		msg         string
+		balances    []*basetypes.BatchBalance
+		retirements []*baseapi.Retirement
+		expBroken   bool
+	}{
+		{
+			"valid test case",
+			[]*basetypes.BatchBalance{
+				{
+					Address:       acc1,
+					BatchKey:      1,
+					RetiredAmount: "110",
+				},
+				{
+					Address:       acc2,
+					BatchKey:      1,
+					RetiredAmount: "10.5",
+				},
+			},
+			[]*baseapi.Retirement{
+				{Owner: acc1, BatchKey: 1, Amount: "100"},
+				{Owner: acc1, BatchKey: 1, Amount: "10"},
+				{Owner: acc2, BatchKey: 1, Amount: "10.5"},
+			},
+			false,
+		},
+		{
+			"valid retired balance without retirement",
+			[]*basetypes.BatchBalance{
+				{
+					Address:       acc1,
+					BatchKey:      1,
+					RetiredAmount: "110",
+				},
+			},
+			[]*baseapi.Retirement{
+				{Owner: acc1, BatchKey: 1, Amount: "10"},
+			},
+			false,
+		},
+		{
+			"fail with error retirements exceed retired balance",
+			[]*basetypes.BatchBalance{
+				{
+					Address:       acc1,
+					BatchKey:      1,
+					RetiredAmount: "10",
+				},
+			},
+			[]*baseapi.Retirement{
+				{Owner: acc1, BatchKey: 1, Amount: "5"},
+				{Owner: acc1, BatchKey: 1, Amount: "5.1"},
+			},
+			true,
+		},
+		{
+			"fail with error retired balance not found",
+			[]*basetypes.BatchBalance{
+				{
+					Address:       acc1,
+					BatchKey:      1,
+					RetiredAmount: "10",
+				},
+			},
+			[]*baseapi.Retirement{
+				{Owner: acc1, BatchKey: 2, Amount: "1"},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite := setupBase(t)
+		t.Run(tc.msg, func(t *testing.T) {
+			initBalances(suite.ctx, t, suite.stateStore, tc.balances)
+			for _, retirement := range tc.retirements {
+				require.NoError(t, suite.stateStore.RetirementTable().Insert(suite.ctx, retirement))
+			}
+
+			msg, broken := basekeeper.RetiredSupplyInvariant(suite.ctx, suite.k)
+			if tc.expBroken {
+				require.True(t, broken, msg)
+			} else {
+				require.False(t, broken, msg)
+			}
+		})
+	}
+}
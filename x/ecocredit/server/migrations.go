@@ -6,6 +6,7 @@ import (
 
 	ecocreditv1 "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	v3 "github.com/regen-network/regen-ledger/x/ecocredit/migrations/v3"
+	v4 "github.com/regen-network/regen-ledger/x/ecocredit/migrations/v4"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -38,3 +39,9 @@ func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 
 	return nil
 }
+
+// Migrate3to4 migrates from version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	baseStore, _, marketStore := m.keeper.GetStateStores()
+	return v4.MigrateState(ctx, baseStore, marketStore)
+}
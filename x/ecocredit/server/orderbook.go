@@ -0,0 +1,17 @@
+package server
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ReloadOrderBook rebuilds the in-memory order book from state if it has not
+// been loaded since the node started.
+func (s serverImpl) ReloadOrderBook(ctx sdk.Context) error {
+	return s.MarketplaceKeeper.ReloadOrderBook(sdk.WrapSDKContext(ctx))
+}
+
+// ProcessOrders fills buy orders that have been matched with sell orders in the
+// order book.
+func (s serverImpl) ProcessOrders(ctx sdk.Context) error {
+	return s.MarketplaceKeeper.ProcessBuyOrders(sdk.WrapSDKContext(ctx))
+}
@@ -20,8 +20,11 @@ import (
 	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket/types/v1"
 	marketkeeper "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/keeper"
 	markettypes "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/orderbook"
 )
 
+var _ basekeeper.MarketplaceHooks = marketkeeper.Keeper{}
+
 type serverImpl struct {
 	legacySubspace paramtypes.Subspace
 	bankKeeper     ecocredit.BankKeeper
@@ -38,8 +41,9 @@ type serverImpl struct {
 }
 
 //nolint:revive
-func NewServer(storeKey storetypes.StoreKey, legacySubspace paramtypes.Subspace,
-	accountKeeper ecocredit.AccountKeeper, bankKeeper ecocredit.BankKeeper, authority sdk.AccAddress) serverImpl {
+func NewServer(storeKey, memStoreKey storetypes.StoreKey, legacySubspace paramtypes.Subspace,
+	accountKeeper ecocredit.AccountKeeper, bankKeeper ecocredit.BankKeeper, distrKeeper ecocredit.DistributionKeeper,
+	transferKeeper ecocredit.TransferKeeper, channelKeeper ecocredit.ChannelKeeper, authority sdk.AccAddress) serverImpl {
 	s := serverImpl{
 		legacySubspace: legacySubspace,
 		bankKeeper:     bankKeeper,
@@ -68,13 +72,35 @@ func NewServer(storeKey storetypes.StoreKey, legacySubspace paramtypes.Subspace,
 	s.stateStore = baseStore
 	s.basketStore = basketStore
 	s.marketplaceStore = marketStore
-	s.BaseKeeper = basekeeper.NewKeeper(baseStore, bankKeeper, baseAddr, basketStore, marketStore, authority)
+	s.BaseKeeper = basekeeper.NewKeeper(s.db, baseStore, accountKeeper, bankKeeper, baseAddr, basketStore, marketStore, authority)
 	s.BasketKeeper = basketkeeper.NewKeeper(basketStore, baseStore, bankKeeper, s.legacySubspace, basketAddr, authority)
-	s.MarketplaceKeeper = marketkeeper.NewKeeper(marketStore, baseStore, bankKeeper, s.legacySubspace, authority)
+
+	memDB, err := ormstore.NewStoreKeyDB(&orderbook.ModuleSchema, memStoreKey, ormdb.ModuleDBOptions{})
+	if err != nil {
+		panic(err)
+	}
+	orderBook, err := orderbook.NewOrderBook(memDB, marketStore, baseStore)
+	if err != nil {
+		panic(err)
+	}
+	s.MarketplaceKeeper = marketkeeper.NewKeeper(marketStore, baseStore, orderBook, accountKeeper, bankKeeper, distrKeeper,
+		transferKeeper, channelKeeper, s.legacySubspace, authority)
+
+	// the marketplace updates the order book when the state of a credit batch
+	// changes. The marketplace hooks do not move credits and therefore do not
+	// depend on the ecocredit hooks set on the marketplace keeper.
+	s.BaseKeeper.SetMarketplaceHooks(s.MarketplaceKeeper)
 
 	return s
 }
 
+// SetHooks sets the ecocredit hooks of the base, basket and marketplace keepers.
+func (s *serverImpl) SetHooks(hooks ecocredit.EcocreditHooks) {
+	s.BaseKeeper.SetHooks(hooks)
+	s.BasketKeeper.SetHooks(hooks)
+	s.MarketplaceKeeper.SetHooks(hooks)
+}
+
 func getStateStores(db ormdb.ModuleDB) (baseapi.StateStore, basketapi.StateStore, marketapi.StateStore) {
 	baseStore, err := baseapi.NewStateStore(db)
 	if err != nil {
@@ -91,10 +117,18 @@ func getStateStores(db ormdb.ModuleDB) (baseapi.StateStore, basketapi.StateStore
 	return baseStore, basketStore, marketStore
 }
 
+func (s serverImpl) MsgServers() (basetypes.MsgServer, baskettypes.MsgServer, markettypes.MsgServer) {
+	return s.BaseKeeper, s.BasketKeeper, s.MarketplaceKeeper
+}
+
 func (s serverImpl) QueryServers() (basetypes.QueryServer, baskettypes.QueryServer, markettypes.QueryServer) {
 	return s.BaseKeeper, s.BasketKeeper, s.MarketplaceKeeper
 }
 
+func (s serverImpl) CreditKeeper() ecocredit.CreditKeeper {
+	return s.BaseKeeper
+}
+
 func (s serverImpl) GetStateStores() (baseapi.StateStore, basketapi.StateStore, marketapi.StateStore) {
 	return s.stateStore, s.basketStore, s.marketplaceStore
 }
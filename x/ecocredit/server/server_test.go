@@ -23,6 +23,7 @@ import (
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 	"github.com/regen-network/regen-ledger/x/ecocredit/basket"
 	"github.com/regen-network/regen-ledger/x/ecocredit/module"
+	"github.com/regen-network/regen-ledger/x/ecocredit/orderbook"
 	"github.com/regen-network/regen-ledger/x/ecocredit/server/testsuite"
 )
 
@@ -53,6 +54,7 @@ func setup(t *testing.T) (fixture.Factory, bankkeeper.BaseKeeper, authkeeper.Acc
 	paramsKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
 	ecoKey := sdk.NewKVStoreKey(ecocredit.ModuleName)
 	tkey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+	memKey := storetypes.NewMemoryStoreKey(orderbook.MemStoreKey)
 
 	baseApp.MountStore(authKey, storetypes.StoreTypeIAVL)
 	baseApp.MountStore(ecoKey, storetypes.StoreTypeIAVL)
@@ -60,6 +62,7 @@ func setup(t *testing.T) (fixture.Factory, bankkeeper.BaseKeeper, authkeeper.Acc
 	baseApp.MountStore(distKey, storetypes.StoreTypeIAVL)
 	baseApp.MountStore(paramsKey, storetypes.StoreTypeIAVL)
 	baseApp.MountStore(tkey, storetypes.StoreTypeTransient)
+	baseApp.MountStore(memKey, storetypes.StoreTypeMemory)
 
 	authSubspace := paramstypes.NewSubspace(cdc, amino, paramsKey, tkey, authtypes.ModuleName)
 	bankSubspace := paramstypes.NewSubspace(cdc, amino, paramsKey, tkey, banktypes.ModuleName)
@@ -80,7 +83,7 @@ func setup(t *testing.T) (fixture.Factory, bankkeeper.BaseKeeper, authkeeper.Acc
 	)
 
 	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
-	ecocreditModule := module.NewModule(ecoKey, authority, accountKeeper, bankKeeper, ecocreditSubspace, nil)
+	ecocreditModule := module.NewModule(ecoKey, memKey, authority, accountKeeper, bankKeeper, ecocreditSubspace, nil, nil, nil, nil)
 	ff.SetModules([]sdkmodule.AppModule{ecocreditModule})
 
 	return ff, bankKeeper, accountKeeper
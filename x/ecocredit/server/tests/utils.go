@@ -18,6 +18,7 @@ import (
 	ecocredittypes "github.com/regen-network/regen-ledger/x/ecocredit"
 	"github.com/regen-network/regen-ledger/x/ecocredit/basket"
 	ecocredit "github.com/regen-network/regen-ledger/x/ecocredit/module"
+	"github.com/regen-network/regen-ledger/x/ecocredit/orderbook"
 )
 
 func NewEcocreditModule(ff fixture.Factory) *ecocredit.Module {
@@ -34,6 +35,7 @@ func NewEcocreditModule(ff fixture.Factory) *ecocredit.Module {
 	distKey := sdk.NewKVStoreKey(disttypes.StoreKey)
 	paramsKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
 	tkey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+	memKey := storetypes.NewMemoryStoreKey(orderbook.MemStoreKey)
 
 	baseApp.MountStore(authKey, storetypes.StoreTypeIAVL)
 	baseApp.MountStore(ecocreditKey, storetypes.StoreTypeIAVL)
@@ -41,6 +43,7 @@ func NewEcocreditModule(ff fixture.Factory) *ecocredit.Module {
 	baseApp.MountStore(distKey, storetypes.StoreTypeIAVL)
 	baseApp.MountStore(paramsKey, storetypes.StoreTypeIAVL)
 	baseApp.MountStore(tkey, storetypes.StoreTypeTransient)
+	baseApp.MountStore(memKey, storetypes.StoreTypeMemory)
 
 	authSubspace := paramstypes.NewSubspace(cdc, amino, paramsKey, tkey, authtypes.ModuleName)
 	bankSubspace := paramstypes.NewSubspace(cdc, amino, paramsKey, tkey, banktypes.ModuleName)
@@ -61,5 +64,5 @@ func NewEcocreditModule(ff fixture.Factory) *ecocredit.Module {
 	)
 
 	_, _, addr := testdata.KeyTestPubAddr()
-	return ecocredit.NewModule(ecocreditKey, addr, accountKeeper, bankKeeper, ecocreditSubspace, nil)
+	return ecocredit.NewModule(ecocreditKey, memKey, addr, accountKeeper, bankKeeper, ecocreditSubspace, nil, nil, nil, nil)
 }
@@ -3,6 +3,8 @@ package utils
 import (
 	"context"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 	"github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -55,3 +57,25 @@ func GetBalance(ctx context.Context, table api.BatchBalanceTable, addr types.Acc
 	}
 	return bal, nil
 }
+
+// AssertBatchNotSuspended returns an error if the credit batch is suspended.
+func AssertBatchNotSuspended(batch *api.Batch) error {
+	if batch.Suspended {
+		return sdkerrors.ErrInvalidRequest.Wrapf("credit batch %s is suspended", batch.Denom)
+	}
+	return nil
+}
+
+// GetTotalCost calculates the cost of an amount of credits by multiplying the price per credit and the amount of
+// credits, truncating the result to an integer.
+func GetTotalCost(pricePerCredit sdkmath.Int, amtCredits math.Dec) (sdkmath.Int, error) {
+	unitPrice, err := math.NewPositiveFixedDecFromString(pricePerCredit.String(), amtCredits.NumDecimalPlaces())
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	cost, err := amtCredits.Mul(unitPrice)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	return cost.SdkIntTrim(), nil
+}
@@ -20,6 +20,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 )
 
@@ -117,3 +118,17 @@ func TestUtils_GetCreditTypeFromBatchDenom(t *testing.T) {
 	_, err = GetCreditTypeFromBatchDenom(s.ctx, s.stateStore, invalidDenom)
 	assert.ErrorContains(t, err, "could not get class with ID C02")
 }
+
+func TestUtils_GetTotalCost(t *testing.T) {
+	t.Parallel()
+	quantity, err := math.NewDecFromString("2.505")
+	assert.NilError(t, err)
+
+	// the cost is truncated to an integer
+	cost, err := GetTotalCost(sdk.NewInt(10), quantity)
+	assert.NilError(t, err)
+	assert.Equal(t, cost.String(), "25")
+
+	_, err = GetTotalCost(sdk.NewInt(0), quantity)
+	assert.ErrorContains(t, err, "expected a positive decimal")
+}